@@ -3,6 +3,7 @@ package analysis
 import (
 	"context"
 	"fmt"
+	"runtime/debug"
 	"strings"
 	"sync"
 	"time"
@@ -15,7 +16,6 @@ import (
 
 	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
 	analysisutil "github.com/argoproj/argo-rollouts/utils/analysis"
-	"github.com/argoproj/argo-rollouts/utils/defaults"
 	logutil "github.com/argoproj/argo-rollouts/utils/log"
 	"github.com/argoproj/argo-rollouts/utils/record"
 	timeutil "github.com/argoproj/argo-rollouts/utils/time"
@@ -30,7 +30,7 @@ const (
 	DefaultErrorRetryInterval = 10 * time.Second
 	// SuccessfulAssessmentRunTerminatedResult is used for logging purposes when the metrics evaluation
 	// is successful and the run is terminated.
-	SuccessfulAssessmentRunTerminatedResult = "Metric Assessment Result - Successful: Run Terminated"
+	SuccessfulAssessmentRunTerminatedResult = analysisutil.SuccessfulAssessmentRunTerminatedResult
 )
 
 // metricTask holds the metric which need to be measured during this reconciliation along with
@@ -56,6 +56,15 @@ func (c *Controller) reconcileAnalysisRun(origRun *v1alpha1.AnalysisRun) *v1alph
 		run.Status.MetricResults = make([]v1alpha1.MetricResult, 0)
 	}
 
+	if err := analysisutil.ValidateMetricTemplates(run.Spec.Metrics); err != nil {
+		message := fmt.Sprintf("Analysis spec invalid: %v", err)
+		logger.Warn(message)
+		run.Status.Phase = v1alpha1.AnalysisPhaseError
+		run.Status.Message = message
+		c.recordAnalysisRunCompletionEvent(run)
+		return run
+	}
+
 	resolvedMetrics, err := getResolvedMetricsWithoutSecrets(run.Spec.Metrics, run.Spec.Args)
 	if err != nil {
 		message := fmt.Sprintf("Unable to resolve metric arguments: %v", err)
@@ -67,6 +76,9 @@ func (c *Controller) reconcileAnalysisRun(origRun *v1alpha1.AnalysisRun) *v1alph
 	}
 
 	err = analysisutil.ValidateMetrics(resolvedMetrics)
+	if err == nil {
+		err = analysisutil.ValidateScoring(run.Spec.Scoring, resolvedMetrics)
+	}
 	if err != nil {
 		message := fmt.Sprintf("Analysis spec invalid: %v", err)
 		logger.Warn(message)
@@ -146,6 +158,9 @@ func getResolvedMetricsWithoutSecrets(metrics []v1alpha1.Metric, args []v1alpha1
 		if newArg.ValueFrom != nil && newArg.ValueFrom.SecretKeyRef != nil {
 			newArg.ValueFrom = nil
 			newArg.Value = ptr.To[string]("temp-for-secret")
+		} else if newArg.ValueFrom != nil && newArg.ValueFrom.ConfigMapKeyRef != nil {
+			newArg.ValueFrom = nil
+			newArg.Value = ptr.To[string]("temp-for-config-map")
 		}
 		newArgs = append(newArgs, *newArg)
 	}
@@ -202,6 +217,10 @@ func generateMetricTasks(run *v1alpha1.AnalysisRun, metrics []v1alpha1.Metric) [
 			continue
 		}
 		if lastMeasurement == nil {
+			if pending := analysisutil.PendingDependencies(run, metric); len(pending) > 0 {
+				logCtx.Infof("Waiting for dependencies to complete: %s", strings.Join(pending, ", "))
+				continue
+			}
 			if metric.InitialDelay != "" {
 				if run.Status.StartedAt == nil {
 					continue
@@ -238,7 +257,7 @@ func generateMetricTasks(run *v1alpha1.AnalysisRun, metrics []v1alpha1.Metric) [
 			if err != nil {
 				continue
 			}
-			interval = parsedInterval
+			interval = adaptInterval(logCtx, metric, *metricResult, parsedInterval)
 		}
 		if timeutil.Now().After(lastMeasurement.FinishedAt.Add(interval)) {
 			tasks = append(tasks, metricTask{metric: run.Spec.Metrics[i]})
@@ -249,6 +268,34 @@ func generateMetricTasks(run *v1alpha1.AnalysisRun, metrics []v1alpha1.Metric) [
 	return tasks
 }
 
+// adaptInterval returns the min interval of the adaptive interval of the metric, instead of its interval, when
+// the last measurement of the metric is close to a threshold
+func adaptInterval(logCtx *log.Entry, metric v1alpha1.Metric, result v1alpha1.MetricResult, interval time.Duration) time.Duration {
+	if metric.AdaptiveInterval == nil || len(result.Measurements) == 0 {
+		return interval
+	}
+	minInterval, err := parseMetricInterval(*logCtx, metric.AdaptiveInterval.MinInterval)
+	if err != nil || minInterval >= interval {
+		return interval
+	}
+	lastMeasurement := result.Measurements[len(result.Measurements)-1]
+	if lastMeasurement.Phase != v1alpha1.AnalysisPhaseSuccessful {
+		return minInterval
+	}
+	if metric.AdaptiveInterval.Condition == "" {
+		return interval
+	}
+	closeToThreshold, err := analysisutil.EvalMeasurementsCondition(result.Measurements, metric.AdaptiveInterval.Condition)
+	if err != nil {
+		logCtx.Warnf("Failed to evaluate adaptive interval condition: %v", err)
+		return interval
+	}
+	if closeToThreshold {
+		return minInterval
+	}
+	return interval
+}
+
 // parseMetricInterval is a helper method to parse the given metric interval and return the
 // parsed duration or error (if any)
 func parseMetricInterval(logCtx log.Entry, metricDurationString v1alpha1.DurationString) (time.Duration, error) {
@@ -260,7 +307,7 @@ func parseMetricInterval(logCtx log.Entry, metricDurationString v1alpha1.Duratio
 	return metricInterval, nil
 }
 
-// resolveArgs resolves args for metricTasks, including secret references
+// resolveArgs resolves args for metricTasks, including secret and config map references
 // returns resolved metricTasks and secrets for log redaction
 func (c *Controller) resolveArgs(tasks []metricTask, args []v1alpha1.Argument, namespace string) ([]metricTask, []string, error) {
 	//create set of secret values for redaction
@@ -285,6 +332,14 @@ func (c *Controller) resolveArgs(tasks []metricTask, args []v1alpha1.Argument, n
 			resolvedArg := arg.DeepCopy()
 			resolvedArg.Value = &secretContent
 			args[i] = *resolvedArg
+		} else if arg.ValueFrom != nil && arg.ValueFrom.ConfigMapKeyRef != nil {
+			value, err := analysisutil.GetConfigMapKeyRefValue(c.kubeclientset, namespace, arg.ValueFrom.ConfigMapKeyRef)
+			if err != nil {
+				return nil, nil, err
+			}
+			resolvedArg := arg.DeepCopy()
+			resolvedArg.Value = &value
+			args[i] = *resolvedArg
 		} else {
 			args[i] = arg
 		}
@@ -308,6 +363,25 @@ func (c *Controller) resolveArgs(tasks []metricTask, args []v1alpha1.Argument, n
 	return tasks, secrets, nil
 }
 
+// measure returns the measurement taken by takeMeasurement. A panic of the metric provider errors the
+// measurement instead of crashing the controller.
+func measure(logger *log.Entry, incompleteMeasurement *v1alpha1.Measurement, takeMeasurement func() v1alpha1.Measurement) (measurement v1alpha1.Measurement) {
+	defer func() {
+		if r := recover(); r != nil {
+			logger.Errorf("Recovered from panic: %+v\n%s", r, debug.Stack())
+			if incompleteMeasurement != nil {
+				measurement = *incompleteMeasurement
+			} else {
+				startedAt := timeutil.MetaNow()
+				measurement = v1alpha1.Measurement{StartedAt: &startedAt}
+			}
+			measurement.Phase = v1alpha1.AnalysisPhaseError
+			measurement.Message = fmt.Sprintf("Recovered from panic: %v", r)
+		}
+	}()
+	return takeMeasurement()
+}
+
 // runMeasurements iterates a list of metric tasks, and runs, resumes, or terminates measurements
 func (c *Controller) runMeasurements(run *v1alpha1.AnalysisRun, tasks []metricTask, dryRunMetricsMap map[string]bool) error {
 	var wg sync.WaitGroup
@@ -344,20 +418,21 @@ func (c *Controller) runMeasurements(run *v1alpha1.AnalysisRun, tasks []metricTa
 				newMeasurement.Phase = v1alpha1.AnalysisPhaseError
 				newMeasurement.Message = providerErr.Error()
 			} else {
-				if t.incompleteMeasurement == nil {
-					newMeasurement = provider.Run(run, t.metric)
-				} else {
+				newMeasurement = measure(logger, t.incompleteMeasurement, func() v1alpha1.Measurement {
+					if t.incompleteMeasurement == nil {
+						return provider.Run(run, t.metric)
+					}
 					// metric is incomplete. either terminate or resume it
 					if terminating {
 						logger.Infof("Terminating in-progress measurement")
-						newMeasurement = provider.Terminate(run, t.metric, *t.incompleteMeasurement)
-						if newMeasurement.Phase == v1alpha1.AnalysisPhaseSuccessful || newMeasurement.Phase == v1alpha1.AnalysisPhaseInconclusive {
-							newMeasurement.Message = "Metric Terminated"
+						measurement := provider.Terminate(run, t.metric, *t.incompleteMeasurement)
+						if measurement.Phase == v1alpha1.AnalysisPhaseSuccessful || measurement.Phase == v1alpha1.AnalysisPhaseInconclusive {
+							measurement.Message = "Metric Terminated"
 						}
-					} else {
-						newMeasurement = provider.Resume(run, t.metric, *t.incompleteMeasurement)
+						return measurement
 					}
-				}
+					return provider.Resume(run, t.metric, *t.incompleteMeasurement)
+				})
 			}
 
 			resultsLock.Lock()
@@ -373,6 +448,9 @@ func (c *Controller) runMeasurements(run *v1alpha1.AnalysisRun, tasks []metricTa
 				if provider != nil && providerErr == nil {
 					metricResult.Metadata = provider.GetMetadata(t.metric)
 				}
+			} else if len(metricResult.Measurements) == 0 && len(t.metric.DependsOn) > 0 && provider != nil && providerErr == nil {
+				// the result was recorded while the metric was waiting for its dependencies to complete
+				metricResult.Metadata = provider.GetMetadata(t.metric)
 			}
 
 			if newMeasurement.Phase.Completed() {
@@ -382,26 +460,8 @@ func (c *Controller) runMeasurements(run *v1alpha1.AnalysisRun, tasks []metricTa
 					newMeasurement.FinishedAt = &finishedAt
 				}
 
-				switch newMeasurement.Phase {
-				case v1alpha1.AnalysisPhaseSuccessful:
-					metricResult.Successful++
-					metricResult.Count++
-					metricResult.ConsecutiveError = 0
-					metricResult.ConsecutiveSuccess++
-				case v1alpha1.AnalysisPhaseFailed:
-					metricResult.Failed++
-					metricResult.Count++
-					metricResult.ConsecutiveError = 0
-					metricResult.ConsecutiveSuccess = 0
-				case v1alpha1.AnalysisPhaseInconclusive:
-					metricResult.Inconclusive++
-					metricResult.Count++
-					metricResult.ConsecutiveError = 0
-					metricResult.ConsecutiveSuccess = 0
-				case v1alpha1.AnalysisPhaseError:
-					metricResult.Error++
-					metricResult.ConsecutiveError++
-					metricResult.ConsecutiveSuccess = 0
+				analysisutil.CountMeasurement(metricResult, newMeasurement.Phase)
+				if newMeasurement.Phase == v1alpha1.AnalysisPhaseError {
 					logger.Warnf("Measurement had error: %s", newMeasurement.Message)
 				}
 			}
@@ -436,6 +496,8 @@ func (c *Controller) runMeasurements(run *v1alpha1.AnalysisRun, tasks []metricTa
 func (c *Controller) assessRunStatus(run *v1alpha1.AnalysisRun, metrics []v1alpha1.Metric, dryRunMetricsMap map[string]bool) (v1alpha1.AnalysisPhase, string) {
 	var worstStatus v1alpha1.AnalysisPhase
 	var worstMessage string
+	// first metric which errored, it decides the phase of a scored run
+	var erroredMetric string
 	terminating := analysisutil.IsTerminating(run)
 	everythingCompleted := true
 
@@ -463,6 +525,10 @@ func (c *Controller) assessRunStatus(run *v1alpha1.AnalysisRun, metrics []v1alph
 		Error:        0,
 	}
 
+	if !terminating {
+		setBlockedMetricResults(run, metrics, dryRunMetricsMap)
+	}
+
 	// Iterate all metrics and update `MetricResult.Phase` fields based on latest measurement(s)
 	for _, metric := range metrics {
 		if dryRunMetricsMap[metric.Name] {
@@ -473,7 +539,7 @@ func (c *Controller) assessRunStatus(run *v1alpha1.AnalysisRun, metrics []v1alph
 		}
 		if result := analysisutil.GetResult(run, metric.Name); result != nil {
 			logger := logutil.WithAnalysisRun(run).WithField("metric", metric.Name)
-			metricStatus := assessMetricStatus(metric, *result, terminating)
+			metricStatus := analysisutil.AssessMetricStatus(metric, *result, terminating)
 			if result.Phase != metricStatus {
 				logger.Infof("Metric '%s' transitioned from %s -> %s", metric.Name, result.Phase, metricStatus)
 				if metricStatus.Completed() {
@@ -486,6 +552,9 @@ func (c *Controller) assessRunStatus(run *v1alpha1.AnalysisRun, metrics []v1alph
 				}
 				if lastMeasurement := analysisutil.LastMeasurement(run, metric.Name); lastMeasurement != nil {
 					result.Message = lastMeasurement.Message
+				} else {
+					// the metric never took a measurement (e.g. it was still waiting on its dependencies)
+					result.Message = ""
 				}
 				result.Phase = metricStatus
 				analysisutil.SetResult(run, *result)
@@ -494,10 +563,13 @@ func (c *Controller) assessRunStatus(run *v1alpha1.AnalysisRun, metrics []v1alph
 				// if any metric is in-progress, then entire analysis run will be considered running
 				everythingCompleted = false
 			} else {
-				phase, message := assessMetricFailureInconclusiveOrError(metric, *result)
+				phase, message := analysisutil.AssessMetricFailureInconclusiveOrError(metric, *result)
 				// NOTE: We don't care about the status if the metric is marked as a Dry-Run
 				// otherwise, remember the worst status of all completed metric results
 				if !dryRunMetricsMap[metric.Name] {
+					if metricStatus == v1alpha1.AnalysisPhaseError && erroredMetric == "" {
+						erroredMetric = metric.Name
+					}
 					if worstStatus == "" || analysisutil.IsWorse(worstStatus, metricStatus) {
 						worstStatus = metricStatus
 						if message != "" {
@@ -553,6 +625,22 @@ func (c *Controller) assessRunStatus(run *v1alpha1.AnalysisRun, metrics []v1alph
 			everythingCompleted = false
 		}
 	}
+	if run.Spec.Scoring != nil {
+		run.Status.Score = analysisutil.ScoreRun(run, metrics, dryRunMetricsMap)
+		// errors cannot be scored, they still decide the phase of the run
+		if erroredMetric != "" {
+			worstStatus = v1alpha1.AnalysisPhaseError
+			worstMessage = fmt.Sprintf("Metric \"%s\" assessed %s", erroredMetric, v1alpha1.AnalysisPhaseError)
+			if result := analysisutil.GetResult(run, erroredMetric); result.Message != "" {
+				worstMessage += fmt.Sprintf(": \"Error Message: %s\"", result.Message)
+			}
+		} else if (everythingCompleted || terminating) && run.Status.Score != nil {
+			worstStatus, worstMessage = analysisutil.AssessScore(*run.Spec.Scoring, *run.Status.Score)
+			if worstStatus == v1alpha1.AnalysisPhaseSuccessful && run.Spec.Terminate {
+				worstMessage = "Run Terminated"
+			}
+		}
+	}
 	// Append Dry-Run metrics results if any.
 	worstMessage = strings.TrimSpace(worstMessage)
 	run.Status.RunSummary = runSummary
@@ -572,118 +660,38 @@ func (c *Controller) assessRunStatus(run *v1alpha1.AnalysisRun, metrics []v1alph
 	return worstStatus, worstMessage
 }
 
-// assessMetricStatus assesses the status of a single metric based on:
-// * current or latest measurement status
-// * parameters given by the metric (failureLimit, count, etc...)
-// * whether we are terminating (e.g. due to failing run, or termination request)
-func assessMetricStatus(metric v1alpha1.Metric, result v1alpha1.MetricResult, terminating bool) v1alpha1.AnalysisPhase {
-	if result.Phase.Completed() {
-		return result.Phase
-	}
-	logger := log.WithField("metric", metric.Name)
-	if len(result.Measurements) == 0 {
-		if terminating {
-			// we have yet to take a single measurement, but have already been instructed to stop
-			logger.Infof(SuccessfulAssessmentRunTerminatedResult)
-			return v1alpha1.AnalysisPhaseSuccessful
-		}
-		return v1alpha1.AnalysisPhasePending
-	}
-	lastMeasurement := result.Measurements[len(result.Measurements)-1]
-	if !lastMeasurement.Phase.Completed() {
-		// we still have an in-flight measurement
-		return v1alpha1.AnalysisPhaseRunning
-	}
-
-	// Check if metric was considered Failed, Inconclusive, or Error
-	// If true, then return AnalysisRunPhase as Failed, Inconclusive, or Error respectively
-	phaseFailureInconclusiveOrError, message := assessMetricFailureInconclusiveOrError(metric, result)
-	if phaseFailureInconclusiveOrError != "" {
-		logger.Infof("Metric Assessment Result - %s: %s", phaseFailureInconclusiveOrError, message)
-		return phaseFailureInconclusiveOrError
-	}
-
-	// Check if consecutiveSuccessLimit is applicable and was reached.
-	if metric.ConsecutiveSuccessLimit != nil && metric.ConsecutiveSuccessLimit.IntValue() > 0 && result.ConsecutiveSuccess >= int32(metric.ConsecutiveSuccessLimit.IntValue()) {
-		logger.Infof("Metric Assessment Result - %s: ConsecutiveSuccessLimit (%s) Reached", v1alpha1.AnalysisPhaseSuccessful, metric.ConsecutiveSuccessLimit.String())
-		return v1alpha1.AnalysisPhaseSuccessful
-	}
-
-	// If a count was specified, and we reached that count, then metric is considered Successful.
-	// The Error, Failed, Inconclusive counters are ignored because those checks have already been
-	// taken into consideration above, and we do not want to fail if failures < failureLimit.
-	effectiveCount := metric.EffectiveCount()
-	if effectiveCount != nil && result.Count >= int32(effectiveCount.IntValue()) {
-
-		failureApplicable := (metric.FailureLimit != nil && metric.FailureLimit.IntValue() >= 0) || metric.FailureLimit == nil
-		successApplicable := metric.ConsecutiveSuccessLimit != nil && metric.ConsecutiveSuccessLimit.IntValue() > 0
-
-		if failureApplicable && successApplicable {
-
-			// failureLimit was checked above and not reached.
-			// consecutiveSuccessLimit was checked above and not reached.
-
-			failureLimit := "0"
-			if metric.FailureLimit != nil {
-				failureLimit = metric.FailureLimit.String()
+// setBlockedMetricResults records a Pending result for every metric which has yet to start because
+// it is waiting for the metrics it depends on to complete successfully. Metrics which depend on a
+// metric which completed unsuccessfully will never start, and are completed as Inconclusive.
+func setBlockedMetricResults(run *v1alpha1.AnalysisRun, metrics []v1alpha1.Metric, dryRunMetricsMap map[string]bool) {
+	// completing a metric may in turn block the metrics depending on it
+	for blocked := true; blocked; {
+		blocked = false
+		for _, metric := range metrics {
+			pending := analysisutil.PendingDependencies(run, metric)
+			if len(pending) == 0 {
+				continue
 			}
-
-			logger.Infof("Metric Assessment Result - %s: ConsecutiveSuccessLimit (%s) Not Reached and FailureLimit (%s) Not Violated", v1alpha1.AnalysisPhaseInconclusive, metric.ConsecutiveSuccessLimit.String(), failureLimit)
-			return v1alpha1.AnalysisPhaseInconclusive
-
-		} else if successApplicable {
-
-			logger.Infof("Metric Assessment Result - %s: ConsecutiveSuccessLimit (%s) Not Reached", v1alpha1.AnalysisPhaseFailed, metric.ConsecutiveSuccessLimit.String())
-			return v1alpha1.AnalysisPhaseFailed
-
-		} else if failureApplicable {
-			// failureLimit was not reached in assessMetricFailureInconclusiveOrError above.
-			// AnalysisPhaseSuccessful below.
-		} else {
-			// This cannot happen, since one of failureLimit or consecutiveSuccessLimit will be applicable
-			// We validate that failureLimit >= 0 when consecutiveSuccessLimit == 0
+			result := analysisutil.GetResult(run, metric.Name)
+			if result == nil {
+				result = &v1alpha1.MetricResult{
+					Name:   metric.Name,
+					Phase:  v1alpha1.AnalysisPhasePending,
+					DryRun: dryRunMetricsMap[metric.Name],
+				}
+			} else if len(result.Measurements) > 0 || result.Phase.Completed() {
+				continue
+			}
+			if failed := analysisutil.FailedDependencies(run, metric); len(failed) > 0 {
+				result.Phase = v1alpha1.AnalysisPhaseInconclusive
+				result.Message = fmt.Sprintf("Metric(s) it depends on did not succeed: %s", strings.Join(failed, ", "))
+				blocked = true
+			} else {
+				result.Message = fmt.Sprintf("Waiting for metric(s) to complete: %s", strings.Join(pending, ", "))
+			}
+			analysisutil.SetResult(run, *result)
 		}
-
-		logger.Infof("Metric Assessment Result - %s: Count (%s) Reached", v1alpha1.AnalysisPhaseSuccessful, effectiveCount.String())
-		return v1alpha1.AnalysisPhaseSuccessful
-	}
-	// if we get here, this metric runs indefinitely
-	if terminating {
-		logger.Infof(SuccessfulAssessmentRunTerminatedResult)
-		return v1alpha1.AnalysisPhaseSuccessful
-	}
-	return v1alpha1.AnalysisPhaseRunning
-}
-
-func assessMetricFailureInconclusiveOrError(metric v1alpha1.Metric, result v1alpha1.MetricResult) (v1alpha1.AnalysisPhase, string) {
-	var message string
-	var phase v1alpha1.AnalysisPhase
-
-	failureLimit := int32(0)
-	if metric.FailureLimit != nil {
-		failureLimit = int32(metric.FailureLimit.IntValue())
-	}
-	// If failureLimit is negative, that means it isn't applicable.
-	if failureLimit >= 0 && result.Failed > failureLimit {
-		phase = v1alpha1.AnalysisPhaseFailed
-		message = fmt.Sprintf("failed (%d) > failureLimit (%d)", result.Failed, failureLimit)
-	}
-
-	inconclusiveLimit := int32(0)
-	if metric.InconclusiveLimit != nil {
-		inconclusiveLimit = int32(metric.InconclusiveLimit.IntValue())
-	}
-	if result.Inconclusive > inconclusiveLimit {
-		phase = v1alpha1.AnalysisPhaseInconclusive
-		message = fmt.Sprintf("inconclusive (%d) > inconclusiveLimit (%d)", result.Inconclusive, inconclusiveLimit)
-	}
-
-	consecutiveErrorLimit := defaults.GetConsecutiveErrorLimitOrDefault(&metric)
-	if result.ConsecutiveError > consecutiveErrorLimit {
-		phase = v1alpha1.AnalysisPhaseError
-		message = fmt.Sprintf("consecutiveErrors (%d) > consecutiveErrorLimit (%d)", result.ConsecutiveError, consecutiveErrorLimit)
 	}
-	return phase, message
 }
 
 // calculateNextReconcileTime calculates the next time that this AnalysisRun should be reconciled,
@@ -698,6 +706,10 @@ func calculateNextReconcileTime(run *v1alpha1.AnalysisRun, metrics []v1alpha1.Me
 		logCtx := logutil.WithAnalysisRun(run).WithField("metric", metric.Name)
 		lastMeasurement := analysisutil.LastMeasurement(run, metric.Name)
 		if lastMeasurement == nil {
+			if len(analysisutil.PendingDependencies(run, metric)) > 0 {
+				// metric will be started once the metrics it depends on complete
+				continue
+			}
 			if metric.InitialDelay != "" {
 				startTime := timeutil.MetaNow()
 				if run.Status.StartedAt != nil {
@@ -713,6 +725,14 @@ func calculateNextReconcileTime(run *v1alpha1.AnalysisRun, metrics []v1alpha1.Me
 				}
 				continue
 			}
+			if len(metric.DependsOn) > 0 {
+				// dependencies completed during this reconciliation. start the metric right away
+				now := timeutil.Now()
+				if reconcileTime == nil || reconcileTime.After(now) {
+					reconcileTime = &now
+				}
+				continue
+			}
 			// no measurement was started . we should never get here
 			logCtx.Warnf("Metric never started. Not factored into enqueue time.")
 			continue
@@ -740,7 +760,7 @@ func calculateNextReconcileTime(run *v1alpha1.AnalysisRun, metrics []v1alpha1.Me
 			if err != nil {
 				continue
 			}
-			interval = parsedInterval
+			interval = adaptInterval(logCtx, metric, *metricResult, parsedInterval)
 		} else {
 			// if we get here, an interval was not set (meaning reoccurrence was not desired), and
 			// there was no error (meaning we don't need to retry). no need to requeue this metric.
@@ -809,6 +829,10 @@ func (c *Controller) maybeGarbageCollectAnalysisRun(run *v1alpha1.AnalysisRun, l
 	if run.DeletionTimestamp != nil || !isAnalysisRunTtlExceeded(run) {
 		return nil
 	}
+	if c.exportPending(run) {
+		logger.Infof("Skipping cleanup of TTL exceeded analysis run pending analysis history export")
+		return nil
+	}
 	logger.Infof("Trying to cleanup TTL exceeded analysis run")
 	err := c.argoProjClientset.ArgoprojV1alpha1().AnalysisRuns(run.Namespace).Delete(ctx, run.Name, metav1.DeleteOptions{})
 	if err != nil && !k8serrors.IsNotFound(err) {
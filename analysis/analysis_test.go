@@ -25,6 +25,7 @@ import (
 	"k8s.io/utils/ptr"
 
 	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
+	analysisutil "github.com/argoproj/argo-rollouts/utils/analysis"
 	"github.com/argoproj/argo-rollouts/utils/defaults"
 	logutil "github.com/argoproj/argo-rollouts/utils/log"
 )
@@ -203,6 +204,61 @@ func TestGenerateMetricTasksInterval(t *testing.T) {
 	}
 }
 
+func TestGenerateMetricTasksAdaptiveInterval(t *testing.T) {
+	measurement := func(value string, phase v1alpha1.AnalysisPhase) v1alpha1.Measurement {
+		return v1alpha1.Measurement{
+			Value:      value,
+			Phase:      phase,
+			StartedAt:  timePtr(metav1.NewTime(time.Now().Add(-50 * time.Second))),
+			FinishedAt: timePtr(metav1.NewTime(time.Now().Add(-50 * time.Second))),
+		}
+	}
+	run := &v1alpha1.AnalysisRun{
+		Spec: v1alpha1.AnalysisRunSpec{
+			Metrics: []v1alpha1.Metric{{
+				Name:             "success-rate",
+				Interval:         "5m",
+				SuccessCondition: "result[0] >= 0.95",
+				AdaptiveInterval: &v1alpha1.AdaptiveInterval{
+					MinInterval: "30s",
+					Condition:   "result[0] < 0.97",
+				},
+			}},
+		},
+		Status: v1alpha1.AnalysisRunStatus{
+			Phase: v1alpha1.AnalysisPhaseRunning,
+			MetricResults: []v1alpha1.MetricResult{{
+				Name:         "success-rate",
+				Phase:        v1alpha1.AnalysisPhaseRunning,
+				Measurements: []v1alpha1.Measurement{measurement("[0.99]", v1alpha1.AnalysisPhaseSuccessful)},
+			}},
+		},
+	}
+	{
+		// far from the threshold, the interval is used
+		assert.Len(t, generateMetricTasks(run, run.Spec.Metrics), 0)
+		nextReconcileTime := calculateNextReconcileTime(run, run.Spec.Metrics)
+		assert.Equal(t, run.Status.MetricResults[0].Measurements[0].FinishedAt.Add(5*time.Minute), *nextReconcileTime)
+	}
+	{
+		// close to the threshold, the min interval is used
+		run.Status.MetricResults[0].Measurements[0] = measurement("[0.96]", v1alpha1.AnalysisPhaseSuccessful)
+		assert.Len(t, generateMetricTasks(run, run.Spec.Metrics), 1)
+		nextReconcileTime := calculateNextReconcileTime(run, run.Spec.Metrics)
+		assert.Equal(t, run.Status.MetricResults[0].Measurements[0].FinishedAt.Add(30*time.Second), *nextReconcileTime)
+	}
+	{
+		// measurements which did not succeed are close to the threshold
+		run.Status.MetricResults[0].Measurements[0] = measurement("[0.90]", v1alpha1.AnalysisPhaseFailed)
+		assert.Len(t, generateMetricTasks(run, run.Spec.Metrics), 1)
+	}
+	{
+		// the interval is used when the condition cannot be evaluated
+		run.Status.MetricResults[0].Measurements[0] = measurement("0.96", v1alpha1.AnalysisPhaseSuccessful)
+		assert.Len(t, generateMetricTasks(run, run.Spec.Metrics), 0)
+	}
+}
+
 func TestGenerateMetricTasksFailing(t *testing.T) {
 	run := &v1alpha1.AnalysisRun{
 		Spec: v1alpha1.AnalysisRunSpec{
@@ -424,6 +480,65 @@ func TestGenerateMetricTasksError(t *testing.T) {
 	}
 }
 
+func TestGenerateMetricTasksHonorDependsOn(t *testing.T) {
+	run := &v1alpha1.AnalysisRun{
+		Spec: v1alpha1.AnalysisRunSpec{
+			Metrics: []v1alpha1.Metric{
+				{
+					Name: "smoke-test",
+				},
+				{
+					Name:      "success-rate",
+					DependsOn: []string{"smoke-test"},
+				},
+			},
+		},
+		Status: v1alpha1.AnalysisRunStatus{
+			Phase: v1alpha1.AnalysisPhaseRunning,
+		},
+	}
+	{
+		// ensure we only measure the metric without dependencies
+		tasks := generateMetricTasks(run, run.Spec.Metrics)
+		assert.Equal(t, 1, len(tasks))
+		assert.Equal(t, "smoke-test", tasks[0].metric.Name)
+	}
+	{
+		// ensure we don't start the dependent metric while the dependency is in progress
+		run.Status.MetricResults = []v1alpha1.MetricResult{{
+			Name:  "smoke-test",
+			Phase: v1alpha1.AnalysisPhaseRunning,
+			Measurements: []v1alpha1.Measurement{{
+				Phase:     v1alpha1.AnalysisPhaseRunning,
+				StartedAt: timePtr(metav1.NewTime(time.Now().Add(-10 * time.Second))),
+				ResumeAt:  timePtr(metav1.NewTime(time.Now().Add(10 * time.Second))),
+			}},
+		}}
+		tasks := generateMetricTasks(run, run.Spec.Metrics)
+		assert.Equal(t, 0, len(tasks))
+	}
+	{
+		// ensure we start the dependent metric once the dependency completed
+		run.Status.MetricResults[0].Phase = v1alpha1.AnalysisPhaseSuccessful
+		tasks := generateMetricTasks(run, run.Spec.Metrics)
+		assert.Equal(t, 1, len(tasks))
+		assert.Equal(t, "success-rate", tasks[0].metric.Name)
+	}
+	{
+		// ensure we never start the dependent metric if the dependency did not succeed
+		run.Status.MetricResults[0].Phase = v1alpha1.AnalysisPhaseFailed
+		tasks := generateMetricTasks(run, run.Spec.Metrics)
+		assert.Equal(t, 0, len(tasks))
+	}
+	{
+		// ensure a dry-run dependency is satisfied regardless of its result
+		run.Status.MetricResults[0].DryRun = true
+		tasks := generateMetricTasks(run, run.Spec.Metrics)
+		assert.Equal(t, 1, len(tasks))
+		assert.Equal(t, "success-rate", tasks[0].metric.Name)
+	}
+}
+
 func TestAssessRunStatus(t *testing.T) {
 	f := newFixture(t)
 	defer f.Close()
@@ -480,6 +595,92 @@ func TestAssessRunStatus(t *testing.T) {
 	}
 }
 
+func TestAssessRunStatusReportsBlockedMetrics(t *testing.T) {
+	f := newFixture(t)
+	defer f.Close()
+	c, _, _ := f.newController(noResyncPeriodFunc)
+	run := &v1alpha1.AnalysisRun{
+		Spec: v1alpha1.AnalysisRunSpec{
+			Metrics: []v1alpha1.Metric{
+				{
+					Name: "smoke-test",
+				},
+				{
+					Name:      "success-rate",
+					DependsOn: []string{"smoke-test"},
+				},
+			},
+		},
+		Status: v1alpha1.AnalysisRunStatus{
+			Phase: v1alpha1.AnalysisPhaseRunning,
+			MetricResults: []v1alpha1.MetricResult{{
+				Name:  "smoke-test",
+				Phase: v1alpha1.AnalysisPhaseRunning,
+				Measurements: []v1alpha1.Measurement{{
+					Phase:     v1alpha1.AnalysisPhaseRunning,
+					StartedAt: timePtr(metav1.NewTime(time.Now().Add(-60 * time.Second))),
+				}},
+			}},
+		},
+	}
+	status, _ := c.assessRunStatus(run, run.Spec.Metrics, map[string]bool{"success-rate": true})
+	assert.Equal(t, v1alpha1.AnalysisPhaseRunning, status)
+	assert.Len(t, run.Status.MetricResults, 2)
+	blocked := run.Status.MetricResults[1]
+	assert.Equal(t, "success-rate", blocked.Name)
+	assert.Equal(t, v1alpha1.AnalysisPhasePending, blocked.Phase)
+	assert.True(t, blocked.DryRun)
+	assert.Equal(t, "Waiting for metric(s) to complete: smoke-test", blocked.Message)
+
+	// ensure the blocked metric is considered successful when the run terminates before it started
+	run.Spec.Terminate = true
+	c.assessRunStatus(run, run.Spec.Metrics, map[string]bool{"success-rate": true})
+	assert.Equal(t, v1alpha1.AnalysisPhaseSuccessful, run.Status.MetricResults[1].Phase)
+	assert.Equal(t, "", run.Status.MetricResults[1].Message)
+}
+
+func TestAssessRunStatusCompletesMetricsOfFailedDependencies(t *testing.T) {
+	f := newFixture(t)
+	defer f.Close()
+	c, _, _ := f.newController(noResyncPeriodFunc)
+	run := &v1alpha1.AnalysisRun{
+		Spec: v1alpha1.AnalysisRunSpec{
+			Metrics: []v1alpha1.Metric{
+				{
+					Name: "smoke-test",
+				},
+				{
+					Name:      "success-rate",
+					DependsOn: []string{"smoke-test"},
+				},
+				{
+					Name:      "latency",
+					DependsOn: []string{"success-rate"},
+				},
+			},
+		},
+		Status: v1alpha1.AnalysisRunStatus{
+			Phase: v1alpha1.AnalysisPhaseRunning,
+			MetricResults: []v1alpha1.MetricResult{{
+				Name:         "smoke-test",
+				Phase:        v1alpha1.AnalysisPhaseFailed,
+				Count:        1,
+				Failed:       1,
+				Measurements: []v1alpha1.Measurement{{Phase: v1alpha1.AnalysisPhaseFailed}},
+			}},
+		},
+	}
+	// a scored run is not terminated by a failed metric
+	run.Spec.Scoring = &v1alpha1.AnalysisScoring{Threshold: v1alpha1.ScoreThreshold{Pass: 50, Marginal: 50}}
+	status, _ := c.assessRunStatus(run, run.Spec.Metrics, map[string]bool{})
+	assert.Equal(t, v1alpha1.AnalysisPhaseFailed, status)
+	assert.Len(t, run.Status.MetricResults, 3)
+	assert.Equal(t, v1alpha1.AnalysisPhaseInconclusive, run.Status.MetricResults[1].Phase)
+	assert.Equal(t, "Metric(s) it depends on did not succeed: smoke-test (Failed)", run.Status.MetricResults[1].Message)
+	assert.Equal(t, v1alpha1.AnalysisPhaseInconclusive, run.Status.MetricResults[2].Phase)
+	assert.Equal(t, "Metric(s) it depends on did not succeed: success-rate (Inconclusive)", run.Status.MetricResults[2].Message)
+}
+
 // TestAssessRunStatusUpdateResult ensures we update the metricresult status properly
 // based on latest measurements
 func TestAssessRunStatusUpdateResult(t *testing.T) {
@@ -534,6 +735,85 @@ func TestAssessRunStatusUpdateResult(t *testing.T) {
 	assert.Equal(t, v1alpha1.AnalysisPhaseFailed, run.Status.MetricResults[1].Phase)
 }
 
+func TestAssessRunStatusWithScoring(t *testing.T) {
+	f := newFixture(t)
+	defer f.Close()
+	c, _, _ := f.newController(noResyncPeriodFunc)
+	newRun := func() *v1alpha1.AnalysisRun {
+		return &v1alpha1.AnalysisRun{
+			Spec: v1alpha1.AnalysisRunSpec{
+				Metrics: []v1alpha1.Metric{
+					{Name: "latency", Provider: v1alpha1.MetricProvider{Job: &v1alpha1.JobMetric{}}},
+					{Name: "success-rate", Provider: v1alpha1.MetricProvider{Job: &v1alpha1.JobMetric{}}},
+					{Name: "saturation", Provider: v1alpha1.MetricProvider{Job: &v1alpha1.JobMetric{}}},
+					{Name: "error-rate", Provider: v1alpha1.MetricProvider{Job: &v1alpha1.JobMetric{}}},
+				},
+				Scoring: &v1alpha1.AnalysisScoring{
+					Weights: []v1alpha1.MetricWeight{
+						{MetricName: "success-rate", Weight: 3},
+						{MetricName: "saturation", Weight: 0},
+					},
+					Threshold: v1alpha1.ScoreThreshold{Pass: 80, Marginal: 50},
+				},
+			},
+			Status: v1alpha1.AnalysisRunStatus{
+				Phase: v1alpha1.AnalysisPhaseRunning,
+				MetricResults: []v1alpha1.MetricResult{
+					{Name: "latency", Phase: v1alpha1.AnalysisPhaseFailed, Count: 4, Successful: 2, Failed: 2},
+					{Name: "success-rate", Phase: v1alpha1.AnalysisPhaseSuccessful, Count: 4, Successful: 4},
+					{Name: "saturation", Phase: v1alpha1.AnalysisPhaseFailed, Count: 4, Failed: 4},
+					{Name: "error-rate", Phase: v1alpha1.AnalysisPhaseFailed, Count: 4, Failed: 4},
+				},
+			},
+		}
+	}
+	dryRunMetricsMap := map[string]bool{"error-rate": true}
+	{
+		// (1 * 50 + 3 * 100) / 4
+		run := newRun()
+		status, message := c.assessRunStatus(run, run.Spec.Metrics, dryRunMetricsMap)
+		assert.Equal(t, v1alpha1.AnalysisPhaseSuccessful, status)
+		assert.Equal(t, "", message)
+		assert.Equal(t, ptr.To[int64](87), run.Status.Score)
+	}
+	{
+		run := newRun()
+		run.Spec.Scoring.Threshold.Pass = 90
+		status, message := c.assessRunStatus(run, run.Spec.Metrics, dryRunMetricsMap)
+		assert.Equal(t, v1alpha1.AnalysisPhaseInconclusive, status)
+		assert.Equal(t, "Score (87) < pass threshold (90)", message)
+	}
+	{
+		run := newRun()
+		run.Spec.Scoring.Threshold = v1alpha1.ScoreThreshold{Pass: 95, Marginal: 90}
+		status, message := c.assessRunStatus(run, run.Spec.Metrics, dryRunMetricsMap)
+		assert.Equal(t, v1alpha1.AnalysisPhaseFailed, status)
+		assert.Equal(t, "Score (87) < marginal threshold (90)", message)
+	}
+	{
+		// the run is not assessed until all metrics have completed
+		run := newRun()
+		run.Status.MetricResults[1].Phase = v1alpha1.AnalysisPhaseRunning
+		run.Status.MetricResults[1].Measurements = []v1alpha1.Measurement{{
+			Phase:     v1alpha1.AnalysisPhaseRunning,
+			StartedAt: timePtr(metav1.NewTime(time.Now().Add(-60 * time.Second))),
+		}}
+		assert.False(t, analysisutil.IsTerminating(run))
+		status, _ := c.assessRunStatus(run, run.Spec.Metrics, dryRunMetricsMap)
+		assert.Equal(t, v1alpha1.AnalysisPhaseRunning, status)
+		assert.Equal(t, ptr.To[int64](87), run.Status.Score)
+	}
+	{
+		// errors are not scored
+		run := newRun()
+		run.Status.MetricResults[1].Phase = v1alpha1.AnalysisPhaseError
+		run.Status.MetricResults[1].Message = "connection refused"
+		status, message := c.assessRunStatus(run, run.Spec.Metrics, dryRunMetricsMap)
+		assert.Equal(t, v1alpha1.AnalysisPhaseError, status)
+		assert.Equal(t, `Metric "success-rate" assessed Error: "Error Message: connection refused"`, message)
+	}
+}
+
 func TestAssessMetricStatusNoMeasurements(t *testing.T) {
 	// no measurements yet taken
 	metric := v1alpha1.Metric{
@@ -542,8 +822,8 @@ func TestAssessMetricStatusNoMeasurements(t *testing.T) {
 	result := v1alpha1.MetricResult{
 		Measurements: nil,
 	}
-	assert.Equal(t, v1alpha1.AnalysisPhasePending, assessMetricStatus(metric, result, false))
-	assert.Equal(t, v1alpha1.AnalysisPhaseSuccessful, assessMetricStatus(metric, result, true))
+	assert.Equal(t, v1alpha1.AnalysisPhasePending, analysisutil.AssessMetricStatus(metric, result, false))
+	assert.Equal(t, v1alpha1.AnalysisPhaseSuccessful, analysisutil.AssessMetricStatus(metric, result, true))
 }
 
 func TestAssessMetricStatusInFlightMeasurement(t *testing.T) {
@@ -566,8 +846,8 @@ func TestAssessMetricStatusInFlightMeasurement(t *testing.T) {
 			},
 		},
 	}
-	assert.Equal(t, v1alpha1.AnalysisPhaseRunning, assessMetricStatus(metric, result, false))
-	assert.Equal(t, v1alpha1.AnalysisPhaseRunning, assessMetricStatus(metric, result, true))
+	assert.Equal(t, v1alpha1.AnalysisPhaseRunning, analysisutil.AssessMetricStatus(metric, result, false))
+	assert.Equal(t, v1alpha1.AnalysisPhaseRunning, analysisutil.AssessMetricStatus(metric, result, true))
 }
 func TestAssessMetricStatusFailureLimit(t *testing.T) { // max failures
 	failureLimit := intstr.FromInt(2)
@@ -586,12 +866,12 @@ func TestAssessMetricStatusFailureLimit(t *testing.T) { // max failures
 			FinishedAt: timePtr(metav1.NewTime(time.Now().Add(-60 * time.Second))),
 		}},
 	}
-	assert.Equal(t, v1alpha1.AnalysisPhaseFailed, assessMetricStatus(metric, result, false))
-	assert.Equal(t, v1alpha1.AnalysisPhaseFailed, assessMetricStatus(metric, result, true))
+	assert.Equal(t, v1alpha1.AnalysisPhaseFailed, analysisutil.AssessMetricStatus(metric, result, false))
+	assert.Equal(t, v1alpha1.AnalysisPhaseFailed, analysisutil.AssessMetricStatus(metric, result, true))
 	newFailureLimit := intstr.FromInt(3)
 	metric.FailureLimit = &newFailureLimit
-	assert.Equal(t, v1alpha1.AnalysisPhaseRunning, assessMetricStatus(metric, result, false))
-	assert.Equal(t, v1alpha1.AnalysisPhaseSuccessful, assessMetricStatus(metric, result, true))
+	assert.Equal(t, v1alpha1.AnalysisPhaseRunning, analysisutil.AssessMetricStatus(metric, result, false))
+	assert.Equal(t, v1alpha1.AnalysisPhaseSuccessful, analysisutil.AssessMetricStatus(metric, result, true))
 }
 
 func TestAssessMetricStatusConsecutiveSuccessLimit(t *testing.T) {
@@ -620,14 +900,14 @@ func TestAssessMetricStatusConsecutiveSuccessLimit(t *testing.T) {
 	// For indefinite analysis (count is not set)
 
 	// When ConsecutiveSuccess == ConsecutiveSuccessLimit
-	assert.Equal(t, v1alpha1.AnalysisPhaseSuccessful, assessMetricStatus(metric, result, false))
-	assert.Equal(t, v1alpha1.AnalysisPhaseSuccessful, assessMetricStatus(metric, result, true))
+	assert.Equal(t, v1alpha1.AnalysisPhaseSuccessful, analysisutil.AssessMetricStatus(metric, result, false))
+	assert.Equal(t, v1alpha1.AnalysisPhaseSuccessful, analysisutil.AssessMetricStatus(metric, result, true))
 
 	// When ConsecutiveSuccess < ConsecutiveSuccessLimit
 	consecutiveSuccessLimit = intstr.FromInt(5)
 	metric.ConsecutiveSuccessLimit = &consecutiveSuccessLimit
-	assert.Equal(t, v1alpha1.AnalysisPhaseRunning, assessMetricStatus(metric, result, false))
-	assert.Equal(t, v1alpha1.AnalysisPhaseSuccessful, assessMetricStatus(metric, result, true))
+	assert.Equal(t, v1alpha1.AnalysisPhaseRunning, analysisutil.AssessMetricStatus(metric, result, false))
+	assert.Equal(t, v1alpha1.AnalysisPhaseSuccessful, analysisutil.AssessMetricStatus(metric, result, true))
 
 	/////////////////////////////////////////////////////////////////////////
 	// For limited analysis (count is >= 1)
@@ -637,28 +917,72 @@ func TestAssessMetricStatusConsecutiveSuccessLimit(t *testing.T) {
 	metric.Count = &metricCount
 
 	//// ConsecutiveSuccess=3 < ConsecutiveSuccessLimit=5
-	assert.Equal(t, v1alpha1.AnalysisPhaseFailed, assessMetricStatus(metric, result, false))
-	assert.Equal(t, v1alpha1.AnalysisPhaseFailed, assessMetricStatus(metric, result, true))
+	assert.Equal(t, v1alpha1.AnalysisPhaseFailed, analysisutil.AssessMetricStatus(metric, result, false))
+	assert.Equal(t, v1alpha1.AnalysisPhaseFailed, analysisutil.AssessMetricStatus(metric, result, true))
 
 	//// ConsecutiveSuccess = ConsecutiveSuccessLimit = 3
 	consecutiveSuccessLimit = intstr.FromInt(3)
 	metric.ConsecutiveSuccessLimit = &consecutiveSuccessLimit
-	assert.Equal(t, v1alpha1.AnalysisPhaseSuccessful, assessMetricStatus(metric, result, false))
-	assert.Equal(t, v1alpha1.AnalysisPhaseSuccessful, assessMetricStatus(metric, result, true))
+	assert.Equal(t, v1alpha1.AnalysisPhaseSuccessful, analysisutil.AssessMetricStatus(metric, result, false))
+	assert.Equal(t, v1alpha1.AnalysisPhaseSuccessful, analysisutil.AssessMetricStatus(metric, result, true))
 
 	/// When metric.Count is not reached
 	metricCount = intstr.FromInt(9)
 	metric.Count = &metricCount
 
 	//// ConsecutiveSuccess = ConsecutiveSuccessLimit = 3
-	assert.Equal(t, v1alpha1.AnalysisPhaseSuccessful, assessMetricStatus(metric, result, false))
-	assert.Equal(t, v1alpha1.AnalysisPhaseSuccessful, assessMetricStatus(metric, result, true))
+	assert.Equal(t, v1alpha1.AnalysisPhaseSuccessful, analysisutil.AssessMetricStatus(metric, result, false))
+	assert.Equal(t, v1alpha1.AnalysisPhaseSuccessful, analysisutil.AssessMetricStatus(metric, result, true))
 
 	//// ConsecutiveSuccess=3 < ConsecutiveSuccessLimit=5
 	consecutiveSuccessLimit = intstr.FromInt(5)
 	metric.ConsecutiveSuccessLimit = &consecutiveSuccessLimit
-	assert.Equal(t, v1alpha1.AnalysisPhaseRunning, assessMetricStatus(metric, result, false))
-	assert.Equal(t, v1alpha1.AnalysisPhaseSuccessful, assessMetricStatus(metric, result, true))
+	assert.Equal(t, v1alpha1.AnalysisPhaseRunning, analysisutil.AssessMetricStatus(metric, result, false))
+	assert.Equal(t, v1alpha1.AnalysisPhaseSuccessful, analysisutil.AssessMetricStatus(metric, result, true))
+}
+
+func TestAssessMetricStatusEarlySuccess(t *testing.T) {
+	measurement := func(value string) v1alpha1.Measurement {
+		return v1alpha1.Measurement{
+			Value:      value,
+			Phase:      v1alpha1.AnalysisPhaseSuccessful,
+			StartedAt:  timePtr(metav1.NewTime(time.Now().Add(-60 * time.Second))),
+			FinishedAt: timePtr(metav1.NewTime(time.Now().Add(-60 * time.Second))),
+		}
+	}
+	metric := v1alpha1.Metric{
+		Name:     "success-rate",
+		Interval: "60s",
+		Count:    ptr.To(intstr.FromInt32(10)),
+		EarlySuccess: &v1alpha1.EarlySuccess{
+			ConsecutiveSuccessLimit: 3,
+		},
+	}
+	result := v1alpha1.MetricResult{
+		Count:              2,
+		Successful:         2,
+		ConsecutiveSuccess: 2,
+		Measurements:       []v1alpha1.Measurement{measurement("[0.99]"), measurement("[0.99]")},
+	}
+	assert.Equal(t, v1alpha1.AnalysisPhaseRunning, analysisutil.AssessMetricStatus(metric, result, false))
+
+	result.Count, result.Successful, result.ConsecutiveSuccess = 3, 3, 3
+	result.Measurements = append(result.Measurements, measurement("[0.96]"))
+	assert.Equal(t, v1alpha1.AnalysisPhaseSuccessful, analysisutil.AssessMetricStatus(metric, result, false))
+
+	// the values of the last measurements must also be tight enough
+	metric.EarlySuccess.Condition = "all(latest(3), {#[0] >= 0.98})"
+	assert.Equal(t, v1alpha1.AnalysisPhaseRunning, analysisutil.AssessMetricStatus(metric, result, false))
+
+	for count := int32(4); count <= 5; count++ {
+		result.Count, result.Successful, result.ConsecutiveSuccess = count, count, count
+		result.Measurements = append(result.Measurements, measurement("[0.99]"))
+		assert.Equal(t, v1alpha1.AnalysisPhaseRunning, analysisutil.AssessMetricStatus(metric, result, false))
+	}
+
+	result.Count, result.Successful, result.ConsecutiveSuccess = 6, 6, 6
+	result.Measurements = append(result.Measurements, measurement("[0.99]"))
+	assert.Equal(t, v1alpha1.AnalysisPhaseSuccessful, analysisutil.AssessMetricStatus(metric, result, false))
 }
 
 func TestAssessMetricStatusFailureLimitAndConsecutiveSuccessLimit(t *testing.T) {
@@ -687,32 +1011,32 @@ func TestAssessMetricStatusFailureLimitAndConsecutiveSuccessLimit(t *testing.T)
 	// For indefinite analysis (count is not set)
 
 	// FailureLimit is not violated and consecutiveSuccessLimit not yet satisfied.
-	assert.Equal(t, v1alpha1.AnalysisPhaseRunning, assessMetricStatus(metric, result, false))
-	assert.Equal(t, v1alpha1.AnalysisPhaseSuccessful, assessMetricStatus(metric, result, true))
+	assert.Equal(t, v1alpha1.AnalysisPhaseRunning, analysisutil.AssessMetricStatus(metric, result, false))
+	assert.Equal(t, v1alpha1.AnalysisPhaseSuccessful, analysisutil.AssessMetricStatus(metric, result, true))
 
 	// FailureLimit is violated and consecutiveSuccessLimit is not yet satisfied.
 	result.Failed = 5
 	result.Successful = 9
 	result.Count = 9
 	result.ConsecutiveSuccess = 0
-	assert.Equal(t, v1alpha1.AnalysisPhaseFailed, assessMetricStatus(metric, result, false))
-	assert.Equal(t, v1alpha1.AnalysisPhaseFailed, assessMetricStatus(metric, result, true))
+	assert.Equal(t, v1alpha1.AnalysisPhaseFailed, analysisutil.AssessMetricStatus(metric, result, false))
+	assert.Equal(t, v1alpha1.AnalysisPhaseFailed, analysisutil.AssessMetricStatus(metric, result, true))
 
 	// FailureLimit is not violated and consecutiveSuccessLimit is satisfied.
 	result.Failed = 3
 	result.Successful = 5
 	result.Count = 8
 	result.ConsecutiveSuccess = 4
-	assert.Equal(t, v1alpha1.AnalysisPhaseSuccessful, assessMetricStatus(metric, result, false))
-	assert.Equal(t, v1alpha1.AnalysisPhaseSuccessful, assessMetricStatus(metric, result, true))
+	assert.Equal(t, v1alpha1.AnalysisPhaseSuccessful, analysisutil.AssessMetricStatus(metric, result, false))
+	assert.Equal(t, v1alpha1.AnalysisPhaseSuccessful, analysisutil.AssessMetricStatus(metric, result, true))
 
 	// FailureLimit is violated and consecutiveSuccessLimit is satisfied.
 	result.Failed = 5
 	result.Successful = 5
 	result.Count = 10
 	result.ConsecutiveSuccess = 4
-	assert.Equal(t, v1alpha1.AnalysisPhaseFailed, assessMetricStatus(metric, result, false))
-	assert.Equal(t, v1alpha1.AnalysisPhaseFailed, assessMetricStatus(metric, result, true))
+	assert.Equal(t, v1alpha1.AnalysisPhaseFailed, analysisutil.AssessMetricStatus(metric, result, false))
+	assert.Equal(t, v1alpha1.AnalysisPhaseFailed, analysisutil.AssessMetricStatus(metric, result, true))
 
 	/////////////////////////////////////////////////////////////////////////
 	// For limited analysis (count is >= 1)
@@ -727,8 +1051,8 @@ func TestAssessMetricStatusFailureLimitAndConsecutiveSuccessLimit(t *testing.T)
 	result.Count = 10
 	result.ConsecutiveSuccess = 3
 
-	assert.Equal(t, v1alpha1.AnalysisPhaseInconclusive, assessMetricStatus(metric, result, false))
-	assert.Equal(t, v1alpha1.AnalysisPhaseInconclusive, assessMetricStatus(metric, result, true))
+	assert.Equal(t, v1alpha1.AnalysisPhaseInconclusive, analysisutil.AssessMetricStatus(metric, result, false))
+	assert.Equal(t, v1alpha1.AnalysisPhaseInconclusive, analysisutil.AssessMetricStatus(metric, result, true))
 
 	//// FailureLimit is violated and consecutiveSuccessLimit is not yet satisfied.
 	result.Failed = 5
@@ -736,8 +1060,8 @@ func TestAssessMetricStatusFailureLimitAndConsecutiveSuccessLimit(t *testing.T)
 	result.Count = 10
 	result.ConsecutiveSuccess = 3
 
-	assert.Equal(t, v1alpha1.AnalysisPhaseFailed, assessMetricStatus(metric, result, false))
-	assert.Equal(t, v1alpha1.AnalysisPhaseFailed, assessMetricStatus(metric, result, true))
+	assert.Equal(t, v1alpha1.AnalysisPhaseFailed, analysisutil.AssessMetricStatus(metric, result, false))
+	assert.Equal(t, v1alpha1.AnalysisPhaseFailed, analysisutil.AssessMetricStatus(metric, result, true))
 
 	//// FailureLimit is not violated and consecutiveSuccessLimit is satisfied.
 	result.Failed = 4
@@ -745,8 +1069,8 @@ func TestAssessMetricStatusFailureLimitAndConsecutiveSuccessLimit(t *testing.T)
 	result.Count = 10
 	result.ConsecutiveSuccess = 4
 
-	assert.Equal(t, v1alpha1.AnalysisPhaseSuccessful, assessMetricStatus(metric, result, false))
-	assert.Equal(t, v1alpha1.AnalysisPhaseSuccessful, assessMetricStatus(metric, result, true))
+	assert.Equal(t, v1alpha1.AnalysisPhaseSuccessful, analysisutil.AssessMetricStatus(metric, result, false))
+	assert.Equal(t, v1alpha1.AnalysisPhaseSuccessful, analysisutil.AssessMetricStatus(metric, result, true))
 
 	//// FailureLimit is violated and consecutiveSuccessLimit is satisfied.
 	result.Failed = 5
@@ -754,8 +1078,8 @@ func TestAssessMetricStatusFailureLimitAndConsecutiveSuccessLimit(t *testing.T)
 	result.Count = 10
 	result.ConsecutiveSuccess = 4
 
-	assert.Equal(t, v1alpha1.AnalysisPhaseFailed, assessMetricStatus(metric, result, false))
-	assert.Equal(t, v1alpha1.AnalysisPhaseFailed, assessMetricStatus(metric, result, true))
+	assert.Equal(t, v1alpha1.AnalysisPhaseFailed, analysisutil.AssessMetricStatus(metric, result, false))
+	assert.Equal(t, v1alpha1.AnalysisPhaseFailed, analysisutil.AssessMetricStatus(metric, result, true))
 
 	/// When metric.Count is not yet reached
 
@@ -765,8 +1089,8 @@ func TestAssessMetricStatusFailureLimitAndConsecutiveSuccessLimit(t *testing.T)
 	result.Count = 8
 	result.ConsecutiveSuccess = 3
 
-	assert.Equal(t, v1alpha1.AnalysisPhaseRunning, assessMetricStatus(metric, result, false))
-	assert.Equal(t, v1alpha1.AnalysisPhaseSuccessful, assessMetricStatus(metric, result, true))
+	assert.Equal(t, v1alpha1.AnalysisPhaseRunning, analysisutil.AssessMetricStatus(metric, result, false))
+	assert.Equal(t, v1alpha1.AnalysisPhaseSuccessful, analysisutil.AssessMetricStatus(metric, result, true))
 
 	//// FailureLimit is violated and consecutiveSuccessLimit is not yet satisfied.
 	result.Failed = 5
@@ -774,8 +1098,8 @@ func TestAssessMetricStatusFailureLimitAndConsecutiveSuccessLimit(t *testing.T)
 	result.Count = 8
 	result.ConsecutiveSuccess = 3
 
-	assert.Equal(t, v1alpha1.AnalysisPhaseFailed, assessMetricStatus(metric, result, false))
-	assert.Equal(t, v1alpha1.AnalysisPhaseFailed, assessMetricStatus(metric, result, true))
+	assert.Equal(t, v1alpha1.AnalysisPhaseFailed, analysisutil.AssessMetricStatus(metric, result, false))
+	assert.Equal(t, v1alpha1.AnalysisPhaseFailed, analysisutil.AssessMetricStatus(metric, result, true))
 
 	//// FailureLimit is not violated and consecutiveSuccessLimit is satisfied.
 	result.Failed = 3
@@ -783,8 +1107,8 @@ func TestAssessMetricStatusFailureLimitAndConsecutiveSuccessLimit(t *testing.T)
 	result.Count = 8
 	result.ConsecutiveSuccess = 4
 
-	assert.Equal(t, v1alpha1.AnalysisPhaseSuccessful, assessMetricStatus(metric, result, false))
-	assert.Equal(t, v1alpha1.AnalysisPhaseSuccessful, assessMetricStatus(metric, result, true))
+	assert.Equal(t, v1alpha1.AnalysisPhaseSuccessful, analysisutil.AssessMetricStatus(metric, result, false))
+	assert.Equal(t, v1alpha1.AnalysisPhaseSuccessful, analysisutil.AssessMetricStatus(metric, result, true))
 
 	//// FailureLimit is violated and consecutiveSuccessLimit is satisfied.
 	result.Failed = 5
@@ -792,8 +1116,8 @@ func TestAssessMetricStatusFailureLimitAndConsecutiveSuccessLimit(t *testing.T)
 	result.Count = 9
 	result.ConsecutiveSuccess = 4
 
-	assert.Equal(t, v1alpha1.AnalysisPhaseFailed, assessMetricStatus(metric, result, false))
-	assert.Equal(t, v1alpha1.AnalysisPhaseFailed, assessMetricStatus(metric, result, true))
+	assert.Equal(t, v1alpha1.AnalysisPhaseFailed, analysisutil.AssessMetricStatus(metric, result, false))
+	assert.Equal(t, v1alpha1.AnalysisPhaseFailed, analysisutil.AssessMetricStatus(metric, result, true))
 }
 
 func TestAssessMetricStatusInconclusiveLimit(t *testing.T) {
@@ -813,12 +1137,12 @@ func TestAssessMetricStatusInconclusiveLimit(t *testing.T) {
 			FinishedAt: timePtr(metav1.NewTime(time.Now().Add(-60 * time.Second))),
 		}},
 	}
-	assert.Equal(t, v1alpha1.AnalysisPhaseInconclusive, assessMetricStatus(metric, result, false))
-	assert.Equal(t, v1alpha1.AnalysisPhaseInconclusive, assessMetricStatus(metric, result, true))
+	assert.Equal(t, v1alpha1.AnalysisPhaseInconclusive, analysisutil.AssessMetricStatus(metric, result, false))
+	assert.Equal(t, v1alpha1.AnalysisPhaseInconclusive, analysisutil.AssessMetricStatus(metric, result, true))
 	newInconclusiveLimit := intstr.FromInt(3)
 	metric.InconclusiveLimit = &newInconclusiveLimit
-	assert.Equal(t, v1alpha1.AnalysisPhaseRunning, assessMetricStatus(metric, result, false))
-	assert.Equal(t, v1alpha1.AnalysisPhaseSuccessful, assessMetricStatus(metric, result, true))
+	assert.Equal(t, v1alpha1.AnalysisPhaseRunning, analysisutil.AssessMetricStatus(metric, result, false))
+	assert.Equal(t, v1alpha1.AnalysisPhaseSuccessful, analysisutil.AssessMetricStatus(metric, result, true))
 }
 
 func TestAssessMetricStatusConsecutiveErrors(t *testing.T) {
@@ -835,11 +1159,11 @@ func TestAssessMetricStatusConsecutiveErrors(t *testing.T) {
 			FinishedAt: timePtr(metav1.NewTime(time.Now().Add(-60 * time.Second))),
 		}},
 	}
-	assert.Equal(t, v1alpha1.AnalysisPhaseError, assessMetricStatus(metric, result, false))
-	assert.Equal(t, v1alpha1.AnalysisPhaseError, assessMetricStatus(metric, result, true))
+	assert.Equal(t, v1alpha1.AnalysisPhaseError, analysisutil.AssessMetricStatus(metric, result, false))
+	assert.Equal(t, v1alpha1.AnalysisPhaseError, analysisutil.AssessMetricStatus(metric, result, true))
 	result.ConsecutiveError = 4
-	assert.Equal(t, v1alpha1.AnalysisPhaseSuccessful, assessMetricStatus(metric, result, true))
-	assert.Equal(t, v1alpha1.AnalysisPhaseRunning, assessMetricStatus(metric, result, false))
+	assert.Equal(t, v1alpha1.AnalysisPhaseSuccessful, analysisutil.AssessMetricStatus(metric, result, true))
+	assert.Equal(t, v1alpha1.AnalysisPhaseRunning, analysisutil.AssessMetricStatus(metric, result, false))
 }
 
 func TestAssessMetricStatusCountReached(t *testing.T) {
@@ -858,10 +1182,10 @@ func TestAssessMetricStatusCountReached(t *testing.T) {
 			FinishedAt: timePtr(metav1.NewTime(time.Now().Add(-60 * time.Second))),
 		}},
 	}
-	assert.Equal(t, v1alpha1.AnalysisPhaseSuccessful, assessMetricStatus(metric, result, false))
+	assert.Equal(t, v1alpha1.AnalysisPhaseSuccessful, analysisutil.AssessMetricStatus(metric, result, false))
 	result.Successful = 5
 	result.Inconclusive = 5
-	assert.Equal(t, v1alpha1.AnalysisPhaseInconclusive, assessMetricStatus(metric, result, false))
+	assert.Equal(t, v1alpha1.AnalysisPhaseInconclusive, analysisutil.AssessMetricStatus(metric, result, false))
 }
 
 func TestCalculateNextReconcileTimeInterval(t *testing.T) {
@@ -1087,6 +1411,71 @@ func TestCalculateNextReconcileUponError(t *testing.T) {
 	}
 }
 
+func TestCalculateNextReconcileTimeDependsOn(t *testing.T) {
+	now := metav1.Now()
+	run := &v1alpha1.AnalysisRun{
+		Spec: v1alpha1.AnalysisRunSpec{
+			Metrics: []v1alpha1.Metric{
+				{
+					Name: "smoke-test",
+				},
+				{
+					Name:      "success-rate",
+					DependsOn: []string{"smoke-test"},
+				},
+			},
+		},
+		Status: v1alpha1.AnalysisRunStatus{
+			Phase: v1alpha1.AnalysisPhaseRunning,
+			MetricResults: []v1alpha1.MetricResult{{
+				Name:  "smoke-test",
+				Phase: v1alpha1.AnalysisPhaseRunning,
+				Measurements: []v1alpha1.Measurement{{
+					Phase:     v1alpha1.AnalysisPhaseRunning,
+					StartedAt: &now,
+				}},
+			}},
+		},
+	}
+	// ensure a blocked metric is not factored into the enqueue time
+	assert.Nil(t, calculateNextReconcileTime(run, run.Spec.Metrics))
+
+	// ensure we requeue right away when the dependency completed
+	run.Status.MetricResults[0].Phase = v1alpha1.AnalysisPhaseSuccessful
+	reconcileTime := calculateNextReconcileTime(run, run.Spec.Metrics)
+	assert.NotNil(t, reconcileTime)
+	assert.False(t, reconcileTime.After(time.Now()))
+}
+
+func TestReconcileAnalysisRunProviderPanic(t *testing.T) {
+	f := newFixture(t)
+	defer f.Close()
+	c, _, _ := f.newController(noResyncPeriodFunc)
+	run := &v1alpha1.AnalysisRun{
+		Spec: v1alpha1.AnalysisRunSpec{
+			Metrics: []v1alpha1.Metric{{
+				Name:     "success-rate",
+				Interval: "60s",
+				Provider: v1alpha1.MetricProvider{
+					Prometheus: &v1alpha1.PrometheusMetric{},
+				},
+			}},
+		},
+	}
+	f.provider.On("Run", mock.Anything, mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+		panic("bad big bug :(")
+	})
+	f.provider.On("GetMetadata", mock.Anything, mock.Anything).Return(map[string]string{}, nil)
+
+	newRun := c.reconcileAnalysisRun(run)
+	assert.Equal(t, 1, len(newRun.Status.MetricResults[0].Measurements))
+	measurement := newRun.Status.MetricResults[0].Measurements[0]
+	assert.Equal(t, v1alpha1.AnalysisPhaseError, measurement.Phase)
+	assert.Equal(t, "Recovered from panic: bad big bug :(", measurement.Message)
+	assert.NotNil(t, measurement.StartedAt)
+	assert.NotNil(t, measurement.FinishedAt)
+}
+
 func TestReconcileAnalysisRunInitial(t *testing.T) {
 	f := newFixture(t)
 	defer f.Close()
@@ -1149,6 +1538,55 @@ func TestReconcileAnalysisRunInvalid(t *testing.T) {
 	assert.Equal(t, v1alpha1.AnalysisPhaseError, newRun.Status.Phase)
 }
 
+func TestReconcileAnalysisRunInvalidScoring(t *testing.T) {
+	f := newFixture(t)
+	defer f.Close()
+	c, _, _ := f.newController(noResyncPeriodFunc)
+	run := &v1alpha1.AnalysisRun{
+		Spec: v1alpha1.AnalysisRunSpec{
+			Metrics: []v1alpha1.Metric{{
+				Name: "success-rate",
+				Provider: v1alpha1.MetricProvider{
+					Web: &v1alpha1.WebMetric{},
+				},
+			}},
+			Scoring: &v1alpha1.AnalysisScoring{
+				Weights:   []v1alpha1.MetricWeight{{MetricName: "latency", Weight: 2}},
+				Threshold: v1alpha1.ScoreThreshold{Pass: 90, Marginal: 60},
+			},
+		},
+	}
+	newRun := c.reconcileAnalysisRun(run)
+	assert.Equal(t, v1alpha1.AnalysisPhaseError, newRun.Status.Phase)
+	assert.Equal(t, "Analysis spec invalid: scoring.weights[0]: metric 'latency' does not exist", newRun.Status.Message)
+}
+
+func TestReconcileAnalysisRunTemplatedSQLQuery(t *testing.T) {
+	f := newFixture(t)
+	defer f.Close()
+	c, _, _ := f.newController(noResyncPeriodFunc)
+	run := &v1alpha1.AnalysisRun{
+		Spec: v1alpha1.AnalysisRunSpec{
+			Args: []v1alpha1.Argument{{
+				Name:  "version",
+				Value: ptr.To[string]("v2' OR '1'='1"),
+			}},
+			Metrics: []v1alpha1.Metric{{
+				Name: "conversion-rate",
+				Provider: v1alpha1.MetricProvider{
+					SQL: &v1alpha1.SQLMetric{
+						Query: "SELECT rate FROM kpis WHERE version = '{{args.version}}'",
+					},
+				},
+			}},
+		},
+	}
+	newRun := c.reconcileAnalysisRun(run)
+	assert.Equal(t, v1alpha1.AnalysisPhaseError, newRun.Status.Phase)
+	assert.Equal(t, "Analysis spec invalid: metrics[0]: sql query must not contain templates, use sql args to bind values to the query", newRun.Status.Message)
+	assert.Empty(t, newRun.Status.MetricResults)
+}
+
 // TestReconcileAnalysisRunTerminateSiblingAfterFail verifies we terminate a metric when we assess
 // a sibling has already Failed
 func TestReconcileAnalysisRunTerminateSiblingAfterFail(t *testing.T) {
@@ -1701,6 +2139,51 @@ func TestKeyNotInSecret(t *testing.T) {
 	assert.Equal(t, "key 'key-name' does not exist in secret 'secret-name'", err.Error())
 }
 
+func TestConfigMapResolution(t *testing.T) {
+	f := newFixture(t)
+	defer f.Close()
+	c, _, _ := f.newController(noResyncPeriodFunc)
+	configMap := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "slo",
+			Namespace: metav1.NamespaceDefault,
+		},
+		Data: map[string]string{
+			"success-rate": "0.95",
+		},
+	}
+	f.kubeclient.CoreV1().ConfigMaps(metav1.NamespaceDefault).Create(context.TODO(), configMap, metav1.CreateOptions{})
+
+	args := []v1alpha1.Argument{{
+		Name: "success-rate",
+		ValueFrom: &v1alpha1.ValueFrom{
+			ConfigMapKeyRef: &v1alpha1.ConfigMapKeyRef{
+				Name: "slo",
+				Key:  "success-rate",
+			},
+		},
+	}}
+	tasks := []metricTask{{
+		metric: v1alpha1.Metric{
+			Name:             "metric-name",
+			SuccessCondition: "result >= {{args.success-rate}}",
+		},
+	}}
+	tasks, secrets, err := c.resolveArgs(tasks, args, metav1.NamespaceDefault)
+	assert.NoError(t, err)
+	assert.Equal(t, "result >= 0.95", tasks[0].metric.SuccessCondition)
+	// config map values are not redacted
+	assert.Len(t, secrets, 0)
+
+	args[0].ValueFrom.ConfigMapKeyRef.Key = "latency"
+	_, _, err = c.resolveArgs(tasks, args, metav1.NamespaceDefault)
+	assert.EqualError(t, err, "key 'latency' does not exist in config map 'slo'")
+
+	args[0].ValueFrom.ConfigMapKeyRef.Name = "missing"
+	_, _, err = c.resolveArgs(tasks, args, metav1.NamespaceDefault)
+	assert.True(t, k8serrors.IsNotFound(err))
+}
+
 func TestSecretResolution(t *testing.T) {
 	f := newFixture(t)
 	secretName, secretKey, secretData := "web-metric-secret", "apikey", "12345"
@@ -1749,11 +2232,11 @@ func TestAssessMetricFailureInconclusiveOrError(t *testing.T) {
 			Phase: v1alpha1.AnalysisPhaseFailed,
 		}},
 	}
-	phase, msg := assessMetricFailureInconclusiveOrError(metric, result)
+	phase, msg := analysisutil.AssessMetricFailureInconclusiveOrError(metric, result)
 	expectedMsg := fmt.Sprintf("failed (%d) > failureLimit (%d)", result.Failed, 0)
 	assert.Equal(t, v1alpha1.AnalysisPhaseFailed, phase)
 	assert.Equal(t, expectedMsg, msg)
-	assert.Equal(t, phase, assessMetricStatus(metric, result, true))
+	assert.Equal(t, phase, analysisutil.AssessMetricStatus(metric, result, true))
 
 	result = v1alpha1.MetricResult{
 		Inconclusive: 1,
@@ -1761,11 +2244,11 @@ func TestAssessMetricFailureInconclusiveOrError(t *testing.T) {
 			Phase: v1alpha1.AnalysisPhaseInconclusive,
 		}},
 	}
-	phase, msg = assessMetricFailureInconclusiveOrError(metric, result)
+	phase, msg = analysisutil.AssessMetricFailureInconclusiveOrError(metric, result)
 	expectedMsg = fmt.Sprintf("inconclusive (%d) > inconclusiveLimit (%d)", result.Inconclusive, 0)
 	assert.Equal(t, v1alpha1.AnalysisPhaseInconclusive, phase)
 	assert.Equal(t, expectedMsg, msg)
-	assert.Equal(t, phase, assessMetricStatus(metric, result, true))
+	assert.Equal(t, phase, analysisutil.AssessMetricStatus(metric, result, true))
 
 	result = v1alpha1.MetricResult{
 		ConsecutiveError: 5, //default ConsecutiveErrorLimit for Metrics is 4
@@ -1773,11 +2256,11 @@ func TestAssessMetricFailureInconclusiveOrError(t *testing.T) {
 			Phase: v1alpha1.AnalysisPhaseError,
 		}},
 	}
-	phase, msg = assessMetricFailureInconclusiveOrError(metric, result)
+	phase, msg = analysisutil.AssessMetricFailureInconclusiveOrError(metric, result)
 	expectedMsg = fmt.Sprintf("consecutiveErrors (%d) > consecutiveErrorLimit (%d)", result.ConsecutiveError, defaults.DefaultConsecutiveErrorLimit)
 	assert.Equal(t, v1alpha1.AnalysisPhaseError, phase)
 	assert.Equal(t, expectedMsg, msg)
-	assert.Equal(t, phase, assessMetricStatus(metric, result, true))
+	assert.Equal(t, phase, analysisutil.AssessMetricStatus(metric, result, true))
 }
 
 func StartAssessRunStatusErrorMessageAnalysisPhaseFail(t *testing.T, isDryRun bool) (v1alpha1.AnalysisPhase, string, *v1alpha1.RunSummary) {
@@ -9,6 +9,7 @@ import (
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
 
+	"github.com/argoproj/argo-rollouts/analysis/history"
 	"github.com/argoproj/argo-rollouts/metric"
 	jobProvider "github.com/argoproj/argo-rollouts/metricproviders/job"
 
@@ -26,12 +27,14 @@ import (
 
 	"github.com/argoproj/argo-rollouts/controller/metrics"
 	"github.com/argoproj/argo-rollouts/metricproviders"
+	"github.com/argoproj/argo-rollouts/metricproviders/querycache"
 	register "github.com/argoproj/argo-rollouts/pkg/apis/rollouts"
 	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
 	clientset "github.com/argoproj/argo-rollouts/pkg/client/clientset/versioned"
 	informers "github.com/argoproj/argo-rollouts/pkg/client/informers/externalversions/rollouts/v1alpha1"
 	listers "github.com/argoproj/argo-rollouts/pkg/client/listers/rollouts/v1alpha1"
 	controllerutil "github.com/argoproj/argo-rollouts/utils/controller"
+	"github.com/argoproj/argo-rollouts/utils/defaults"
 	logutil "github.com/argoproj/argo-rollouts/utils/log"
 	"github.com/argoproj/argo-rollouts/utils/record"
 	timeutil "github.com/argoproj/argo-rollouts/utils/time"
@@ -74,6 +77,8 @@ type Controller struct {
 	// Kubernetes API.
 	recorder     record.EventRecorder
 	resyncPeriod time.Duration
+	// historySink receives the results of completed AnalysisRuns, when analysis history is enabled
+	historySink history.Sink
 }
 
 // ControllerConfig describes the data required to instantiate a new analysis controller
@@ -87,6 +92,10 @@ type ControllerConfig struct {
 	AnalysisRunWorkQueue workqueue.RateLimitingInterface
 	MetricsServer        *metrics.MetricsServer
 	Recorder             record.EventRecorder
+	// MetricProviderConfigInformer is used to resolve the MetricProviderConfigs referenced by metrics
+	MetricProviderConfigInformer informers.MetricProviderConfigInformer
+	// HistorySink optionally exports the results of completed AnalysisRuns
+	HistorySink history.Sink
 }
 
 // NewController returns a new analysis controller
@@ -103,6 +112,7 @@ func NewController(cfg ControllerConfig) *Controller {
 		analysisRunSynced:    cfg.AnalysisRunInformer.Informer().HasSynced,
 		recorder:             cfg.Recorder,
 		resyncPeriod:         cfg.ResyncPeriod,
+		historySink:          cfg.HistorySink,
 	}
 
 	controller.enqueueAnalysis = func(obj any) {
@@ -113,9 +123,11 @@ func NewController(cfg ControllerConfig) *Controller {
 	}
 
 	providerFactory := metricproviders.ProviderFactory{
-		KubeClient:    controller.kubeclientset,
-		JobLister:     cfg.JobInformer.Lister(),
-		JobPodsLister: cfg.JobPodsInformer.Lister(),
+		KubeClient:                 controller.kubeclientset,
+		JobLister:                  cfg.JobInformer.Lister(),
+		JobPodsLister:              cfg.JobPodsInformer.Lister(),
+		MetricProviderConfigLister: cfg.MetricProviderConfigInformer.Lister(),
+		QueryCache:                 querycache.NewCache(defaults.GetMetricQueryCacheTTL(), defaults.GetMetricQueryQPS(), defaults.GetMetricQueryBurst()),
 	}
 	controller.newProvider = providerFactory.NewProvider
 
@@ -198,7 +210,13 @@ func (c *Controller) syncHandler(ctx context.Context, key string) error {
 	}
 
 	newRun := c.reconcileAnalysisRun(run)
-	return c.persistAnalysisRunStatus(run, newRun.Status)
+	if err := c.persistAnalysisRunStatus(run, newRun.Status); err != nil {
+		return err
+	}
+	if newRun.Status.Phase.Completed() && (!run.Status.Phase.Completed() || c.exportPending(run)) {
+		c.exportAnalysisRun(newRun)
+	}
+	return nil
 }
 
 func (c *Controller) jobParentReference(obj any) (*v1.OwnerReference, string) {
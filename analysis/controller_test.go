@@ -9,19 +9,23 @@ import (
 	"testing"
 	"time"
 
+	"github.com/argoproj/argo-rollouts/analysis/history"
 	"github.com/argoproj/argo-rollouts/metric"
 
 	timeutil "github.com/argoproj/argo-rollouts/utils/time"
 
 	"github.com/argoproj/argo-rollouts/utils/queue"
 
+	"github.com/aws/smithy-go/ptr"
 	log "github.com/sirupsen/logrus"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 	"k8s.io/apimachinery/pkg/api/equality"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/intstr"
 	kubeinformers "k8s.io/client-go/informers"
 	k8sfake "k8s.io/client-go/kubernetes/fake"
 	core "k8s.io/client-go/testing"
@@ -33,6 +37,7 @@ import (
 	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
 	"github.com/argoproj/argo-rollouts/pkg/client/clientset/versioned/fake"
 	informers "github.com/argoproj/argo-rollouts/pkg/client/informers/externalversions"
+	"github.com/argoproj/argo-rollouts/utils/annotations"
 	"github.com/argoproj/argo-rollouts/utils/record"
 )
 
@@ -111,15 +116,16 @@ func (f *fixture) newController(resync resyncFunc) (*Controller, informers.Share
 	})
 
 	c := NewController(ControllerConfig{
-		KubeClientSet:        f.kubeclient,
-		ArgoProjClientset:    f.client,
-		AnalysisRunInformer:  i.Argoproj().V1alpha1().AnalysisRuns(),
-		JobInformer:          k8sI.Batch().V1().Jobs(),
-		JobPodsInformer:      k8sI.Core().V1().Pods(),
-		ResyncPeriod:         resync(),
-		AnalysisRunWorkQueue: analysisRunWorkqueue,
-		MetricsServer:        metricsServer,
-		Recorder:             record.NewFakeEventRecorder(),
+		KubeClientSet:                f.kubeclient,
+		ArgoProjClientset:            f.client,
+		AnalysisRunInformer:          i.Argoproj().V1alpha1().AnalysisRuns(),
+		JobInformer:                  k8sI.Batch().V1().Jobs(),
+		JobPodsInformer:              k8sI.Core().V1().Pods(),
+		ResyncPeriod:                 resync(),
+		AnalysisRunWorkQueue:         analysisRunWorkqueue,
+		MetricsServer:                metricsServer,
+		Recorder:                     record.NewFakeEventRecorder(),
+		MetricProviderConfigInformer: i.Argoproj().V1alpha1().MetricProviderConfigs(),
 	})
 
 	c.enqueueAnalysis = func(obj any) {
@@ -244,6 +250,8 @@ func filterInformerActions(actions []core.Action) []core.Action {
 	for _, action := range actions {
 		if action.Matches("list", "analysisruns") ||
 			action.Matches("watch", "analysisruns") ||
+			action.Matches("list", "metricproviderconfigs") ||
+			action.Matches("watch", "metricproviderconfigs") ||
 			action.Matches("list", "rollouts") ||
 			action.Matches("watch", "rollouts") {
 			continue
@@ -400,3 +408,163 @@ func TestRun(t *testing.T) {
 	}()
 	c.Run(ctx, 1)
 }
+
+func TestExportCompletedAnalysisRun(t *testing.T) {
+	one := intstr.FromInt32(1)
+	newOwnedRun := func(name string, owner string) *v1alpha1.AnalysisRun {
+		ar := &v1alpha1.AnalysisRun{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:        name,
+				Namespace:   metav1.NamespaceDefault,
+				UID:         types.UID(name + "-uid"),
+				Annotations: map[string]string{annotations.RevisionAnnotation: "3"},
+			},
+			Spec: v1alpha1.AnalysisRunSpec{
+				Args: []v1alpha1.Argument{{Name: "service", Value: ptr.String("checkout")}},
+				Metrics: []v1alpha1.Metric{{
+					Name:     "success-rate",
+					Count:    &one,
+					Provider: v1alpha1.MetricProvider{Job: &v1alpha1.JobMetric{}},
+				}},
+			},
+		}
+		if owner != "" {
+			ar.OwnerReferences = []metav1.OwnerReference{*metav1.NewControllerRef(&v1alpha1.Rollout{ObjectMeta: metav1.ObjectMeta{Name: owner}}, v1alpha1.SchemeGroupVersion.WithKind("Rollout"))}
+		}
+		return ar
+	}
+
+	t.Run("run owned by a rollout is exported on completion", func(t *testing.T) {
+		f := newFixture(t)
+		defer f.Close()
+		ar := newOwnedRun("foo", "checkout")
+		f.analysisRunLister = append(f.analysisRunLister, ar)
+		f.objects = append(f.objects, ar)
+
+		c, i, k8sI := f.newController(noResyncPeriodFunc)
+		sink, err := history.NewDirSink(t.TempDir())
+		assert.NoError(t, err)
+		c.historySink = sink
+		f.provider.On("Run", mock.Anything, mock.Anything, mock.Anything).Return(newMeasurement(v1alpha1.AnalysisPhaseSuccessful), nil)
+		f.provider.On("GetMetadata", mock.Anything, mock.Anything).Return(map[string]string{}, nil)
+
+		f.expectPatchAnalysisRunAction(ar)
+		f.runController(getKey(ar, t), true, false, c, i, k8sI)
+
+		records, err := sink.List(metav1.NamespaceDefault, "checkout")
+		assert.NoError(t, err)
+		assert.Len(t, records, 1)
+		assert.Equal(t, "foo", records[0].Name)
+		assert.Equal(t, "3", records[0].Revision)
+		assert.Equal(t, v1alpha1.AnalysisPhaseSuccessful, records[0].Phase)
+		assert.Equal(t, "checkout", *records[0].Args[0].Value)
+		assert.Len(t, records[0].MetricResults, 1)
+		assert.Equal(t, "100", records[0].MetricResults[0].Measurements[0].Value)
+	})
+
+	t.Run("run not owned by a rollout is not exported", func(t *testing.T) {
+		f := newFixture(t)
+		defer f.Close()
+		ar := newOwnedRun("foo", "")
+		f.analysisRunLister = append(f.analysisRunLister, ar)
+		f.objects = append(f.objects, ar)
+
+		c, i, k8sI := f.newController(noResyncPeriodFunc)
+		sink := &recordingSink{}
+		c.historySink = sink
+		f.provider.On("Run", mock.Anything, mock.Anything, mock.Anything).Return(newMeasurement(v1alpha1.AnalysisPhaseSuccessful), nil)
+		f.provider.On("GetMetadata", mock.Anything, mock.Anything).Return(map[string]string{}, nil)
+
+		f.expectPatchAnalysisRunAction(ar)
+		f.runController(getKey(ar, t), true, false, c, i, k8sI)
+		assert.Empty(t, sink.records)
+	})
+
+	t.Run("completed run is not exported again", func(t *testing.T) {
+		f := newFixture(t)
+		defer f.Close()
+		ar := newOwnedRun("foo", "checkout")
+		ar.Status.Phase = v1alpha1.AnalysisPhaseSuccessful
+		f.analysisRunLister = append(f.analysisRunLister, ar)
+		f.objects = append(f.objects, ar)
+
+		c, i, k8sI := f.newController(noResyncPeriodFunc)
+		sink := &recordingSink{}
+		c.historySink = sink
+		f.runController(getKey(ar, t), true, false, c, i, k8sI)
+		assert.Empty(t, sink.records)
+	})
+
+	t.Run("export failure does not fail the reconciliation", func(t *testing.T) {
+		f := newFixture(t)
+		defer f.Close()
+		ar := newOwnedRun("foo", "checkout")
+		f.analysisRunLister = append(f.analysisRunLister, ar)
+		f.objects = append(f.objects, ar)
+
+		c, i, k8sI := f.newController(noResyncPeriodFunc)
+		c.historySink = &recordingSink{err: fmt.Errorf("disk full")}
+		f.provider.On("Run", mock.Anything, mock.Anything, mock.Anything).Return(newMeasurement(v1alpha1.AnalysisPhaseSuccessful), nil)
+		f.provider.On("GetMetadata", mock.Anything, mock.Anything).Return(map[string]string{}, nil)
+
+		pi := f.expectPatchAnalysisRunAction(ar)
+		annotationPatch := f.expectPatchAnalysisRunAction(ar)
+		f.runController(getKey(ar, t), true, false, c, i, k8sI)
+		assert.Equal(t, v1alpha1.AnalysisPhaseSuccessful, f.getPatchedAnalysisRun(pi).Status.Phase)
+		// the run is marked so that the export is retried
+		assert.Equal(t, "true", f.getPatchedAnalysisRun(annotationPatch).Annotations[history.ExportPendingAnnotation])
+	})
+
+	t.Run("pending export is retried", func(t *testing.T) {
+		f := newFixture(t)
+		defer f.Close()
+		ar := newOwnedRun("foo", "checkout")
+		ar.Status.Phase = v1alpha1.AnalysisPhaseSuccessful
+		ar.Annotations[history.ExportPendingAnnotation] = "true"
+		f.analysisRunLister = append(f.analysisRunLister, ar)
+		f.objects = append(f.objects, ar)
+
+		c, i, k8sI := f.newController(noResyncPeriodFunc)
+		sink := &recordingSink{}
+		c.historySink = sink
+		pi := f.expectPatchAnalysisRunAction(ar)
+		f.runController(getKey(ar, t), true, false, c, i, k8sI)
+		assert.Len(t, sink.records, 1)
+		action := filterInformerActions(f.client.Actions())[pi].(core.PatchAction)
+		assert.JSONEq(t, `{"metadata":{"annotations":{"argo-rollouts.argoproj.io/analysis-history-export-pending":null}}}`, string(action.GetPatch()))
+	})
+
+	t.Run("run pending export is not garbage collected", func(t *testing.T) {
+		f := newFixture(t)
+		defer f.Close()
+		ar := newOwnedRun("foo", "checkout")
+		ar.Status.Phase = v1alpha1.AnalysisPhaseSuccessful
+		ar.Status.CompletedAt = &metav1.Time{Time: time.Now().Add(-time.Hour)}
+		ar.Spec.TTLStrategy = &v1alpha1.TTLStrategy{SecondsAfterCompletion: ptr.Int32(60)}
+		ar.Annotations[history.ExportPendingAnnotation] = "true"
+		f.analysisRunLister = append(f.analysisRunLister, ar)
+		f.objects = append(f.objects, ar)
+
+		c, i, k8sI := f.newController(noResyncPeriodFunc)
+		c.historySink = &recordingSink{err: fmt.Errorf("disk full")}
+		f.runController(getKey(ar, t), true, false, c, i, k8sI)
+	})
+}
+
+// recordingSink is an analysis history sink keeping written records in memory
+type recordingSink struct {
+	records []history.Record
+	err     error
+}
+
+func (s *recordingSink) Write(record history.Record) error {
+	if s.err != nil {
+		return s.err
+	}
+	s.records = append(s.records, record)
+	return nil
+}
+
+func (s *recordingSink) List(namespace, rollout string) ([]history.Record, error) {
+	return s.records, s.err
+}
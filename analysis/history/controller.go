@@ -0,0 +1,46 @@
+package history
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+const controllerTimeout = 30 * time.Second
+
+// controllerLister lists the records served by the metrics server of a controller exporting the history
+type controllerLister struct {
+	baseURL string
+	client  *http.Client
+}
+
+// NewControllerLister returns a lister reading the history served by the controller at baseURL, the
+// address of its metrics server, e.g. http://argo-rollouts-metrics.argo-rollouts:8090
+func NewControllerLister(baseURL string) (Lister, error) {
+	if _, err := url.Parse(baseURL); err != nil {
+		return nil, fmt.Errorf("invalid analysis history controller url '%s': %w", baseURL, err)
+	}
+	return &controllerLister{
+		baseURL: strings.TrimSuffix(baseURL, "/"),
+		client:  &http.Client{Timeout: controllerTimeout},
+	}, nil
+}
+
+func (l *controllerLister) List(namespace, rollout string) ([]Record, error) {
+	resp, err := l.client.Get(l.baseURL + ControllerPath + url.PathEscape(namespace) + "/" + url.PathEscape(rollout))
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("controller responded with %s", resp.Status)
+	}
+	var response Response
+	if err := json.NewDecoder(resp.Body).Decode(&response); err != nil {
+		return nil, fmt.Errorf("failed to decode analysis history: %w", err)
+	}
+	return response.Items, nil
+}
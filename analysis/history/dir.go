@@ -0,0 +1,86 @@
+package history
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// dirSink stores every record as its own JSON object, keyed <namespace>/<rollout>/<uid>.json below a
+// root directory. The layout maps one to one to an object store bucket, so the directory can be a
+// mounted bucket or be synced to one.
+type dirSink struct {
+	root string
+}
+
+// NewDirSink returns a sink storing records below the directory root, creating it when missing
+func NewDirSink(root string) (Sink, error) {
+	if root == "" {
+		return nil, fmt.Errorf("dir analysis history sink requires a path")
+	}
+	if err := os.MkdirAll(root, 0o755); err != nil {
+		return nil, err
+	}
+	return &dirSink{root: root}, nil
+}
+
+func (s *dirSink) Write(record Record) error {
+	data, err := json.Marshal(record)
+	if err != nil {
+		return err
+	}
+	dir := filepath.Join(s.root, record.Namespace, record.Rollout)
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return err
+	}
+	// write to a temporary object first so readers never observe a partial record
+	tmp, err := os.CreateTemp(dir, ".tmp-")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), filepath.Join(dir, string(record.UID)+".json"))
+}
+
+func (s *dirSink) List(namespace, rollout string) ([]Record, error) {
+	for _, name := range []string{namespace, rollout} {
+		if name == "" || name == "." || name == ".." || strings.ContainsRune(name, filepath.Separator) {
+			return nil, fmt.Errorf("invalid analysis history key '%s'", name)
+		}
+	}
+	dir := filepath.Join(s.root, namespace, rollout)
+	entries, err := os.ReadDir(dir)
+	if errors.Is(err, os.ErrNotExist) {
+		return []Record{}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	records := []Record{}
+	for _, entry := range entries {
+		if entry.IsDir() || !strings.HasSuffix(entry.Name(), ".json") {
+			continue
+		}
+		data, err := os.ReadFile(filepath.Join(dir, entry.Name()))
+		if err != nil {
+			return nil, err
+		}
+		var record Record
+		if err := json.Unmarshal(data, &record); err != nil {
+			return nil, fmt.Errorf("failed to decode analysis history '%s': %w", entry.Name(), err)
+		}
+		records = append(records, record)
+	}
+	sortRecords(records)
+	return records, nil
+}
@@ -0,0 +1,56 @@
+package history
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	log "github.com/sirupsen/logrus"
+	"k8s.io/apimachinery/pkg/util/validation"
+)
+
+// ControllerPath is where the controller serves the history of a rollout on its metrics server, as
+// /analysis-history/<namespace>/<rollout>
+const ControllerPath = "/analysis-history/"
+
+// Response lists the records of a rollout, oldest first
+type Response struct {
+	Items []Record `json:"items"`
+}
+
+// NewHandler returns a handler serving the records listed by lister for the <namespace>/<rollout>
+// request path
+func NewHandler(lister Lister) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			http.Error(w, http.StatusText(http.StatusMethodNotAllowed), http.StatusMethodNotAllowed)
+			return
+		}
+		parts := strings.Split(strings.Trim(r.URL.Path, "/"), "/")
+		if len(parts) != 2 {
+			http.Error(w, "expected <namespace>/<rollout>", http.StatusBadRequest)
+			return
+		}
+		namespace, rollout := parts[0], parts[1]
+		if errs := validation.IsDNS1123Label(namespace); len(errs) > 0 {
+			http.Error(w, fmt.Sprintf("invalid namespace '%s': %s", namespace, strings.Join(errs, ", ")), http.StatusBadRequest)
+			return
+		}
+		if errs := validation.IsDNS1123Subdomain(rollout); len(errs) > 0 {
+			http.Error(w, fmt.Sprintf("invalid rollout '%s': %s", rollout, strings.Join(errs, ", ")), http.StatusBadRequest)
+			return
+		}
+
+		records, err := lister.List(namespace, rollout)
+		if err != nil {
+			log.Errorf("Failed to list analysis history of %s/%s: %v", namespace, rollout, err)
+			http.Error(w, "failed to list analysis history", http.StatusInternalServerError)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		if err := json.NewEncoder(w).Encode(Response{Items: records}); err != nil {
+			log.Errorf("Failed to write analysis history response: %v", err)
+		}
+	})
+}
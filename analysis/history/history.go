@@ -0,0 +1,122 @@
+// Package history exports the results of completed AnalysisRuns to a durable sink, so they remain
+// available for postmortems after the AnalysisRuns themselves are garbage collected.
+package history
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
+	"github.com/argoproj/argo-rollouts/utils/annotations"
+	timeutil "github.com/argoproj/argo-rollouts/utils/time"
+)
+
+const (
+	// FileSinkPrefix selects a sink appending records as JSON lines to a local file
+	FileSinkPrefix = "file:"
+	// DirSinkPrefix selects a sink writing one JSON object per record below a directory, laid out
+	// like an object store bucket
+	DirSinkPrefix = "dir:"
+	// SQLSinkPrefix selects a sink storing records in a postgres or mysql database
+	SQLSinkPrefix = "sql:"
+	// ExportPendingAnnotation marks a completed AnalysisRun whose export failed, so that the export is
+	// retried until it succeeds
+	ExportPendingAnnotation = "argo-rollouts.argoproj.io/analysis-history-export-pending"
+)
+
+// Record is the durable copy of a completed AnalysisRun
+type Record struct {
+	Namespace     string                  `json:"namespace"`
+	Name          string                  `json:"name"`
+	UID           types.UID               `json:"uid"`
+	Rollout       string                  `json:"rollout"`
+	Revision      string                  `json:"revision,omitempty"`
+	Phase         v1alpha1.AnalysisPhase  `json:"phase"`
+	Message       string                  `json:"message,omitempty"`
+	Score         *int64                  `json:"score,omitempty"`
+	StartedAt     *metav1.Time            `json:"startedAt,omitempty"`
+	CompletedAt   metav1.Time             `json:"completedAt"`
+	Args          []v1alpha1.Argument     `json:"args,omitempty"`
+	MetricResults []v1alpha1.MetricResult `json:"metricResults,omitempty"`
+}
+
+// Lister lists the records of a rollout
+type Lister interface {
+	// List returns the records of a rollout, oldest first
+	List(namespace, rollout string) ([]Record, error)
+}
+
+// Sink stores records and lists those of a rollout
+type Sink interface {
+	Lister
+	// Write stores the record, replacing any previous record of the same AnalysisRun
+	Write(record Record) error
+}
+
+// NewSink returns the sink described by spec, which is one of:
+//
+//	file:<path>            JSON lines appended to a local file
+//	dir:<path>             one JSON object per run, stored as <path>/<namespace>/<rollout>/<uid>.json
+//	sql:<driver>:<dsn>     a table in a postgres or mysql database, e.g. sql:postgres:postgres://host/db
+func NewSink(spec string) (Sink, error) {
+	switch {
+	case strings.HasPrefix(spec, FileSinkPrefix):
+		return NewFileSink(strings.TrimPrefix(spec, FileSinkPrefix))
+	case strings.HasPrefix(spec, DirSinkPrefix):
+		return NewDirSink(strings.TrimPrefix(spec, DirSinkPrefix))
+	case strings.HasPrefix(spec, SQLSinkPrefix):
+		driverName, dsn, found := strings.Cut(strings.TrimPrefix(spec, SQLSinkPrefix), ":")
+		if !found {
+			return nil, fmt.Errorf("invalid analysis history sink '%s': expected sql:<driver>:<dsn>", spec)
+		}
+		return NewSQLSink(driverName, dsn)
+	}
+	return nil, fmt.Errorf("invalid analysis history sink '%s': must start with one of %s, %s or %s", spec, FileSinkPrefix, DirSinkPrefix, SQLSinkPrefix)
+}
+
+// NewLister returns the lister described by spec, either the http(s) address of the metrics server of a
+// controller exporting the history, or a sink spec
+func NewLister(spec string) (Lister, error) {
+	if strings.HasPrefix(spec, "http://") || strings.HasPrefix(spec, "https://") {
+		return NewControllerLister(spec)
+	}
+	return NewSink(spec)
+}
+
+// NewRecord returns the record of a completed AnalysisRun. The second return value is false when the
+// run was not started by a rollout, since records are only kept per rollout.
+func NewRecord(run *v1alpha1.AnalysisRun) (Record, bool) {
+	owner := metav1.GetControllerOf(run)
+	if owner == nil || owner.Kind != "Rollout" {
+		return Record{}, false
+	}
+	completedAt := metav1.NewTime(timeutil.Now())
+	if run.Status.CompletedAt != nil {
+		completedAt = *run.Status.CompletedAt
+	}
+	return Record{
+		Namespace:     run.Namespace,
+		Name:          run.Name,
+		UID:           run.UID,
+		Rollout:       owner.Name,
+		Revision:      run.Annotations[annotations.RevisionAnnotation],
+		Phase:         run.Status.Phase,
+		Message:       run.Status.Message,
+		Score:         run.Status.Score,
+		StartedAt:     run.Status.StartedAt,
+		CompletedAt:   completedAt,
+		Args:          run.Spec.Args,
+		MetricResults: run.Status.MetricResults,
+	}, true
+}
+
+// sortRecords orders records by completion, oldest first
+func sortRecords(records []Record) {
+	sort.SliceStable(records, func(i, j int) bool {
+		return records[i].CompletedAt.Before(&records[j].CompletedAt)
+	})
+}
@@ -0,0 +1,286 @@
+package history
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
+	"github.com/argoproj/argo-rollouts/utils/annotations"
+)
+
+func newTestRecord(uid, rollout string, completedAt time.Time, phase v1alpha1.AnalysisPhase) Record {
+	return Record{
+		Namespace:   metav1.NamespaceDefault,
+		Name:        "run-" + uid,
+		UID:         types.UID(uid),
+		Rollout:     rollout,
+		Phase:       phase,
+		CompletedAt: metav1.NewTime(completedAt.Truncate(time.Second)),
+		MetricResults: []v1alpha1.MetricResult{{
+			Name:         "success-rate",
+			Phase:        phase,
+			Measurements: []v1alpha1.Measurement{{Phase: phase, Value: "0.99"}},
+		}},
+	}
+}
+
+// testSink checks the behavior every sink shares
+func testSink(t *testing.T, sink Sink) {
+	now := time.Now()
+	older := newTestRecord("a", "checkout", now.Add(-time.Hour), v1alpha1.AnalysisPhaseFailed)
+	newer := newTestRecord("b", "checkout", now, v1alpha1.AnalysisPhaseSuccessful)
+	other := newTestRecord("c", "cart", now, v1alpha1.AnalysisPhaseSuccessful)
+
+	records, err := sink.List(metav1.NamespaceDefault, "checkout")
+	assert.NoError(t, err)
+	assert.Empty(t, records)
+
+	for _, record := range []Record{newer, other, older} {
+		assert.NoError(t, sink.Write(record))
+	}
+	records, err = sink.List(metav1.NamespaceDefault, "checkout")
+	assert.NoError(t, err)
+	assert.Equal(t, []Record{older, newer}, records)
+
+	// writing a record of the same run replaces it
+	older.Message = "rewritten"
+	assert.NoError(t, sink.Write(older))
+	records, err = sink.List(metav1.NamespaceDefault, "checkout")
+	assert.NoError(t, err)
+	assert.Len(t, records, 2)
+	assert.Equal(t, "rewritten", records[0].Message)
+
+	records, err = sink.List("other", "checkout")
+	assert.NoError(t, err)
+	assert.Empty(t, records)
+}
+
+func TestFileSink(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "history", "runs.jsonl")
+	sink, err := NewSink("file:" + path)
+	assert.NoError(t, err)
+	testSink(t, sink)
+
+	assert.NoError(t, os.WriteFile(path, []byte("not json\n"), 0o644))
+	_, err = sink.List(metav1.NamespaceDefault, "checkout")
+	assert.ErrorContains(t, err, "failed to decode analysis history")
+}
+
+func TestDirSink(t *testing.T) {
+	root := t.TempDir()
+	sink, err := NewSink("dir:" + root)
+	assert.NoError(t, err)
+	testSink(t, sink)
+
+	assert.FileExists(t, filepath.Join(root, metav1.NamespaceDefault, "checkout", "a.json"))
+	_, err = sink.List("..", "checkout")
+	assert.EqualError(t, err, "invalid analysis history key '..'")
+}
+
+func TestSQLSink(t *testing.T) {
+	sink, err := NewSink("sql:fakehistory:test")
+	assert.NoError(t, err)
+	testSink(t, sink)
+
+	_, err = NewSink("sql:fakehistory")
+	assert.EqualError(t, err, "invalid analysis history sink 'sql:fakehistory': expected sql:<driver>:<dsn>")
+	_, err = NewSink("sql:unknown:test")
+	assert.ErrorContains(t, err, "unknown driver")
+}
+
+func TestRebind(t *testing.T) {
+	assert.Equal(t, "DELETE FROM analysis_history WHERE uid = $1", rebind("postgres", deleteStatement))
+	assert.Equal(t, "INSERT INTO analysis_history (uid, namespace, rollout, name, completed_at, record) VALUES ($1, $2, $3, $4, $5, $6)", rebind("postgres", insertStatement))
+	assert.Equal(t, insertStatement, rebind("mysql", insertStatement))
+}
+
+func TestControllerLister(t *testing.T) {
+	sink, err := NewDirSink(t.TempDir())
+	assert.NoError(t, err)
+	record := newTestRecord("a", "checkout", time.Now(), v1alpha1.AnalysisPhaseSuccessful)
+	assert.NoError(t, sink.Write(record))
+	mux := http.NewServeMux()
+	mux.Handle(ControllerPath, http.StripPrefix(ControllerPath, NewHandler(sink)))
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	lister, err := NewLister(server.URL)
+	assert.NoError(t, err)
+	records, err := lister.List(metav1.NamespaceDefault, "checkout")
+	assert.NoError(t, err)
+	assert.Equal(t, []Record{record}, records)
+	records, err = lister.List(metav1.NamespaceDefault, "cart")
+	assert.NoError(t, err)
+	assert.Empty(t, records)
+
+	_, err = lister.List(metav1.NamespaceDefault, "check_out")
+	assert.EqualError(t, err, "controller responded with 400 Bad Request")
+}
+
+func TestNewSinkInvalid(t *testing.T) {
+	_, err := NewSink("s3://bucket")
+	assert.EqualError(t, err, "invalid analysis history sink 's3://bucket': must start with one of file:, dir: or sql:")
+	_, err = NewSink("file:")
+	assert.EqualError(t, err, "file analysis history sink requires a path")
+}
+
+func TestNewRecord(t *testing.T) {
+	startedAt := metav1.NewTime(time.Now().Add(-time.Minute))
+	completedAt := metav1.Now()
+	run := &v1alpha1.AnalysisRun{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        "checkout-abc-3",
+			Namespace:   metav1.NamespaceDefault,
+			UID:         types.UID("uid"),
+			Annotations: map[string]string{annotations.RevisionAnnotation: "3"},
+		},
+		Spec: v1alpha1.AnalysisRunSpec{
+			Args: []v1alpha1.Argument{{Name: "service"}},
+		},
+		Status: v1alpha1.AnalysisRunStatus{
+			Phase:         v1alpha1.AnalysisPhaseFailed,
+			Message:       "Metric \"success-rate\" assessed Failed",
+			StartedAt:     &startedAt,
+			CompletedAt:   &completedAt,
+			MetricResults: []v1alpha1.MetricResult{{Name: "success-rate"}},
+		},
+	}
+
+	_, ok := NewRecord(run)
+	assert.False(t, ok)
+
+	experiment := &v1alpha1.Experiment{ObjectMeta: metav1.ObjectMeta{Name: "checkout-abc-3"}}
+	run.OwnerReferences = []metav1.OwnerReference{*metav1.NewControllerRef(experiment, v1alpha1.SchemeGroupVersion.WithKind("Experiment"))}
+	_, ok = NewRecord(run)
+	assert.False(t, ok)
+
+	rollout := &v1alpha1.Rollout{ObjectMeta: metav1.ObjectMeta{Name: "checkout"}}
+	run.OwnerReferences = []metav1.OwnerReference{*metav1.NewControllerRef(rollout, v1alpha1.SchemeGroupVersion.WithKind("Rollout"))}
+	record, ok := NewRecord(run)
+	assert.True(t, ok)
+	assert.Equal(t, Record{
+		Namespace:     metav1.NamespaceDefault,
+		Name:          "checkout-abc-3",
+		UID:           types.UID("uid"),
+		Rollout:       "checkout",
+		Revision:      "3",
+		Phase:         v1alpha1.AnalysisPhaseFailed,
+		Message:       "Metric \"success-rate\" assessed Failed",
+		StartedAt:     &startedAt,
+		CompletedAt:   completedAt,
+		Args:          run.Spec.Args,
+		MetricResults: run.Status.MetricResults,
+	}, record)
+}
+
+// fakeDriver is a database/sql driver understanding only the statements of the SQL sink, keeping the
+// analysis_history table in memory
+type fakeDriver struct {
+	lock sync.Mutex
+	rows map[string][]driver.Value
+}
+
+func init() {
+	sql.Register("fakehistory", &fakeDriver{rows: map[string][]driver.Value{}})
+}
+
+func (d *fakeDriver) Open(name string) (driver.Conn, error) {
+	return &fakeConn{driver: d}, nil
+}
+
+type fakeConn struct {
+	driver *fakeDriver
+}
+
+func (c *fakeConn) Prepare(query string) (driver.Stmt, error) {
+	return nil, fmt.Errorf("prepared statements are not supported")
+}
+
+func (c *fakeConn) Close() error {
+	return nil
+}
+
+func (c *fakeConn) Begin() (driver.Tx, error) {
+	return c, nil
+}
+
+func (c *fakeConn) Commit() error {
+	return nil
+}
+
+func (c *fakeConn) Rollback() error {
+	return nil
+}
+
+func (c *fakeConn) ExecContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Result, error) {
+	c.driver.lock.Lock()
+	defer c.driver.lock.Unlock()
+	switch query {
+	case createTableStatement:
+	case deleteStatement:
+		delete(c.driver.rows, args[0].Value.(string))
+	case insertStatement:
+		row := make([]driver.Value, len(args))
+		for i := range args {
+			row[i] = args[i].Value
+		}
+		c.driver.rows[args[0].Value.(string)] = row
+	default:
+		return nil, fmt.Errorf("unexpected statement: %s", query)
+	}
+	return driver.RowsAffected(1), nil
+}
+
+func (c *fakeConn) QueryContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
+	if query != selectStatement {
+		return nil, fmt.Errorf("unexpected query: %s", query)
+	}
+	c.driver.lock.Lock()
+	defer c.driver.lock.Unlock()
+	matched := [][]driver.Value{}
+	for _, row := range c.driver.rows {
+		if row[1] == args[0].Value && row[2] == args[1].Value {
+			matched = append(matched, row)
+		}
+	}
+	sort.Slice(matched, func(i, j int) bool {
+		return matched[i][4].(time.Time).Before(matched[j][4].(time.Time))
+	})
+	return &fakeRows{rows: matched}, nil
+}
+
+type fakeRows struct {
+	rows [][]driver.Value
+}
+
+func (r *fakeRows) Columns() []string {
+	return []string{"record"}
+}
+
+func (r *fakeRows) Close() error {
+	return nil
+}
+
+func (r *fakeRows) Next(dest []driver.Value) error {
+	if len(r.rows) == 0 {
+		return io.EOF
+	}
+	dest[0] = r.rows[0][5]
+	r.rows = r.rows[1:]
+	return nil
+}
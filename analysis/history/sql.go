@@ -0,0 +1,120 @@
+package history
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	// database/sql drivers of the sql sink
+	_ "github.com/go-sql-driver/mysql"
+	_ "github.com/lib/pq"
+)
+
+const (
+	createTableStatement = "CREATE TABLE IF NOT EXISTS analysis_history (uid VARCHAR(64) PRIMARY KEY, namespace VARCHAR(253) NOT NULL, rollout VARCHAR(253) NOT NULL, name VARCHAR(253) NOT NULL, completed_at TIMESTAMP NOT NULL, record TEXT NOT NULL)"
+	deleteStatement      = "DELETE FROM analysis_history WHERE uid = ?"
+	insertStatement      = "INSERT INTO analysis_history (uid, namespace, rollout, name, completed_at, record) VALUES (?, ?, ?, ?, ?, ?)"
+	selectStatement      = "SELECT record FROM analysis_history WHERE namespace = ? AND rollout = ? ORDER BY completed_at"
+
+	sqlTimeout = 30 * time.Second
+)
+
+// sqlSink stores records in the analysis_history table of a database/sql database. The whole record
+// is kept as JSON next to the columns it is looked up by. The postgres and mysql drivers are registered.
+type sqlSink struct {
+	db *sql.DB
+	// the statements, with the placeholders of the driver
+	deleteStatement string
+	insertStatement string
+	selectStatement string
+}
+
+// NewSQLSink opens the database and creates the analysis_history table when missing
+func NewSQLSink(driverName, dsn string) (Sink, error) {
+	db, err := sql.Open(driverName, dsn)
+	if err != nil {
+		return nil, err
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), sqlTimeout)
+	defer cancel()
+	if _, err := db.ExecContext(ctx, createTableStatement); err != nil {
+		db.Close()
+		return nil, fmt.Errorf("failed to create analysis_history table: %w", err)
+	}
+	return &sqlSink{
+		db:              db,
+		deleteStatement: rebind(driverName, deleteStatement),
+		insertStatement: rebind(driverName, insertStatement),
+		selectStatement: rebind(driverName, selectStatement),
+	}, nil
+}
+
+// rebind replaces the ? placeholders of statement with the numbered placeholders of postgres
+func rebind(driverName, statement string) string {
+	if driverName != "postgres" {
+		return statement
+	}
+	var b strings.Builder
+	n := 0
+	for _, c := range statement {
+		if c == '?' {
+			n++
+			b.WriteString("$" + strconv.Itoa(n))
+			continue
+		}
+		b.WriteRune(c)
+	}
+	return b.String()
+}
+
+func (s *sqlSink) Write(record Record) error {
+	data, err := json.Marshal(record)
+	if err != nil {
+		return err
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), sqlTimeout)
+	defer cancel()
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+	if _, err := tx.ExecContext(ctx, s.deleteStatement, string(record.UID)); err != nil {
+		return err
+	}
+	if _, err := tx.ExecContext(ctx, s.insertStatement, string(record.UID), record.Namespace, record.Rollout, record.Name, record.CompletedAt.UTC(), string(data)); err != nil {
+		return err
+	}
+	return tx.Commit()
+}
+
+func (s *sqlSink) List(namespace, rollout string) ([]Record, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), sqlTimeout)
+	defer cancel()
+	rows, err := s.db.QueryContext(ctx, s.selectStatement, namespace, rollout)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	records := []Record{}
+	for rows.Next() {
+		var data string
+		if err := rows.Scan(&data); err != nil {
+			return nil, err
+		}
+		var record Record
+		if err := json.Unmarshal([]byte(data), &record); err != nil {
+			return nil, fmt.Errorf("failed to decode analysis history: %w", err)
+		}
+		records = append(records, record)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	sortRecords(records)
+	return records, nil
+}
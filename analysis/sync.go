@@ -2,10 +2,14 @@ package analysis
 
 import (
 	"context"
+	"encoding/json"
+	"time"
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	patchtypes "k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
 
+	"github.com/argoproj/argo-rollouts/analysis/history"
 	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
 	"github.com/argoproj/argo-rollouts/utils/diff"
 	logutil "github.com/argoproj/argo-rollouts/utils/log"
@@ -38,3 +42,66 @@ func (c *Controller) persistAnalysisRunStatus(orig *v1alpha1.AnalysisRun, newSta
 	logCtx.Info("Patch status successfully")
 	return nil
 }
+
+// historyExportRetryInterval is the interval between attempts to export an AnalysisRun whose export failed
+const historyExportRetryInterval = time.Minute
+
+// exportPending returns whether the export of a completed AnalysisRun to the analysis history failed and
+// has yet to be retried successfully
+func (c *Controller) exportPending(run *v1alpha1.AnalysisRun) bool {
+	_, pending := run.Annotations[history.ExportPendingAnnotation]
+	return c.historySink != nil && pending
+}
+
+// exportAnalysisRun writes the results of a completed AnalysisRun to the analysis history sink. A failure
+// never fails the reconciliation, which already persisted the completed status. Instead, the run is
+// annotated as pending export and the export is retried until it succeeds.
+func (c *Controller) exportAnalysisRun(run *v1alpha1.AnalysisRun) {
+	if c.historySink == nil {
+		return
+	}
+	logCtx := logutil.WithAnalysisRun(run)
+	record, ok := history.NewRecord(run)
+	if !ok {
+		logCtx.Debug("AnalysisRun is not owned by a rollout. Skipping analysis history export")
+		return
+	}
+	pending := c.exportPending(run)
+	if err := c.historySink.Write(record); err != nil {
+		logCtx.Warnf("Failed to export AnalysisRun to analysis history, retrying in %v: %v", historyExportRetryInterval, err)
+		if !pending {
+			if err := c.patchExportPending(run, true); err != nil {
+				logCtx.Warnf("Failed to mark AnalysisRun as pending analysis history export: %v", err)
+			}
+		}
+		c.enqueueAnalysisAfter(run, historyExportRetryInterval)
+		return
+	}
+	logCtx.Info("Exported AnalysisRun to analysis history")
+	if pending {
+		if err := c.patchExportPending(run, false); err != nil {
+			// the export is retried, which replaces the record
+			logCtx.Warnf("Failed to clear pending analysis history export: %v", err)
+		}
+	}
+}
+
+// patchExportPending sets or removes the annotation marking the AnalysisRun as pending export
+func (c *Controller) patchExportPending(run *v1alpha1.AnalysisRun, pending bool) error {
+	var value *string
+	if pending {
+		value = ptr.To("true")
+	}
+	patch, err := json.Marshal(map[string]any{
+		"metadata": map[string]any{
+			"annotations": map[string]*string{
+				history.ExportPendingAnnotation: value,
+			},
+		},
+	})
+	if err != nil {
+		return err
+	}
+	_, err = c.argoProjClientset.ArgoprojV1alpha1().AnalysisRuns(run.Namespace).Patch(context.TODO(), run.Name, patchtypes.MergePatchType, patch, metav1.PatchOptions{})
+	return err
+}
@@ -22,6 +22,7 @@ import (
 	_ "k8s.io/client-go/plugin/pkg/client/auth/oidc"
 	"k8s.io/client-go/tools/clientcmd"
 
+	"github.com/argoproj/argo-rollouts/analysis/history"
 	"github.com/argoproj/argo-rollouts/metricproviders"
 	"github.com/argoproj/argo-rollouts/rollout"
 	"github.com/argoproj/argo-rollouts/utils/errors"
@@ -84,11 +85,17 @@ func newCommand() *cobra.Command {
 		albIngressClasses              []string
 		nginxIngressClasses            []string
 		awsVerifyTargetGroup           bool
+		istioVerifyWeight              bool
+		selfHealTrafficRouting         bool
 		namespaced                     bool
 		printVersion                   bool
 		selfServiceNotificationEnabled bool
 		controllersEnabled             []string
 		pprofAddress                   string
+		metricQueryCacheTTL            time.Duration
+		metricQueryQPS                 float32
+		metricQueryBurst               int
+		analysisHistorySinkSpec        string
 	)
 	electOpts := controller.NewLeaderElectionOptions()
 	var command = cobra.Command{
@@ -112,6 +119,8 @@ func newCommand() *cobra.Command {
 			ctx := signals.SetupSignalHandlerContext()
 
 			defaults.SetVerifyTargetGroup(awsVerifyTargetGroup)
+			defaults.SetVerifyIstioWeight(istioVerifyWeight)
+			defaults.SetSelfHealTrafficRouting(selfHealTrafficRouting)
 			defaults.SetTargetGroupBindingAPIVersion(targetGroupBindingVersion)
 			defaults.SetalbTagKeyResourceID(albTagKeyResourceID)
 			defaults.SetIstioAPIVersion(istioVersion)
@@ -120,6 +129,16 @@ func newCommand() *cobra.Command {
 			defaults.SetAppMeshCRDVersion(appmeshCRDVersion)
 			defaults.SetTraefikAPIGroup(traefikAPIGroup)
 			defaults.SetTraefikVersion(traefikVersion)
+			defaults.SetMetricQueryCacheTTL(metricQueryCacheTTL)
+			defaults.SetMetricQueryQPS(metricQueryQPS)
+			defaults.SetMetricQueryBurst(metricQueryBurst)
+
+			var analysisHistorySink history.Sink
+			if analysisHistorySinkSpec != "" {
+				sink, err := history.NewSink(analysisHistorySinkSpec)
+				errors.CheckError(err)
+				analysisHistorySink = sink
+			}
 
 			config, err := clientConfig.ClientConfig()
 			errors.CheckError(err)
@@ -195,10 +214,17 @@ func newCommand() *cobra.Command {
 			clusterDynamicInformerFactory := dynamicinformer.NewFilteredDynamicSharedInformerFactory(dynamicClient, resyncDuration, metav1.NamespaceAll, instanceIDTweakListFunc)
 			// 3. We finally need an istio dynamic informer factory which does not use a tweakListFunc.
 			_, istioPrimaryDynamicClient := istioutil.GetPrimaryClusterDynamicClient(kubeClient, namespace)
-			if istioPrimaryDynamicClient == nil {
+			istioMultiCluster := istioPrimaryDynamicClient != nil
+			if !istioMultiCluster {
 				istioPrimaryDynamicClient = dynamicClient
 			}
 			istioDynamicInformerFactory := dynamicinformer.NewFilteredDynamicSharedInformerFactory(istioPrimaryDynamicClient, resyncDuration, namespace, nil)
+			// The other traffic routing resources (e.g. SMI TrafficSplits) are watched through the
+			// istio factory, unless it points to the istio primary cluster.
+			trafficRoutingInformerFactory := istioDynamicInformerFactory
+			if istioMultiCluster {
+				trafficRoutingInformerFactory = dynamicinformer.NewFilteredDynamicSharedInformerFactory(dynamicClient, resyncDuration, namespace, nil)
+			}
 
 			var notificationConfigNamespace string
 			if selfServiceNotificationEnabled {
@@ -253,6 +279,7 @@ func newCommand() *cobra.Command {
 					tolerantinformer.NewTolerantAnalysisRunInformer(dynamicInformerFactory),
 					tolerantinformer.NewTolerantAnalysisTemplateInformer(dynamicInformerFactory),
 					tolerantinformer.NewTolerantClusterAnalysisTemplateInformer(clusterDynamicInformerFactory),
+					tolerantinformer.NewTolerantMetricProviderConfigInformer(dynamicInformerFactory),
 					resyncDuration,
 					metricsPort,
 					healthzPort,
@@ -261,7 +288,8 @@ func newCommand() *cobra.Command {
 					clusterDynamicInformerFactory,
 					namespaced,
 					kubeInformerFactory,
-					jobInformerFactory)
+					jobInformerFactory,
+					analysisHistorySink)
 			} else {
 				cm = controller.NewManager(
 					namespace,
@@ -280,6 +308,7 @@ func newCommand() *cobra.Command {
 					tolerantinformer.NewTolerantAnalysisRunInformer(dynamicInformerFactory),
 					tolerantinformer.NewTolerantAnalysisTemplateInformer(dynamicInformerFactory),
 					tolerantinformer.NewTolerantClusterAnalysisTemplateInformer(clusterDynamicInformerFactory),
+					tolerantinformer.NewTolerantMetricProviderConfigInformer(dynamicInformerFactory),
 					istioPrimaryDynamicClient,
 					istioDynamicInformerFactory.ForResource(istioutil.GetIstioVirtualServiceGVR()).Informer(),
 					istioDynamicInformerFactory.ForResource(istioutil.GetIstioDestinationRuleGVR()).Informer(),
@@ -295,13 +324,15 @@ func newCommand() *cobra.Command {
 					dynamicInformerFactory,
 					clusterDynamicInformerFactory,
 					istioDynamicInformerFactory,
+					trafficRoutingInformerFactory,
 					namespaced,
 					kubeInformerFactory,
 					replicaSetInformerFactory,
 					jobInformerFactory,
 					ephemeralMetadataThreads,
 					ephemeralMetadataPodRetries,
-					selfServiceNotificationEnabled)
+					selfServiceNotificationEnabled,
+					analysisHistorySink)
 			}
 			if err = cm.Run(ctx, rolloutThreads, serviceThreads, ingressThreads, experimentThreads, analysisThreads, electOpts); err != nil {
 				log.Fatalf("Error running controller: %s", err.Error())
@@ -329,6 +360,10 @@ func newCommand() *cobra.Command {
 	command.Flags().IntVar(&rolloutThreads, "rollout-threads", controller.DefaultRolloutThreads, "Set the number of worker threads for the Rollout controller")
 	command.Flags().IntVar(&experimentThreads, "experiment-threads", controller.DefaultExperimentThreads, "Set the number of worker threads for the Experiment controller")
 	command.Flags().IntVar(&analysisThreads, "analysis-threads", controller.DefaultAnalysisThreads, "Set the number of worker threads for the Experiment controller")
+	command.Flags().DurationVar(&metricQueryCacheTTL, "metric-query-cache-ttl", 0, "Duration for which the results of identical analysis metric queries are shared between AnalysisRuns. Only applies to the prometheus and sloBurnRate providers. Default: 0 (disabled)")
+	command.Flags().Float32Var(&metricQueryQPS, "metric-query-qps", 0, "Maximum QPS (queries per second) of analysis metric queries to a single metric backend address. Only applies to the prometheus and sloBurnRate providers. Default: 0 (unlimited)")
+	command.Flags().IntVar(&metricQueryBurst, "metric-query-burst", defaults.DefaultMetricQueryBurst, "Maximum burst of analysis metric queries to a single metric backend address. Only applies to the prometheus and sloBurnRate providers.")
+	command.Flags().StringVar(&analysisHistorySinkSpec, "analysis-history-sink", "", "Export the results of completed AnalysisRuns to a sink, one of file:<path>, dir:<path> or sql:<driver>:<dsn>, and serve them on the metrics server. Disabled when empty.")
 	command.Flags().IntVar(&serviceThreads, "service-threads", controller.DefaultServiceThreads, "Set the number of worker threads for the Service controller")
 	command.Flags().IntVar(&ingressThreads, "ingress-threads", controller.DefaultIngressThreads, "Set the number of worker threads for the Ingress controller")
 	command.Flags().IntVar(&ephemeralMetadataThreads, "ephemeral-metadata-threads", rollout.DefaultEphemeralMetadataThreads, "Set the number of worker threads for the Ephemeral Metadata reconciler")
@@ -347,6 +382,8 @@ func newCommand() *cobra.Command {
 	command.Flags().BoolVar(&awsVerifyTargetGroup, "alb-verify-weight", false, "Verify ALB target group weights before progressing through steps (requires AWS privileges)")
 	command.Flags().MarkDeprecated("alb-verify-weight", "Use --aws-verify-target-group instead")
 	command.Flags().BoolVar(&awsVerifyTargetGroup, "aws-verify-target-group", false, "Verify ALB target group before progressing through steps (requires AWS privileges)")
+	command.Flags().BoolVar(&istioVerifyWeight, "istio-verify-weight", false, "Verify istiod reconciled the VirtualService weights before progressing through steps (requires istiod status reporting)")
+	command.Flags().BoolVar(&selfHealTrafficRouting, "self-heal-traffic-routing", true, "Re-apply the desired weights to the traffic routing resources which drifted from them. When disabled, the drift is only reported and holds the rollout at its current step")
 	command.Flags().BoolVar(&printVersion, "version", false, "Print version")
 	command.Flags().BoolVar(&electOpts.LeaderElect, "leader-elect", controller.DefaultLeaderElect, "If true, controller will perform leader election between instances to ensure no more than one instance of controller operates at a time")
 	command.Flags().DurationVar(&electOpts.LeaderElectionLeaseDuration, "leader-election-lease-duration", controller.DefaultLeaderElectionLeaseDuration, "The duration that non-leader candidates will wait after observing a leadership renewal until attempting to acquire leadership of a led but unrenewed leader slot. This is effectively the maximum duration that a leader can be stopped before it is replaced by another candidate. This is only applicable if leader election is enabled.")
@@ -44,6 +44,7 @@ import (
 	"k8s.io/client-go/util/workqueue"
 
 	"github.com/argoproj/argo-rollouts/analysis"
+	"github.com/argoproj/argo-rollouts/analysis/history"
 	"github.com/argoproj/argo-rollouts/controller/metrics"
 	"github.com/argoproj/argo-rollouts/experiments"
 	"github.com/argoproj/argo-rollouts/ingress"
@@ -148,6 +149,7 @@ type Manager struct {
 	analysisRunSynced             cache.InformerSynced
 	analysisTemplateSynced        cache.InformerSynced
 	clusterAnalysisTemplateSynced cache.InformerSynced
+	metricProviderConfigSynced    cache.InformerSynced
 	serviceSynced                 cache.InformerSynced
 	ingressSynced                 cache.InformerSynced
 	jobSynced                     cache.InformerSynced
@@ -196,6 +198,7 @@ func NewAnalysisManager(
 	analysisRunInformer informers.AnalysisRunInformer,
 	analysisTemplateInformer informers.AnalysisTemplateInformer,
 	clusterAnalysisTemplateInformer informers.ClusterAnalysisTemplateInformer,
+	metricProviderConfigInformer informers.MetricProviderConfigInformer,
 	resyncPeriod time.Duration,
 	metricsPort int,
 	healthzPort int,
@@ -205,6 +208,7 @@ func NewAnalysisManager(
 	namespaced bool,
 	kubeInformerFactory kubeinformers.SharedInformerFactory,
 	jobInformerFactory kubeinformers.SharedInformerFactory,
+	analysisHistorySink history.Sink,
 ) *Manager {
 	runtime.Must(rolloutscheme.AddToScheme(scheme.Scheme))
 	log.Info("Creating event broadcaster")
@@ -218,21 +222,24 @@ func NewAnalysisManager(
 		ClusterAnalysisTemplateLister: clusterAnalysisTemplateInformer.Lister(),
 		ExperimentLister:              nil,
 		K8SRequestProvider:            k8sRequestProvider,
+		AnalysisHistory:               analysisHistorySink,
 	})
 
 	healthzServer := NewHealthzServer(fmt.Sprintf(listenAddr, healthzPort))
 	analysisRunWorkqueue := workqueue.NewNamedRateLimitingQueue(queue.DefaultArgoRolloutsRateLimiter(), "AnalysisRuns")
 	recorder := record.NewEventRecorder(kubeclientset, metrics.MetricRolloutEventsTotal, metrics.MetricNotificationFailedTotal, metrics.MetricNotificationSuccessTotal, metrics.MetricNotificationSend, nil)
 	analysisController := analysis.NewController(analysis.ControllerConfig{
-		KubeClientSet:        kubeclientset,
-		ArgoProjClientset:    argoprojclientset,
-		AnalysisRunInformer:  analysisRunInformer,
-		JobInformer:          jobInformer,
-		JobPodsInformer:      jobPodsInformer,
-		ResyncPeriod:         resyncPeriod,
-		AnalysisRunWorkQueue: analysisRunWorkqueue,
-		MetricsServer:        metricsServer,
-		Recorder:             recorder,
+		KubeClientSet:                kubeclientset,
+		ArgoProjClientset:            argoprojclientset,
+		AnalysisRunInformer:          analysisRunInformer,
+		JobInformer:                  jobInformer,
+		JobPodsInformer:              jobPodsInformer,
+		ResyncPeriod:                 resyncPeriod,
+		AnalysisRunWorkQueue:         analysisRunWorkqueue,
+		MetricsServer:                metricsServer,
+		Recorder:                     recorder,
+		MetricProviderConfigInformer: metricProviderConfigInformer,
+		HistorySink:                  analysisHistorySink,
 	})
 
 	cm := &Manager{
@@ -244,6 +251,7 @@ func NewAnalysisManager(
 		analysisRunSynced:             analysisRunInformer.Informer().HasSynced,
 		analysisTemplateSynced:        analysisTemplateInformer.Informer().HasSynced,
 		clusterAnalysisTemplateSynced: clusterAnalysisTemplateInformer.Informer().HasSynced,
+		metricProviderConfigSynced:    metricProviderConfigInformer.Informer().HasSynced,
 		analysisRunWorkqueue:          analysisRunWorkqueue,
 		analysisController:            analysisController,
 		namespace:                     namespace,
@@ -287,6 +295,7 @@ func NewManager(
 	analysisRunInformer informers.AnalysisRunInformer,
 	analysisTemplateInformer informers.AnalysisTemplateInformer,
 	clusterAnalysisTemplateInformer informers.ClusterAnalysisTemplateInformer,
+	metricProviderConfigInformer informers.MetricProviderConfigInformer,
 	istioPrimaryDynamicClient dynamic.Interface,
 	istioVirtualServiceInformer cache.SharedIndexInformer,
 	istioDestinationRuleInformer cache.SharedIndexInformer,
@@ -302,6 +311,7 @@ func NewManager(
 	dynamicInformerFactory dynamicinformer.DynamicSharedInformerFactory,
 	clusterDynamicInformerFactory dynamicinformer.DynamicSharedInformerFactory,
 	istioDynamicInformerFactory dynamicinformer.DynamicSharedInformerFactory,
+	trafficRoutingInformerFactory dynamicinformer.DynamicSharedInformerFactory,
 	namespaced bool,
 	kubeInformerFactory kubeinformers.SharedInformerFactory,
 	replicaSetInformerFactory kubeinformers.SharedInformerFactory,
@@ -309,6 +319,7 @@ func NewManager(
 	ephemeralMetadataThreads int,
 	ephemeralMetadataPodRetries int,
 	selfServiceNotificationEnabled bool,
+	analysisHistorySink history.Sink,
 ) *Manager {
 	runtime.Must(rolloutscheme.AddToScheme(scheme.Scheme))
 	log.Info("Creating event broadcaster")
@@ -322,6 +333,7 @@ func NewManager(
 		ClusterAnalysisTemplateLister: clusterAnalysisTemplateInformer.Lister(),
 		ExperimentLister:              experimentsInformer.Lister(),
 		K8SRequestProvider:            k8sRequestProvider,
+		AnalysisHistory:               analysisHistorySink,
 	})
 
 	healthzServer := NewHealthzServer(fmt.Sprintf(listenAddr, healthzPort))
@@ -358,6 +370,7 @@ func NewManager(
 		IstioPrimaryDynamicClient:       istioPrimaryDynamicClient,
 		IstioVirtualServiceInformer:     istioVirtualServiceInformer,
 		IstioDestinationRuleInformer:    istioDestinationRuleInformer,
+		TrafficRoutingInformerFactory:   trafficRoutingInformerFactory,
 		ReplicaSetInformer:              replicaSetInformer,
 		ServicesInformer:                servicesInformer,
 		IngressWrapper:                  ingressWrap,
@@ -389,15 +402,17 @@ func NewManager(
 	})
 
 	analysisController := analysis.NewController(analysis.ControllerConfig{
-		KubeClientSet:        kubeclientset,
-		ArgoProjClientset:    argoprojclientset,
-		AnalysisRunInformer:  analysisRunInformer,
-		JobInformer:          jobInformer,
-		JobPodsInformer:      jobPodsInformer,
-		ResyncPeriod:         resyncPeriod,
-		AnalysisRunWorkQueue: analysisRunWorkqueue,
-		MetricsServer:        metricsServer,
-		Recorder:             recorder,
+		KubeClientSet:                kubeclientset,
+		ArgoProjClientset:            argoprojclientset,
+		AnalysisRunInformer:          analysisRunInformer,
+		JobInformer:                  jobInformer,
+		JobPodsInformer:              jobPodsInformer,
+		ResyncPeriod:                 resyncPeriod,
+		AnalysisRunWorkQueue:         analysisRunWorkqueue,
+		MetricsServer:                metricsServer,
+		Recorder:                     recorder,
+		MetricProviderConfigInformer: metricProviderConfigInformer,
+		HistorySink:                  analysisHistorySink,
 	})
 
 	serviceController := service.NewController(service.ControllerConfig{
@@ -438,6 +453,7 @@ func NewManager(
 		analysisRunSynced:                    analysisRunInformer.Informer().HasSynced,
 		analysisTemplateSynced:               analysisTemplateInformer.Informer().HasSynced,
 		clusterAnalysisTemplateSynced:        clusterAnalysisTemplateInformer.Informer().HasSynced,
+		metricProviderConfigSynced:           metricProviderConfigInformer.Informer().HasSynced,
 		replicasSetSynced:                    replicaSetInformer.Informer().HasSynced,
 		configMapSynced:                      notificationConfigMapInformerFactory.Core().V1().ConfigMaps().Informer().HasSynced,
 		secretSynced:                         notificationSecretInformerFactory.Core().V1().Secrets().Informer().HasSynced,
@@ -606,7 +622,7 @@ func (c *Manager) startLeading(ctx context.Context, rolloutThreadiness, serviceT
 
 	if c.onlyAnalysisMode {
 		log.Info("Waiting for controller's informer caches to sync")
-		if ok := cache.WaitForCacheSync(ctx.Done(), c.analysisRunSynced, c.analysisTemplateSynced, c.jobSynced, c.jobPodsSynced); !ok {
+		if ok := cache.WaitForCacheSync(ctx.Done(), c.analysisRunSynced, c.analysisTemplateSynced, c.metricProviderConfigSynced, c.jobSynced, c.jobPodsSynced); !ok {
 			log.Fatalf("failed to wait for caches to sync, exiting")
 		}
 		// only wait for cluster scoped informers to sync if we are running in cluster-wide mode
@@ -635,7 +651,7 @@ func (c *Manager) startLeading(ctx context.Context, rolloutThreadiness, serviceT
 
 		// Wait for the caches to be synced before starting workers
 		log.Info("Waiting for controller's informer caches to sync")
-		if ok := cache.WaitForCacheSync(ctx.Done(), c.serviceSynced, c.ingressSynced, c.jobSynced, c.jobPodsSynced, c.rolloutSynced, c.experimentSynced, c.analysisRunSynced, c.analysisTemplateSynced, c.replicasSetSynced, c.configMapSynced, c.secretSynced); !ok {
+		if ok := cache.WaitForCacheSync(ctx.Done(), c.serviceSynced, c.ingressSynced, c.jobSynced, c.jobPodsSynced, c.rolloutSynced, c.experimentSynced, c.analysisRunSynced, c.analysisTemplateSynced, c.metricProviderConfigSynced, c.replicasSetSynced, c.configMapSynced, c.secretSynced); !ok {
 			log.Fatalf("failed to wait for caches to sync, exiting")
 		}
 		// only wait for cluster scoped informers to sync if we are running in cluster-wide mode
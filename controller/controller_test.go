@@ -32,6 +32,8 @@ import (
 	"github.com/argoproj/argo-rollouts/pkg/client/clientset/versioned/fake"
 	informers "github.com/argoproj/argo-rollouts/pkg/client/informers/externalversions"
 	rolloutController "github.com/argoproj/argo-rollouts/rollout"
+	"github.com/argoproj/argo-rollouts/rollout/trafficrouting/ambassador"
+	"github.com/argoproj/argo-rollouts/rollout/trafficrouting/smi"
 	"github.com/argoproj/argo-rollouts/service"
 	ingressutil "github.com/argoproj/argo-rollouts/utils/ingress"
 	istioutil "github.com/argoproj/argo-rollouts/utils/istio"
@@ -75,6 +77,7 @@ func (f *fixture) newManager(t *testing.T) *Manager {
 		analysisRunSynced:                    alwaysReady,
 		analysisTemplateSynced:               alwaysReady,
 		clusterAnalysisTemplateSynced:        alwaysReady,
+		metricProviderConfigSynced:           alwaysReady,
 		serviceSynced:                        alwaysReady,
 		ingressSynced:                        alwaysReady,
 		jobSynced:                            alwaysReady,
@@ -111,9 +114,11 @@ func (f *fixture) newManager(t *testing.T) *Manager {
 	destGVR := istioutil.GetIstioDestinationRuleGVR()
 	scheme := runtime.NewScheme()
 	listMapping := map[schema.GroupVersionResource]string{
-		tgbGVR:  "TargetGroupBindingList",
-		vsvcGVR: vsvcGVR.Resource + "List",
-		destGVR: destGVR.Resource + "List",
+		tgbGVR:                     "TargetGroupBindingList",
+		vsvcGVR:                    vsvcGVR.Resource + "List",
+		destGVR:                    destGVR.Resource + "List",
+		ambassador.GetMappingGVR(): "MappingList",
+		smi.GetTrafficSplitGVR():   "TrafficSplitList",
 	}
 
 	dynamicClient := dynamicfake.NewSimpleDynamicClientWithCustomListKinds(scheme, listMapping)
@@ -149,6 +154,7 @@ func (f *fixture) newManager(t *testing.T) *Manager {
 		IstioPrimaryDynamicClient:       dynamicClient,
 		IstioVirtualServiceInformer:     istioVirtualServiceInformer,
 		IstioDestinationRuleInformer:    istioDestinationRuleInformer,
+		TrafficRoutingInformerFactory:   dynamicInformerFactory,
 		ResyncPeriod:                    noResyncPeriodFunc(),
 		RolloutWorkQueue:                rolloutWorkqueue,
 		ServiceWorkQueue:                serviceWorkqueue,
@@ -158,15 +164,16 @@ func (f *fixture) newManager(t *testing.T) *Manager {
 	})
 
 	cm.analysisController = analysis.NewController(analysis.ControllerConfig{
-		KubeClientSet:        f.kubeclient,
-		ArgoProjClientset:    f.client,
-		AnalysisRunInformer:  i.Argoproj().V1alpha1().AnalysisRuns(),
-		JobInformer:          k8sI.Batch().V1().Jobs(),
-		JobPodsInformer:      k8sI.Core().V1().Pods(),
-		ResyncPeriod:         noResyncPeriodFunc(),
-		AnalysisRunWorkQueue: analysisRunWorkqueue,
-		MetricsServer:        cm.metricsServer,
-		Recorder:             record.NewFakeEventRecorder(),
+		KubeClientSet:                f.kubeclient,
+		ArgoProjClientset:            f.client,
+		AnalysisRunInformer:          i.Argoproj().V1alpha1().AnalysisRuns(),
+		JobInformer:                  k8sI.Batch().V1().Jobs(),
+		JobPodsInformer:              k8sI.Core().V1().Pods(),
+		ResyncPeriod:                 noResyncPeriodFunc(),
+		AnalysisRunWorkQueue:         analysisRunWorkqueue,
+		MetricsServer:                cm.metricsServer,
+		Recorder:                     record.NewFakeEventRecorder(),
+		MetricProviderConfigInformer: i.Argoproj().V1alpha1().MetricProviderConfigs(),
 	})
 
 	cm.ingressController = ingress.NewController(ingress.ControllerConfig{
@@ -260,6 +267,7 @@ func TestNewManager(t *testing.T) {
 				i.Argoproj().V1alpha1().AnalysisRuns(),
 				i.Argoproj().V1alpha1().AnalysisTemplates(),
 				i.Argoproj().V1alpha1().ClusterAnalysisTemplates(),
+				i.Argoproj().V1alpha1().MetricProviderConfigs(),
 				dynamicClient,
 				istioVirtualServiceInformer,
 				istioDestinationRuleInformer,
@@ -275,6 +283,7 @@ func TestNewManager(t *testing.T) {
 				dynamicInformerFactory,
 				nil,
 				nil,
+				dynamicInformerFactory,
 				false,
 				nil,
 				nil,
@@ -282,6 +291,7 @@ func TestNewManager(t *testing.T) {
 				rolloutController.DefaultEphemeralMetadataThreads,
 				rolloutController.DefaultEphemeralMetadataPodRetries,
 				selfService,
+				nil,
 			)
 
 			assert.NotNil(t, cm)
@@ -325,6 +335,7 @@ func TestNewAnalysisManager(t *testing.T) {
 		i.Argoproj().V1alpha1().AnalysisRuns(),
 		i.Argoproj().V1alpha1().AnalysisTemplates(),
 		i.Argoproj().V1alpha1().ClusterAnalysisTemplates(),
+		i.Argoproj().V1alpha1().MetricProviderConfigs(),
 		noResyncPeriodFunc(),
 		8090,
 		8080,
@@ -334,6 +345,7 @@ func TestNewAnalysisManager(t *testing.T) {
 		false,
 		nil,
 		nil,
+		nil,
 	)
 
 	assert.NotNil(t, cm)
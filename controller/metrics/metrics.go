@@ -14,6 +14,7 @@ import (
 	// make sure to register workqueue prometheus metrics
 	_ "k8s.io/component-base/metrics/prometheus/workqueue"
 
+	"github.com/argoproj/argo-rollouts/analysis/history"
 	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
 	rolloutlister "github.com/argoproj/argo-rollouts/pkg/client/listers/rollouts/v1alpha1"
 	"github.com/argoproj/argo-rollouts/utils/log"
@@ -67,6 +68,8 @@ type ServerConfig struct {
 	ClusterAnalysisTemplateLister rolloutlister.ClusterAnalysisTemplateLister
 	ExperimentLister              rolloutlister.ExperimentLister
 	K8SRequestProvider            *K8sRequestsCountProvider
+	// AnalysisHistory, when set, is served at history.ControllerPath
+	AnalysisHistory history.Lister
 }
 
 // NewMetricsServer returns a new prometheus server which collects rollout metrics
@@ -135,6 +138,9 @@ func NewMetricsServer(cfg ServerConfig) *MetricsServer {
 		// contains process, golang and controller workqueues metrics
 		registry.DefaultGatherer,
 	}, promhttp.HandlerOpts{}))
+	if cfg.AnalysisHistory != nil {
+		mux.Handle(history.ControllerPath, http.StripPrefix(history.ControllerPath, history.NewHandler(cfg.AnalysisHistory)))
+	}
 	return &MetricsServer{
 		Server: &http.Server{
 			Addr:    cfg.Addr,
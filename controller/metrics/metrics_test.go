@@ -2,6 +2,7 @@ package metrics
 
 import (
 	"context"
+	"encoding/json"
 	"net/http"
 	"net/http/httptest"
 	"strings"
@@ -16,6 +17,7 @@ import (
 
 	"github.com/prometheus/client_golang/prometheus/testutil"
 
+	"github.com/argoproj/argo-rollouts/analysis/history"
 	"github.com/argoproj/argo-rollouts/utils/defaults"
 
 	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
@@ -598,3 +600,25 @@ func TestEmitRolloutDuration_NilFinishedAt(t *testing.T) {
 	err := testutil.GatherAndCompare(m.registry, strings.NewReader(expected), "rollout_duration_seconds", "rollout_progression_duration_seconds", "rollout_manual_pause_duration_seconds")
 	require.NoError(t, err)
 }
+
+func TestAnalysisHistory(t *testing.T) {
+	sink, err := history.NewDirSink(t.TempDir())
+	require.NoError(t, err)
+	require.NoError(t, sink.Write(history.Record{Namespace: "ns", Name: "checkout-1", Rollout: "checkout", Phase: v1alpha1.AnalysisPhaseSuccessful}))
+
+	req, err := http.NewRequest("GET", history.ControllerPath+"ns/checkout", nil)
+	require.NoError(t, err)
+	rr := httptest.NewRecorder()
+	NewMetricsServer(newFakeServerConfig()).Handler.ServeHTTP(rr, req)
+	assert.Equal(t, http.StatusNotFound, rr.Code)
+
+	cfg := newFakeServerConfig()
+	cfg.AnalysisHistory = sink
+	rr = httptest.NewRecorder()
+	NewMetricsServer(cfg).Handler.ServeHTTP(rr, req)
+	assert.Equal(t, http.StatusOK, rr.Code)
+	var resp history.Response
+	require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &resp))
+	require.Len(t, resp.Items, 1)
+	assert.Equal(t, "checkout-1", resp.Items[0].Name)
+}
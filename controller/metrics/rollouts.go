@@ -114,6 +114,15 @@ func getStrategyAndTrafficRouter(rollout *v1alpha1.Rollout) (string, string) {
 			if rollout.Spec.Strategy.Canary.TrafficRouting.AppMesh != nil {
 				trafficRouter = "AppMesh"
 			}
+			if rollout.Spec.Strategy.Canary.TrafficRouting.Kong != nil {
+				trafficRouter = "Kong"
+			}
+			if rollout.Spec.Strategy.Canary.TrafficRouting.Contour != nil {
+				trafficRouter = "Contour"
+			}
+			if rollout.Spec.Strategy.Canary.TrafficRouting.Consul != nil {
+				trafficRouter = "Consul"
+			}
 		}
 	}
 	return strategy, trafficRouter
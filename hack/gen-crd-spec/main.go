@@ -41,6 +41,7 @@ var crdPaths = map[string]string{
 	"AnalysisTemplate":        "manifests/crds/analysis-template-crd.yaml",
 	"ClusterAnalysisTemplate": "manifests/crds/cluster-analysis-template-crd.yaml",
 	"AnalysisRun":             "manifests/crds/analysis-run-crd.yaml",
+	"MetricProviderConfig":    "manifests/crds/metric-provider-config-crd.yaml",
 }
 
 func setValidationOverride(un *unstructured.Unstructured, fieldOverride map[string]any, path string) {
@@ -103,6 +104,7 @@ func NewCustomResourceDefinition() []*extensionsobj.CustomResourceDefinition {
 	deleteFile("config/crd/argoproj.io_analysistemplates.yaml")
 	deleteFile("config/crd/argoproj.io_clusteranalysistemplates.yaml")
 	deleteFile("config/crd/argoproj.io_experiments.yaml")
+	deleteFile("config/crd/argoproj.io_metricproviderconfigs.yaml")
 	deleteFile("config/crd/argoproj.io_rollouts.yaml")
 	deleteFile("config/crd")
 	deleteFile("config")
@@ -11,6 +11,7 @@ import (
 	log "github.com/sirupsen/logrus"
 
 	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
+	analysisutil "github.com/argoproj/argo-rollouts/utils/analysis"
 	"github.com/argoproj/argo-rollouts/utils/evaluate"
 	metricutil "github.com/argoproj/argo-rollouts/utils/metric"
 	timeutil "github.com/argoproj/argo-rollouts/utils/time"
@@ -91,7 +92,7 @@ func (p *Provider) Run(run *v1alpha1.AnalysisRun, metric v1alpha1.Metric) v1alph
 	}
 	measurement.Value = fmt.Sprintf("%+v", value)
 
-	status, err := evaluate.EvaluateResult(result.MetricDataResults, metric, p.logCtx)
+	status, err := evaluate.EvaluateResultWithHistory(result.MetricDataResults, metric, analysisutil.ArrayMeasurement(run, metric.Name), p.logCtx)
 	if err != nil {
 		return metricutil.MarkMeasurementError(measurement, err)
 	}
@@ -14,6 +14,7 @@ import (
 	"time"
 
 	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
+	analysisutil "github.com/argoproj/argo-rollouts/utils/analysis"
 	"github.com/argoproj/argo-rollouts/utils/defaults"
 	"github.com/argoproj/argo-rollouts/utils/evaluate"
 	metricutil "github.com/argoproj/argo-rollouts/utils/metric"
@@ -321,7 +322,7 @@ func (p *Provider) Run(run *v1alpha1.AnalysisRun, metric v1alpha1.Metric) v1alph
 	}
 	defer response.Body.Close()
 
-	value, status, metadata, err := p.parseResponse(metric, response, dd.ApiVersion)
+	value, status, metadata, err := p.parseResponse(metric, response, dd.ApiVersion, analysisutil.ArrayMeasurement(run, metric.Name))
 	if err != nil {
 		return metricutil.MarkMeasurementError(measurement, err)
 	}
@@ -410,15 +411,15 @@ func (p *Provider) createRequestV2(queries map[string]string, formula string, no
 	return request, nil
 }
 
-func (p *Provider) parseResponse(metric v1alpha1.Metric, response *http.Response, apiVersion string) (string, v1alpha1.AnalysisPhase, map[string]string, error) {
+func (p *Provider) parseResponse(metric v1alpha1.Metric, response *http.Response, apiVersion string, history []v1alpha1.Measurement) (string, v1alpha1.AnalysisPhase, map[string]string, error) {
 	if apiVersion == "v1" {
-		value, phase, err := p.parseResponseV1(metric, response)
+		value, phase, err := p.parseResponseV1(metric, response, history)
 		return value, phase, nil, err
 	}
-	return p.parseResponseV2(metric, response)
+	return p.parseResponseV2(metric, response, history)
 }
 
-func (p *Provider) parseResponseV1(metric v1alpha1.Metric, response *http.Response) (string, v1alpha1.AnalysisPhase, error) {
+func (p *Provider) parseResponseV1(metric v1alpha1.Metric, response *http.Response, history []v1alpha1.Measurement) (string, v1alpha1.AnalysisPhase, error) {
 	bodyBytes, err := io.ReadAll(response.Body)
 	if err != nil {
 		return "", v1alpha1.AnalysisPhaseError, fmt.Errorf("Received no bytes in response: %v", err)
@@ -439,7 +440,7 @@ func (p *Provider) parseResponseV1(metric v1alpha1.Metric, response *http.Respon
 	// Handle an empty query result
 	if len(res.Series) == 0 || len(res.Series[0].Pointlist) == 0 {
 		var nilFloat64 *float64
-		status, err := evaluate.EvaluateResult(nilFloat64, metric, p.logCtx)
+		status, err := evaluate.EvaluateResultWithHistory(nilFloat64, metric, history, p.logCtx)
 		seriesBytes, jsonErr := json.Marshal(res.Series)
 		if jsonErr != nil {
 			return "", v1alpha1.AnalysisPhaseError, fmt.Errorf("Failed to marshall JSON empty series: %v", jsonErr)
@@ -456,11 +457,11 @@ func (p *Provider) parseResponseV1(metric v1alpha1.Metric, response *http.Respon
 	}
 
 	value := datapoint[1]
-	status, err := evaluate.EvaluateResult(value, metric, p.logCtx)
+	status, err := evaluate.EvaluateResultWithHistory(value, metric, history, p.logCtx)
 	return strconv.FormatFloat(value, 'f', -1, 64), status, err
 }
 
-func (p *Provider) parseResponseV2(metric v1alpha1.Metric, response *http.Response) (string, v1alpha1.AnalysisPhase, map[string]string, error) {
+func (p *Provider) parseResponseV2(metric v1alpha1.Metric, response *http.Response, history []v1alpha1.Measurement) (string, v1alpha1.AnalysisPhase, map[string]string, error) {
 	bodyBytes, err := io.ReadAll(response.Body)
 	if err != nil {
 		return "", v1alpha1.AnalysisPhaseError, nil, fmt.Errorf("Received no bytes in response: %v", err)
@@ -491,7 +492,7 @@ func (p *Provider) parseResponseV2(metric v1alpha1.Metric, response *http.Respon
 	// Handle an empty query result
 	if len(values) == 0 {
 		var nilFloat64 *float64
-		status, evalErr := evaluate.EvaluateResult(nilFloat64, metric, p.logCtx)
+		status, evalErr := evaluate.EvaluateResultWithHistory(nilFloat64, metric, history, p.logCtx)
 		return "[]", status, nil, evalErr
 	}
 
@@ -504,11 +505,11 @@ func (p *Provider) parseResponseV2(metric v1alpha1.Metric, response *http.Respon
 	// mirrors the Prometheus provider, which keys off the response type rather
 	// than the number of samples returned.
 	if !grouped {
-		status, evalErr := evaluate.EvaluateResult(values[0], metric, p.logCtx)
+		status, evalErr := evaluate.EvaluateResultWithHistory(values[0], metric, history, p.logCtx)
 		return strconv.FormatFloat(values[0], 'f', -1, 64), status, nil, evalErr
 	}
 
-	status, evalErr := evaluate.EvaluateResult(values, metric, p.logCtx)
+	status, evalErr := evaluate.EvaluateResultWithHistory(values, metric, history, p.logCtx)
 
 	// For grouped queries, surface the (name, value) pairs as JSON so
 	// operators can map an outlier in `result` back to the entity that
@@ -327,7 +327,7 @@ func TestParseResponseV2TruncatesHighCardinalityGroups(t *testing.T) {
 	resp := &http.Response{StatusCode: http.StatusOK, Body: io.NopCloser(strings.NewReader(body))}
 	metric := v1alpha1.Metric{Name: "high-cardinality", SuccessCondition: "max(result) < 1000000"}
 
-	value, _, metadata, err := p.parseResponseV2(metric, resp)
+	value, _, metadata, err := p.parseResponseV2(metric, resp, nil)
 	assert.NoError(t, err)
 	assert.Equal(t, "true", metadata["groups_truncated"])
 
@@ -345,7 +345,7 @@ func TestParseResponseV2BodyReadError(t *testing.T) {
 		StatusCode: http.StatusOK,
 		Body:       io.NopCloser(&errReader{}),
 	}
-	_, phase, _, err := p.parseResponseV2(v1alpha1.Metric{}, resp)
+	_, phase, _, err := p.parseResponseV2(v1alpha1.Metric{}, resp, nil)
 	assert.Equal(t, v1alpha1.AnalysisPhaseError, phase)
 	assert.ErrorContains(t, err, "Received no bytes in response")
 }
@@ -10,6 +10,7 @@ import (
 	log "github.com/sirupsen/logrus"
 
 	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
+	analysisutil "github.com/argoproj/argo-rollouts/utils/analysis"
 	"github.com/argoproj/argo-rollouts/utils/evaluate"
 	metricutil "github.com/argoproj/argo-rollouts/utils/metric"
 	timeutil "github.com/argoproj/argo-rollouts/utils/time"
@@ -70,7 +71,7 @@ func (p *Provider) Run(run *v1alpha1.AnalysisRun, metric v1alpha1.Metric) v1alph
 		return metricutil.MarkMeasurementError(newMeasurement, errors.New("no values found"))
 	}
 
-	newValue, newStatus, err := p.processResponse(metric, result)
+	newValue, newStatus, err := p.processResponse(metric, result, analysisutil.ArrayMeasurement(run, metric.Name))
 	newMeasurement.Value = newValue
 	if err != nil {
 		return metricutil.MarkMeasurementError(newMeasurement, err)
@@ -100,7 +101,7 @@ func (p *Provider) GarbageCollect(run *v1alpha1.AnalysisRun, metric v1alpha1.Met
 	return nil
 }
 
-func (p *Provider) processResponse(metric v1alpha1.Metric, dataPoints []dataPoint) (string, v1alpha1.AnalysisPhase, error) {
+func (p *Provider) processResponse(metric v1alpha1.Metric, dataPoints []dataPoint, history []v1alpha1.Measurement) (string, v1alpha1.AnalysisPhase, error) {
 	results := make([]float64, 0, len(dataPoints))
 	valueStr := "["
 
@@ -117,7 +118,7 @@ func (p *Provider) processResponse(metric v1alpha1.Metric, dataPoints []dataPoin
 	}
 
 	valueStr = valueStr + "]"
-	newStatus, err := evaluate.EvaluateResult(results, metric, p.logCtx)
+	newStatus, err := evaluate.EvaluateResultWithHistory(results, metric, history, p.logCtx)
 
 	return valueStr, newStatus, err
 }
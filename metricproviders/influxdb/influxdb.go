@@ -12,10 +12,10 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/kubernetes"
 
-	"github.com/argoproj/argo-rollouts/utils/defaults"
 	"github.com/argoproj/argo-rollouts/utils/evaluate"
 
 	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
+	analysisutil "github.com/argoproj/argo-rollouts/utils/analysis"
 	metricutil "github.com/argoproj/argo-rollouts/utils/metric"
 )
 
@@ -58,7 +58,7 @@ func (p *Provider) Run(run *v1alpha1.AnalysisRun, metric v1alpha1.Metric) v1alph
 	if err != nil {
 		return metricutil.MarkMeasurementError(newMeasurement, err)
 	}
-	newValue, newStatus, err := p.processResponse(metric, result)
+	newValue, newStatus, err := p.processResponse(metric, result, analysisutil.ArrayMeasurement(run, metric.Name))
 	if err != nil {
 		return metricutil.MarkMeasurementError(newMeasurement, err)
 	}
@@ -87,7 +87,7 @@ func (p *Provider) GarbageCollect(run *v1alpha1.AnalysisRun, metric v1alpha1.Met
 	return nil
 }
 
-func (p *Provider) processResponse(metric v1alpha1.Metric, result *influxapi.QueryTableResult) (string, v1alpha1.AnalysisPhase, error) {
+func (p *Provider) processResponse(metric v1alpha1.Metric, result *influxapi.QueryTableResult, history []v1alpha1.Measurement) (string, v1alpha1.AnalysisPhase, error) {
 	var res []any
 	if result == nil {
 		return "", v1alpha1.AnalysisPhaseError, fmt.Errorf("no QueryTableResult returned from flux query")
@@ -98,7 +98,7 @@ func (p *Provider) processResponse(metric v1alpha1.Metric, result *influxapi.Que
 	if len(res) == 0 {
 		return "", v1alpha1.AnalysisPhaseError, fmt.Errorf("no results returned from flux query")
 	}
-	status, err := evaluate.EvaluateResult(res, metric, p.logCtx)
+	status, err := evaluate.EvaluateResultWithHistory(res, metric, history, p.logCtx)
 	if err != nil {
 		return "", v1alpha1.AnalysisPhaseError, err
 	}
@@ -113,13 +113,12 @@ func NewInfluxdbProvider(api influxapi.QueryAPI, logCtx log.Entry) *Provider {
 	}
 }
 
-// NewInfluxdbAPI generates a Influx API from the metric configuration
-func NewInfluxdbAPI(metric v1alpha1.Metric, kubeclientset kubernetes.Interface) (influxapi.QueryAPI, error) {
+// NewInfluxdbAPI generates a Influx API from the metric configuration and the profile secret in namespace
+func NewInfluxdbAPI(metric v1alpha1.Metric, kubeclientset kubernetes.Interface, ns string) (influxapi.QueryAPI, error) {
 	profileSecret := DefaultInfluxdbTokensSecretName
 	if metric.Provider.Influxdb.Profile != "" {
 		profileSecret = metric.Provider.Influxdb.Profile
 	}
-	ns := defaults.Namespace()
 	secret, err := kubeclientset.CoreV1().Secrets(ns).Get(context.TODO(), profileSecret, metav1.GetOptions{})
 	if err != nil {
 		return nil, err
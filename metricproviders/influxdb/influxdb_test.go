@@ -217,7 +217,7 @@ func TestNewInfluxdbAPI(t *testing.T) {
 			influxdbOrg:     []byte("test-org"),
 			influxdbAddress: []byte("http://localhost:8086"),
 		}
-		_, err := NewInfluxdbAPI(metric, fakeClient)
+		_, err := NewInfluxdbAPI(metric, fakeClient, "argo-rollouts")
 		assert.Nil(t, err)
 	})
 
@@ -225,7 +225,7 @@ func TestNewInfluxdbAPI(t *testing.T) {
 		tokenSecret.Data = map[string][]byte{
 			influxdbToken: []byte("ABCDEFG01234"),
 		}
-		_, err := NewInfluxdbAPI(metric, fakeClient)
+		_, err := NewInfluxdbAPI(metric, fakeClient, "argo-rollouts")
 		assert.EqualError(t, err, "authToken, org, or address not found")
 	})
 
@@ -237,14 +237,14 @@ func TestNewInfluxdbAPI(t *testing.T) {
 			influxdbOrg:     []byte("test-org"),
 			influxdbAddress: []byte("http://localhost:8086"),
 		}
-		_, err := NewInfluxdbAPI(metric, fakeClient)
+		_, err := NewInfluxdbAPI(metric, fakeClient, "argo-rollouts")
 		assert.Nil(t, err)
 	})
 	t.Run("when the secret is not found", func(t *testing.T) {
 		fakeClient.PrependReactor("get", "*", func(action kubetesting.Action) (handled bool, ret runtime.Object, err error) {
 			return true, nil, errors.New("secret not found")
 		})
-		_, err := NewInfluxdbAPI(metric, fakeClient)
+		_, err := NewInfluxdbAPI(metric, fakeClient, "argo-rollouts")
 		assert.NotNil(t, err)
 	})
 }
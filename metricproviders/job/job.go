@@ -222,6 +222,14 @@ func (p *JobProvider) Resume(run *v1alpha1.AnalysisRun, metric v1alpha1.Metric,
 			measurement.Phase = v1alpha1.AnalysisPhaseFailed
 		}
 	}
+	if measurement.Phase == v1alpha1.AnalysisPhaseSuccessful && metric.Provider.Job.Result != nil {
+		value, phase, err := p.evaluateResult(run, metric, job)
+		if err != nil {
+			return metricutil.MarkMeasurementError(measurement, err)
+		}
+		measurement.Value = value
+		measurement.Phase = phase
+	}
 	if measurement.Phase.Completed() {
 		p.logCtx.Infof("job %s/%s completed: %s", job.Namespace, job.Name, measurement.Phase)
 		return measurement
@@ -0,0 +1,167 @@
+package job
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	batchv1 "k8s.io/api/batch/v1"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/util/jsonpath"
+
+	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
+	analysisutil "github.com/argoproj/argo-rollouts/utils/analysis"
+	"github.com/argoproj/argo-rollouts/utils/evaluate"
+)
+
+const (
+	// logResultTailLines is the number of lines at the end of the log searched for the result
+	logResultTailLines int64 = 100
+	// logResultLimitBytes bounds the size of the log read to search for the result
+	logResultLimitBytes int64 = 1024 * 1024
+)
+
+// evaluateResult reads the result of a completed job from its pod and evaluates it with the success and
+// failure conditions of the metric. It returns the measurement value and phase.
+func (p *JobProvider) evaluateResult(run *v1alpha1.AnalysisRun, metric v1alpha1.Metric, job *batchv1.Job) (string, v1alpha1.AnalysisPhase, error) {
+	spec := metric.Provider.Job.Result
+	pod, err := p.getSucceededPod(job)
+	if err != nil {
+		return "", v1alpha1.AnalysisPhaseError, err
+	}
+	container := spec.Container
+	if container == "" && len(pod.Spec.Containers) > 0 {
+		container = pod.Spec.Containers[0].Name
+	}
+
+	var raw string
+	switch spec.Source {
+	case v1alpha1.JobResultSourceTerminationMessage:
+		raw, err = getTerminationMessage(pod, container)
+	case v1alpha1.JobResultSourceLog:
+		raw, err = p.getLogResult(pod, container, spec.LogPrefix)
+	default:
+		err = fmt.Errorf("unsupported job result source '%s'", spec.Source)
+	}
+	if err != nil {
+		return "", v1alpha1.AnalysisPhaseError, err
+	}
+
+	value, valueStr, err := parseResult(raw, spec.JSONPath)
+	if err != nil {
+		return "", v1alpha1.AnalysisPhaseError, err
+	}
+	phase, err := evaluate.EvaluateResultWithHistory(value, metric, analysisutil.ArrayMeasurement(run, metric.Name), p.logCtx)
+	return valueStr, phase, err
+}
+
+// getSucceededPod returns the most recently started pod of the job which succeeded
+func (p *JobProvider) getSucceededPod(job *batchv1.Job) (*v1.Pod, error) {
+	selector, err := metav1.LabelSelectorAsSelector(job.Spec.Selector)
+	if err != nil {
+		return nil, err
+	}
+	pods, err := p.podLister.Pods(job.Namespace).List(selector)
+	if err != nil {
+		return nil, err
+	}
+	var succeeded []*v1.Pod
+	for _, pod := range pods {
+		if pod.Status.Phase == v1.PodSucceeded {
+			succeeded = append(succeeded, pod)
+		}
+	}
+	if len(succeeded) == 0 {
+		return nil, fmt.Errorf("job %s/%s has no succeeded pod to read the result from", job.Namespace, job.Name)
+	}
+	sort.Slice(succeeded, func(i, j int) bool {
+		return succeeded[i].CreationTimestamp.Before(&succeeded[j].CreationTimestamp)
+	})
+	return succeeded[len(succeeded)-1], nil
+}
+
+// getTerminationMessage returns the termination message of the container
+func getTerminationMessage(pod *v1.Pod, container string) (string, error) {
+	for _, cs := range pod.Status.ContainerStatuses {
+		if cs.Name != container {
+			continue
+		}
+		if cs.State.Terminated == nil || strings.TrimSpace(cs.State.Terminated.Message) == "" {
+			return "", fmt.Errorf("container %s of pod %s has no termination message", container, pod.Name)
+		}
+		return strings.TrimSpace(cs.State.Terminated.Message), nil
+	}
+	return "", fmt.Errorf("container %s not found in pod %s", container, pod.Name)
+}
+
+// getLogResult returns the result written by the container in the last lines of its log
+func (p *JobProvider) getLogResult(pod *v1.Pod, container string, prefix string) (string, error) {
+	tailLines, limitBytes := logResultTailLines, logResultLimitBytes
+	logOptions := &v1.PodLogOptions{
+		Container:  container,
+		TailLines:  &tailLines,
+		LimitBytes: &limitBytes,
+	}
+	logs, err := p.kubeclientset.CoreV1().Pods(pod.Namespace).GetLogs(pod.Name, logOptions).DoRaw(context.TODO())
+	if err != nil {
+		return "", fmt.Errorf("failed to get the log of container %s of pod %s: %w", container, pod.Name, err)
+	}
+	result, ok := findLogResult(string(logs), prefix)
+	if !ok {
+		return "", fmt.Errorf("no result found in the log of container %s of pod %s", container, pod.Name)
+	}
+	return result, nil
+}
+
+// findLogResult returns the last line of logs starting with prefix, without the prefix, or the last line
+// which is valid JSON when there is no prefix
+func findLogResult(logs string, prefix string) (string, bool) {
+	lines := strings.Split(logs, "\n")
+	for i := len(lines) - 1; i >= 0; i-- {
+		line := strings.TrimSpace(lines[i])
+		if prefix != "" {
+			if strings.HasPrefix(line, prefix) {
+				return strings.TrimSpace(strings.TrimPrefix(line, prefix)), true
+			}
+			continue
+		}
+		if line != "" && json.Valid([]byte(line)) {
+			return line, true
+		}
+	}
+	return "", false
+}
+
+// parseResult parses the raw JSON result and extracts the value at jsonPath. Results which are not JSON are
+// evaluated as strings.
+func parseResult(raw string, jsonPath string) (any, string, error) {
+	var data any
+	if err := json.Unmarshal([]byte(raw), &data); err != nil {
+		if jsonPath != "" {
+			return nil, "", fmt.Errorf("job result is not valid JSON: %v", err)
+		}
+		return raw, raw, nil
+	}
+	if jsonPath == "" {
+		return data, raw, nil
+	}
+	parser := jsonpath.New("result")
+	if err := parser.Parse(jsonPath); err != nil {
+		return nil, "", err
+	}
+	fullResults, err := parser.FindResults(data)
+	if err != nil {
+		return nil, "", fmt.Errorf("Could not find JSONPath in job result: %s", err)
+	}
+	for _, results := range fullResults {
+		for _, r := range results {
+			val := r.Interface()
+			valBytes, err := json.Marshal(val)
+			return val, string(valBytes), err
+		}
+	}
+	return nil, "", fmt.Errorf("JSONPath %s produced no value from job result", jsonPath)
+}
@@ -0,0 +1,164 @@
+package job
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8sfake "k8s.io/client-go/kubernetes/fake"
+	k8stesting "k8s.io/client-go/testing"
+
+	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
+)
+
+func newCompletedJobWithPod(run *v1alpha1.AnalysisRun, message string) (*batchv1.Job, *corev1.Pod) {
+	job := newJob(run, batchv1.JobComplete)
+	job.Spec.Selector = &metav1.LabelSelector{
+		MatchLabels: map[string]string{"job-name": job.Name},
+	}
+	pod := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "succeeded-pod",
+			Namespace: job.Namespace,
+			Labels:    map[string]string{"job-name": job.Name},
+		},
+		Spec: corev1.PodSpec{
+			Containers: []corev1.Container{{Name: "dummy"}},
+		},
+		Status: corev1.PodStatus{
+			Phase: corev1.PodSucceeded,
+			ContainerStatuses: []corev1.ContainerStatus{
+				{
+					Name: "dummy",
+					State: corev1.ContainerState{
+						Terminated: &corev1.ContainerStateTerminated{Message: message},
+					},
+				},
+			},
+		},
+	}
+	return job, pod
+}
+
+func TestResumeCompletedJobWithTerminationMessageResult(t *testing.T) {
+	run := newRunWithJobMetric()
+	run.Spec.Metrics[0].Provider.Job.Result = &v1alpha1.JobMetricResult{
+		Source: v1alpha1.JobResultSourceTerminationMessage,
+	}
+	run.Spec.Metrics[0].SuccessCondition = "result.p99 < 300 && result.errorRate < 0.01"
+	job, pod := newCompletedJobWithPod(run, `{"p99": 250, "errorRate": 0.001}`)
+	p := newTestJobProvider(job, pod)
+
+	measurement := p.Resume(run, run.Spec.Metrics[0], newRunningMeasurement(job.Name))
+	assert.Equal(t, v1alpha1.AnalysisPhaseSuccessful, measurement.Phase)
+	assert.Equal(t, `{"p99": 250, "errorRate": 0.001}`, measurement.Value)
+	assert.NotNil(t, measurement.FinishedAt)
+
+	run.Spec.Metrics[0].SuccessCondition = "result.p99 < 200"
+	measurement = p.Resume(run, run.Spec.Metrics[0], newRunningMeasurement(job.Name))
+	assert.Equal(t, v1alpha1.AnalysisPhaseFailed, measurement.Phase)
+}
+
+func TestResumeCompletedJobWithJSONPathResult(t *testing.T) {
+	run := newRunWithJobMetric()
+	run.Spec.Metrics[0].Provider.Job.Result = &v1alpha1.JobMetricResult{
+		Source:    v1alpha1.JobResultSourceTerminationMessage,
+		Container: "dummy",
+		JSONPath:  "{$.latency.p99}",
+	}
+	run.Spec.Metrics[0].SuccessCondition = "result < 300"
+	job, pod := newCompletedJobWithPod(run, `{"latency": {"p99": 250}}`)
+	p := newTestJobProvider(job, pod)
+
+	measurement := p.Resume(run, run.Spec.Metrics[0], newRunningMeasurement(job.Name))
+	assert.Equal(t, v1alpha1.AnalysisPhaseSuccessful, measurement.Phase)
+	assert.Equal(t, "250", measurement.Value)
+}
+
+func TestResumeCompletedJobWithMissingResult(t *testing.T) {
+	run := newRunWithJobMetric()
+	run.Spec.Metrics[0].Provider.Job.Result = &v1alpha1.JobMetricResult{
+		Source: v1alpha1.JobResultSourceTerminationMessage,
+	}
+	job, pod := newCompletedJobWithPod(run, "")
+	p := newTestJobProvider(job, pod)
+	measurement := p.Resume(run, run.Spec.Metrics[0], newRunningMeasurement(job.Name))
+	assert.Equal(t, v1alpha1.AnalysisPhaseError, measurement.Phase)
+	assert.Equal(t, "container dummy of pod succeeded-pod has no termination message", measurement.Message)
+
+	// the log of the fake client contains no result
+	run.Spec.Metrics[0].Provider.Job.Result.Source = v1alpha1.JobResultSourceLog
+	measurement = p.Resume(run, run.Spec.Metrics[0], newRunningMeasurement(job.Name))
+	assert.Equal(t, v1alpha1.AnalysisPhaseError, measurement.Phase)
+	assert.Equal(t, "no result found in the log of container dummy of pod succeeded-pod", measurement.Message)
+	// only the end of the log is read
+	var logOptions *corev1.PodLogOptions
+	for _, action := range p.kubeclientset.(*k8sfake.Clientset).Actions() {
+		if action.GetSubresource() == "log" {
+			logOptions = action.(k8stesting.GenericAction).GetValue().(*corev1.PodLogOptions)
+		}
+	}
+	if assert.NotNil(t, logOptions) {
+		assert.Equal(t, logResultTailLines, *logOptions.TailLines)
+		assert.Equal(t, logResultLimitBytes, *logOptions.LimitBytes)
+	}
+
+	// without a succeeded pod
+	p = newTestJobProvider(job)
+	measurement = p.Resume(run, run.Spec.Metrics[0], newRunningMeasurement(job.Name))
+	assert.Equal(t, v1alpha1.AnalysisPhaseError, measurement.Phase)
+	assert.Equal(t, "job dummynamespace/dummyrun-metric-abc123 has no succeeded pod to read the result from", measurement.Message)
+}
+
+func TestResumeFailedJobIgnoresResult(t *testing.T) {
+	run := newRunWithJobMetric()
+	run.Spec.Metrics[0].Provider.Job.Result = &v1alpha1.JobMetricResult{
+		Source: v1alpha1.JobResultSourceTerminationMessage,
+	}
+	job := newJob(run, batchv1.JobFailed)
+	p := newTestJobProvider(job)
+	measurement := p.Resume(run, run.Spec.Metrics[0], newRunningMeasurement(job.Name))
+	assert.Equal(t, v1alpha1.AnalysisPhaseFailed, measurement.Phase)
+	assert.Empty(t, measurement.Value)
+}
+
+func TestFindLogResult(t *testing.T) {
+	logs := `starting load test
+{"p99": 120}
+RESULT: {"p99": 250}
+{"p99": 300}
+done
+`
+	result, ok := findLogResult(logs, "")
+	assert.True(t, ok)
+	assert.Equal(t, `{"p99": 300}`, result)
+
+	result, ok = findLogResult(logs, "RESULT:")
+	assert.True(t, ok)
+	assert.Equal(t, `{"p99": 250}`, result)
+
+	_, ok = findLogResult("no json here\n", "")
+	assert.False(t, ok)
+	_, ok = findLogResult(logs, "SUMMARY:")
+	assert.False(t, ok)
+}
+
+func TestParseResult(t *testing.T) {
+	value, valueStr, err := parseResult("42", "")
+	assert.NoError(t, err)
+	assert.Equal(t, float64(42), value)
+	assert.Equal(t, "42", valueStr)
+
+	value, valueStr, err = parseResult("all tests passed", "")
+	assert.NoError(t, err)
+	assert.Equal(t, "all tests passed", value)
+	assert.Equal(t, "all tests passed", valueStr)
+
+	_, _, err = parseResult("all tests passed", "{$.p99}")
+	assert.Error(t, err)
+
+	_, _, err = parseResult(`{"p50": 10}`, "{$.p99}")
+	assert.Error(t, err)
+}
@@ -11,13 +11,17 @@ import (
 	"github.com/argoproj/argo-rollouts/metric"
 	"github.com/argoproj/argo-rollouts/metricproviders/influxdb"
 	"github.com/argoproj/argo-rollouts/metricproviders/skywalking"
+	"github.com/argoproj/argo-rollouts/metricproviders/sloburnrate"
 
 	"github.com/argoproj/argo-rollouts/metricproviders/cloudwatch"
 	"github.com/argoproj/argo-rollouts/metricproviders/datadog"
 	"github.com/argoproj/argo-rollouts/metricproviders/graphite"
 	"github.com/argoproj/argo-rollouts/metricproviders/kayenta"
 	"github.com/argoproj/argo-rollouts/metricproviders/newrelic"
+	"github.com/argoproj/argo-rollouts/metricproviders/opensearch"
 	"github.com/argoproj/argo-rollouts/metricproviders/plugin"
+	"github.com/argoproj/argo-rollouts/metricproviders/probe"
+	"github.com/argoproj/argo-rollouts/metricproviders/sqlmetric"
 	"github.com/argoproj/argo-rollouts/metricproviders/wavefront"
 	"github.com/argoproj/argo-rollouts/metricproviders/webmetric"
 
@@ -27,8 +31,11 @@ import (
 
 	"github.com/argoproj/argo-rollouts/metricproviders/job"
 	"github.com/argoproj/argo-rollouts/metricproviders/prometheus"
+	"github.com/argoproj/argo-rollouts/metricproviders/querycache"
 
 	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
+	listers "github.com/argoproj/argo-rollouts/pkg/client/listers/rollouts/v1alpha1"
+	"github.com/argoproj/argo-rollouts/utils/defaults"
 )
 
 const (
@@ -41,19 +48,47 @@ type ProviderFactory struct {
 	KubeClient    kubernetes.Interface
 	JobLister     batchlisters.JobLister
 	JobPodsLister coreListers.PodLister
+	// MetricProviderConfigLister is used to get the MetricProviderConfigs referenced by metrics
+	MetricProviderConfigLister listers.MetricProviderConfigLister
+	// QueryCache, if set, is shared by the providers which support it to deduplicate, cache and rate limit
+	// the queries sent to metric backends
+	QueryCache *querycache.Cache
 }
 
 type ProviderFactoryFunc func(logCtx log.Entry, metric v1alpha1.Metric) (metric.Provider, error)
 
 // NewProvider creates the correct provider based on the provider type of the Metric
 func (f *ProviderFactory) NewProvider(logCtx log.Entry, namespace string, metric v1alpha1.Metric) (metric.Provider, error) {
+	// profile secrets are read from the controller namespace, unless the metric references a
+	// MetricProviderConfig which is resolved in the namespace of the AnalysisRun
+	secretNamespace := defaults.Namespace()
+	if metric.Provider.ConfigRef != nil {
+		resolved, err := f.resolveMetricProviderConfig(namespace, metric)
+		if err != nil {
+			return nil, err
+		}
+		metric = resolved
+		secretNamespace = namespace
+	}
 	switch provider := Type(metric); provider {
 	case prometheus.ProviderType:
 		api, err := prometheus.NewPrometheusAPI(metric)
 		if err != nil {
 			return nil, err
 		}
+		if f.QueryCache != nil {
+			api = prometheus.NewCachedAPI(api, *metric.Provider.Prometheus, f.QueryCache)
+		}
 		return prometheus.NewPrometheusProvider(api, logCtx, metric)
+	case sloburnrate.ProviderType:
+		api, err := sloburnrate.NewPrometheusAPI(metric)
+		if err != nil {
+			return nil, err
+		}
+		if f.QueryCache != nil {
+			api = prometheus.NewCachedAPI(api, metric.Provider.SLOBurnRate.Prometheus, f.QueryCache)
+		}
+		return sloburnrate.NewSLOBurnRateProvider(api, logCtx, metric)
 	case job.ProviderType:
 		kubeClient, customKubeconfig, err := GetAnalysisJobClientset(f.KubeClient)
 		if err != nil {
@@ -65,7 +100,7 @@ func (f *ProviderFactory) NewProvider(logCtx log.Entry, namespace string, metric
 		c := kayenta.NewHttpClient()
 		return kayenta.NewKayentaProvider(logCtx, c), nil
 	case webmetric.ProviderType:
-		c, err := webmetric.NewWebMetricHttpClient(metric)
+		c, err := webmetric.NewWebMetricHttpClient(metric, f.KubeClient, namespace)
 		if err != nil {
 			return nil, err
 		}
@@ -83,7 +118,7 @@ func (f *ProviderFactory) NewProvider(logCtx log.Entry, namespace string, metric
 		}
 		return wavefront.NewWavefrontProvider(client, logCtx), nil
 	case newrelic.ProviderType:
-		client, err := newrelic.NewNewRelicAPIClient(metric, f.KubeClient)
+		client, err := newrelic.NewNewRelicAPIClient(metric, f.KubeClient, secretNamespace)
 		if err != nil {
 			return nil, err
 		}
@@ -101,7 +136,7 @@ func (f *ProviderFactory) NewProvider(logCtx log.Entry, namespace string, metric
 		}
 		return cloudwatch.NewCloudWatchProvider(client, logCtx), nil
 	case influxdb.ProviderType:
-		client, err := influxdb.NewInfluxdbAPI(metric, f.KubeClient)
+		client, err := influxdb.NewInfluxdbAPI(metric, f.KubeClient, secretNamespace)
 		if err != nil {
 			return nil, err
 		}
@@ -112,6 +147,12 @@ func (f *ProviderFactory) NewProvider(logCtx log.Entry, namespace string, metric
 			return nil, err
 		}
 		return skywalking.NewSkyWalkingProvider(client, logCtx), nil
+	case opensearch.ProviderType:
+		return opensearch.NewOpenSearchProvider(logCtx, f.KubeClient, namespace, metric)
+	case sqlmetric.ProviderType:
+		return sqlmetric.NewSQLProvider(logCtx, f.KubeClient, namespace, metric)
+	case probe.ProviderType:
+		return probe.NewProbeProvider(logCtx, metric)
 	case plugin.ProviderType:
 		plugin, err := plugin.NewRpcPlugin(metric)
 		if err != nil {
@@ -146,6 +187,14 @@ func Type(metric v1alpha1.Metric) string {
 		return influxdb.ProviderType
 	} else if metric.Provider.SkyWalking != nil {
 		return skywalking.ProviderType
+	} else if metric.Provider.SLOBurnRate != nil {
+		return sloburnrate.ProviderType
+	} else if metric.Provider.OpenSearch != nil {
+		return opensearch.ProviderType
+	} else if metric.Provider.SQL != nil {
+		return sqlmetric.ProviderType
+	} else if metric.Provider.Probe != nil {
+		return probe.ProviderType
 	} else if metric.Provider.Plugin != nil {
 		return plugin.ProviderType
 	}
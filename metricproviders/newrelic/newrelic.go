@@ -14,7 +14,7 @@ import (
 	"k8s.io/client-go/kubernetes"
 
 	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
-	"github.com/argoproj/argo-rollouts/utils/defaults"
+	analysisutil "github.com/argoproj/argo-rollouts/utils/analysis"
 	"github.com/argoproj/argo-rollouts/utils/evaluate"
 	metricutil "github.com/argoproj/argo-rollouts/utils/metric"
 	timeutil "github.com/argoproj/argo-rollouts/utils/time"
@@ -117,7 +117,7 @@ func (p *Provider) Run(run *v1alpha1.AnalysisRun, metric v1alpha1.Metric) v1alph
 		return metricutil.MarkMeasurementError(newMeasurement, err)
 	}
 
-	valueStr, newStatus, err := p.processResponse(metric, results)
+	valueStr, newStatus, err := p.processResponse(metric, results, analysisutil.ArrayMeasurement(run, metric.Name))
 	if err != nil {
 		return metricutil.MarkMeasurementError(newMeasurement, err)
 	}
@@ -137,7 +137,7 @@ func toJSONString(v any) (string, error) {
 	return string(b), nil
 }
 
-func (p *Provider) processResponse(metric v1alpha1.Metric, results []nrdb.NRDBResult) (string, v1alpha1.AnalysisPhase, error) {
+func (p *Provider) processResponse(metric v1alpha1.Metric, results []nrdb.NRDBResult, history []v1alpha1.Measurement) (string, v1alpha1.AnalysisPhase, error) {
 	if len(results) == 1 {
 		result := results[0]
 		if len(result) == 0 {
@@ -147,14 +147,14 @@ func (p *Provider) processResponse(metric v1alpha1.Metric, results []nrdb.NRDBRe
 		if err != nil {
 			return "", v1alpha1.AnalysisPhaseError, fmt.Errorf("could not marshal results: %w", err)
 		}
-		newStatus, err := evaluate.EvaluateResult(result, metric, p.logCtx)
+		newStatus, err := evaluate.EvaluateResultWithHistory(result, metric, history, p.logCtx)
 		return valueStr, newStatus, err
 	} else if len(results) > 1 {
 		valueStr, err := toJSONString(results)
 		if err != nil {
 			return "", v1alpha1.AnalysisPhaseError, fmt.Errorf("could not marshal results: %w", err)
 		}
-		newStatus, err := evaluate.EvaluateResult(results, metric, p.logCtx)
+		newStatus, err := evaluate.EvaluateResultWithHistory(results, metric, history, p.logCtx)
 		return valueStr, newStatus, err
 	} else {
 		return "", v1alpha1.AnalysisPhaseFailed, fmt.Errorf("no results returned from NRQL query")
@@ -199,9 +199,8 @@ func NewNewRelicProvider(api NewRelicClientAPI, logCtx log.Entry) *Provider {
 	}
 }
 
-// NewNewRelicAPIClient creates a new NewRelic API client from metric configuration
-func NewNewRelicAPIClient(metric v1alpha1.Metric, kubeclientset kubernetes.Interface) (NewRelicClientAPI, error) {
-	ns := defaults.Namespace()
+// NewNewRelicAPIClient creates a new NewRelic API client from metric configuration and the profile secret in namespace
+func NewNewRelicAPIClient(metric v1alpha1.Metric, kubeclientset kubernetes.Interface, ns string) (NewRelicClientAPI, error) {
 	profileSecret := DefaultNewRelicProfileSecretName
 	if metric.Provider.NewRelic.Profile != "" {
 		profileSecret = metric.Provider.NewRelic.Profile
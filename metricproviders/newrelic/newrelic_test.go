@@ -339,7 +339,7 @@ func TestNewNewRelicAPIClient(t *testing.T) {
 			"personal-api-key": []byte("ABCDEFG01234"),
 			"account-id":       []byte("12345"),
 		}
-		_, err := NewNewRelicAPIClient(metric, fakeClient)
+		_, err := NewNewRelicAPIClient(metric, fakeClient, "argo-rollouts")
 		assert.Nil(t, err)
 	})
 
@@ -349,7 +349,7 @@ func TestNewNewRelicAPIClient(t *testing.T) {
 			"account-id":       []byte("12345"),
 			"region":           []byte("eu"),
 		}
-		_, err := NewNewRelicAPIClient(metric, fakeClient)
+		_, err := NewNewRelicAPIClient(metric, fakeClient, "argo-rollouts")
 		assert.Nil(t, err)
 	})
 	t.Run("when the region is invalid", func(t *testing.T) {
@@ -358,7 +358,7 @@ func TestNewNewRelicAPIClient(t *testing.T) {
 			"account-id":       []byte("12345"),
 			"region":           []byte("prod"),
 		}
-		_, err := NewNewRelicAPIClient(metric, fakeClient)
+		_, err := NewNewRelicAPIClient(metric, fakeClient, "argo-rollouts")
 		// client defaults to US when not set or set to something incorrect, does not error
 		assert.Nil(t, err)
 	})
@@ -370,7 +370,7 @@ func TestNewNewRelicAPIClient(t *testing.T) {
 			"base-url-rest":      []byte("example.com/api/v2"),
 			"base-url-nerdgraph": []byte("example.com/query"),
 		}
-		_, err := NewNewRelicAPIClient(metric, fakeClient)
+		_, err := NewNewRelicAPIClient(metric, fakeClient, "argo-rollouts")
 
 		assert.Nil(t, err)
 	})
@@ -378,7 +378,7 @@ func TestNewNewRelicAPIClient(t *testing.T) {
 		tokenSecret.Data = map[string][]byte{
 			"personal-api-key": []byte("ABCDEFG01234"),
 		}
-		_, err := NewNewRelicAPIClient(metric, fakeClient)
+		_, err := NewNewRelicAPIClient(metric, fakeClient, "argo-rollouts")
 		assert.EqualError(t, err, "account ID or personal API key not found")
 	})
 	t.Run("with a non-integer account ID", func(t *testing.T) {
@@ -386,7 +386,7 @@ func TestNewNewRelicAPIClient(t *testing.T) {
 			"personal-api-key": []byte("ABCDEFG01234"),
 			"account-id":       []byte("abcdef"),
 		}
-		_, err := NewNewRelicAPIClient(metric, fakeClient)
+		_, err := NewNewRelicAPIClient(metric, fakeClient, "argo-rollouts")
 		assert.NotNil(t, err)
 	})
 	t.Run("when secretName is specified by the metric", func(t *testing.T) {
@@ -396,14 +396,14 @@ func TestNewNewRelicAPIClient(t *testing.T) {
 			"personal-api-key": []byte("ABCDEFG01234"),
 			"account-id":       []byte("12345"),
 		}
-		_, err := NewNewRelicAPIClient(metric, fakeClient)
+		_, err := NewNewRelicAPIClient(metric, fakeClient, "argo-rollouts")
 		assert.Nil(t, err)
 	})
 	t.Run("when the secret is not found", func(t *testing.T) {
 		fakeClient.PrependReactor("get", "*", func(action kubetesting.Action) (handled bool, ret runtime.Object, err error) {
 			return true, nil, errors.New("secret not found")
 		})
-		_, err := NewNewRelicAPIClient(metric, fakeClient)
+		_, err := NewNewRelicAPIClient(metric, fakeClient, "argo-rollouts")
 		assert.NotNil(t, err)
 	})
 }
@@ -0,0 +1,302 @@
+package opensearch
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+
+	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
+	analysisutil "github.com/argoproj/argo-rollouts/utils/analysis"
+	"github.com/argoproj/argo-rollouts/utils/defaults"
+	"github.com/argoproj/argo-rollouts/utils/evaluate"
+	metricutil "github.com/argoproj/argo-rollouts/utils/metric"
+	timeutil "github.com/argoproj/argo-rollouts/utils/time"
+)
+
+const (
+	// ProviderType indicates the provider is OpenSearch
+	ProviderType = "OpenSearch"
+	// OpenSearchSecretName is the name of the secret looked up in the controller namespace when the metric
+	// references no secret
+	OpenSearchSecretName = "opensearch"
+	OpenSearchAddress    = "address"
+	OpenSearchUsername   = "username"
+	OpenSearchPassword   = "password"
+	OpenSearchApiKey     = "api-key"
+
+	// DefaultDSLValuePath is the path of the number of matching documents in a search response
+	DefaultDSLValuePath = "hits.total.value"
+	// DefaultSQLValuePath is the path of the first column of the first row in a SQL response
+	DefaultSQLValuePath = "datarows.0.0"
+
+	sqlEndpoint = "/_plugins/_sql"
+)
+
+// Provider contains all the required components to run an OpenSearch query
+// Implements the Provider Interface
+type Provider struct {
+	logCtx log.Entry
+	config openSearchConfig
+	client *http.Client
+}
+
+type openSearchConfig struct {
+	Address  string
+	Username string
+	Password string
+	ApiKey   string
+}
+
+// Type indicates provider is an OpenSearch provider
+func (p *Provider) Type() string {
+	return ProviderType
+}
+
+// GetMetadata returns any additional metadata which needs to be stored & displayed as part of the metrics result.
+func (p *Provider) GetMetadata(metric v1alpha1.Metric) map[string]string {
+	return nil
+}
+
+func (p *Provider) Run(run *v1alpha1.AnalysisRun, metric v1alpha1.Metric) v1alpha1.Measurement {
+	startTime := timeutil.MetaNow()
+	measurement := v1alpha1.Measurement{
+		StartedAt: &startTime,
+	}
+
+	request, err := p.newRequest(metric.Provider.OpenSearch)
+	if err != nil {
+		return metricutil.MarkMeasurementError(measurement, err)
+	}
+	response, err := p.client.Do(request)
+	if err != nil {
+		return metricutil.MarkMeasurementError(measurement, err)
+	}
+	defer response.Body.Close()
+
+	value, status, err := p.parseResponse(metric, response, analysisutil.ArrayMeasurement(run, metric.Name))
+	if err != nil {
+		return metricutil.MarkMeasurementError(measurement, err)
+	}
+
+	measurement.Value = value
+	measurement.Phase = status
+	finishedTime := timeutil.MetaNow()
+	measurement.FinishedAt = &finishedTime
+	return measurement
+}
+
+func (p *Provider) newRequest(spec *v1alpha1.OpenSearchMetric) (*http.Request, error) {
+	address := strings.TrimSuffix(p.config.Address, "/")
+	var endpoint string
+	var body []byte
+	if spec.Language == v1alpha1.OpenSearchQueryLanguageSQL {
+		endpoint = address + sqlEndpoint
+		body, _ = json.Marshal(map[string]string{"query": spec.Query})
+	} else {
+		endpoint = address + "/" + url.PathEscape(spec.Index) + "/_search"
+		body = []byte(spec.Query)
+	}
+
+	request, err := http.NewRequest(http.MethodPost, endpoint, bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	request.Header.Set("Content-Type", "application/json")
+	if p.config.ApiKey != "" {
+		request.Header.Set("Authorization", "ApiKey "+p.config.ApiKey)
+	} else if p.config.Username != "" {
+		request.SetBasicAuth(p.config.Username, p.config.Password)
+	}
+	return request, nil
+}
+
+func (p *Provider) parseResponse(metric v1alpha1.Metric, response *http.Response, history []v1alpha1.Measurement) (string, v1alpha1.AnalysisPhase, error) {
+	bodyBytes, err := io.ReadAll(response.Body)
+	if err != nil {
+		return "", v1alpha1.AnalysisPhaseError, fmt.Errorf("Received no bytes in response: %v", err)
+	}
+	if response.StatusCode < 200 || response.StatusCode >= 300 {
+		return "", v1alpha1.AnalysisPhaseError, fmt.Errorf("received non 2xx response code: %v %s", response.StatusCode, string(bodyBytes))
+	}
+
+	var data any
+	if err := json.Unmarshal(bodyBytes, &data); err != nil {
+		return "", v1alpha1.AnalysisPhaseError, fmt.Errorf("Could not parse JSON body: %v", err)
+	}
+	value, err := lookupPath(data, valuePath(metric.Provider.OpenSearch))
+	if err != nil {
+		return "", v1alpha1.AnalysisPhaseError, err
+	}
+	valueBytes, err := json.Marshal(value)
+	if err != nil {
+		return "", v1alpha1.AnalysisPhaseError, err
+	}
+
+	status, err := evaluate.EvaluateResultWithHistory(value, metric, history, p.logCtx)
+	return string(valueBytes), status, err
+}
+
+func valuePath(spec *v1alpha1.OpenSearchMetric) string {
+	switch {
+	case spec.ValuePath != "":
+		return spec.ValuePath
+	case spec.Language == v1alpha1.OpenSearchQueryLanguageSQL:
+		return DefaultSQLValuePath
+	default:
+		return DefaultDSLValuePath
+	}
+}
+
+// lookupPath returns the value at the dot separated path in data. Numeric path elements index arrays.
+func lookupPath(data any, path string) (any, error) {
+	value := data
+	for _, key := range strings.Split(path, ".") {
+		switch v := value.(type) {
+		case map[string]any:
+			child, ok := v[key]
+			if !ok {
+				return nil, fmt.Errorf("value path %s not found in response: no key %s", path, key)
+			}
+			value = child
+		case []any:
+			i, err := strconv.Atoi(key)
+			if err != nil || i < 0 || i >= len(v) {
+				return nil, fmt.Errorf("value path %s not found in response: no index %s", path, key)
+			}
+			value = v[i]
+		default:
+			return nil, fmt.Errorf("value path %s not found in response: %s is not an object or array", path, key)
+		}
+	}
+	return value, nil
+}
+
+// Resume should not be used the OpenSearch provider since all the work should occur in the Run method
+func (p *Provider) Resume(run *v1alpha1.AnalysisRun, metric v1alpha1.Metric, measurement v1alpha1.Measurement) v1alpha1.Measurement {
+	p.logCtx.Warn("OpenSearch provider should not execute the Resume method")
+	return measurement
+}
+
+// Terminate should not be used the OpenSearch provider since all the work should occur in the Run method
+func (p *Provider) Terminate(run *v1alpha1.AnalysisRun, metric v1alpha1.Metric, measurement v1alpha1.Measurement) v1alpha1.Measurement {
+	p.logCtx.Warn("OpenSearch provider should not execute the Terminate method")
+	return measurement
+}
+
+// GarbageCollect is a no-op for the OpenSearch provider
+func (p *Provider) GarbageCollect(run *v1alpha1.AnalysisRun, metric v1alpha1.Metric, limit int) error {
+	return nil
+}
+
+func validateIncomingProps(spec *v1alpha1.OpenSearchMetric) error {
+	if spec.Query == "" {
+		return errors.New("query is required")
+	}
+	if spec.Language != v1alpha1.OpenSearchQueryLanguageSQL {
+		if spec.Index == "" {
+			return errors.New("index is required for DSL queries")
+		}
+		if !json.Valid([]byte(spec.Query)) {
+			return errors.New("DSL query must be a valid JSON search request")
+		}
+	}
+	if spec.RequestTimeout != "" {
+		timeout, err := spec.RequestTimeout.Duration()
+		if err != nil {
+			return fmt.Errorf("could not parse the request timeout: %v", err)
+		}
+		if timeout <= 0 {
+			return errors.New("request timeout must be a positive duration")
+		}
+	}
+	return nil
+}
+
+// findConfig reads the address and credentials of the cluster from the secret of the metric. The secret is
+// looked up in the controller namespace unless it is namespaced. When the metric references no secret, the
+// default secret is used if it exists.
+func findConfig(kubeclientset kubernetes.Interface, namespace string, spec *v1alpha1.OpenSearchMetric) (openSearchConfig, error) {
+	config := openSearchConfig{}
+	secretName := spec.SecretRef.Name
+	secretNamespace := defaults.Namespace()
+	if spec.SecretRef.Namespaced {
+		if secretName == "" {
+			return config, errors.New("secret name is required for namespaced credentials")
+		}
+		secretNamespace = namespace
+	}
+	if spec.Address != "" && !spec.SecretRef.Namespaced {
+		// the credentials of a secret of the controller namespace must not be sent to an address chosen by the
+		// author of the metric
+		if secretName != "" {
+			return config, errors.New("address cannot be set in the metric with a secret of the controller namespace, set it in the secret or use a namespaced secret")
+		}
+		config.Address = spec.Address
+		return config, nil
+	}
+	optional := secretName == ""
+	if optional {
+		secretName = OpenSearchSecretName
+	}
+
+	secret, err := kubeclientset.CoreV1().Secrets(secretNamespace).Get(context.TODO(), secretName, metav1.GetOptions{})
+	if err != nil && !(optional && k8serrors.IsNotFound(err)) {
+		return config, err
+	}
+	if err == nil {
+		config.Address = string(secret.Data[OpenSearchAddress])
+		config.Username = string(secret.Data[OpenSearchUsername])
+		config.Password = string(secret.Data[OpenSearchPassword])
+		config.ApiKey = string(secret.Data[OpenSearchApiKey])
+	}
+	if spec.Address != "" {
+		config.Address = spec.Address
+	}
+	if config.Address == "" {
+		return config, errors.New("address is required, either in the metric or in the secret")
+	}
+	return config, nil
+}
+
+func NewOpenSearchProvider(logCtx log.Entry, kubeclientset kubernetes.Interface, namespace string, metric v1alpha1.Metric) (*Provider, error) {
+	spec := metric.Provider.OpenSearch
+	if err := validateIncomingProps(spec); err != nil {
+		return nil, err
+	}
+	config, err := findConfig(kubeclientset, namespace, spec)
+	if err != nil {
+		return nil, err
+	}
+
+	timeout := 10 * time.Second
+	if spec.RequestTimeout != "" {
+		timeout, _ = spec.RequestTimeout.Duration()
+	}
+	client := &http.Client{
+		Timeout: timeout,
+	}
+	if spec.Insecure {
+		client.Transport = &http.Transport{
+			TLSClientConfig: &tls.Config{InsecureSkipVerify: true},
+		}
+	}
+	return &Provider{
+		logCtx: logCtx,
+		config: config,
+		client: client,
+	}, nil
+}
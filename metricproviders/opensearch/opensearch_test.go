@@ -0,0 +1,242 @@
+package opensearch
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8sfake "k8s.io/client-go/kubernetes/fake"
+
+	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
+	"github.com/argoproj/argo-rollouts/utils/defaults"
+)
+
+const searchResponse = `{
+  "hits": {"total": {"value": 12, "relation": "eq"}, "hits": []},
+  "aggregations": {"errors": {"value": 3}, "by_status": {"buckets": [{"key": "500", "doc_count": 2}]}}
+}`
+
+const sqlResponse = `{
+  "schema": [{"name": "count(*)", "type": "integer"}],
+  "datarows": [[7]],
+  "total": 1,
+  "size": 1,
+  "status": 200
+}`
+
+func newSecret(name, namespace string, data map[string]string) *corev1.Secret {
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace},
+		Data:       map[string][]byte{},
+	}
+	for k, v := range data {
+		secret.Data[k] = []byte(v)
+	}
+	return secret
+}
+
+func newMetric(spec v1alpha1.OpenSearchMetric, successCondition string) v1alpha1.Metric {
+	return v1alpha1.Metric{
+		Name:             "errors",
+		SuccessCondition: successCondition,
+		Provider: v1alpha1.MetricProvider{
+			OpenSearch: &spec,
+		},
+	}
+}
+
+func newAnalysisRun() *v1alpha1.AnalysisRun {
+	return &v1alpha1.AnalysisRun{}
+}
+
+func TestType(t *testing.T) {
+	p := &Provider{}
+	assert.Equal(t, ProviderType, p.Type())
+	assert.Nil(t, p.GetMetadata(v1alpha1.Metric{}))
+}
+
+func TestRunDSLQuery(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		assert.Equal(t, http.MethodPost, req.Method)
+		assert.Equal(t, "/logs-*/_search", req.URL.Path)
+		username, password, ok := req.BasicAuth()
+		assert.True(t, ok)
+		assert.Equal(t, "reader", username)
+		assert.Equal(t, "secret", password)
+		body, _ := io.ReadAll(req.Body)
+		assert.JSONEq(t, `{"size": 0, "query": {"match": {"level": "error"}}}`, string(body))
+		io.WriteString(rw, searchResponse)
+	}))
+	defer server.Close()
+
+	kubeclient := k8sfake.NewSimpleClientset(newSecret(OpenSearchSecretName, defaults.Namespace(), map[string]string{
+		OpenSearchAddress:  server.URL,
+		OpenSearchUsername: "reader",
+		OpenSearchPassword: "secret",
+	}))
+	metric := newMetric(v1alpha1.OpenSearchMetric{
+		Index: "logs-*",
+		Query: `{"size": 0, "query": {"match": {"level": "error"}}}`,
+	}, "result < 20")
+	p, err := NewOpenSearchProvider(*log.NewEntry(log.New()), kubeclient, "default", metric)
+	assert.NoError(t, err)
+
+	measurement := p.Run(newAnalysisRun(), metric)
+	assert.Equal(t, v1alpha1.AnalysisPhaseSuccessful, measurement.Phase)
+	assert.Equal(t, "12", measurement.Value)
+	assert.NotNil(t, measurement.FinishedAt)
+
+	metric.Provider.OpenSearch.ValuePath = "aggregations.errors.value"
+	metric.SuccessCondition = "result < 3"
+	measurement = p.Run(newAnalysisRun(), metric)
+	assert.Equal(t, v1alpha1.AnalysisPhaseFailed, measurement.Phase)
+	assert.Equal(t, "3", measurement.Value)
+
+	metric.Provider.OpenSearch.ValuePath = "aggregations.by_status.buckets.0.doc_count"
+	metric.SuccessCondition = "result == 2"
+	measurement = p.Run(newAnalysisRun(), metric)
+	assert.Equal(t, v1alpha1.AnalysisPhaseSuccessful, measurement.Phase)
+
+	metric.Provider.OpenSearch.ValuePath = "aggregations.by_status.buckets.1.doc_count"
+	measurement = p.Run(newAnalysisRun(), metric)
+	assert.Equal(t, v1alpha1.AnalysisPhaseError, measurement.Phase)
+	assert.Equal(t, "value path aggregations.by_status.buckets.1.doc_count not found in response: no index 1", measurement.Message)
+}
+
+func TestRunSQLQueryWithApiKey(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		assert.Equal(t, "/_plugins/_sql", req.URL.Path)
+		assert.Equal(t, "ApiKey my-key", req.Header.Get("Authorization"))
+		var body map[string]string
+		assert.NoError(t, json.NewDecoder(req.Body).Decode(&body))
+		assert.Equal(t, "SELECT COUNT(*) FROM logs WHERE level = 'error'", body["query"])
+		io.WriteString(rw, sqlResponse)
+	}))
+	defer server.Close()
+
+	kubeclient := k8sfake.NewSimpleClientset(newSecret("team-opensearch", "team", map[string]string{
+		OpenSearchApiKey: "my-key",
+	}))
+	metric := newMetric(v1alpha1.OpenSearchMetric{
+		Address:   server.URL,
+		Language:  v1alpha1.OpenSearchQueryLanguageSQL,
+		Query:     "SELECT COUNT(*) FROM logs WHERE level = 'error'",
+		SecretRef: v1alpha1.SecretRef{Name: "team-opensearch", Namespaced: true},
+	}, "result <= 10")
+	p, err := NewOpenSearchProvider(*log.NewEntry(log.New()), kubeclient, "team", metric)
+	assert.NoError(t, err)
+
+	measurement := p.Run(newAnalysisRun(), metric)
+	assert.Equal(t, v1alpha1.AnalysisPhaseSuccessful, measurement.Phase)
+	assert.Equal(t, "7", measurement.Value)
+}
+
+func TestRunWithAddressDoesNotUseControllerSecret(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		assert.Equal(t, "/logs%2F..%2F_all/_search", req.URL.EscapedPath())
+		assert.Empty(t, req.Header.Get("Authorization"))
+		io.WriteString(rw, searchResponse)
+	}))
+	defer server.Close()
+
+	kubeclient := k8sfake.NewSimpleClientset(newSecret(OpenSearchSecretName, defaults.Namespace(), map[string]string{
+		OpenSearchAddress:  "https://opensearch.logging.svc:9200",
+		OpenSearchUsername: "reader",
+		OpenSearchPassword: "secret",
+	}))
+	metric := newMetric(v1alpha1.OpenSearchMetric{
+		Address: server.URL,
+		Index:   "logs/../_all",
+		Query:   `{}`,
+	}, "result < 20")
+	p, err := NewOpenSearchProvider(*log.NewEntry(log.New()), kubeclient, "default", metric)
+	assert.NoError(t, err)
+	measurement := p.Run(newAnalysisRun(), metric)
+	assert.Equal(t, v1alpha1.AnalysisPhaseSuccessful, measurement.Phase)
+}
+
+func TestRunErrorResponse(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		rw.WriteHeader(http.StatusBadRequest)
+		io.WriteString(rw, `{"error": "parsing_exception"}`)
+	}))
+	defer server.Close()
+
+	metric := newMetric(v1alpha1.OpenSearchMetric{
+		Address: server.URL,
+		Index:   "logs",
+		Query:   `{}`,
+	}, "result < 1")
+	p, err := NewOpenSearchProvider(*log.NewEntry(log.New()), k8sfake.NewSimpleClientset(), "default", metric)
+	assert.NoError(t, err)
+	measurement := p.Run(newAnalysisRun(), metric)
+	assert.Equal(t, v1alpha1.AnalysisPhaseError, measurement.Phase)
+	assert.Equal(t, `received non 2xx response code: 400 {"error": "parsing_exception"}`, measurement.Message)
+}
+
+func TestNewOpenSearchProviderErrors(t *testing.T) {
+	kubeclient := k8sfake.NewSimpleClientset()
+	logCtx := *log.NewEntry(log.New())
+	tests := []struct {
+		spec          v1alpha1.OpenSearchMetric
+		expectedError string
+	}{
+		{
+			spec:          v1alpha1.OpenSearchMetric{Address: "http://opensearch", Index: "logs"},
+			expectedError: "query is required",
+		},
+		{
+			spec:          v1alpha1.OpenSearchMetric{Address: "http://opensearch", Query: "{}"},
+			expectedError: "index is required for DSL queries",
+		},
+		{
+			spec:          v1alpha1.OpenSearchMetric{Address: "http://opensearch", Index: "logs", Query: "level:error"},
+			expectedError: "DSL query must be a valid JSON search request",
+		},
+		{
+			spec:          v1alpha1.OpenSearchMetric{Address: "http://opensearch", Index: "logs", Query: "{}", RequestTimeout: "-1s"},
+			expectedError: "request timeout must be a positive duration",
+		},
+		{
+			spec:          v1alpha1.OpenSearchMetric{Index: "logs", Query: "{}"},
+			expectedError: "address is required, either in the metric or in the secret",
+		},
+		{
+			spec:          v1alpha1.OpenSearchMetric{Index: "logs", Query: "{}", SecretRef: v1alpha1.SecretRef{Namespaced: true}},
+			expectedError: "secret name is required for namespaced credentials",
+		},
+		{
+			spec:          v1alpha1.OpenSearchMetric{Index: "logs", Query: "{}", SecretRef: v1alpha1.SecretRef{Name: "missing"}},
+			expectedError: `secrets "missing" not found`,
+		},
+		{
+			spec:          v1alpha1.OpenSearchMetric{Address: "http://opensearch", Index: "logs", Query: "{}", SecretRef: v1alpha1.SecretRef{Name: "shared"}},
+			expectedError: "address cannot be set in the metric with a secret of the controller namespace, set it in the secret or use a namespaced secret",
+		},
+	}
+	for _, test := range tests {
+		_, err := NewOpenSearchProvider(logCtx, kubeclient, "default", newMetric(test.spec, ""))
+		assert.EqualError(t, err, test.expectedError)
+	}
+}
+
+func TestLookupPath(t *testing.T) {
+	var data any
+	assert.NoError(t, json.Unmarshal([]byte(searchResponse), &data))
+
+	value, err := lookupPath(data, "hits.total")
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]any{"value": float64(12), "relation": "eq"}, value)
+
+	_, err = lookupPath(data, "hits.missing")
+	assert.EqualError(t, err, "value path hits.missing not found in response: no key missing")
+
+	_, err = lookupPath(data, "hits.total.value.count")
+	assert.EqualError(t, err, "value path hits.total.value.count not found in response: count is not an object or array")
+}
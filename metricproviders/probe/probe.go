@@ -0,0 +1,369 @@
+package probe
+
+import (
+	"context"
+	"crypto/tls"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"net/http"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/metadata"
+
+	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
+	analysisutil "github.com/argoproj/argo-rollouts/utils/analysis"
+	"github.com/argoproj/argo-rollouts/utils/evaluate"
+	metricutil "github.com/argoproj/argo-rollouts/utils/metric"
+	timeutil "github.com/argoproj/argo-rollouts/utils/time"
+)
+
+const (
+	// ProviderType indicates the provider is a probe
+	ProviderType = "Probe"
+	// DefaultRequests is the number of requests of probes without requests
+	DefaultRequests = 100
+	// DefaultRPS is the rate of probes without rps
+	DefaultRPS = 10
+	// DefaultTimeout is the timeout of the requests of probes without timeout
+	DefaultTimeout = 5 * time.Second
+	// MaxDuration bounds the time a measurement blocks, from the first request until the last one
+	// completes, since the measurement is taken synchronously by the analysis controller
+	MaxDuration = time.Minute
+)
+
+// sender sends a single request, returning an error if the request failed. The connections of a sender
+// are opened and closed by Run, since providers are also created for runs which are never measured.
+type sender interface {
+	open() error
+	send(ctx context.Context) error
+	close()
+}
+
+// Provider contains all the required components to run a probe
+// Implements the Provider Interface
+type Provider struct {
+	logCtx   log.Entry
+	sender   sender
+	requests int
+	rps      int
+	timeout  time.Duration
+}
+
+// Result is the value of a probe measurement. Latencies are in milliseconds.
+type Result struct {
+	Requests  int     `json:"requests"`
+	Errors    int     `json:"errors"`
+	ErrorRate float64 `json:"errorRate"`
+	P50       float64 `json:"p50"`
+	P90       float64 `json:"p90"`
+	P95       float64 `json:"p95"`
+	P99       float64 `json:"p99"`
+	Max       float64 `json:"max"`
+}
+
+// Type indicates provider is a probe provider
+func (p *Provider) Type() string {
+	return ProviderType
+}
+
+// GetMetadata returns any additional metadata which needs to be stored & displayed as part of the metrics result.
+func (p *Provider) GetMetadata(metric v1alpha1.Metric) map[string]string {
+	return nil
+}
+
+func (p *Provider) Run(run *v1alpha1.AnalysisRun, metric v1alpha1.Metric) v1alpha1.Measurement {
+	startTime := timeutil.MetaNow()
+	measurement := v1alpha1.Measurement{
+		StartedAt: &startTime,
+	}
+	if err := p.sender.open(); err != nil {
+		return metricutil.MarkMeasurementError(measurement, err)
+	}
+	defer p.sender.close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), MaxDuration)
+	defer cancel()
+	result, lastErr := p.probe(ctx)
+	if lastErr != nil {
+		p.logCtx.Infof("%d/%d probe requests failed, last error: %v", result.Errors, result.Requests, lastErr)
+	}
+	valueBytes, err := json.Marshal(result)
+	if err != nil {
+		return metricutil.MarkMeasurementError(measurement, err)
+	}
+	// the result is evaluated as a map so that conditions use the json field names
+	var value map[string]any
+	if err := json.Unmarshal(valueBytes, &value); err != nil {
+		return metricutil.MarkMeasurementError(measurement, err)
+	}
+	status, err := evaluate.EvaluateResultWithHistory(value, metric, analysisutil.ArrayMeasurement(run, metric.Name), p.logCtx)
+	if err != nil {
+		return metricutil.MarkMeasurementError(measurement, err)
+	}
+
+	measurement.Value = string(valueBytes)
+	measurement.Phase = status
+	finishedTime := timeutil.MetaNow()
+	measurement.FinishedAt = &finishedTime
+	return measurement
+}
+
+// probe sends the requests at the configured rate and waits for all of them to complete. Requests which
+// are not sent before the context is done are not part of the result. It returns the result and the
+// error of the last failed request.
+func (p *Provider) probe(ctx context.Context) (Result, error) {
+	latencies := make([]time.Duration, p.requests)
+	errs := make([]error, p.requests)
+	ticker := time.NewTicker(time.Second / time.Duration(p.rps))
+	defer ticker.Stop()
+
+	var wg sync.WaitGroup
+	sent := 0
+	for ; sent < p.requests; sent++ {
+		if sent > 0 {
+			select {
+			case <-ticker.C:
+			case <-ctx.Done():
+			}
+		}
+		if ctx.Err() != nil {
+			break
+		}
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			requestCtx, cancel := context.WithTimeout(ctx, p.timeout)
+			defer cancel()
+			start := time.Now()
+			errs[i] = p.sender.send(requestCtx)
+			latencies[i] = time.Since(start)
+		}(sent)
+	}
+	wg.Wait()
+	latencies, errs = latencies[:sent], errs[:sent]
+	if sent == 0 {
+		return Result{}, ctx.Err()
+	}
+
+	result := Result{Requests: sent}
+	var lastErr error
+	for _, err := range errs {
+		if err != nil {
+			result.Errors++
+			lastErr = err
+		}
+	}
+	result.ErrorRate = float64(result.Errors) / float64(result.Requests)
+	sort.Slice(latencies, func(i, j int) bool { return latencies[i] < latencies[j] })
+	result.P50 = percentile(latencies, 50)
+	result.P90 = percentile(latencies, 90)
+	result.P95 = percentile(latencies, 95)
+	result.P99 = percentile(latencies, 99)
+	result.Max = milliseconds(latencies[len(latencies)-1])
+	return result, lastErr
+}
+
+// percentile returns the nearest-rank percentile of the sorted latencies in milliseconds
+func percentile(sorted []time.Duration, p float64) float64 {
+	rank := int(math.Ceil(p / 100 * float64(len(sorted))))
+	if rank < 1 {
+		rank = 1
+	}
+	return milliseconds(sorted[rank-1])
+}
+
+func milliseconds(d time.Duration) float64 {
+	return float64(d) / float64(time.Millisecond)
+}
+
+// Resume should not be used the probe provider since all the work should occur in the Run method
+func (p *Provider) Resume(run *v1alpha1.AnalysisRun, metric v1alpha1.Metric, measurement v1alpha1.Measurement) v1alpha1.Measurement {
+	p.logCtx.Warn("Probe provider should not execute the Resume method")
+	return measurement
+}
+
+// Terminate should not be used the probe provider since all the work should occur in the Run method
+func (p *Provider) Terminate(run *v1alpha1.AnalysisRun, metric v1alpha1.Metric, measurement v1alpha1.Measurement) v1alpha1.Measurement {
+	p.logCtx.Warn("Probe provider should not execute the Terminate method")
+	return measurement
+}
+
+// GarbageCollect is a no-op for the probe provider
+func (p *Provider) GarbageCollect(run *v1alpha1.AnalysisRun, metric v1alpha1.Metric, limit int) error {
+	return nil
+}
+
+type httpSender struct {
+	client  *http.Client
+	spec    *v1alpha1.ProbeHTTP
+	method  string
+	headers http.Header
+}
+
+func (s *httpSender) open() error {
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	if s.spec.Insecure {
+		transport.TLSClientConfig = &tls.Config{InsecureSkipVerify: true}
+	}
+	s.client = &http.Client{Transport: transport}
+	return nil
+}
+
+func (s *httpSender) send(ctx context.Context) error {
+	var body io.Reader
+	if s.spec.Body != "" {
+		body = strings.NewReader(s.spec.Body)
+	}
+	request, err := http.NewRequestWithContext(ctx, s.method, s.spec.URL, body)
+	if err != nil {
+		return err
+	}
+	request.Header = s.headers.Clone()
+	response, err := s.client.Do(request)
+	if err != nil {
+		return err
+	}
+	defer response.Body.Close()
+	io.Copy(io.Discard, response.Body)
+	if response.StatusCode >= 500 {
+		return fmt.Errorf("received %d response code", response.StatusCode)
+	}
+	return nil
+}
+
+func (s *httpSender) close() {
+	s.client.CloseIdleConnections()
+}
+
+func newHTTPSender(spec *v1alpha1.ProbeHTTP) (*httpSender, error) {
+	method := v1alpha1.WebMetricMethodGet
+	if spec.Method != "" {
+		method = spec.Method
+	}
+	if spec.Body != "" && method == v1alpha1.WebMetricMethodGet {
+		return nil, errors.New("body can only be used with POST or PUT methods")
+	}
+	headers := make(http.Header)
+	for _, header := range spec.Headers {
+		headers.Set(header.Key, header.Value)
+	}
+	return &httpSender{
+		spec:    spec,
+		method:  string(method),
+		headers: headers,
+	}, nil
+}
+
+type grpcSender struct {
+	conn   *grpc.ClientConn
+	client healthpb.HealthClient
+	spec   *v1alpha1.ProbeGRPC
+	md     metadata.MD
+}
+
+func (s *grpcSender) open() error {
+	creds := insecure.NewCredentials()
+	if s.spec.TLS {
+		creds = credentials.NewTLS(&tls.Config{InsecureSkipVerify: s.spec.Insecure})
+	}
+	conn, err := grpc.NewClient(s.spec.Address, grpc.WithTransportCredentials(creds))
+	if err != nil {
+		return err
+	}
+	s.conn = conn
+	s.client = healthpb.NewHealthClient(conn)
+	return nil
+}
+
+func (s *grpcSender) send(ctx context.Context) error {
+	if len(s.md) > 0 {
+		ctx = metadata.NewOutgoingContext(ctx, s.md)
+	}
+	response, err := s.client.Check(ctx, &healthpb.HealthCheckRequest{Service: s.spec.Service})
+	if err != nil {
+		return err
+	}
+	if response.Status != healthpb.HealthCheckResponse_SERVING {
+		return fmt.Errorf("service status is %s", response.Status)
+	}
+	return nil
+}
+
+func (s *grpcSender) close() {
+	s.conn.Close()
+}
+
+func newGRPCSender(spec *v1alpha1.ProbeGRPC) (*grpcSender, error) {
+	if spec.Address == "" {
+		return nil, errors.New("grpc probe address must be set")
+	}
+	md := metadata.MD{}
+	for _, header := range spec.Metadata {
+		md.Append(header.Key, header.Value)
+	}
+	return &grpcSender{
+		spec: spec,
+		md:   md,
+	}, nil
+}
+
+func NewProbeProvider(logCtx log.Entry, metric v1alpha1.Metric) (*Provider, error) {
+	spec := metric.Provider.Probe
+	if (spec.HTTP == nil) == (spec.GRPC == nil) {
+		return nil, errors.New("probe must specify exactly one of http or grpc")
+	}
+	p := &Provider{
+		logCtx:   logCtx,
+		requests: DefaultRequests,
+		rps:      DefaultRPS,
+		timeout:  DefaultTimeout,
+	}
+	if spec.Requests < 0 || spec.RPS < 0 {
+		return nil, errors.New("probe requests and rps must be positive")
+	}
+	if spec.Requests > analysisutil.MaxProbeRequests || spec.RPS > analysisutil.MaxProbeRPS {
+		return nil, fmt.Errorf("probe requests must be at most %d and rps at most %d", analysisutil.MaxProbeRequests, analysisutil.MaxProbeRPS)
+	}
+	if spec.Requests > 0 {
+		p.requests = int(spec.Requests)
+	}
+	if spec.RPS > 0 {
+		p.rps = int(spec.RPS)
+	}
+	if spec.Timeout != "" {
+		timeout, err := spec.Timeout.Duration()
+		if err != nil {
+			return nil, fmt.Errorf("could not parse the probe timeout: %v", err)
+		}
+		if timeout <= 0 {
+			return nil, errors.New("probe timeout must be a positive duration")
+		}
+		p.timeout = timeout
+	}
+	// the last request is sent (requests-1)/rps after the first one and may take up to the timeout
+	if duration := time.Duration(p.requests-1)*time.Second/time.Duration(p.rps) + p.timeout; duration > MaxDuration {
+		return nil, fmt.Errorf("probe could take %v, more than the maximum of %v", duration, MaxDuration)
+	}
+
+	var err error
+	if spec.HTTP != nil {
+		p.sender, err = newHTTPSender(spec.HTTP)
+	} else {
+		p.sender, err = newGRPCSender(spec.GRPC)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return p, nil
+}
@@ -0,0 +1,256 @@
+package probe
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/metadata"
+
+	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
+)
+
+func newMetric(spec v1alpha1.ProbeMetric, successCondition string) v1alpha1.Metric {
+	return v1alpha1.Metric{
+		Name:             "probe",
+		SuccessCondition: successCondition,
+		Provider: v1alpha1.MetricProvider{
+			Probe: &spec,
+		},
+	}
+}
+
+func TestType(t *testing.T) {
+	p := &Provider{}
+	assert.Equal(t, ProviderType, p.Type())
+	assert.Nil(t, p.GetMetadata(v1alpha1.Metric{}))
+}
+
+func TestRunHTTPProbe(t *testing.T) {
+	var requests int32
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		n := atomic.AddInt32(&requests, 1)
+		assert.Equal(t, "always", req.Header.Get("X-Canary"))
+		assert.Equal(t, http.MethodPost, req.Method)
+		body, _ := io.ReadAll(req.Body)
+		assert.Equal(t, `{"ping": true}`, string(body))
+		if n%5 == 0 {
+			rw.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		// client errors are not server errors
+		rw.WriteHeader(http.StatusNotFound)
+	}))
+	defer server.Close()
+
+	metric := newMetric(v1alpha1.ProbeMetric{
+		HTTP: &v1alpha1.ProbeHTTP{
+			URL:     server.URL,
+			Method:  v1alpha1.WebMetricMethodPost,
+			Body:    `{"ping": true}`,
+			Headers: []v1alpha1.WebMetricHeader{{Key: "X-Canary", Value: "always"}},
+		},
+		Requests: 10,
+		RPS:      1000,
+	}, "result.errorRate <= 0.1 && result.p99 < 1000")
+	p, err := NewProbeProvider(*log.NewEntry(log.New()), metric)
+	assert.NoError(t, err)
+
+	measurement := p.Run(&v1alpha1.AnalysisRun{}, metric)
+	assert.Equal(t, v1alpha1.AnalysisPhaseFailed, measurement.Phase)
+	assert.Equal(t, int32(10), atomic.LoadInt32(&requests))
+	var result Result
+	assert.NoError(t, json.Unmarshal([]byte(measurement.Value), &result))
+	assert.Equal(t, 10, result.Requests)
+	assert.Equal(t, 2, result.Errors)
+	assert.Equal(t, 0.2, result.ErrorRate)
+	assert.True(t, result.P50 <= result.P99)
+	assert.True(t, result.P99 <= result.Max)
+}
+
+func TestRunHTTPProbeTimeout(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		time.Sleep(100 * time.Millisecond)
+	}))
+	defer server.Close()
+
+	metric := newMetric(v1alpha1.ProbeMetric{
+		HTTP:     &v1alpha1.ProbeHTTP{URL: server.URL},
+		Requests: 2,
+		RPS:      100,
+		Timeout:  "10ms",
+	}, "result.errorRate == 0")
+	p, err := NewProbeProvider(*log.NewEntry(log.New()), metric)
+	assert.NoError(t, err)
+	measurement := p.Run(&v1alpha1.AnalysisRun{}, metric)
+	assert.Equal(t, v1alpha1.AnalysisPhaseFailed, measurement.Phase)
+	assert.Contains(t, measurement.Value, `"errors":2`)
+}
+
+func TestRunGRPCProbe(t *testing.T) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	assert.NoError(t, err)
+	var canaryRequests int32
+	server := grpc.NewServer(grpc.UnaryInterceptor(func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		if md, ok := metadata.FromIncomingContext(ctx); ok && len(md.Get("x-canary")) > 0 {
+			atomic.AddInt32(&canaryRequests, 1)
+		}
+		return handler(ctx, req)
+	}))
+	healthServer := health.NewServer()
+	healthServer.SetServingStatus("checkout", healthpb.HealthCheckResponse_SERVING)
+	healthServer.SetServingStatus("payments", healthpb.HealthCheckResponse_NOT_SERVING)
+	healthpb.RegisterHealthServer(server, healthServer)
+	go server.Serve(listener)
+	defer server.Stop()
+
+	metric := newMetric(v1alpha1.ProbeMetric{
+		GRPC: &v1alpha1.ProbeGRPC{
+			Address:  listener.Addr().String(),
+			Service:  "checkout",
+			Metadata: []v1alpha1.WebMetricHeader{{Key: "X-Canary", Value: "always"}},
+		},
+		Requests: 5,
+		RPS:      1000,
+	}, "result.errorRate == 0")
+	p, err := NewProbeProvider(*log.NewEntry(log.New()), metric)
+	assert.NoError(t, err)
+	measurement := p.Run(&v1alpha1.AnalysisRun{}, metric)
+	assert.Equal(t, v1alpha1.AnalysisPhaseSuccessful, measurement.Phase)
+	assert.Equal(t, int32(5), atomic.LoadInt32(&canaryRequests))
+
+	metric.Provider.Probe.GRPC.Service = "payments"
+	p, err = NewProbeProvider(*log.NewEntry(log.New()), metric)
+	assert.NoError(t, err)
+	measurement = p.Run(&v1alpha1.AnalysisRun{}, metric)
+	assert.Equal(t, v1alpha1.AnalysisPhaseFailed, measurement.Phase)
+	assert.Contains(t, measurement.Value, `"errorRate":1`)
+}
+
+type fakeSender struct {
+	opened int32
+	closed int32
+	sent   int32
+}
+
+func (s *fakeSender) open() error {
+	atomic.AddInt32(&s.opened, 1)
+	return nil
+}
+
+func (s *fakeSender) send(ctx context.Context) error {
+	atomic.AddInt32(&s.sent, 1)
+	return nil
+}
+
+func (s *fakeSender) close() {
+	atomic.AddInt32(&s.closed, 1)
+}
+
+func TestRunOpensAndClosesSender(t *testing.T) {
+	sender := &fakeSender{}
+	p := &Provider{logCtx: *log.NewEntry(log.New()), sender: sender, requests: 3, rps: 1000, timeout: time.Second}
+	metric := newMetric(v1alpha1.ProbeMetric{}, "result.errorRate == 0")
+
+	measurement := p.Run(&v1alpha1.AnalysisRun{}, metric)
+	assert.Equal(t, v1alpha1.AnalysisPhaseSuccessful, measurement.Phase)
+	assert.Equal(t, int32(1), sender.opened)
+	assert.Equal(t, int32(1), sender.closed)
+	assert.Equal(t, int32(3), sender.sent)
+}
+
+func TestProbeStopsSendingWhenContextIsDone(t *testing.T) {
+	sender := &fakeSender{}
+	p := &Provider{logCtx: *log.NewEntry(log.New()), sender: sender, requests: 100, rps: 10, timeout: time.Second}
+	ctx, cancel := context.WithTimeout(context.Background(), 150*time.Millisecond)
+	defer cancel()
+
+	start := time.Now()
+	result, err := p.probe(ctx)
+	assert.NoError(t, err)
+	assert.Less(t, time.Since(start), time.Second)
+	assert.Equal(t, int(sender.sent), result.Requests)
+	assert.Less(t, result.Requests, 100)
+}
+
+func TestNewProbeProviderDoesNotConnect(t *testing.T) {
+	metric := newMetric(v1alpha1.ProbeMetric{GRPC: &v1alpha1.ProbeGRPC{Address: "canary:8080"}}, "")
+	p, err := NewProbeProvider(*log.NewEntry(log.New()), metric)
+	assert.NoError(t, err)
+	assert.Nil(t, p.sender.(*grpcSender).conn)
+}
+
+func TestNewProbeProviderErrors(t *testing.T) {
+	logCtx := *log.NewEntry(log.New())
+	httpProbe := &v1alpha1.ProbeHTTP{URL: "http://canary"}
+	tests := []struct {
+		spec          v1alpha1.ProbeMetric
+		expectedError string
+	}{
+		{
+			spec:          v1alpha1.ProbeMetric{},
+			expectedError: "probe must specify exactly one of http or grpc",
+		},
+		{
+			spec:          v1alpha1.ProbeMetric{HTTP: httpProbe, GRPC: &v1alpha1.ProbeGRPC{Address: "canary:8080"}},
+			expectedError: "probe must specify exactly one of http or grpc",
+		},
+		{
+			spec:          v1alpha1.ProbeMetric{HTTP: httpProbe, RPS: -1},
+			expectedError: "probe requests and rps must be positive",
+		},
+		{
+			spec:          v1alpha1.ProbeMetric{HTTP: httpProbe, RPS: 2000000000},
+			expectedError: "probe requests must be at most 1000 and rps at most 1000",
+		},
+		{
+			spec:          v1alpha1.ProbeMetric{HTTP: httpProbe, Requests: 1001, RPS: 1000},
+			expectedError: "probe requests must be at most 1000 and rps at most 1000",
+		},
+		{
+			spec:          v1alpha1.ProbeMetric{HTTP: httpProbe, Requests: 1000, RPS: 10},
+			expectedError: "probe could take 1m44.9s, more than the maximum of 1m0s",
+		},
+		{
+			spec:          v1alpha1.ProbeMetric{HTTP: httpProbe, Requests: 560, RPS: 10, Timeout: "5s"},
+			expectedError: "probe could take 1m0.9s, more than the maximum of 1m0s",
+		},
+		{
+			spec:          v1alpha1.ProbeMetric{GRPC: &v1alpha1.ProbeGRPC{}},
+			expectedError: "grpc probe address must be set",
+		},
+		{
+			spec:          v1alpha1.ProbeMetric{HTTP: httpProbe, Timeout: "0s"},
+			expectedError: "probe timeout must be a positive duration",
+		},
+		{
+			spec:          v1alpha1.ProbeMetric{HTTP: &v1alpha1.ProbeHTTP{URL: "http://canary", Body: "{}"}},
+			expectedError: "body can only be used with POST or PUT methods",
+		},
+	}
+	for _, test := range tests {
+		_, err := NewProbeProvider(logCtx, newMetric(test.spec, ""))
+		assert.EqualError(t, err, test.expectedError)
+	}
+}
+
+func TestPercentile(t *testing.T) {
+	latencies := make([]time.Duration, 100)
+	for i := range latencies {
+		latencies[i] = time.Duration(i+1) * time.Millisecond
+	}
+	assert.Equal(t, float64(50), percentile(latencies, 50))
+	assert.Equal(t, float64(99), percentile(latencies, 99))
+	assert.Equal(t, float64(7), percentile([]time.Duration{7 * time.Millisecond}, 50))
+}
@@ -0,0 +1,96 @@
+package prometheus
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+	"time"
+
+	v1 "github.com/prometheus/client_golang/api/prometheus/v1"
+	"github.com/prometheus/common/model"
+
+	"github.com/argoproj/argo-rollouts/metricproviders/querycache"
+	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
+)
+
+// cachedAPI serves instant and range queries through a shared query cache
+type cachedAPI struct {
+	v1.API
+	cache   *querycache.Cache
+	address string
+	// scope distinguishes servers sharing an address but returning different data, e.g. multi-tenant
+	// servers selecting the tenant with a header, or servers returning the data the identity used to
+	// authenticate is allowed to read
+	scope string
+}
+
+type queryResult struct {
+	value    model.Value
+	warnings v1.Warnings
+}
+
+// NewCachedAPI wraps api so that its queries are deduplicated, cached and rate limited by cache. The address,
+// headers and authentication of the prometheus metric identify the server the api is connected to.
+func NewCachedAPI(api v1.API, prometheus v1alpha1.PrometheusMetric, cache *querycache.Cache) v1.API {
+	address := prometheus.Address
+	if address == "" {
+		address = os.Getenv(EnvVarArgoRolloutsPrometheusAddress)
+	}
+	headers := make([]string, 0, len(prometheus.Headers))
+	for _, header := range prometheus.Headers {
+		headers = append(headers, header.Key+":"+header.Value)
+	}
+	sort.Strings(headers)
+	return &cachedAPI{
+		API:     api,
+		cache:   cache,
+		address: address,
+		scope:   strings.Join(headers, "\n") + "\x00" + authenticationScope(prometheus.Authentication),
+	}
+}
+
+// authenticationScope returns a digest of the authentication settings, so that the credentials are not kept
+// in the keys of the cache
+func authenticationScope(authentication v1alpha1.Authentication) string {
+	data, _ := json.Marshal(authentication)
+	digest := sha256.Sum256(data)
+	return hex.EncodeToString(digest[:])
+}
+
+// Query performs an instant query, sharing the result with identical queries in the same time bucket. The
+// query is evaluated at the time requested by the first of them.
+func (c *cachedAPI) Query(ctx context.Context, query string, ts time.Time, opts ...v1.Option) (model.Value, v1.Warnings, error) {
+	key := fmt.Sprintf("query\x00%s\x00%s\x00%d", c.scope, query, c.cache.Bucket(ts).UnixNano())
+	return c.do(ctx, key, func(ctx context.Context) (model.Value, v1.Warnings, error) {
+		return c.API.Query(ctx, query, ts, opts...)
+	})
+}
+
+// QueryRange performs a range query, sharing the result with identical queries whose start and end fall in
+// the same time buckets. The query is evaluated over the range requested by the first of them.
+func (c *cachedAPI) QueryRange(ctx context.Context, query string, r v1.Range, opts ...v1.Option) (model.Value, v1.Warnings, error) {
+	key := fmt.Sprintf("range\x00%s\x00%s\x00%d\x00%d\x00%d", c.scope, query, c.cache.Bucket(r.Start).UnixNano(), c.cache.Bucket(r.End).UnixNano(), r.Step)
+	return c.do(ctx, key, func(ctx context.Context) (model.Value, v1.Warnings, error) {
+		return c.API.QueryRange(ctx, query, r, opts...)
+	})
+}
+
+func (c *cachedAPI) do(ctx context.Context, key string, query func(ctx context.Context) (model.Value, v1.Warnings, error)) (model.Value, v1.Warnings, error) {
+	result, err := c.cache.Do(ctx, c.address, key, func(ctx context.Context) (any, error) {
+		value, warnings, err := query(ctx)
+		if err != nil {
+			return nil, err
+		}
+		return queryResult{value: value, warnings: warnings}, nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	r := result.(queryResult)
+	return r.value, r.warnings, nil
+}
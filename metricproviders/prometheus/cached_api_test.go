@@ -0,0 +1,91 @@
+package prometheus
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	v1 "github.com/prometheus/client_golang/api/prometheus/v1"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/argoproj/argo-rollouts/metricproviders/querycache"
+	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
+)
+
+func TestCachedAPIQuery(t *testing.T) {
+	mock := &mockAPI{value: newScalar(10)}
+	cache := querycache.NewCache(time.Hour, 0, 0)
+	api := NewCachedAPI(mock, v1alpha1.PrometheusMetric{Address: "http://prometheus.example.com"}, cache)
+
+	now := time.Date(2024, 1, 1, 10, 30, 0, 0, time.UTC)
+	value, _, err := api.Query(context.Background(), "up", now)
+	assert.NoError(t, err)
+	assert.Equal(t, newScalar(10), value)
+	// the query is evaluated at the requested time, not at the start of the bucket
+	assert.Equal(t, now, mock.timeSent)
+	value, _, err = api.Query(context.Background(), "up", now.Add(time.Second))
+	assert.NoError(t, err)
+	assert.Equal(t, newScalar(10), value)
+	assert.Equal(t, 1, mock.queries)
+
+	_, _, err = api.Query(context.Background(), "down", now)
+	assert.NoError(t, err)
+	assert.Equal(t, 2, mock.queries)
+
+	// a server sharing the address but selected by different headers does not share results
+	tenantAPI := NewCachedAPI(mock, v1alpha1.PrometheusMetric{
+		Address: "http://prometheus.example.com",
+		Headers: []v1alpha1.WebMetricHeader{{Key: "X-Scope-OrgID", Value: "tenant"}},
+	}, cache)
+	_, _, err = tenantAPI.Query(context.Background(), "up", now)
+	assert.NoError(t, err)
+	assert.Equal(t, 3, mock.queries)
+
+	// neither does a server accessed with another identity
+	userAPI := NewCachedAPI(mock, v1alpha1.PrometheusMetric{
+		Address: "http://prometheus.example.com",
+		Authentication: v1alpha1.Authentication{
+			BasicAuth: v1alpha1.BasicAuthConfig{Username: "user", Password: "password"},
+		},
+	}, cache)
+	_, _, err = userAPI.Query(context.Background(), "up", now)
+	assert.NoError(t, err)
+	assert.Equal(t, 4, mock.queries)
+	_, _, err = userAPI.Query(context.Background(), "up", now)
+	assert.NoError(t, err)
+	assert.Equal(t, 4, mock.queries)
+}
+
+func TestCachedAPIQueryRange(t *testing.T) {
+	mock := &mockAPI{value: newMatrix(1)}
+	cache := querycache.NewCache(time.Hour, 0, 0)
+	api := NewCachedAPI(mock, v1alpha1.PrometheusMetric{Address: "http://prometheus.example.com"}, cache)
+
+	end := time.Date(2024, 1, 1, 10, 30, 0, 0, time.UTC)
+	r := v1.Range{Start: end.Add(-time.Hour), End: end, Step: time.Minute}
+	_, _, err := api.QueryRange(context.Background(), "up", r)
+	assert.NoError(t, err)
+	assert.Equal(t, r.Start, mock.startTimeSent)
+	assert.Equal(t, r.End, mock.endTimeSent)
+
+	r = v1.Range{Start: end.Add(-time.Hour + time.Second), End: end.Add(time.Second), Step: time.Minute}
+	_, _, err = api.QueryRange(context.Background(), "up", r)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, mock.queries)
+
+	r.Step = 2 * time.Minute
+	_, _, err = api.QueryRange(context.Background(), "up", r)
+	assert.NoError(t, err)
+	assert.Equal(t, 2, mock.queries)
+}
+
+func TestCachedAPIError(t *testing.T) {
+	mock := &mockAPI{err: errors.New("bad big bug :(")}
+	api := NewCachedAPI(mock, v1alpha1.PrometheusMetric{Address: "http://prometheus.example.com"}, querycache.NewCache(time.Hour, 0, 0))
+	_, _, err := api.Query(context.Background(), "up", time.Now())
+	assert.EqualError(t, err, "bad big bug :(")
+	_, _, err = api.Query(context.Background(), "up", time.Now())
+	assert.EqualError(t, err, "bad big bug :(")
+	assert.Equal(t, 2, mock.queries)
+}
@@ -12,9 +12,11 @@ type mockAPI struct {
 	value         model.Value
 	err           error
 	warnings      v1.Warnings
+	timeSent      time.Time
 	startTimeSent time.Time
 	endTimeSent   time.Time
 	stepSent      time.Duration
+	queries       int
 }
 
 func (m *mockAPI) WalReplay(ctx context.Context) (v1.WalReplayStatus, error) {
@@ -23,6 +25,8 @@ func (m *mockAPI) WalReplay(ctx context.Context) (v1.WalReplayStatus, error) {
 
 // Query performs a query for the given time.
 func (m *mockAPI) Query(ctx context.Context, query string, ts time.Time, opt ...v1.Option) (model.Value, v1.Warnings, error) {
+	m.timeSent = ts
+	m.queries++
 	if m.err != nil {
 		return nil, m.warnings, m.err
 	}
@@ -55,6 +59,7 @@ func (m *mockAPI) QueryRange(ctx context.Context, query string, r v1.Range, opt
 	m.startTimeSent = r.Start
 	m.endTimeSent = r.End
 	m.stepSent = r.Step
+	m.queries++
 	if m.err != nil {
 		return nil, m.warnings, m.err
 	}
@@ -22,6 +22,7 @@ import (
 	"golang.org/x/oauth2/clientcredentials"
 
 	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
+	analysisutil "github.com/argoproj/argo-rollouts/utils/analysis"
 	"github.com/argoproj/argo-rollouts/utils/evaluate"
 	metricutil "github.com/argoproj/argo-rollouts/utils/metric"
 	timeutil "github.com/argoproj/argo-rollouts/utils/time"
@@ -96,7 +97,7 @@ func (p *Provider) Run(run *v1alpha1.AnalysisRun, metric v1alpha1.Metric) v1alph
 		return metricutil.MarkMeasurementError(newMeasurement, err)
 	}
 
-	newValue, newStatus, err := p.processResponse(metric, response)
+	newValue, newStatus, err := p.processResponse(metric, response, analysisutil.ArrayMeasurement(run, metric.Name))
 	if err != nil {
 		return metricutil.MarkMeasurementError(newMeasurement, err)
 
@@ -153,12 +154,12 @@ func sampleValuesToResultStr(sampleValues []model.SampleValue) string {
 	return fmt.Sprintf("[%s]", strings.Join(results, ","))
 }
 
-func (p *Provider) processResponse(metric v1alpha1.Metric, response model.Value) (string, v1alpha1.AnalysisPhase, error) {
+func (p *Provider) processResponse(metric v1alpha1.Metric, response model.Value, history []v1alpha1.Measurement) (string, v1alpha1.AnalysisPhase, error) {
 	switch value := response.(type) {
 	case *model.Scalar:
 		valueStr := value.Value.String()
 		result := float64(value.Value)
-		newStatus, err := evaluate.EvaluateResult(result, metric, p.logCtx)
+		newStatus, err := evaluate.EvaluateResultWithHistory(result, metric, history, p.logCtx)
 		return valueStr, newStatus, err
 	case model.Matrix:
 		sampleValues := []model.SampleValue{}
@@ -170,7 +171,7 @@ func (p *Provider) processResponse(metric v1alpha1.Metric, response model.Value)
 			}
 		}
 		floatResults := sampleValuesToFloatSlice(sampleValues)
-		newStatus, err := evaluate.EvaluateResult(floatResults, metric, p.logCtx)
+		newStatus, err := evaluate.EvaluateResultWithHistory(floatResults, metric, history, p.logCtx)
 		return sampleValuesToResultStr(sampleValues), newStatus, err
 	case model.Vector:
 		sampleValues := []model.SampleValue{}
@@ -180,7 +181,7 @@ func (p *Provider) processResponse(metric v1alpha1.Metric, response model.Value)
 			}
 		}
 		floatResults := sampleValuesToFloatSlice(sampleValues)
-		newStatus, err := evaluate.EvaluateResult(floatResults, metric, p.logCtx)
+		newStatus, err := evaluate.EvaluateResultWithHistory(floatResults, metric, history, p.logCtx)
 		return sampleValuesToResultStr(sampleValues), newStatus, err
 	//TODO(dthomson) add other response types
 	default:
@@ -119,6 +119,37 @@ func TestRunSuccessfully(t *testing.T) {
 	assert.Equal(t, v1alpha1.AnalysisPhaseSuccessful, measurement.Phase)
 }
 
+func TestRunWithMeasurementHistory(t *testing.T) {
+	e := log.Entry{}
+	mock := &mockAPI{
+		value: newScalar(10),
+	}
+	metric := v1alpha1.Metric{
+		Name:             "foo",
+		SuccessCondition: "result <= 10",
+		FailureCondition: "avg(latest(3)) > 10",
+		Provider: v1alpha1.MetricProvider{
+			Prometheus: &v1alpha1.PrometheusMetric{
+				Query: "test",
+			},
+		},
+	}
+	p, err := NewPrometheusProvider(mock, e, metric)
+	assert.NoError(t, err)
+
+	run := newAnalysisRun()
+	run.Status.MetricResults = []v1alpha1.MetricResult{{
+		Name: "foo",
+		Measurements: []v1alpha1.Measurement{
+			{Phase: v1alpha1.AnalysisPhaseFailed, Value: "20"},
+			{Phase: v1alpha1.AnalysisPhaseFailed, Value: "30"},
+		},
+	}}
+	measurement := p.Run(run, metric)
+	assert.Equal(t, "10", measurement.Value)
+	assert.Equal(t, v1alpha1.AnalysisPhaseFailed, measurement.Phase)
+}
+
 func TestRunSuccessfullyWithRangeQuery(t *testing.T) {
 	e := log.Entry{}
 	mock := &mockAPI{
@@ -485,7 +516,7 @@ func TestProcessScalarResponse(t *testing.T) {
 		Timestamp: model.Time(0),
 	}
 
-	value, status, err := p.processResponse(metric, response)
+	value, status, err := p.processResponse(metric, response, nil)
 	assert.Nil(t, err)
 	assert.Equal(t, v1alpha1.AnalysisPhaseSuccessful, status)
 	assert.Equal(t, "10", value)
@@ -509,7 +540,7 @@ func TestProcessNaNResponse(t *testing.T) {
 			FailureCondition: "result < 0.9",
 		}
 
-		value, status, err := p.processResponse(metric, response)
+		value, status, err := p.processResponse(metric, response, nil)
 		assert.Nil(t, err)
 		assert.Equal(t, v1alpha1.AnalysisPhaseInconclusive, status)
 		assert.Equal(t, "NaN", value)
@@ -520,7 +551,7 @@ func TestProcessNaNResponse(t *testing.T) {
 			SuccessCondition: "result >= 0.9 || isNaN(result)",
 		}
 
-		value, status, err := p.processResponse(metric, response)
+		value, status, err := p.processResponse(metric, response, nil)
 		assert.Nil(t, err)
 		assert.Equal(t, v1alpha1.AnalysisPhaseSuccessful, status)
 		assert.Equal(t, "NaN", value)
@@ -531,7 +562,7 @@ func TestProcessNaNResponse(t *testing.T) {
 			SuccessCondition: "result >= 0.9",
 		}
 
-		value, status, err := p.processResponse(metric, response)
+		value, status, err := p.processResponse(metric, response, nil)
 		assert.Nil(t, err)
 		assert.Equal(t, v1alpha1.AnalysisPhaseFailed, status)
 		assert.Equal(t, "NaN", value)
@@ -554,7 +585,7 @@ func TestProcessInfResponse(t *testing.T) {
 			SuccessCondition: "result >= 0.9",
 		}
 
-		value, status, err := p.processResponse(metric, response)
+		value, status, err := p.processResponse(metric, response, nil)
 		assert.Nil(t, err)
 		assert.Equal(t, v1alpha1.AnalysisPhaseSuccessful, status)
 		assert.Equal(t, "+Inf", value)
@@ -565,7 +596,7 @@ func TestProcessInfResponse(t *testing.T) {
 			FailureCondition: "isInf(result)",
 		}
 
-		value, status, err := p.processResponse(metric, response)
+		value, status, err := p.processResponse(metric, response, nil)
 		assert.Nil(t, err)
 		assert.Equal(t, v1alpha1.AnalysisPhaseFailed, status)
 		assert.Equal(t, "+Inf", value)
@@ -592,7 +623,7 @@ func TestProcessVectorResponse(t *testing.T) {
 			Timestamp: model.Time(0),
 		},
 	}
-	value, status, err := p.processResponse(metric, response)
+	value, status, err := p.processResponse(metric, response, nil)
 	assert.Nil(t, err)
 	assert.Equal(t, v1alpha1.AnalysisPhaseSuccessful, status)
 	assert.Equal(t, "[10,11]", value)
@@ -609,7 +640,7 @@ func TestProcessInvalidResponse(t *testing.T) {
 		FailureCondition: "true",
 	}
 
-	value, status, err := p.processResponse(metric, nil)
+	value, status, err := p.processResponse(metric, nil, nil)
 	assert.NotNil(t, err)
 	assert.Equal(t, v1alpha1.AnalysisPhaseError, status)
 	assert.Equal(t, "", value)
@@ -0,0 +1,158 @@
+package metricproviders
+
+import (
+	"context"
+	"fmt"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+
+	"github.com/argoproj/argo-rollouts/metricproviders/datadog"
+	"github.com/argoproj/argo-rollouts/metricproviders/influxdb"
+	"github.com/argoproj/argo-rollouts/metricproviders/newrelic"
+	"github.com/argoproj/argo-rollouts/metricproviders/prometheus"
+	"github.com/argoproj/argo-rollouts/metricproviders/sloburnrate"
+	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
+)
+
+// resolveMetricProviderConfig returns a copy of the metric with the settings of the MetricProviderConfig it
+// references applied. Settings specified in the metric take precedence over the ones of the config.
+func (f *ProviderFactory) resolveMetricProviderConfig(namespace string, metric v1alpha1.Metric) (v1alpha1.Metric, error) {
+	ref := metric.Provider.ConfigRef
+	if f.MetricProviderConfigLister == nil {
+		return metric, fmt.Errorf("cannot resolve MetricProviderConfig '%s': no lister configured", ref.Name)
+	}
+	config, err := f.MetricProviderConfigLister.MetricProviderConfigs(namespace).Get(ref.Name)
+	if err != nil {
+		return metric, fmt.Errorf("failed to get MetricProviderConfig '%s': %w", ref.Name, err)
+	}
+	return ApplyMetricProviderConfig(f.KubeClient, metric, config)
+}
+
+// ApplyMetricProviderConfig returns a copy of the metric using the settings of config as defaults. The
+// credentials referenced by config are read from the secrets of its namespace.
+func ApplyMetricProviderConfig(kubeClient kubernetes.Interface, metric v1alpha1.Metric, config *v1alpha1.MetricProviderConfig) (v1alpha1.Metric, error) {
+	metric = *metric.DeepCopy()
+	spec := config.Spec
+	missing := func(providerType string) error {
+		return fmt.Errorf("MetricProviderConfig '%s' has no %s settings", config.Name, providerType)
+	}
+
+	switch providerType := Type(metric); providerType {
+	case prometheus.ProviderType:
+		if spec.Prometheus == nil {
+			return metric, missing(providerType)
+		}
+		if err := applyPrometheusProviderConfig(kubeClient, config, metric.Provider.Prometheus); err != nil {
+			return metric, err
+		}
+	case sloburnrate.ProviderType:
+		if spec.Prometheus == nil {
+			return metric, missing(prometheus.ProviderType)
+		}
+		if err := applyPrometheusProviderConfig(kubeClient, config, &metric.Provider.SLOBurnRate.Prometheus); err != nil {
+			return metric, err
+		}
+	case datadog.ProviderType:
+		if spec.Datadog == nil {
+			return metric, missing(providerType)
+		}
+		if metric.Provider.Datadog.SecretRef.Name == "" {
+			metric.Provider.Datadog.SecretRef = v1alpha1.SecretRef{
+				Name:       spec.Datadog.SecretName,
+				Namespaced: true,
+			}
+		}
+		if metric.Provider.Datadog.ApiVersion == "" {
+			metric.Provider.Datadog.ApiVersion = spec.Datadog.ApiVersion
+		}
+	case newrelic.ProviderType:
+		if spec.NewRelic == nil {
+			return metric, missing(providerType)
+		}
+		if metric.Provider.NewRelic.Profile == "" {
+			metric.Provider.NewRelic.Profile = spec.NewRelic.Profile
+		}
+	case influxdb.ProviderType:
+		if spec.Influxdb == nil {
+			return metric, missing(providerType)
+		}
+		if metric.Provider.Influxdb.Profile == "" {
+			metric.Provider.Influxdb.Profile = spec.Influxdb.Profile
+		}
+	default:
+		return metric, fmt.Errorf("provider %s does not support MetricProviderConfig", providerType)
+	}
+	return metric, nil
+}
+
+func applyPrometheusProviderConfig(kubeClient kubernetes.Interface, providerConfig *v1alpha1.MetricProviderConfig, metric *v1alpha1.PrometheusMetric) error {
+	config := providerConfig.Spec.Prometheus
+	secretValue := func(ref *v1alpha1.SecretKeyRef) (string, error) {
+		if ref == nil {
+			return "", nil
+		}
+		return getSecretValue(kubeClient, providerConfig.Namespace, ref)
+	}
+	var err error
+
+	if metric.Address == "" {
+		metric.Address = config.Address
+	}
+	if metric.Authentication.Sigv4 == (v1alpha1.Sigv4Config{}) {
+		metric.Authentication.Sigv4 = config.Authentication.Sigv4
+	}
+	if oauth2 := config.Authentication.OAuth2; metric.Authentication.OAuth2.TokenURL == "" && oauth2.TokenURL != "" {
+		metric.Authentication.OAuth2 = v1alpha1.OAuth2Config{
+			TokenURL: oauth2.TokenURL,
+			ClientID: oauth2.ClientID,
+			Scopes:   append([]string(nil), oauth2.Scopes...),
+		}
+		if metric.Authentication.OAuth2.ClientSecret, err = secretValue(oauth2.ClientSecretRef); err != nil {
+			return err
+		}
+	}
+	if basicAuth := config.Authentication.BasicAuth; metric.Authentication.BasicAuth == (v1alpha1.BasicAuthConfig{}) {
+		if metric.Authentication.BasicAuth.Username, err = secretValue(basicAuth.UsernameSecretRef); err != nil {
+			return err
+		}
+		if metric.Authentication.BasicAuth.Password, err = secretValue(basicAuth.PasswordSecretRef); err != nil {
+			return err
+		}
+	}
+	if metric.Timeout == nil && config.Timeout != nil {
+		timeout := *config.Timeout
+		metric.Timeout = &timeout
+	}
+	metric.Insecure = metric.Insecure || config.Insecure
+	if len(config.Headers) > 0 {
+		headers := make([]v1alpha1.WebMetricHeader, 0, len(config.Headers)+len(metric.Headers))
+		for _, header := range config.Headers {
+			overridden := false
+			for _, metricHeader := range metric.Headers {
+				if metricHeader.Key == header.Key {
+					overridden = true
+					break
+				}
+			}
+			if !overridden {
+				headers = append(headers, header)
+			}
+		}
+		metric.Headers = append(headers, metric.Headers...)
+	}
+	return nil
+}
+
+// getSecretValue returns the value of the key of the secret referenced by ref
+func getSecretValue(kubeClient kubernetes.Interface, namespace string, ref *v1alpha1.SecretKeyRef) (string, error) {
+	secret, err := kubeClient.CoreV1().Secrets(namespace).Get(context.TODO(), ref.Name, metav1.GetOptions{})
+	if err != nil {
+		return "", err
+	}
+	value, ok := secret.Data[ref.Key]
+	if !ok {
+		return "", fmt.Errorf("key '%s' does not exist in secret '%s'", ref.Key, ref.Name)
+	}
+	return string(value), nil
+}
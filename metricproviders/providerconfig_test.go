@@ -0,0 +1,200 @@
+package metricproviders
+
+import (
+	"testing"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8sfake "k8s.io/client-go/kubernetes/fake"
+	"k8s.io/client-go/tools/cache"
+
+	"github.com/argoproj/argo-rollouts/metricproviders/prometheus"
+	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
+	listers "github.com/argoproj/argo-rollouts/pkg/client/listers/rollouts/v1alpha1"
+)
+
+func newMetricProviderConfig() *v1alpha1.MetricProviderConfig {
+	timeout := int64(10)
+	return &v1alpha1.MetricProviderConfig{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "team-backends",
+			Namespace: "team",
+		},
+		Spec: v1alpha1.MetricProviderConfigSpec{
+			Prometheus: &v1alpha1.PrometheusProviderConfig{
+				Address: "http://prometheus.team.svc:9090",
+				Authentication: v1alpha1.PrometheusProviderAuthentication{
+					BasicAuth: v1alpha1.BasicAuthProviderConfig{
+						UsernameSecretRef: &v1alpha1.SecretKeyRef{Name: "team-prometheus", Key: "username"},
+						PasswordSecretRef: &v1alpha1.SecretKeyRef{Name: "team-prometheus", Key: "password"},
+					},
+				},
+				Timeout: &timeout,
+				Headers: []v1alpha1.WebMetricHeader{
+					{Key: "X-Scope-OrgID", Value: "team"},
+					{Key: "X-Source", Value: "config"},
+				},
+			},
+			Datadog: &v1alpha1.DatadogProviderConfig{
+				SecretName: "team-datadog",
+				ApiVersion: "v2",
+			},
+			NewRelic: &v1alpha1.NewRelicProviderConfig{Profile: "team-newrelic"},
+			Influxdb: &v1alpha1.InfluxdbProviderConfig{Profile: "team-influxdb"},
+		},
+	}
+}
+
+func newPrometheusSecret() *corev1.Secret {
+	return &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "team-prometheus",
+			Namespace: "team",
+		},
+		Data: map[string][]byte{
+			"username": []byte("team"),
+			"password": []byte("secret"),
+		},
+	}
+}
+
+func TestApplyMetricProviderConfigPrometheus(t *testing.T) {
+	kubeClient := k8sfake.NewSimpleClientset(newPrometheusSecret())
+	metric := v1alpha1.Metric{
+		Name: "success-rate",
+		Provider: v1alpha1.MetricProvider{
+			Prometheus: &v1alpha1.PrometheusMetric{
+				Query:   "up",
+				Headers: []v1alpha1.WebMetricHeader{{Key: "X-Source", Value: "metric"}},
+			},
+			ConfigRef: &v1alpha1.MetricProviderConfigRef{Name: "team-backends"},
+		},
+	}
+	resolved, err := ApplyMetricProviderConfig(kubeClient, metric, newMetricProviderConfig())
+	assert.NoError(t, err)
+	assert.Equal(t, "http://prometheus.team.svc:9090", resolved.Provider.Prometheus.Address)
+	assert.Equal(t, "up", resolved.Provider.Prometheus.Query)
+	assert.Equal(t, v1alpha1.BasicAuthConfig{Username: "team", Password: "secret"}, resolved.Provider.Prometheus.Authentication.BasicAuth)
+	assert.Equal(t, int64(10), *resolved.Provider.Prometheus.Timeout)
+	assert.Equal(t, []v1alpha1.WebMetricHeader{
+		{Key: "X-Scope-OrgID", Value: "team"},
+		{Key: "X-Source", Value: "metric"},
+	}, resolved.Provider.Prometheus.Headers)
+	// the original metric is left untouched
+	assert.Equal(t, "", metric.Provider.Prometheus.Address)
+
+	metric.Provider.Prometheus.Address = "http://prometheus.override.svc:9090"
+	resolved, err = ApplyMetricProviderConfig(kubeClient, metric, newMetricProviderConfig())
+	assert.NoError(t, err)
+	assert.Equal(t, "http://prometheus.override.svc:9090", resolved.Provider.Prometheus.Address)
+}
+
+func TestApplyMetricProviderConfigSLOBurnRate(t *testing.T) {
+	metric := v1alpha1.Metric{
+		Provider: v1alpha1.MetricProvider{
+			SLOBurnRate: &v1alpha1.SLOBurnRateMetric{ErrorRatioQuery: "errors"},
+			ConfigRef:   &v1alpha1.MetricProviderConfigRef{Name: "team-backends"},
+		},
+	}
+	resolved, err := ApplyMetricProviderConfig(k8sfake.NewSimpleClientset(newPrometheusSecret()), metric, newMetricProviderConfig())
+	assert.NoError(t, err)
+	assert.Equal(t, "http://prometheus.team.svc:9090", resolved.Provider.SLOBurnRate.Prometheus.Address)
+	assert.Equal(t, "secret", resolved.Provider.SLOBurnRate.Prometheus.Authentication.BasicAuth.Password)
+}
+
+func TestApplyMetricProviderConfigOAuth2(t *testing.T) {
+	config := newMetricProviderConfig()
+	config.Spec.Prometheus.Authentication = v1alpha1.PrometheusProviderAuthentication{
+		OAuth2: v1alpha1.OAuth2ProviderConfig{
+			TokenURL:        "https://auth.example.com/token",
+			ClientID:        "team",
+			ClientSecretRef: &v1alpha1.SecretKeyRef{Name: "team-prometheus", Key: "password"},
+			Scopes:          []string{"read"},
+		},
+	}
+	resolved, err := ApplyMetricProviderConfig(k8sfake.NewSimpleClientset(newPrometheusSecret()), v1alpha1.Metric{
+		Provider: v1alpha1.MetricProvider{Prometheus: &v1alpha1.PrometheusMetric{Query: "up"}},
+	}, config)
+	assert.NoError(t, err)
+	assert.Equal(t, v1alpha1.OAuth2Config{
+		TokenURL:     "https://auth.example.com/token",
+		ClientID:     "team",
+		ClientSecret: "secret",
+		Scopes:       []string{"read"},
+	}, resolved.Provider.Prometheus.Authentication.OAuth2)
+}
+
+func TestApplyMetricProviderConfigMissingSecret(t *testing.T) {
+	metric := v1alpha1.Metric{
+		Provider: v1alpha1.MetricProvider{Prometheus: &v1alpha1.PrometheusMetric{Query: "up"}},
+	}
+	_, err := ApplyMetricProviderConfig(k8sfake.NewSimpleClientset(), metric, newMetricProviderConfig())
+	assert.EqualError(t, err, `secrets "team-prometheus" not found`)
+
+	secret := newPrometheusSecret()
+	delete(secret.Data, "password")
+	_, err = ApplyMetricProviderConfig(k8sfake.NewSimpleClientset(secret), metric, newMetricProviderConfig())
+	assert.EqualError(t, err, "key 'password' does not exist in secret 'team-prometheus'")
+}
+
+func TestApplyMetricProviderConfigSecrets(t *testing.T) {
+	config := newMetricProviderConfig()
+	kubeClient := k8sfake.NewSimpleClientset()
+
+	resolved, err := ApplyMetricProviderConfig(kubeClient, v1alpha1.Metric{
+		Provider: v1alpha1.MetricProvider{Datadog: &v1alpha1.DatadogMetric{Query: "avg:errors"}},
+	}, config)
+	assert.NoError(t, err)
+	assert.Equal(t, v1alpha1.SecretRef{Name: "team-datadog", Namespaced: true}, resolved.Provider.Datadog.SecretRef)
+	assert.Equal(t, "v2", resolved.Provider.Datadog.ApiVersion)
+
+	resolved, err = ApplyMetricProviderConfig(kubeClient, v1alpha1.Metric{
+		Provider: v1alpha1.MetricProvider{NewRelic: &v1alpha1.NewRelicMetric{Query: "SELECT 1"}},
+	}, config)
+	assert.NoError(t, err)
+	assert.Equal(t, "team-newrelic", resolved.Provider.NewRelic.Profile)
+
+	resolved, err = ApplyMetricProviderConfig(kubeClient, v1alpha1.Metric{
+		Provider: v1alpha1.MetricProvider{Influxdb: &v1alpha1.InfluxdbMetric{Query: "from()"}},
+	}, config)
+	assert.NoError(t, err)
+	assert.Equal(t, "team-influxdb", resolved.Provider.Influxdb.Profile)
+}
+
+func TestApplyMetricProviderConfigErrors(t *testing.T) {
+	config := newMetricProviderConfig()
+	config.Spec.Datadog = nil
+	_, err := ApplyMetricProviderConfig(k8sfake.NewSimpleClientset(), v1alpha1.Metric{
+		Provider: v1alpha1.MetricProvider{Datadog: &v1alpha1.DatadogMetric{}},
+	}, config)
+	assert.EqualError(t, err, "MetricProviderConfig 'team-backends' has no Datadog settings")
+
+	_, err = ApplyMetricProviderConfig(k8sfake.NewSimpleClientset(), v1alpha1.Metric{
+		Provider: v1alpha1.MetricProvider{Web: &v1alpha1.WebMetric{}},
+	}, config)
+	assert.EqualError(t, err, "provider Web does not support MetricProviderConfig")
+}
+
+func TestNewProviderWithMetricProviderConfig(t *testing.T) {
+	metric := v1alpha1.Metric{
+		Name: "success-rate",
+		Provider: v1alpha1.MetricProvider{
+			Prometheus: &v1alpha1.PrometheusMetric{Query: "up"},
+			ConfigRef:  &v1alpha1.MetricProviderConfigRef{Name: "team-backends"},
+		},
+	}
+	indexer := cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc})
+	assert.NoError(t, indexer.Add(newMetricProviderConfig()))
+	f := &ProviderFactory{
+		KubeClient:                 k8sfake.NewSimpleClientset(newPrometheusSecret()),
+		MetricProviderConfigLister: listers.NewMetricProviderConfigLister(indexer),
+	}
+	provider, err := f.NewProvider(*log.NewEntry(log.New()), "team", metric)
+	assert.NoError(t, err)
+	assert.Equal(t, prometheus.ProviderType, provider.Type())
+
+	_, err = f.NewProvider(*log.NewEntry(log.New()), "other-team", metric)
+	assert.ErrorContains(t, err, "failed to get MetricProviderConfig 'team-backends'")
+}
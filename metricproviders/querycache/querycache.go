@@ -0,0 +1,145 @@
+package querycache
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"golang.org/x/sync/singleflight"
+	"golang.org/x/time/rate"
+)
+
+// Cache is shared by the metric providers of the analysis controller to avoid overloading metric backends
+// when many AnalysisRuns execute the same queries, e.g. during a mass deploy of Rollouts sharing a
+// ClusterAnalysisTemplate. It deduplicates concurrent identical queries, caches successful results for the
+// time bucket they were executed in, and rate limits the queries sent to each backend address.
+type Cache struct {
+	ttl   time.Duration
+	qps   rate.Limit
+	burst int
+
+	group    singleflight.Group
+	mutex    sync.Mutex
+	entries  map[string]entry
+	limiters map[string]*rate.Limiter
+
+	// used for unit testing
+	now func() time.Time
+}
+
+// QueryTimeout is the maximum duration of a query shared by the callers of Do
+const QueryTimeout = time.Minute
+
+type entry struct {
+	value   any
+	expires time.Time
+}
+
+// NewCache returns a cache which keeps query results for ttl and allows qps queries per second, with bursts
+// of up to burst queries, to every backend address. A zero ttl disables caching and a zero qps disables
+// rate limiting, but concurrent identical queries are always deduplicated.
+func NewCache(ttl time.Duration, qps float32, burst int) *Cache {
+	if burst < 1 {
+		burst = 1
+	}
+	return &Cache{
+		ttl:      ttl,
+		qps:      rate.Limit(qps),
+		burst:    burst,
+		entries:  map[string]entry{},
+		limiters: map[string]*rate.Limiter{},
+		now:      time.Now,
+	}
+}
+
+// Bucket truncates t to the beginning of its time bucket. Queries relative to the current time should use
+// the bucket in their key so they are shared for the lifetime of a cache entry.
+func (c *Cache) Bucket(t time.Time) time.Time {
+	if c.ttl <= 0 {
+		return t
+	}
+	return t.Truncate(c.ttl)
+}
+
+// Do returns the result of the query identified by key against the backend at address. The result is
+// served from the cache when possible, otherwise query is executed once for all concurrent callers,
+// after waiting for the rate limiter of the address. Errors are never cached.
+//
+// As the query is shared, it runs with a context detached from the cancellation of the caller which
+// started it, limited to QueryTimeout. Every caller still stops waiting for it once its own ctx is done.
+func (c *Cache) Do(ctx context.Context, address, key string, query func(ctx context.Context) (any, error)) (any, error) {
+	key = address + "\x00" + key
+	if value, ok := c.get(key); ok {
+		return value, nil
+	}
+	results := c.group.DoChan(key, func() (any, error) {
+		queryCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), QueryTimeout)
+		defer cancel()
+		if value, ok := c.get(key); ok {
+			return value, nil
+		}
+		if err := c.limiter(address).Wait(queryCtx); err != nil {
+			return nil, err
+		}
+		value, err := query(queryCtx)
+		if err != nil {
+			return nil, err
+		}
+		c.set(key, value)
+		return value, nil
+	})
+	select {
+	case result := <-results:
+		return result.Val, result.Err
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+func (c *Cache) get(key string) (any, bool) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	e, ok := c.entries[key]
+	if !ok {
+		return nil, false
+	}
+	if !c.now().Before(e.expires) {
+		delete(c.entries, key)
+		return nil, false
+	}
+	return e.value, true
+}
+
+func (c *Cache) set(key string, value any) {
+	if c.ttl <= 0 {
+		return
+	}
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	now := c.now()
+	// drop expired entries so results of one-off queries do not accumulate
+	for k, e := range c.entries {
+		if !now.Before(e.expires) {
+			delete(c.entries, k)
+		}
+	}
+	c.entries[key] = entry{
+		value:   value,
+		expires: c.Bucket(now).Add(c.ttl),
+	}
+}
+
+func (c *Cache) limiter(address string) *rate.Limiter {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	limiter, ok := c.limiters[address]
+	if !ok {
+		limit := c.qps
+		if limit <= 0 {
+			limit = rate.Inf
+		}
+		limiter = rate.NewLimiter(limit, c.burst)
+		c.limiters[address] = limiter
+	}
+	return limiter
+}
@@ -0,0 +1,157 @@
+package querycache
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDoCachesWithinBucket(t *testing.T) {
+	now := time.Date(2024, 1, 1, 0, 0, 10, 0, time.UTC)
+	c := NewCache(30*time.Second, 0, 0)
+	c.now = func() time.Time { return now }
+
+	calls := 0
+	query := func(context.Context) (any, error) {
+		calls++
+		return calls, nil
+	}
+
+	value, err := c.Do(context.Background(), "http://prometheus", "up", query)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, value)
+
+	now = now.Add(15 * time.Second)
+	value, err = c.Do(context.Background(), "http://prometheus", "up", query)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, value)
+
+	// same query against another backend is not shared
+	value, err = c.Do(context.Background(), "http://other", "up", query)
+	assert.NoError(t, err)
+	assert.Equal(t, 2, value)
+
+	// entries expire at the end of the bucket they were created in
+	now = now.Add(5 * time.Second)
+	value, err = c.Do(context.Background(), "http://prometheus", "up", query)
+	assert.NoError(t, err)
+	assert.Equal(t, 3, value)
+}
+
+func TestDoDoesNotCacheErrors(t *testing.T) {
+	c := NewCache(time.Minute, 0, 0)
+	calls := 0
+	query := func(context.Context) (any, error) {
+		calls++
+		if calls == 1 {
+			return nil, errors.New("intermittent error")
+		}
+		return "ok", nil
+	}
+	_, err := c.Do(context.Background(), "http://prometheus", "up", query)
+	assert.EqualError(t, err, "intermittent error")
+	value, err := c.Do(context.Background(), "http://prometheus", "up", query)
+	assert.NoError(t, err)
+	assert.Equal(t, "ok", value)
+	assert.Equal(t, 2, calls)
+}
+
+func TestDoWithoutTTL(t *testing.T) {
+	c := NewCache(0, 0, 0)
+	now := time.Now()
+	assert.Equal(t, now, c.Bucket(now))
+	calls := 0
+	query := func(context.Context) (any, error) {
+		calls++
+		return calls, nil
+	}
+	c.Do(context.Background(), "http://prometheus", "up", query)
+	value, _ := c.Do(context.Background(), "http://prometheus", "up", query)
+	assert.Equal(t, 2, value)
+}
+
+func TestDoDeduplicatesConcurrentQueries(t *testing.T) {
+	c := NewCache(0, 0, 0)
+	var calls int32
+	release := make(chan struct{})
+	query := func(context.Context) (any, error) {
+		atomic.AddInt32(&calls, 1)
+		<-release
+		return "ok", nil
+	}
+
+	wg := sync.WaitGroup{}
+	started := sync.WaitGroup{}
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		started.Add(1)
+		go func() {
+			defer wg.Done()
+			started.Done()
+			value, err := c.Do(context.Background(), "http://prometheus", "up", query)
+			assert.NoError(t, err)
+			assert.Equal(t, "ok", value)
+		}()
+	}
+	started.Wait()
+	// give the goroutines time to join the in-flight query
+	time.Sleep(50 * time.Millisecond)
+	close(release)
+	wg.Wait()
+	assert.Equal(t, int32(1), atomic.LoadInt32(&calls))
+}
+
+func TestDoIsNotCanceledByFirstCaller(t *testing.T) {
+	c := NewCache(0, 0, 0)
+	release := make(chan struct{})
+	query := func(ctx context.Context) (any, error) {
+		<-release
+		return "ok", ctx.Err()
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	first := make(chan error)
+	go func() {
+		_, err := c.Do(ctx, "http://prometheus", "up", query)
+		first <- err
+	}()
+	// give the first caller time to start the query
+	time.Sleep(50 * time.Millisecond)
+	second := make(chan any)
+	go func() {
+		value, err := c.Do(context.Background(), "http://prometheus", "up", query)
+		assert.NoError(t, err)
+		second <- value
+	}()
+	time.Sleep(50 * time.Millisecond)
+
+	// the first caller stops waiting, but the query keeps running for the second one
+	cancel()
+	assert.ErrorIs(t, <-first, context.Canceled)
+	close(release)
+	assert.Equal(t, "ok", <-second)
+}
+
+func TestDoRateLimitsPerAddress(t *testing.T) {
+	c := NewCache(0, 1, 1)
+	query := func(context.Context) (any, error) {
+		return "ok", nil
+	}
+	_, err := c.Do(context.Background(), "http://prometheus", "first", query)
+	assert.NoError(t, err)
+
+	// the burst is exhausted for this address so the next query must wait longer than the deadline
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	_, err = c.Do(ctx, "http://prometheus", "second", query)
+	assert.Error(t, err)
+
+	// other addresses have their own limit
+	_, err = c.Do(context.Background(), "http://other", "second", query)
+	assert.NoError(t, err)
+}
@@ -11,6 +11,7 @@ import (
 	"k8s.io/client-go/kubernetes"
 
 	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
+	analysisutil "github.com/argoproj/argo-rollouts/utils/analysis"
 	"github.com/argoproj/argo-rollouts/utils/evaluate"
 	metricutil "github.com/argoproj/argo-rollouts/utils/metric"
 	timeutil "github.com/argoproj/argo-rollouts/utils/time"
@@ -70,7 +71,7 @@ func (p *Provider) Run(run *v1alpha1.AnalysisRun, metric v1alpha1.Metric) v1alph
 		return metricutil.MarkMeasurementError(newMeasurement, err)
 	}
 
-	valueStr, newStatus, err := p.processResponse(metric, results)
+	valueStr, newStatus, err := p.processResponse(metric, results, analysisutil.ArrayMeasurement(run, metric.Name))
 	if err != nil {
 		return metricutil.MarkMeasurementError(newMeasurement, err)
 	}
@@ -90,7 +91,7 @@ func toJSONString(v any) (string, error) {
 	return string(b), nil
 }
 
-func (p *Provider) processResponse(metric v1alpha1.Metric, result any) (string, v1alpha1.AnalysisPhase, error) {
+func (p *Provider) processResponse(metric v1alpha1.Metric, result any, history []v1alpha1.Measurement) (string, v1alpha1.AnalysisPhase, error) {
 	if result == nil {
 		return "", v1alpha1.AnalysisPhaseFailed, fmt.Errorf("no results returned from SkyWalking query")
 	}
@@ -98,7 +99,7 @@ func (p *Provider) processResponse(metric v1alpha1.Metric, result any) (string,
 	if err != nil {
 		return "", v1alpha1.AnalysisPhaseError, fmt.Errorf("could not marshal results: %w", err)
 	}
-	newStatus, err := evaluate.EvaluateResult(result, metric, p.logCtx)
+	newStatus, err := evaluate.EvaluateResultWithHistory(result, metric, history, p.logCtx)
 	return valueStr, newStatus, err
 }
 
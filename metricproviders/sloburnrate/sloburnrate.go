@@ -0,0 +1,246 @@
+package sloburnrate
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+
+	v1 "github.com/prometheus/client_golang/api/prometheus/v1"
+	"github.com/prometheus/common/model"
+	log "github.com/sirupsen/logrus"
+
+	"github.com/argoproj/argo-rollouts/metricproviders/prometheus"
+	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
+	metricutil "github.com/argoproj/argo-rollouts/utils/metric"
+	timeutil "github.com/argoproj/argo-rollouts/utils/time"
+)
+
+const (
+	// ProviderType indicates the provider is an SLO burn rate evaluation
+	ProviderType = "SLOBurnRate"
+	// ResolvedErrorRatioQuery is used as the key for storing the resolved error ratio query in the metrics result
+	// metadata object.
+	ResolvedErrorRatioQuery = "ResolvedErrorRatioQuery"
+	// WindowPlaceholder is replaced in the error ratio query by the duration of the window it is evaluated over
+	WindowPlaceholder = "$window"
+
+	defaultTimeout = 30 * time.Second
+)
+
+// Provider computes the burn rate of an SLO error budget from prometheus queries
+type Provider struct {
+	api     v1.API
+	logCtx  log.Entry
+	timeout time.Duration
+}
+
+// window is a parsed SLOBurnRateWindow
+type window struct {
+	short    time.Duration
+	long     time.Duration
+	burnRate float64
+}
+
+// Type indicates provider is an SLO burn rate provider
+func (p *Provider) Type() string {
+	return ProviderType
+}
+
+// GetMetadata returns any additional metadata which needs to be stored & displayed as part of the metrics result.
+func (p *Provider) GetMetadata(metric v1alpha1.Metric) map[string]string {
+	metricsMetadata := make(map[string]string)
+	if metric.Provider.SLOBurnRate.ErrorRatioQuery != "" {
+		metricsMetadata[ResolvedErrorRatioQuery] = metric.Provider.SLOBurnRate.ErrorRatioQuery
+	}
+	return metricsMetadata
+}
+
+// Run computes the burn rate over every window of the metric and fails the measurement when both the
+// short and the long window of any pair exceed the pair's threshold
+func (p *Provider) Run(run *v1alpha1.AnalysisRun, metric v1alpha1.Metric) v1alpha1.Measurement {
+	startTime := timeutil.MetaNow()
+	newMeasurement := v1alpha1.Measurement{
+		StartedAt: &startTime,
+	}
+
+	slo := metric.Provider.SLOBurnRate
+	target, windows, err := parseSLO(slo)
+	if err != nil {
+		return metricutil.MarkMeasurementError(newMeasurement, err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), p.timeout)
+	defer cancel()
+
+	now := time.Now()
+	burnRates := map[string]float64{}
+	burnRateOver := func(d time.Duration) (float64, error) {
+		key := model.Duration(d).String()
+		if burnRate, ok := burnRates[key]; ok {
+			return burnRate, nil
+		}
+		errorRatio, err := p.errorRatio(ctx, slo.ErrorRatioQuery, d, now)
+		if err != nil {
+			return 0, fmt.Errorf("failed to compute error ratio over %s: %w", key, err)
+		}
+		burnRate := errorRatio / (1 - target)
+		burnRates[key] = burnRate
+		return burnRate, nil
+	}
+
+	newMeasurement.Phase = v1alpha1.AnalysisPhaseSuccessful
+	for _, w := range windows {
+		short, err := burnRateOver(w.short)
+		if err != nil {
+			return metricutil.MarkMeasurementError(newMeasurement, err)
+		}
+		long, err := burnRateOver(w.long)
+		if err != nil {
+			return metricutil.MarkMeasurementError(newMeasurement, err)
+		}
+		if short > w.burnRate && long > w.burnRate {
+			p.logCtx.Infof("Burn rate over %s (%g) and %s (%g) exceeds %g", model.Duration(w.short), short, model.Duration(w.long), long, w.burnRate)
+			newMeasurement.Phase = v1alpha1.AnalysisPhaseFailed
+		}
+	}
+
+	value, err := json.Marshal(burnRates)
+	if err != nil {
+		return metricutil.MarkMeasurementError(newMeasurement, err)
+	}
+	newMeasurement.Value = string(value)
+	finishedTime := timeutil.MetaNow()
+	newMeasurement.FinishedAt = &finishedTime
+	return newMeasurement
+}
+
+// errorRatio evaluates the error ratio query over the window at the given time. The query must return a
+// single value: averaging the ratios of several series would weigh the series regardless of their traffic.
+// A ratio which is not a number, the result of a division by zero when there was no traffic in the window,
+// is a ratio of 0.
+func (p *Provider) errorRatio(ctx context.Context, query string, window time.Duration, ts time.Time) (float64, error) {
+	query = strings.ReplaceAll(query, WindowPlaceholder, model.Duration(window).String())
+	response, warnings, err := p.api.Query(ctx, query, ts)
+	if err != nil {
+		return 0, err
+	}
+	if len(warnings) > 0 {
+		p.logCtx.Warnf("Prometheus returned the following warnings: %v", warnings)
+	}
+	var ratio float64
+	switch value := response.(type) {
+	case *model.Scalar:
+		ratio = float64(value.Value)
+	case model.Vector:
+		if len(value) == 0 {
+			return 0, errors.New("query returned no data")
+		}
+		if len(value) > 1 {
+			return 0, fmt.Errorf("query returned %d series, expected a single error ratio (aggregate the query, e.g. with sum)", len(value))
+		}
+		ratio = float64(value[0].Value)
+	default:
+		return 0, fmt.Errorf("unexpected prometheus response type %s", response.Type())
+	}
+	if math.IsNaN(ratio) {
+		return 0, nil
+	}
+	if math.IsInf(ratio, 0) || ratio < 0 {
+		return 0, fmt.Errorf("query returned %v, expected an error ratio between 0 and 1", ratio)
+	}
+	return ratio, nil
+}
+
+// Resume should not be used the SLO burn rate provider since all the work should occur in the Run method
+func (p *Provider) Resume(run *v1alpha1.AnalysisRun, metric v1alpha1.Metric, measurement v1alpha1.Measurement) v1alpha1.Measurement {
+	p.logCtx.Warn("SLO burn rate provider should not execute the Resume method")
+	return measurement
+}
+
+// Terminate should not be used the SLO burn rate provider since all the work should occur in the Run method
+func (p *Provider) Terminate(run *v1alpha1.AnalysisRun, metric v1alpha1.Metric, measurement v1alpha1.Measurement) v1alpha1.Measurement {
+	p.logCtx.Warn("SLO burn rate provider should not execute the Terminate method")
+	return measurement
+}
+
+// GarbageCollect is a no-op for the SLO burn rate provider
+func (p *Provider) GarbageCollect(run *v1alpha1.AnalysisRun, metric v1alpha1.Metric, limit int) error {
+	return nil
+}
+
+func parseSLO(slo *v1alpha1.SLOBurnRateMetric) (float64, []window, error) {
+	if slo.ErrorRatioQuery == "" {
+		return 0, nil, errors.New("errorRatioQuery is required")
+	}
+	if !strings.Contains(slo.ErrorRatioQuery, WindowPlaceholder) {
+		return 0, nil, fmt.Errorf("errorRatioQuery must use %s as the range of its rates so it is evaluated over each window", WindowPlaceholder)
+	}
+	target, err := strconv.ParseFloat(slo.Target, 64)
+	if err != nil {
+		return 0, nil, fmt.Errorf("failed to parse target '%s': %w", slo.Target, err)
+	}
+	if target <= 0 || target >= 1 {
+		return 0, nil, fmt.Errorf("target must be between 0 and 1 exclusive, got '%s'", slo.Target)
+	}
+	if len(slo.Windows) == 0 {
+		return 0, nil, errors.New("at least one window is required")
+	}
+	windows := make([]window, 0, len(slo.Windows))
+	for i, w := range slo.Windows {
+		short, err := w.ShortWindow.Duration()
+		if err != nil {
+			return 0, nil, fmt.Errorf("windows[%d]: failed to parse shortWindow as duration: %w", i, err)
+		}
+		long, err := w.LongWindow.Duration()
+		if err != nil {
+			return 0, nil, fmt.Errorf("windows[%d]: failed to parse longWindow as duration: %w", i, err)
+		}
+		if short <= 0 || long <= short {
+			return 0, nil, fmt.Errorf("windows[%d]: shortWindow must be positive and shorter than longWindow", i)
+		}
+		burnRate, err := strconv.ParseFloat(w.BurnRate, 64)
+		if err != nil {
+			return 0, nil, fmt.Errorf("windows[%d]: failed to parse burnRate '%s': %w", i, w.BurnRate, err)
+		}
+		windows = append(windows, window{short: short, long: long, burnRate: burnRate})
+	}
+	return target, windows, nil
+}
+
+// NewSLOBurnRateProvider creates a new SLO burn rate provider
+func NewSLOBurnRateProvider(api v1.API, logCtx log.Entry, metric v1alpha1.Metric) (*Provider, error) {
+	provider := &Provider{
+		logCtx:  logCtx,
+		api:     api,
+		timeout: defaultTimeout,
+	}
+	if metric.Provider.SLOBurnRate == nil {
+		return nil, errors.New("sloBurnRate is not configured")
+	}
+	if _, _, err := parseSLO(metric.Provider.SLOBurnRate); err != nil {
+		return nil, err
+	}
+	if metricTimeout := metric.Provider.SLOBurnRate.Prometheus.Timeout; metricTimeout != nil {
+		if *metricTimeout < 0 {
+			return nil, errors.New("prometheus timeout should not be negative")
+		}
+		provider.timeout = time.Duration(*metricTimeout * int64(time.Second))
+	}
+	return provider, nil
+}
+
+// NewPrometheusAPI generates a prometheus API for the prometheus server of the SLO
+func NewPrometheusAPI(metric v1alpha1.Metric) (v1.API, error) {
+	prometheusMetric := metric.Provider.SLOBurnRate.Prometheus.DeepCopy()
+	return prometheus.NewPrometheusAPI(v1alpha1.Metric{
+		Name: metric.Name,
+		Provider: v1alpha1.MetricProvider{
+			Prometheus: prometheusMetric,
+		},
+	})
+}
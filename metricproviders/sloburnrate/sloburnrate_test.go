@@ -0,0 +1,232 @@
+package sloburnrate
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math"
+	"regexp"
+	"testing"
+	"time"
+
+	v1 "github.com/prometheus/client_golang/api/prometheus/v1"
+	"github.com/prometheus/common/model"
+	log "github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
+)
+
+// mockAPI returns one sample per error ratio configured for the window of the query
+type mockAPI struct {
+	v1.API
+	errorRatios map[string][]float64
+	err         error
+	queries     []string
+}
+
+var windowRegex = regexp.MustCompile(`\[(\w+)\]`)
+
+func (m *mockAPI) Query(ctx context.Context, query string, ts time.Time, opts ...v1.Option) (model.Value, v1.Warnings, error) {
+	m.queries = append(m.queries, query)
+	if m.err != nil {
+		return nil, nil, m.err
+	}
+	vector := model.Vector{}
+	for _, v := range m.errorRatios[windowRegex.FindStringSubmatch(query)[1]] {
+		vector = append(vector, &model.Sample{Value: model.SampleValue(v)})
+	}
+	return vector, nil, nil
+}
+
+func newMetric() v1alpha1.Metric {
+	return v1alpha1.Metric{
+		Name: "slo",
+		Provider: v1alpha1.MetricProvider{
+			SLOBurnRate: &v1alpha1.SLOBurnRateMetric{
+				Prometheus: v1alpha1.PrometheusMetric{
+					Address: "http://prometheus.example.com:9090",
+				},
+				ErrorRatioQuery: "sum(rate(errors[$window])) / sum(rate(requests[$window]))",
+				Target:          "0.99",
+				Windows: []v1alpha1.SLOBurnRateWindow{
+					{ShortWindow: "5m", LongWindow: "1h", BurnRate: "14.4"},
+					{ShortWindow: "30m", LongWindow: "6h", BurnRate: "6"},
+				},
+			},
+		},
+	}
+}
+
+func TestType(t *testing.T) {
+	p, err := NewSLOBurnRateProvider(&mockAPI{}, *log.NewEntry(log.New()), newMetric())
+	assert.NoError(t, err)
+	assert.Equal(t, ProviderType, p.Type())
+	assert.Equal(t, map[string]string{ResolvedErrorRatioQuery: newMetric().Provider.SLOBurnRate.ErrorRatioQuery}, p.GetMetadata(newMetric()))
+}
+
+func TestRunSuccessful(t *testing.T) {
+	mock := &mockAPI{
+		errorRatios: map[string][]float64{
+			// only the short window burns fast
+			"5m":  {0.2},
+			"1h":  {0.055},
+			"30m": {0.01},
+			// no traffic
+			"6h": {math.NaN()},
+		},
+	}
+	p, err := NewSLOBurnRateProvider(mock, *log.NewEntry(log.New()), newMetric())
+	assert.NoError(t, err)
+	measurement := p.Run(&v1alpha1.AnalysisRun{}, newMetric())
+	assert.Equal(t, v1alpha1.AnalysisPhaseSuccessful, measurement.Phase)
+	assert.NotNil(t, measurement.StartedAt)
+	assert.NotNil(t, measurement.FinishedAt)
+	assert.Equal(t, []string{
+		"sum(rate(errors[5m])) / sum(rate(requests[5m]))",
+		"sum(rate(errors[1h])) / sum(rate(requests[1h]))",
+		"sum(rate(errors[30m])) / sum(rate(requests[30m]))",
+		"sum(rate(errors[6h])) / sum(rate(requests[6h]))",
+	}, mock.queries)
+
+	var burnRates map[string]float64
+	assert.NoError(t, json.Unmarshal([]byte(measurement.Value), &burnRates))
+	assert.InDelta(t, 20, burnRates["5m"], 0.0001)
+	assert.InDelta(t, 5.5, burnRates["1h"], 0.0001)
+	assert.InDelta(t, 1, burnRates["30m"], 0.0001)
+	assert.InDelta(t, 0, burnRates["6h"], 0.0001)
+}
+
+func TestRunFailed(t *testing.T) {
+	mock := &mockAPI{
+		errorRatios: map[string][]float64{
+			"5m":  {0.01},
+			"1h":  {0.01},
+			"30m": {0.07},
+			"6h":  {0.07},
+		},
+	}
+	p, err := NewSLOBurnRateProvider(mock, *log.NewEntry(log.New()), newMetric())
+	assert.NoError(t, err)
+	measurement := p.Run(&v1alpha1.AnalysisRun{}, newMetric())
+	assert.Equal(t, v1alpha1.AnalysisPhaseFailed, measurement.Phase)
+}
+
+func TestRunReusesWindows(t *testing.T) {
+	metric := newMetric()
+	metric.Provider.SLOBurnRate.Windows = []v1alpha1.SLOBurnRateWindow{
+		{ShortWindow: "5m", LongWindow: "1h", BurnRate: "14.4"},
+		{ShortWindow: "1h", LongWindow: "6h", BurnRate: "6"},
+	}
+	mock := &mockAPI{
+		errorRatios: map[string][]float64{
+			"5m": {0.01},
+			"1h": {0.01},
+			"6h": {0.01},
+		},
+	}
+	p, err := NewSLOBurnRateProvider(mock, *log.NewEntry(log.New()), metric)
+	assert.NoError(t, err)
+	measurement := p.Run(&v1alpha1.AnalysisRun{}, metric)
+	assert.Equal(t, v1alpha1.AnalysisPhaseSuccessful, measurement.Phase)
+	assert.Len(t, mock.queries, 3)
+}
+
+func TestRunError(t *testing.T) {
+	mock := &mockAPI{err: fmt.Errorf("bad big bug :(")}
+	p, err := NewSLOBurnRateProvider(mock, *log.NewEntry(log.New()), newMetric())
+	assert.NoError(t, err)
+	measurement := p.Run(&v1alpha1.AnalysisRun{}, newMetric())
+	assert.Equal(t, v1alpha1.AnalysisPhaseError, measurement.Phase)
+	assert.Equal(t, "failed to compute error ratio over 5m: bad big bug :(", measurement.Message)
+}
+
+func TestRunNoData(t *testing.T) {
+	mock := &mockAPI{}
+	p, err := NewSLOBurnRateProvider(mock, *log.NewEntry(log.New()), newMetric())
+	assert.NoError(t, err)
+	measurement := p.Run(&v1alpha1.AnalysisRun{}, newMetric())
+	assert.Equal(t, v1alpha1.AnalysisPhaseError, measurement.Phase)
+	assert.Equal(t, "failed to compute error ratio over 5m: query returned no data", measurement.Message)
+}
+
+func TestRunMultipleSeries(t *testing.T) {
+	mock := &mockAPI{
+		errorRatios: map[string][]float64{
+			"5m": {0.5, 0.01},
+		},
+	}
+	p, err := NewSLOBurnRateProvider(mock, *log.NewEntry(log.New()), newMetric())
+	assert.NoError(t, err)
+	measurement := p.Run(&v1alpha1.AnalysisRun{}, newMetric())
+	assert.Equal(t, v1alpha1.AnalysisPhaseError, measurement.Phase)
+	assert.Equal(t, "failed to compute error ratio over 5m: query returned 2 series, expected a single error ratio (aggregate the query, e.g. with sum)", measurement.Message)
+}
+
+func TestNewSLOBurnRateProviderValidation(t *testing.T) {
+	tests := []struct {
+		name   string
+		modify func(slo *v1alpha1.SLOBurnRateMetric)
+		err    string
+	}{
+		{
+			name:   "missing query",
+			modify: func(slo *v1alpha1.SLOBurnRateMetric) { slo.ErrorRatioQuery = "" },
+			err:    "errorRatioQuery is required",
+		},
+		{
+			name:   "invalid target",
+			modify: func(slo *v1alpha1.SLOBurnRateMetric) { slo.Target = "99.9" },
+			err:    "target must be between 0 and 1 exclusive, got '99.9'",
+		},
+		{
+			name: "query without window",
+			modify: func(slo *v1alpha1.SLOBurnRateMetric) {
+				slo.ErrorRatioQuery = "sum(rate(errors[5m])) / sum(rate(requests[5m]))"
+			},
+			err: "errorRatioQuery must use $window as the range of its rates",
+		},
+		{
+			name:   "no windows",
+			modify: func(slo *v1alpha1.SLOBurnRateMetric) { slo.Windows = nil },
+			err:    "at least one window is required",
+		},
+		{
+			name:   "short window longer than long window",
+			modify: func(slo *v1alpha1.SLOBurnRateMetric) { slo.Windows[1].ShortWindow = "12h" },
+			err:    "windows[1]: shortWindow must be positive and shorter than longWindow",
+		},
+		{
+			name:   "invalid burn rate",
+			modify: func(slo *v1alpha1.SLOBurnRateMetric) { slo.Windows[0].BurnRate = "fast" },
+			err:    "windows[0]: failed to parse burnRate 'fast'",
+		},
+		{
+			name: "negative timeout",
+			modify: func(slo *v1alpha1.SLOBurnRateMetric) {
+				timeout := int64(-1)
+				slo.Prometheus.Timeout = &timeout
+			},
+			err: "prometheus timeout should not be negative",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			metric := newMetric()
+			test.modify(metric.Provider.SLOBurnRate)
+			_, err := NewSLOBurnRateProvider(&mockAPI{}, *log.NewEntry(log.New()), metric)
+			assert.ErrorContains(t, err, test.err)
+		})
+	}
+}
+
+func TestNewPrometheusAPI(t *testing.T) {
+	metric := newMetric()
+	_, err := NewPrometheusAPI(metric)
+	assert.NoError(t, err)
+	assert.Nil(t, metric.Provider.Prometheus)
+
+	metric.Provider.SLOBurnRate.Prometheus.Address = ":::"
+	_, err = NewPrometheusAPI(metric)
+	assert.Error(t, err)
+}
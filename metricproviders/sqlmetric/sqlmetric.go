@@ -0,0 +1,261 @@
+package sqlmetric
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+	"sync"
+	"time"
+
+	// database/sql drivers registered for the binaries running the provider, the controller and the CLI
+	_ "github.com/go-sql-driver/mysql"
+	_ "github.com/lib/pq"
+	log "github.com/sirupsen/logrus"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+
+	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
+	analysisutil "github.com/argoproj/argo-rollouts/utils/analysis"
+	"github.com/argoproj/argo-rollouts/utils/defaults"
+	"github.com/argoproj/argo-rollouts/utils/evaluate"
+	metricutil "github.com/argoproj/argo-rollouts/utils/metric"
+	timeutil "github.com/argoproj/argo-rollouts/utils/time"
+)
+
+const (
+	// ProviderType indicates the provider is a SQL query
+	ProviderType = "SQL"
+	// SQLDriverKey is the key of the secret holding the name of the database driver
+	SQLDriverKey = "driver"
+	// SQLDSNKey is the key of the secret holding the data source name
+	SQLDSNKey = "dsn"
+	// DefaultTimeout is the timeout of queries of metrics without a timeout
+	DefaultTimeout = 10 * time.Second
+	// maxOpenConns bounds the connections opened to a database by all the metrics sharing its data source
+	maxOpenConns = 5
+	// connMaxIdleTime closes the connections to databases which are no longer queried
+	connMaxIdleTime = 5 * time.Minute
+)
+
+var (
+	// dbs are the connection pools of the data sources, shared by all the measurements
+	dbs      = map[string]*sql.DB{}
+	dbsMutex sync.Mutex
+)
+
+// getDB returns the connection pool of the data source
+func getDB(driver, dsn string) (*sql.DB, error) {
+	dbsMutex.Lock()
+	defer dbsMutex.Unlock()
+	key := driver + "\x00" + dsn
+	if db, ok := dbs[key]; ok {
+		return db, nil
+	}
+	db, err := sql.Open(driver, dsn)
+	if err != nil {
+		return nil, err
+	}
+	db.SetMaxOpenConns(maxOpenConns)
+	db.SetConnMaxIdleTime(connMaxIdleTime)
+	dbs[key] = db
+	return db, nil
+}
+
+// Provider contains all the required components to run a SQL query
+// Implements the Provider Interface
+type Provider struct {
+	logCtx log.Entry
+	driver string
+	dsn    string
+}
+
+// Type indicates provider is a SQL provider
+func (p *Provider) Type() string {
+	return ProviderType
+}
+
+// GetMetadata returns any additional metadata which needs to be stored & displayed as part of the metrics result.
+func (p *Provider) GetMetadata(metric v1alpha1.Metric) map[string]string {
+	return nil
+}
+
+func (p *Provider) Run(run *v1alpha1.AnalysisRun, metric v1alpha1.Metric) v1alpha1.Measurement {
+	startTime := timeutil.MetaNow()
+	measurement := v1alpha1.Measurement{
+		StartedAt: &startTime,
+	}
+
+	result, err := p.query(metric.Provider.SQL)
+	if err != nil {
+		return metricutil.MarkMeasurementError(measurement, err)
+	}
+	valueBytes, err := json.Marshal(result)
+	if err != nil {
+		return metricutil.MarkMeasurementError(measurement, err)
+	}
+	status, err := evaluate.EvaluateResultWithHistory(result, metric, analysisutil.ArrayMeasurement(run, metric.Name), p.logCtx)
+	if err != nil {
+		return metricutil.MarkMeasurementError(measurement, err)
+	}
+
+	measurement.Value = string(valueBytes)
+	measurement.Phase = status
+	finishedTime := timeutil.MetaNow()
+	measurement.FinishedAt = &finishedTime
+	return measurement
+}
+
+// query runs the query of the metric in a read-only transaction, binding the args of the metric to its
+// placeholders, and returns its result
+func (p *Provider) query(metric *v1alpha1.SQLMetric) (any, error) {
+	timeout := DefaultTimeout
+	if metric.Timeout != "" {
+		timeout, _ = metric.Timeout.Duration()
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+
+	db, err := getDB(p.driver, p.dsn)
+	if err != nil {
+		return nil, err
+	}
+	tx, err := db.BeginTx(ctx, &sql.TxOptions{ReadOnly: true})
+	if err != nil {
+		return nil, err
+	}
+	// the transaction only guards against statements writing to the database, it is never committed
+	defer tx.Rollback()
+
+	args := make([]any, len(metric.Args))
+	for i, arg := range metric.Args {
+		args[i] = arg
+	}
+	rows, err := tx.QueryContext(ctx, metric.Query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	columns, err := rows.Columns()
+	if err != nil {
+		return nil, err
+	}
+	resultSet := []map[string]any{}
+	for rows.Next() {
+		values := make([]any, len(columns))
+		pointers := make([]any, len(columns))
+		for i := range values {
+			pointers[i] = &values[i]
+		}
+		if err := rows.Scan(pointers...); err != nil {
+			return nil, err
+		}
+		row := make(map[string]any, len(columns))
+		for i, column := range columns {
+			row[column] = convertValue(values[i])
+		}
+		resultSet = append(resultSet, row)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	if metric.ResultSet {
+		return resultSet, nil
+	}
+	if len(columns) != 1 || len(resultSet) != 1 {
+		return nil, fmt.Errorf("query returned %d rows with %d columns, expected a single value (use resultSet to evaluate several rows or columns)", len(resultSet), len(columns))
+	}
+	return resultSet[0][columns[0]], nil
+}
+
+// convertValue converts a scanned value to a type usable in conditions. Drivers returning numbers or
+// decimals as bytes, e.g. for NUMERIC columns, have them converted to numbers.
+func convertValue(value any) any {
+	switch v := value.(type) {
+	case []byte:
+		s := string(v)
+		if f, err := strconv.ParseFloat(s, 64); err == nil {
+			return f
+		}
+		return s
+	case int64:
+		return float64(v)
+	case float32:
+		return float64(v)
+	case time.Time:
+		return v.Format(time.RFC3339Nano)
+	default:
+		return v
+	}
+}
+
+// Resume should not be used the SQL provider since all the work should occur in the Run method
+func (p *Provider) Resume(run *v1alpha1.AnalysisRun, metric v1alpha1.Metric, measurement v1alpha1.Measurement) v1alpha1.Measurement {
+	p.logCtx.Warn("SQL provider should not execute the Resume method")
+	return measurement
+}
+
+// Terminate should not be used the SQL provider since all the work should occur in the Run method
+func (p *Provider) Terminate(run *v1alpha1.AnalysisRun, metric v1alpha1.Metric, measurement v1alpha1.Measurement) v1alpha1.Measurement {
+	p.logCtx.Warn("SQL provider should not execute the Terminate method")
+	return measurement
+}
+
+// GarbageCollect is a no-op for the SQL provider
+func (p *Provider) GarbageCollect(run *v1alpha1.AnalysisRun, metric v1alpha1.Metric, limit int) error {
+	return nil
+}
+
+func validateIncomingProps(metric *v1alpha1.SQLMetric) error {
+	if metric.Query == "" {
+		return errors.New("query is required")
+	}
+	if metric.SecretRef.Name == "" {
+		return errors.New("secret name is required")
+	}
+	if metric.Timeout != "" {
+		timeout, err := metric.Timeout.Duration()
+		if err != nil {
+			return fmt.Errorf("could not parse the timeout: %v", err)
+		}
+		if timeout <= 0 {
+			return errors.New("timeout must be a positive duration")
+		}
+	}
+	return nil
+}
+
+// NewSQLProvider returns a provider querying the database of the secret referenced by the metric. The secret is
+// looked up in the controller namespace unless it is namespaced. The driver must be registered in the binary.
+func NewSQLProvider(logCtx log.Entry, kubeclientset kubernetes.Interface, namespace string, metric v1alpha1.Metric) (*Provider, error) {
+	spec := metric.Provider.SQL
+	if err := validateIncomingProps(spec); err != nil {
+		return nil, err
+	}
+	secretNamespace := defaults.Namespace()
+	if spec.SecretRef.Namespaced {
+		secretNamespace = namespace
+	}
+	secret, err := kubeclientset.CoreV1().Secrets(secretNamespace).Get(context.TODO(), spec.SecretRef.Name, metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+	driver := string(secret.Data[SQLDriverKey])
+	dsn := string(secret.Data[SQLDSNKey])
+	if driver == "" || dsn == "" {
+		return nil, fmt.Errorf("secret %s/%s must hold the %s and %s keys", secretNamespace, spec.SecretRef.Name, SQLDriverKey, SQLDSNKey)
+	}
+	if !slices.Contains(sql.Drivers(), driver) {
+		return nil, fmt.Errorf("sql driver %s is not available", driver)
+	}
+	return &Provider{
+		logCtx: logCtx,
+		driver: driver,
+		dsn:    dsn,
+	}, nil
+}
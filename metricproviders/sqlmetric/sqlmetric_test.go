@@ -0,0 +1,256 @@
+package sqlmetric
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"io"
+	"testing"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8sfake "k8s.io/client-go/kubernetes/fake"
+
+	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
+	"github.com/argoproj/argo-rollouts/utils/defaults"
+)
+
+// fakeDriver serves the results of queries from fakeResults, recording the arguments bound to them
+type fakeDriver struct{}
+
+type fakeResult struct {
+	columns []string
+	rows    [][]driver.Value
+}
+
+var (
+	fakeResults = map[string]fakeResult{
+		"SELECT conversion_rate FROM kpis WHERE version = $1": {
+			columns: []string{"conversion_rate"},
+			rows:    [][]driver.Value{{[]byte("0.042")}},
+		},
+		"SELECT region, success FROM checkouts": {
+			columns: []string{"region", "success"},
+			rows: [][]driver.Value{
+				{"eu", int64(98)},
+				{"us", int64(99)},
+			},
+		},
+	}
+	fakeArgs     []driver.NamedValue
+	fakeReadOnly bool
+)
+
+func init() {
+	sql.Register("fake", fakeDriver{})
+}
+
+func (fakeDriver) Open(name string) (driver.Conn, error) {
+	return fakeConn{}, nil
+}
+
+type fakeConn struct{}
+
+func (fakeConn) Prepare(query string) (driver.Stmt, error) {
+	return nil, errors.New("not implemented")
+}
+
+func (fakeConn) Close() error {
+	return nil
+}
+
+func (fakeConn) Begin() (driver.Tx, error) {
+	return fakeTx{}, nil
+}
+
+func (fakeConn) BeginTx(ctx context.Context, opts driver.TxOptions) (driver.Tx, error) {
+	fakeReadOnly = opts.ReadOnly
+	return fakeTx{}, nil
+}
+
+func (fakeConn) QueryContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
+	result, ok := fakeResults[query]
+	if !ok {
+		return nil, errors.New("syntax error")
+	}
+	fakeArgs = args
+	return &fakeRows{result: result}, nil
+}
+
+type fakeTx struct{}
+
+func (fakeTx) Commit() error {
+	return errors.New("read-only transactions must not be committed")
+}
+
+func (fakeTx) Rollback() error {
+	return nil
+}
+
+type fakeRows struct {
+	result fakeResult
+	next   int
+}
+
+func (r *fakeRows) Columns() []string {
+	return r.result.columns
+}
+
+func (r *fakeRows) Close() error {
+	return nil
+}
+
+func (r *fakeRows) Next(dest []driver.Value) error {
+	if r.next >= len(r.result.rows) {
+		return io.EOF
+	}
+	copy(dest, r.result.rows[r.next])
+	r.next++
+	return nil
+}
+
+func newSecret(namespace string, data map[string]string) *corev1.Secret {
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "kpis", Namespace: namespace},
+		Data:       map[string][]byte{},
+	}
+	for k, v := range data {
+		secret.Data[k] = []byte(v)
+	}
+	return secret
+}
+
+func newMetric(spec v1alpha1.SQLMetric, successCondition string) v1alpha1.Metric {
+	if spec.SecretRef.Name == "" {
+		spec.SecretRef.Name = "kpis"
+	}
+	return v1alpha1.Metric{
+		Name:             "kpis",
+		SuccessCondition: successCondition,
+		Provider: v1alpha1.MetricProvider{
+			SQL: &spec,
+		},
+	}
+}
+
+func newProvider(t *testing.T, metric v1alpha1.Metric) *Provider {
+	kubeclient := k8sfake.NewSimpleClientset(newSecret(defaults.Namespace(), map[string]string{
+		SQLDriverKey: "fake",
+		SQLDSNKey:    "replica",
+	}))
+	p, err := NewSQLProvider(*log.NewEntry(log.New()), kubeclient, "default", metric)
+	assert.NoError(t, err)
+	return p
+}
+
+func TestType(t *testing.T) {
+	p := &Provider{}
+	assert.Equal(t, ProviderType, p.Type())
+	assert.Nil(t, p.GetMetadata(v1alpha1.Metric{}))
+}
+
+func TestRunSingleValue(t *testing.T) {
+	metric := newMetric(v1alpha1.SQLMetric{
+		Query: "SELECT conversion_rate FROM kpis WHERE version = $1",
+		Args:  []string{"canary'; DROP TABLE kpis; --"},
+	}, "result >= 0.04")
+	p := newProvider(t, metric)
+
+	measurement := p.Run(&v1alpha1.AnalysisRun{}, metric)
+	assert.Equal(t, v1alpha1.AnalysisPhaseSuccessful, measurement.Phase)
+	assert.Equal(t, "0.042", measurement.Value)
+	assert.NotNil(t, measurement.FinishedAt)
+	assert.True(t, fakeReadOnly)
+	// args are bound as parameters, the query is sent as is
+	assert.Equal(t, []driver.NamedValue{{Ordinal: 1, Value: "canary'; DROP TABLE kpis; --"}}, fakeArgs)
+}
+
+func TestRunResultSet(t *testing.T) {
+	metric := newMetric(v1alpha1.SQLMetric{
+		Query:     "SELECT region, success FROM checkouts",
+		ResultSet: true,
+	}, "all(result, {.success >= 99})")
+	p := newProvider(t, metric)
+
+	measurement := p.Run(&v1alpha1.AnalysisRun{}, metric)
+	assert.Equal(t, v1alpha1.AnalysisPhaseFailed, measurement.Phase)
+	assert.Equal(t, `[{"region":"eu","success":98},{"region":"us","success":99}]`, measurement.Value)
+
+	// several rows cannot be evaluated as a single value
+	metric.Provider.SQL.ResultSet = false
+	measurement = p.Run(&v1alpha1.AnalysisRun{}, metric)
+	assert.Equal(t, v1alpha1.AnalysisPhaseError, measurement.Phase)
+	assert.Equal(t, "query returned 2 rows with 2 columns, expected a single value (use resultSet to evaluate several rows or columns)", measurement.Message)
+}
+
+func TestRunQueryError(t *testing.T) {
+	metric := newMetric(v1alpha1.SQLMetric{Query: "SELEC 1"}, "result == 1")
+	p := newProvider(t, metric)
+	measurement := p.Run(&v1alpha1.AnalysisRun{}, metric)
+	assert.Equal(t, v1alpha1.AnalysisPhaseError, measurement.Phase)
+	assert.Equal(t, "syntax error", measurement.Message)
+}
+
+func TestGetDBSharesPoolPerDataSource(t *testing.T) {
+	db, err := getDB("fake", "replica")
+	assert.NoError(t, err)
+	other, err := getDB("fake", "replica")
+	assert.NoError(t, err)
+	assert.Same(t, db, other)
+
+	primary, err := getDB("fake", "primary")
+	assert.NoError(t, err)
+	assert.NotSame(t, db, primary)
+}
+
+func TestNewSQLProviderErrors(t *testing.T) {
+	kubeclient := k8sfake.NewSimpleClientset(
+		newSecret("team", map[string]string{SQLDriverKey: "oracle", SQLDSNKey: "replica"}),
+		newSecret(defaults.Namespace(), map[string]string{SQLDriverKey: "fake"}),
+	)
+	logCtx := *log.NewEntry(log.New())
+	tests := []struct {
+		spec          v1alpha1.SQLMetric
+		expectedError string
+	}{
+		{
+			spec:          v1alpha1.SQLMetric{},
+			expectedError: "query is required",
+		},
+		{
+			spec:          v1alpha1.SQLMetric{Query: "SELECT 1", Timeout: "0s"},
+			expectedError: "timeout must be a positive duration",
+		},
+		{
+			spec:          v1alpha1.SQLMetric{Query: "SELECT 1"},
+			expectedError: "secret " + defaults.Namespace() + "/kpis must hold the driver and dsn keys",
+		},
+		{
+			spec:          v1alpha1.SQLMetric{Query: "SELECT 1", SecretRef: v1alpha1.SecretRef{Name: "kpis", Namespaced: true}},
+			expectedError: "sql driver oracle is not available",
+		},
+		{
+			spec:          v1alpha1.SQLMetric{Query: "SELECT 1", SecretRef: v1alpha1.SecretRef{Name: "missing"}},
+			expectedError: `secrets "missing" not found`,
+		},
+	}
+	for _, test := range tests {
+		metric := newMetric(test.spec, "")
+		if test.spec.Query == "" {
+			metric.Provider.SQL.SecretRef.Name = ""
+		}
+		_, err := NewSQLProvider(logCtx, kubeclient, "team", metric)
+		assert.EqualError(t, err, test.expectedError)
+	}
+}
+
+func TestConvertValue(t *testing.T) {
+	assert.Equal(t, float64(3), convertValue(int64(3)))
+	assert.Equal(t, float64(1.5), convertValue([]byte("1.5")))
+	assert.Equal(t, "ok", convertValue([]byte("ok")))
+	assert.Equal(t, true, convertValue(true))
+	assert.Nil(t, convertValue(nil))
+}
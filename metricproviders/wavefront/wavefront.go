@@ -14,6 +14,7 @@ import (
 	"k8s.io/client-go/kubernetes"
 
 	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
+	analysisutil "github.com/argoproj/argo-rollouts/utils/analysis"
 	"github.com/argoproj/argo-rollouts/utils/defaults"
 	"github.com/argoproj/argo-rollouts/utils/evaluate"
 	metricutil "github.com/argoproj/argo-rollouts/utils/metric"
@@ -101,7 +102,7 @@ func (p *Provider) Run(run *v1alpha1.AnalysisRun, metric v1alpha1.Metric) v1alph
 	if err != nil {
 		return metricutil.MarkMeasurementError(newMeasurement, err)
 	}
-	result, err := p.processResponse(metric, response, startTime)
+	result, err := p.processResponse(metric, response, startTime, analysisutil.ArrayMeasurement(run, metric.Name))
 	if err != nil {
 		return metricutil.MarkMeasurementError(newMeasurement, err)
 	}
@@ -150,7 +151,7 @@ func (p *Provider) findDataPointValue(datapoints []wavefrontapi.DataPoint, mTime
 	return currentValue, int64(currentTime), int64(delta)
 }
 
-func (p *Provider) processResponse(metric v1alpha1.Metric, response *wavefrontapi.QueryResponse, startTime metav1.Time) (wavefrontResponse, error) {
+func (p *Provider) processResponse(metric v1alpha1.Metric, response *wavefrontapi.QueryResponse, startTime metav1.Time, history []v1alpha1.Measurement) (wavefrontResponse, error) {
 	wavefrontResponse := wavefrontResponse{}
 	var err error
 	if len(response.TimeSeries) == 1 {
@@ -158,7 +159,7 @@ func (p *Provider) processResponse(metric v1alpha1.Metric, response *wavefrontap
 		value, epoch, drift := p.findDataPointValue(series.DataPoints, startTime)
 		wavefrontResponse.newValue = fmt.Sprintf("%.2f", value)
 		wavefrontResponse.epochsUsed = strconv.Itoa(int(epoch))
-		wavefrontResponse.newStatus, err = evaluate.EvaluateResult(value, metric, p.logCtx)
+		wavefrontResponse.newStatus, err = evaluate.EvaluateResultWithHistory(value, metric, history, p.logCtx)
 		wavefrontResponse.drift = strconv.Itoa(int(drift))
 		return wavefrontResponse, err
 
@@ -177,7 +178,7 @@ func (p *Provider) processResponse(metric v1alpha1.Metric, response *wavefrontap
 		wavefrontResponse.newValue = fmt.Sprintf("[%s]", strings.Join(resultStrs, ","))
 		wavefrontResponse.epochsUsed = fmt.Sprintf("[%s]", strings.Join(epochStrs, ","))
 		wavefrontResponse.drift = fmt.Sprintf("[%s]", strings.Join(driftStrs, ","))
-		wavefrontResponse.newStatus, err = evaluate.EvaluateResult(results, metric, p.logCtx)
+		wavefrontResponse.newStatus, err = evaluate.EvaluateResultWithHistory(results, metric, history, p.logCtx)
 		return wavefrontResponse, err
 
 	} else {
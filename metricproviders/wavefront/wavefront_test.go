@@ -194,7 +194,7 @@ func TestProcessMultipleTimeseriesResponse(t *testing.T) {
 	response := &wavefrontapi.QueryResponse{
 		TimeSeries: []wavefrontapi.TimeSeries{mockSeries1, mockSeries2},
 	}
-	result, err := p.processResponse(metric, response, metav1.Unix(12000, 0))
+	result, err := p.processResponse(metric, response, metav1.Unix(12000, 0), nil)
 	assert.Nil(t, err)
 	assert.Equal(t, v1alpha1.AnalysisPhaseSuccessful, result.newStatus)
 	assert.Equal(t, "[10.00,11.00]", result.newValue)
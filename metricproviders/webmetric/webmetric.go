@@ -3,22 +3,30 @@ package webmetric
 import (
 	"bytes"
 	"context"
+	"crypto/sha256"
 	"crypto/tls"
+	"crypto/x509"
+	"encoding/hex"
 	"encoding/json"
 	"errors"
 	"fmt"
 	"io"
 	"net/http"
 	"reflect"
-	"strings"
+	"sync"
 	"time"
 
 	log "github.com/sirupsen/logrus"
 	"golang.org/x/oauth2"
 	"golang.org/x/oauth2/clientcredentials"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/util/jsonpath"
 
 	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
+	analysisutil "github.com/argoproj/argo-rollouts/utils/analysis"
+	"github.com/argoproj/argo-rollouts/utils/defaults"
 	"github.com/argoproj/argo-rollouts/utils/evaluate"
 	metricutil "github.com/argoproj/argo-rollouts/utils/metric"
 	timeutil "github.com/argoproj/argo-rollouts/utils/time"
@@ -29,6 +37,12 @@ const (
 	ProviderType         = "Web"
 	ContentTypeKey       = "Content-Type"
 	ContentTypeJsonValue = "application/json"
+	// DefaultRetryBackoff is the delay before the first retry of a request when the metric sets no backoff
+	DefaultRetryBackoff = time.Second
+	// MaxRetryLimit is the maximum number of retries of a request
+	MaxRetryLimit = 10
+	// DefaultTimeout is the timeout of a measurement when the metric sets no timeoutSeconds
+	DefaultTimeout = 10 * time.Second
 )
 
 // Provider contains all the required components to run a WebMetric query
@@ -67,7 +81,7 @@ func (p *Provider) Run(run *v1alpha1.AnalysisRun, metric v1alpha1.Metric) v1alph
 	stringBody := metric.Provider.Web.Body
 	jsonBody := metric.Provider.Web.JSONBody
 
-	var body io.Reader
+	var bodyBytes []byte
 
 	if stringBody != "" && jsonBody != nil {
 		return metricutil.MarkMeasurementError(measurement, fmt.Errorf("use either Body or JSONBody; both cannot exists for WebMetric payload"))
@@ -76,42 +90,53 @@ func (p *Provider) Run(run *v1alpha1.AnalysisRun, metric v1alpha1.Metric) v1alph
 	}
 
 	if stringBody != "" {
-		body = strings.NewReader(stringBody)
+		bodyBytes = []byte(stringBody)
 	} else if jsonBody != nil {
-		bodyBytes, err := jsonBody.MarshalJSON()
+		var err error
+		bodyBytes, err = jsonBody.MarshalJSON()
 		if err != nil {
 			return metricutil.MarkMeasurementError(measurement, err)
 		}
-		body = bytes.NewBuffer(bodyBytes)
 	}
 
-	// Create request
-	request, err := http.NewRequest(string(method), url, body)
-	if err != nil {
-		return metricutil.MarkMeasurementError(measurement, err)
-	}
+	// the timeout bounds the whole measurement, retries included
+	ctx, cancel := context.WithTimeout(context.Background(), requestTimeout(metric))
+	defer cancel()
 
-	request.Header = make(http.Header)
+	// Create request, once per attempt since the body is consumed when sent
+	newRequest := func() (*http.Request, error) {
+		var body io.Reader
+		if bodyBytes != nil {
+			body = bytes.NewReader(bodyBytes)
+		}
+		request, err := http.NewRequestWithContext(ctx, string(method), url, body)
+		if err != nil {
+			return nil, err
+		}
 
-	for _, header := range metric.Provider.Web.Headers {
-		request.Header.Set(header.Key, header.Value)
-	}
-	if jsonBody != nil {
-		request.Header.Set(ContentTypeKey, ContentTypeJsonValue)
+		request.Header = make(http.Header)
+
+		for _, header := range metric.Provider.Web.Headers {
+			request.Header.Set(header.Key, header.Value)
+		}
+		if jsonBody != nil {
+			request.Header.Set(ContentTypeKey, ContentTypeJsonValue)
+		}
+		return request, nil
 	}
 
 	// Send Request
-	response, err := p.client.Do(request)
+	response, err := p.doWithRetry(ctx, metric.Provider.Web.Retry, newRequest)
 	if err != nil {
 		return metricutil.MarkMeasurementError(measurement, err)
 	}
 	defer response.Body.Close()
 
-	if response.StatusCode < 200 || response.StatusCode >= 300 {
+	if !metric.Provider.Web.IgnoreStatusCode && (response.StatusCode < 200 || response.StatusCode >= 300) {
 		return metricutil.MarkMeasurementError(measurement, fmt.Errorf("received non 2xx response code: %v", response.StatusCode))
 	}
 
-	value, status, err := p.parseResponse(metric, response)
+	value, status, err := p.parseResponse(metric, response, analysisutil.ArrayMeasurement(run, metric.Name))
 	if err != nil {
 		return metricutil.MarkMeasurementError(measurement, err)
 	}
@@ -124,8 +149,90 @@ func (p *Provider) Run(run *v1alpha1.AnalysisRun, metric v1alpha1.Metric) v1alph
 	return measurement
 }
 
-func (p *Provider) parseResponse(metric v1alpha1.Metric, response *http.Response) (string, v1alpha1.AnalysisPhase, error) {
+// doWithRetry sends the request, retrying it with an exponential backoff while the server responds with a
+// 5xx status code, the retry limit is not reached and the next attempt can start before the deadline of ctx
+func (p *Provider) doWithRetry(ctx context.Context, retry *v1alpha1.WebMetricRetry, newRequest func() (*http.Request, error)) (*http.Response, error) {
+	limit, backoff, err := retryPolicy(retry)
+	if err != nil {
+		return nil, err
+	}
+	for attempt := 0; ; attempt++ {
+		request, err := newRequest()
+		if err != nil {
+			return nil, err
+		}
+		response, err := p.client.Do(request)
+		if err != nil {
+			return nil, err
+		}
+		if response.StatusCode < 500 || attempt >= limit {
+			return response, nil
+		}
+		if deadline, ok := ctx.Deadline(); ok && time.Now().Add(backoff).After(deadline) {
+			p.logCtx.Infof("web metric request failed with status code %d, no time left to retry", response.StatusCode)
+			return response, nil
+		}
+		// drain the body so the connection can be reused by the next attempt
+		io.Copy(io.Discard, response.Body)
+		response.Body.Close()
+		p.logCtx.Infof("web metric request failed with status code %d, retrying in %v", response.StatusCode, backoff)
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-time.After(backoff):
+		}
+		backoff *= 2
+	}
+}
+
+// retryPolicy returns the number of retries and the initial backoff of the retry settings
+func retryPolicy(retry *v1alpha1.WebMetricRetry) (int, time.Duration, error) {
+	if retry == nil {
+		return 0, DefaultRetryBackoff, nil
+	}
+	if retry.Limit < 0 || retry.Limit > MaxRetryLimit {
+		return 0, 0, fmt.Errorf("retry limit must be between 0 and %d", MaxRetryLimit)
+	}
+	backoff := DefaultRetryBackoff
+	if retry.Backoff != "" {
+		var err error
+		backoff, err = retry.Backoff.Duration()
+		if err != nil {
+			return 0, 0, fmt.Errorf("invalid retry backoff: %w", err)
+		}
+		if backoff <= 0 {
+			return 0, 0, errors.New("retry backoff must be positive")
+		}
+	}
+	return int(retry.Limit), backoff, nil
+}
+
+// requestTimeout returns the timeout of a measurement of the metric
+func requestTimeout(metric v1alpha1.Metric) time.Duration {
+	if metric.Provider.Web.TimeoutSeconds <= 0 {
+		return DefaultTimeout
+	}
+	return time.Duration(metric.Provider.Web.TimeoutSeconds) * time.Second
+}
+
+// responseVariables returns the variables exposing the status code and headers of the response to the
+// success and failure conditions
+func responseVariables(response *http.Response) map[string]any {
+	headers := make(map[string]string, len(response.Header))
+	for key := range response.Header {
+		headers[key] = response.Header.Get(key)
+	}
+	return map[string]any{
+		"response": map[string]any{
+			"statusCode": response.StatusCode,
+			"headers":    headers,
+		},
+	}
+}
+
+func (p *Provider) parseResponse(metric v1alpha1.Metric, response *http.Response, history []v1alpha1.Measurement) (string, v1alpha1.AnalysisPhase, error) {
 	var data any
+	variables := responseVariables(response)
 
 	bodyBytes, err := io.ReadAll(response.Body)
 	if err != nil {
@@ -135,7 +242,7 @@ func (p *Provider) parseResponse(metric v1alpha1.Metric, response *http.Response
 	err = json.Unmarshal(bodyBytes, &data)
 	if err != nil {
 		bodyStr := string(bodyBytes)
-		status, evalErr := evaluate.EvaluateResult(bodyStr, metric, p.logCtx)
+		status, evalErr := evaluate.EvaluateResultWithVariables(bodyStr, metric, history, variables, p.logCtx)
 		return bodyStr, status, evalErr
 	}
 
@@ -148,7 +255,7 @@ func (p *Provider) parseResponse(metric v1alpha1.Metric, response *http.Response
 		return "", v1alpha1.AnalysisPhaseError, err
 	}
 
-	status, err := evaluate.EvaluateResult(val, metric, p.logCtx)
+	status, err := evaluate.EvaluateResultWithVariables(val, metric, history, variables, p.logCtx)
 	return valString, status, err
 }
 
@@ -184,21 +291,120 @@ var insecureTransport *http.Transport = &http.Transport{
 	TLSClientConfig: &tls.Config{InsecureSkipVerify: true},
 }
 
-func NewWebMetricHttpClient(metric v1alpha1.Metric) (*http.Client, error) {
-	var timeout time.Duration
+// tlsTransports caches the transports of the metrics using TLS by their settings, so that measurements reuse
+// the connections of a transport instead of leaving the idle connections of a new transport behind
+var tlsTransports = struct {
+	sync.Mutex
+	entries map[string]tlsTransport
+}{entries: map[string]tlsTransport{}}
+
+type tlsTransport struct {
+	// digest identifies the certificates the transport was configured with
+	digest    string
+	transport *http.Transport
+}
+
+// newTLSTransport returns the transport of the metric with its TLS configuration, shared by the metrics with
+// the same TLS settings. When the certificates change, e.g. after the rotation of the client certificate, the
+// transport is replaced and the idle connections of the previous one are closed.
+func newTLSTransport(metric v1alpha1.Metric, kubeclientset kubernetes.Interface, namespace string) (*http.Transport, error) {
+	spec := metric.Provider.Web.TLS
+	var secret *corev1.Secret
+	if ref := spec.ClientCertSecretRef; ref != nil {
+		if ref.Name == "" {
+			return nil, errors.New("secret name is required for the client certificate")
+		}
+		secretNamespace := defaults.Namespace()
+		if ref.Namespaced {
+			secretNamespace = namespace
+		}
+		var err error
+		secret, err = kubeclientset.CoreV1().Secrets(secretNamespace).Get(context.TODO(), ref.Name, metav1.GetOptions{})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	settings := sha256.New()
+	fmt.Fprintf(settings, "%t\x00%s\x00%s", metric.Provider.Web.Insecure, spec.ServerName, spec.CABundle)
+	certificates := sha256.New()
+	if secret != nil {
+		fmt.Fprintf(settings, "\x00%s/%s", secret.Namespace, secret.Name)
+		for _, key := range []string{corev1.TLSCertKey, corev1.TLSPrivateKeyKey, corev1.ServiceAccountRootCAKey} {
+			certificates.Write(secret.Data[key])
+			certificates.Write([]byte{0})
+		}
+	}
+	key := hex.EncodeToString(settings.Sum(nil))
+	digest := hex.EncodeToString(certificates.Sum(nil))
+
+	tlsTransports.Lock()
+	defer tlsTransports.Unlock()
+	cached, ok := tlsTransports.entries[key]
+	if ok && cached.digest == digest {
+		return cached.transport, nil
+	}
+	tlsConfig, err := newTLSConfig(metric, secret)
+	if err != nil {
+		return nil, err
+	}
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.TLSClientConfig = tlsConfig
+	if ok {
+		cached.transport.CloseIdleConnections()
+	}
+	tlsTransports.entries[key] = tlsTransport{digest: digest, transport: transport}
+	return transport, nil
+}
+
+// newTLSConfig returns the TLS configuration of the metric, using the client certificate of secret if any
+func newTLSConfig(metric v1alpha1.Metric, secret *corev1.Secret) (*tls.Config, error) {
+	spec := metric.Provider.Web.TLS
+	tlsConfig := &tls.Config{
+		InsecureSkipVerify: metric.Provider.Web.Insecure,
+		ServerName:         spec.ServerName,
+	}
+	caBundle := []byte(spec.CABundle)
+	if secret != nil {
+		cert, err := tls.X509KeyPair(secret.Data[corev1.TLSCertKey], secret.Data[corev1.TLSPrivateKeyKey])
+		if err != nil {
+			return nil, fmt.Errorf("invalid client certificate in secret %s/%s: %w", secret.Namespace, secret.Name, err)
+		}
+		tlsConfig.Certificates = []tls.Certificate{cert}
+		if ca := secret.Data[corev1.ServiceAccountRootCAKey]; len(ca) > 0 {
+			caBundle = append(append(caBundle, '\n'), ca...)
+		}
+	}
+	if len(bytes.TrimSpace(caBundle)) > 0 {
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(caBundle) {
+			return nil, errors.New("no valid certificate found in the CA bundle")
+		}
+		tlsConfig.RootCAs = pool
+	}
+	return tlsConfig, nil
+}
+
+func NewWebMetricHttpClient(metric v1alpha1.Metric, kubeclientset kubernetes.Interface, namespace string) (*http.Client, error) {
 	var oauthCfg clientcredentials.Config
 
-	// Using a default timeout of 10 seconds
-	if metric.Provider.Web.TimeoutSeconds <= 0 {
-		timeout = time.Duration(10) * time.Second
-	} else {
-		timeout = time.Duration(metric.Provider.Web.TimeoutSeconds) * time.Second
+	timeout := requestTimeout(metric)
+	if _, backoff, err := retryPolicy(metric.Provider.Web.Retry); err != nil {
+		return nil, err
+	} else if metric.Provider.Web.Retry != nil && backoff >= timeout {
+		return nil, fmt.Errorf("retry backoff must be shorter than the timeout of %v", timeout)
 	}
 
 	c := &http.Client{
 		Timeout: timeout,
 	}
-	if metric.Provider.Web.Insecure {
+	if metric.Provider.Web.TLS != nil {
+		transport, err := newTLSTransport(metric, kubeclientset, namespace)
+		if err != nil {
+			return nil, err
+		}
+		c.Transport = transport
+	} else if metric.Provider.Web.Insecure {
 		c.Transport = insecureTransport
 	}
 	if metric.Provider.Web.Authentication.OAuth2.TokenURL != "" {
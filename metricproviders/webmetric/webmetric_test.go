@@ -2,16 +2,29 @@ package webmetric
 
 import (
 	"bytes"
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
 	"encoding/json"
+	"encoding/pem"
 	"fmt"
 	"io"
+	"math/big"
 	"net/http"
 	"net/http/httptest"
 	"strings"
 	"testing"
+	"time"
 
 	log "github.com/sirupsen/logrus"
 	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8sfake "k8s.io/client-go/kubernetes/fake"
 
 	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
 )
@@ -802,7 +815,7 @@ func TestRunSuite(t *testing.T) {
 
 		jsonparser, err := NewWebMetricJsonParser(test.metric)
 		assert.NoError(t, err)
-		client, err := NewWebMetricHttpClient(test.metric)
+		client, err := NewWebMetricHttpClient(test.metric, nil, "")
 		assert.NoError(t, err)
 		provider := NewWebMetricProvider(*logCtx, client, jsonparser)
 
@@ -859,7 +872,7 @@ func TestNewPromApiErrorWithIncompleteOAuthParams(t *testing.T) {
 		},
 	}
 
-	_, err := NewWebMetricHttpClient(metric)
+	_, err := NewWebMetricHttpClient(metric, nil, "")
 	assert.Error(t, err)
 
 	// Missing Client Secret should fail
@@ -883,7 +896,7 @@ func TestNewPromApiErrorWithIncompleteOAuthParams(t *testing.T) {
 			},
 		},
 	}
-	_, err = NewWebMetricHttpClient(metric)
+	_, err = NewWebMetricHttpClient(metric, nil, "")
 	assert.Error(t, err)
 
 	// Missing Scope should succeed
@@ -904,7 +917,7 @@ func TestNewPromApiErrorWithIncompleteOAuthParams(t *testing.T) {
 			},
 		},
 	}
-	_, err = NewWebMetricHttpClient(metric)
+	_, err = NewWebMetricHttpClient(metric, nil, "")
 	assert.NoError(t, err)
 
 }
@@ -941,3 +954,294 @@ func mockOAuthKOResponse(w http.ResponseWriter, r *http.Request) {
 	sc := http.StatusUnauthorized
 	w.WriteHeader(sc)
 }
+
+func newWebMetricProvider(t *testing.T, metric v1alpha1.Metric) *Provider {
+	jsonparser, err := NewWebMetricJsonParser(metric)
+	assert.NoError(t, err)
+	client, err := NewWebMetricHttpClient(metric, nil, "")
+	assert.NoError(t, err)
+	return NewWebMetricProvider(*log.WithField("test", "test"), client, jsonparser)
+}
+
+func TestRunRetriesServerErrors(t *testing.T) {
+	attempts := 0
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		attempts++
+		body, _ := io.ReadAll(req.Body)
+		assert.Equal(t, `{"key":"value"}`, string(body))
+		if attempts < 3 {
+			http.Error(rw, "unavailable", http.StatusServiceUnavailable)
+			return
+		}
+		io.WriteString(rw, `{"ok": true}`)
+	}))
+	defer server.Close()
+
+	metric := v1alpha1.Metric{
+		SuccessCondition: "result.ok",
+		Provider: v1alpha1.MetricProvider{
+			Web: &v1alpha1.WebMetric{
+				URL:      server.URL,
+				Method:   v1alpha1.WebMetricMethodPost,
+				JSONBody: json.RawMessage(`{"key":"value"}`),
+				Retry:    &v1alpha1.WebMetricRetry{Limit: 2, Backoff: "1ms"},
+			},
+		},
+	}
+	measurement := newWebMetricProvider(t, metric).Run(newAnalysisRun(), metric)
+	assert.Equal(t, v1alpha1.AnalysisPhaseSuccessful, measurement.Phase)
+	assert.Equal(t, 3, attempts)
+
+	// the last response is used once the retries are exhausted
+	attempts = 0
+	metric.Provider.Web.Retry.Limit = 1
+	measurement = newWebMetricProvider(t, metric).Run(newAnalysisRun(), metric)
+	assert.Equal(t, v1alpha1.AnalysisPhaseError, measurement.Phase)
+	assert.Equal(t, "received non 2xx response code: 503", measurement.Message)
+	assert.Equal(t, 2, attempts)
+
+	// retries stop when the next one could not complete within the timeout
+	attempts = 0
+	metric.Provider.Web.TimeoutSeconds = 1
+	metric.Provider.Web.Retry = &v1alpha1.WebMetricRetry{Limit: 5, Backoff: "400ms"}
+	measurement = newWebMetricProvider(t, metric).Run(newAnalysisRun(), metric)
+	assert.Equal(t, v1alpha1.AnalysisPhaseError, measurement.Phase)
+	assert.Equal(t, "received non 2xx response code: 503", measurement.Message)
+	assert.Equal(t, 2, attempts)
+}
+
+func TestNewWebMetricHttpClientValidatesRetry(t *testing.T) {
+	metric := v1alpha1.Metric{
+		Provider: v1alpha1.MetricProvider{
+			Web: &v1alpha1.WebMetric{
+				URL:            "https://my-server.com",
+				TimeoutSeconds: 5,
+			},
+		},
+	}
+	for _, tc := range []struct {
+		retry v1alpha1.WebMetricRetry
+		err   string
+	}{
+		{retry: v1alpha1.WebMetricRetry{Limit: 10, Backoff: "4s"}},
+		{retry: v1alpha1.WebMetricRetry{Limit: -1}, err: "retry limit must be between 0 and 10"},
+		{retry: v1alpha1.WebMetricRetry{Limit: 11}, err: "retry limit must be between 0 and 10"},
+		{retry: v1alpha1.WebMetricRetry{Limit: 1, Backoff: "soon"}, err: "invalid retry backoff"},
+		{retry: v1alpha1.WebMetricRetry{Limit: 1, Backoff: "0s"}, err: "retry backoff must be positive"},
+		{retry: v1alpha1.WebMetricRetry{Limit: 1, Backoff: "5s"}, err: "retry backoff must be shorter than the timeout of 5s"},
+	} {
+		metric.Provider.Web.Retry = &tc.retry
+		_, err := NewWebMetricHttpClient(metric, nil, "")
+		if tc.err == "" {
+			assert.NoError(t, err)
+		} else {
+			assert.ErrorContains(t, err, tc.err)
+		}
+	}
+}
+
+func TestRunDoesNotRetryClientErrors(t *testing.T) {
+	attempts := 0
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		attempts++
+		http.Error(rw, "not found", http.StatusNotFound)
+	}))
+	defer server.Close()
+
+	metric := v1alpha1.Metric{
+		Provider: v1alpha1.MetricProvider{
+			Web: &v1alpha1.WebMetric{
+				URL:   server.URL,
+				Retry: &v1alpha1.WebMetricRetry{Limit: 3, Backoff: "1ms"},
+			},
+		},
+	}
+	measurement := newWebMetricProvider(t, metric).Run(newAnalysisRun(), metric)
+	assert.Equal(t, v1alpha1.AnalysisPhaseError, measurement.Phase)
+	assert.Equal(t, 1, attempts)
+}
+
+func TestRunResponseConditions(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		rw.Header().Set("X-Health", "degraded")
+		rw.Header().Set("Content-Type", "application/json")
+		rw.WriteHeader(http.StatusTooManyRequests)
+		io.WriteString(rw, `{"status": "throttled"}`)
+	}))
+	defer server.Close()
+
+	metric := v1alpha1.Metric{
+		SuccessCondition: "response.statusCode == 200",
+		FailureCondition: "response.statusCode == 429 && response.headers['X-Health'] == 'degraded'",
+		Provider: v1alpha1.MetricProvider{
+			Web: &v1alpha1.WebMetric{
+				URL:              server.URL,
+				JSONPath:         "{$.status}",
+				IgnoreStatusCode: true,
+			},
+		},
+	}
+	measurement := newWebMetricProvider(t, metric).Run(newAnalysisRun(), metric)
+	assert.Equal(t, v1alpha1.AnalysisPhaseFailed, measurement.Phase)
+	assert.Equal(t, `"throttled"`, measurement.Value)
+
+	metric.Provider.Web.IgnoreStatusCode = false
+	measurement = newWebMetricProvider(t, metric).Run(newAnalysisRun(), metric)
+	assert.Equal(t, v1alpha1.AnalysisPhaseError, measurement.Phase)
+	assert.Equal(t, "received non 2xx response code: 429", measurement.Message)
+}
+
+// newClientCertificate returns a self-signed client certificate and its private key, PEM encoded
+func newClientCertificate(t *testing.T) ([]byte, []byte) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	assert.NoError(t, err)
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "argo-rollouts"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		KeyUsage:              x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign,
+		ExtKeyUsage:           []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	assert.NoError(t, err)
+	keyDer, err := x509.MarshalECPrivateKey(key)
+	assert.NoError(t, err)
+	return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}),
+		pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDer})
+}
+
+func TestRunWithMutualTLS(t *testing.T) {
+	certPEM, keyPEM := newClientCertificate(t)
+	clientCAs := x509.NewCertPool()
+	clientCAs.AppendCertsFromPEM(certPEM)
+
+	server := httptest.NewUnstartedServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		io.WriteString(rw, `{"ok": true}`)
+	}))
+	server.TLS = &tls.Config{
+		ClientAuth: tls.RequireAndVerifyClientCert,
+		ClientCAs:  clientCAs,
+	}
+	server.StartTLS()
+	defer server.Close()
+	serverCA := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw})
+
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "client-cert", Namespace: "team"},
+		Type:       corev1.SecretTypeTLS,
+		Data: map[string][]byte{
+			corev1.TLSCertKey:              certPEM,
+			corev1.TLSPrivateKeyKey:        keyPEM,
+			corev1.ServiceAccountRootCAKey: serverCA,
+		},
+	}
+	kubeclient := k8sfake.NewSimpleClientset(secret)
+	metric := v1alpha1.Metric{
+		SuccessCondition: "result.ok",
+		Provider: v1alpha1.MetricProvider{
+			Web: &v1alpha1.WebMetric{
+				URL: server.URL,
+				TLS: &v1alpha1.WebMetricTLS{
+					ClientCertSecretRef: &v1alpha1.SecretRef{Name: "client-cert", Namespaced: true},
+				},
+			},
+		},
+	}
+	jsonparser, err := NewWebMetricJsonParser(metric)
+	assert.NoError(t, err)
+	client, err := NewWebMetricHttpClient(metric, kubeclient, "team")
+	assert.NoError(t, err)
+	provider := NewWebMetricProvider(*log.WithField("test", "test"), client, jsonparser)
+	measurement := provider.Run(newAnalysisRun(), metric)
+	assert.Equal(t, v1alpha1.AnalysisPhaseSuccessful, measurement.Phase)
+
+	// without a client certificate the server rejects the connection, the CA bundle still verifies the server
+	metric.Provider.Web.TLS = &v1alpha1.WebMetricTLS{CABundle: string(serverCA)}
+	client, err = NewWebMetricHttpClient(metric, kubeclient, "team")
+	assert.NoError(t, err)
+	provider = NewWebMetricProvider(*log.WithField("test", "test"), client, jsonparser)
+	measurement = provider.Run(newAnalysisRun(), metric)
+	assert.Equal(t, v1alpha1.AnalysisPhaseError, measurement.Phase)
+	assert.NotContains(t, measurement.Message, "certificate signed by unknown authority")
+}
+
+func TestNewWebMetricHttpClientSharesTLSTransport(t *testing.T) {
+	certPEM, keyPEM := newClientCertificate(t)
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "client-cert", Namespace: "team"},
+		Type:       corev1.SecretTypeTLS,
+		Data: map[string][]byte{
+			corev1.TLSCertKey:       certPEM,
+			corev1.TLSPrivateKeyKey: keyPEM,
+		},
+	}
+	kubeclient := k8sfake.NewSimpleClientset(secret)
+	metric := v1alpha1.Metric{
+		Provider: v1alpha1.MetricProvider{
+			Web: &v1alpha1.WebMetric{
+				URL: "https://example.com",
+				TLS: &v1alpha1.WebMetricTLS{
+					ClientCertSecretRef: &v1alpha1.SecretRef{Name: "client-cert", Namespaced: true},
+				},
+			},
+		},
+	}
+	client, err := NewWebMetricHttpClient(metric, kubeclient, "team")
+	assert.NoError(t, err)
+	other, err := NewWebMetricHttpClient(metric, kubeclient, "team")
+	assert.NoError(t, err)
+	assert.Same(t, client.Transport, other.Transport)
+
+	// other settings use another transport
+	metric.Provider.Web.TLS.ServerName = "other.example.com"
+	other, err = NewWebMetricHttpClient(metric, kubeclient, "team")
+	assert.NoError(t, err)
+	assert.NotSame(t, client.Transport, other.Transport)
+	metric.Provider.Web.TLS.ServerName = ""
+
+	// a rotated certificate replaces the transport
+	secret.Data[corev1.TLSCertKey], secret.Data[corev1.TLSPrivateKeyKey] = newClientCertificate(t)
+	_, err = kubeclient.CoreV1().Secrets("team").Update(context.TODO(), secret, metav1.UpdateOptions{})
+	assert.NoError(t, err)
+	rotated, err := NewWebMetricHttpClient(metric, kubeclient, "team")
+	assert.NoError(t, err)
+	assert.NotSame(t, client.Transport, rotated.Transport)
+	other, err = NewWebMetricHttpClient(metric, kubeclient, "team")
+	assert.NoError(t, err)
+	assert.Same(t, rotated.Transport, other.Transport)
+}
+
+func TestNewWebMetricHttpClientTLSErrors(t *testing.T) {
+	kubeclient := k8sfake.NewSimpleClientset(&corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "invalid-cert", Namespace: "team"},
+		Data:       map[string][]byte{corev1.TLSCertKey: []byte("invalid")},
+	})
+	newMetric := func(tlsSpec *v1alpha1.WebMetricTLS) v1alpha1.Metric {
+		return v1alpha1.Metric{
+			Provider: v1alpha1.MetricProvider{
+				Web: &v1alpha1.WebMetric{URL: "https://example.com", TLS: tlsSpec},
+			},
+		}
+	}
+
+	_, err := NewWebMetricHttpClient(newMetric(&v1alpha1.WebMetricTLS{
+		ClientCertSecretRef: &v1alpha1.SecretRef{Namespaced: true},
+	}), kubeclient, "team")
+	assert.EqualError(t, err, "secret name is required for the client certificate")
+
+	_, err = NewWebMetricHttpClient(newMetric(&v1alpha1.WebMetricTLS{
+		ClientCertSecretRef: &v1alpha1.SecretRef{Name: "invalid-cert", Namespaced: true},
+	}), kubeclient, "team")
+	assert.ErrorContains(t, err, "invalid client certificate in secret team/invalid-cert")
+
+	_, err = NewWebMetricHttpClient(newMetric(&v1alpha1.WebMetricTLS{
+		ClientCertSecretRef: &v1alpha1.SecretRef{Name: "missing-cert"},
+	}), kubeclient, "team")
+	assert.Error(t, err)
+
+	_, err = NewWebMetricHttpClient(newMetric(&v1alpha1.WebMetricTLS{CABundle: "invalid"}), kubeclient, "team")
+	assert.EqualError(t, err, "no valid certificate found in the CA bundle")
+}
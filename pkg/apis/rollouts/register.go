@@ -43,4 +43,9 @@ const (
 	AnalysisRunSingular string = "analysisrun"
 	AnalysisRunPlural   string = "analysisruns"
 	AnalysisRunFullName string = AnalysisRunPlural + "." + Group
+
+	MetricProviderConfigKind     string = "MetricProviderConfig"
+	MetricProviderConfigSingular string = "metricproviderconfig"
+	MetricProviderConfigPlural   string = "metricproviderconfigs"
+	MetricProviderConfigFullName string = MetricProviderConfigPlural + "." + Group
 )
@@ -51,6 +51,130 @@ type AnalysisTemplateList struct {
 	Items           []AnalysisTemplate `json:"items" protobuf:"bytes,2,rep,name=items"`
 }
 
+// MetricProviderConfig holds the connection settings of metric providers, which metrics of the same namespace
+// can reference by name
+// +genclient
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +kubebuilder:resource:path=metricproviderconfigs,shortName=mpc
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp",description="Time since resource was created"
+type MetricProviderConfig struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty" protobuf:"bytes,1,opt,name=metadata"`
+
+	Spec MetricProviderConfigSpec `json:"spec" protobuf:"bytes,2,opt,name=spec"`
+}
+
+// MetricProviderConfigList is a list of MetricProviderConfig resources
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type MetricProviderConfigList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata" protobuf:"bytes,1,opt,name=metadata"`
+	Items           []MetricProviderConfig `json:"items" protobuf:"bytes,2,rep,name=items"`
+}
+
+// MetricProviderConfigSpec is the specification for a MetricProviderConfig resource. The settings are used
+// as defaults for the metrics referencing the config: settings specified in a metric take precedence.
+type MetricProviderConfigSpec struct {
+	// Prometheus holds the connection settings of a prometheus server
+	// +optional
+	Prometheus *PrometheusProviderConfig `json:"prometheus,omitempty" protobuf:"bytes,1,opt,name=prometheus"`
+	// Datadog holds the connection settings of datadog
+	// +optional
+	Datadog *DatadogProviderConfig `json:"datadog,omitempty" protobuf:"bytes,2,opt,name=datadog"`
+	// NewRelic holds the connection settings of newrelic
+	// +optional
+	NewRelic *NewRelicProviderConfig `json:"newRelic,omitempty" protobuf:"bytes,3,opt,name=newRelic"`
+	// Influxdb holds the connection settings of influxdb
+	// +optional
+	Influxdb *InfluxdbProviderConfig `json:"influxdb,omitempty" protobuf:"bytes,4,opt,name=influxdb"`
+}
+
+// PrometheusProviderConfig defines the connection settings of a prometheus server
+type PrometheusProviderConfig struct {
+	// Address is the HTTP address and port of the prometheus server
+	Address string `json:"address,omitempty" protobuf:"bytes,1,opt,name=address"`
+	// Authentication details
+	// +optional
+	Authentication PrometheusProviderAuthentication `json:"authentication,omitempty" protobuf:"bytes,2,opt,name=authentication"`
+	// Timeout represents the duration within which a prometheus query should complete. It is expressed in seconds.
+	// +optional
+	Timeout *int64 `json:"timeout,omitempty" protobuf:"bytes,3,opt,name=timeout"`
+	// Insecure skips host TLS verification
+	Insecure bool `json:"insecure,omitempty" protobuf:"varint,4,opt,name=insecure"`
+	// Headers are HTTP headers added to the requests, before the headers of the metric
+	// +optional
+	// +patchMergeKey=key
+	// +patchStrategy=merge
+	Headers []WebMetricHeader `json:"headers,omitempty" patchStrategy:"merge" patchMergeKey:"key" protobuf:"bytes,5,opt,name=headers"`
+}
+
+// PrometheusProviderAuthentication defines the authentication to a prometheus server. The credentials are read
+// from secrets in the namespace of the MetricProviderConfig.
+type PrometheusProviderAuthentication struct {
+	// Sigv4 Config is the aws SigV4 configuration to use for SigV4 signing if using Amazon Managed Prometheus
+	// +optional
+	Sigv4 Sigv4Config `json:"sigv4,omitempty" protobuf:"bytes,1,opt,name=sigv4"`
+	// OAuth2 config
+	// +optional
+	OAuth2 OAuth2ProviderConfig `json:"oauth2,omitempty" protobuf:"bytes,2,opt,name=oauth2"`
+	// BasicAuth config
+	// +optional
+	BasicAuth BasicAuthProviderConfig `json:"basicAuth,omitempty" protobuf:"bytes,3,opt,name=basicAuth"`
+}
+
+// OAuth2ProviderConfig defines the OAuth2 client credentials of a MetricProviderConfig
+type OAuth2ProviderConfig struct {
+	// OAuth2 provider token URL
+	TokenURL string `json:"tokenUrl,omitempty" protobuf:"bytes,1,opt,name=tokenUrl"`
+	// OAuth2 client ID
+	ClientID string `json:"clientId,omitempty" protobuf:"bytes,2,opt,name=clientId"`
+	// ClientSecretRef references the key of the secret holding the OAuth2 client secret
+	// +optional
+	ClientSecretRef *SecretKeyRef `json:"clientSecretRef,omitempty" protobuf:"bytes,3,opt,name=clientSecretRef"`
+	// OAuth2 scopes
+	// +optional
+	Scopes []string `json:"scopes,omitempty" protobuf:"bytes,4,opt,name=scopes"`
+}
+
+// BasicAuthProviderConfig defines the basic authentication credentials of a MetricProviderConfig
+type BasicAuthProviderConfig struct {
+	// UsernameSecretRef references the key of the secret holding the username
+	// +optional
+	UsernameSecretRef *SecretKeyRef `json:"usernameSecretRef,omitempty" protobuf:"bytes,1,opt,name=usernameSecretRef"`
+	// PasswordSecretRef references the key of the secret holding the password
+	// +optional
+	PasswordSecretRef *SecretKeyRef `json:"passwordSecretRef,omitempty" protobuf:"bytes,2,opt,name=passwordSecretRef"`
+}
+
+// DatadogProviderConfig defines the connection settings of datadog
+type DatadogProviderConfig struct {
+	// SecretName is the name of the secret, in the namespace of the MetricProviderConfig, holding the address,
+	// api-key and app-key of datadog
+	SecretName string `json:"secretName" protobuf:"bytes,1,opt,name=secretName"`
+	// ApiVersion is the default Datadog API version of the metrics (v1 or v2)
+	// +kubebuilder:validation:Enum=v1;v2
+	// +optional
+	ApiVersion string `json:"apiVersion,omitempty" protobuf:"bytes,2,opt,name=apiVersion"`
+}
+
+// NewRelicProviderConfig defines the connection settings of newrelic
+type NewRelicProviderConfig struct {
+	// Profile is the name of the secret, in the namespace of the MetricProviderConfig, holding the NR account configuration
+	Profile string `json:"profile" protobuf:"bytes,1,opt,name=profile"`
+}
+
+// InfluxdbProviderConfig defines the connection settings of influxdb
+type InfluxdbProviderConfig struct {
+	// Profile is the name of the secret, in the namespace of the MetricProviderConfig, holding the InfluxDB account configuration
+	Profile string `json:"profile" protobuf:"bytes,1,opt,name=profile"`
+}
+
+// MetricProviderConfigRef references a MetricProviderConfig in the namespace of the AnalysisRun
+type MetricProviderConfigRef struct {
+	// Name is the name of the MetricProviderConfig
+	Name string `json:"name" protobuf:"bytes,1,opt,name=name"`
+}
+
 // AnalysisTemplateSpec is the specification for a AnalysisTemplate resource
 type AnalysisTemplateSpec struct {
 	// Metrics contains the list of metrics to query as part of an analysis run
@@ -76,6 +200,38 @@ type AnalysisTemplateSpec struct {
 	// +patchMergeKey=templateName
 	// +patchStrategy=merge
 	Templates []AnalysisTemplateRef `json:"templates,omitempty" patchStrategy:"merge" patchMergeKey:"templateName" protobuf:"bytes,5,rep,name=templates"`
+	// Scoring decides the phase of the analysis from a weighted score of its metrics, instead of the worst phase of its metrics
+	// +optional
+	Scoring *AnalysisScoring `json:"scoring,omitempty" protobuf:"bytes,6,opt,name=scoring"`
+}
+
+// AnalysisScoring defines how the metrics of an analysis are combined into a score from 0 to 100. The score of a metric
+// is the percentage of its measurements which were successful, and the score of the analysis is the weighted average of
+// the scores of its metrics. Metrics which fail no longer terminate the analysis, but metrics which error still do.
+type AnalysisScoring struct {
+	// Weights are the weights of the metrics in the score. Metrics without a weight have a weight of 1.
+	// +patchMergeKey=metricName
+	// +patchStrategy=merge
+	// +optional
+	Weights []MetricWeight `json:"weights,omitempty" patchStrategy:"merge" patchMergeKey:"metricName" protobuf:"bytes,1,rep,name=weights"`
+	// Threshold is the minimum score for the analysis to be Successful, or Inconclusive
+	Threshold ScoreThreshold `json:"threshold" protobuf:"bytes,2,opt,name=threshold"`
+}
+
+// MetricWeight is the weight of a metric in the score of an analysis
+type MetricWeight struct {
+	// MetricName is the name of the metric
+	MetricName string `json:"metricName" protobuf:"bytes,1,opt,name=metricName"`
+	// Weight is the weight of the metric. A weight of 0 excludes the metric from the score.
+	Weight int32 `json:"weight" protobuf:"varint,2,opt,name=weight"`
+}
+
+// ScoreThreshold defines the phase of an analysis from its score
+type ScoreThreshold struct {
+	// Pass is the minimum score for the analysis to be Successful
+	Pass int64 `json:"pass" protobuf:"varint,1,opt,name=pass"`
+	// Marginal is the minimum score for the analysis to be Inconclusive rather than Failed
+	Marginal int64 `json:"marginal" protobuf:"varint,2,opt,name=marginal"`
 }
 
 // DurationString is a string representing a duration (e.g. 30s, 5m, 1h)
@@ -125,6 +281,43 @@ type Metric struct {
 	// ConsecutiveSuccessLimit is the number of consecutive times the measurement must succeed for the
 	// entire metric to be considered Successful (default: 0, which means it's disabled)
 	ConsecutiveSuccessLimit *intstrutil.IntOrString `json:"consecutiveSuccessLimit,omitempty" protobuf:"bytes,11,opt,name=consecutiveSuccessLimit"`
+	// DependsOn is a list of names of other metrics in the analysis which must complete before this
+	// metric starts taking measurements. Until then, the metric is reported as Pending.
+	// +optional
+	DependsOn []string `json:"dependsOn,omitempty" protobuf:"bytes,12,rep,name=dependsOn"`
+	// AdaptiveInterval shortens the interval between measurements while they are close to a threshold
+	// +optional
+	AdaptiveInterval *AdaptiveInterval `json:"adaptiveInterval,omitempty" protobuf:"bytes,13,opt,name=adaptiveInterval"`
+	// EarlySuccess considers the metric Successful before its count is reached. Unlike ConsecutiveSuccessLimit,
+	// the metric is not required to reach it
+	// +optional
+	EarlySuccess *EarlySuccess `json:"earlySuccess,omitempty" protobuf:"bytes,14,opt,name=earlySuccess"`
+}
+
+// AdaptiveInterval defines when measurements are taken more frequently than the interval of the metric
+type AdaptiveInterval struct {
+	// MinInterval is the interval (e.g. 30s) between measurements while the last measurement is close to a threshold
+	MinInterval DurationString `json:"minInterval" protobuf:"bytes,1,opt,name=minInterval,casttype=DurationString"`
+	// Condition is an expression which determines if the last measurement is close to a threshold, with the
+	// same variables as the success condition. Measurements which did not succeed are always considered close
+	// to a threshold.
+	// Example:
+	//   result[0] < 0.97
+	// +optional
+	Condition string `json:"condition,omitempty" protobuf:"bytes,2,opt,name=condition"`
+}
+
+// EarlySuccess defines when a metric is considered Successful before its count is reached
+type EarlySuccess struct {
+	// ConsecutiveSuccessLimit is the number of consecutive times the measurement must succeed for the metric to be
+	// considered Successful early
+	ConsecutiveSuccessLimit int32 `json:"consecutiveSuccessLimit" protobuf:"varint,1,opt,name=consecutiveSuccessLimit"`
+	// Condition is an expression which must also be true for the metric to be considered Successful early, with
+	// the same variables as the success condition, e.g. to require the last values to be close to each other.
+	// Example:
+	//   avg(latest(5)) >= 0.99
+	// +optional
+	Condition string `json:"condition,omitempty" protobuf:"bytes,2,opt,name=condition"`
 }
 
 // DryRun defines the settings for running the analysis in Dry-Run mode.
@@ -193,6 +386,17 @@ type MetricProvider struct {
 	Influxdb *InfluxdbMetric `json:"influxdb,omitempty" protobuf:"bytes,10,opt,name=influxdb"`
 	// SkyWalking specifies the skywalking metric to query
 	SkyWalking *SkyWalkingMetric `json:"skywalking,omitempty" protobuf:"bytes,11,opt,name=skywalking"`
+	// SLOBurnRate specifies a multi-window, multi-burn-rate evaluation of an SLO error budget
+	SLOBurnRate *SLOBurnRateMetric `json:"sloBurnRate,omitempty" protobuf:"bytes,13,opt,name=sloBurnRate"`
+	// ConfigRef references a MetricProviderConfig holding the connection settings of the provider
+	// +optional
+	ConfigRef *MetricProviderConfigRef `json:"configRef,omitempty" protobuf:"bytes,14,opt,name=configRef"`
+	// OpenSearch specifies an OpenSearch or Elasticsearch query
+	OpenSearch *OpenSearchMetric `json:"opensearch,omitempty" protobuf:"bytes,15,opt,name=opensearch"`
+	// SQL specifies a read-only SQL query to run against a database
+	SQL *SQLMetric `json:"sql,omitempty" protobuf:"bytes,16,opt,name=sql"`
+	// Probe specifies synthetic requests to send to measure the latency and error rate of a service
+	Probe *ProbeMetric `json:"probe,omitempty" protobuf:"bytes,17,opt,name=probe"`
 	// +kubebuilder:validation:Schemaless
 	// +kubebuilder:pruning:PreserveUnknownFields
 	// +kubebuilder:validation:Type=object
@@ -256,6 +460,148 @@ type PrometheusMetric struct {
 	RangeQuery *PrometheusRangeQueryArgs `json:"rangeQuery,omitempty" protobuf:"bytes,7,opt,name=rangeQuery"`
 }
 
+// SLOBurnRateMetric defines an SLO whose error budget burn rate is evaluated over pairs of short and long windows
+type SLOBurnRateMetric struct {
+	// Prometheus is the prometheus server used to evaluate the error ratio. The query and rangeQuery fields are ignored.
+	Prometheus PrometheusMetric `json:"prometheus" protobuf:"bytes,1,opt,name=prometheus"`
+	// ErrorRatioQuery is a prometheus query returning the ratio of failed requests to total requests, between 0 and 1,
+	// as a single value. $window is replaced by the duration of the window the ratio is evaluated over (e.g. 5m)
+	ErrorRatioQuery string `json:"errorRatioQuery" protobuf:"bytes,2,opt,name=errorRatioQuery"`
+	// Target is the SLO target expressed as the expected ratio of successful requests (e.g. 0.999)
+	Target string `json:"target" protobuf:"bytes,3,opt,name=target"`
+	// Windows are the window pairs to evaluate. The measurement fails when the burn rates over both
+	// the short and the long window of any pair exceed the pair's burn rate threshold
+	Windows []SLOBurnRateWindow `json:"windows" protobuf:"bytes,5,rep,name=windows"`
+}
+
+// SLOBurnRateWindow defines a burn rate threshold over a short and a long window
+type SLOBurnRateWindow struct {
+	// ShortWindow is the duration of the short window (e.g. 5m)
+	ShortWindow DurationString `json:"shortWindow" protobuf:"bytes,1,opt,name=shortWindow,casttype=DurationString"`
+	// LongWindow is the duration of the long window (e.g. 1h)
+	LongWindow DurationString `json:"longWindow" protobuf:"bytes,2,opt,name=longWindow,casttype=DurationString"`
+	// BurnRate is the threshold which both windows must exceed for the measurement to fail (e.g. 14.4)
+	BurnRate string `json:"burnRate" protobuf:"bytes,3,opt,name=burnRate"`
+}
+
+// OpenSearchQueryLanguage is the language of an OpenSearch query
+type OpenSearchQueryLanguage string
+
+const (
+	// OpenSearchQueryLanguageDSL queries an index with a query DSL search request
+	OpenSearchQueryLanguageDSL OpenSearchQueryLanguage = "DSL"
+	// OpenSearchQueryLanguageSQL queries with a SQL statement
+	OpenSearchQueryLanguageSQL OpenSearchQueryLanguage = "SQL"
+)
+
+// OpenSearchMetric defines an OpenSearch or Elasticsearch query to perform canary analysis
+type OpenSearchMetric struct {
+	// Address is the URL of the cluster. Overrides the address of the secret
+	// +optional
+	Address string `json:"address,omitempty" protobuf:"bytes,1,opt,name=address"`
+	// Index is the index or index pattern searched by a DSL query
+	// +optional
+	Index string `json:"index,omitempty" protobuf:"bytes,2,opt,name=index"`
+	// Query is the JSON search request body of a DSL query, or the statement of a SQL query
+	Query string `json:"query" protobuf:"bytes,3,opt,name=query"`
+	// Language is the language of the query, either DSL or SQL (default: DSL)
+	// +kubebuilder:validation:Enum=DSL;SQL
+	// +optional
+	Language OpenSearchQueryLanguage `json:"language,omitempty" protobuf:"bytes,4,opt,name=language,casttype=OpenSearchQueryLanguage"`
+	// ValuePath is the dot separated path of the result in the response, e.g. aggregations.errors.value
+	// (default: hits.total.value for DSL queries and the first column of the first row for SQL queries)
+	// +optional
+	ValuePath string `json:"valuePath,omitempty" protobuf:"bytes,5,opt,name=valuePath"`
+	// SecretRef references the secret holding the address and credentials of the cluster
+	// +optional
+	SecretRef SecretRef `json:"secretRef,omitempty" protobuf:"bytes,6,opt,name=secretRef"`
+	// RequestTimeout is the timeout of the request (default: 10s)
+	// +optional
+	RequestTimeout DurationString `json:"requestTimeout,omitempty" protobuf:"bytes,7,opt,name=requestTimeout,casttype=DurationString"`
+	// Insecure skips host TLS verification
+	// +optional
+	Insecure bool `json:"insecure,omitempty" protobuf:"varint,8,opt,name=insecure"`
+}
+
+// SQLMetric defines a read-only SQL query to perform canary analysis
+type SQLMetric struct {
+	// SecretRef references the secret holding the name of the database driver (driver) and the data source name (dsn)
+	SecretRef SecretRef `json:"secretRef" protobuf:"bytes,1,opt,name=secretRef"`
+	// Query is the query to run in a read-only transaction. It uses the placeholders of the driver,
+	// e.g. $1 or ?, for its arguments
+	Query string `json:"query" protobuf:"bytes,2,opt,name=query"`
+	// Args are the values bound to the placeholders of the query
+	// +optional
+	Args []string `json:"args,omitempty" protobuf:"bytes,3,rep,name=args"`
+	// Timeout is the duration after which the query is cancelled (default: 10s)
+	// +optional
+	Timeout DurationString `json:"timeout,omitempty" protobuf:"bytes,4,opt,name=timeout,casttype=DurationString"`
+	// ResultSet returns all the rows as a list of objects keyed by column name, instead of the single value
+	// of a query returning one row with one column
+	// +optional
+	ResultSet bool `json:"resultSet,omitempty" protobuf:"varint,5,opt,name=resultSet"`
+}
+
+// ProbeMetric defines synthetic requests sent during a measurement, whose latency percentiles and error rate
+// are the result of the measurement
+type ProbeMetric struct {
+	// HTTP sends HTTP requests
+	// +optional
+	HTTP *ProbeHTTP `json:"http,omitempty" protobuf:"bytes,1,opt,name=http"`
+	// GRPC sends gRPC health checks
+	// +optional
+	GRPC *ProbeGRPC `json:"grpc,omitempty" protobuf:"bytes,2,opt,name=grpc"`
+	// Requests is the number of requests sent during a measurement (default: 100)
+	// +optional
+	Requests int32 `json:"requests,omitempty" protobuf:"varint,3,opt,name=requests"`
+	// RPS is the number of requests sent per second (default: 10)
+	// +optional
+	RPS int32 `json:"rps,omitempty" protobuf:"varint,4,opt,name=rps"`
+	// Timeout is the timeout of each request (default: 5s)
+	// +optional
+	Timeout DurationString `json:"timeout,omitempty" protobuf:"bytes,5,opt,name=timeout,casttype=DurationString"`
+}
+
+// ProbeHTTP defines the HTTP requests of a probe
+type ProbeHTTP struct {
+	// URL is the address requests are sent to, e.g. the canary service
+	URL string `json:"url" protobuf:"bytes,1,opt,name=url"`
+	// Method is the method of the requests (default: GET)
+	// +optional
+	Method WebMetricMethod `json:"method,omitempty" protobuf:"bytes,2,opt,name=method"`
+	// +patchMergeKey=key
+	// +patchStrategy=merge
+	// Headers are the headers of the requests, e.g. to reach a header-routed canary
+	// +optional
+	Headers []WebMetricHeader `json:"headers,omitempty" patchStrategy:"merge" patchMergeKey:"key" protobuf:"bytes,3,rep,name=headers"`
+	// Body is the body of the requests (method must be POST/PUT)
+	// +optional
+	Body string `json:"body,omitempty" protobuf:"bytes,4,opt,name=body"`
+	// Insecure skips host TLS verification
+	// +optional
+	Insecure bool `json:"insecure,omitempty" protobuf:"varint,5,opt,name=insecure"`
+}
+
+// ProbeGRPC defines the gRPC health checks of a probe
+type ProbeGRPC struct {
+	// Address is the host and port health checks are sent to, e.g. the canary service
+	Address string `json:"address" protobuf:"bytes,1,opt,name=address"`
+	// Service is the name of the service whose health is checked (default: the server health)
+	// +optional
+	Service string `json:"service,omitempty" protobuf:"bytes,2,opt,name=service"`
+	// +patchMergeKey=key
+	// +patchStrategy=merge
+	// Metadata is the metadata of the requests, e.g. to reach a header-routed canary
+	// +optional
+	Metadata []WebMetricHeader `json:"metadata,omitempty" patchStrategy:"merge" patchMergeKey:"key" protobuf:"bytes,3,rep,name=metadata"`
+	// TLS connects to the server with TLS instead of plaintext
+	// +optional
+	TLS bool `json:"tls,omitempty" protobuf:"varint,4,opt,name=tls"`
+	// Insecure skips host TLS verification
+	// +optional
+	Insecure bool `json:"insecure,omitempty" protobuf:"varint,5,opt,name=insecure"`
+}
+
 // Authentication method
 type Authentication struct {
 	// Sigv4 Config is the aws SigV4 configuration to use for SigV4 signing if using Amazon Managed Prometheus
@@ -320,6 +666,37 @@ type NewRelicMetric struct {
 type JobMetric struct {
 	Metadata metav1.ObjectMeta `json:"metadata,omitempty" protobuf:"bytes,1,opt,name=metadata"`
 	Spec     batchv1.JobSpec   `json:"spec" protobuf:"bytes,2,opt,name=spec"`
+	// Result reads a result value from the pod of the completed job, which is evaluated by the
+	// success and failure conditions of the metric
+	// +optional
+	Result *JobMetricResult `json:"result,omitempty" protobuf:"bytes,3,opt,name=result"`
+}
+
+// JobResultSource is where the result of a job metric is read from
+type JobResultSource string
+
+const (
+	// JobResultSourceTerminationMessage reads the result from the termination message of the container
+	JobResultSourceTerminationMessage JobResultSource = "TerminationMessage"
+	// JobResultSourceLog reads the result from the last JSON line of the container log
+	JobResultSourceLog JobResultSource = "Log"
+)
+
+// JobMetricResult defines how the result of a job metric is read from the pod of the completed job
+type JobMetricResult struct {
+	// Source is where the result is read from, either TerminationMessage or Log
+	// +kubebuilder:validation:Enum=TerminationMessage;Log
+	Source JobResultSource `json:"source" protobuf:"bytes,1,opt,name=source,casttype=JobResultSource"`
+	// Container is the name of the container producing the result (default: the first container)
+	// +optional
+	Container string `json:"container,omitempty" protobuf:"bytes,2,opt,name=container"`
+	// LogPrefix selects the last log line starting with the prefix, the rest of the line being the
+	// JSON result. Without a prefix, the last log line which is valid JSON is used
+	// +optional
+	LogPrefix string `json:"logPrefix,omitempty" protobuf:"bytes,3,opt,name=logPrefix"`
+	// JSONPath is a JSON Path to use as the result variable (default: "{$}")
+	// +optional
+	JSONPath string `json:"jsonPath,omitempty" protobuf:"bytes,4,opt,name=jsonPath"`
 }
 
 // GraphiteMetric defines the Graphite query to perform canary analysis
@@ -425,6 +802,9 @@ type AnalysisRunSpec struct {
 	// TTLStrategy object contains the strategy for the time to live depending on if the analysis succeeded or failed
 	// +optional
 	TTLStrategy *TTLStrategy `json:"ttlStrategy,omitempty" protobuf:"bytes,6,opt,name=ttlStrategy"`
+	// Scoring decides the phase of the run from a weighted score of its metrics, instead of the worst phase of its metrics
+	// +optional
+	Scoring *AnalysisScoring `json:"scoring,omitempty" protobuf:"bytes,7,opt,name=scoring"`
 }
 
 // Argument is an argument to an AnalysisRun
@@ -447,6 +827,10 @@ type ValueFrom struct {
 	// valueFrom
 	// +optional
 	FieldRef *FieldRef `json:"fieldRef,omitempty" protobuf:"bytes,2,opt,name=fieldRef"`
+	// ConfigMapKeyRef is a reference to a key of a config map in the namespace of the analysis. This field is one
+	// of the fields with valueFrom
+	// +optional
+	ConfigMapKeyRef *ConfigMapKeyRef `json:"configMapKeyRef,omitempty" protobuf:"bytes,3,opt,name=configMapKeyRef"`
 }
 
 type SecretKeyRef struct {
@@ -456,6 +840,13 @@ type SecretKeyRef struct {
 	Key string `json:"key" protobuf:"bytes,2,opt,name=key"`
 }
 
+type ConfigMapKeyRef struct {
+	// Name is the name of the config map
+	Name string `json:"name" protobuf:"bytes,1,opt,name=name"`
+	// Key is the key of the config map to select from.
+	Key string `json:"key" protobuf:"bytes,2,opt,name=key"`
+}
+
 // AnalysisRunStatus is the status for a AnalysisRun resource
 type AnalysisRunStatus struct {
 	// Phase is the status of the analysis run
@@ -472,6 +863,9 @@ type AnalysisRunStatus struct {
 	DryRunSummary *RunSummary `json:"dryRunSummary,omitempty" protobuf:"bytes,6,opt,name=dryRunSummary"`
 	// CompletedAt indicates when the analysisRun completed
 	CompletedAt *metav1.Time `json:"completedAt,omitempty" protobuf:"bytes,7,opt,name=completedAt"`
+	// Score is the weighted score of the metrics measured so far, from 0 to 100, when the run defines scoring
+	// +optional
+	Score *int64 `json:"score,omitempty" protobuf:"varint,8,opt,name=score"`
 }
 
 // RunSummary contains the final results from the metric executions
@@ -605,6 +999,39 @@ type WebMetric struct {
 	// Authentication details
 	// +optional
 	Authentication Authentication `json:"authentication,omitempty" protobuf:"bytes,9,opt,name=authentication"`
+	// TLS configures the client certificate and certificate authorities used for mutual TLS
+	// +optional
+	TLS *WebMetricTLS `json:"tls,omitempty" protobuf:"bytes,10,opt,name=tls"`
+	// Retry retries requests failing with a 5xx status code with an exponential backoff
+	// +optional
+	Retry *WebMetricRetry `json:"retry,omitempty" protobuf:"bytes,11,opt,name=retry"`
+	// IgnoreStatusCode evaluates responses of any status code with the success and failure conditions,
+	// instead of treating non 2xx responses as errors
+	// +optional
+	IgnoreStatusCode bool `json:"ignoreStatusCode,omitempty" protobuf:"varint,12,opt,name=ignoreStatusCode"`
+}
+
+// WebMetricTLS defines the TLS settings of a web metric
+type WebMetricTLS struct {
+	// ClientCertSecretRef references a kubernetes.io/tls Secret holding the client certificate (tls.crt) and
+	// private key (tls.key) presented to the server. A CA bundle (ca.crt) in the Secret is used to verify the server.
+	// +optional
+	ClientCertSecretRef *SecretRef `json:"clientCertSecretRef,omitempty" protobuf:"bytes,1,opt,name=clientCertSecretRef"`
+	// CABundle is a PEM encoded bundle of certificate authorities used to verify the server instead of the system ones
+	// +optional
+	CABundle string `json:"caBundle,omitempty" protobuf:"bytes,2,opt,name=caBundle"`
+	// ServerName overrides the host name used to verify the server certificate
+	// +optional
+	ServerName string `json:"serverName,omitempty" protobuf:"bytes,3,opt,name=serverName"`
+}
+
+// WebMetricRetry defines how requests of a web metric failing with a 5xx status code are retried
+type WebMetricRetry struct {
+	// Limit is the maximum number of retries
+	Limit int32 `json:"limit,omitempty" protobuf:"varint,1,opt,name=limit"`
+	// Backoff is the delay before the first retry, doubled for every following retry (default: 1s)
+	// +optional
+	Backoff DurationString `json:"backoff,omitempty" protobuf:"bytes,2,opt,name=backoff,casttype=DurationString"`
 }
 
 // WebMetricMethod is the available HTTP methods
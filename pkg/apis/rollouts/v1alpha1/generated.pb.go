@@ -103,10 +103,38 @@ func (m *ALBTrafficRouting) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_ALBTrafficRouting proto.InternalMessageInfo
 
+func (m *AdaptiveInterval) Reset()      { *m = AdaptiveInterval{} }
+func (*AdaptiveInterval) ProtoMessage() {}
+func (*AdaptiveInterval) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e0e705f843545fab, []int{2}
+}
+func (m *AdaptiveInterval) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AdaptiveInterval) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *AdaptiveInterval) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AdaptiveInterval.Merge(m, src)
+}
+func (m *AdaptiveInterval) XXX_Size() int {
+	return m.Size()
+}
+func (m *AdaptiveInterval) XXX_DiscardUnknown() {
+	xxx_messageInfo_AdaptiveInterval.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AdaptiveInterval proto.InternalMessageInfo
+
 func (m *AmbassadorTrafficRouting) Reset()      { *m = AmbassadorTrafficRouting{} }
 func (*AmbassadorTrafficRouting) ProtoMessage() {}
 func (*AmbassadorTrafficRouting) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{2}
+	return fileDescriptor_e0e705f843545fab, []int{3}
 }
 func (m *AmbassadorTrafficRouting) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -134,7 +162,7 @@ var xxx_messageInfo_AmbassadorTrafficRouting proto.InternalMessageInfo
 func (m *AnalysisRun) Reset()      { *m = AnalysisRun{} }
 func (*AnalysisRun) ProtoMessage() {}
 func (*AnalysisRun) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{3}
+	return fileDescriptor_e0e705f843545fab, []int{4}
 }
 func (m *AnalysisRun) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -162,7 +190,7 @@ var xxx_messageInfo_AnalysisRun proto.InternalMessageInfo
 func (m *AnalysisRunArgument) Reset()      { *m = AnalysisRunArgument{} }
 func (*AnalysisRunArgument) ProtoMessage() {}
 func (*AnalysisRunArgument) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{4}
+	return fileDescriptor_e0e705f843545fab, []int{5}
 }
 func (m *AnalysisRunArgument) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -190,7 +218,7 @@ var xxx_messageInfo_AnalysisRunArgument proto.InternalMessageInfo
 func (m *AnalysisRunList) Reset()      { *m = AnalysisRunList{} }
 func (*AnalysisRunList) ProtoMessage() {}
 func (*AnalysisRunList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{5}
+	return fileDescriptor_e0e705f843545fab, []int{6}
 }
 func (m *AnalysisRunList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -218,7 +246,7 @@ var xxx_messageInfo_AnalysisRunList proto.InternalMessageInfo
 func (m *AnalysisRunMetadata) Reset()      { *m = AnalysisRunMetadata{} }
 func (*AnalysisRunMetadata) ProtoMessage() {}
 func (*AnalysisRunMetadata) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{6}
+	return fileDescriptor_e0e705f843545fab, []int{7}
 }
 func (m *AnalysisRunMetadata) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -246,7 +274,7 @@ var xxx_messageInfo_AnalysisRunMetadata proto.InternalMessageInfo
 func (m *AnalysisRunSpec) Reset()      { *m = AnalysisRunSpec{} }
 func (*AnalysisRunSpec) ProtoMessage() {}
 func (*AnalysisRunSpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{7}
+	return fileDescriptor_e0e705f843545fab, []int{8}
 }
 func (m *AnalysisRunSpec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -274,7 +302,7 @@ var xxx_messageInfo_AnalysisRunSpec proto.InternalMessageInfo
 func (m *AnalysisRunStatus) Reset()      { *m = AnalysisRunStatus{} }
 func (*AnalysisRunStatus) ProtoMessage() {}
 func (*AnalysisRunStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{8}
+	return fileDescriptor_e0e705f843545fab, []int{9}
 }
 func (m *AnalysisRunStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -302,7 +330,7 @@ var xxx_messageInfo_AnalysisRunStatus proto.InternalMessageInfo
 func (m *AnalysisRunStrategy) Reset()      { *m = AnalysisRunStrategy{} }
 func (*AnalysisRunStrategy) ProtoMessage() {}
 func (*AnalysisRunStrategy) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{9}
+	return fileDescriptor_e0e705f843545fab, []int{10}
 }
 func (m *AnalysisRunStrategy) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -327,10 +355,38 @@ func (m *AnalysisRunStrategy) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_AnalysisRunStrategy proto.InternalMessageInfo
 
+func (m *AnalysisScoring) Reset()      { *m = AnalysisScoring{} }
+func (*AnalysisScoring) ProtoMessage() {}
+func (*AnalysisScoring) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e0e705f843545fab, []int{11}
+}
+func (m *AnalysisScoring) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AnalysisScoring) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *AnalysisScoring) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AnalysisScoring.Merge(m, src)
+}
+func (m *AnalysisScoring) XXX_Size() int {
+	return m.Size()
+}
+func (m *AnalysisScoring) XXX_DiscardUnknown() {
+	xxx_messageInfo_AnalysisScoring.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AnalysisScoring proto.InternalMessageInfo
+
 func (m *AnalysisTemplate) Reset()      { *m = AnalysisTemplate{} }
 func (*AnalysisTemplate) ProtoMessage() {}
 func (*AnalysisTemplate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{10}
+	return fileDescriptor_e0e705f843545fab, []int{12}
 }
 func (m *AnalysisTemplate) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -358,7 +414,7 @@ var xxx_messageInfo_AnalysisTemplate proto.InternalMessageInfo
 func (m *AnalysisTemplateList) Reset()      { *m = AnalysisTemplateList{} }
 func (*AnalysisTemplateList) ProtoMessage() {}
 func (*AnalysisTemplateList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{11}
+	return fileDescriptor_e0e705f843545fab, []int{13}
 }
 func (m *AnalysisTemplateList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -386,7 +442,7 @@ var xxx_messageInfo_AnalysisTemplateList proto.InternalMessageInfo
 func (m *AnalysisTemplateRef) Reset()      { *m = AnalysisTemplateRef{} }
 func (*AnalysisTemplateRef) ProtoMessage() {}
 func (*AnalysisTemplateRef) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{12}
+	return fileDescriptor_e0e705f843545fab, []int{14}
 }
 func (m *AnalysisTemplateRef) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -414,7 +470,7 @@ var xxx_messageInfo_AnalysisTemplateRef proto.InternalMessageInfo
 func (m *AnalysisTemplateSpec) Reset()      { *m = AnalysisTemplateSpec{} }
 func (*AnalysisTemplateSpec) ProtoMessage() {}
 func (*AnalysisTemplateSpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{13}
+	return fileDescriptor_e0e705f843545fab, []int{15}
 }
 func (m *AnalysisTemplateSpec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -442,7 +498,7 @@ var xxx_messageInfo_AnalysisTemplateSpec proto.InternalMessageInfo
 func (m *AntiAffinity) Reset()      { *m = AntiAffinity{} }
 func (*AntiAffinity) ProtoMessage() {}
 func (*AntiAffinity) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{14}
+	return fileDescriptor_e0e705f843545fab, []int{16}
 }
 func (m *AntiAffinity) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -470,7 +526,7 @@ var xxx_messageInfo_AntiAffinity proto.InternalMessageInfo
 func (m *ApisixRoute) Reset()      { *m = ApisixRoute{} }
 func (*ApisixRoute) ProtoMessage() {}
 func (*ApisixRoute) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{15}
+	return fileDescriptor_e0e705f843545fab, []int{17}
 }
 func (m *ApisixRoute) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -498,7 +554,7 @@ var xxx_messageInfo_ApisixRoute proto.InternalMessageInfo
 func (m *ApisixTrafficRouting) Reset()      { *m = ApisixTrafficRouting{} }
 func (*ApisixTrafficRouting) ProtoMessage() {}
 func (*ApisixTrafficRouting) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{16}
+	return fileDescriptor_e0e705f843545fab, []int{18}
 }
 func (m *ApisixTrafficRouting) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -526,7 +582,7 @@ var xxx_messageInfo_ApisixTrafficRouting proto.InternalMessageInfo
 func (m *AppMeshTrafficRouting) Reset()      { *m = AppMeshTrafficRouting{} }
 func (*AppMeshTrafficRouting) ProtoMessage() {}
 func (*AppMeshTrafficRouting) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{17}
+	return fileDescriptor_e0e705f843545fab, []int{19}
 }
 func (m *AppMeshTrafficRouting) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -554,7 +610,7 @@ var xxx_messageInfo_AppMeshTrafficRouting proto.InternalMessageInfo
 func (m *AppMeshVirtualNodeGroup) Reset()      { *m = AppMeshVirtualNodeGroup{} }
 func (*AppMeshVirtualNodeGroup) ProtoMessage() {}
 func (*AppMeshVirtualNodeGroup) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{18}
+	return fileDescriptor_e0e705f843545fab, []int{20}
 }
 func (m *AppMeshVirtualNodeGroup) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -582,7 +638,7 @@ var xxx_messageInfo_AppMeshVirtualNodeGroup proto.InternalMessageInfo
 func (m *AppMeshVirtualNodeReference) Reset()      { *m = AppMeshVirtualNodeReference{} }
 func (*AppMeshVirtualNodeReference) ProtoMessage() {}
 func (*AppMeshVirtualNodeReference) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{19}
+	return fileDescriptor_e0e705f843545fab, []int{21}
 }
 func (m *AppMeshVirtualNodeReference) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -610,7 +666,7 @@ var xxx_messageInfo_AppMeshVirtualNodeReference proto.InternalMessageInfo
 func (m *AppMeshVirtualService) Reset()      { *m = AppMeshVirtualService{} }
 func (*AppMeshVirtualService) ProtoMessage() {}
 func (*AppMeshVirtualService) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{20}
+	return fileDescriptor_e0e705f843545fab, []int{22}
 }
 func (m *AppMeshVirtualService) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -638,7 +694,7 @@ var xxx_messageInfo_AppMeshVirtualService proto.InternalMessageInfo
 func (m *Argument) Reset()      { *m = Argument{} }
 func (*Argument) ProtoMessage() {}
 func (*Argument) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{21}
+	return fileDescriptor_e0e705f843545fab, []int{23}
 }
 func (m *Argument) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -666,7 +722,7 @@ var xxx_messageInfo_Argument proto.InternalMessageInfo
 func (m *ArgumentValueFrom) Reset()      { *m = ArgumentValueFrom{} }
 func (*ArgumentValueFrom) ProtoMessage() {}
 func (*ArgumentValueFrom) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{22}
+	return fileDescriptor_e0e705f843545fab, []int{24}
 }
 func (m *ArgumentValueFrom) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -694,7 +750,7 @@ var xxx_messageInfo_ArgumentValueFrom proto.InternalMessageInfo
 func (m *Authentication) Reset()      { *m = Authentication{} }
 func (*Authentication) ProtoMessage() {}
 func (*Authentication) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{23}
+	return fileDescriptor_e0e705f843545fab, []int{25}
 }
 func (m *Authentication) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -722,7 +778,7 @@ var xxx_messageInfo_Authentication proto.InternalMessageInfo
 func (m *AwsResourceRef) Reset()      { *m = AwsResourceRef{} }
 func (*AwsResourceRef) ProtoMessage() {}
 func (*AwsResourceRef) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{24}
+	return fileDescriptor_e0e705f843545fab, []int{26}
 }
 func (m *AwsResourceRef) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -750,7 +806,7 @@ var xxx_messageInfo_AwsResourceRef proto.InternalMessageInfo
 func (m *BasicAuthConfig) Reset()      { *m = BasicAuthConfig{} }
 func (*BasicAuthConfig) ProtoMessage() {}
 func (*BasicAuthConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{25}
+	return fileDescriptor_e0e705f843545fab, []int{27}
 }
 func (m *BasicAuthConfig) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -775,10 +831,38 @@ func (m *BasicAuthConfig) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_BasicAuthConfig proto.InternalMessageInfo
 
+func (m *BasicAuthProviderConfig) Reset()      { *m = BasicAuthProviderConfig{} }
+func (*BasicAuthProviderConfig) ProtoMessage() {}
+func (*BasicAuthProviderConfig) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e0e705f843545fab, []int{28}
+}
+func (m *BasicAuthProviderConfig) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BasicAuthProviderConfig) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *BasicAuthProviderConfig) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BasicAuthProviderConfig.Merge(m, src)
+}
+func (m *BasicAuthProviderConfig) XXX_Size() int {
+	return m.Size()
+}
+func (m *BasicAuthProviderConfig) XXX_DiscardUnknown() {
+	xxx_messageInfo_BasicAuthProviderConfig.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BasicAuthProviderConfig proto.InternalMessageInfo
+
 func (m *BlueGreenStatus) Reset()      { *m = BlueGreenStatus{} }
 func (*BlueGreenStatus) ProtoMessage() {}
 func (*BlueGreenStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{26}
+	return fileDescriptor_e0e705f843545fab, []int{29}
 }
 func (m *BlueGreenStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -806,7 +890,7 @@ var xxx_messageInfo_BlueGreenStatus proto.InternalMessageInfo
 func (m *BlueGreenStrategy) Reset()      { *m = BlueGreenStrategy{} }
 func (*BlueGreenStrategy) ProtoMessage() {}
 func (*BlueGreenStrategy) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{27}
+	return fileDescriptor_e0e705f843545fab, []int{30}
 }
 func (m *BlueGreenStrategy) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -834,7 +918,7 @@ var xxx_messageInfo_BlueGreenStrategy proto.InternalMessageInfo
 func (m *CanaryStatus) Reset()      { *m = CanaryStatus{} }
 func (*CanaryStatus) ProtoMessage() {}
 func (*CanaryStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{28}
+	return fileDescriptor_e0e705f843545fab, []int{31}
 }
 func (m *CanaryStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -862,7 +946,7 @@ var xxx_messageInfo_CanaryStatus proto.InternalMessageInfo
 func (m *CanaryStep) Reset()      { *m = CanaryStep{} }
 func (*CanaryStep) ProtoMessage() {}
 func (*CanaryStep) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{29}
+	return fileDescriptor_e0e705f843545fab, []int{32}
 }
 func (m *CanaryStep) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -890,7 +974,7 @@ var xxx_messageInfo_CanaryStep proto.InternalMessageInfo
 func (m *CanaryStrategy) Reset()      { *m = CanaryStrategy{} }
 func (*CanaryStrategy) ProtoMessage() {}
 func (*CanaryStrategy) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{30}
+	return fileDescriptor_e0e705f843545fab, []int{33}
 }
 func (m *CanaryStrategy) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -918,7 +1002,7 @@ var xxx_messageInfo_CanaryStrategy proto.InternalMessageInfo
 func (m *CloudWatchMetric) Reset()      { *m = CloudWatchMetric{} }
 func (*CloudWatchMetric) ProtoMessage() {}
 func (*CloudWatchMetric) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{31}
+	return fileDescriptor_e0e705f843545fab, []int{34}
 }
 func (m *CloudWatchMetric) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -946,7 +1030,7 @@ var xxx_messageInfo_CloudWatchMetric proto.InternalMessageInfo
 func (m *CloudWatchMetricDataQuery) Reset()      { *m = CloudWatchMetricDataQuery{} }
 func (*CloudWatchMetricDataQuery) ProtoMessage() {}
 func (*CloudWatchMetricDataQuery) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{32}
+	return fileDescriptor_e0e705f843545fab, []int{35}
 }
 func (m *CloudWatchMetricDataQuery) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -974,7 +1058,7 @@ var xxx_messageInfo_CloudWatchMetricDataQuery proto.InternalMessageInfo
 func (m *CloudWatchMetricStat) Reset()      { *m = CloudWatchMetricStat{} }
 func (*CloudWatchMetricStat) ProtoMessage() {}
 func (*CloudWatchMetricStat) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{33}
+	return fileDescriptor_e0e705f843545fab, []int{36}
 }
 func (m *CloudWatchMetricStat) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1002,7 +1086,7 @@ var xxx_messageInfo_CloudWatchMetricStat proto.InternalMessageInfo
 func (m *CloudWatchMetricStatMetric) Reset()      { *m = CloudWatchMetricStatMetric{} }
 func (*CloudWatchMetricStatMetric) ProtoMessage() {}
 func (*CloudWatchMetricStatMetric) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{34}
+	return fileDescriptor_e0e705f843545fab, []int{37}
 }
 func (m *CloudWatchMetricStatMetric) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1030,7 +1114,7 @@ var xxx_messageInfo_CloudWatchMetricStatMetric proto.InternalMessageInfo
 func (m *CloudWatchMetricStatMetricDimension) Reset()      { *m = CloudWatchMetricStatMetricDimension{} }
 func (*CloudWatchMetricStatMetricDimension) ProtoMessage() {}
 func (*CloudWatchMetricStatMetricDimension) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{35}
+	return fileDescriptor_e0e705f843545fab, []int{38}
 }
 func (m *CloudWatchMetricStatMetricDimension) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1058,7 +1142,7 @@ var xxx_messageInfo_CloudWatchMetricStatMetricDimension proto.InternalMessageInf
 func (m *ClusterAnalysisTemplate) Reset()      { *m = ClusterAnalysisTemplate{} }
 func (*ClusterAnalysisTemplate) ProtoMessage() {}
 func (*ClusterAnalysisTemplate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{36}
+	return fileDescriptor_e0e705f843545fab, []int{39}
 }
 func (m *ClusterAnalysisTemplate) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1086,7 +1170,7 @@ var xxx_messageInfo_ClusterAnalysisTemplate proto.InternalMessageInfo
 func (m *ClusterAnalysisTemplateList) Reset()      { *m = ClusterAnalysisTemplateList{} }
 func (*ClusterAnalysisTemplateList) ProtoMessage() {}
 func (*ClusterAnalysisTemplateList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{37}
+	return fileDescriptor_e0e705f843545fab, []int{40}
 }
 func (m *ClusterAnalysisTemplateList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1111,10 +1195,94 @@ func (m *ClusterAnalysisTemplateList) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_ClusterAnalysisTemplateList proto.InternalMessageInfo
 
+func (m *ConfigMapKeyRef) Reset()      { *m = ConfigMapKeyRef{} }
+func (*ConfigMapKeyRef) ProtoMessage() {}
+func (*ConfigMapKeyRef) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e0e705f843545fab, []int{41}
+}
+func (m *ConfigMapKeyRef) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ConfigMapKeyRef) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *ConfigMapKeyRef) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConfigMapKeyRef.Merge(m, src)
+}
+func (m *ConfigMapKeyRef) XXX_Size() int {
+	return m.Size()
+}
+func (m *ConfigMapKeyRef) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConfigMapKeyRef.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ConfigMapKeyRef proto.InternalMessageInfo
+
+func (m *ConsulTrafficRouting) Reset()      { *m = ConsulTrafficRouting{} }
+func (*ConsulTrafficRouting) ProtoMessage() {}
+func (*ConsulTrafficRouting) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e0e705f843545fab, []int{42}
+}
+func (m *ConsulTrafficRouting) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ConsulTrafficRouting) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *ConsulTrafficRouting) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConsulTrafficRouting.Merge(m, src)
+}
+func (m *ConsulTrafficRouting) XXX_Size() int {
+	return m.Size()
+}
+func (m *ConsulTrafficRouting) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConsulTrafficRouting.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ConsulTrafficRouting proto.InternalMessageInfo
+
+func (m *ContourTrafficRouting) Reset()      { *m = ContourTrafficRouting{} }
+func (*ContourTrafficRouting) ProtoMessage() {}
+func (*ContourTrafficRouting) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e0e705f843545fab, []int{43}
+}
+func (m *ContourTrafficRouting) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ContourTrafficRouting) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *ContourTrafficRouting) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ContourTrafficRouting.Merge(m, src)
+}
+func (m *ContourTrafficRouting) XXX_Size() int {
+	return m.Size()
+}
+func (m *ContourTrafficRouting) XXX_DiscardUnknown() {
+	xxx_messageInfo_ContourTrafficRouting.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ContourTrafficRouting proto.InternalMessageInfo
+
 func (m *DatadogMetric) Reset()      { *m = DatadogMetric{} }
 func (*DatadogMetric) ProtoMessage() {}
 func (*DatadogMetric) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{38}
+	return fileDescriptor_e0e705f843545fab, []int{44}
 }
 func (m *DatadogMetric) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1139,10 +1307,38 @@ func (m *DatadogMetric) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_DatadogMetric proto.InternalMessageInfo
 
+func (m *DatadogProviderConfig) Reset()      { *m = DatadogProviderConfig{} }
+func (*DatadogProviderConfig) ProtoMessage() {}
+func (*DatadogProviderConfig) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e0e705f843545fab, []int{45}
+}
+func (m *DatadogProviderConfig) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DatadogProviderConfig) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *DatadogProviderConfig) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DatadogProviderConfig.Merge(m, src)
+}
+func (m *DatadogProviderConfig) XXX_Size() int {
+	return m.Size()
+}
+func (m *DatadogProviderConfig) XXX_DiscardUnknown() {
+	xxx_messageInfo_DatadogProviderConfig.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DatadogProviderConfig proto.InternalMessageInfo
+
 func (m *DryRun) Reset()      { *m = DryRun{} }
 func (*DryRun) ProtoMessage() {}
 func (*DryRun) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{39}
+	return fileDescriptor_e0e705f843545fab, []int{46}
 }
 func (m *DryRun) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1167,10 +1363,38 @@ func (m *DryRun) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_DryRun proto.InternalMessageInfo
 
+func (m *EarlySuccess) Reset()      { *m = EarlySuccess{} }
+func (*EarlySuccess) ProtoMessage() {}
+func (*EarlySuccess) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e0e705f843545fab, []int{47}
+}
+func (m *EarlySuccess) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EarlySuccess) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *EarlySuccess) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EarlySuccess.Merge(m, src)
+}
+func (m *EarlySuccess) XXX_Size() int {
+	return m.Size()
+}
+func (m *EarlySuccess) XXX_DiscardUnknown() {
+	xxx_messageInfo_EarlySuccess.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EarlySuccess proto.InternalMessageInfo
+
 func (m *Experiment) Reset()      { *m = Experiment{} }
 func (*Experiment) ProtoMessage() {}
 func (*Experiment) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{40}
+	return fileDescriptor_e0e705f843545fab, []int{48}
 }
 func (m *Experiment) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1198,7 +1422,7 @@ var xxx_messageInfo_Experiment proto.InternalMessageInfo
 func (m *ExperimentAnalysisRunStatus) Reset()      { *m = ExperimentAnalysisRunStatus{} }
 func (*ExperimentAnalysisRunStatus) ProtoMessage() {}
 func (*ExperimentAnalysisRunStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{41}
+	return fileDescriptor_e0e705f843545fab, []int{49}
 }
 func (m *ExperimentAnalysisRunStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1226,7 +1450,7 @@ var xxx_messageInfo_ExperimentAnalysisRunStatus proto.InternalMessageInfo
 func (m *ExperimentAnalysisTemplateRef) Reset()      { *m = ExperimentAnalysisTemplateRef{} }
 func (*ExperimentAnalysisTemplateRef) ProtoMessage() {}
 func (*ExperimentAnalysisTemplateRef) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{42}
+	return fileDescriptor_e0e705f843545fab, []int{50}
 }
 func (m *ExperimentAnalysisTemplateRef) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1254,7 +1478,7 @@ var xxx_messageInfo_ExperimentAnalysisTemplateRef proto.InternalMessageInfo
 func (m *ExperimentCondition) Reset()      { *m = ExperimentCondition{} }
 func (*ExperimentCondition) ProtoMessage() {}
 func (*ExperimentCondition) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{43}
+	return fileDescriptor_e0e705f843545fab, []int{51}
 }
 func (m *ExperimentCondition) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1282,7 +1506,7 @@ var xxx_messageInfo_ExperimentCondition proto.InternalMessageInfo
 func (m *ExperimentList) Reset()      { *m = ExperimentList{} }
 func (*ExperimentList) ProtoMessage() {}
 func (*ExperimentList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{44}
+	return fileDescriptor_e0e705f843545fab, []int{52}
 }
 func (m *ExperimentList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1310,7 +1534,7 @@ var xxx_messageInfo_ExperimentList proto.InternalMessageInfo
 func (m *ExperimentSpec) Reset()      { *m = ExperimentSpec{} }
 func (*ExperimentSpec) ProtoMessage() {}
 func (*ExperimentSpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{45}
+	return fileDescriptor_e0e705f843545fab, []int{53}
 }
 func (m *ExperimentSpec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1338,7 +1562,7 @@ var xxx_messageInfo_ExperimentSpec proto.InternalMessageInfo
 func (m *ExperimentStatus) Reset()      { *m = ExperimentStatus{} }
 func (*ExperimentStatus) ProtoMessage() {}
 func (*ExperimentStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{46}
+	return fileDescriptor_e0e705f843545fab, []int{54}
 }
 func (m *ExperimentStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1366,7 +1590,7 @@ var xxx_messageInfo_ExperimentStatus proto.InternalMessageInfo
 func (m *FieldRef) Reset()      { *m = FieldRef{} }
 func (*FieldRef) ProtoMessage() {}
 func (*FieldRef) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{47}
+	return fileDescriptor_e0e705f843545fab, []int{55}
 }
 func (m *FieldRef) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1391,10 +1615,38 @@ func (m *FieldRef) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_FieldRef proto.InternalMessageInfo
 
+func (m *GatewayAPITrafficRouting) Reset()      { *m = GatewayAPITrafficRouting{} }
+func (*GatewayAPITrafficRouting) ProtoMessage() {}
+func (*GatewayAPITrafficRouting) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e0e705f843545fab, []int{56}
+}
+func (m *GatewayAPITrafficRouting) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GatewayAPITrafficRouting) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *GatewayAPITrafficRouting) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GatewayAPITrafficRouting.Merge(m, src)
+}
+func (m *GatewayAPITrafficRouting) XXX_Size() int {
+	return m.Size()
+}
+func (m *GatewayAPITrafficRouting) XXX_DiscardUnknown() {
+	xxx_messageInfo_GatewayAPITrafficRouting.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GatewayAPITrafficRouting proto.InternalMessageInfo
+
 func (m *GraphiteMetric) Reset()      { *m = GraphiteMetric{} }
 func (*GraphiteMetric) ProtoMessage() {}
 func (*GraphiteMetric) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{48}
+	return fileDescriptor_e0e705f843545fab, []int{57}
 }
 func (m *GraphiteMetric) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1422,7 +1674,7 @@ var xxx_messageInfo_GraphiteMetric proto.InternalMessageInfo
 func (m *HeaderRoutingMatch) Reset()      { *m = HeaderRoutingMatch{} }
 func (*HeaderRoutingMatch) ProtoMessage() {}
 func (*HeaderRoutingMatch) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{49}
+	return fileDescriptor_e0e705f843545fab, []int{58}
 }
 func (m *HeaderRoutingMatch) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1450,7 +1702,7 @@ var xxx_messageInfo_HeaderRoutingMatch proto.InternalMessageInfo
 func (m *InfluxdbMetric) Reset()      { *m = InfluxdbMetric{} }
 func (*InfluxdbMetric) ProtoMessage() {}
 func (*InfluxdbMetric) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{50}
+	return fileDescriptor_e0e705f843545fab, []int{59}
 }
 func (m *InfluxdbMetric) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1475,10 +1727,38 @@ func (m *InfluxdbMetric) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_InfluxdbMetric proto.InternalMessageInfo
 
+func (m *InfluxdbProviderConfig) Reset()      { *m = InfluxdbProviderConfig{} }
+func (*InfluxdbProviderConfig) ProtoMessage() {}
+func (*InfluxdbProviderConfig) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e0e705f843545fab, []int{60}
+}
+func (m *InfluxdbProviderConfig) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *InfluxdbProviderConfig) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *InfluxdbProviderConfig) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InfluxdbProviderConfig.Merge(m, src)
+}
+func (m *InfluxdbProviderConfig) XXX_Size() int {
+	return m.Size()
+}
+func (m *InfluxdbProviderConfig) XXX_DiscardUnknown() {
+	xxx_messageInfo_InfluxdbProviderConfig.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_InfluxdbProviderConfig proto.InternalMessageInfo
+
 func (m *IstioDestinationRule) Reset()      { *m = IstioDestinationRule{} }
 func (*IstioDestinationRule) ProtoMessage() {}
 func (*IstioDestinationRule) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{51}
+	return fileDescriptor_e0e705f843545fab, []int{61}
 }
 func (m *IstioDestinationRule) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1506,7 +1786,7 @@ var xxx_messageInfo_IstioDestinationRule proto.InternalMessageInfo
 func (m *IstioTrafficRouting) Reset()      { *m = IstioTrafficRouting{} }
 func (*IstioTrafficRouting) ProtoMessage() {}
 func (*IstioTrafficRouting) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{52}
+	return fileDescriptor_e0e705f843545fab, []int{62}
 }
 func (m *IstioTrafficRouting) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1534,7 +1814,7 @@ var xxx_messageInfo_IstioTrafficRouting proto.InternalMessageInfo
 func (m *IstioVirtualService) Reset()      { *m = IstioVirtualService{} }
 func (*IstioVirtualService) ProtoMessage() {}
 func (*IstioVirtualService) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{53}
+	return fileDescriptor_e0e705f843545fab, []int{63}
 }
 func (m *IstioVirtualService) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1562,7 +1842,7 @@ var xxx_messageInfo_IstioVirtualService proto.InternalMessageInfo
 func (m *JobMetric) Reset()      { *m = JobMetric{} }
 func (*JobMetric) ProtoMessage() {}
 func (*JobMetric) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{54}
+	return fileDescriptor_e0e705f843545fab, []int{64}
 }
 func (m *JobMetric) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1587,10 +1867,38 @@ func (m *JobMetric) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_JobMetric proto.InternalMessageInfo
 
+func (m *JobMetricResult) Reset()      { *m = JobMetricResult{} }
+func (*JobMetricResult) ProtoMessage() {}
+func (*JobMetricResult) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e0e705f843545fab, []int{65}
+}
+func (m *JobMetricResult) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *JobMetricResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *JobMetricResult) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_JobMetricResult.Merge(m, src)
+}
+func (m *JobMetricResult) XXX_Size() int {
+	return m.Size()
+}
+func (m *JobMetricResult) XXX_DiscardUnknown() {
+	xxx_messageInfo_JobMetricResult.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_JobMetricResult proto.InternalMessageInfo
+
 func (m *KayentaMetric) Reset()      { *m = KayentaMetric{} }
 func (*KayentaMetric) ProtoMessage() {}
 func (*KayentaMetric) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{55}
+	return fileDescriptor_e0e705f843545fab, []int{66}
 }
 func (m *KayentaMetric) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1618,7 +1926,7 @@ var xxx_messageInfo_KayentaMetric proto.InternalMessageInfo
 func (m *KayentaScope) Reset()      { *m = KayentaScope{} }
 func (*KayentaScope) ProtoMessage() {}
 func (*KayentaScope) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{56}
+	return fileDescriptor_e0e705f843545fab, []int{67}
 }
 func (m *KayentaScope) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1646,7 +1954,7 @@ var xxx_messageInfo_KayentaScope proto.InternalMessageInfo
 func (m *KayentaThreshold) Reset()      { *m = KayentaThreshold{} }
 func (*KayentaThreshold) ProtoMessage() {}
 func (*KayentaThreshold) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{57}
+	return fileDescriptor_e0e705f843545fab, []int{68}
 }
 func (m *KayentaThreshold) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1671,10 +1979,38 @@ func (m *KayentaThreshold) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_KayentaThreshold proto.InternalMessageInfo
 
+func (m *KongTrafficRouting) Reset()      { *m = KongTrafficRouting{} }
+func (*KongTrafficRouting) ProtoMessage() {}
+func (*KongTrafficRouting) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e0e705f843545fab, []int{69}
+}
+func (m *KongTrafficRouting) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *KongTrafficRouting) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *KongTrafficRouting) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_KongTrafficRouting.Merge(m, src)
+}
+func (m *KongTrafficRouting) XXX_Size() int {
+	return m.Size()
+}
+func (m *KongTrafficRouting) XXX_DiscardUnknown() {
+	xxx_messageInfo_KongTrafficRouting.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_KongTrafficRouting proto.InternalMessageInfo
+
 func (m *MangedRoutes) Reset()      { *m = MangedRoutes{} }
 func (*MangedRoutes) ProtoMessage() {}
 func (*MangedRoutes) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{58}
+	return fileDescriptor_e0e705f843545fab, []int{70}
 }
 func (m *MangedRoutes) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1702,7 +2038,7 @@ var xxx_messageInfo_MangedRoutes proto.InternalMessageInfo
 func (m *Measurement) Reset()      { *m = Measurement{} }
 func (*Measurement) ProtoMessage() {}
 func (*Measurement) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{59}
+	return fileDescriptor_e0e705f843545fab, []int{71}
 }
 func (m *Measurement) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1730,7 +2066,7 @@ var xxx_messageInfo_Measurement proto.InternalMessageInfo
 func (m *MeasurementRetention) Reset()      { *m = MeasurementRetention{} }
 func (*MeasurementRetention) ProtoMessage() {}
 func (*MeasurementRetention) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{60}
+	return fileDescriptor_e0e705f843545fab, []int{72}
 }
 func (m *MeasurementRetention) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1758,7 +2094,7 @@ var xxx_messageInfo_MeasurementRetention proto.InternalMessageInfo
 func (m *Metric) Reset()      { *m = Metric{} }
 func (*Metric) ProtoMessage() {}
 func (*Metric) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{61}
+	return fileDescriptor_e0e705f843545fab, []int{73}
 }
 func (m *Metric) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1786,7 +2122,7 @@ var xxx_messageInfo_Metric proto.InternalMessageInfo
 func (m *MetricProvider) Reset()      { *m = MetricProvider{} }
 func (*MetricProvider) ProtoMessage() {}
 func (*MetricProvider) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{62}
+	return fileDescriptor_e0e705f843545fab, []int{74}
 }
 func (m *MetricProvider) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1811,10 +2147,122 @@ func (m *MetricProvider) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MetricProvider proto.InternalMessageInfo
 
+func (m *MetricProviderConfig) Reset()      { *m = MetricProviderConfig{} }
+func (*MetricProviderConfig) ProtoMessage() {}
+func (*MetricProviderConfig) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e0e705f843545fab, []int{75}
+}
+func (m *MetricProviderConfig) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MetricProviderConfig) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *MetricProviderConfig) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MetricProviderConfig.Merge(m, src)
+}
+func (m *MetricProviderConfig) XXX_Size() int {
+	return m.Size()
+}
+func (m *MetricProviderConfig) XXX_DiscardUnknown() {
+	xxx_messageInfo_MetricProviderConfig.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MetricProviderConfig proto.InternalMessageInfo
+
+func (m *MetricProviderConfigList) Reset()      { *m = MetricProviderConfigList{} }
+func (*MetricProviderConfigList) ProtoMessage() {}
+func (*MetricProviderConfigList) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e0e705f843545fab, []int{76}
+}
+func (m *MetricProviderConfigList) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MetricProviderConfigList) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *MetricProviderConfigList) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MetricProviderConfigList.Merge(m, src)
+}
+func (m *MetricProviderConfigList) XXX_Size() int {
+	return m.Size()
+}
+func (m *MetricProviderConfigList) XXX_DiscardUnknown() {
+	xxx_messageInfo_MetricProviderConfigList.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MetricProviderConfigList proto.InternalMessageInfo
+
+func (m *MetricProviderConfigRef) Reset()      { *m = MetricProviderConfigRef{} }
+func (*MetricProviderConfigRef) ProtoMessage() {}
+func (*MetricProviderConfigRef) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e0e705f843545fab, []int{77}
+}
+func (m *MetricProviderConfigRef) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MetricProviderConfigRef) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *MetricProviderConfigRef) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MetricProviderConfigRef.Merge(m, src)
+}
+func (m *MetricProviderConfigRef) XXX_Size() int {
+	return m.Size()
+}
+func (m *MetricProviderConfigRef) XXX_DiscardUnknown() {
+	xxx_messageInfo_MetricProviderConfigRef.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MetricProviderConfigRef proto.InternalMessageInfo
+
+func (m *MetricProviderConfigSpec) Reset()      { *m = MetricProviderConfigSpec{} }
+func (*MetricProviderConfigSpec) ProtoMessage() {}
+func (*MetricProviderConfigSpec) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e0e705f843545fab, []int{78}
+}
+func (m *MetricProviderConfigSpec) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MetricProviderConfigSpec) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *MetricProviderConfigSpec) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MetricProviderConfigSpec.Merge(m, src)
+}
+func (m *MetricProviderConfigSpec) XXX_Size() int {
+	return m.Size()
+}
+func (m *MetricProviderConfigSpec) XXX_DiscardUnknown() {
+	xxx_messageInfo_MetricProviderConfigSpec.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MetricProviderConfigSpec proto.InternalMessageInfo
+
 func (m *MetricResult) Reset()      { *m = MetricResult{} }
 func (*MetricResult) ProtoMessage() {}
 func (*MetricResult) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{63}
+	return fileDescriptor_e0e705f843545fab, []int{79}
 }
 func (m *MetricResult) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1839,10 +2287,38 @@ func (m *MetricResult) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MetricResult proto.InternalMessageInfo
 
+func (m *MetricWeight) Reset()      { *m = MetricWeight{} }
+func (*MetricWeight) ProtoMessage() {}
+func (*MetricWeight) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e0e705f843545fab, []int{80}
+}
+func (m *MetricWeight) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MetricWeight) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *MetricWeight) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MetricWeight.Merge(m, src)
+}
+func (m *MetricWeight) XXX_Size() int {
+	return m.Size()
+}
+func (m *MetricWeight) XXX_DiscardUnknown() {
+	xxx_messageInfo_MetricWeight.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MetricWeight proto.InternalMessageInfo
+
 func (m *NewRelicMetric) Reset()      { *m = NewRelicMetric{} }
 func (*NewRelicMetric) ProtoMessage() {}
 func (*NewRelicMetric) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{64}
+	return fileDescriptor_e0e705f843545fab, []int{81}
 }
 func (m *NewRelicMetric) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1867,10 +2343,38 @@ func (m *NewRelicMetric) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_NewRelicMetric proto.InternalMessageInfo
 
+func (m *NewRelicProviderConfig) Reset()      { *m = NewRelicProviderConfig{} }
+func (*NewRelicProviderConfig) ProtoMessage() {}
+func (*NewRelicProviderConfig) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e0e705f843545fab, []int{82}
+}
+func (m *NewRelicProviderConfig) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *NewRelicProviderConfig) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *NewRelicProviderConfig) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NewRelicProviderConfig.Merge(m, src)
+}
+func (m *NewRelicProviderConfig) XXX_Size() int {
+	return m.Size()
+}
+func (m *NewRelicProviderConfig) XXX_DiscardUnknown() {
+	xxx_messageInfo_NewRelicProviderConfig.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NewRelicProviderConfig proto.InternalMessageInfo
+
 func (m *NginxTrafficRouting) Reset()      { *m = NginxTrafficRouting{} }
 func (*NginxTrafficRouting) ProtoMessage() {}
 func (*NginxTrafficRouting) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{65}
+	return fileDescriptor_e0e705f843545fab, []int{83}
 }
 func (m *NginxTrafficRouting) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1898,7 +2402,7 @@ var xxx_messageInfo_NginxTrafficRouting proto.InternalMessageInfo
 func (m *OAuth2Config) Reset()      { *m = OAuth2Config{} }
 func (*OAuth2Config) ProtoMessage() {}
 func (*OAuth2Config) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{66}
+	return fileDescriptor_e0e705f843545fab, []int{84}
 }
 func (m *OAuth2Config) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1923,10 +2427,38 @@ func (m *OAuth2Config) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_OAuth2Config proto.InternalMessageInfo
 
+func (m *OAuth2ProviderConfig) Reset()      { *m = OAuth2ProviderConfig{} }
+func (*OAuth2ProviderConfig) ProtoMessage() {}
+func (*OAuth2ProviderConfig) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e0e705f843545fab, []int{85}
+}
+func (m *OAuth2ProviderConfig) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *OAuth2ProviderConfig) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *OAuth2ProviderConfig) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OAuth2ProviderConfig.Merge(m, src)
+}
+func (m *OAuth2ProviderConfig) XXX_Size() int {
+	return m.Size()
+}
+func (m *OAuth2ProviderConfig) XXX_DiscardUnknown() {
+	xxx_messageInfo_OAuth2ProviderConfig.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OAuth2ProviderConfig proto.InternalMessageInfo
+
 func (m *ObjectRef) Reset()      { *m = ObjectRef{} }
 func (*ObjectRef) ProtoMessage() {}
 func (*ObjectRef) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{67}
+	return fileDescriptor_e0e705f843545fab, []int{86}
 }
 func (m *ObjectRef) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1951,10 +2483,38 @@ func (m *ObjectRef) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_ObjectRef proto.InternalMessageInfo
 
+func (m *OpenSearchMetric) Reset()      { *m = OpenSearchMetric{} }
+func (*OpenSearchMetric) ProtoMessage() {}
+func (*OpenSearchMetric) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e0e705f843545fab, []int{87}
+}
+func (m *OpenSearchMetric) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *OpenSearchMetric) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *OpenSearchMetric) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OpenSearchMetric.Merge(m, src)
+}
+func (m *OpenSearchMetric) XXX_Size() int {
+	return m.Size()
+}
+func (m *OpenSearchMetric) XXX_DiscardUnknown() {
+	xxx_messageInfo_OpenSearchMetric.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OpenSearchMetric proto.InternalMessageInfo
+
 func (m *PauseCondition) Reset()      { *m = PauseCondition{} }
 func (*PauseCondition) ProtoMessage() {}
 func (*PauseCondition) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{68}
+	return fileDescriptor_e0e705f843545fab, []int{88}
 }
 func (m *PauseCondition) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1982,7 +2542,7 @@ var xxx_messageInfo_PauseCondition proto.InternalMessageInfo
 func (m *PingPongSpec) Reset()      { *m = PingPongSpec{} }
 func (*PingPongSpec) ProtoMessage() {}
 func (*PingPongSpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{69}
+	return fileDescriptor_e0e705f843545fab, []int{89}
 }
 func (m *PingPongSpec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2010,7 +2570,7 @@ var xxx_messageInfo_PingPongSpec proto.InternalMessageInfo
 func (m *PluginStep) Reset()      { *m = PluginStep{} }
 func (*PluginStep) ProtoMessage() {}
 func (*PluginStep) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{70}
+	return fileDescriptor_e0e705f843545fab, []int{90}
 }
 func (m *PluginStep) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2038,7 +2598,7 @@ var xxx_messageInfo_PluginStep proto.InternalMessageInfo
 func (m *PodTemplateMetadata) Reset()      { *m = PodTemplateMetadata{} }
 func (*PodTemplateMetadata) ProtoMessage() {}
 func (*PodTemplateMetadata) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{71}
+	return fileDescriptor_e0e705f843545fab, []int{91}
 }
 func (m *PodTemplateMetadata) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2068,7 +2628,7 @@ func (m *PreferredDuringSchedulingIgnoredDuringExecution) Reset() {
 }
 func (*PreferredDuringSchedulingIgnoredDuringExecution) ProtoMessage() {}
 func (*PreferredDuringSchedulingIgnoredDuringExecution) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{72}
+	return fileDescriptor_e0e705f843545fab, []int{92}
 }
 func (m *PreferredDuringSchedulingIgnoredDuringExecution) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2093,10 +2653,94 @@ func (m *PreferredDuringSchedulingIgnoredDuringExecution) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_PreferredDuringSchedulingIgnoredDuringExecution proto.InternalMessageInfo
 
+func (m *ProbeGRPC) Reset()      { *m = ProbeGRPC{} }
+func (*ProbeGRPC) ProtoMessage() {}
+func (*ProbeGRPC) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e0e705f843545fab, []int{93}
+}
+func (m *ProbeGRPC) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ProbeGRPC) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *ProbeGRPC) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProbeGRPC.Merge(m, src)
+}
+func (m *ProbeGRPC) XXX_Size() int {
+	return m.Size()
+}
+func (m *ProbeGRPC) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProbeGRPC.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ProbeGRPC proto.InternalMessageInfo
+
+func (m *ProbeHTTP) Reset()      { *m = ProbeHTTP{} }
+func (*ProbeHTTP) ProtoMessage() {}
+func (*ProbeHTTP) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e0e705f843545fab, []int{94}
+}
+func (m *ProbeHTTP) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ProbeHTTP) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *ProbeHTTP) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProbeHTTP.Merge(m, src)
+}
+func (m *ProbeHTTP) XXX_Size() int {
+	return m.Size()
+}
+func (m *ProbeHTTP) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProbeHTTP.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ProbeHTTP proto.InternalMessageInfo
+
+func (m *ProbeMetric) Reset()      { *m = ProbeMetric{} }
+func (*ProbeMetric) ProtoMessage() {}
+func (*ProbeMetric) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e0e705f843545fab, []int{95}
+}
+func (m *ProbeMetric) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ProbeMetric) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *ProbeMetric) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProbeMetric.Merge(m, src)
+}
+func (m *ProbeMetric) XXX_Size() int {
+	return m.Size()
+}
+func (m *ProbeMetric) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProbeMetric.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ProbeMetric proto.InternalMessageInfo
+
 func (m *PrometheusMetric) Reset()      { *m = PrometheusMetric{} }
 func (*PrometheusMetric) ProtoMessage() {}
 func (*PrometheusMetric) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{73}
+	return fileDescriptor_e0e705f843545fab, []int{96}
 }
 func (m *PrometheusMetric) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2121,10 +2765,66 @@ func (m *PrometheusMetric) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_PrometheusMetric proto.InternalMessageInfo
 
+func (m *PrometheusProviderAuthentication) Reset()      { *m = PrometheusProviderAuthentication{} }
+func (*PrometheusProviderAuthentication) ProtoMessage() {}
+func (*PrometheusProviderAuthentication) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e0e705f843545fab, []int{97}
+}
+func (m *PrometheusProviderAuthentication) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PrometheusProviderAuthentication) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *PrometheusProviderAuthentication) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PrometheusProviderAuthentication.Merge(m, src)
+}
+func (m *PrometheusProviderAuthentication) XXX_Size() int {
+	return m.Size()
+}
+func (m *PrometheusProviderAuthentication) XXX_DiscardUnknown() {
+	xxx_messageInfo_PrometheusProviderAuthentication.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PrometheusProviderAuthentication proto.InternalMessageInfo
+
+func (m *PrometheusProviderConfig) Reset()      { *m = PrometheusProviderConfig{} }
+func (*PrometheusProviderConfig) ProtoMessage() {}
+func (*PrometheusProviderConfig) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e0e705f843545fab, []int{98}
+}
+func (m *PrometheusProviderConfig) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PrometheusProviderConfig) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *PrometheusProviderConfig) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PrometheusProviderConfig.Merge(m, src)
+}
+func (m *PrometheusProviderConfig) XXX_Size() int {
+	return m.Size()
+}
+func (m *PrometheusProviderConfig) XXX_DiscardUnknown() {
+	xxx_messageInfo_PrometheusProviderConfig.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PrometheusProviderConfig proto.InternalMessageInfo
+
 func (m *PrometheusRangeQueryArgs) Reset()      { *m = PrometheusRangeQueryArgs{} }
 func (*PrometheusRangeQueryArgs) ProtoMessage() {}
 func (*PrometheusRangeQueryArgs) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{74}
+	return fileDescriptor_e0e705f843545fab, []int{99}
 }
 func (m *PrometheusRangeQueryArgs) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2152,7 +2852,7 @@ var xxx_messageInfo_PrometheusRangeQueryArgs proto.InternalMessageInfo
 func (m *ReplicaProgressThreshold) Reset()      { *m = ReplicaProgressThreshold{} }
 func (*ReplicaProgressThreshold) ProtoMessage() {}
 func (*ReplicaProgressThreshold) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{75}
+	return fileDescriptor_e0e705f843545fab, []int{100}
 }
 func (m *ReplicaProgressThreshold) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2182,7 +2882,7 @@ func (m *RequiredDuringSchedulingIgnoredDuringExecution) Reset() {
 }
 func (*RequiredDuringSchedulingIgnoredDuringExecution) ProtoMessage() {}
 func (*RequiredDuringSchedulingIgnoredDuringExecution) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{76}
+	return fileDescriptor_e0e705f843545fab, []int{101}
 }
 func (m *RequiredDuringSchedulingIgnoredDuringExecution) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2210,7 +2910,7 @@ var xxx_messageInfo_RequiredDuringSchedulingIgnoredDuringExecution proto.Interna
 func (m *RollbackWindowSpec) Reset()      { *m = RollbackWindowSpec{} }
 func (*RollbackWindowSpec) ProtoMessage() {}
 func (*RollbackWindowSpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{77}
+	return fileDescriptor_e0e705f843545fab, []int{102}
 }
 func (m *RollbackWindowSpec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2238,7 +2938,7 @@ var xxx_messageInfo_RollbackWindowSpec proto.InternalMessageInfo
 func (m *Rollout) Reset()      { *m = Rollout{} }
 func (*Rollout) ProtoMessage() {}
 func (*Rollout) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{78}
+	return fileDescriptor_e0e705f843545fab, []int{103}
 }
 func (m *Rollout) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2266,7 +2966,7 @@ var xxx_messageInfo_Rollout proto.InternalMessageInfo
 func (m *RolloutAnalysis) Reset()      { *m = RolloutAnalysis{} }
 func (*RolloutAnalysis) ProtoMessage() {}
 func (*RolloutAnalysis) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{79}
+	return fileDescriptor_e0e705f843545fab, []int{104}
 }
 func (m *RolloutAnalysis) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2294,7 +2994,7 @@ var xxx_messageInfo_RolloutAnalysis proto.InternalMessageInfo
 func (m *RolloutAnalysisBackground) Reset()      { *m = RolloutAnalysisBackground{} }
 func (*RolloutAnalysisBackground) ProtoMessage() {}
 func (*RolloutAnalysisBackground) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{80}
+	return fileDescriptor_e0e705f843545fab, []int{105}
 }
 func (m *RolloutAnalysisBackground) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2322,7 +3022,7 @@ var xxx_messageInfo_RolloutAnalysisBackground proto.InternalMessageInfo
 func (m *RolloutAnalysisRunStatus) Reset()      { *m = RolloutAnalysisRunStatus{} }
 func (*RolloutAnalysisRunStatus) ProtoMessage() {}
 func (*RolloutAnalysisRunStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{81}
+	return fileDescriptor_e0e705f843545fab, []int{106}
 }
 func (m *RolloutAnalysisRunStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2350,7 +3050,7 @@ var xxx_messageInfo_RolloutAnalysisRunStatus proto.InternalMessageInfo
 func (m *RolloutCondition) Reset()      { *m = RolloutCondition{} }
 func (*RolloutCondition) ProtoMessage() {}
 func (*RolloutCondition) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{82}
+	return fileDescriptor_e0e705f843545fab, []int{107}
 }
 func (m *RolloutCondition) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2378,7 +3078,7 @@ var xxx_messageInfo_RolloutCondition proto.InternalMessageInfo
 func (m *RolloutDurationStatus) Reset()      { *m = RolloutDurationStatus{} }
 func (*RolloutDurationStatus) ProtoMessage() {}
 func (*RolloutDurationStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{83}
+	return fileDescriptor_e0e705f843545fab, []int{108}
 }
 func (m *RolloutDurationStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2406,7 +3106,7 @@ var xxx_messageInfo_RolloutDurationStatus proto.InternalMessageInfo
 func (m *RolloutExperimentStep) Reset()      { *m = RolloutExperimentStep{} }
 func (*RolloutExperimentStep) ProtoMessage() {}
 func (*RolloutExperimentStep) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{84}
+	return fileDescriptor_e0e705f843545fab, []int{109}
 }
 func (m *RolloutExperimentStep) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2436,7 +3136,7 @@ func (m *RolloutExperimentStepAnalysisTemplateRef) Reset() {
 }
 func (*RolloutExperimentStepAnalysisTemplateRef) ProtoMessage() {}
 func (*RolloutExperimentStepAnalysisTemplateRef) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{85}
+	return fileDescriptor_e0e705f843545fab, []int{110}
 }
 func (m *RolloutExperimentStepAnalysisTemplateRef) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2464,7 +3164,7 @@ var xxx_messageInfo_RolloutExperimentStepAnalysisTemplateRef proto.InternalMessa
 func (m *RolloutExperimentTemplate) Reset()      { *m = RolloutExperimentTemplate{} }
 func (*RolloutExperimentTemplate) ProtoMessage() {}
 func (*RolloutExperimentTemplate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{86}
+	return fileDescriptor_e0e705f843545fab, []int{111}
 }
 func (m *RolloutExperimentTemplate) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2492,7 +3192,7 @@ var xxx_messageInfo_RolloutExperimentTemplate proto.InternalMessageInfo
 func (m *RolloutList) Reset()      { *m = RolloutList{} }
 func (*RolloutList) ProtoMessage() {}
 func (*RolloutList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{87}
+	return fileDescriptor_e0e705f843545fab, []int{112}
 }
 func (m *RolloutList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2520,7 +3220,7 @@ var xxx_messageInfo_RolloutList proto.InternalMessageInfo
 func (m *RolloutPause) Reset()      { *m = RolloutPause{} }
 func (*RolloutPause) ProtoMessage() {}
 func (*RolloutPause) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{88}
+	return fileDescriptor_e0e705f843545fab, []int{113}
 }
 func (m *RolloutPause) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2548,7 +3248,7 @@ var xxx_messageInfo_RolloutPause proto.InternalMessageInfo
 func (m *RolloutSpec) Reset()      { *m = RolloutSpec{} }
 func (*RolloutSpec) ProtoMessage() {}
 func (*RolloutSpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{89}
+	return fileDescriptor_e0e705f843545fab, []int{114}
 }
 func (m *RolloutSpec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2576,7 +3276,7 @@ var xxx_messageInfo_RolloutSpec proto.InternalMessageInfo
 func (m *RolloutStatus) Reset()      { *m = RolloutStatus{} }
 func (*RolloutStatus) ProtoMessage() {}
 func (*RolloutStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{90}
+	return fileDescriptor_e0e705f843545fab, []int{115}
 }
 func (m *RolloutStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2604,7 +3304,7 @@ var xxx_messageInfo_RolloutStatus proto.InternalMessageInfo
 func (m *RolloutStrategy) Reset()      { *m = RolloutStrategy{} }
 func (*RolloutStrategy) ProtoMessage() {}
 func (*RolloutStrategy) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{91}
+	return fileDescriptor_e0e705f843545fab, []int{116}
 }
 func (m *RolloutStrategy) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2632,7 +3332,7 @@ var xxx_messageInfo_RolloutStrategy proto.InternalMessageInfo
 func (m *RolloutTrafficRouting) Reset()      { *m = RolloutTrafficRouting{} }
 func (*RolloutTrafficRouting) ProtoMessage() {}
 func (*RolloutTrafficRouting) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{92}
+	return fileDescriptor_e0e705f843545fab, []int{117}
 }
 func (m *RolloutTrafficRouting) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2660,7 +3360,7 @@ var xxx_messageInfo_RolloutTrafficRouting proto.InternalMessageInfo
 func (m *RouteMatch) Reset()      { *m = RouteMatch{} }
 func (*RouteMatch) ProtoMessage() {}
 func (*RouteMatch) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{93}
+	return fileDescriptor_e0e705f843545fab, []int{118}
 }
 func (m *RouteMatch) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2688,7 +3388,7 @@ var xxx_messageInfo_RouteMatch proto.InternalMessageInfo
 func (m *RunSummary) Reset()      { *m = RunSummary{} }
 func (*RunSummary) ProtoMessage() {}
 func (*RunSummary) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{94}
+	return fileDescriptor_e0e705f843545fab, []int{119}
 }
 func (m *RunSummary) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2713,10 +3413,66 @@ func (m *RunSummary) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_RunSummary proto.InternalMessageInfo
 
+func (m *SLOBurnRateMetric) Reset()      { *m = SLOBurnRateMetric{} }
+func (*SLOBurnRateMetric) ProtoMessage() {}
+func (*SLOBurnRateMetric) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e0e705f843545fab, []int{120}
+}
+func (m *SLOBurnRateMetric) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SLOBurnRateMetric) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *SLOBurnRateMetric) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SLOBurnRateMetric.Merge(m, src)
+}
+func (m *SLOBurnRateMetric) XXX_Size() int {
+	return m.Size()
+}
+func (m *SLOBurnRateMetric) XXX_DiscardUnknown() {
+	xxx_messageInfo_SLOBurnRateMetric.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SLOBurnRateMetric proto.InternalMessageInfo
+
+func (m *SLOBurnRateWindow) Reset()      { *m = SLOBurnRateWindow{} }
+func (*SLOBurnRateWindow) ProtoMessage() {}
+func (*SLOBurnRateWindow) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e0e705f843545fab, []int{121}
+}
+func (m *SLOBurnRateWindow) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SLOBurnRateWindow) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *SLOBurnRateWindow) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SLOBurnRateWindow.Merge(m, src)
+}
+func (m *SLOBurnRateWindow) XXX_Size() int {
+	return m.Size()
+}
+func (m *SLOBurnRateWindow) XXX_DiscardUnknown() {
+	xxx_messageInfo_SLOBurnRateWindow.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SLOBurnRateWindow proto.InternalMessageInfo
+
 func (m *SMITrafficRouting) Reset()      { *m = SMITrafficRouting{} }
 func (*SMITrafficRouting) ProtoMessage() {}
 func (*SMITrafficRouting) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{95}
+	return fileDescriptor_e0e705f843545fab, []int{122}
 }
 func (m *SMITrafficRouting) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2741,10 +3497,38 @@ func (m *SMITrafficRouting) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_SMITrafficRouting proto.InternalMessageInfo
 
+func (m *SQLMetric) Reset()      { *m = SQLMetric{} }
+func (*SQLMetric) ProtoMessage() {}
+func (*SQLMetric) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e0e705f843545fab, []int{123}
+}
+func (m *SQLMetric) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SQLMetric) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *SQLMetric) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SQLMetric.Merge(m, src)
+}
+func (m *SQLMetric) XXX_Size() int {
+	return m.Size()
+}
+func (m *SQLMetric) XXX_DiscardUnknown() {
+	xxx_messageInfo_SQLMetric.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SQLMetric proto.InternalMessageInfo
+
 func (m *ScopeDetail) Reset()      { *m = ScopeDetail{} }
 func (*ScopeDetail) ProtoMessage() {}
 func (*ScopeDetail) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{96}
+	return fileDescriptor_e0e705f843545fab, []int{124}
 }
 func (m *ScopeDetail) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2769,10 +3553,38 @@ func (m *ScopeDetail) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_ScopeDetail proto.InternalMessageInfo
 
+func (m *ScoreThreshold) Reset()      { *m = ScoreThreshold{} }
+func (*ScoreThreshold) ProtoMessage() {}
+func (*ScoreThreshold) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e0e705f843545fab, []int{125}
+}
+func (m *ScoreThreshold) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ScoreThreshold) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *ScoreThreshold) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ScoreThreshold.Merge(m, src)
+}
+func (m *ScoreThreshold) XXX_Size() int {
+	return m.Size()
+}
+func (m *ScoreThreshold) XXX_DiscardUnknown() {
+	xxx_messageInfo_ScoreThreshold.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ScoreThreshold proto.InternalMessageInfo
+
 func (m *SecretKeyRef) Reset()      { *m = SecretKeyRef{} }
 func (*SecretKeyRef) ProtoMessage() {}
 func (*SecretKeyRef) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{97}
+	return fileDescriptor_e0e705f843545fab, []int{126}
 }
 func (m *SecretKeyRef) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2800,7 +3612,7 @@ var xxx_messageInfo_SecretKeyRef proto.InternalMessageInfo
 func (m *SecretRef) Reset()      { *m = SecretRef{} }
 func (*SecretRef) ProtoMessage() {}
 func (*SecretRef) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{98}
+	return fileDescriptor_e0e705f843545fab, []int{127}
 }
 func (m *SecretRef) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2828,7 +3640,7 @@ var xxx_messageInfo_SecretRef proto.InternalMessageInfo
 func (m *SetCanaryScale) Reset()      { *m = SetCanaryScale{} }
 func (*SetCanaryScale) ProtoMessage() {}
 func (*SetCanaryScale) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{99}
+	return fileDescriptor_e0e705f843545fab, []int{128}
 }
 func (m *SetCanaryScale) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2856,7 +3668,7 @@ var xxx_messageInfo_SetCanaryScale proto.InternalMessageInfo
 func (m *SetHeaderRoute) Reset()      { *m = SetHeaderRoute{} }
 func (*SetHeaderRoute) ProtoMessage() {}
 func (*SetHeaderRoute) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{100}
+	return fileDescriptor_e0e705f843545fab, []int{129}
 }
 func (m *SetHeaderRoute) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2881,10 +3693,38 @@ func (m *SetHeaderRoute) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_SetHeaderRoute proto.InternalMessageInfo
 
+func (m *SetLocality) Reset()      { *m = SetLocality{} }
+func (*SetLocality) ProtoMessage() {}
+func (*SetLocality) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e0e705f843545fab, []int{130}
+}
+func (m *SetLocality) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SetLocality) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *SetLocality) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetLocality.Merge(m, src)
+}
+func (m *SetLocality) XXX_Size() int {
+	return m.Size()
+}
+func (m *SetLocality) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetLocality.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetLocality proto.InternalMessageInfo
+
 func (m *SetMirrorRoute) Reset()      { *m = SetMirrorRoute{} }
 func (*SetMirrorRoute) ProtoMessage() {}
 func (*SetMirrorRoute) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{101}
+	return fileDescriptor_e0e705f843545fab, []int{131}
 }
 func (m *SetMirrorRoute) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2912,7 +3752,7 @@ var xxx_messageInfo_SetMirrorRoute proto.InternalMessageInfo
 func (m *Sigv4Config) Reset()      { *m = Sigv4Config{} }
 func (*Sigv4Config) ProtoMessage() {}
 func (*Sigv4Config) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{102}
+	return fileDescriptor_e0e705f843545fab, []int{132}
 }
 func (m *Sigv4Config) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2940,7 +3780,7 @@ var xxx_messageInfo_Sigv4Config proto.InternalMessageInfo
 func (m *SkyWalkingMetric) Reset()      { *m = SkyWalkingMetric{} }
 func (*SkyWalkingMetric) ProtoMessage() {}
 func (*SkyWalkingMetric) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{103}
+	return fileDescriptor_e0e705f843545fab, []int{133}
 }
 func (m *SkyWalkingMetric) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2968,7 +3808,7 @@ var xxx_messageInfo_SkyWalkingMetric proto.InternalMessageInfo
 func (m *StepPluginStatus) Reset()      { *m = StepPluginStatus{} }
 func (*StepPluginStatus) ProtoMessage() {}
 func (*StepPluginStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{104}
+	return fileDescriptor_e0e705f843545fab, []int{134}
 }
 func (m *StepPluginStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2996,7 +3836,7 @@ var xxx_messageInfo_StepPluginStatus proto.InternalMessageInfo
 func (m *StickinessConfig) Reset()      { *m = StickinessConfig{} }
 func (*StickinessConfig) ProtoMessage() {}
 func (*StickinessConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{105}
+	return fileDescriptor_e0e705f843545fab, []int{135}
 }
 func (m *StickinessConfig) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3024,7 +3864,7 @@ var xxx_messageInfo_StickinessConfig proto.InternalMessageInfo
 func (m *StringMatch) Reset()      { *m = StringMatch{} }
 func (*StringMatch) ProtoMessage() {}
 func (*StringMatch) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{106}
+	return fileDescriptor_e0e705f843545fab, []int{136}
 }
 func (m *StringMatch) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3052,7 +3892,7 @@ var xxx_messageInfo_StringMatch proto.InternalMessageInfo
 func (m *TCPRoute) Reset()      { *m = TCPRoute{} }
 func (*TCPRoute) ProtoMessage() {}
 func (*TCPRoute) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{107}
+	return fileDescriptor_e0e705f843545fab, []int{137}
 }
 func (m *TCPRoute) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3080,7 +3920,7 @@ var xxx_messageInfo_TCPRoute proto.InternalMessageInfo
 func (m *TLSRoute) Reset()      { *m = TLSRoute{} }
 func (*TLSRoute) ProtoMessage() {}
 func (*TLSRoute) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{108}
+	return fileDescriptor_e0e705f843545fab, []int{138}
 }
 func (m *TLSRoute) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3108,7 +3948,7 @@ var xxx_messageInfo_TLSRoute proto.InternalMessageInfo
 func (m *TTLStrategy) Reset()      { *m = TTLStrategy{} }
 func (*TTLStrategy) ProtoMessage() {}
 func (*TTLStrategy) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{109}
+	return fileDescriptor_e0e705f843545fab, []int{139}
 }
 func (m *TTLStrategy) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3136,7 +3976,7 @@ var xxx_messageInfo_TTLStrategy proto.InternalMessageInfo
 func (m *TemplateService) Reset()      { *m = TemplateService{} }
 func (*TemplateService) ProtoMessage() {}
 func (*TemplateService) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{110}
+	return fileDescriptor_e0e705f843545fab, []int{140}
 }
 func (m *TemplateService) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3164,7 +4004,7 @@ var xxx_messageInfo_TemplateService proto.InternalMessageInfo
 func (m *TemplateSpec) Reset()      { *m = TemplateSpec{} }
 func (*TemplateSpec) ProtoMessage() {}
 func (*TemplateSpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{111}
+	return fileDescriptor_e0e705f843545fab, []int{141}
 }
 func (m *TemplateSpec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3192,7 +4032,7 @@ var xxx_messageInfo_TemplateSpec proto.InternalMessageInfo
 func (m *TemplateStatus) Reset()      { *m = TemplateStatus{} }
 func (*TemplateStatus) ProtoMessage() {}
 func (*TemplateStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{112}
+	return fileDescriptor_e0e705f843545fab, []int{142}
 }
 func (m *TemplateStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3220,7 +4060,7 @@ var xxx_messageInfo_TemplateStatus proto.InternalMessageInfo
 func (m *TraefikTrafficRouting) Reset()      { *m = TraefikTrafficRouting{} }
 func (*TraefikTrafficRouting) ProtoMessage() {}
 func (*TraefikTrafficRouting) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{113}
+	return fileDescriptor_e0e705f843545fab, []int{143}
 }
 func (m *TraefikTrafficRouting) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3245,10 +4085,38 @@ func (m *TraefikTrafficRouting) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_TraefikTrafficRouting proto.InternalMessageInfo
 
+func (m *TrafficStickiness) Reset()      { *m = TrafficStickiness{} }
+func (*TrafficStickiness) ProtoMessage() {}
+func (*TrafficStickiness) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e0e705f843545fab, []int{144}
+}
+func (m *TrafficStickiness) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TrafficStickiness) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *TrafficStickiness) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TrafficStickiness.Merge(m, src)
+}
+func (m *TrafficStickiness) XXX_Size() int {
+	return m.Size()
+}
+func (m *TrafficStickiness) XXX_DiscardUnknown() {
+	xxx_messageInfo_TrafficStickiness.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TrafficStickiness proto.InternalMessageInfo
+
 func (m *TrafficWeights) Reset()      { *m = TrafficWeights{} }
 func (*TrafficWeights) ProtoMessage() {}
 func (*TrafficWeights) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{114}
+	return fileDescriptor_e0e705f843545fab, []int{145}
 }
 func (m *TrafficWeights) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3276,7 +4144,7 @@ var xxx_messageInfo_TrafficWeights proto.InternalMessageInfo
 func (m *ValueFrom) Reset()      { *m = ValueFrom{} }
 func (*ValueFrom) ProtoMessage() {}
 func (*ValueFrom) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{115}
+	return fileDescriptor_e0e705f843545fab, []int{146}
 }
 func (m *ValueFrom) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3304,7 +4172,7 @@ var xxx_messageInfo_ValueFrom proto.InternalMessageInfo
 func (m *WavefrontMetric) Reset()      { *m = WavefrontMetric{} }
 func (*WavefrontMetric) ProtoMessage() {}
 func (*WavefrontMetric) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{116}
+	return fileDescriptor_e0e705f843545fab, []int{147}
 }
 func (m *WavefrontMetric) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3332,7 +4200,7 @@ var xxx_messageInfo_WavefrontMetric proto.InternalMessageInfo
 func (m *WebMetric) Reset()      { *m = WebMetric{} }
 func (*WebMetric) ProtoMessage() {}
 func (*WebMetric) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{117}
+	return fileDescriptor_e0e705f843545fab, []int{148}
 }
 func (m *WebMetric) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3360,7 +4228,7 @@ var xxx_messageInfo_WebMetric proto.InternalMessageInfo
 func (m *WebMetricHeader) Reset()      { *m = WebMetricHeader{} }
 func (*WebMetricHeader) ProtoMessage() {}
 func (*WebMetricHeader) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{118}
+	return fileDescriptor_e0e705f843545fab, []int{149}
 }
 func (m *WebMetricHeader) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3385,10 +4253,66 @@ func (m *WebMetricHeader) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_WebMetricHeader proto.InternalMessageInfo
 
+func (m *WebMetricRetry) Reset()      { *m = WebMetricRetry{} }
+func (*WebMetricRetry) ProtoMessage() {}
+func (*WebMetricRetry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e0e705f843545fab, []int{150}
+}
+func (m *WebMetricRetry) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *WebMetricRetry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *WebMetricRetry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WebMetricRetry.Merge(m, src)
+}
+func (m *WebMetricRetry) XXX_Size() int {
+	return m.Size()
+}
+func (m *WebMetricRetry) XXX_DiscardUnknown() {
+	xxx_messageInfo_WebMetricRetry.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WebMetricRetry proto.InternalMessageInfo
+
+func (m *WebMetricTLS) Reset()      { *m = WebMetricTLS{} }
+func (*WebMetricTLS) ProtoMessage() {}
+func (*WebMetricTLS) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e0e705f843545fab, []int{151}
+}
+func (m *WebMetricTLS) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *WebMetricTLS) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *WebMetricTLS) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WebMetricTLS.Merge(m, src)
+}
+func (m *WebMetricTLS) XXX_Size() int {
+	return m.Size()
+}
+func (m *WebMetricTLS) XXX_DiscardUnknown() {
+	xxx_messageInfo_WebMetricTLS.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WebMetricTLS proto.InternalMessageInfo
+
 func (m *WeightDestination) Reset()      { *m = WeightDestination{} }
 func (*WeightDestination) ProtoMessage() {}
 func (*WeightDestination) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{119}
+	return fileDescriptor_e0e705f843545fab, []int{152}
 }
 func (m *WeightDestination) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3416,6 +4340,7 @@ var xxx_messageInfo_WeightDestination proto.InternalMessageInfo
 func init() {
 	proto.RegisterType((*ALBStatus)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.ALBStatus")
 	proto.RegisterType((*ALBTrafficRouting)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.ALBTrafficRouting")
+	proto.RegisterType((*AdaptiveInterval)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.AdaptiveInterval")
 	proto.RegisterType((*AmbassadorTrafficRouting)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.AmbassadorTrafficRouting")
 	proto.RegisterType((*AnalysisRun)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.AnalysisRun")
 	proto.RegisterType((*AnalysisRunArgument)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.AnalysisRunArgument")
@@ -3426,6 +4351,7 @@ func init() {
 	proto.RegisterType((*AnalysisRunSpec)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.AnalysisRunSpec")
 	proto.RegisterType((*AnalysisRunStatus)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.AnalysisRunStatus")
 	proto.RegisterType((*AnalysisRunStrategy)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.AnalysisRunStrategy")
+	proto.RegisterType((*AnalysisScoring)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.AnalysisScoring")
 	proto.RegisterType((*AnalysisTemplate)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.AnalysisTemplate")
 	proto.RegisterType((*AnalysisTemplateList)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.AnalysisTemplateList")
 	proto.RegisterType((*AnalysisTemplateRef)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.AnalysisTemplateRef")
@@ -3442,6 +4368,7 @@ func init() {
 	proto.RegisterType((*Authentication)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.Authentication")
 	proto.RegisterType((*AwsResourceRef)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.AwsResourceRef")
 	proto.RegisterType((*BasicAuthConfig)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.BasicAuthConfig")
+	proto.RegisterType((*BasicAuthProviderConfig)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.BasicAuthProviderConfig")
 	proto.RegisterType((*BlueGreenStatus)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.BlueGreenStatus")
 	proto.RegisterType((*BlueGreenStrategy)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.BlueGreenStrategy")
 	proto.RegisterType((*CanaryStatus)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.CanaryStatus")
@@ -3454,9 +4381,14 @@ func init() {
 	proto.RegisterType((*CloudWatchMetricStatMetricDimension)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.CloudWatchMetricStatMetricDimension")
 	proto.RegisterType((*ClusterAnalysisTemplate)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.ClusterAnalysisTemplate")
 	proto.RegisterType((*ClusterAnalysisTemplateList)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.ClusterAnalysisTemplateList")
+	proto.RegisterType((*ConfigMapKeyRef)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.ConfigMapKeyRef")
+	proto.RegisterType((*ConsulTrafficRouting)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.ConsulTrafficRouting")
+	proto.RegisterType((*ContourTrafficRouting)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.ContourTrafficRouting")
 	proto.RegisterType((*DatadogMetric)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.DatadogMetric")
 	proto.RegisterMapType((map[string]string)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.DatadogMetric.QueriesEntry")
+	proto.RegisterType((*DatadogProviderConfig)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.DatadogProviderConfig")
 	proto.RegisterType((*DryRun)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.DryRun")
+	proto.RegisterType((*EarlySuccess)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.EarlySuccess")
 	proto.RegisterType((*Experiment)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.Experiment")
 	proto.RegisterType((*ExperimentAnalysisRunStatus)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.ExperimentAnalysisRunStatus")
 	proto.RegisterType((*ExperimentAnalysisTemplateRef)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.ExperimentAnalysisTemplateRef")
@@ -3465,16 +4397,20 @@ func init() {
 	proto.RegisterType((*ExperimentSpec)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.ExperimentSpec")
 	proto.RegisterType((*ExperimentStatus)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.ExperimentStatus")
 	proto.RegisterType((*FieldRef)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.FieldRef")
+	proto.RegisterType((*GatewayAPITrafficRouting)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.GatewayAPITrafficRouting")
 	proto.RegisterType((*GraphiteMetric)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.GraphiteMetric")
 	proto.RegisterType((*HeaderRoutingMatch)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.HeaderRoutingMatch")
 	proto.RegisterType((*InfluxdbMetric)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.InfluxdbMetric")
+	proto.RegisterType((*InfluxdbProviderConfig)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.InfluxdbProviderConfig")
 	proto.RegisterType((*IstioDestinationRule)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.IstioDestinationRule")
 	proto.RegisterType((*IstioTrafficRouting)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.IstioTrafficRouting")
 	proto.RegisterType((*IstioVirtualService)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.IstioVirtualService")
 	proto.RegisterType((*JobMetric)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.JobMetric")
+	proto.RegisterType((*JobMetricResult)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.JobMetricResult")
 	proto.RegisterType((*KayentaMetric)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.KayentaMetric")
 	proto.RegisterType((*KayentaScope)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.KayentaScope")
 	proto.RegisterType((*KayentaThreshold)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.KayentaThreshold")
+	proto.RegisterType((*KongTrafficRouting)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.KongTrafficRouting")
 	proto.RegisterType((*MangedRoutes)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.MangedRoutes")
 	proto.RegisterType((*Measurement)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.Measurement")
 	proto.RegisterMapType((map[string]string)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.Measurement.MetadataEntry")
@@ -3482,14 +4418,22 @@ func init() {
 	proto.RegisterType((*Metric)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.Metric")
 	proto.RegisterType((*MetricProvider)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.MetricProvider")
 	proto.RegisterMapType((map[string]encoding_json.RawMessage)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.MetricProvider.PluginEntry")
+	proto.RegisterType((*MetricProviderConfig)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.MetricProviderConfig")
+	proto.RegisterType((*MetricProviderConfigList)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.MetricProviderConfigList")
+	proto.RegisterType((*MetricProviderConfigRef)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.MetricProviderConfigRef")
+	proto.RegisterType((*MetricProviderConfigSpec)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.MetricProviderConfigSpec")
 	proto.RegisterType((*MetricResult)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.MetricResult")
 	proto.RegisterMapType((map[string]string)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.MetricResult.MetadataEntry")
+	proto.RegisterType((*MetricWeight)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.MetricWeight")
 	proto.RegisterType((*NewRelicMetric)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.NewRelicMetric")
+	proto.RegisterType((*NewRelicProviderConfig)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.NewRelicProviderConfig")
 	proto.RegisterType((*NginxTrafficRouting)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.NginxTrafficRouting")
 	proto.RegisterMapType((map[string]string)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.NginxTrafficRouting.AdditionalIngressAnnotationsEntry")
 	proto.RegisterMapType((map[string]string)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.NginxTrafficRouting.CanaryIngressAnnotationsEntry")
 	proto.RegisterType((*OAuth2Config)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.OAuth2Config")
+	proto.RegisterType((*OAuth2ProviderConfig)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.OAuth2ProviderConfig")
 	proto.RegisterType((*ObjectRef)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.ObjectRef")
+	proto.RegisterType((*OpenSearchMetric)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.OpenSearchMetric")
 	proto.RegisterType((*PauseCondition)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.PauseCondition")
 	proto.RegisterType((*PingPongSpec)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.PingPongSpec")
 	proto.RegisterType((*PluginStep)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.PluginStep")
@@ -3497,7 +4441,12 @@ func init() {
 	proto.RegisterMapType((map[string]string)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.PodTemplateMetadata.AnnotationsEntry")
 	proto.RegisterMapType((map[string]string)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.PodTemplateMetadata.LabelsEntry")
 	proto.RegisterType((*PreferredDuringSchedulingIgnoredDuringExecution)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.PreferredDuringSchedulingIgnoredDuringExecution")
+	proto.RegisterType((*ProbeGRPC)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.ProbeGRPC")
+	proto.RegisterType((*ProbeHTTP)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.ProbeHTTP")
+	proto.RegisterType((*ProbeMetric)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.ProbeMetric")
 	proto.RegisterType((*PrometheusMetric)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.PrometheusMetric")
+	proto.RegisterType((*PrometheusProviderAuthentication)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.PrometheusProviderAuthentication")
+	proto.RegisterType((*PrometheusProviderConfig)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.PrometheusProviderConfig")
 	proto.RegisterType((*PrometheusRangeQueryArgs)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.PrometheusRangeQueryArgs")
 	proto.RegisterType((*ReplicaProgressThreshold)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.ReplicaProgressThreshold")
 	proto.RegisterType((*RequiredDuringSchedulingIgnoredDuringExecution)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.RequiredDuringSchedulingIgnoredDuringExecution")
@@ -3521,12 +4470,17 @@ func init() {
 	proto.RegisterType((*RouteMatch)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.RouteMatch")
 	proto.RegisterMapType((map[string]StringMatch)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.RouteMatch.HeadersEntry")
 	proto.RegisterType((*RunSummary)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.RunSummary")
+	proto.RegisterType((*SLOBurnRateMetric)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.SLOBurnRateMetric")
+	proto.RegisterType((*SLOBurnRateWindow)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.SLOBurnRateWindow")
 	proto.RegisterType((*SMITrafficRouting)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.SMITrafficRouting")
+	proto.RegisterType((*SQLMetric)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.SQLMetric")
 	proto.RegisterType((*ScopeDetail)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.ScopeDetail")
+	proto.RegisterType((*ScoreThreshold)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.ScoreThreshold")
 	proto.RegisterType((*SecretKeyRef)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.SecretKeyRef")
 	proto.RegisterType((*SecretRef)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.SecretRef")
 	proto.RegisterType((*SetCanaryScale)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.SetCanaryScale")
 	proto.RegisterType((*SetHeaderRoute)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.SetHeaderRoute")
+	proto.RegisterType((*SetLocality)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.SetLocality")
 	proto.RegisterType((*SetMirrorRoute)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.SetMirrorRoute")
 	proto.RegisterType((*Sigv4Config)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.Sigv4Config")
 	proto.RegisterType((*SkyWalkingMetric)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.SkyWalkingMetric")
@@ -3540,11 +4494,14 @@ func init() {
 	proto.RegisterType((*TemplateSpec)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.TemplateSpec")
 	proto.RegisterType((*TemplateStatus)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.TemplateStatus")
 	proto.RegisterType((*TraefikTrafficRouting)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.TraefikTrafficRouting")
+	proto.RegisterType((*TrafficStickiness)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.TrafficStickiness")
 	proto.RegisterType((*TrafficWeights)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.TrafficWeights")
 	proto.RegisterType((*ValueFrom)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.ValueFrom")
 	proto.RegisterType((*WavefrontMetric)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.WavefrontMetric")
 	proto.RegisterType((*WebMetric)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.WebMetric")
 	proto.RegisterType((*WebMetricHeader)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.WebMetricHeader")
+	proto.RegisterType((*WebMetricRetry)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.WebMetricRetry")
+	proto.RegisterType((*WebMetricTLS)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.WebMetricTLS")
 	proto.RegisterType((*WeightDestination)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.WeightDestination")
 }
 
@@ -3553,591 +4510,705 @@ func init() {
 }
 
 var fileDescriptor_e0e705f843545fab = []byte{
-	// 9336 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x7d, 0x6d, 0x6c, 0x24, 0xd9,
-	0x71, 0x98, 0x9a, 0xc3, 0x21, 0x67, 0x6a, 0xb8, 0x24, 0xf7, 0xed, 0xae, 0x96, 0xc7, 0xbb, 0xdd,
-	0x59, 0xf5, 0x39, 0xca, 0xca, 0x3a, 0x91, 0xd2, 0xea, 0xce, 0x39, 0xe9, 0x94, 0x4b, 0x66, 0xc8,
-	0xdd, 0x5b, 0xee, 0x91, 0xbb, 0xbc, 0x1a, 0xee, 0xad, 0xf5, 0x71, 0xb6, 0x9a, 0x33, 0x8f, 0xc3,
-	0x5e, 0xf6, 0x74, 0xcf, 0x75, 0xf7, 0x70, 0x97, 0xa7, 0x8b, 0x75, 0x92, 0x70, 0x92, 0x92, 0x48,
-	0xb0, 0x62, 0xfb, 0x10, 0x24, 0x31, 0x82, 0x4b, 0xe0, 0xc0, 0xf9, 0xfa, 0x61, 0x18, 0x0a, 0x92,
-	0x1f, 0x06, 0x1c, 0xc4, 0x70, 0x20, 0x23, 0x50, 0x20, 0x23, 0x48, 0xe4, 0x24, 0x30, 0x1d, 0xd1,
-	0xf9, 0x13, 0x23, 0x81, 0xe2, 0x20, 0x81, 0x90, 0xfd, 0x61, 0x04, 0xef, 0xa3, 0x5f, 0xbf, 0xee,
-	0xe9, 0x21, 0x39, 0x9c, 0xe6, 0x9e, 0x92, 0xf8, 0xdf, 0xcc, 0xab, 0x7a, 0x55, 0xd5, 0xef, 0xb3,
-	0x5e, 0xbd, 0xaa, 0x7a, 0xb0, 0xda, 0xb6, 0xc3, 0xed, 0xde, 0xe6, 0x42, 0xd3, 0xeb, 0x2c, 0x5a,
-	0x7e, 0xdb, 0xeb, 0xfa, 0xde, 0x7d, 0xfe, 0xe3, 0x23, 0xbe, 0xe7, 0x38, 0x5e, 0x2f, 0x0c, 0x16,
-	0xbb, 0x3b, 0xed, 0x45, 0xab, 0x6b, 0x07, 0x8b, 0xaa, 0x64, 0xf7, 0x63, 0x96, 0xd3, 0xdd, 0xb6,
-	0x3e, 0xb6, 0xd8, 0xa6, 0x2e, 0xf5, 0xad, 0x90, 0xb6, 0x16, 0xba, 0xbe, 0x17, 0x7a, 0xe4, 0x53,
-	0x31, 0xb5, 0x85, 0x88, 0x1a, 0xff, 0xf1, 0xb3, 0x51, 0xdd, 0x85, 0xee, 0x4e, 0x7b, 0x81, 0x51,
-	0x5b, 0x50, 0x25, 0x11, 0xb5, 0xf9, 0x8f, 0x68, 0xb2, 0xb4, 0xbd, 0xb6, 0xb7, 0xc8, 0x89, 0x6e,
-	0xf6, 0xb6, 0xf8, 0x3f, 0xfe, 0x87, 0xff, 0x12, 0xcc, 0xe6, 0x9f, 0xde, 0x79, 0x3e, 0x58, 0xb0,
-	0x3d, 0x26, 0xdb, 0xe2, 0xa6, 0x15, 0x36, 0xb7, 0x17, 0x77, 0xfb, 0x24, 0x9a, 0x37, 0x35, 0xa4,
-	0xa6, 0xe7, 0xd3, 0x2c, 0x9c, 0x67, 0x63, 0x9c, 0x8e, 0xd5, 0xdc, 0xb6, 0x5d, 0xea, 0xef, 0xc5,
-	0x5f, 0xdd, 0xa1, 0xa1, 0x95, 0x55, 0x6b, 0x71, 0x50, 0x2d, 0xbf, 0xe7, 0x86, 0x76, 0x87, 0xf6,
-	0x55, 0xf8, 0xa9, 0xa3, 0x2a, 0x04, 0xcd, 0x6d, 0xda, 0xb1, 0xfa, 0xea, 0x7d, 0x7c, 0x50, 0xbd,
-	0x5e, 0x68, 0x3b, 0x8b, 0xb6, 0x1b, 0x06, 0xa1, 0x9f, 0xae, 0x64, 0xfe, 0xb0, 0x00, 0xe5, 0xda,
-	0x6a, 0xbd, 0x11, 0x5a, 0x61, 0x2f, 0x20, 0x5f, 0x35, 0x60, 0xca, 0xf1, 0xac, 0x56, 0xdd, 0x72,
-	0x2c, 0xb7, 0x49, 0xfd, 0x39, 0xe3, 0x8a, 0x71, 0xb5, 0x72, 0x6d, 0x75, 0x61, 0x94, 0xfe, 0x5a,
-	0xa8, 0x3d, 0x08, 0x90, 0x06, 0x5e, 0xcf, 0x6f, 0x52, 0xa4, 0x5b, 0xf5, 0xf3, 0xdf, 0xd9, 0xaf,
-	0xbe, 0xef, 0x60, 0xbf, 0x3a, 0xb5, 0xaa, 0x71, 0xc2, 0x04, 0x5f, 0xf2, 0x8e, 0x01, 0x67, 0x9b,
-	0x96, 0x6b, 0xf9, 0x7b, 0x1b, 0x96, 0xdf, 0xa6, 0xe1, 0x4b, 0xbe, 0xd7, 0xeb, 0xce, 0x8d, 0x9d,
-	0x82, 0x34, 0x4f, 0x48, 0x69, 0xce, 0x2e, 0xa5, 0xd9, 0x61, 0xbf, 0x04, 0x5c, 0xae, 0x20, 0xb4,
-	0x36, 0x1d, 0xaa, 0xcb, 0x55, 0x38, 0x4d, 0xb9, 0x1a, 0x69, 0x76, 0xd8, 0x2f, 0x01, 0xf9, 0x10,
-	0x4c, 0xda, 0x6e, 0xdb, 0xa7, 0x41, 0x30, 0x37, 0x7e, 0xc5, 0xb8, 0x5a, 0xae, 0xcf, 0xc8, 0xea,
-	0x93, 0x2b, 0xa2, 0x18, 0x23, 0xb8, 0xf9, 0xeb, 0x05, 0x38, 0x5b, 0x5b, 0xad, 0x6f, 0xf8, 0xd6,
-	0xd6, 0x96, 0xdd, 0x44, 0xaf, 0x17, 0xda, 0x6e, 0x5b, 0x27, 0x60, 0x1c, 0x4e, 0x80, 0x3c, 0x07,
-	0x95, 0x80, 0xfa, 0xbb, 0x76, 0x93, 0xae, 0x7b, 0x7e, 0xc8, 0x3b, 0xa5, 0x58, 0x3f, 0x27, 0xd1,
-	0x2b, 0x8d, 0x18, 0x84, 0x3a, 0x1e, 0xab, 0xe6, 0x7b, 0x5e, 0x28, 0xe1, 0xbc, 0xcd, 0xca, 0x71,
-	0x35, 0x8c, 0x41, 0xa8, 0xe3, 0x91, 0x65, 0x98, 0xb5, 0x5c, 0xd7, 0x0b, 0xad, 0xd0, 0xf6, 0xdc,
-	0x75, 0x9f, 0x6e, 0xd9, 0x0f, 0xe5, 0x27, 0xce, 0xc9, 0xba, 0xb3, 0xb5, 0x14, 0x1c, 0xfb, 0x6a,
-	0x90, 0x6f, 0x19, 0x30, 0x1b, 0x84, 0x76, 0x73, 0xc7, 0x76, 0x69, 0x10, 0x2c, 0x79, 0xee, 0x96,
-	0xdd, 0x9e, 0x2b, 0xf2, 0x6e, 0xbb, 0x3d, 0x5a, 0xb7, 0x35, 0x52, 0x54, 0xeb, 0xe7, 0x99, 0x48,
-	0xe9, 0x52, 0xec, 0xe3, 0x4e, 0x3e, 0x0c, 0x65, 0xd9, 0xa2, 0x34, 0x98, 0x9b, 0xb8, 0x52, 0xb8,
-	0x5a, 0xae, 0x9f, 0x39, 0xd8, 0xaf, 0x96, 0x57, 0xa2, 0x42, 0x8c, 0xe1, 0xe6, 0x32, 0xcc, 0xd5,
-	0x3a, 0x9b, 0x56, 0x10, 0x58, 0x2d, 0xcf, 0x4f, 0x75, 0xdd, 0x55, 0x28, 0x75, 0xac, 0x6e, 0xd7,
-	0x76, 0xdb, 0xac, 0xef, 0x18, 0x9d, 0xa9, 0x83, 0xfd, 0x6a, 0x69, 0x4d, 0x96, 0xa1, 0x82, 0x9a,
-	0xff, 0x7e, 0x0c, 0x2a, 0x35, 0xd7, 0x72, 0xf6, 0x02, 0x3b, 0xc0, 0x9e, 0x4b, 0x3e, 0x0f, 0x25,
-	0xb6, 0x6a, 0xb5, 0xac, 0xd0, 0x92, 0x33, 0xfd, 0xa3, 0x0b, 0x62, 0x11, 0x59, 0xd0, 0x17, 0x91,
-	0xf8, 0xf3, 0x19, 0xf6, 0xc2, 0xee, 0xc7, 0x16, 0xee, 0x6c, 0xde, 0xa7, 0xcd, 0x70, 0x8d, 0x86,
-	0x56, 0x9d, 0xc8, 0x5e, 0x80, 0xb8, 0x0c, 0x15, 0x55, 0xe2, 0xc1, 0x78, 0xd0, 0xa5, 0x4d, 0x39,
-	0x73, 0xd7, 0x46, 0x9c, 0x21, 0xb1, 0xe8, 0x8d, 0x2e, 0x6d, 0xd6, 0xa7, 0x24, 0xeb, 0x71, 0xf6,
-	0x0f, 0x39, 0x23, 0xf2, 0x00, 0x26, 0x02, 0xbe, 0x96, 0xc9, 0x49, 0x79, 0x27, 0x3f, 0x96, 0x9c,
-	0x6c, 0x7d, 0x5a, 0x32, 0x9d, 0x10, 0xff, 0x51, 0xb2, 0x33, 0xff, 0x83, 0x01, 0xe7, 0x34, 0xec,
-	0x9a, 0xdf, 0xee, 0x75, 0xa8, 0x1b, 0x92, 0x2b, 0x30, 0xee, 0x5a, 0x1d, 0x2a, 0x67, 0x95, 0x12,
-	0xf9, 0xb6, 0xd5, 0xa1, 0xc8, 0x21, 0xe4, 0x69, 0x28, 0xee, 0x5a, 0x4e, 0x8f, 0xf2, 0x46, 0x2a,
-	0xd7, 0xcf, 0x48, 0x94, 0xe2, 0xab, 0xac, 0x10, 0x05, 0x8c, 0xbc, 0x09, 0x65, 0xfe, 0xe3, 0x86,
-	0xef, 0x75, 0x72, 0xfa, 0x34, 0x29, 0xe1, 0xab, 0x11, 0x59, 0x31, 0xfc, 0xd4, 0x5f, 0x8c, 0x19,
-	0x9a, 0x7f, 0x60, 0xc0, 0x8c, 0xf6, 0x71, 0xab, 0x76, 0x10, 0x92, 0xcf, 0xf5, 0x0d, 0x9e, 0x85,
-	0xe3, 0x0d, 0x1e, 0x56, 0x9b, 0x0f, 0x9d, 0x59, 0xf9, 0xa5, 0xa5, 0xa8, 0x44, 0x1b, 0x38, 0x2e,
-	0x14, 0xed, 0x90, 0x76, 0x82, 0xb9, 0xb1, 0x2b, 0x85, 0xab, 0x95, 0x6b, 0x2b, 0xb9, 0x75, 0x63,
-	0xdc, 0xbe, 0x2b, 0x8c, 0x3e, 0x0a, 0x36, 0xe6, 0xb7, 0x0b, 0x89, 0xee, 0x5b, 0x8b, 0xe4, 0x78,
-	0xdb, 0x80, 0x09, 0xc7, 0xda, 0xa4, 0x8e, 0x98, 0x5b, 0x95, 0x6b, 0xaf, 0xe5, 0x26, 0x49, 0xc4,
-	0x63, 0x61, 0x95, 0xd3, 0xbf, 0xee, 0x86, 0xfe, 0x5e, 0x3c, 0xbc, 0x44, 0x21, 0x4a, 0xe6, 0xe4,
-	0x6f, 0x18, 0x50, 0x89, 0x57, 0xb5, 0xa8, 0x59, 0x36, 0xf3, 0x17, 0x26, 0x5e, 0x4c, 0xa5, 0x44,
-	0x6a, 0x89, 0xd6, 0x20, 0xa8, 0xcb, 0x32, 0xff, 0x09, 0xa8, 0x68, 0x9f, 0x40, 0x66, 0xa1, 0xb0,
-	0x43, 0xf7, 0xc4, 0x80, 0x47, 0xf6, 0x93, 0x9c, 0x4f, 0x8c, 0x70, 0x39, 0xa4, 0x3f, 0x39, 0xf6,
-	0xbc, 0x31, 0xff, 0x22, 0xcc, 0xa6, 0x19, 0x0e, 0x53, 0xdf, 0xfc, 0xb5, 0x62, 0x62, 0x60, 0xb2,
-	0x85, 0x80, 0x78, 0x30, 0xd9, 0xa1, 0xa1, 0x6f, 0x37, 0xa3, 0x2e, 0x5b, 0x1e, 0xad, 0x95, 0xd6,
-	0x38, 0xb1, 0x78, 0x43, 0x14, 0xff, 0x03, 0x8c, 0xb8, 0x90, 0x6d, 0x18, 0xb7, 0xfc, 0x76, 0xd4,
-	0x27, 0x37, 0xf2, 0x99, 0x96, 0xf1, 0x52, 0x51, 0xf3, 0xdb, 0x01, 0x72, 0x0e, 0x64, 0x11, 0xca,
-	0x21, 0xf5, 0x3b, 0xb6, 0x6b, 0x85, 0x62, 0x07, 0x2d, 0xd5, 0xcf, 0x4a, 0xb4, 0xf2, 0x46, 0x04,
-	0xc0, 0x18, 0x87, 0x38, 0x30, 0xd1, 0xf2, 0xf7, 0xb0, 0xe7, 0xce, 0x8d, 0xe7, 0xd1, 0x14, 0xcb,
-	0x9c, 0x56, 0x3c, 0x48, 0xc5, 0x7f, 0x94, 0x3c, 0xc8, 0xaf, 0x18, 0x70, 0xbe, 0x43, 0xad, 0xa0,
-	0xe7, 0x53, 0xf6, 0x09, 0x48, 0x43, 0xea, 0xb2, 0x8e, 0x9d, 0x2b, 0x72, 0xe6, 0x38, 0x6a, 0x3f,
-	0xf4, 0x53, 0xae, 0x3f, 0x25, 0x45, 0x39, 0x9f, 0x05, 0xc5, 0x4c, 0x69, 0xc8, 0x9b, 0x50, 0x09,
-	0x43, 0xa7, 0x11, 0x32, 0x3d, 0xb8, 0xbd, 0x37, 0x37, 0xc1, 0x17, 0xaf, 0x11, 0x57, 0x98, 0x8d,
-	0x8d, 0xd5, 0x88, 0x60, 0x7d, 0x86, 0xcd, 0x16, 0xad, 0x00, 0x75, 0x76, 0xe6, 0x3f, 0x2b, 0xc2,
-	0xd9, 0xbe, 0x6d, 0x85, 0x3c, 0x0b, 0xc5, 0xee, 0xb6, 0x15, 0x44, 0xfb, 0xc4, 0xe5, 0x68, 0x91,
-	0x5a, 0x67, 0x85, 0x8f, 0xf6, 0xab, 0x67, 0xa2, 0x2a, 0xbc, 0x00, 0x05, 0x32, 0xd3, 0xda, 0x3a,
-	0x34, 0x08, 0xac, 0x76, 0xb4, 0x79, 0x68, 0x83, 0x94, 0x17, 0x63, 0x04, 0x27, 0x5f, 0x33, 0xe0,
-	0x8c, 0x18, 0xb0, 0x48, 0x83, 0x9e, 0x13, 0xb2, 0x0d, 0x92, 0x75, 0xca, 0xad, 0x3c, 0x26, 0x87,
-	0x20, 0x59, 0xbf, 0x20, 0xb9, 0x9f, 0xd1, 0x4b, 0x03, 0x4c, 0xf2, 0x25, 0xf7, 0xa0, 0x1c, 0x84,
-	0x96, 0x1f, 0xd2, 0x56, 0x2d, 0xe4, 0xaa, 0x5c, 0xe5, 0xda, 0x4f, 0x1e, 0x6f, 0xe7, 0xd8, 0xb0,
-	0x3b, 0x54, 0xec, 0x52, 0x8d, 0x88, 0x00, 0xc6, 0xb4, 0xc8, 0x9b, 0x00, 0x7e, 0xcf, 0x6d, 0xf4,
-	0x3a, 0x1d, 0xcb, 0xdf, 0x93, 0xda, 0xdd, 0xcd, 0xd1, 0x3e, 0x0f, 0x15, 0xbd, 0x58, 0xd1, 0x89,
-	0xcb, 0x50, 0xe3, 0x47, 0xbe, 0x64, 0xc0, 0x19, 0x31, 0x0f, 0x22, 0x09, 0x26, 0x72, 0x96, 0xe0,
-	0x2c, 0x6b, 0xda, 0x65, 0x9d, 0x05, 0x26, 0x39, 0x92, 0xd7, 0xa0, 0xd2, 0xf4, 0x3a, 0x5d, 0x87,
-	0x8a, 0xc6, 0x9d, 0x1c, 0xba, 0x71, 0xf9, 0xd0, 0x5d, 0x8a, 0x49, 0xa0, 0x4e, 0xcf, 0xfc, 0xb7,
-	0x49, 0x1d, 0x27, 0x1a, 0xd2, 0xe4, 0xb3, 0xf0, 0x44, 0xd0, 0x6b, 0x36, 0x69, 0x10, 0x6c, 0xf5,
-	0x1c, 0xec, 0xb9, 0x37, 0xed, 0x20, 0xf4, 0xfc, 0xbd, 0x55, 0xbb, 0x63, 0x87, 0x7c, 0x40, 0x17,
-	0xeb, 0x97, 0x0e, 0xf6, 0xab, 0x4f, 0x34, 0x06, 0x21, 0xe1, 0xe0, 0xfa, 0xc4, 0x82, 0x27, 0x7b,
-	0xee, 0x60, 0xf2, 0xe2, 0xf8, 0x51, 0x3d, 0xd8, 0xaf, 0x3e, 0x79, 0x77, 0x30, 0x1a, 0x1e, 0x46,
-	0xc3, 0xfc, 0x23, 0x83, 0x6d, 0x43, 0xe2, 0xbb, 0x36, 0x68, 0xa7, 0xeb, 0xb0, 0xa5, 0xf3, 0xf4,
-	0x95, 0xe3, 0x30, 0xa1, 0x1c, 0x63, 0x3e, 0x7b, 0x79, 0x24, 0xff, 0x20, 0x0d, 0xd9, 0xfc, 0x2f,
-	0x06, 0x9c, 0x4f, 0x23, 0x3f, 0x06, 0x85, 0x2e, 0x48, 0x2a, 0x74, 0xb7, 0xf3, 0xfd, 0xda, 0x01,
-	0x5a, 0xdd, 0xdb, 0xda, 0x80, 0x8d, 0x50, 0x91, 0x6e, 0x91, 0xe7, 0x61, 0x2a, 0x94, 0x7f, 0x6f,
-	0xc7, 0xca, 0xb9, 0x32, 0x4c, 0x6c, 0x68, 0x30, 0x4c, 0x60, 0x92, 0x67, 0x61, 0xaa, 0xe9, 0xf4,
-	0x82, 0x90, 0xfa, 0x8d, 0xa6, 0xd7, 0x15, 0xcb, 0x6e, 0xa9, 0x3e, 0xcb, 0x6a, 0x2d, 0x69, 0xe5,
-	0x98, 0xc0, 0x32, 0xff, 0x6a, 0xb1, 0xbf, 0xcd, 0xff, 0x5f, 0xd7, 0x55, 0x62, 0xd5, 0xa3, 0xf0,
-	0x5e, 0xaa, 0x1e, 0xe3, 0x3f, 0x56, 0xaa, 0xc7, 0x97, 0x0d, 0xa6, 0xc1, 0x89, 0x01, 0x10, 0x48,
-	0xb5, 0xe8, 0x95, 0x7c, 0xa7, 0x02, 0xd2, 0x2d, 0x5d, 0x29, 0x94, 0xbc, 0x30, 0x66, 0x6b, 0xfe,
-	0xfd, 0x71, 0x98, 0xaa, 0xb9, 0xa1, 0x5d, 0xdb, 0xda, 0xb2, 0x5d, 0x3b, 0xdc, 0x23, 0xdf, 0x18,
-	0x83, 0xc5, 0xae, 0x4f, 0xb7, 0xa8, 0xef, 0xd3, 0xd6, 0x72, 0xcf, 0xb7, 0xdd, 0x76, 0xa3, 0xb9,
-	0x4d, 0x5b, 0x3d, 0xc7, 0x76, 0xdb, 0x2b, 0x6d, 0xd7, 0x53, 0xc5, 0xd7, 0x1f, 0xd2, 0x66, 0x8f,
-	0xb7, 0xab, 0x58, 0x21, 0x3a, 0xa3, 0xc9, 0xbe, 0x3e, 0x1c, 0xd3, 0xfa, 0xc7, 0x0f, 0xf6, 0xab,
-	0x8b, 0x43, 0x56, 0xc2, 0x61, 0x3f, 0x8d, 0x7c, 0x7d, 0x0c, 0x16, 0x7c, 0xfa, 0x7a, 0xcf, 0x3e,
-	0x7e, 0x6b, 0x88, 0x25, 0xdc, 0x19, 0x71, 0xab, 0x1f, 0x8a, 0x67, 0xfd, 0xda, 0xc1, 0x7e, 0x75,
-	0xc8, 0x3a, 0x38, 0xe4, 0x77, 0x99, 0xeb, 0x50, 0xa9, 0x75, 0xed, 0xc0, 0x7e, 0x88, 0x5e, 0x2f,
-	0xa4, 0xc7, 0x30, 0x66, 0x54, 0xa1, 0xe8, 0xf7, 0x1c, 0x2a, 0x16, 0x98, 0x72, 0xbd, 0xcc, 0x96,
-	0x64, 0x64, 0x05, 0x28, 0xca, 0xcd, 0x2f, 0xb3, 0xed, 0x87, 0x93, 0x4c, 0x99, 0xb1, 0xee, 0x43,
-	0xd1, 0x67, 0x4c, 0xe4, 0xc8, 0x1a, 0xf5, 0xc4, 0x1f, 0x4b, 0x2d, 0x85, 0x60, 0x3f, 0x51, 0xb0,
-	0x30, 0x7f, 0x6b, 0x0c, 0x2e, 0xd4, 0xba, 0xdd, 0x35, 0x1a, 0x6c, 0xa7, 0xa4, 0xf8, 0x79, 0x03,
-	0xa6, 0x77, 0x6d, 0x3f, 0xec, 0x59, 0x4e, 0x64, 0xa9, 0x14, 0xf2, 0x34, 0x46, 0x95, 0x87, 0x73,
-	0x7b, 0x35, 0x41, 0xba, 0x4e, 0x0e, 0xf6, 0xab, 0xd3, 0xc9, 0x32, 0x4c, 0xb1, 0x27, 0x7f, 0xdd,
-	0x80, 0x59, 0x59, 0x74, 0xdb, 0x6b, 0x51, 0xdd, 0x12, 0x7e, 0x37, 0x4f, 0x99, 0x14, 0x71, 0x61,
-	0xc1, 0x4c, 0x97, 0x62, 0x9f, 0x10, 0xe6, 0x7f, 0x1b, 0x83, 0x8b, 0x03, 0x68, 0x90, 0x5f, 0x35,
-	0xe0, 0xbc, 0x30, 0x9f, 0x6b, 0x20, 0xa4, 0x5b, 0xb2, 0x35, 0x3f, 0x9d, 0xb7, 0xe4, 0xc8, 0xa6,
-	0x38, 0x75, 0x9b, 0xb4, 0x3e, 0xc7, 0x96, 0xe4, 0xa5, 0x0c, 0xd6, 0x98, 0x29, 0x10, 0x97, 0x54,
-	0x18, 0xd4, 0x53, 0x92, 0x8e, 0x3d, 0x16, 0x49, 0x1b, 0x19, 0xac, 0x31, 0x53, 0x20, 0xf3, 0x2f,
-	0xc0, 0x93, 0x87, 0x90, 0x3b, 0x7a, 0x72, 0x9a, 0xaf, 0xa9, 0x51, 0x9f, 0x1c, 0x73, 0xc7, 0x98,
-	0xd7, 0x26, 0x4c, 0xf0, 0xa9, 0x13, 0x4d, 0x6c, 0x60, 0x7b, 0x30, 0x9f, 0x53, 0x01, 0x4a, 0x88,
-	0xf9, 0x5b, 0x06, 0x94, 0x86, 0xb0, 0x7b, 0x56, 0x93, 0x76, 0xcf, 0x72, 0x9f, 0xcd, 0x33, 0xec,
-	0xb7, 0x79, 0xbe, 0x34, 0x5a, 0x6f, 0x1c, 0xc7, 0xd6, 0xf9, 0x43, 0x03, 0xce, 0xf6, 0xd9, 0x46,
-	0xc9, 0x36, 0x9c, 0xef, 0x7a, 0xad, 0x68, 0x3b, 0xbd, 0x69, 0x05, 0xdb, 0x1c, 0x26, 0x3f, 0xef,
-	0x59, 0xd6, 0x93, 0xeb, 0x19, 0xf0, 0x47, 0xfb, 0xd5, 0x39, 0x45, 0x24, 0x85, 0x80, 0x99, 0x14,
-	0x49, 0x17, 0x4a, 0x5b, 0x36, 0x75, 0x5a, 0xf1, 0x10, 0x1c, 0x51, 0x4b, 0xbb, 0x21, 0xa9, 0x89,
-	0x6b, 0x81, 0xe8, 0x1f, 0x2a, 0x2e, 0xe6, 0xff, 0x1c, 0x83, 0xe9, 0x5a, 0x2f, 0xdc, 0x66, 0x3a,
-	0x4a, 0x93, 0x5b, 0xe2, 0x88, 0x0b, 0xc5, 0xc0, 0x6e, 0xef, 0x3e, 0x9b, 0xcf, 0x62, 0xdc, 0x60,
-	0xa4, 0xe4, 0xf5, 0x88, 0x52, 0xd4, 0x79, 0x21, 0x0a, 0x36, 0xc4, 0x87, 0x09, 0xcf, 0xea, 0x85,
-	0xdb, 0xd7, 0xe4, 0x27, 0x8f, 0x68, 0x95, 0xb8, 0xc3, 0x3e, 0xe7, 0x9a, 0xe4, 0xa8, 0x54, 0x46,
-	0x51, 0x8a, 0x92, 0x13, 0xf9, 0x39, 0x28, 0x6f, 0x5a, 0x81, 0xdd, 0x64, 0xa5, 0x72, 0x78, 0x8d,
-	0x78, 0x41, 0x51, 0x8f, 0xc8, 0x49, 0xce, 0x4a, 0x0d, 0x53, 0x00, 0x8c, 0x59, 0x9a, 0x5f, 0x84,
-	0xe9, 0xe4, 0x9d, 0xdf, 0x31, 0xe6, 0xcc, 0x25, 0x28, 0x58, 0xbe, 0x2b, 0x67, 0x4c, 0x45, 0x22,
-	0x14, 0x6a, 0x78, 0x1b, 0x59, 0x39, 0x79, 0x06, 0x4a, 0x5b, 0x3d, 0xc7, 0xe1, 0x67, 0x1a, 0x71,
-	0xc1, 0xa6, 0x8e, 0x64, 0x37, 0x64, 0x39, 0x2a, 0x0c, 0xb3, 0x03, 0x33, 0x29, 0x89, 0x19, 0x81,
-	0x5e, 0x40, 0x7d, 0x4d, 0x0a, 0x45, 0xe0, 0xae, 0x2c, 0x47, 0x85, 0xc1, 0xb0, 0xbb, 0x56, 0x10,
-	0x3c, 0xf0, 0xfc, 0x96, 0x14, 0x49, 0x61, 0xaf, 0xcb, 0x72, 0x54, 0x18, 0xe6, 0xff, 0x1e, 0x87,
-	0x99, 0xba, 0xd3, 0xa3, 0x2f, 0xf9, 0x94, 0x46, 0x66, 0xaf, 0x1a, 0xcc, 0x74, 0x7d, 0xba, 0x6b,
-	0xd3, 0x07, 0x0d, 0xea, 0xd0, 0x66, 0xe8, 0xf9, 0x92, 0xed, 0x45, 0x49, 0x68, 0x66, 0x3d, 0x09,
-	0xc6, 0x34, 0x3e, 0x79, 0x11, 0xa6, 0xad, 0x66, 0x68, 0xef, 0x52, 0x45, 0x41, 0x88, 0xf2, 0x7e,
-	0x49, 0x61, 0xba, 0x96, 0x80, 0x62, 0x0a, 0x9b, 0x7c, 0x0e, 0xe6, 0x82, 0xa6, 0xe5, 0xd0, 0xbb,
-	0x5d, 0xc9, 0x6a, 0x69, 0x9b, 0x36, 0x77, 0xd6, 0x3d, 0xdb, 0x0d, 0xa5, 0x89, 0xf5, 0x8a, 0xa4,
-	0x34, 0xd7, 0x18, 0x80, 0x87, 0x03, 0x29, 0x90, 0xdf, 0x34, 0xe0, 0x52, 0xd7, 0xa7, 0xeb, 0xbe,
-	0xd7, 0xf1, 0xd8, 0xcc, 0xea, 0xb3, 0xfc, 0x49, 0x0b, 0xd8, 0xab, 0x23, 0xaa, 0x8e, 0xa2, 0xa4,
-	0xff, 0xba, 0xea, 0x03, 0x07, 0xfb, 0xd5, 0x4b, 0xeb, 0x87, 0x09, 0x80, 0x87, 0xcb, 0x47, 0xfe,
-	0x85, 0x01, 0x97, 0xbb, 0x5e, 0x10, 0x1e, 0xf2, 0x09, 0xc5, 0x53, 0xfd, 0x04, 0xf3, 0x60, 0xbf,
-	0x7a, 0x79, 0xfd, 0x50, 0x09, 0xf0, 0x08, 0x09, 0xcd, 0x83, 0x0a, 0x9c, 0xd5, 0xc6, 0x9e, 0xb4,
-	0x5b, 0xbd, 0x00, 0x67, 0xa2, 0xc1, 0x10, 0xab, 0x7a, 0xe5, 0xd8, 0x8c, 0x59, 0xd3, 0x81, 0x98,
-	0xc4, 0x65, 0xe3, 0x4e, 0x0d, 0x45, 0x51, 0x3b, 0x35, 0xee, 0xd6, 0x13, 0x50, 0x4c, 0x61, 0x93,
-	0x15, 0x38, 0x27, 0x4b, 0x90, 0x76, 0x1d, 0xbb, 0x69, 0x2d, 0x79, 0x3d, 0x39, 0xe4, 0x8a, 0xf5,
-	0x8b, 0x07, 0xfb, 0xd5, 0x73, 0xeb, 0xfd, 0x60, 0xcc, 0xaa, 0x43, 0x56, 0xe1, 0xbc, 0xd5, 0x0b,
-	0x3d, 0xf5, 0xfd, 0xd7, 0x5d, 0xa6, 0x3d, 0xb4, 0xf8, 0xd0, 0x2a, 0x09, 0x35, 0xa3, 0x96, 0x01,
-	0xc7, 0xcc, 0x5a, 0x64, 0x3d, 0x45, 0xad, 0x41, 0x9b, 0x9e, 0xdb, 0x12, 0xbd, 0x5c, 0x8c, 0x4f,
-	0xbd, 0xb5, 0x0c, 0x1c, 0xcc, 0xac, 0x49, 0x1c, 0x98, 0xee, 0x58, 0x0f, 0xef, 0xba, 0xd6, 0xae,
-	0x65, 0x3b, 0x8c, 0x89, 0x34, 0x8d, 0x0e, 0x36, 0xa8, 0xf5, 0x42, 0xdb, 0x59, 0x10, 0x2e, 0x2b,
-	0x0b, 0x2b, 0x6e, 0x78, 0xc7, 0x6f, 0x84, 0xec, 0x60, 0x22, 0x14, 0xe6, 0xb5, 0x04, 0x2d, 0x4c,
-	0xd1, 0x26, 0x77, 0xe0, 0x02, 0x9f, 0x8e, 0xcb, 0xde, 0x03, 0x77, 0x99, 0x3a, 0xd6, 0x5e, 0xf4,
-	0x01, 0x93, 0xfc, 0x03, 0x9e, 0x38, 0xd8, 0xaf, 0x5e, 0x68, 0x64, 0x21, 0x60, 0x76, 0x3d, 0x62,
-	0xc1, 0x93, 0x49, 0x00, 0xd2, 0x5d, 0x3b, 0xb0, 0x3d, 0x57, 0x58, 0x20, 0x4b, 0xb1, 0x05, 0xb2,
-	0x31, 0x18, 0x0d, 0x0f, 0xa3, 0x41, 0xfe, 0x96, 0x01, 0xe7, 0xb3, 0xa6, 0xe1, 0x5c, 0x39, 0x8f,
-	0x7d, 0x29, 0x35, 0xb5, 0xc4, 0x88, 0xc8, 0x5c, 0x14, 0x32, 0x85, 0x20, 0x6f, 0x19, 0x30, 0x65,
-	0x69, 0x06, 0x83, 0x39, 0xc8, 0x63, 0x93, 0xd6, 0x4d, 0x10, 0xc2, 0x82, 0xa6, 0x97, 0x60, 0x82,
-	0x23, 0xf9, 0xdb, 0x06, 0x5c, 0xc8, 0x9c, 0xe3, 0x73, 0x95, 0xd3, 0x68, 0x21, 0x3e, 0x48, 0xb2,
-	0xd7, 0x9c, 0x6c, 0x31, 0xc8, 0xb7, 0x0c, 0xb5, 0x95, 0x45, 0x77, 0xa9, 0x73, 0x53, 0x5c, 0xb4,
-	0x11, 0xed, 0x3b, 0x9a, 0xd6, 0x18, 0x11, 0xae, 0x9f, 0xd3, 0x76, 0xc6, 0xa8, 0x10, 0xd3, 0xec,
-	0xc9, 0x37, 0x8d, 0x68, 0x6b, 0x54, 0x12, 0x9d, 0x39, 0x2d, 0x89, 0x48, 0xbc, 0xd3, 0x2a, 0x81,
-	0x52, 0xcc, 0xc9, 0xcf, 0xc0, 0xbc, 0xb5, 0xe9, 0xf9, 0x61, 0xe6, 0xe4, 0x9b, 0x9b, 0xe6, 0xd3,
-	0xe8, 0xf2, 0xc1, 0x7e, 0x75, 0xbe, 0x36, 0x10, 0x0b, 0x0f, 0xa1, 0x60, 0xfe, 0xce, 0x04, 0x4c,
-	0x89, 0x83, 0x9f, 0xdc, 0xba, 0x7e, 0xc3, 0x80, 0xa7, 0x9a, 0x3d, 0xdf, 0xa7, 0x6e, 0xd8, 0x08,
-	0x69, 0xb7, 0x7f, 0xe3, 0x32, 0x4e, 0x75, 0xe3, 0xba, 0x72, 0xb0, 0x5f, 0x7d, 0x6a, 0xe9, 0x10,
-	0xfe, 0x78, 0xa8, 0x74, 0xe4, 0x5f, 0x1b, 0x60, 0x4a, 0x84, 0xba, 0xd5, 0xdc, 0x69, 0xfb, 0x5e,
-	0xcf, 0x6d, 0xf5, 0x7f, 0xc4, 0xd8, 0xa9, 0x7e, 0xc4, 0x07, 0x0f, 0xf6, 0xab, 0xe6, 0xd2, 0x91,
-	0x52, 0xe0, 0x31, 0x24, 0x25, 0x2f, 0xc1, 0x59, 0x89, 0x75, 0xfd, 0x61, 0x97, 0xfa, 0x36, 0x3b,
-	0x62, 0x49, 0x3d, 0x35, 0x76, 0xc3, 0x4b, 0x23, 0x60, 0x7f, 0x1d, 0x12, 0xc0, 0xe4, 0x03, 0x6a,
-	0xb7, 0xb7, 0xc3, 0x48, 0x7d, 0x1a, 0xd1, 0xf7, 0x4e, 0x1a, 0x81, 0xee, 0x09, 0x9a, 0xf5, 0xca,
-	0xc1, 0x7e, 0x75, 0x52, 0xfe, 0xc1, 0x88, 0x13, 0xb9, 0x0d, 0xd3, 0xe2, 0x58, 0xbe, 0x6e, 0xbb,
-	0xed, 0x75, 0xcf, 0x15, 0x0e, 0x64, 0xe5, 0xfa, 0x07, 0xa3, 0x0d, 0xbf, 0x91, 0x80, 0x3e, 0xda,
-	0xaf, 0x4e, 0x45, 0xbf, 0x37, 0xf6, 0xba, 0x14, 0x53, 0xb5, 0xc9, 0xdf, 0x34, 0x80, 0x04, 0x21,
-	0xed, 0xae, 0x3b, 0xbd, 0xb6, 0x2d, 0x9b, 0x48, 0xba, 0x82, 0xe5, 0xe0, 0x95, 0x96, 0xa4, 0x5b,
-	0x9f, 0x97, 0x42, 0x92, 0x46, 0x1f, 0x47, 0xcc, 0x90, 0xc2, 0xfc, 0xf6, 0x24, 0x40, 0x34, 0x97,
-	0x68, 0x97, 0x7c, 0x18, 0xca, 0x01, 0x0d, 0x45, 0x93, 0xc8, 0x1b, 0x3d, 0x71, 0x0f, 0x1b, 0x15,
-	0x62, 0x0c, 0x27, 0x3b, 0x50, 0xec, 0x5a, 0xbd, 0x80, 0xe6, 0x73, 0x96, 0x93, 0x23, 0x73, 0x9d,
-	0x51, 0x14, 0x46, 0x02, 0xfe, 0x13, 0x05, 0x0f, 0xf2, 0x15, 0x03, 0x80, 0x26, 0x47, 0xd3, 0xc8,
-	0xc6, 0x3a, 0xc9, 0x32, 0x1e, 0x70, 0xac, 0x0d, 0xea, 0xd3, 0x07, 0xfb, 0x55, 0xd0, 0xc6, 0xa5,
-	0xc6, 0x96, 0x3c, 0x80, 0x92, 0x15, 0x6d, 0x48, 0xe3, 0xa7, 0xb1, 0x21, 0xf1, 0xb3, 0xbb, 0x9a,
-	0x51, 0x8a, 0x19, 0xf9, 0xba, 0x01, 0xd3, 0x01, 0x0d, 0x65, 0x57, 0xb1, 0x65, 0x51, 0x6a, 0xe3,
-	0x23, 0xce, 0x88, 0x46, 0x82, 0xa6, 0x58, 0xde, 0x93, 0x65, 0x98, 0xe2, 0x1b, 0x89, 0x72, 0x93,
-	0x5a, 0x2d, 0xea, 0x73, 0xd3, 0x90, 0x54, 0xf3, 0x46, 0x17, 0x45, 0xa3, 0xa9, 0x44, 0xd1, 0xca,
-	0x30, 0xc5, 0x37, 0x12, 0x65, 0xcd, 0xf6, 0x7d, 0x4f, 0x8a, 0x52, 0xca, 0x49, 0x14, 0x8d, 0xa6,
-	0x12, 0x45, 0x2b, 0xc3, 0x14, 0x5f, 0xe2, 0xc0, 0x44, 0x97, 0x4f, 0x2d, 0xa9, 0xca, 0x8d, 0xe8,
-	0x0e, 0x10, 0x4d, 0x53, 0xda, 0x15, 0x26, 0x38, 0xf1, 0x1f, 0x25, 0x0f, 0xf3, 0xdf, 0x4c, 0xc3,
-	0x74, 0x34, 0x6d, 0xe3, 0x43, 0x8e, 0xb0, 0x7b, 0x0e, 0x38, 0xe4, 0x2c, 0xe9, 0x40, 0x4c, 0xe2,
-	0xb2, 0xca, 0x62, 0xd5, 0x4a, 0x9e, 0x71, 0x54, 0xe5, 0x86, 0x0e, 0xc4, 0x24, 0x2e, 0xe9, 0x40,
-	0x91, 0xad, 0x2c, 0x91, 0xa7, 0xc9, 0x88, 0x5f, 0x1e, 0xaf, 0x46, 0x9a, 0x0d, 0x89, 0x91, 0x47,
-	0xc1, 0x85, 0x9b, 0xee, 0xc3, 0x84, 0x35, 0x5f, 0x4e, 0xc5, 0x7c, 0x56, 0x83, 0xe4, 0x45, 0x81,
-	0xe8, 0xfb, 0x64, 0x19, 0xa6, 0xd8, 0x67, 0x9c, 0x7b, 0x8a, 0xa7, 0x78, 0xee, 0xf9, 0x0c, 0x94,
-	0x3a, 0xd6, 0xc3, 0x46, 0xcf, 0x6f, 0x9f, 0xfc, 0x7c, 0x25, 0x3d, 0x87, 0x05, 0x15, 0x54, 0xf4,
-	0xc8, 0x97, 0x0c, 0x6d, 0x81, 0x13, 0x6e, 0x25, 0xf7, 0xf2, 0x5d, 0xe0, 0x94, 0xda, 0x30, 0x70,
-	0xa9, 0xeb, 0x3b, 0x85, 0x94, 0x1e, 0xfb, 0x29, 0x84, 0x69, 0xd4, 0x62, 0x82, 0x28, 0x8d, 0xba,
-	0x7c, 0xaa, 0x1a, 0xf5, 0x52, 0x82, 0x19, 0xa6, 0x98, 0x73, 0x79, 0xc4, 0x9c, 0x53, 0xf2, 0xc0,
-	0xa9, 0xca, 0xd3, 0x48, 0x30, 0xc3, 0x14, 0xf3, 0xc1, 0x47, 0xef, 0xca, 0xe9, 0x1c, 0xbd, 0xa7,
-	0x72, 0x38, 0x7a, 0x1f, 0x7e, 0x2a, 0x39, 0x33, 0xea, 0xa9, 0x84, 0xdc, 0x02, 0xd2, 0xda, 0x73,
-	0xad, 0x8e, 0xdd, 0x94, 0x8b, 0x25, 0xdf, 0xa4, 0xa7, 0xb9, 0x69, 0x46, 0x69, 0x65, 0xcb, 0x7d,
-	0x18, 0x98, 0x51, 0x8b, 0x84, 0x50, 0xea, 0x46, 0xca, 0xe7, 0x4c, 0x1e, 0xa3, 0x3f, 0x52, 0x46,
-	0x85, 0xb7, 0x10, 0x37, 0xdc, 0xca, 0x12, 0x54, 0x9c, 0xc8, 0x2a, 0x9c, 0xef, 0xd8, 0xee, 0xba,
-	0xd7, 0x0a, 0xd6, 0xa9, 0x2f, 0x0d, 0x4f, 0x0d, 0x1a, 0xce, 0xcd, 0xf2, 0xb6, 0xe1, 0xc6, 0x84,
-	0xb5, 0x0c, 0x38, 0x66, 0xd6, 0x22, 0xbf, 0x66, 0xc0, 0x9c, 0x2f, 0xfe, 0xae, 0xfb, 0x1e, 0x0f,
-	0x70, 0xd8, 0xd8, 0xf6, 0x69, 0xb0, 0xed, 0x39, 0xad, 0xb9, 0xb3, 0xb9, 0x9c, 0x65, 0x06, 0x50,
-	0xaf, 0x3f, 0x75, 0xb0, 0x5f, 0x9d, 0x1b, 0x04, 0xc5, 0x81, 0x52, 0x99, 0xff, 0xcb, 0x80, 0xd9,
-	0x25, 0xc7, 0xeb, 0xb5, 0xee, 0x59, 0x61, 0x73, 0x5b, 0xf8, 0xd4, 0x90, 0x17, 0xa1, 0x64, 0xbb,
-	0x21, 0xf5, 0x77, 0x2d, 0x47, 0x6e, 0xa9, 0x66, 0x64, 0xfc, 0x5e, 0x91, 0xe5, 0x8f, 0xf6, 0xab,
-	0xd3, 0xcb, 0x3d, 0x9f, 0x5f, 0xa9, 0x88, 0x05, 0x16, 0x55, 0x1d, 0xf2, 0xae, 0x01, 0x67, 0x85,
-	0x57, 0xce, 0xb2, 0x15, 0x5a, 0xaf, 0xf4, 0xa8, 0x6f, 0xd3, 0xc8, 0x2f, 0x67, 0xc4, 0xb5, 0x35,
-	0x2d, 0x6b, 0xc4, 0x60, 0x2f, 0x3e, 0x66, 0xad, 0xa5, 0x39, 0x63, 0xbf, 0x30, 0xe6, 0x2f, 0x16,
-	0xe0, 0x89, 0x81, 0xb4, 0xc8, 0x3c, 0x8c, 0xd9, 0x2d, 0xf9, 0xe9, 0x20, 0xe9, 0x8e, 0xad, 0xb4,
-	0x70, 0xcc, 0x6e, 0x91, 0x05, 0xae, 0x94, 0xb3, 0x56, 0x8c, 0xbc, 0x23, 0xca, 0x4a, 0x7f, 0x96,
-	0xa5, 0xa8, 0x61, 0x90, 0x2a, 0x14, 0xb9, 0xa3, 0xbb, 0x3c, 0x0d, 0x72, 0x35, 0x9f, 0xfb, 0x94,
-	0xa3, 0x28, 0x27, 0x5f, 0x36, 0x00, 0x84, 0x80, 0xec, 0x88, 0x22, 0x37, 0x76, 0xcc, 0xb7, 0x99,
-	0x18, 0x65, 0x21, 0x65, 0xfc, 0x1f, 0x35, 0xae, 0x64, 0x03, 0x26, 0x98, 0xc6, 0xef, 0xb5, 0x4e,
-	0xbc, 0x8f, 0x0b, 0x9d, 0x8d, 0xd3, 0x40, 0x49, 0x8b, 0xb5, 0x95, 0x4f, 0xc3, 0x9e, 0xef, 0xb2,
-	0xa6, 0xe5, 0x3b, 0x77, 0x49, 0x48, 0x81, 0xaa, 0x14, 0x35, 0x0c, 0xf3, 0x9f, 0x8e, 0xc1, 0xf9,
-	0x2c, 0xd1, 0xd9, 0x06, 0x39, 0x21, 0xa4, 0x95, 0x86, 0x8d, 0x9f, 0xce, 0xbf, 0x7d, 0xa4, 0x83,
-	0x99, 0xba, 0x53, 0x93, 0x9e, 0xbe, 0x92, 0x2f, 0xf9, 0x69, 0xd5, 0x42, 0x63, 0x27, 0x6c, 0x21,
-	0x45, 0x39, 0xd5, 0x4a, 0x57, 0x60, 0x3c, 0x60, 0x3d, 0x5f, 0x48, 0xde, 0x8d, 0xf1, 0x3e, 0xe2,
-	0x10, 0x86, 0xd1, 0x73, 0xed, 0x50, 0x46, 0x87, 0x29, 0x8c, 0xbb, 0xae, 0x1d, 0x22, 0x87, 0x98,
-	0xef, 0x8c, 0xc1, 0xfc, 0xe0, 0x8f, 0x22, 0xef, 0x18, 0x00, 0x2d, 0x76, 0x9e, 0x0b, 0x78, 0x88,
-	0x85, 0x70, 0xc8, 0xb3, 0x4e, 0xab, 0x0d, 0x97, 0x23, 0x4e, 0xb1, 0x97, 0xa8, 0x2a, 0x0a, 0x50,
-	0x13, 0x84, 0x5c, 0x8b, 0x86, 0x3e, 0xbf, 0xd7, 0x13, 0x93, 0x49, 0xd5, 0x59, 0x53, 0x10, 0xd4,
-	0xb0, 0xd8, 0x81, 0xdd, 0xb5, 0x3a, 0x34, 0xe8, 0x5a, 0x2a, 0xd6, 0x8e, 0x1f, 0xd8, 0x6f, 0x47,
-	0x85, 0x18, 0xc3, 0x4d, 0x07, 0x9e, 0x3e, 0x86, 0x9c, 0x39, 0x85, 0x32, 0x99, 0x7f, 0x6c, 0xc0,
-	0x45, 0xe9, 0x2b, 0xf9, 0xff, 0x8d, 0xd3, 0xed, 0x8f, 0x0c, 0x78, 0x72, 0xc0, 0x37, 0x3f, 0x06,
-	0xdf, 0xdb, 0x37, 0x92, 0xbe, 0xb7, 0x77, 0x47, 0x1d, 0xd2, 0x99, 0xdf, 0x31, 0xc0, 0x05, 0xf7,
-	0x9d, 0x22, 0x9c, 0x61, 0xcb, 0x56, 0xcb, 0x6b, 0xe7, 0xb4, 0x71, 0x3e, 0x0d, 0xc5, 0xd7, 0xd9,
-	0x06, 0x94, 0x1e, 0x64, 0x7c, 0x57, 0x42, 0x01, 0x23, 0x5f, 0x31, 0x60, 0xf2, 0x75, 0xb9, 0xa7,
-	0x8a, 0xe3, 0xe7, 0x88, 0x8b, 0x61, 0xe2, 0x1b, 0x16, 0xe4, 0x0e, 0x29, 0x22, 0xa4, 0x94, 0xb7,
-	0x6d, 0xb4, 0x95, 0x46, 0x9c, 0xc9, 0x87, 0x60, 0x72, 0xcb, 0xf3, 0x3b, 0x3d, 0xc7, 0x4a, 0x87,
-	0xe5, 0xde, 0x10, 0xc5, 0x18, 0xc1, 0xd9, 0x24, 0xb7, 0xba, 0xf6, 0xab, 0xd4, 0x0f, 0x44, 0xc0,
-	0x4c, 0x62, 0x92, 0xd7, 0x14, 0x04, 0x35, 0x2c, 0x5e, 0xa7, 0xdd, 0xf6, 0x69, 0xdb, 0x0a, 0x3d,
-	0x9f, 0xef, 0x1c, 0x7a, 0x1d, 0x05, 0x41, 0x0d, 0x8b, 0x3c, 0x84, 0x72, 0x40, 0x9b, 0x3e, 0x0d,
-	0x91, 0x6e, 0xc9, 0x93, 0xdc, 0x4b, 0xa3, 0x1a, 0x45, 0x24, 0xb9, 0xd8, 0xdf, 0x41, 0x15, 0x61,
-	0xcc, 0x8c, 0xac, 0xc3, 0xb4, 0x4f, 0x5f, 0xef, 0xd1, 0x20, 0xdc, 0xb0, 0x3b, 0xd4, 0xeb, 0x89,
-	0x9b, 0xb3, 0x72, 0xfd, 0x6a, 0x64, 0x3f, 0xc5, 0x04, 0x34, 0x63, 0x0c, 0xa4, 0xea, 0xcf, 0x7f,
-	0x12, 0xa6, 0xf4, 0x8e, 0x18, 0x2a, 0x72, 0xec, 0x53, 0x20, 0x5d, 0x88, 0x53, 0xcb, 0xab, 0x71,
-	0x9c, 0xe5, 0xd5, 0xfc, 0x77, 0x63, 0xa0, 0x99, 0x02, 0x1f, 0xc3, 0xb2, 0xe5, 0x26, 0x96, 0xad,
-	0x11, 0xcd, 0x58, 0x9a, 0x61, 0x73, 0x50, 0x1c, 0xed, 0x6e, 0x2a, 0x8e, 0xf6, 0x76, 0x6e, 0x1c,
-	0x0f, 0x0f, 0xa3, 0xfd, 0xbe, 0x01, 0x4f, 0xc6, 0xc8, 0xfd, 0x57, 0x08, 0x47, 0xef, 0x41, 0xcf,
-	0x41, 0xc5, 0x8a, 0xab, 0xc9, 0x45, 0x42, 0x0b, 0x62, 0x54, 0x20, 0xd4, 0xf1, 0xe2, 0x00, 0xac,
-	0xc2, 0x09, 0x03, 0xb0, 0xc6, 0x0f, 0x0f, 0xc0, 0x32, 0xff, 0xfb, 0x18, 0x5c, 0xea, 0xff, 0x32,
-	0x3d, 0x2a, 0xe1, 0xe8, 0x6f, 0x4b, 0xc7, 0x2d, 0x8c, 0x9d, 0x38, 0x6e, 0xa1, 0x70, 0x9c, 0xb8,
-	0x05, 0x15, 0x2d, 0x30, 0x7e, 0xea, 0xd1, 0x02, 0x0d, 0xb8, 0x10, 0xb9, 0x26, 0xdf, 0xf0, 0x7c,
-	0x19, 0x81, 0x14, 0xad, 0x84, 0xa5, 0xfa, 0x25, 0x59, 0xe5, 0x02, 0x66, 0x21, 0x61, 0x76, 0x5d,
-	0xf3, 0xfb, 0x05, 0x38, 0x17, 0x37, 0xf9, 0x92, 0xe7, 0xb6, 0x6c, 0xee, 0xdd, 0xf6, 0x02, 0x8c,
-	0x87, 0x7b, 0xdd, 0xa8, 0xa1, 0xff, 0x6c, 0x24, 0xce, 0xc6, 0x5e, 0x97, 0xf5, 0xf4, 0xc5, 0x8c,
-	0x2a, 0xfc, 0x02, 0x87, 0x57, 0x22, 0xab, 0x6a, 0x66, 0x88, 0xd6, 0x7f, 0x36, 0x39, 0x92, 0x1f,
-	0xed, 0x57, 0x33, 0x72, 0x89, 0x2c, 0x28, 0x4a, 0xc9, 0xf1, 0x4e, 0xee, 0xc3, 0xb4, 0x63, 0x05,
-	0xe1, 0xdd, 0x6e, 0xcb, 0x0a, 0x29, 0x5b, 0xd7, 0xe4, 0x7c, 0x1b, 0x26, 0x68, 0x4b, 0x79, 0x9c,
-	0xac, 0x26, 0x28, 0x61, 0x8a, 0x32, 0xd9, 0x05, 0xc2, 0x4a, 0x36, 0x7c, 0xcb, 0x0d, 0xc4, 0x57,
-	0x31, 0x7e, 0xc3, 0x47, 0xe0, 0x29, 0xab, 0xc5, 0x6a, 0x1f, 0x35, 0xcc, 0xe0, 0x40, 0x3e, 0x08,
-	0x13, 0x3e, 0xb5, 0x02, 0xb5, 0xad, 0xa9, 0xb9, 0x8f, 0xbc, 0x14, 0x25, 0x54, 0x9f, 0x4c, 0x13,
-	0x47, 0x4c, 0xa6, 0xdf, 0x37, 0x60, 0x3a, 0xee, 0xa6, 0xc7, 0xa0, 0x42, 0x75, 0x92, 0x2a, 0xd4,
-	0xcd, 0xbc, 0x96, 0xc3, 0x01, 0x5a, 0xd3, 0x1f, 0x4d, 0xea, 0xdf, 0xc7, 0x43, 0x85, 0xbe, 0xa0,
-	0x47, 0x8e, 0x18, 0x79, 0xc4, 0x6e, 0x26, 0xb4, 0xd6, 0x43, 0x43, 0x46, 0x98, 0xce, 0xd6, 0x92,
-	0x7b, 0xb1, 0x1c, 0xf6, 0x4a, 0x67, 0x8b, 0xf6, 0xe8, 0x2c, 0x9d, 0x2d, 0xaa, 0x43, 0xee, 0xc2,
-	0xc5, 0xae, 0x34, 0xab, 0x2c, 0x53, 0xab, 0xe5, 0xd8, 0x2e, 0x8d, 0x2c, 0x6c, 0xc2, 0xe1, 0xe9,
-	0xc9, 0x83, 0xfd, 0xea, 0xc5, 0xf5, 0x6c, 0x14, 0x1c, 0x54, 0x37, 0x19, 0x0f, 0x3d, 0x7e, 0x8c,
-	0x78, 0xe8, 0xbf, 0xac, 0xec, 0xd8, 0x2a, 0xfc, 0xe6, 0xb3, 0x79, 0x75, 0x65, 0x56, 0x20, 0x8e,
-	0x1a, 0x52, 0x35, 0xc9, 0x14, 0x15, 0xfb, 0xc1, 0xc6, 0xd2, 0x89, 0x13, 0x1a, 0x4b, 0xe3, 0x88,
-	0xab, 0xc9, 0xf7, 0x32, 0xe2, 0xaa, 0xf4, 0x63, 0x15, 0x71, 0xf5, 0xae, 0x01, 0xe7, 0xac, 0xfe,
-	0x3c, 0x07, 0xf9, 0xd8, 0xed, 0x33, 0x12, 0x28, 0xd4, 0x9f, 0x94, 0x42, 0x66, 0xa5, 0x93, 0xc0,
-	0x2c, 0x51, 0xcc, 0xb7, 0x8b, 0x30, 0x9b, 0x56, 0x90, 0x4e, 0x3f, 0x20, 0xfc, 0x17, 0x0c, 0x98,
-	0x8d, 0x26, 0xb8, 0x72, 0x3e, 0x10, 0x47, 0xa5, 0xd5, 0x9c, 0xd6, 0x15, 0xa1, 0xea, 0xa9, 0x3c,
-	0x3d, 0x1b, 0x29, 0x6e, 0xd8, 0xc7, 0x9f, 0xbc, 0x06, 0x15, 0x75, 0xa1, 0x75, 0xa2, 0xe8, 0x70,
-	0x1e, 0xc0, 0x5c, 0x8b, 0x49, 0xa0, 0x4e, 0x8f, 0xbc, 0x6d, 0x00, 0x34, 0xa3, 0x9d, 0x38, 0xa7,
-	0xf8, 0xbb, 0x0c, 0x6d, 0x21, 0xd6, 0xe5, 0x55, 0x51, 0x80, 0x1a, 0x63, 0xf2, 0x8b, 0xfc, 0x2a,
-	0x4b, 0x8d, 0x84, 0xc8, 0xe9, 0xe3, 0xd3, 0x79, 0x2f, 0x45, 0xb1, 0x1b, 0x8f, 0xd2, 0x11, 0x35,
-	0x50, 0x80, 0x09, 0x21, 0xcc, 0x17, 0x40, 0x45, 0x07, 0xb0, 0x95, 0x95, 0xc7, 0x07, 0xac, 0x5b,
-	0xe1, 0xb6, 0x1c, 0x82, 0x6a, 0x65, 0xbd, 0x11, 0x01, 0x30, 0xc6, 0x31, 0x3f, 0x0f, 0xd3, 0x2f,
-	0xf9, 0x56, 0x77, 0xdb, 0xe6, 0x57, 0x46, 0xec, 0x9c, 0xff, 0x21, 0x98, 0xb4, 0x5a, 0xad, 0xac,
-	0x94, 0x52, 0x35, 0x51, 0x8c, 0x11, 0xfc, 0x58, 0x47, 0x7a, 0xf3, 0x5f, 0x1a, 0x40, 0xe2, 0x4b,
-	0x7e, 0xdb, 0x6d, 0xaf, 0x59, 0x61, 0x73, 0x9b, 0x1d, 0xdf, 0xb6, 0x79, 0x69, 0xd6, 0xf1, 0xed,
-	0xa6, 0x82, 0xa0, 0x86, 0x45, 0xde, 0x84, 0x8a, 0xf8, 0xf7, 0xaa, 0x3a, 0x1c, 0x8e, 0x1e, 0xe4,
-	0xc0, 0xf7, 0x3c, 0x2e, 0x93, 0x18, 0x85, 0x37, 0x63, 0x0e, 0xa8, 0xb3, 0x63, 0x4d, 0xb5, 0xe2,
-	0x6e, 0x39, 0xbd, 0x87, 0xad, 0xcd, 0xb8, 0xa9, 0xba, 0xbe, 0xb7, 0x65, 0x3b, 0x34, 0xdd, 0x54,
-	0xeb, 0xa2, 0x18, 0x23, 0xf8, 0xf1, 0x9a, 0xea, 0x9d, 0x31, 0x38, 0xbf, 0x12, 0x84, 0xb6, 0xb7,
-	0x4c, 0x83, 0x90, 0xed, 0x7c, 0x6c, 0x7d, 0xec, 0x39, 0xc7, 0x09, 0xf4, 0x59, 0x86, 0x59, 0xe9,
-	0x02, 0xd0, 0xdb, 0x0c, 0x68, 0xa8, 0x1d, 0x33, 0xd4, 0x3c, 0x5e, 0x4a, 0xc1, 0xb1, 0xaf, 0x06,
-	0xa3, 0x22, 0x7d, 0x01, 0x62, 0x2a, 0x85, 0x24, 0x95, 0x46, 0x0a, 0x8e, 0x7d, 0x35, 0xd8, 0x0e,
-	0x69, 0xb5, 0xc4, 0x9c, 0xb1, 0x9c, 0xb8, 0x5c, 0x9c, 0x47, 0xca, 0x62, 0x87, 0xac, 0x65, 0x21,
-	0x60, 0x76, 0x3d, 0xf3, 0x7b, 0x05, 0x38, 0xc7, 0xdb, 0x25, 0x15, 0xf5, 0xf7, 0xcd, 0x41, 0x51,
-	0x7f, 0x23, 0xae, 0x0d, 0x9c, 0xd7, 0x09, 0x62, 0xfe, 0xfe, 0x9a, 0x01, 0x33, 0xad, 0x64, 0xd7,
-	0xe5, 0x63, 0xb0, 0xcc, 0x1a, 0x14, 0xc2, 0x9b, 0x34, 0x55, 0x88, 0x69, 0xfe, 0xe4, 0x97, 0x0c,
-	0x98, 0x49, 0x8a, 0x19, 0x6d, 0x17, 0xa7, 0xd0, 0x48, 0x2a, 0xfc, 0x23, 0x59, 0x1e, 0x60, 0x5a,
-	0x04, 0xf3, 0xbb, 0x63, 0xb2, 0x4b, 0x4f, 0x23, 0xa4, 0x8d, 0x3c, 0x80, 0x72, 0xe8, 0x04, 0xa2,
-	0x50, 0x7e, 0xed, 0x88, 0xa7, 0xe0, 0x8d, 0xd5, 0x86, 0x70, 0x1e, 0x8a, 0x15, 0x55, 0x59, 0xc2,
-	0x14, 0xee, 0x88, 0x17, 0x67, 0xdc, 0xec, 0x4a, 0xc6, 0xb9, 0x1c, 0xbf, 0x37, 0x96, 0xd6, 0xd3,
-	0x8c, 0x65, 0x09, 0x63, 0x1c, 0xf1, 0x32, 0xff, 0x91, 0x01, 0xe5, 0x5b, 0x5e, 0xb4, 0x30, 0xfd,
-	0x4c, 0x0e, 0x86, 0x2d, 0xa5, 0x03, 0x2b, 0x2d, 0x28, 0x3e, 0x56, 0xbd, 0x98, 0x30, 0x6b, 0x3d,
-	0xa5, 0xd1, 0x5e, 0xe0, 0xa9, 0x3a, 0x19, 0xa9, 0x5b, 0xde, 0xe6, 0x40, 0xbb, 0xfa, 0xf7, 0x8a,
-	0x70, 0xe6, 0x65, 0x6b, 0x8f, 0xba, 0xa1, 0x35, 0xfc, 0xae, 0xf3, 0x1c, 0x54, 0xac, 0x2e, 0xbf,
-	0xf2, 0xd5, 0xce, 0x35, 0xb1, 0xa5, 0x28, 0x06, 0xa1, 0x8e, 0x17, 0xaf, 0x90, 0x22, 0x66, 0x2a,
-	0x6b, 0x6d, 0x5b, 0x4a, 0xc1, 0xb1, 0xaf, 0x06, 0xb9, 0x05, 0x44, 0xe6, 0x64, 0xa8, 0x35, 0x9b,
-	0x5e, 0xcf, 0x15, 0x6b, 0xa4, 0x30, 0x22, 0xa9, 0x03, 0xf6, 0x5a, 0x1f, 0x06, 0x66, 0xd4, 0x22,
-	0x9f, 0x83, 0xb9, 0x26, 0xa7, 0x2c, 0x8f, 0x5b, 0x3a, 0x45, 0x71, 0xe4, 0x56, 0x21, 0x4c, 0x4b,
-	0x03, 0xf0, 0x70, 0x20, 0x05, 0x26, 0x69, 0x10, 0x7a, 0xbe, 0xd5, 0xa6, 0x3a, 0xdd, 0x89, 0xa4,
-	0xa4, 0x8d, 0x3e, 0x0c, 0xcc, 0xa8, 0x45, 0xbe, 0x08, 0xe5, 0x50, 0x5d, 0xf6, 0x4f, 0xe6, 0x61,
-	0x59, 0x94, 0xbd, 0x1f, 0x5f, 0xf2, 0xc7, 0xc3, 0x5b, 0xdd, 0xec, 0xc7, 0x3c, 0x89, 0x0f, 0x13,
-	0x41, 0xd3, 0xeb, 0xd2, 0x40, 0x1e, 0x53, 0x6e, 0xe5, 0xc2, 0x9d, 0x5b, 0xcb, 0x34, 0x9b, 0x26,
-	0xe7, 0x80, 0x92, 0x13, 0x79, 0x06, 0x4a, 0x8e, 0xe7, 0xed, 0x6c, 0x5a, 0xcd, 0x1d, 0x7e, 0xec,
-	0x28, 0x69, 0x96, 0x06, 0x59, 0x8e, 0x0a, 0xc3, 0xfc, 0xed, 0x31, 0x98, 0xd2, 0xc9, 0x1e, 0x63,
-	0x25, 0xfb, 0x8a, 0x01, 0x53, 0x4d, 0xcf, 0x0d, 0x7d, 0xcf, 0x89, 0xb3, 0x92, 0x8c, 0xae, 0xd0,
-	0x30, 0x52, 0xcb, 0x34, 0xb4, 0x6c, 0x27, 0x56, 0x1f, 0x97, 0x34, 0x36, 0x98, 0x60, 0x4a, 0xbe,
-	0x61, 0xc0, 0x4c, 0xec, 0x12, 0x1b, 0x9b, 0x19, 0x73, 0x15, 0x44, 0x6d, 0x0c, 0xd7, 0x93, 0x9c,
-	0x30, 0xcd, 0xda, 0xdc, 0x84, 0xd9, 0xf4, 0xd8, 0x60, 0x4d, 0xd9, 0xb5, 0xe4, 0xca, 0x50, 0x88,
-	0x9b, 0x72, 0xdd, 0x0a, 0x02, 0xe4, 0x10, 0xd6, 0x57, 0x1d, 0xcb, 0x6f, 0xdb, 0xae, 0xe5, 0xf0,
-	0x56, 0x2c, 0x68, 0xcb, 0x97, 0x2c, 0x47, 0x85, 0x61, 0x7e, 0x14, 0xa6, 0xd6, 0x2c, 0xb7, 0x4d,
-	0x5b, 0x72, 0xd5, 0x3e, 0x3a, 0x04, 0xfb, 0x0f, 0xc7, 0xa1, 0xa2, 0x9d, 0x5e, 0x4f, 0xff, 0x98,
-	0x97, 0xc8, 0xb6, 0x55, 0xc8, 0x31, 0xdb, 0xd6, 0x67, 0x00, 0xb6, 0x6c, 0xd7, 0x0e, 0xb6, 0x4f,
-	0x98, 0xc7, 0x8b, 0xbb, 0x38, 0xdc, 0x50, 0x14, 0x50, 0xa3, 0x16, 0xdf, 0x23, 0x17, 0x0f, 0x49,
-	0x89, 0xf9, 0xb6, 0xa1, 0x6d, 0x4e, 0x13, 0x79, 0xf8, 0xcd, 0x68, 0x1d, 0xb3, 0x10, 0x6d, 0x56,
-	0xe2, 0x8a, 0xef, 0xb0, 0x3d, 0x6c, 0x03, 0x4a, 0x3e, 0x0d, 0x7a, 0x1d, 0x7a, 0xa2, 0x8c, 0x5b,
-	0xdc, 0xe9, 0x0a, 0x65, 0x7d, 0x54, 0x94, 0xe6, 0x5f, 0x80, 0x33, 0x09, 0x11, 0x86, 0xba, 0xdc,
-	0xf2, 0x20, 0xd3, 0x44, 0x72, 0x92, 0xab, 0x2e, 0xd6, 0x17, 0x8e, 0x96, 0x69, 0x4b, 0xf5, 0x85,
-	0x70, 0xad, 0x13, 0x30, 0xf3, 0x4f, 0x26, 0x41, 0xba, 0x82, 0x1c, 0x63, 0xb9, 0xd2, 0x2f, 0x80,
-	0xc7, 0x4e, 0x70, 0x01, 0x7c, 0x0b, 0xa6, 0x6c, 0xd7, 0x0e, 0x6d, 0xcb, 0xe1, 0xe6, 0x2f, 0xb9,
-	0xf9, 0x46, 0x61, 0x18, 0x53, 0x2b, 0x1a, 0x2c, 0x83, 0x4e, 0xa2, 0x2e, 0x79, 0x05, 0x8a, 0x7c,
-	0x77, 0x92, 0x03, 0x78, 0x78, 0x7f, 0x15, 0xee, 0xaa, 0x24, 0x62, 0x33, 0x05, 0x25, 0x7e, 0xf6,
-	0x11, 0xa9, 0xc6, 0xd4, 0xe9, 0x5f, 0x8e, 0xe3, 0xf8, 0xec, 0x93, 0x82, 0x63, 0x5f, 0x0d, 0x46,
-	0x65, 0xcb, 0xb2, 0x9d, 0x9e, 0x4f, 0x63, 0x2a, 0x13, 0x49, 0x2a, 0x37, 0x52, 0x70, 0xec, 0xab,
-	0x41, 0xb6, 0x60, 0x4a, 0x96, 0x09, 0x87, 0xc9, 0xc9, 0x13, 0x7e, 0x25, 0xbf, 0x28, 0xba, 0xa1,
-	0x51, 0xc2, 0x04, 0x5d, 0xd2, 0x83, 0xb3, 0xb6, 0xdb, 0xf4, 0xdc, 0xa6, 0xd3, 0x0b, 0xec, 0x5d,
-	0x1a, 0x07, 0x46, 0x9e, 0x84, 0xd9, 0x85, 0x83, 0xfd, 0xea, 0xd9, 0x95, 0x34, 0x39, 0xec, 0xe7,
-	0x40, 0xbe, 0x64, 0xc0, 0x85, 0xa6, 0xe7, 0x06, 0x3c, 0x5d, 0xcd, 0x2e, 0xbd, 0xee, 0xfb, 0x9e,
-	0x2f, 0x78, 0x97, 0x4f, 0xc8, 0x9b, 0x9f, 0x29, 0x97, 0xb2, 0x48, 0x62, 0x36, 0x27, 0xf2, 0x06,
-	0x94, 0xba, 0xbe, 0xb7, 0x6b, 0xb7, 0xa8, 0x2f, 0x9d, 0x6f, 0x57, 0xf3, 0xc8, 0xe1, 0xb5, 0x2e,
-	0x69, 0x6a, 0x21, 0xf5, 0xb2, 0x04, 0x15, 0x3f, 0xf2, 0x35, 0x03, 0x2e, 0x6a, 0x52, 0xc9, 0x61,
-	0x25, 0x5a, 0xa0, 0x72, 0xc2, 0x16, 0xe0, 0x96, 0xf8, 0xa5, 0x6c, 0xa2, 0x38, 0x88, 0x9b, 0xf9,
-	0x27, 0x15, 0x98, 0x4e, 0x0a, 0x4e, 0x7e, 0x0e, 0xa0, 0xeb, 0x7b, 0x1d, 0x1a, 0x6e, 0x53, 0x15,
-	0x6a, 0x77, 0x7b, 0xd4, 0x7c, 0x51, 0x11, 0xbd, 0xc8, 0x0f, 0x8d, 0x2d, 0x5c, 0x71, 0x29, 0x6a,
-	0x1c, 0x89, 0x0f, 0x93, 0x3b, 0x42, 0x01, 0x90, 0xfa, 0xd0, 0xcb, 0xb9, 0xe8, 0x7a, 0x92, 0x33,
-	0x8f, 0x11, 0x93, 0x45, 0x18, 0x31, 0x22, 0x9b, 0x50, 0x78, 0x40, 0x37, 0xf3, 0x49, 0x56, 0x72,
-	0x8f, 0xca, 0x53, 0x58, 0x7d, 0xf2, 0x60, 0xbf, 0x5a, 0xb8, 0x47, 0x37, 0x91, 0x11, 0x67, 0xdf,
-	0xd5, 0x12, 0xce, 0x28, 0x72, 0xd1, 0x7a, 0x39, 0x47, 0xcf, 0x16, 0xf1, 0x5d, 0xb2, 0x08, 0x23,
-	0x46, 0xe4, 0x0d, 0x28, 0x3f, 0xb0, 0x76, 0xe9, 0x96, 0xef, 0xb9, 0xa1, 0x74, 0x7e, 0x1c, 0x31,
-	0xc0, 0xe9, 0x5e, 0x44, 0x4e, 0xf2, 0xe5, 0x8a, 0x86, 0x2a, 0xc4, 0x98, 0x1d, 0xd9, 0x85, 0x92,
-	0x4b, 0x1f, 0x20, 0x75, 0xec, 0x66, 0x3e, 0x01, 0x45, 0xb7, 0x25, 0x35, 0xc9, 0x99, 0xef, 0xc0,
-	0x51, 0x19, 0x2a, 0x5e, 0xac, 0x2f, 0xef, 0x7b, 0x9b, 0xf9, 0xf8, 0xc8, 0xa8, 0x13, 0xb5, 0xe8,
-	0xcb, 0x5b, 0xde, 0x26, 0x32, 0xe2, 0x6c, 0x8e, 0x34, 0x95, 0xe7, 0x9d, 0x5c, 0x30, 0x6f, 0xe7,
-	0xeb, 0x71, 0x28, 0xe6, 0x48, 0x5c, 0x8a, 0x1a, 0x47, 0xd6, 0xb6, 0x6d, 0x69, 0xb5, 0x95, 0x4b,
-	0xe6, 0x88, 0x6d, 0x9b, 0xb4, 0x01, 0x8b, 0xb6, 0x8d, 0xca, 0x50, 0xf1, 0x62, 0x7c, 0x6d, 0x69,
-	0x02, 0xcd, 0x67, 0xd1, 0x4c, 0x1a, 0x54, 0x05, 0xdf, 0xa8, 0x0c, 0x15, 0x2f, 0xd6, 0xde, 0xc1,
-	0xce, 0xde, 0x03, 0xcb, 0xd9, 0xb1, 0xdd, 0xb6, 0x5c, 0x22, 0x47, 0x0d, 0xb5, 0xdc, 0xd9, 0xbb,
-	0x27, 0xe8, 0xe9, 0xed, 0x1d, 0x97, 0xa2, 0xc6, 0x91, 0xfc, 0xb2, 0xa1, 0xc2, 0xc1, 0xa6, 0xf2,
-	0xf0, 0x4a, 0x4b, 0x2e, 0xb9, 0x32, 0x3a, 0x4c, 0xa8, 0xac, 0x3f, 0xa9, 0x1c, 0x69, 0x79, 0xe1,
-	0x5f, 0xf9, 0x83, 0xea, 0x1c, 0x75, 0x9b, 0x5e, 0xcb, 0x76, 0xdb, 0x8b, 0xf7, 0x03, 0xcf, 0x5d,
-	0x40, 0xeb, 0x41, 0x74, 0x5a, 0x90, 0x32, 0xcd, 0x7f, 0x02, 0x2a, 0x1a, 0x89, 0xa3, 0x54, 0xce,
-	0x29, 0x5d, 0xe5, 0xfc, 0xd1, 0x04, 0x4c, 0xe9, 0x69, 0x7f, 0x8f, 0xa1, 0x07, 0xaa, 0xb3, 0xcf,
-	0xd8, 0x30, 0x67, 0x1f, 0x76, 0xd8, 0xd5, 0x6e, 0xfa, 0x22, 0xb3, 0xdc, 0x4a, 0x6e, 0xaa, 0x7f,
-	0x7c, 0xd8, 0xd5, 0x0a, 0x03, 0x4c, 0x30, 0x1d, 0xc2, 0xf1, 0x87, 0x29, 0xd0, 0x42, 0xc5, 0x2c,
-	0x26, 0x15, 0xe8, 0x84, 0xd2, 0x78, 0x0d, 0x20, 0xce, 0x4f, 0x2b, 0x6f, 0x80, 0x95, 0x66, 0xae,
-	0xe5, 0xcd, 0xd5, 0xb0, 0xc8, 0x07, 0x61, 0x82, 0x29, 0x61, 0xb4, 0x25, 0x33, 0x5b, 0x28, 0xfb,
-	0xc3, 0x0d, 0x5e, 0x8a, 0x12, 0x4a, 0x9e, 0x67, 0xfa, 0x72, 0xac, 0x3a, 0xc9, 0x84, 0x15, 0xe7,
-	0x63, 0x7d, 0x39, 0x86, 0x61, 0x02, 0x93, 0x89, 0x4e, 0x99, 0xa6, 0xc3, 0xd7, 0x06, 0x4d, 0x74,
-	0xae, 0xfe, 0xa0, 0x80, 0x71, 0x7b, 0x58, 0x4a, 0x33, 0xe2, 0x73, 0xba, 0xa8, 0xd9, 0xc3, 0x52,
-	0x70, 0xec, 0xab, 0xc1, 0x3e, 0x46, 0x5e, 0x5e, 0x57, 0x84, 0x07, 0xfc, 0x80, 0x6b, 0xe7, 0xaf,
-	0xea, 0xa7, 0xbe, 0x1c, 0xe7, 0x90, 0x18, 0xb5, 0x43, 0x1c, 0xfb, 0x6e, 0x01, 0xe9, 0x57, 0x86,
-	0x64, 0xbc, 0x90, 0x32, 0x8b, 0xf5, 0xeb, 0x51, 0x98, 0x51, 0x6b, 0xb4, 0xc3, 0xde, 0xd7, 0x0c,
-	0x98, 0x4e, 0x6e, 0x69, 0x79, 0xdf, 0x27, 0x91, 0x3f, 0x03, 0x93, 0xa1, 0xf4, 0xd9, 0x2c, 0x70,
-	0xa3, 0x08, 0xd7, 0x12, 0xa4, 0x1b, 0x26, 0x46, 0x30, 0xf3, 0xef, 0x4d, 0xc0, 0xb9, 0xdb, 0x6d,
-	0xdb, 0x4d, 0xa7, 0x76, 0xcc, 0x7a, 0xc3, 0xc5, 0x18, 0xfa, 0x0d, 0x17, 0x15, 0x8b, 0x2a, 0x5f,
-	0x48, 0xc9, 0x8e, 0x45, 0x8d, 0x9e, 0xab, 0x49, 0xe2, 0x92, 0xdf, 0x37, 0xe0, 0xa9, 0xf8, 0x4e,
-	0x48, 0x96, 0x6a, 0x4f, 0x0f, 0xc8, 0x55, 0x24, 0x18, 0x51, 0xb3, 0xe8, 0xff, 0xf8, 0x85, 0xda,
-	0x21, 0x5c, 0xc5, 0x28, 0xfb, 0x09, 0xf9, 0x05, 0x4f, 0x1d, 0x86, 0x8a, 0x87, 0x8a, 0x4f, 0xfe,
-	0x3c, 0xcc, 0x24, 0x3e, 0x58, 0x5d, 0x92, 0xf1, 0xcb, 0x9d, 0x46, 0x12, 0x84, 0x69, 0x5c, 0xf2,
-	0x5d, 0x03, 0xe6, 0x84, 0x89, 0x3a, 0xa3, 0x69, 0xc4, 0x35, 0xb9, 0x97, 0x7f, 0xd3, 0x2c, 0x0d,
-	0xe0, 0x28, 0x9a, 0x25, 0xb6, 0x59, 0x0f, 0x40, 0xc3, 0x81, 0x22, 0xcf, 0xdf, 0x81, 0x0f, 0x1c,
-	0xd9, 0xee, 0x43, 0x3d, 0x54, 0xf1, 0x32, 0x5c, 0x3a, 0x54, 0xda, 0xa1, 0x66, 0xec, 0x77, 0x0c,
-	0x98, 0xd2, 0x53, 0xd4, 0x91, 0x67, 0xa0, 0x14, 0x7a, 0x3b, 0xd4, 0xbd, 0xeb, 0x3b, 0xe9, 0xb4,
-	0x6b, 0x1b, 0xbc, 0x1c, 0x57, 0x51, 0x61, 0x30, 0xec, 0xa6, 0x63, 0x53, 0x37, 0x5c, 0xe9, 0x4b,
-	0xbb, 0xb6, 0x24, 0xca, 0x97, 0x51, 0x61, 0xb0, 0xd5, 0x5f, 0xfc, 0x16, 0x4e, 0xd9, 0xd2, 0x5a,
-	0x12, 0x1b, 0x74, 0x35, 0x18, 0x26, 0x30, 0x89, 0xa9, 0x6c, 0xe5, 0xe3, 0xf1, 0x05, 0x59, 0xd2,
-	0xb6, 0x6d, 0x7e, 0xdb, 0x80, 0xb2, 0xb8, 0xeb, 0x41, 0xba, 0x95, 0x72, 0x62, 0x4f, 0xd9, 0x97,
-	0x6a, 0xeb, 0x2b, 0x59, 0x4e, 0xec, 0x57, 0x60, 0x7c, 0xc7, 0x76, 0xa3, 0x2f, 0x51, 0x7a, 0xc2,
-	0xcb, 0xb6, 0xdb, 0x42, 0x0e, 0x51, 0x9a, 0x44, 0x61, 0xa0, 0x26, 0xb1, 0x08, 0x65, 0xe5, 0x12,
-	0x25, 0xf7, 0xe3, 0xd8, 0x17, 0x3d, 0x02, 0x60, 0x8c, 0x63, 0xfe, 0x8a, 0x01, 0xd3, 0x3c, 0x8d,
-	0x44, 0x6c, 0x2a, 0x79, 0x4e, 0x79, 0x29, 0x0a, 0xb9, 0x2f, 0x25, 0xbd, 0x14, 0x1f, 0xed, 0x57,
-	0x2b, 0x22, 0xf1, 0x44, 0xd2, 0x69, 0xf1, 0xb3, 0xd2, 0xbe, 0xca, 0x7d, 0x29, 0xc7, 0x86, 0x36,
-	0xff, 0xc5, 0x62, 0x46, 0x44, 0x30, 0xa6, 0x67, 0xbe, 0x09, 0x53, 0x7a, 0x84, 0x26, 0x79, 0x0e,
-	0x2a, 0x5d, 0xdb, 0x6d, 0x27, 0x23, 0xf9, 0xd5, 0x8d, 0xd5, 0x7a, 0x0c, 0x42, 0x1d, 0x8f, 0x57,
-	0xf3, 0xe2, 0x6a, 0xa9, 0x8b, 0xae, 0x75, 0x4f, 0xaf, 0x16, 0xff, 0x31, 0x5d, 0x80, 0x38, 0xdd,
-	0xc0, 0xb1, 0xec, 0x7a, 0x13, 0xe2, 0x12, 0x49, 0x68, 0x87, 0x3c, 0x75, 0xcc, 0x84, 0x18, 0xe1,
-	0x8f, 0xf6, 0x0f, 0xd3, 0x3e, 0x45, 0x2d, 0xfe, 0x06, 0x4f, 0x46, 0xe4, 0x71, 0xee, 0x6f, 0xf0,
-	0x64, 0xf0, 0x78, 0xef, 0xde, 0xe0, 0xc9, 0x12, 0xe6, 0xff, 0xae, 0x37, 0x78, 0x3e, 0x0d, 0xc3,
-	0xa6, 0xe4, 0x66, 0xca, 0xde, 0x03, 0x3d, 0x97, 0x8c, 0x6a, 0x71, 0x99, 0x4c, 0x46, 0x42, 0xcd,
-	0xdf, 0x19, 0x87, 0xd9, 0xb4, 0xcd, 0x27, 0x6f, 0xbf, 0x22, 0xf2, 0x0d, 0x03, 0xa6, 0xad, 0x44,
-	0xfa, 0xd3, 0x9c, 0x1e, 0xf4, 0x4b, 0xd0, 0xd4, 0xf2, 0x51, 0x26, 0xca, 0x31, 0xc5, 0x5b, 0xd7,
-	0xb5, 0xc6, 0x07, 0xeb, 0x5a, 0x6c, 0x13, 0xb0, 0xb9, 0x1e, 0xe9, 0x53, 0xe9, 0x23, 0x3f, 0x1b,
-	0x1b, 0xd1, 0x45, 0x39, 0x2a, 0x0c, 0xf2, 0x10, 0x26, 0x85, 0x07, 0x52, 0xe4, 0x6a, 0xb6, 0x96,
-	0x93, 0x6d, 0x4a, 0x38, 0x39, 0xc5, 0x5d, 0x20, 0xfe, 0x07, 0x18, 0xb1, 0x63, 0xfa, 0x3a, 0xf8,
-	0x96, 0xdb, 0xa6, 0xbc, 0xcd, 0xa5, 0x35, 0xe5, 0xd5, 0xbc, 0xcc, 0x80, 0xa8, 0x28, 0xd7, 0xfc,
-	0x76, 0x20, 0xc3, 0x66, 0x55, 0x19, 0x6a, 0x9c, 0xcd, 0x5f, 0x30, 0x60, 0x6e, 0x50, 0x45, 0x36,
-	0x50, 0xf8, 0xaa, 0x2b, 0x47, 0x94, 0x96, 0x60, 0xc4, 0xf2, 0x43, 0x14, 0x30, 0x72, 0x09, 0x0a,
-	0x54, 0x6d, 0x54, 0x2a, 0xf9, 0xea, 0x75, 0xb7, 0x85, 0xac, 0x9c, 0x5c, 0x83, 0xf1, 0x20, 0xa4,
-	0xdd, 0x54, 0x00, 0xc9, 0x38, 0x5b, 0x3c, 0x33, 0xae, 0x21, 0x38, 0xae, 0xf9, 0x3a, 0x0c, 0x8c,
-	0x47, 0x27, 0x1f, 0x4d, 0x44, 0x29, 0x3c, 0x95, 0x8a, 0x52, 0x98, 0x52, 0x15, 0xe2, 0xd0, 0x84,
-	0x44, 0xf8, 0x65, 0x71, 0x40, 0xf8, 0xe5, 0x47, 0x61, 0xc8, 0xa4, 0xf1, 0xe6, 0x75, 0x20, 0xe8,
-	0x39, 0xce, 0xa6, 0xd5, 0xdc, 0xb9, 0x67, 0xbb, 0x2d, 0xef, 0x01, 0xdf, 0x8b, 0x16, 0xa1, 0xec,
-	0xcb, 0x44, 0x0a, 0x81, 0x9c, 0xc6, 0x6a, 0x33, 0x8b, 0x32, 0x2c, 0x04, 0x18, 0xe3, 0x98, 0xdf,
-	0x1d, 0x83, 0x49, 0x99, 0xf5, 0xe3, 0x31, 0x04, 0x4c, 0xed, 0x24, 0x3c, 0x4b, 0x56, 0x72, 0x49,
-	0x56, 0x32, 0x30, 0x5a, 0x2a, 0x48, 0x45, 0x4b, 0xbd, 0x9c, 0x0f, 0xbb, 0xc3, 0x43, 0xa5, 0x7e,
-	0xb3, 0x08, 0x33, 0xa9, 0x2c, 0x2a, 0xa9, 0xf7, 0x25, 0x8c, 0xf7, 0xe4, 0x7d, 0x09, 0x12, 0x24,
-	0xde, 0x18, 0xc9, 0xcf, 0xc5, 0xfa, 0x4f, 0x9f, 0x1b, 0x19, 0xd6, 0xf9, 0xfd, 0x97, 0x07, 0x38,
-	0xbf, 0x17, 0x4f, 0xcb, 0xf9, 0xfd, 0xe2, 0x50, 0x8e, 0xef, 0xff, 0xd9, 0x80, 0x27, 0x06, 0xe6,
-	0x01, 0xe2, 0x19, 0x35, 0xfd, 0x24, 0x54, 0xae, 0x15, 0x39, 0xe7, 0x56, 0x53, 0x3e, 0x25, 0xe9,
-	0x24, 0x88, 0x69, 0xf6, 0xe4, 0x59, 0x98, 0xe2, 0x5b, 0x01, 0x5b, 0x35, 0xd9, 0x52, 0x2f, 0xd6,
-	0x59, 0x7e, 0x39, 0xda, 0xd0, 0xca, 0x31, 0x81, 0x65, 0xbe, 0x6b, 0xc0, 0xdc, 0xa0, 0xfc, 0x8a,
-	0xc7, 0x50, 0xab, 0xff, 0x5c, 0x2a, 0xe0, 0xac, 0xda, 0x17, 0x70, 0x96, 0x32, 0x94, 0x46, 0xb1,
-	0x65, 0x9a, 0x8d, 0xb2, 0x70, 0x44, 0x3c, 0xd5, 0xef, 0x16, 0x60, 0x56, 0x8a, 0x18, 0x9f, 0x88,
-	0x9e, 0x4f, 0x6c, 0x40, 0x3f, 0x91, 0xda, 0x80, 0xce, 0xa7, 0xf1, 0xff, 0x34, 0x46, 0xee, 0xc7,
-	0x2b, 0x46, 0xee, 0xdd, 0x71, 0xb8, 0x20, 0xfb, 0x28, 0xd6, 0x3d, 0x78, 0x83, 0x3a, 0x30, 0xeb,
-	0xab, 0x2d, 0x46, 0xba, 0x06, 0x19, 0x43, 0x7f, 0x22, 0x7f, 0x26, 0x04, 0x53, 0x74, 0xb0, 0x8f,
-	0x32, 0x79, 0x08, 0xe7, 0x3b, 0x96, 0xdb, 0xb3, 0x1c, 0x7e, 0x7c, 0x8e, 0x39, 0x0e, 0x7f, 0x58,
-	0x16, 0xa9, 0x86, 0x32, 0x68, 0x61, 0x26, 0x07, 0xd2, 0x81, 0x6a, 0xe8, 0x85, 0x96, 0xa3, 0x55,
-	0x51, 0x2d, 0xa1, 0x45, 0x9f, 0x15, 0xea, 0x4f, 0x1f, 0xec, 0x57, 0xab, 0x1b, 0x87, 0xa3, 0xe2,
-	0x51, 0xb4, 0x4e, 0xd5, 0x23, 0x6a, 0x03, 0x66, 0x9b, 0x2a, 0xb0, 0x55, 0x4b, 0xbb, 0x5e, 0xae,
-	0x5f, 0x15, 0x06, 0xf6, 0x24, 0xec, 0x51, 0x46, 0x19, 0xf6, 0x51, 0x30, 0xff, 0x63, 0x51, 0x0d,
-	0x91, 0x64, 0xb2, 0x4b, 0xf2, 0xf5, 0x0c, 0x45, 0xe2, 0x5e, 0xce, 0x59, 0x35, 0x55, 0xe6, 0x88,
-	0xd3, 0x8d, 0x3d, 0xfc, 0x25, 0x3d, 0xe6, 0x4f, 0x28, 0x07, 0x5b, 0xa7, 0x90, 0x1f, 0x74, 0xd8,
-	0xf0, 0xbf, 0xc7, 0xfb, 0x34, 0xeb, 0xbb, 0x8f, 0x5b, 0x13, 0x18, 0x3a, 0x0c, 0x2e, 0xf7, 0x78,
-	0x48, 0xf3, 0xab, 0x05, 0xb8, 0x7a, 0xdc, 0xae, 0xfa, 0x31, 0x0c, 0xbe, 0x0f, 0x12, 0xc1, 0xf7,
-	0x8f, 0x49, 0x8d, 0x3e, 0x95, 0x38, 0xfc, 0xbf, 0x33, 0xae, 0xf4, 0xbc, 0xfe, 0xd9, 0x7f, 0x2c,
-	0xc3, 0xe2, 0x24, 0x3b, 0x66, 0x45, 0x2f, 0xe2, 0xc4, 0xba, 0xc8, 0x64, 0x43, 0x14, 0x3f, 0xda,
-	0xaf, 0x9e, 0x8d, 0x53, 0xcc, 0xc9, 0x42, 0x8c, 0x2a, 0x91, 0xab, 0x50, 0x92, 0xb9, 0xdd, 0xa2,
-	0x70, 0x63, 0xe9, 0x75, 0x29, 0xca, 0x50, 0x41, 0xc9, 0x17, 0xb5, 0x73, 0xe9, 0xf8, 0x69, 0x65,
-	0x52, 0x3c, 0xec, 0x56, 0xf1, 0x35, 0x28, 0x05, 0xd1, 0x3b, 0x26, 0x62, 0x6e, 0x7e, 0xfc, 0x98,
-	0x51, 0xec, 0xd6, 0x26, 0x75, 0xa2, 0x47, 0x4d, 0xc4, 0xf7, 0xa9, 0x27, 0x4f, 0x14, 0x49, 0x62,
-	0x2a, 0xc3, 0x9b, 0x98, 0x54, 0xd0, 0x6f, 0x74, 0x23, 0x21, 0x4c, 0x06, 0xd2, 0x52, 0x3c, 0x99,
-	0x87, 0xba, 0xad, 0xc2, 0x3e, 0x65, 0x6c, 0x0f, 0xb7, 0x67, 0x45, 0x06, 0xe7, 0x88, 0x95, 0xf9,
-	0x7d, 0x03, 0x2a, 0x72, 0x8c, 0x3c, 0x86, 0x70, 0xfe, 0xfb, 0xc9, 0x70, 0xfe, 0xeb, 0xb9, 0xec,
-	0x07, 0x03, 0x62, 0xf9, 0xef, 0xc3, 0x94, 0x9e, 0xc3, 0x9a, 0x7c, 0x46, 0xdb, 0xcf, 0x8c, 0x51,
-	0xf2, 0xb4, 0x46, 0x3b, 0x5e, 0xbc, 0xd7, 0x99, 0xff, 0xb8, 0xac, 0x5a, 0x91, 0x1b, 0x69, 0xf4,
-	0x91, 0x6f, 0x1c, 0x3a, 0xf2, 0xf5, 0x81, 0x37, 0x96, 0xff, 0xc0, 0x7b, 0x05, 0x4a, 0xd1, 0x92,
-	0x28, 0xb5, 0xf7, 0xa7, 0xf5, 0x60, 0x1f, 0x76, 0x04, 0x60, 0xc4, 0xb4, 0xe9, 0xc2, 0x8d, 0x2d,
-	0xf1, 0x35, 0x58, 0xb4, 0x54, 0x2b, 0x32, 0xe4, 0x0d, 0xa8, 0x3c, 0xf0, 0xfc, 0x1d, 0xc7, 0xb3,
-	0xf8, 0x5b, 0x59, 0x90, 0x87, 0x9f, 0x96, 0xba, 0xca, 0x12, 0x21, 0x9c, 0xf7, 0x62, 0xfa, 0xa8,
-	0x33, 0x23, 0x35, 0x98, 0xe9, 0xd8, 0x2e, 0x52, 0xab, 0xa5, 0x76, 0xa9, 0x71, 0xf1, 0x70, 0x4b,
-	0x74, 0x96, 0x5c, 0x4b, 0x82, 0x31, 0x8d, 0xcf, 0xcd, 0xce, 0x7e, 0xc2, 0xac, 0x26, 0x5f, 0x67,
-	0x58, 0x1f, 0x7d, 0x30, 0x26, 0x4d, 0x75, 0x22, 0xe4, 0x30, 0x59, 0x8e, 0x29, 0xde, 0xe4, 0x0b,
-	0x50, 0x0a, 0xa2, 0x17, 0xd1, 0x8b, 0x39, 0x9e, 0xb2, 0xd5, 0xab, 0xe8, 0xaa, 0x2b, 0xd5, 0xb3,
-	0xe8, 0x8a, 0x21, 0x59, 0x85, 0xf3, 0x91, 0x9d, 0x30, 0xf1, 0xb8, 0xf3, 0x44, 0x9c, 0x61, 0x14,
-	0x33, 0xe0, 0x98, 0x59, 0x8b, 0x9d, 0xa5, 0x78, 0x6e, 0x78, 0xe1, 0x17, 0xa3, 0xb9, 0x92, 0xf0,
-	0xf9, 0xd7, 0x42, 0x09, 0x3d, 0x2c, 0x29, 0x45, 0x69, 0x84, 0xa4, 0x14, 0x0d, 0xb8, 0x90, 0x06,
-	0xf1, 0xd4, 0xb1, 0x3c, 0x5b, 0xad, 0xb6, 0x85, 0xae, 0x67, 0x21, 0x61, 0x76, 0x5d, 0x72, 0x0f,
-	0xca, 0x3e, 0xe5, 0x56, 0x85, 0x5a, 0xe4, 0xdc, 0x3c, 0x74, 0x18, 0x07, 0x46, 0x04, 0x30, 0xa6,
-	0xc5, 0xfa, 0xdd, 0x4a, 0x3e, 0xa5, 0x92, 0x9f, 0xa6, 0xa1, 0xfa, 0x7e, 0x40, 0x4a, 0x67, 0xf3,
-	0x5f, 0xcd, 0xc2, 0x99, 0x84, 0xb1, 0x93, 0x3c, 0x0d, 0x45, 0x9e, 0x4b, 0x97, 0xaf, 0x56, 0xa5,
-	0x78, 0x45, 0x15, 0x8d, 0x23, 0x60, 0xe4, 0xe7, 0x0d, 0x98, 0xe9, 0x26, 0x6e, 0x6f, 0xa3, 0x85,
-	0x7c, 0xc4, 0x2b, 0x9b, 0xe4, 0x95, 0xb0, 0xf6, 0x08, 0x59, 0x92, 0x19, 0xa6, 0xb9, 0xb3, 0xf5,
-	0x40, 0xc6, 0x42, 0x39, 0xd4, 0xe7, 0xd8, 0x52, 0xc9, 0x53, 0x24, 0x96, 0x92, 0x60, 0x4c, 0xe3,
-	0xb3, 0x1e, 0xe6, 0x5f, 0x37, 0xca, 0xb3, 0xf8, 0xb5, 0x88, 0x00, 0xc6, 0xb4, 0xc8, 0x8b, 0x30,
-	0x2d, 0x5f, 0xd0, 0x58, 0xf7, 0x5a, 0x37, 0xad, 0x60, 0x5b, 0x1e, 0x1c, 0x95, 0x49, 0x64, 0x29,
-	0x01, 0xc5, 0x14, 0x36, 0xff, 0xb6, 0xf8, 0x99, 0x12, 0x4e, 0x60, 0x22, 0xf9, 0x46, 0xdb, 0x52,
-	0x12, 0x8c, 0x69, 0x7c, 0xf2, 0x8c, 0xb6, 0x0d, 0x09, 0x5f, 0x35, 0xb5, 0x1a, 0x64, 0x6c, 0x45,
-	0x35, 0x98, 0xe9, 0x71, 0x8b, 0x4c, 0x2b, 0x02, 0xca, 0xf9, 0xa8, 0x18, 0xde, 0x4d, 0x82, 0x31,
-	0x8d, 0x4f, 0x5e, 0x80, 0x33, 0x3e, 0x5b, 0x6c, 0x15, 0x01, 0xe1, 0xc0, 0xa6, 0x7c, 0x85, 0x50,
-	0x07, 0x62, 0x12, 0x97, 0xbc, 0x04, 0x67, 0xe3, 0x2c, 0xeb, 0x11, 0x01, 0xe1, 0xd1, 0xa6, 0xf2,
-	0xe7, 0xd6, 0xd2, 0x08, 0xd8, 0x5f, 0x87, 0xfc, 0x45, 0x98, 0xd5, 0x5a, 0x62, 0xc5, 0x6d, 0xd1,
-	0x87, 0x32, 0x13, 0x36, 0xb7, 0x9d, 0x2c, 0xa5, 0x60, 0xd8, 0x87, 0x4d, 0x3e, 0x09, 0xd3, 0x4d,
-	0xcf, 0x71, 0xf8, 0x1a, 0x27, 0xde, 0x07, 0x13, 0x29, 0xaf, 0x45, 0x72, 0xf0, 0x04, 0x04, 0x53,
-	0x98, 0xe4, 0x16, 0x10, 0x6f, 0x93, 0xa9, 0x57, 0xb4, 0xf5, 0x12, 0x75, 0xa9, 0xd4, 0x38, 0xce,
-	0x24, 0xe3, 0x36, 0xef, 0xf4, 0x61, 0x60, 0x46, 0x2d, 0x9e, 0x7e, 0x57, 0x4b, 0x9c, 0x31, 0x9d,
-	0xc7, 0x1b, 0x25, 0x69, 0xfb, 0xe1, 0x91, 0x59, 0x33, 0x7c, 0x98, 0x10, 0x0e, 0x3f, 0xf9, 0xe4,
-	0xbe, 0xd6, 0x9f, 0x0a, 0x8a, 0xf7, 0x08, 0x51, 0x8a, 0x92, 0x13, 0x7f, 0x24, 0x32, 0x7a, 0x37,
-	0x8e, 0x27, 0xbc, 0x1e, 0xfd, 0x91, 0xc8, 0xe4, 0x13, 0x88, 0xda, 0x23, 0x91, 0x11, 0x00, 0x63,
-	0x96, 0xe4, 0x83, 0x50, 0xb9, 0xb9, 0x5e, 0x53, 0xa3, 0xf0, 0x2c, 0xef, 0xfd, 0x71, 0x56, 0x05,
-	0x75, 0x00, 0x9b, 0x61, 0x4a, 0x7d, 0x23, 0x49, 0x9f, 0xa0, 0x0c, 0x6d, 0x8c, 0x61, 0x73, 0x0f,
-	0x30, 0x6c, 0xcc, 0x9d, 0x4b, 0x61, 0xcb, 0x72, 0x54, 0x18, 0xe4, 0x35, 0xa8, 0xc8, 0xfd, 0x82,
-	0xaf, 0x4d, 0xe7, 0x4f, 0x96, 0x94, 0x05, 0x63, 0x12, 0xa8, 0xd3, 0xe3, 0xde, 0x29, 0xfc, 0x39,
-	0x2d, 0x7a, 0xa3, 0xe7, 0x38, 0x73, 0x17, 0xf8, 0xba, 0x19, 0x7b, 0xa7, 0xc4, 0x20, 0xd4, 0xf1,
-	0xc8, 0xc7, 0x23, 0xef, 0xe1, 0xf7, 0x27, 0xdc, 0x75, 0x94, 0xf7, 0xb0, 0x52, 0xba, 0x07, 0x04,
-	0x4e, 0x5e, 0x3c, 0xc2, 0x6d, 0x77, 0x13, 0xe6, 0x23, 0x8d, 0xaf, 0x7f, 0x92, 0xcc, 0xcd, 0x25,
-	0x0c, 0x51, 0xf3, 0xf7, 0x06, 0x62, 0xe2, 0x21, 0x54, 0xc8, 0x26, 0x14, 0x2c, 0x67, 0x73, 0xee,
-	0x89, 0x3c, 0x54, 0xd7, 0xda, 0x6a, 0x5d, 0x8e, 0x28, 0x1e, 0x62, 0x50, 0x5b, 0xad, 0x23, 0x23,
-	0x4e, 0x6c, 0x18, 0xb7, 0x9c, 0xcd, 0x60, 0x6e, 0x9e, 0xcf, 0xd9, 0xdc, 0x98, 0xc4, 0xc6, 0x83,
-	0xd5, 0x7a, 0x80, 0x9c, 0x05, 0xf9, 0x4b, 0xda, 0xc9, 0xe6, 0xc9, 0x1c, 0x9f, 0xde, 0x48, 0x9a,
-	0xaf, 0x07, 0x1e, 0x7e, 0xbe, 0x34, 0xa6, 0x2e, 0x44, 0xd5, 0xeb, 0x27, 0x6f, 0xea, 0xf3, 0x57,
-	0x9c, 0xb6, 0xee, 0xe4, 0x36, 0x7f, 0xa5, 0x76, 0x73, 0x66, 0xe0, 0xec, 0xed, 0xaa, 0x15, 0x2b,
-	0x97, 0xbc, 0x9d, 0xc9, 0x97, 0x5d, 0xc4, 0xe1, 0x3d, 0xb9, 0x5e, 0x99, 0x5f, 0xae, 0x28, 0x8b,
-	0x6e, 0xca, 0x09, 0xd7, 0x87, 0xa2, 0x1d, 0x84, 0xb6, 0x97, 0x63, 0x66, 0x93, 0xd4, 0x93, 0x28,
-	0x3c, 0x14, 0x92, 0x03, 0x50, 0xb0, 0x62, 0x3c, 0xdd, 0xb6, 0xed, 0x3e, 0x94, 0x9f, 0xff, 0x4a,
-	0xee, 0x2e, 0xa4, 0x82, 0x27, 0x07, 0xa0, 0x60, 0x45, 0xee, 0x8b, 0x39, 0x55, 0xc8, 0xa3, 0xaf,
-	0x6b, 0xab, 0xf5, 0x14, 0xbf, 0xe4, 0xdc, 0xba, 0x0f, 0x85, 0xa0, 0x63, 0x4b, 0x6d, 0x6d, 0x44,
-	0x5e, 0x8d, 0xb5, 0x95, 0x2c, 0x5e, 0x8d, 0xb5, 0x15, 0x64, 0x4c, 0xb8, 0x23, 0x8d, 0xd5, 0xd9,
-	0xb4, 0x82, 0xc0, 0x6a, 0x29, 0xe3, 0xd0, 0x88, 0x8e, 0x34, 0x35, 0x45, 0x2f, 0xc5, 0x9a, 0x5f,
-	0x45, 0xc4, 0x50, 0xd4, 0x38, 0x93, 0x37, 0x60, 0xd2, 0x12, 0xaf, 0x88, 0xcb, 0x70, 0xac, 0x7c,
-	0x9e, 0xc6, 0x4f, 0x49, 0xc0, 0xad, 0x44, 0x12, 0x84, 0x11, 0x43, 0xc6, 0x3b, 0xf4, 0x2d, 0xba,
-	0x65, 0xef, 0x48, 0xdb, 0x54, 0x63, 0xe4, 0x87, 0xdf, 0x18, 0xb1, 0x2c, 0xde, 0x12, 0x84, 0x11,
-	0x43, 0xf2, 0x35, 0x03, 0xce, 0x74, 0x2c, 0xd7, 0x52, 0xe1, 0xfe, 0xf9, 0xa4, 0x90, 0xd0, 0x13,
-	0x08, 0xc4, 0x0a, 0xea, 0x9a, 0xce, 0x08, 0x93, 0x7c, 0xc9, 0x2e, 0x4c, 0x30, 0x62, 0xf6, 0x43,
-	0x79, 0x12, 0x1c, 0x35, 0x8b, 0x39, 0xa7, 0x95, 0x6a, 0x03, 0xbe, 0xb8, 0x08, 0x08, 0x4a, 0x6e,
-	0xe4, 0x57, 0x0d, 0x98, 0x14, 0x91, 0x42, 0x4c, 0x1f, 0x66, 0xdf, 0xfe, 0xf9, 0x53, 0x78, 0x5a,
-	0x49, 0x46, 0x31, 0x49, 0xd7, 0xc7, 0x0f, 0xab, 0xc8, 0x05, 0x51, 0x7a, 0x68, 0x1c, 0x53, 0x24,
-	0x1d, 0xd3, 0xbc, 0x3b, 0xd6, 0xc3, 0xc4, 0xb3, 0x7e, 0xba, 0xe6, 0xbd, 0x96, 0x82, 0x61, 0x1f,
-	0xf6, 0xfc, 0x27, 0x61, 0x4a, 0x97, 0x63, 0xa8, 0x58, 0xa8, 0x1f, 0x16, 0x00, 0x78, 0x57, 0x89,
-	0x0c, 0x65, 0x1d, 0xfe, 0x2c, 0xc3, 0xb6, 0xd7, 0xca, 0xe9, 0x35, 0x75, 0x2d, 0xd1, 0x18, 0xc8,
-	0x37, 0x18, 0xb6, 0xbd, 0x16, 0x4a, 0x26, 0xa4, 0x0d, 0xe3, 0x5d, 0x2b, 0xdc, 0xce, 0x3f, 0xab,
-	0x59, 0x49, 0xe4, 0xca, 0x08, 0xb7, 0x91, 0x33, 0x20, 0x6f, 0x19, 0xb1, 0x57, 0x61, 0x21, 0x8f,
-	0xcc, 0xf2, 0x71, 0x9b, 0x2d, 0x48, 0x3f, 0xc2, 0x54, 0x82, 0xf5, 0xb4, 0x77, 0xe1, 0xfc, 0xdb,
-	0x06, 0x4c, 0xe9, 0xa8, 0x19, 0xdd, 0xf4, 0xb3, 0x7a, 0x37, 0xe5, 0xd9, 0x1e, 0x7a, 0x8f, 0xff,
-	0x57, 0x03, 0x00, 0x7b, 0x6e, 0xa3, 0xd7, 0xe9, 0xb0, 0x53, 0x83, 0x0a, 0xf9, 0x32, 0x8e, 0x1d,
-	0xf2, 0x35, 0x36, 0x64, 0xc8, 0x57, 0x61, 0xa8, 0x90, 0xaf, 0xf1, 0xe1, 0x43, 0xbe, 0x8a, 0x83,
-	0x43, 0xbe, 0xcc, 0x6f, 0x19, 0x70, 0xb6, 0x6f, 0xbf, 0x62, 0x8a, 0xbc, 0xef, 0x79, 0xe1, 0x00,
-	0xef, 0x74, 0x8c, 0x41, 0xa8, 0xe3, 0x91, 0x65, 0x98, 0x95, 0xef, 0xa6, 0x35, 0xba, 0x8e, 0x9d,
-	0x99, 0x71, 0x6e, 0x23, 0x05, 0xc7, 0xbe, 0x1a, 0xe6, 0x3f, 0x37, 0xa0, 0xa2, 0x25, 0x8a, 0xe1,
-	0x1e, 0x9d, 0xfc, 0xb2, 0x2d, 0xed, 0xd1, 0xc9, 0x6f, 0xda, 0x04, 0x4c, 0x78, 0x5d, 0xb4, 0xb5,
-	0x27, 0x6a, 0x62, 0xaf, 0x0b, 0x56, 0x8a, 0x12, 0x2a, 0x1e, 0x1f, 0x91, 0xae, 0x9d, 0x05, 0xfd,
-	0xf1, 0x11, 0xda, 0x15, 0x8e, 0x9c, 0xb1, 0x03, 0xe9, 0xf8, 0xd1, 0x0e, 0xa4, 0xc5, 0x6c, 0x07,
-	0x52, 0xf3, 0x0e, 0x4c, 0x89, 0xc8, 0x8b, 0x97, 0xe9, 0xde, 0xf1, 0xae, 0x24, 0x2f, 0x89, 0xd1,
-	0x9e, 0xf2, 0x48, 0x65, 0xd5, 0x59, 0xb9, 0x69, 0x41, 0x9c, 0x89, 0xff, 0x18, 0xd4, 0xae, 0x01,
-	0xa8, 0x37, 0x41, 0x84, 0x9b, 0x6b, 0x29, 0x1e, 0x90, 0xea, 0xe1, 0x90, 0x16, 0x6a, 0x58, 0xe6,
-	0x3f, 0x34, 0x20, 0xf5, 0x2e, 0xa4, 0x76, 0xc7, 0x64, 0x0c, 0xbc, 0x63, 0xd2, 0xef, 0x25, 0xc6,
-	0x0e, 0xbd, 0x97, 0xb8, 0x05, 0xa4, 0xc3, 0x66, 0x5b, 0x72, 0x2d, 0x2f, 0x24, 0x9f, 0xcf, 0x5a,
-	0xeb, 0xc3, 0xc0, 0x8c, 0x5a, 0xe6, 0x3f, 0x10, 0xc2, 0xea, 0x2f, 0x45, 0x1e, 0xdd, 0x2a, 0x3d,
-	0x28, 0x72, 0x52, 0xd2, 0xc2, 0x38, 0xa2, 0x75, 0xbe, 0x3f, 0x81, 0x65, 0x3c, 0x56, 0xe4, 0xaa,
-	0xc2, 0xb9, 0x99, 0xbf, 0x2b, 0x64, 0xd5, 0x9f, 0x92, 0x3c, 0x5a, 0xd6, 0x4e, 0x52, 0xd6, 0x9b,
-	0x79, 0x2d, 0xc7, 0xd9, 0x32, 0x92, 0x05, 0x80, 0x2e, 0xf5, 0x9b, 0xd4, 0x0d, 0xa3, 0x38, 0xd8,
-	0xa2, 0xcc, 0xc8, 0xa0, 0x4a, 0x51, 0xc3, 0x30, 0xbf, 0xc9, 0xe6, 0xa8, 0xdd, 0xde, 0x7d, 0x56,
-	0x86, 0x3d, 0x5d, 0x4d, 0x7b, 0xf2, 0xa7, 0xe7, 0x9f, 0x72, 0xe4, 0xd7, 0x02, 0x1a, 0xc7, 0x8e,
-	0x08, 0x68, 0xfc, 0x10, 0x4c, 0xfa, 0x9e, 0x43, 0x6b, 0xbe, 0x9b, 0xf6, 0x7a, 0x43, 0x56, 0x8c,
-	0xb7, 0x31, 0x82, 0x9b, 0x7f, 0xd7, 0x80, 0xd9, 0x74, 0xf8, 0x76, 0xee, 0xe1, 0x05, 0x7a, 0xb6,
-	0x9b, 0xc2, 0xf0, 0xd9, 0x6e, 0xcc, 0x3f, 0x2e, 0xc2, 0x6c, 0xfa, 0xd1, 0x5e, 0xc6, 0xd9, 0xe6,
-	0xe6, 0xc4, 0xd4, 0x06, 0x23, 0xec, 0x88, 0x02, 0xa6, 0xc6, 0xcb, 0xd8, 0xc0, 0xf1, 0x72, 0x03,
-	0xca, 0x5e, 0x37, 0x32, 0x69, 0x14, 0x12, 0xaf, 0x71, 0x94, 0xef, 0x44, 0x80, 0x47, 0xfb, 0xd5,
-	0x73, 0xb1, 0x00, 0xaa, 0x18, 0xe3, 0xaa, 0xe4, 0xa7, 0x22, 0x5b, 0xcc, 0x78, 0x22, 0xdb, 0x9c,
-	0xb2, 0xc5, 0xcc, 0xc4, 0xf5, 0x07, 0x99, 0x63, 0x8a, 0xc3, 0xe4, 0xb1, 0x9a, 0xc8, 0x31, 0x8f,
-	0xd5, 0x3d, 0x28, 0x4b, 0xeb, 0xf1, 0x89, 0xf2, 0x37, 0x71, 0xc2, 0x77, 0x23, 0x02, 0x18, 0xd3,
-	0x4a, 0xb9, 0x83, 0x95, 0x72, 0x75, 0x07, 0x7b, 0x01, 0x26, 0x37, 0xad, 0xe6, 0x8e, 0xb7, 0xb5,
-	0xc5, 0x8f, 0x00, 0xe5, 0xfa, 0x07, 0xa2, 0x86, 0xab, 0x8b, 0xe2, 0x8c, 0x21, 0x15, 0xd5, 0x60,
-	0xeb, 0x3c, 0x8d, 0x9c, 0xfb, 0x23, 0xc3, 0xb6, 0x5a, 0xe7, 0x95, 0xdb, 0x7f, 0x80, 0x1a, 0x16,
-	0x79, 0x06, 0x4a, 0x2d, 0x3b, 0xb0, 0x36, 0x99, 0xea, 0x51, 0x49, 0x86, 0x9b, 0x2c, 0xcb, 0x72,
-	0x54, 0x18, 0xe4, 0x45, 0xe5, 0xff, 0x39, 0x15, 0x47, 0x82, 0x29, 0xcf, 0xb4, 0x43, 0x22, 0xc1,
-	0xa4, 0x6b, 0xfb, 0x5b, 0x6c, 0x62, 0x86, 0x76, 0x73, 0xc7, 0x76, 0x45, 0x52, 0x24, 0xb6, 0x5a,
-	0x7c, 0x08, 0x26, 0xa9, 0x2b, 0x24, 0x10, 0x97, 0x43, 0x6a, 0xb0, 0x5c, 0x17, 0xc5, 0x18, 0xc1,
-	0x49, 0x0d, 0x66, 0x5a, 0x29, 0x47, 0x3f, 0x91, 0xcc, 0x4d, 0xdd, 0x20, 0xa4, 0x9d, 0xfb, 0xd2,
-	0xf8, 0xe6, 0x17, 0xa1, 0xa2, 0xe9, 0x7a, 0x5c, 0x2d, 0x7a, 0x68, 0x35, 0xfb, 0x02, 0x44, 0xae,
-	0xb3, 0x42, 0x14, 0x30, 0x7e, 0xf1, 0x28, 0xa2, 0x9b, 0x53, 0xea, 0x84, 0x8c, 0x69, 0x96, 0x50,
-	0x46, 0xcc, 0xa7, 0x6d, 0xfa, 0x30, 0x7a, 0x98, 0x2b, 0x22, 0x86, 0xac, 0x10, 0x05, 0xcc, 0x7c,
-	0x06, 0x4a, 0x51, 0x82, 0x4e, 0x9e, 0xb7, 0x2e, 0xba, 0x14, 0xd3, 0xf3, 0xd6, 0x79, 0x7e, 0x88,
-	0x1c, 0x62, 0xbe, 0x0a, 0xa5, 0x28, 0x8f, 0xe8, 0xd1, 0xd8, 0x6c, 0xfb, 0x0d, 0x5c, 0xfb, 0xa6,
-	0x17, 0x84, 0x51, 0xf2, 0x53, 0x71, 0x6f, 0x7f, 0x7b, 0x85, 0x97, 0xa1, 0x82, 0x9a, 0x3f, 0x32,
-	0xa0, 0xb2, 0xb1, 0xb1, 0xaa, 0xec, 0x69, 0x08, 0xef, 0x0f, 0x44, 0x0b, 0xd5, 0xb6, 0x42, 0xaa,
-	0x3b, 0x08, 0x89, 0x95, 0x68, 0xfe, 0x60, 0xbf, 0xfa, 0xfe, 0x46, 0x26, 0x06, 0x0e, 0xa8, 0x49,
-	0x56, 0xe0, 0x9c, 0x0e, 0x91, 0x69, 0xa6, 0xa4, 0x5e, 0xc0, 0x3d, 0xca, 0x1b, 0xfd, 0x60, 0xcc,
-	0xaa, 0x93, 0x26, 0x15, 0x45, 0xe5, 0x17, 0xb2, 0x49, 0x45, 0x21, 0xf9, 0x59, 0x75, 0xcc, 0x8f,
-	0xc3, 0x4c, 0xca, 0x73, 0xe5, 0x18, 0xe9, 0xfd, 0x7e, 0xbb, 0x00, 0x53, 0xba, 0x03, 0xc3, 0x31,
-	0xf6, 0xec, 0xe3, 0xab, 0x42, 0x19, 0x4e, 0x07, 0x85, 0x21, 0x9d, 0x0e, 0x74, 0x2f, 0x8f, 0xf1,
-	0xd3, 0xf5, 0xf2, 0x28, 0xe6, 0xe3, 0xe5, 0xa1, 0x79, 0x23, 0x4d, 0x3c, 0x3e, 0x6f, 0xa4, 0xdf,
-	0x28, 0xc2, 0x74, 0x32, 0x5d, 0xfd, 0x31, 0x7a, 0xf2, 0x99, 0xbe, 0x9e, 0x1c, 0xf2, 0x96, 0xb3,
-	0x30, 0xea, 0x2d, 0xe7, 0xf8, 0xa8, 0xb7, 0x9c, 0xc5, 0x13, 0xdc, 0x72, 0xf6, 0xdf, 0x51, 0x4e,
-	0x1c, 0xfb, 0x8e, 0xf2, 0x53, 0x6a, 0xa3, 0x98, 0x4c, 0x38, 0xf6, 0xc5, 0x9b, 0x05, 0x49, 0x76,
-	0xc3, 0x92, 0xd7, 0xca, 0x0c, 0x70, 0x28, 0x1d, 0xa1, 0x3e, 0xf8, 0x99, 0x7e, 0xfd, 0xc3, 0x3b,
-	0x52, 0xbc, 0x7f, 0x08, 0x9f, 0xfe, 0xe7, 0xa0, 0x22, 0xc7, 0x13, 0x3f, 0xd3, 0x42, 0xf2, 0x3c,
-	0xdc, 0x88, 0x41, 0xa8, 0xe3, 0xb1, 0x81, 0xd1, 0x8d, 0x27, 0x08, 0xbf, 0x6f, 0xaf, 0x24, 0xef,
-	0xdb, 0xd7, 0x93, 0x60, 0x4c, 0xe3, 0x9b, 0x5f, 0x80, 0x0b, 0x99, 0x96, 0x4d, 0x7e, 0xa9, 0xc5,
-	0xcf, 0x42, 0xb4, 0x25, 0x11, 0x34, 0x31, 0x52, 0xaf, 0xf1, 0xcd, 0xdf, 0x1b, 0x88, 0x89, 0x87,
-	0x50, 0x31, 0x7f, 0xbd, 0x00, 0xd3, 0x89, 0x73, 0x57, 0x40, 0x1e, 0xa8, 0x7b, 0x90, 0x5c, 0xae,
-	0x60, 0x04, 0x59, 0x2d, 0x63, 0xf9, 0xc0, 0xeb, 0xdb, 0x07, 0x7c, 0x7c, 0x6d, 0xaa, 0xf4, 0xe9,
-	0xa7, 0xc7, 0x58, 0xde, 0x9b, 0x4a, 0x76, 0xe4, 0x2b, 0x06, 0x40, 0x9c, 0xb0, 0x43, 0x9a, 0xc7,
-	0x72, 0xe7, 0x1e, 0xe7, 0x56, 0x50, 0xac, 0x50, 0x63, 0xcb, 0xf6, 0x96, 0x5d, 0xea, 0xdb, 0x5b,
-	0x36, 0x6d, 0xc9, 0xe7, 0x71, 0xf8, 0xca, 0xfd, 0xaa, 0x2c, 0x43, 0x05, 0x35, 0xdf, 0x1a, 0x83,
-	0x32, 0x0f, 0x13, 0xbd, 0xe1, 0x7b, 0x1d, 0xfe, 0xd4, 0x7a, 0xa0, 0x99, 0x22, 0x64, 0xb7, 0xdd,
-	0xca, 0xe3, 0xa1, 0x40, 0x41, 0x51, 0x06, 0x4d, 0x69, 0x25, 0x98, 0xe0, 0x48, 0xba, 0x50, 0xda,
-	0x92, 0x8f, 0x51, 0xc8, 0xbe, 0x1b, 0x31, 0xff, 0x79, 0xf4, 0xb4, 0x85, 0x68, 0x82, 0xe8, 0x1f,
-	0x2a, 0x2e, 0xa6, 0x05, 0x33, 0xa9, 0xa4, 0x74, 0xb9, 0x3f, 0x61, 0xf1, 0x3f, 0xc6, 0xa1, 0xac,
-	0x42, 0xa7, 0xc9, 0x27, 0x12, 0x76, 0xe1, 0x58, 0x87, 0x97, 0x06, 0x5d, 0x76, 0x6e, 0x52, 0xc8,
-	0x29, 0x1b, 0xef, 0x25, 0x28, 0xf4, 0x7c, 0x27, 0x6d, 0xf8, 0xb9, 0x8b, 0xab, 0xc8, 0xca, 0xf5,
-	0x70, 0xef, 0xc2, 0xe3, 0x0d, 0xf7, 0xbe, 0x02, 0xe3, 0x9b, 0x5e, 0x6b, 0x2f, 0xfd, 0x08, 0x6f,
-	0xdd, 0x6b, 0xed, 0x21, 0x87, 0x90, 0x17, 0x61, 0x5a, 0xc6, 0xb0, 0x47, 0x4a, 0x4c, 0x91, 0xeb,
-	0xa9, 0xca, 0x1d, 0x69, 0x23, 0x01, 0xc5, 0x14, 0x36, 0xdb, 0x65, 0xd9, 0xb1, 0x81, 0x3f, 0x4c,
-	0x32, 0x91, 0xf4, 0x5d, 0xb8, 0xd5, 0xb8, 0x73, 0x9b, 0xdb, 0xa7, 0x15, 0x46, 0x22, 0x4c, 0x7e,
-	0xf2, 0xc8, 0x30, 0xf9, 0x65, 0x41, 0x9b, 0x49, 0xcb, 0x77, 0x94, 0x29, 0x1e, 0x5d, 0xc3, 0xe9,
-	0xb2, 0xb2, 0x43, 0xcf, 0x2e, 0xaa, 0x66, 0x56, 0x42, 0x81, 0xf2, 0x7b, 0x97, 0x50, 0xc0, 0xbc,
-	0x0b, 0x33, 0xa9, 0xfe, 0x8b, 0xec, 0x86, 0x46, 0xb6, 0xdd, 0xf0, 0x78, 0xcf, 0xf8, 0xfe, 0x13,
-	0x03, 0xce, 0xf6, 0xad, 0x48, 0xc7, 0xcd, 0xec, 0x90, 0xde, 0x1b, 0xc7, 0x4e, 0xbe, 0x37, 0x16,
-	0x86, 0xdb, 0x1b, 0xeb, 0x9b, 0xdf, 0xf9, 0xc1, 0xe5, 0xf7, 0x7d, 0xef, 0x07, 0x97, 0xdf, 0xf7,
-	0x7b, 0x3f, 0xb8, 0xfc, 0xbe, 0xb7, 0x0e, 0x2e, 0x1b, 0xdf, 0x39, 0xb8, 0x6c, 0x7c, 0xef, 0xe0,
-	0xb2, 0xf1, 0x7b, 0x07, 0x97, 0x8d, 0xff, 0x74, 0x70, 0xd9, 0xf8, 0xd6, 0x1f, 0x5e, 0x7e, 0xdf,
-	0x67, 0x3e, 0x15, 0xf7, 0xd4, 0x62, 0xd4, 0x53, 0xfc, 0xc7, 0x47, 0xa2, 0x7e, 0x59, 0xec, 0xee,
-	0xb4, 0x17, 0x59, 0x4f, 0x2d, 0xaa, 0x92, 0xa8, 0xa7, 0xfe, 0x4f, 0x00, 0x00, 0x00, 0xff, 0xff,
-	0xe1, 0x83, 0x41, 0x34, 0x2e, 0xb5, 0x00, 0x00,
+	// 11154 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0xbd, 0x7d, 0x6c, 0x24, 0xc9,
+	0x75, 0x18, 0xae, 0x9e, 0x0f, 0x92, 0x53, 0xe4, 0x92, 0xdc, 0xde, 0xdd, 0xdb, 0xb9, 0xbd, 0xbb,
+	0xe5, 0xaa, 0x4f, 0xd2, 0x6f, 0x65, 0x49, 0x5c, 0x69, 0x75, 0xf2, 0x4f, 0xd6, 0x29, 0x97, 0xcc,
+	0x90, 0xfb, 0xc1, 0x3b, 0x72, 0x77, 0xee, 0x0d, 0x77, 0xd7, 0x92, 0x2c, 0x59, 0xcd, 0x99, 0xe2,
+	0xb0, 0x97, 0x3d, 0xdd, 0x73, 0xdd, 0x3d, 0xdc, 0xe5, 0x59, 0xd6, 0x49, 0x36, 0x4e, 0xb2, 0x03,
+	0x29, 0x52, 0x64, 0x0b, 0x46, 0x1c, 0x43, 0x56, 0x0c, 0xc7, 0xce, 0x17, 0x92, 0x40, 0xb0, 0x13,
+	0x04, 0x70, 0xe0, 0xc4, 0x86, 0x03, 0x19, 0x81, 0x03, 0x19, 0x41, 0x62, 0x39, 0x89, 0xe9, 0x88,
+	0x4e, 0xfe, 0x88, 0x91, 0xc0, 0x56, 0x90, 0x40, 0xc8, 0xe6, 0x8f, 0x04, 0xf5, 0x5d, 0xd5, 0xd3,
+	0x43, 0x72, 0x38, 0xcd, 0xbd, 0x4b, 0xe2, 0xbf, 0xc8, 0x79, 0xef, 0xd5, 0x7b, 0xd5, 0xf5, 0xf9,
+	0xea, 0xd5, 0x7b, 0xaf, 0xd0, 0x6a, 0xc7, 0x4b, 0xb6, 0xfa, 0x1b, 0x8b, 0xad, 0xb0, 0x7b, 0xc5,
+	0x8d, 0x3a, 0x61, 0x2f, 0x0a, 0xef, 0xd3, 0x7f, 0xde, 0x13, 0x85, 0xbe, 0x1f, 0xf6, 0x93, 0xf8,
+	0x4a, 0x6f, 0xbb, 0x73, 0xc5, 0xed, 0x79, 0xf1, 0x15, 0x09, 0xd9, 0x79, 0x9f, 0xeb, 0xf7, 0xb6,
+	0xdc, 0xf7, 0x5d, 0xe9, 0xe0, 0x00, 0x47, 0x6e, 0x82, 0xdb, 0x8b, 0xbd, 0x28, 0x4c, 0x42, 0xfb,
+	0xc3, 0x8a, 0xdb, 0xa2, 0xe0, 0x46, 0xff, 0xf9, 0x61, 0x51, 0x76, 0xb1, 0xb7, 0xdd, 0x59, 0x24,
+	0xdc, 0x16, 0x25, 0x44, 0x70, 0xbb, 0xf0, 0x1e, 0xad, 0x2e, 0x9d, 0xb0, 0x13, 0x5e, 0xa1, 0x4c,
+	0x37, 0xfa, 0x9b, 0xf4, 0x17, 0xfd, 0x41, 0xff, 0x63, 0xc2, 0x2e, 0x3c, 0xbb, 0xfd, 0xc1, 0x78,
+	0xd1, 0x0b, 0x49, 0xdd, 0xae, 0x6c, 0xb8, 0x49, 0x6b, 0xeb, 0xca, 0xce, 0x40, 0x8d, 0x2e, 0x38,
+	0x1a, 0x51, 0x2b, 0x8c, 0x70, 0x16, 0xcd, 0x73, 0x8a, 0xa6, 0xeb, 0xb6, 0xb6, 0xbc, 0x00, 0x47,
+	0xbb, 0xea, 0xab, 0xbb, 0x38, 0x71, 0xb3, 0x4a, 0x5d, 0x19, 0x56, 0x2a, 0xea, 0x07, 0x89, 0xd7,
+	0xc5, 0x03, 0x05, 0xbe, 0xff, 0xb0, 0x02, 0x71, 0x6b, 0x0b, 0x77, 0xdd, 0x81, 0x72, 0xef, 0x1f,
+	0x56, 0xae, 0x9f, 0x78, 0xfe, 0x15, 0x2f, 0x48, 0xe2, 0x24, 0x4a, 0x17, 0x72, 0xfe, 0xa4, 0x88,
+	0x2a, 0xb5, 0xd5, 0x7a, 0x33, 0x71, 0x93, 0x7e, 0x6c, 0x7f, 0xce, 0x42, 0x33, 0x7e, 0xe8, 0xb6,
+	0xeb, 0xae, 0xef, 0x06, 0x2d, 0x1c, 0x55, 0xad, 0x4b, 0xd6, 0xe5, 0xe9, 0xab, 0xab, 0x8b, 0xe3,
+	0xf4, 0xd7, 0x62, 0xed, 0x41, 0x0c, 0x38, 0x0e, 0xfb, 0x51, 0x0b, 0x03, 0xde, 0xac, 0x9f, 0xfd,
+	0xe6, 0xde, 0xc2, 0x5b, 0xf6, 0xf7, 0x16, 0x66, 0x56, 0x35, 0x49, 0x60, 0xc8, 0xb5, 0xbf, 0x6a,
+	0xa1, 0xd3, 0x2d, 0x37, 0x70, 0xa3, 0xdd, 0x75, 0x37, 0xea, 0xe0, 0xe4, 0x46, 0x14, 0xf6, 0x7b,
+	0xd5, 0xc2, 0x09, 0xd4, 0xe6, 0x49, 0x5e, 0x9b, 0xd3, 0x4b, 0x69, 0x71, 0x30, 0x58, 0x03, 0x5a,
+	0xaf, 0x38, 0x71, 0x37, 0x7c, 0xac, 0xd7, 0xab, 0x78, 0x92, 0xf5, 0x6a, 0xa6, 0xc5, 0xc1, 0x60,
+	0x0d, 0xec, 0x77, 0xa2, 0x49, 0x2f, 0xe8, 0x44, 0x38, 0x8e, 0xab, 0xa5, 0x4b, 0xd6, 0xe5, 0x4a,
+	0x7d, 0x8e, 0x17, 0x9f, 0x5c, 0x61, 0x60, 0x10, 0x78, 0xe7, 0x1b, 0x45, 0x74, 0xba, 0xb6, 0x5a,
+	0x5f, 0x8f, 0xdc, 0xcd, 0x4d, 0xaf, 0x05, 0x61, 0x3f, 0xf1, 0x82, 0x8e, 0xce, 0xc0, 0x3a, 0x98,
+	0x81, 0xfd, 0x01, 0x34, 0x1d, 0xe3, 0x68, 0xc7, 0x6b, 0xe1, 0x46, 0x18, 0x25, 0xb4, 0x53, 0xca,
+	0xf5, 0x33, 0x9c, 0x7c, 0xba, 0xa9, 0x50, 0xa0, 0xd3, 0x91, 0x62, 0x51, 0x18, 0x26, 0x1c, 0x4f,
+	0xdb, 0xac, 0xa2, 0x8a, 0x81, 0x42, 0x81, 0x4e, 0x67, 0x2f, 0xa3, 0x79, 0x37, 0x08, 0xc2, 0xc4,
+	0x4d, 0xbc, 0x30, 0x68, 0x44, 0x78, 0xd3, 0x7b, 0xc8, 0x3f, 0xb1, 0xca, 0xcb, 0xce, 0xd7, 0x52,
+	0x78, 0x18, 0x28, 0x61, 0x7f, 0xd9, 0x42, 0xf3, 0x71, 0xe2, 0xb5, 0xb6, 0xbd, 0x00, 0xc7, 0xf1,
+	0x52, 0x18, 0x6c, 0x7a, 0x9d, 0x6a, 0x99, 0x76, 0xdb, 0xad, 0xf1, 0xba, 0xad, 0x99, 0xe2, 0x5a,
+	0x3f, 0x4b, 0xaa, 0x94, 0x86, 0xc2, 0x80, 0x74, 0xfb, 0x5d, 0xa8, 0xc2, 0x5b, 0x14, 0xc7, 0xd5,
+	0x89, 0x4b, 0xc5, 0xcb, 0x95, 0xfa, 0xa9, 0xfd, 0xbd, 0x85, 0xca, 0x8a, 0x00, 0x82, 0xc2, 0x3b,
+	0x5f, 0xb0, 0xd0, 0x7c, 0xad, 0xed, 0xf6, 0x12, 0x6f, 0x07, 0xaf, 0x04, 0x09, 0x8e, 0x76, 0x5c,
+	0xdf, 0xbe, 0x81, 0xa6, 0xbb, 0x5e, 0x20, 0x7e, 0xf2, 0x7e, 0x7b, 0xbb, 0x68, 0xd1, 0x35, 0x85,
+	0x7a, 0xb4, 0xb7, 0x30, 0xbb, 0xdc, 0x8f, 0x68, 0x83, 0x34, 0x93, 0xc8, 0x0b, 0x3a, 0xa0, 0x97,
+	0xb4, 0xaf, 0xa0, 0x4a, 0x2b, 0x0c, 0xda, 0x1e, 0xc1, 0xd3, 0xfe, 0xac, 0xd4, 0x4f, 0x73, 0x36,
+	0x95, 0x25, 0x81, 0x00, 0x45, 0xe3, 0x2c, 0xa3, 0x6a, 0xad, 0xbb, 0xe1, 0xc6, 0xb1, 0xdb, 0x0e,
+	0xa3, 0xd4, 0x48, 0xba, 0x8c, 0xa6, 0xba, 0x6e, 0xaf, 0xe7, 0x05, 0x1d, 0x32, 0x94, 0xc8, 0x67,
+	0xcd, 0xec, 0xef, 0x2d, 0x4c, 0xad, 0x71, 0x18, 0x48, 0xac, 0xf3, 0xfb, 0x05, 0x34, 0x5d, 0x0b,
+	0x5c, 0x7f, 0x37, 0xf6, 0x62, 0xe8, 0x07, 0xf6, 0x27, 0xd1, 0x14, 0x59, 0x44, 0xdb, 0x6e, 0xe2,
+	0xf2, 0x85, 0xe7, 0xbd, 0x8b, 0x6c, 0x4d, 0x5b, 0xd4, 0xd7, 0x34, 0xd5, 0x1b, 0x84, 0x7a, 0x71,
+	0xe7, 0x7d, 0x8b, 0xb7, 0x37, 0xee, 0xe3, 0x56, 0xb2, 0x86, 0x13, 0xb7, 0x6e, 0xf3, 0x7a, 0x23,
+	0x05, 0x03, 0xc9, 0xd5, 0x0e, 0x51, 0x29, 0xee, 0xe1, 0x16, 0x5f, 0x48, 0xd6, 0xc6, 0x9c, 0xb0,
+	0xaa, 0xea, 0xcd, 0x1e, 0x6e, 0xd5, 0x67, 0xb8, 0xe8, 0x12, 0xf9, 0x05, 0x54, 0x90, 0xfd, 0x00,
+	0x4d, 0xc4, 0x74, 0x69, 0xe5, 0x6b, 0xc4, 0xed, 0xfc, 0x44, 0x52, 0xb6, 0xf5, 0x59, 0x2e, 0x74,
+	0x82, 0xfd, 0x06, 0x2e, 0xce, 0xf9, 0x37, 0x16, 0x3a, 0xa3, 0x51, 0xd7, 0xa2, 0x4e, 0xbf, 0x8b,
+	0x83, 0xc4, 0xbe, 0x84, 0x4a, 0x81, 0xdb, 0xc5, 0x7c, 0xb0, 0xc8, 0x2a, 0xdf, 0x72, 0xbb, 0x18,
+	0x28, 0xc6, 0x7e, 0x16, 0x95, 0x77, 0x5c, 0xbf, 0x8f, 0xf9, 0x40, 0x38, 0xc5, 0x49, 0xca, 0x77,
+	0x09, 0x10, 0x18, 0xce, 0xfe, 0x14, 0xaa, 0xd0, 0x7f, 0xae, 0x47, 0x61, 0x37, 0xa7, 0x4f, 0xe3,
+	0x35, 0xbc, 0x2b, 0xd8, 0xb2, 0xd9, 0x20, 0x7f, 0x82, 0x12, 0xe8, 0xfc, 0xa1, 0x85, 0xe6, 0xb4,
+	0x8f, 0x5b, 0xf5, 0xe2, 0xc4, 0xfe, 0xa1, 0x81, 0xc1, 0xb3, 0x78, 0xb4, 0xc1, 0x43, 0x4a, 0xd3,
+	0xa1, 0x33, 0xcf, 0xbf, 0x74, 0x4a, 0x40, 0xb4, 0x81, 0x13, 0xa0, 0xb2, 0x97, 0xe0, 0x6e, 0x5c,
+	0x2d, 0x5c, 0x2a, 0x5e, 0x9e, 0xbe, 0xba, 0x92, 0x5b, 0x37, 0xaa, 0xf6, 0x5d, 0x21, 0xfc, 0x81,
+	0x89, 0x71, 0x7e, 0xa5, 0x68, 0x74, 0xdf, 0x9a, 0xa8, 0xc7, 0xeb, 0x16, 0x9a, 0xf0, 0xdd, 0x0d,
+	0xec, 0xb3, 0xb9, 0x35, 0x7d, 0xf5, 0xe3, 0xb9, 0xd5, 0x44, 0xc8, 0x58, 0x5c, 0xa5, 0xfc, 0xaf,
+	0x05, 0x49, 0xb4, 0xab, 0x86, 0x17, 0x03, 0x02, 0x17, 0x6e, 0xff, 0x15, 0x0b, 0x4d, 0xab, 0x45,
+	0x56, 0x34, 0xcb, 0x46, 0xfe, 0x95, 0x51, 0x6b, 0x3b, 0xaf, 0x91, 0xdc, 0x31, 0x34, 0x0c, 0xe8,
+	0x75, 0xb9, 0xf0, 0x03, 0x68, 0x5a, 0xfb, 0x04, 0x7b, 0x1e, 0x15, 0xb7, 0xf1, 0x2e, 0x1b, 0xf0,
+	0x40, 0xfe, 0xb5, 0xcf, 0x1a, 0x23, 0x9c, 0x0f, 0xe9, 0x0f, 0x15, 0x3e, 0x68, 0x5d, 0x78, 0x01,
+	0xcd, 0xa7, 0x05, 0x8e, 0x52, 0xde, 0xf9, 0xa5, 0x09, 0x63, 0x60, 0x92, 0x85, 0xc0, 0x0e, 0xd1,
+	0x64, 0x17, 0x27, 0x91, 0xd7, 0x12, 0x5d, 0xb6, 0x3c, 0x5e, 0x2b, 0xad, 0x51, 0x66, 0x6a, 0x7f,
+	0x66, 0xbf, 0x63, 0x10, 0x52, 0xec, 0x2d, 0x54, 0x72, 0xa3, 0x8e, 0xe8, 0x93, 0xeb, 0xf9, 0x4c,
+	0x4b, 0xb5, 0x54, 0xd4, 0xa2, 0x4e, 0x0c, 0x54, 0x02, 0xd9, 0x37, 0x12, 0x1c, 0x75, 0xbd, 0xc0,
+	0x4d, 0xd8, 0x86, 0x3e, 0xa5, 0xf6, 0x8d, 0x75, 0x81, 0x00, 0x45, 0x63, 0xfb, 0x68, 0xa2, 0x1d,
+	0xed, 0x42, 0x3f, 0xa8, 0x96, 0xf2, 0x68, 0x8a, 0x65, 0xca, 0x4b, 0x0d, 0x52, 0xf6, 0x1b, 0xb8,
+	0x0c, 0xfb, 0x17, 0x2d, 0x74, 0xb6, 0x8b, 0xdd, 0xb8, 0x1f, 0x61, 0xf2, 0x09, 0x80, 0x13, 0x1c,
+	0xd0, 0x2d, 0xae, 0x4c, 0x85, 0xc3, 0xb8, 0xfd, 0x30, 0xc8, 0xb9, 0xfe, 0x34, 0xaf, 0xca, 0xd9,
+	0x2c, 0x2c, 0x64, 0xd6, 0xc6, 0xfe, 0x14, 0x9a, 0x4e, 0x12, 0xbf, 0x99, 0x44, 0x6e, 0x82, 0x3b,
+	0xbb, 0xd5, 0x89, 0x4b, 0xd6, 0xf8, 0x2b, 0xcc, 0xfa, 0xfa, 0xaa, 0x60, 0x58, 0x9f, 0x23, 0xb3,
+	0x45, 0x03, 0x80, 0x2e, 0xce, 0x4e, 0xd0, 0x64, 0xdc, 0x0a, 0x89, 0x4e, 0x50, 0x9d, 0xcc, 0x73,
+	0x57, 0x6c, 0x32, 0xa6, 0xf5, 0x69, 0x32, 0x46, 0xf9, 0x0f, 0x10, 0xa2, 0x9c, 0xdf, 0x2f, 0xa3,
+	0xd3, 0x03, 0x9b, 0x99, 0xfd, 0x1c, 0x2a, 0xf7, 0xb6, 0xdc, 0x58, 0xec, 0x4e, 0x17, 0xc5, 0xd2,
+	0xd8, 0x20, 0xc0, 0x47, 0x7b, 0x0b, 0xa7, 0x44, 0x11, 0x0a, 0x00, 0x46, 0x4c, 0x54, 0xd7, 0x2e,
+	0x8e, 0x63, 0xb7, 0x23, 0xb6, 0x2c, 0x6d, 0x6a, 0x50, 0x30, 0x08, 0xbc, 0xfd, 0x79, 0x0b, 0x9d,
+	0x62, 0xd3, 0x04, 0x70, 0xdc, 0xf7, 0x13, 0xb2, 0x2d, 0x93, 0xa1, 0xf0, 0x62, 0x1e, 0x53, 0x92,
+	0xb1, 0xac, 0x9f, 0xe3, 0xd2, 0x4f, 0xe9, 0xd0, 0x18, 0x4c, 0xb9, 0xf6, 0x3d, 0x54, 0x89, 0x13,
+	0x37, 0x4a, 0x70, 0xbb, 0x96, 0x50, 0x7d, 0x76, 0xfa, 0xea, 0xf7, 0x1d, 0x6d, 0xbf, 0x5a, 0xf7,
+	0xba, 0x98, 0xed, 0x8d, 0x4d, 0xc1, 0x00, 0x14, 0x2f, 0xfb, 0x53, 0x08, 0x45, 0xfd, 0xa0, 0xd9,
+	0xef, 0x76, 0xdd, 0x68, 0x97, 0xab, 0xb8, 0x37, 0xc7, 0xfb, 0x3c, 0x90, 0xfc, 0x94, 0x7a, 0xa5,
+	0x60, 0xa0, 0xc9, 0xb3, 0x3f, 0x6b, 0xa1, 0x53, 0x6c, 0xf6, 0x89, 0x1a, 0x4c, 0xe4, 0x5c, 0x83,
+	0xd3, 0xa4, 0x69, 0x97, 0x75, 0x11, 0x60, 0x4a, 0xb4, 0x3f, 0x8e, 0xa6, 0x5b, 0x61, 0xb7, 0xe7,
+	0x63, 0xd6, 0xb8, 0x93, 0x23, 0x37, 0x2e, 0x9d, 0x30, 0x4b, 0x8a, 0x05, 0xe8, 0xfc, 0xec, 0x05,
+	0x54, 0x26, 0xa3, 0x18, 0x57, 0xa7, 0x2e, 0x59, 0x97, 0x8b, 0xf5, 0x0a, 0x19, 0xa0, 0x64, 0x7c,
+	0x63, 0x60, 0x70, 0xe7, 0x5f, 0x99, 0xaa, 0x97, 0x9c, 0x69, 0x1f, 0x43, 0x4f, 0xc6, 0xfd, 0x56,
+	0x0b, 0xc7, 0xf1, 0x66, 0xdf, 0x87, 0x7e, 0x70, 0xd3, 0x8b, 0x93, 0x30, 0xda, 0x5d, 0xf5, 0xba,
+	0x5e, 0x42, 0x47, 0x7c, 0xb9, 0xfe, 0xcc, 0xfe, 0xde, 0xc2, 0x93, 0xcd, 0x61, 0x44, 0x30, 0xbc,
+	0xbc, 0xed, 0xa2, 0xa7, 0xfa, 0xc1, 0x70, 0xf6, 0xec, 0x90, 0xb6, 0xb0, 0xbf, 0xb7, 0xf0, 0xd4,
+	0x9d, 0xe1, 0x64, 0x70, 0x10, 0x0f, 0xe7, 0xf3, 0x05, 0xb5, 0xb9, 0xf1, 0x09, 0x6d, 0xf7, 0xd1,
+	0xe4, 0x03, 0xec, 0x75, 0xb6, 0x12, 0xb1, 0xb9, 0xe5, 0x32, 0x93, 0xee, 0x51, 0x96, 0x6a, 0x1e,
+	0xb3, 0xdf, 0x31, 0x08, 0x59, 0xf6, 0x8f, 0xa2, 0x4a, 0xb2, 0x15, 0xe1, 0x78, 0x2b, 0xf4, 0xdb,
+	0xf9, 0x58, 0x05, 0x68, 0x0f, 0xae, 0x0b, 0x9e, 0xda, 0x36, 0x26, 0x40, 0xa0, 0x24, 0x3a, 0x7f,
+	0x4c, 0x4e, 0x63, 0xbc, 0x25, 0xd6, 0x71, 0xb7, 0xe7, 0x93, 0xbd, 0xed, 0xe4, 0x4f, 0x2f, 0x89,
+	0x71, 0x7a, 0x81, 0x7c, 0xd6, 0x69, 0x51, 0xff, 0x61, 0x47, 0x18, 0xe7, 0x3f, 0x59, 0xe8, 0x6c,
+	0x9a, 0xf8, 0x31, 0x68, 0xdc, 0xb1, 0xa9, 0x71, 0xdf, 0xca, 0xf7, 0x6b, 0x87, 0xa8, 0xdd, 0xaf,
+	0x6b, 0x53, 0x57, 0x90, 0x02, 0xde, 0xb4, 0x3f, 0x88, 0x66, 0x12, 0xfe, 0xf3, 0x96, 0x3a, 0x3d,
+	0x49, 0x43, 0xd6, 0xba, 0x86, 0x03, 0x83, 0xd2, 0x7e, 0x0e, 0xcd, 0xb4, 0xfc, 0x7e, 0x9c, 0xe0,
+	0xa8, 0xd9, 0x0a, 0x7b, 0x6c, 0x87, 0x9a, 0xaa, 0xcf, 0x93, 0x52, 0x4b, 0x1a, 0x1c, 0x0c, 0x2a,
+	0xe7, 0xb3, 0x13, 0x83, 0x6d, 0xfe, 0x7f, 0xbb, 0x32, 0xa9, 0x74, 0xc3, 0xe2, 0x1b, 0xa9, 0x1b,
+	0x96, 0xde, 0x54, 0xba, 0xe1, 0x8f, 0x59, 0x44, 0xc5, 0x66, 0x03, 0x20, 0xe6, 0x7a, 0xeb, 0xcb,
+	0xf9, 0x4e, 0x05, 0x62, 0x6c, 0xd4, 0xb4, 0x76, 0x2e, 0x0b, 0x94, 0x58, 0x5d, 0x45, 0x9c, 0x78,
+	0x7c, 0x2a, 0xe2, 0xdf, 0x28, 0xa1, 0x99, 0x5a, 0x90, 0x78, 0xb5, 0xcd, 0x4d, 0x2f, 0xf0, 0x92,
+	0x5d, 0xfb, 0x0b, 0x05, 0x74, 0xa5, 0x17, 0xe1, 0x4d, 0x1c, 0x45, 0xb8, 0xbd, 0xdc, 0x27, 0x44,
+	0xcd, 0xd6, 0x16, 0x6e, 0xf7, 0x7d, 0x2f, 0xe8, 0xac, 0x74, 0x82, 0x50, 0x82, 0xaf, 0x3d, 0xc4,
+	0xad, 0x3e, 0xed, 0x4d, 0xb6, 0x2e, 0x75, 0xc7, 0xab, 0x6f, 0x63, 0x34, 0xa1, 0xf5, 0xf7, 0xef,
+	0xef, 0x2d, 0x5c, 0x19, 0xb1, 0x10, 0x8c, 0xfa, 0x69, 0xf6, 0x4f, 0x14, 0xd0, 0x62, 0x84, 0x5f,
+	0xe9, 0x7b, 0x47, 0x6f, 0x0d, 0xb6, 0x71, 0xf8, 0x63, 0xea, 0x62, 0x23, 0xc9, 0xac, 0x5f, 0xdd,
+	0xdf, 0x5b, 0x18, 0xb1, 0x0c, 0x8c, 0xf8, 0x5d, 0x4e, 0x03, 0x4d, 0xd7, 0x7a, 0x5e, 0xec, 0x3d,
+	0x24, 0x36, 0x48, 0x7c, 0x04, 0x1b, 0xd7, 0x02, 0x2a, 0x47, 0x7d, 0x1f, 0xb3, 0x65, 0xad, 0xc2,
+	0x74, 0x38, 0x20, 0x00, 0x60, 0x70, 0xe7, 0xc7, 0xc8, 0xa6, 0x47, 0x59, 0xa6, 0xac, 0x9b, 0xf7,
+	0x51, 0x39, 0x22, 0x42, 0xaa, 0x56, 0x1e, 0xc7, 0x34, 0xad, 0xd6, 0xbc, 0x12, 0xe4, 0x5f, 0x60,
+	0x22, 0x9c, 0xdf, 0x2c, 0xa0, 0x73, 0xb5, 0x5e, 0x6f, 0x0d, 0xc7, 0x5b, 0xa9, 0x5a, 0x7c, 0xc9,
+	0x42, 0xb3, 0x3b, 0x5e, 0x94, 0xf4, 0x5d, 0x5f, 0xd8, 0xd3, 0x59, 0x7d, 0x9a, 0xe3, 0xd6, 0x87,
+	0x4a, 0xbb, 0x6b, 0xb0, 0xae, 0xdb, 0xfb, 0x7b, 0x0b, 0xb3, 0x26, 0x0c, 0x52, 0xe2, 0xed, 0x9f,
+	0xb1, 0xd0, 0x3c, 0x07, 0xdd, 0x0a, 0xdb, 0x58, 0xbf, 0xaf, 0xb9, 0x93, 0x67, 0x9d, 0x24, 0x73,
+	0x66, 0x67, 0x4f, 0x43, 0x61, 0xa0, 0x12, 0xce, 0x7f, 0x29, 0xa0, 0xf3, 0x43, 0x78, 0xd8, 0xbf,
+	0x6c, 0xa1, 0xb3, 0xec, 0x92, 0x47, 0x43, 0x01, 0xde, 0xe4, 0xad, 0xf9, 0x91, 0xbc, 0x6b, 0x0e,
+	0x64, 0x8a, 0xe3, 0xa0, 0x85, 0xeb, 0x55, 0xb2, 0x11, 0x2c, 0x65, 0x88, 0x86, 0xcc, 0x0a, 0xd1,
+	0x9a, 0xb2, 0x6b, 0x9f, 0x54, 0x4d, 0x0b, 0x8f, 0xa5, 0xa6, 0xcd, 0x0c, 0xd1, 0x90, 0x59, 0x21,
+	0xe7, 0xcf, 0xa3, 0xa7, 0x0e, 0x60, 0x77, 0xf8, 0xe4, 0x74, 0x3e, 0x8e, 0xce, 0x99, 0x0c, 0xc4,
+	0x18, 0x3b, 0x7c, 0x5e, 0x3b, 0x68, 0x82, 0x4e, 0x1d, 0x31, 0xb1, 0x11, 0xd9, 0xf9, 0xe9, 0x9c,
+	0x8a, 0x81, 0x63, 0x9c, 0xdf, 0xb4, 0xd0, 0xd4, 0x08, 0xe6, 0xf0, 0x05, 0xd3, 0x1c, 0x5e, 0x19,
+	0x30, 0x85, 0x27, 0x83, 0xa6, 0xf0, 0x1b, 0xe3, 0xf5, 0xc6, 0x51, 0x4c, 0xe0, 0xff, 0xa8, 0x80,
+	0x4e, 0x0f, 0x98, 0xcc, 0xed, 0x2d, 0x74, 0xb6, 0x17, 0xb6, 0xc5, 0x26, 0x7e, 0xd3, 0x8d, 0xb7,
+	0x28, 0x8e, 0x7f, 0xde, 0x73, 0xa4, 0x27, 0x1b, 0x19, 0xf8, 0x47, 0x7b, 0x0b, 0x55, 0xc9, 0x24,
+	0x45, 0x00, 0x99, 0x1c, 0xed, 0x1e, 0x9a, 0xda, 0xf4, 0xb0, 0xdf, 0x56, 0x43, 0x70, 0x4c, 0xdd,
+	0xf0, 0x3a, 0xe7, 0xc6, 0x6e, 0x8b, 0xc4, 0x2f, 0x90, 0x52, 0xec, 0x9b, 0x68, 0x86, 0x97, 0x62,
+	0xdf, 0xc4, 0x2e, 0x10, 0xdf, 0x46, 0x34, 0x69, 0xd0, 0xe0, 0x8f, 0xc8, 0xaa, 0x20, 0x5b, 0x8c,
+	0x21, 0xc0, 0x28, 0xe9, 0xfc, 0xb7, 0x02, 0x9a, 0xad, 0xf5, 0x93, 0x2d, 0xa2, 0x63, 0xb5, 0xa8,
+	0xa9, 0x97, 0xd8, 0xf7, 0x63, 0xaf, 0xb3, 0xf3, 0x5c, 0x3e, 0xcb, 0x7a, 0x93, 0xb0, 0xe2, 0xd7,
+	0x81, 0xf2, 0xa0, 0x41, 0x81, 0xc0, 0xc4, 0xd8, 0x11, 0x9a, 0x08, 0xdd, 0x7e, 0xb2, 0x75, 0x95,
+	0x37, 0xde, 0x98, 0xc7, 0xe6, 0xdb, 0xe4, 0x73, 0xae, 0x72, 0x89, 0x52, 0xe5, 0x65, 0x50, 0xe0,
+	0x92, 0xec, 0x4f, 0xa3, 0xca, 0x86, 0x1b, 0x7b, 0x2d, 0x02, 0xad, 0x16, 0xf3, 0x50, 0xe4, 0xea,
+	0x82, 0x1d, 0x97, 0x2c, 0xd5, 0x48, 0x89, 0x00, 0x25, 0xd2, 0x79, 0x0d, 0xcd, 0x9a, 0x77, 0xdc,
+	0x47, 0x98, 0x7d, 0xcf, 0xa0, 0xa2, 0x1b, 0x89, 0x3b, 0xc9, 0x69, 0x4e, 0x50, 0xac, 0xc1, 0x2d,
+	0x20, 0x70, 0xfb, 0xdd, 0x68, 0x6a, 0xb3, 0xef, 0xfb, 0xa4, 0x00, 0x1f, 0x0f, 0xf2, 0x48, 0x79,
+	0x9d, 0xc3, 0x41, 0x52, 0x38, 0x5d, 0x34, 0x97, 0xaa, 0x31, 0x61, 0xd0, 0x8f, 0x71, 0xa4, 0xd5,
+	0x42, 0x32, 0xb8, 0xc3, 0xe1, 0x20, 0x29, 0x08, 0x75, 0xcf, 0x8d, 0xe3, 0x07, 0x61, 0xd4, 0xae,
+	0x16, 0x4c, 0xea, 0x06, 0x87, 0x83, 0xa4, 0x70, 0xfe, 0x71, 0x01, 0x9d, 0x97, 0xf2, 0x1a, 0x51,
+	0xb8, 0xe3, 0xb5, 0x71, 0xc4, 0xe5, 0x7e, 0xc9, 0x42, 0xa7, 0x05, 0xdb, 0x26, 0x6e, 0x45, 0x38,
+	0x51, 0xbb, 0xce, 0x98, 0x63, 0x81, 0xb1, 0x7b, 0x09, 0xef, 0x92, 0xc9, 0x74, 0x8e, 0x78, 0x10,
+	0xdc, 0x49, 0x0b, 0x82, 0x41, 0xd9, 0xb4, 0x46, 0xa2, 0xea, 0xaa, 0x46, 0x85, 0x93, 0xa9, 0x51,
+	0x23, 0x2d, 0x08, 0x06, 0x65, 0x3b, 0xff, 0xa3, 0x84, 0xe6, 0xea, 0x7e, 0x1f, 0xdf, 0x88, 0x30,
+	0x16, 0x16, 0xe2, 0x1a, 0x9a, 0xeb, 0x45, 0x78, 0xc7, 0xc3, 0x0f, 0x9a, 0xd8, 0xc7, 0xad, 0x24,
+	0x8c, 0x78, 0xb7, 0x9d, 0xe7, 0x1d, 0x31, 0xd7, 0x30, 0xd1, 0x90, 0xa6, 0xb7, 0x5f, 0x40, 0xb3,
+	0x6e, 0x8b, 0xdc, 0xa3, 0x4b, 0x0e, 0xac, 0x2b, 0x9f, 0xe0, 0x1c, 0x66, 0x6b, 0x06, 0x16, 0x52,
+	0xd4, 0xf6, 0x0f, 0xa1, 0x6a, 0xdc, 0x72, 0x7d, 0x7c, 0xa7, 0xc7, 0x45, 0x2d, 0x6d, 0xe1, 0xd6,
+	0x76, 0x23, 0xf4, 0x82, 0x84, 0xdf, 0x81, 0x5c, 0xe2, 0x9c, 0xaa, 0xcd, 0x21, 0x74, 0x30, 0x94,
+	0x83, 0xfd, 0xeb, 0x16, 0x7a, 0xa6, 0x17, 0xe1, 0x46, 0x14, 0x76, 0x43, 0xb2, 0x32, 0x0d, 0x18,
+	0xc9, 0xb9, 0xb1, 0xf8, 0xee, 0x98, 0x4a, 0x3c, 0x83, 0x0c, 0xde, 0x27, 0xbf, 0x75, 0x7f, 0x6f,
+	0xe1, 0x99, 0xc6, 0x41, 0x15, 0x80, 0x83, 0xeb, 0x67, 0xff, 0x86, 0x85, 0x2e, 0xf6, 0xc2, 0x38,
+	0x39, 0xe0, 0x13, 0xca, 0x27, 0xfa, 0x09, 0xce, 0xfe, 0xde, 0xc2, 0xc5, 0xc6, 0x81, 0x35, 0x80,
+	0x43, 0x6a, 0xe8, 0xec, 0x4f, 0xa3, 0xd3, 0xda, 0xd8, 0xe3, 0x16, 0xdc, 0xe7, 0xd1, 0x29, 0x31,
+	0x18, 0x94, 0xd2, 0x5d, 0x51, 0x16, 0xff, 0x9a, 0x8e, 0x04, 0x93, 0x96, 0x8c, 0x3b, 0x39, 0x14,
+	0x59, 0xe9, 0xd4, 0xb8, 0x6b, 0x18, 0x58, 0x48, 0x51, 0xdb, 0x2b, 0xe8, 0x0c, 0x87, 0x00, 0xee,
+	0xf9, 0x5e, 0xcb, 0x5d, 0x0a, 0xfb, 0x7c, 0xc8, 0x95, 0xeb, 0xe7, 0xf7, 0xf7, 0x16, 0xce, 0x34,
+	0x06, 0xd1, 0x90, 0x55, 0xc6, 0x5e, 0x45, 0x67, 0xdd, 0x7e, 0x12, 0xca, 0xef, 0xbf, 0x16, 0x10,
+	0x3d, 0xae, 0x4d, 0x87, 0xd6, 0x14, 0x53, 0xf8, 0x6a, 0x19, 0x78, 0xc8, 0x2c, 0x65, 0x37, 0x52,
+	0xdc, 0x9a, 0x98, 0x38, 0x8a, 0xb0, 0x5e, 0x2e, 0x2b, 0xab, 0x47, 0x2d, 0x83, 0x06, 0x32, 0x4b,
+	0xda, 0x3e, 0x9a, 0xed, 0xba, 0x0f, 0xef, 0x04, 0xee, 0x8e, 0xeb, 0xf9, 0x44, 0x48, 0x75, 0xe2,
+	0x10, 0x83, 0x6a, 0x3f, 0xf1, 0xfc, 0x45, 0xe6, 0xe2, 0xb6, 0xb8, 0x12, 0x24, 0xb7, 0x23, 0xe6,
+	0xe6, 0xc2, 0x8e, 0x2e, 0x6b, 0x06, 0x2f, 0x48, 0xf1, 0xb6, 0x6f, 0xa3, 0x73, 0x74, 0x3a, 0x2e,
+	0x87, 0x0f, 0x82, 0x65, 0xec, 0xbb, 0xbb, 0xe2, 0x03, 0x26, 0xe9, 0x07, 0x3c, 0xb9, 0xbf, 0xb7,
+	0x70, 0xae, 0x99, 0x45, 0x00, 0xd9, 0xe5, 0x88, 0x2d, 0xde, 0x44, 0x00, 0xde, 0xf1, 0x62, 0x2f,
+	0x0c, 0x98, 0x2d, 0x7e, 0x4a, 0xd9, 0xe2, 0x9b, 0xc3, 0xc9, 0xe0, 0x20, 0x1e, 0xf6, 0x5f, 0xb5,
+	0xd0, 0xd9, 0xac, 0x69, 0x58, 0xad, 0xe4, 0xb1, 0xaf, 0xa7, 0xa6, 0x16, 0x1b, 0x11, 0x99, 0x8b,
+	0x42, 0x66, 0x25, 0xec, 0xcf, 0x58, 0x68, 0xc6, 0xd5, 0x4c, 0x37, 0x55, 0x94, 0xc7, 0x36, 0xa2,
+	0x1b, 0x83, 0x98, 0x05, 0x55, 0x87, 0x80, 0x21, 0xd1, 0xfe, 0x9a, 0x85, 0xce, 0x65, 0xce, 0xf1,
+	0xea, 0xf4, 0x49, 0xb4, 0x10, 0x1d, 0x24, 0xd9, 0x6b, 0x4e, 0x76, 0x35, 0x88, 0x47, 0x9a, 0xd8,
+	0x9a, 0x84, 0xb3, 0x43, 0x75, 0xe6, 0x92, 0x35, 0xbe, 0x7d, 0x4f, 0xd3, 0xdf, 0x05, 0xe3, 0xfa,
+	0x19, 0x6d, 0x67, 0x14, 0x40, 0x48, 0x8b, 0xb7, 0xbf, 0x68, 0x89, 0xad, 0x51, 0xd6, 0xe8, 0xd4,
+	0x49, 0xd5, 0xc8, 0x56, 0x3b, 0xad, 0xac, 0x50, 0x4a, 0xb8, 0xfd, 0x09, 0x74, 0xc1, 0xdd, 0x08,
+	0xa3, 0x24, 0x73, 0xf2, 0x55, 0x67, 0xe9, 0x34, 0xba, 0xb8, 0xbf, 0xb7, 0x70, 0xa1, 0x36, 0x94,
+	0x0a, 0x0e, 0xe0, 0xe0, 0xfc, 0xf6, 0x04, 0x9a, 0x61, 0x47, 0x70, 0xbe, 0x75, 0xfd, 0x9a, 0x85,
+	0x9e, 0x6e, 0xf5, 0xa3, 0x08, 0x07, 0x49, 0x33, 0xc1, 0xbd, 0xc1, 0x8d, 0xcb, 0x3a, 0xd1, 0x8d,
+	0xeb, 0xd2, 0xfe, 0xde, 0xc2, 0xd3, 0x4b, 0x07, 0xc8, 0x87, 0x03, 0x6b, 0x67, 0xff, 0x0b, 0x0b,
+	0x39, 0x9c, 0xa0, 0xee, 0xb6, 0xb6, 0x3b, 0x51, 0xd8, 0x0f, 0xda, 0x83, 0x1f, 0x51, 0x38, 0xd1,
+	0x8f, 0x78, 0xc7, 0xfe, 0xde, 0x82, 0xb3, 0x74, 0x68, 0x2d, 0xe0, 0x08, 0x35, 0xb5, 0x6f, 0xa0,
+	0xd3, 0x9c, 0xea, 0xda, 0xc3, 0x1e, 0x8e, 0xbc, 0x2e, 0xe6, 0x1b, 0x5e, 0x45, 0x73, 0xdb, 0x4d,
+	0x13, 0xc0, 0x60, 0x19, 0x3b, 0x56, 0xd7, 0x94, 0xa5, 0x3c, 0x6e, 0x0b, 0xb9, 0x39, 0x8e, 0xdf,
+	0x4b, 0x32, 0x03, 0xf6, 0xc0, 0x25, 0xe5, 0x2d, 0x34, 0xcb, 0x0c, 0x24, 0x0d, 0x2f, 0xe8, 0x34,
+	0xc2, 0x80, 0x39, 0x9c, 0x56, 0xea, 0xef, 0x10, 0x1b, 0x7e, 0xd3, 0xc0, 0x3e, 0xda, 0x5b, 0x98,
+	0x11, 0xff, 0xaf, 0xef, 0xf6, 0x30, 0xa4, 0x4a, 0xdb, 0x3f, 0x6b, 0x21, 0x3b, 0x4e, 0x70, 0xaf,
+	0xe1, 0xf7, 0x3b, 0x1e, 0x6f, 0x22, 0xee, 0x3a, 0x9a, 0x83, 0x17, 0xab, 0xc9, 0xb7, 0x7e, 0x81,
+	0x57, 0xd2, 0x6e, 0x0e, 0x48, 0x84, 0x8c, 0x5a, 0x38, 0xdf, 0x98, 0x42, 0x48, 0xcc, 0x25, 0xdc,
+	0x23, 0xce, 0xad, 0x31, 0x4e, 0x58, 0x93, 0xf0, 0xbb, 0x6d, 0xe6, 0xb2, 0x20, 0x80, 0xa0, 0xf0,
+	0xf6, 0x36, 0x2a, 0xf7, 0xdc, 0x7e, 0x8c, 0xf3, 0x39, 0x6d, 0xf0, 0x91, 0xd9, 0x20, 0x1c, 0x99,
+	0xb9, 0x86, 0xfe, 0x0b, 0x4c, 0x86, 0xfd, 0xe3, 0x16, 0x42, 0xd8, 0x1c, 0x4d, 0x63, 0x9b, 0x4d,
+	0xb9, 0x48, 0x35, 0xe0, 0x48, 0x1b, 0xd4, 0x67, 0xc9, 0x45, 0xae, 0x82, 0x81, 0x26, 0xd6, 0x7e,
+	0x80, 0xa6, 0x5c, 0xb1, 0x21, 0x95, 0x4e, 0x62, 0x43, 0xa2, 0x56, 0x14, 0xf1, 0x0b, 0xa4, 0x30,
+	0xfb, 0x27, 0x2c, 0x34, 0x1b, 0xe3, 0x84, 0x77, 0x15, 0x59, 0x16, 0xab, 0xe5, 0x3c, 0x66, 0x44,
+	0xd3, 0xe0, 0xc9, 0x96, 0x77, 0x13, 0x06, 0x29, 0xb9, 0xa2, 0x2a, 0x37, 0xb1, 0xdb, 0xc6, 0x11,
+	0x35, 0xd2, 0x55, 0x27, 0x72, 0xaa, 0x8a, 0xc6, 0x53, 0x56, 0x45, 0x83, 0x41, 0x4a, 0xae, 0xa8,
+	0xca, 0x9a, 0x17, 0x45, 0x21, 0xaf, 0xca, 0x54, 0x4e, 0x55, 0xd1, 0x78, 0xca, 0xaa, 0x68, 0x30,
+	0x48, 0xc9, 0x25, 0xd7, 0xa0, 0x3d, 0x3a, 0xb5, 0xaa, 0x95, 0x3c, 0x3c, 0x67, 0xc4, 0x34, 0xc5,
+	0x3d, 0x66, 0x0c, 0x65, 0xbf, 0x81, 0xcb, 0x20, 0xbe, 0x67, 0x31, 0x4e, 0x56, 0xc3, 0x96, 0xeb,
+	0x2b, 0x3d, 0x6d, 0x65, 0xec, 0x8f, 0x16, 0x0c, 0x99, 0x2b, 0x8d, 0x06, 0x00, 0x5d, 0x9c, 0xf3,
+	0x2f, 0x67, 0xd1, 0xac, 0x58, 0x34, 0xd4, 0x11, 0x8b, 0xd9, 0xbf, 0x87, 0x1c, 0xb1, 0x96, 0x74,
+	0x24, 0x98, 0xb4, 0xa4, 0x30, 0x5b, 0x33, 0xcd, 0x13, 0x96, 0x2c, 0xdc, 0xd4, 0x91, 0x60, 0xd2,
+	0xda, 0x5d, 0x54, 0x26, 0xeb, 0x9a, 0x70, 0x09, 0x1b, 0xb3, 0xdd, 0xd5, 0x5a, 0xa8, 0x59, 0x00,
+	0x09, 0x7b, 0x60, 0x52, 0xe8, 0x15, 0x4e, 0x62, 0xdc, 0xea, 0x54, 0x4b, 0x39, 0xae, 0x45, 0xe6,
+	0x85, 0x11, 0x1b, 0x79, 0x26, 0x0c, 0x52, 0xe2, 0x33, 0x4e, 0x5d, 0xe5, 0x13, 0x3c, 0x75, 0x7d,
+	0x94, 0x84, 0x09, 0x3c, 0x6c, 0xf6, 0xa3, 0xce, 0xf1, 0x4f, 0x77, 0x3c, 0xb0, 0x80, 0x71, 0x01,
+	0xc9, 0x8f, 0x78, 0xa1, 0xa9, 0xe5, 0x95, 0xf9, 0x7f, 0xdd, 0xcb, 0x77, 0x79, 0x95, 0x4a, 0xcb,
+	0xd0, 0x85, 0x76, 0xe0, 0x0c, 0x34, 0xf5, 0xd8, 0xcf, 0x40, 0x44, 0x9f, 0x67, 0x13, 0x44, 0xea,
+	0xf3, 0x95, 0x13, 0xd5, 0xe7, 0x97, 0x0c, 0x61, 0x90, 0x12, 0x4e, 0xeb, 0xc3, 0xe6, 0x9c, 0xac,
+	0x0f, 0x3a, 0xd1, 0xfa, 0x34, 0x0d, 0x61, 0x90, 0x12, 0x3e, 0xfc, 0xe0, 0x3f, 0x7d, 0x32, 0x07,
+	0xff, 0x99, 0x1c, 0x0e, 0xfe, 0x07, 0x9f, 0x89, 0x4e, 0x8d, 0x7b, 0x26, 0xb2, 0x5f, 0x44, 0x76,
+	0x7b, 0x37, 0x70, 0xbb, 0x5e, 0x8b, 0x2f, 0x96, 0x84, 0x8a, 0x9e, 0xb5, 0xa6, 0x94, 0x4e, 0xb8,
+	0x3c, 0x40, 0x01, 0x19, 0xa5, 0xec, 0x04, 0x4d, 0xf5, 0x84, 0xea, 0x3b, 0x97, 0xc7, 0xe8, 0x17,
+	0xaa, 0x30, 0xf3, 0x55, 0xa3, 0x66, 0x77, 0x0e, 0x01, 0x29, 0x89, 0x18, 0xb7, 0xba, 0x5e, 0xd0,
+	0x08, 0xdb, 0x71, 0x03, 0x47, 0xdc, 0xec, 0xd5, 0xc4, 0x49, 0x75, 0x9e, 0xb6, 0x0d, 0x35, 0x65,
+	0xac, 0x65, 0xe0, 0x21, 0xb3, 0x94, 0xfd, 0xf7, 0x2d, 0x54, 0x8d, 0xd8, 0xcf, 0x46, 0x14, 0xd2,
+	0x70, 0x2c, 0xe9, 0x12, 0x58, 0x3d, 0x9d, 0xcb, 0x49, 0x6a, 0x08, 0xf7, 0xfa, 0xd3, 0xc4, 0x84,
+	0x3c, 0x0c, 0x0b, 0x43, 0x6b, 0xe5, 0xfc, 0x77, 0x0b, 0xcd, 0x2f, 0xf9, 0x61, 0xbf, 0x7d, 0xcf,
+	0x4d, 0x5a, 0x5b, 0xcc, 0xa3, 0xcb, 0x7e, 0x01, 0x4d, 0x79, 0x66, 0xa0, 0x98, 0x23, 0xae, 0x2e,
+	0x0e, 0x88, 0x12, 0x93, 0x65, 0xec, 0xaf, 0x5b, 0xe8, 0x34, 0xf3, 0x09, 0x5b, 0x76, 0x13, 0xf7,
+	0xe5, 0x3e, 0x8e, 0x3c, 0x2c, 0xbc, 0xc2, 0xc6, 0x5c, 0x5b, 0xd3, 0x75, 0x15, 0x02, 0x76, 0xd5,
+	0x21, 0x6f, 0x2d, 0x2d, 0x19, 0x06, 0x2b, 0xe3, 0xfc, 0x54, 0x11, 0x3d, 0x39, 0x94, 0x97, 0x7d,
+	0x01, 0x15, 0xbc, 0x36, 0xff, 0x74, 0xc4, 0xf9, 0x16, 0x56, 0xda, 0x50, 0xf0, 0xda, 0xf6, 0x22,
+	0x3d, 0x12, 0x90, 0x56, 0x54, 0x01, 0x70, 0x42, 0x7b, 0xe7, 0x50, 0xd0, 0x28, 0xc8, 0x9d, 0x30,
+	0x8d, 0x83, 0xe1, 0x67, 0x51, 0x7a, 0xc8, 0xa0, 0x21, 0x27, 0xc0, 0xe0, 0xc4, 0x6d, 0x0b, 0xb1,
+	0x0a, 0x92, 0x03, 0x12, 0xdf, 0xd8, 0x21, 0xdf, 0x66, 0x22, 0x9c, 0x59, 0x2d, 0xd5, 0x6f, 0xd0,
+	0xa4, 0xda, 0xeb, 0x68, 0x82, 0x9c, 0x37, 0xc2, 0xf6, 0xb1, 0xf7, 0x71, 0xa6, 0x31, 0x52, 0x1e,
+	0xc0, 0x79, 0x91, 0xb6, 0x8a, 0x70, 0xd2, 0x8f, 0x02, 0xd2, 0xb4, 0x74, 0xe7, 0x9e, 0x62, 0xb5,
+	0x00, 0x09, 0x05, 0x8d, 0xc2, 0xf9, 0x87, 0x05, 0x74, 0x36, 0xab, 0xea, 0x64, 0x83, 0x9c, 0x60,
+	0xb5, 0xe5, 0x66, 0x95, 0x1f, 0xcc, 0xbf, 0x7d, 0xd8, 0x7f, 0xea, 0x46, 0x94, 0xfd, 0x06, 0x2e,
+	0xd7, 0xfe, 0x41, 0xd9, 0x42, 0x85, 0x63, 0xb6, 0x90, 0xe4, 0x9c, 0x6a, 0xa5, 0x4b, 0xa8, 0x14,
+	0x93, 0x9e, 0x2f, 0x9a, 0x37, 0x9b, 0xb4, 0x8f, 0x28, 0x86, 0x50, 0xf4, 0x03, 0x2f, 0xa9, 0x96,
+	0x4c, 0x8a, 0x3b, 0x81, 0x97, 0x00, 0xc5, 0x38, 0x5f, 0x2d, 0xa0, 0x0b, 0xc3, 0x3f, 0x8a, 0x84,
+	0x22, 0xa3, 0x36, 0x39, 0x4d, 0xc6, 0x34, 0x02, 0x8b, 0xb9, 0x83, 0xba, 0x27, 0xd5, 0x86, 0xcb,
+	0x42, 0x92, 0xf2, 0x51, 0x96, 0xa0, 0x18, 0xb4, 0x8a, 0xd8, 0x57, 0xc5, 0xd0, 0xa7, 0xb7, 0xb2,
+	0x6c, 0x32, 0xc9, 0x32, 0x6b, 0x12, 0x03, 0x1a, 0x15, 0x31, 0x17, 0x90, 0xdb, 0xc8, 0xb8, 0xe7,
+	0xca, 0xc8, 0x60, 0x6a, 0x2e, 0xb8, 0x25, 0x80, 0xa0, 0xf0, 0x8e, 0x8f, 0x9e, 0x3d, 0x42, 0x3d,
+	0x73, 0x8a, 0x74, 0x74, 0xbe, 0x6b, 0xa1, 0xf3, 0xdc, 0x53, 0xf7, 0xff, 0x19, 0x97, 0xef, 0xef,
+	0x59, 0xe8, 0xa9, 0x21, 0xdf, 0xfc, 0x18, 0x3c, 0xbf, 0x5f, 0x35, 0x3d, 0xbf, 0xef, 0x8c, 0x3b,
+	0xa4, 0x33, 0xbf, 0x63, 0x88, 0x03, 0x38, 0xa0, 0x39, 0x76, 0x43, 0xbf, 0xe6, 0xf6, 0xd8, 0x85,
+	0xf5, 0xd1, 0x9c, 0x14, 0x48, 0x84, 0x60, 0xca, 0x49, 0x81, 0x14, 0x27, 0x70, 0xe7, 0xef, 0x5a,
+	0xe8, 0xec, 0x52, 0x18, 0xc4, 0x7d, 0x3f, 0xe5, 0xc5, 0xb7, 0x86, 0xce, 0xf0, 0x00, 0xf9, 0x66,
+	0xcf, 0xf7, 0x92, 0x04, 0x47, 0x9a, 0x73, 0xf9, 0x53, 0x9c, 0xcf, 0x99, 0xe6, 0x20, 0x09, 0x64,
+	0x95, 0x23, 0xd6, 0x52, 0x0e, 0x26, 0x02, 0x38, 0xb3, 0x82, 0x69, 0x2d, 0x6d, 0xa6, 0x09, 0x60,
+	0xb0, 0x8c, 0xf3, 0x22, 0x3a, 0xb7, 0x14, 0x06, 0x49, 0xd8, 0x4f, 0x87, 0x76, 0xbf, 0x0f, 0x4d,
+	0x6f, 0x25, 0x49, 0xaf, 0x11, 0x85, 0x0f, 0x3d, 0xcc, 0x96, 0x9c, 0x0a, 0x3b, 0xe2, 0xdf, 0x5c,
+	0x5f, 0x6f, 0x70, 0x30, 0xe8, 0x34, 0xce, 0x57, 0xcb, 0xe8, 0x14, 0xd9, 0x07, 0xda, 0x61, 0x27,
+	0x27, 0x4d, 0xe4, 0x59, 0x54, 0x7e, 0x85, 0xec, 0xe8, 0xe9, 0x59, 0x4b, 0xb7, 0x79, 0x60, 0x38,
+	0x62, 0xe5, 0x9b, 0x7c, 0x85, 0x2b, 0x29, 0xec, 0x3c, 0x3f, 0xe6, 0xee, 0x62, 0x7c, 0xc3, 0x22,
+	0x57, 0x39, 0x58, 0x44, 0xaa, 0x74, 0x9e, 0xe7, 0x50, 0x10, 0x92, 0x49, 0x64, 0xda, 0x66, 0x18,
+	0x75, 0xfb, 0xbe, 0x9b, 0xce, 0xca, 0x70, 0x9d, 0x81, 0x41, 0xe0, 0xc9, 0xaa, 0xe9, 0xf6, 0xbc,
+	0xbb, 0x38, 0x8a, 0x59, 0x80, 0xa2, 0xb1, 0x6a, 0xd6, 0x24, 0x06, 0x34, 0x2a, 0x5a, 0xa6, 0xd3,
+	0x89, 0x70, 0xc7, 0x4d, 0xc2, 0xa8, 0x3a, 0x91, 0x2a, 0x23, 0x31, 0xa0, 0x51, 0xd9, 0x0f, 0x89,
+	0x61, 0x56, 0x78, 0x77, 0x4c, 0xe6, 0xe1, 0xad, 0x26, 0x1d, 0x36, 0x94, 0xfb, 0x8f, 0x04, 0x81,
+	0x12, 0x66, 0x37, 0xd0, 0x2c, 0x71, 0xeb, 0xc5, 0x71, 0x42, 0x82, 0xac, 0xc2, 0x3e, 0xbb, 0x08,
+	0xad, 0xd4, 0x2f, 0x0b, 0x73, 0x38, 0x18, 0xd8, 0x8c, 0x31, 0x90, 0x2a, 0x7f, 0xe1, 0x43, 0x68,
+	0x46, 0xef, 0x88, 0x91, 0x22, 0x75, 0x5f, 0x43, 0xe7, 0x78, 0x97, 0xa6, 0x3c, 0x73, 0xae, 0x22,
+	0xc4, 0xea, 0xac, 0xcd, 0x45, 0xd9, 0xa8, 0x4d, 0x89, 0x01, 0x8d, 0x2a, 0xd5, 0x79, 0x85, 0xa3,
+	0x74, 0x9e, 0xf3, 0x61, 0xc4, 0x43, 0x12, 0x52, 0x1b, 0xa6, 0x75, 0x94, 0x0d, 0xd3, 0xf9, 0x59,
+	0x0b, 0xcd, 0x5c, 0x73, 0x23, 0x7f, 0x97, 0x07, 0x8b, 0xd9, 0x1f, 0x41, 0xe7, 0x5b, 0x61, 0x10,
+	0x53, 0x8f, 0xe8, 0x1d, 0xcc, 0xa1, 0x7a, 0x68, 0xd9, 0x02, 0xe7, 0x78, 0x7e, 0x29, 0x9b, 0x0c,
+	0x86, 0x95, 0x1f, 0x3d, 0x3b, 0xc4, 0xbf, 0x2e, 0x20, 0xcd, 0xee, 0xfd, 0x18, 0x76, 0xc9, 0xc0,
+	0xd8, 0x25, 0xc7, 0xb4, 0xd9, 0x6a, 0x56, 0xfc, 0x61, 0x59, 0x1d, 0x76, 0x52, 0x59, 0x1d, 0x6e,
+	0xe5, 0x26, 0xf1, 0xe0, 0xa4, 0x0e, 0xbf, 0x67, 0xa1, 0xa7, 0x14, 0xf1, 0xe0, 0x7d, 0xd9, 0xe1,
+	0x5b, 0xd5, 0x07, 0x48, 0xd8, 0xbe, 0x2c, 0xc6, 0x7b, 0x53, 0x0b, 0xa9, 0x97, 0x28, 0xd0, 0xe9,
+	0x54, 0x60, 0x6e, 0xf1, 0x98, 0x81, 0xb9, 0xa5, 0x83, 0x03, 0x73, 0x9d, 0x3f, 0x2d, 0xa0, 0x67,
+	0x06, 0xbf, 0x4c, 0x0f, 0xc1, 0x3a, 0xfc, 0xdb, 0xd2, 0x41, 0x5a, 0x85, 0x63, 0x07, 0x69, 0x15,
+	0x8f, 0x12, 0xa4, 0x25, 0x43, 0xa3, 0x4a, 0x27, 0x1e, 0x1a, 0xd5, 0x44, 0xe7, 0x44, 0x44, 0xc4,
+	0xf5, 0x30, 0xe2, 0x91, 0xa9, 0x62, 0x9f, 0x98, 0xaa, 0x3f, 0xc3, 0x8b, 0x9c, 0x83, 0x2c, 0x22,
+	0xc8, 0x2e, 0xeb, 0xfc, 0x5e, 0x11, 0x9d, 0x51, 0x4d, 0x2e, 0x27, 0xb2, 0xfd, 0x3c, 0x2a, 0x25,
+	0xbb, 0x3d, 0xd1, 0xd0, 0xff, 0x9f, 0xa8, 0x0e, 0xb9, 0x92, 0x7c, 0xb4, 0xb7, 0x70, 0x3e, 0xa3,
+	0x08, 0x41, 0x01, 0x2d, 0x64, 0xaf, 0xca, 0x99, 0xc1, 0x5a, 0xff, 0x39, 0x73, 0x24, 0x3f, 0xda,
+	0x5b, 0xc8, 0x48, 0xb4, 0xb5, 0x28, 0x39, 0x99, 0xe3, 0xdd, 0xbe, 0x8f, 0x66, 0x7d, 0x37, 0x4e,
+	0xee, 0xf4, 0xda, 0x6e, 0x82, 0xc9, 0xaa, 0x5f, 0x2d, 0x8e, 0x1c, 0xcc, 0x2b, 0xdd, 0xab, 0x56,
+	0x0d, 0x4e, 0x90, 0xe2, 0x6c, 0xef, 0x20, 0x9b, 0x40, 0xd6, 0x23, 0x37, 0x88, 0xd9, 0x57, 0x79,
+	0x5d, 0x36, 0x6e, 0x47, 0x93, 0x27, 0x8d, 0x64, 0xab, 0x03, 0xdc, 0x20, 0x43, 0x82, 0xfd, 0x0e,
+	0x34, 0x11, 0x61, 0x37, 0x96, 0x9b, 0xbe, 0x9c, 0xfb, 0x40, 0xa1, 0xc0, 0xb1, 0xfa, 0x64, 0x9a,
+	0x38, 0x64, 0x32, 0xfd, 0x81, 0x85, 0x66, 0x55, 0x37, 0x3d, 0x06, 0x8d, 0xbd, 0x6b, 0x6a, 0xec,
+	0x37, 0xf3, 0x5a, 0x0e, 0x87, 0x28, 0xe9, 0x7f, 0x3c, 0xa9, 0x7f, 0x1f, 0x8d, 0x8b, 0xfc, 0x11,
+	0x3d, 0x4c, 0x2e, 0x97, 0x48, 0x64, 0xe3, 0x90, 0x74, 0x70, 0x7c, 0xdc, 0x0b, 0x68, 0xaa, 0xcd,
+	0x35, 0x95, 0x6a, 0xc1, 0xd4, 0x68, 0x85, 0x06, 0x93, 0xa5, 0xd1, 0x8a, 0x32, 0xf6, 0x1d, 0x74,
+	0xbe, 0xc7, 0xad, 0x78, 0xcb, 0xd8, 0x6d, 0xfb, 0x5e, 0x80, 0x85, 0x41, 0x97, 0x79, 0xf7, 0x3d,
+	0x45, 0xf6, 0xed, 0x46, 0x36, 0x09, 0x0c, 0x2b, 0x6b, 0x66, 0xe7, 0x28, 0x1d, 0x21, 0x3b, 0xc7,
+	0x4f, 0xca, 0x6b, 0x13, 0x19, 0x6b, 0xf8, 0xb1, 0xbc, 0xba, 0x32, 0x2b, 0xea, 0x50, 0x0e, 0xa9,
+	0x1a, 0x17, 0x0a, 0x52, 0xfc, 0x70, 0xdb, 0xfc, 0xc4, 0x31, 0x6d, 0xf3, 0x2a, 0xbc, 0x74, 0xf2,
+	0x8d, 0x0c, 0x2f, 0x9d, 0x7a, 0x53, 0x85, 0x97, 0x7e, 0xdd, 0x42, 0x67, 0xdc, 0xc1, 0xac, 0x3b,
+	0xf9, 0x5c, 0x13, 0x65, 0xa4, 0xf3, 0x51, 0xa7, 0xda, 0x0c, 0x24, 0x64, 0x55, 0xc5, 0x79, 0xbd,
+	0x8c, 0xe6, 0xd3, 0x0a, 0xd2, 0xc9, 0x27, 0x0a, 0xf9, 0x8a, 0x85, 0xe6, 0xc5, 0x04, 0x97, 0x9e,
+	0x36, 0xec, 0x20, 0xb9, 0x9a, 0xd3, 0xba, 0xc2, 0x54, 0x3d, 0x99, 0xc4, 0x6e, 0x3d, 0x25, 0x0d,
+	0x06, 0xe4, 0x93, 0xc4, 0x16, 0xf2, 0xfe, 0xf4, 0x58, 0x59, 0x43, 0xe8, 0x51, 0xbd, 0xa6, 0x58,
+	0x80, 0xce, 0x8f, 0xe4, 0x96, 0x42, 0x52, 0x89, 0xcf, 0x29, 0xd8, 0x38, 0x43, 0x5b, 0x50, 0xba,
+	0xbc, 0x04, 0xc5, 0xa0, 0x09, 0xb6, 0x7f, 0x8a, 0xde, 0x9c, 0xca, 0x91, 0x20, 0x3c, 0x9c, 0x3e,
+	0x92, 0xf7, 0x52, 0xa4, 0x7c, 0xd6, 0xa4, 0x8e, 0xa8, 0xa1, 0x62, 0x30, 0x2a, 0xe1, 0x3c, 0x8f,
+	0x64, 0x50, 0x12, 0x59, 0x59, 0x69, 0x58, 0x52, 0xc3, 0x4d, 0xb6, 0xf8, 0x10, 0x94, 0x2b, 0xeb,
+	0x75, 0x81, 0x00, 0x45, 0xe3, 0xfc, 0x2d, 0x0b, 0x55, 0x6f, 0xb8, 0x09, 0x7e, 0xe0, 0xee, 0xd6,
+	0x1a, 0x2b, 0x29, 0xab, 0xca, 0x15, 0x54, 0x21, 0x16, 0x13, 0x90, 0x61, 0xa5, 0x1a, 0x37, 0x62,
+	0x57, 0xa1, 0x08, 0x50, 0x34, 0xa4, 0x40, 0x27, 0xea, 0xb5, 0x58, 0x81, 0xd4, 0x81, 0xec, 0x06,
+	0x34, 0x96, 0x78, 0x01, 0x49, 0x43, 0xe2, 0x56, 0x92, 0x16, 0x17, 0x90, 0x0a, 0x93, 0x59, 0x5f,
+	0xe2, 0xfc, 0x25, 0x85, 0xf3, 0x49, 0x34, 0x7b, 0x23, 0x72, 0x7b, 0x5b, 0x5e, 0x82, 0xb9, 0xc9,
+	0xe6, 0x9d, 0x68, 0xd2, 0x6d, 0xb7, 0xb3, 0x92, 0x43, 0xd6, 0x18, 0x18, 0x04, 0xfe, 0x48, 0xd6,
+	0x19, 0xe7, 0x9f, 0x59, 0xc8, 0x56, 0xee, 0x37, 0x5e, 0xd0, 0x59, 0x23, 0xa6, 0x5c, 0x72, 0x10,
+	0xde, 0xa2, 0xd0, 0xac, 0x83, 0xf0, 0x4d, 0x89, 0x01, 0x8d, 0x8a, 0x38, 0xb0, 0xb0, 0x5f, 0x77,
+	0xe5, 0x39, 0x7f, 0x7c, 0x07, 0x96, 0x24, 0x12, 0x75, 0xe2, 0xd6, 0x2d, 0x25, 0x01, 0x74, 0x71,
+	0xa4, 0xa9, 0x56, 0x82, 0x4d, 0xbf, 0xff, 0xb0, 0xbd, 0xa1, 0x9a, 0xaa, 0x17, 0x85, 0x9b, 0x9e,
+	0x8f, 0xd3, 0x4d, 0xd5, 0x60, 0x60, 0x10, 0xf8, 0xa3, 0x35, 0xd5, 0x12, 0x7a, 0x42, 0x48, 0x48,
+	0x19, 0x2a, 0x8e, 0x2e, 0x89, 0xdc, 0x24, 0x9c, 0x5d, 0x89, 0x13, 0x2f, 0x5c, 0xc6, 0x71, 0x42,
+	0xf6, 0x7a, 0xb2, 0x23, 0xf4, 0xfd, 0xa3, 0x44, 0x54, 0x2e, 0xa3, 0x79, 0xee, 0x63, 0xd3, 0xdf,
+	0x88, 0xb9, 0x51, 0xa4, 0x60, 0xa6, 0xdf, 0x5c, 0x4a, 0xe1, 0x61, 0xa0, 0x04, 0xe1, 0xc2, 0x9d,
+	0x6d, 0x14, 0x97, 0xa2, 0xc9, 0xa5, 0x99, 0xc2, 0xc3, 0x40, 0x09, 0xa2, 0x13, 0xb8, 0x6d, 0xb6,
+	0x4a, 0xb8, 0xbe, 0x82, 0xb3, 0x13, 0x58, 0x85, 0xe9, 0x04, 0xb5, 0x2c, 0x02, 0xc8, 0x2e, 0xe7,
+	0x7c, 0xab, 0x88, 0xce, 0xd0, 0x76, 0x49, 0xcd, 0xc8, 0x2f, 0x0e, 0x0b, 0xaf, 0x1e, 0x73, 0x35,
+	0xa4, 0xb2, 0x8e, 0x11, 0x5c, 0xfd, 0x97, 0x2d, 0x34, 0xd7, 0x36, 0xbb, 0x2e, 0x9f, 0x1b, 0x81,
+	0xac, 0x41, 0xc1, 0x9c, 0xc5, 0x53, 0x40, 0x48, 0xcb, 0xb7, 0x7f, 0xda, 0x42, 0x73, 0x66, 0x35,
+	0xc5, 0x06, 0x79, 0x02, 0x8d, 0x24, 0xa3, 0xbb, 0x4c, 0x78, 0x0c, 0xe9, 0x2a, 0x38, 0xbf, 0x53,
+	0xe0, 0x5d, 0x7a, 0x12, 0xb1, 0xc3, 0xf6, 0x03, 0x54, 0x49, 0xfc, 0x98, 0x01, 0xab, 0xc5, 0x3c,
+	0xce, 0xfd, 0xeb, 0xab, 0x4d, 0xca, 0x4e, 0x53, 0xcd, 0x39, 0x24, 0x06, 0x25, 0x8b, 0x0a, 0xe6,
+	0xeb, 0x73, 0x4e, 0x06, 0x07, 0xb1, 0xf0, 0x6b, 0x82, 0x97, 0x1a, 0x52, 0xb0, 0x90, 0xe5, 0x7c,
+	0xad, 0x80, 0x2a, 0x2f, 0x86, 0x62, 0x75, 0xfb, 0x44, 0x0e, 0xa6, 0x3c, 0xb9, 0xf5, 0x48, 0xbd,
+	0x4f, 0x1d, 0x24, 0x5f, 0x30, 0x0c, 0x79, 0x4f, 0x6b, 0xbc, 0x17, 0x69, 0xe6, 0x6e, 0xc2, 0xea,
+	0xc5, 0x70, 0x63, 0xa8, 0x61, 0xee, 0x15, 0x72, 0x98, 0x8e, 0xfb, 0x7e, 0x92, 0x4f, 0x7c, 0xab,
+	0xfc, 0x70, 0x9e, 0xda, 0x8d, 0x0d, 0x09, 0xfa, 0x3f, 0x70, 0x41, 0xce, 0xbf, 0xb3, 0xd0, 0x5c,
+	0x8a, 0xce, 0xfe, 0x01, 0x34, 0xc1, 0x82, 0x5c, 0xf9, 0x70, 0x7b, 0xab, 0xb4, 0x82, 0x50, 0xe8,
+	0xa3, 0xbd, 0x05, 0x52, 0x84, 0x11, 0x33, 0x10, 0xf0, 0x02, 0xdc, 0xd8, 0x9a, 0xb8, 0xa4, 0x1d,
+	0x33, 0x8c, 0xad, 0x0c, 0x01, 0x8a, 0x86, 0x14, 0xf0, 0xc3, 0x0e, 0x4b, 0x73, 0x5c, 0x2d, 0x9a,
+	0x05, 0x56, 0x05, 0x02, 0x14, 0x0d, 0x51, 0x06, 0xee, 0xc7, 0x61, 0x40, 0x75, 0x97, 0x92, 0xa9,
+	0x0c, 0xbc, 0xd8, 0xbc, 0x7d, 0x8b, 0xc0, 0x41, 0x52, 0x38, 0xdf, 0x2a, 0xa3, 0x53, 0x2f, 0xb9,
+	0xbb, 0x38, 0x48, 0xdc, 0xd1, 0x95, 0x01, 0x62, 0x6d, 0xec, 0x51, 0x2f, 0x15, 0xed, 0x6c, 0xac,
+	0xac, 0x8d, 0x0a, 0x05, 0x3a, 0x9d, 0xda, 0x73, 0xd8, 0x4e, 0x97, 0xb5, 0x5b, 0x2c, 0xa5, 0xf0,
+	0x30, 0x50, 0x82, 0x78, 0x32, 0xf1, 0x24, 0x46, 0xb5, 0x56, 0x2b, 0xec, 0x07, 0x6c, 0xd7, 0x61,
+	0x5f, 0x2c, 0x8d, 0x34, 0x6b, 0x03, 0x14, 0x90, 0x51, 0x8a, 0xc4, 0x7c, 0xb6, 0x28, 0x67, 0x7e,
+	0x64, 0xd7, 0x39, 0x32, 0xb3, 0x8d, 0x8c, 0xf9, 0x5c, 0x1a, 0x42, 0x07, 0x43, 0x39, 0x90, 0x9a,
+	0xc6, 0x49, 0x18, 0xb9, 0x1d, 0xac, 0xf3, 0x9d, 0x30, 0x6b, 0xda, 0x1c, 0xa0, 0x80, 0x8c, 0x52,
+	0xf6, 0x6b, 0x7a, 0x66, 0xb4, 0xc9, 0x3c, 0xac, 0xd3, 0xbc, 0xf7, 0x8f, 0x98, 0x1b, 0x8d, 0x44,
+	0xb6, 0xc7, 0xad, 0xb0, 0x87, 0xe3, 0xea, 0x54, 0x1e, 0x66, 0x18, 0x2e, 0x9d, 0x5a, 0x5c, 0x35,
+	0xbb, 0x38, 0x95, 0x00, 0x5c, 0x12, 0x19, 0xd2, 0x7e, 0x18, 0x6e, 0x6f, 0xb8, 0xad, 0x6d, 0x7a,
+	0x74, 0x9d, 0xd2, 0xac, 0x55, 0x1c, 0x0e, 0x92, 0xc2, 0xf9, 0xad, 0x02, 0x9a, 0xd1, 0xd9, 0x1e,
+	0x61, 0x6f, 0xf8, 0x71, 0x0b, 0xcd, 0x90, 0x29, 0x17, 0x85, 0xbe, 0x4a, 0xe3, 0x35, 0xbe, 0x9e,
+	0x49, 0x58, 0x2d, 0xe3, 0xc4, 0xf5, 0x7c, 0x75, 0x04, 0x59, 0xd2, 0xc4, 0x80, 0x21, 0xd4, 0xfe,
+	0x82, 0x85, 0xe6, 0x54, 0x0c, 0x81, 0x32, 0x55, 0xe7, 0x5a, 0x11, 0xb9, 0xd5, 0x5e, 0x33, 0x25,
+	0x41, 0x5a, 0xb4, 0xb3, 0x81, 0xe6, 0xd3, 0x63, 0x83, 0x34, 0x65, 0xcf, 0xe5, 0x2b, 0x43, 0x51,
+	0x35, 0x25, 0x89, 0xf7, 0x06, 0x8a, 0x21, 0x7d, 0xd5, 0x75, 0xa3, 0x8e, 0x17, 0xb8, 0x3e, 0x6d,
+	0xc5, 0xa2, 0xb6, 0x21, 0x70, 0x38, 0x48, 0x0a, 0xe7, 0x37, 0x2c, 0x64, 0xbf, 0x44, 0x02, 0x62,
+	0x4c, 0x05, 0xed, 0x83, 0x68, 0x46, 0xcf, 0x81, 0x9f, 0xce, 0xc7, 0xa6, 0xa7, 0xcc, 0x07, 0x83,
+	0x92, 0x94, 0xd4, 0xb3, 0xfa, 0xa7, 0x2f, 0x09, 0xf4, 0x47, 0x00, 0xc0, 0xa0, 0x54, 0x25, 0x99,
+	0x0b, 0x7d, 0xb5, 0x98, 0x55, 0x92, 0xe1, 0xc0, 0xa0, 0x74, 0xde, 0x8b, 0x66, 0xd6, 0xdc, 0xa0,
+	0x83, 0xdb, 0x7c, 0x33, 0x3f, 0x3c, 0x05, 0xca, 0x1f, 0x95, 0xd0, 0xb4, 0x66, 0xc6, 0x39, 0x79,
+	0x7b, 0x87, 0x91, 0x8e, 0xb4, 0x98, 0x63, 0x3a, 0xd2, 0x8f, 0x22, 0x44, 0xbc, 0x91, 0xe3, 0xad,
+	0x63, 0x26, 0x3a, 0xa5, 0xae, 0x65, 0xd7, 0x25, 0x07, 0xd0, 0xb8, 0x29, 0xff, 0x9d, 0xf2, 0x01,
+	0x99, 0xca, 0x5f, 0xb7, 0x34, 0x9d, 0x65, 0x22, 0x0f, 0x7f, 0x45, 0xad, 0x63, 0x16, 0x85, 0x0e,
+	0xc3, 0x3c, 0x01, 0x0e, 0x52, 0x6d, 0xd6, 0xd1, 0x14, 0xd1, 0x18, 0xba, 0xf8, 0x58, 0x29, 0x49,
+	0xa9, 0xb3, 0x2b, 0xf0, 0xf2, 0x20, 0x39, 0x5d, 0x78, 0x1e, 0x9d, 0x32, 0xaa, 0x30, 0xd2, 0x1d,
+	0x78, 0x88, 0x32, 0x6d, 0x85, 0xc7, 0xb9, 0x90, 0x26, 0x7d, 0xe1, 0x6b, 0x99, 0x46, 0x65, 0x5f,
+	0xb0, 0xbb, 0x65, 0x86, 0x73, 0xfe, 0x29, 0x42, 0xdc, 0x05, 0xef, 0x08, 0x6b, 0xae, 0xee, 0x27,
+	0x52, 0x38, 0x86, 0x9f, 0xc8, 0x8b, 0x68, 0xc6, 0x0b, 0xbc, 0xc4, 0x73, 0x7d, 0x6a, 0x07, 0xae,
+	0x16, 0x8d, 0xe0, 0xbb, 0x99, 0x15, 0x0d, 0x97, 0xc1, 0xc7, 0x28, 0x6b, 0xbf, 0x8c, 0xca, 0x74,
+	0x8b, 0xad, 0x96, 0x0e, 0x51, 0x7a, 0x87, 0xf9, 0x09, 0x52, 0x17, 0x51, 0x16, 0x91, 0xcf, 0x38,
+	0xd1, 0x23, 0x31, 0xbb, 0x65, 0x97, 0x66, 0xb0, 0x6a, 0xd9, 0x54, 0x72, 0x9a, 0x29, 0x3c, 0x0c,
+	0x94, 0x20, 0x5c, 0x36, 0x5d, 0xcf, 0xef, 0x47, 0x58, 0x71, 0x99, 0x30, 0xb9, 0x5c, 0x4f, 0xe1,
+	0x61, 0xa0, 0x84, 0xbd, 0x89, 0x66, 0x38, 0x8c, 0x79, 0x0c, 0x4c, 0x1e, 0xf3, 0x2b, 0xe9, 0x8d,
+	0xe9, 0x75, 0x8d, 0x13, 0x18, 0x7c, 0xed, 0x3e, 0x3a, 0xed, 0x05, 0xad, 0x30, 0x20, 0xd7, 0xa8,
+	0xde, 0x0e, 0x56, 0xe1, 0xf0, 0xc7, 0x11, 0x46, 0x13, 0x89, 0xac, 0xa4, 0xd9, 0xc1, 0xa0, 0x04,
+	0x12, 0x0e, 0x72, 0x4e, 0x73, 0x6e, 0xb8, 0x16, 0x45, 0x61, 0xc4, 0x64, 0x57, 0x8e, 0x29, 0x9b,
+	0x9a, 0x1a, 0x96, 0xb2, 0x58, 0x42, 0xb6, 0x24, 0xfb, 0x55, 0x34, 0xd5, 0xe3, 0xf6, 0x1b, 0x1e,
+	0xf4, 0xb0, 0x9a, 0x47, 0xe6, 0x4e, 0x61, 0x13, 0xd2, 0x12, 0xd1, 0x70, 0x08, 0x48, 0x79, 0x24,
+	0xeb, 0xf5, 0x50, 0xe7, 0x90, 0xe9, 0x63, 0xb6, 0xc0, 0x53, 0xc7, 0x72, 0x25, 0x79, 0x17, 0xaa,
+	0xb4, 0x71, 0x0f, 0x07, 0xed, 0xf8, 0x76, 0x50, 0x9d, 0x51, 0x6f, 0x9e, 0x2c, 0x0b, 0x20, 0x28,
+	0x3c, 0x7d, 0xb3, 0xc5, 0x4d, 0xbd, 0x79, 0x52, 0x3d, 0x95, 0x87, 0x4a, 0x9b, 0x7e, 0x49, 0x85,
+	0xe5, 0x92, 0x4b, 0x43, 0x61, 0x40, 0x3a, 0x0d, 0xea, 0xc1, 0x9a, 0xdb, 0x0d, 0x0d, 0x8c, 0x18,
+	0x5b, 0xc7, 0xd5, 0x1d, 0x79, 0xd8, 0x1c, 0xd2, 0x21, 0x60, 0x48, 0x74, 0xbe, 0x3d, 0x8f, 0x66,
+	0xcd, 0xbe, 0xb7, 0x3f, 0x8d, 0x50, 0x2f, 0x0a, 0xbb, 0x38, 0xd9, 0xc2, 0x32, 0x46, 0xfd, 0xd6,
+	0xb8, 0x29, 0x2f, 0x05, 0x3f, 0xe1, 0x42, 0x4d, 0xd6, 0x7e, 0x05, 0x05, 0x4d, 0xa2, 0x1d, 0xa1,
+	0xc9, 0x6d, 0xa6, 0x08, 0x72, 0xbd, 0xf8, 0xa5, 0x5c, 0x74, 0x7e, 0x2e, 0x99, 0x06, 0x57, 0x73,
+	0x10, 0x08, 0x41, 0xf6, 0x06, 0x2a, 0x3e, 0xc0, 0x1b, 0xf9, 0xe4, 0x5b, 0xbb, 0x87, 0xf9, 0xf1,
+	0xbd, 0x3e, 0x49, 0x1c, 0x37, 0xef, 0xe1, 0x0d, 0x20, 0xcc, 0xc9, 0x77, 0xb5, 0x99, 0x8f, 0x58,
+	0xb5, 0x94, 0xc7, 0x77, 0x19, 0x3e, 0x84, 0xec, 0xbb, 0x38, 0x08, 0x84, 0x20, 0xfb, 0x55, 0x54,
+	0x79, 0xe0, 0xee, 0xe0, 0xcd, 0x28, 0x0c, 0x92, 0x6a, 0x39, 0x0f, 0x23, 0xc6, 0x3d, 0xc1, 0x8e,
+	0xcb, 0xa5, 0x13, 0x4e, 0x02, 0x41, 0x89, 0xb3, 0x77, 0xd0, 0x54, 0x40, 0x32, 0xc5, 0xf8, 0x5e,
+	0x2b, 0x9f, 0x48, 0xdc, 0x5b, 0x9c, 0x1b, 0x97, 0x4c, 0x95, 0x18, 0x01, 0x03, 0x29, 0x8b, 0xf4,
+	0xe5, 0xfd, 0x70, 0x23, 0x1f, 0x6f, 0xc4, 0x17, 0x43, 0xa3, 0x2f, 0x89, 0x99, 0x85, 0x30, 0x27,
+	0x73, 0xa4, 0x25, 0x9d, 0xc6, 0xab, 0x53, 0x79, 0xcc, 0x91, 0xb4, 0x13, 0x3a, 0x9b, 0x23, 0x0a,
+	0x0a, 0x9a, 0x44, 0xd2, 0xb6, 0x1d, 0x7e, 0xa9, 0x52, 0xad, 0xe4, 0xd1, 0xb6, 0xe6, 0x15, 0x0d,
+	0x6b, 0x5b, 0x01, 0x03, 0x29, 0x8b, 0xc8, 0xf5, 0xf8, 0xfd, 0x41, 0x3e, 0xfb, 0x8e, 0x79, 0xdf,
+	0xc1, 0xe4, 0x0a, 0x18, 0x48, 0x59, 0xa4, 0xbd, 0xe3, 0xed, 0xdd, 0x07, 0xae, 0xbf, 0x4d, 0x22,
+	0x5b, 0xa7, 0x73, 0x79, 0x69, 0x6b, 0x7b, 0xf7, 0x1e, 0xe3, 0xa7, 0xb7, 0xb7, 0x82, 0x82, 0x26,
+	0x91, 0x44, 0xe0, 0x4c, 0xc7, 0x7e, 0x58, 0xef, 0x47, 0x01, 0xb8, 0x09, 0xae, 0x9e, 0xca, 0xe3,
+	0x8d, 0xa2, 0xe6, 0xea, 0x6d, 0xc1, 0x50, 0x24, 0xce, 0xa6, 0xf1, 0xcd, 0x0a, 0x0c, 0xba, 0x50,
+	0x52, 0x89, 0x0a, 0xb3, 0xfa, 0x10, 0x6f, 0xdb, 0xd9, 0x3c, 0xb2, 0xa1, 0x9a, 0x4b, 0xff, 0x92,
+	0x60, 0xce, 0x66, 0xb5, 0xfc, 0x09, 0x4a, 0x2c, 0xe9, 0x89, 0xb0, 0x87, 0x83, 0x18, 0xbb, 0x51,
+	0x6b, 0xab, 0x3a, 0x97, 0x47, 0x4f, 0xdc, 0xee, 0xe1, 0xa0, 0x49, 0xf9, 0xe9, 0x3d, 0xa1, 0xa0,
+	0xa0, 0x49, 0x24, 0xb3, 0x3b, 0x7e, 0xc5, 0xaf, 0xce, 0xe7, 0x31, 0xbb, 0x9b, 0x2f, 0xaf, 0xea,
+	0xb3, 0xbb, 0xf9, 0xf2, 0x2a, 0x10, 0xe6, 0x24, 0x2b, 0x6f, 0x2f, 0x0a, 0x37, 0x70, 0xf5, 0x74,
+	0x1e, 0xe6, 0x90, 0x06, 0x61, 0xc5, 0xe5, 0xb0, 0x04, 0x12, 0x04, 0x00, 0x4c, 0x84, 0xfd, 0x73,
+	0x96, 0x8c, 0xd0, 0x9f, 0xc9, 0xc3, 0xb3, 0xdc, 0xec, 0x51, 0x1e, 0xb0, 0xcf, 0xce, 0x93, 0xdf,
+	0x27, 0xa3, 0x8b, 0x28, 0xf0, 0x2f, 0xfe, 0xe1, 0x42, 0x15, 0x07, 0xad, 0xb0, 0xed, 0x05, 0x9d,
+	0x2b, 0xc4, 0x42, 0xbb, 0x08, 0xee, 0x03, 0x71, 0x94, 0xe7, 0x75, 0x22, 0xaf, 0x1f, 0x69, 0x2c,
+	0x0e, 0x3b, 0x0f, 0xce, 0xe8, 0xe7, 0xc1, 0xff, 0x6a, 0xa1, 0xb3, 0x66, 0x6d, 0xf8, 0x55, 0xe3,
+	0xc9, 0x7b, 0xf0, 0x3e, 0x34, 0x0c, 0xff, 0x77, 0xf3, 0x9f, 0x23, 0x43, 0x63, 0x5d, 0xbe, 0x6b,
+	0xa1, 0x6a, 0x56, 0x81, 0xc7, 0xe0, 0x36, 0xf7, 0xc0, 0x74, 0x9b, 0x83, 0xfc, 0xbf, 0x7a, 0x88,
+	0x03, 0xdd, 0xf3, 0xe8, 0xfc, 0x90, 0x75, 0xe4, 0x08, 0xb6, 0xa9, 0x9f, 0x2f, 0x65, 0x37, 0x18,
+	0xf5, 0xc3, 0xfb, 0x9c, 0x95, 0xa1, 0x8b, 0xde, 0xcd, 0x4b, 0x17, 0x4d, 0x7d, 0xdc, 0x41, 0x3a,
+	0xe9, 0xab, 0x4a, 0x77, 0x2b, 0xe4, 0x91, 0x56, 0x21, 0x33, 0x58, 0x60, 0x88, 0x0e, 0xf7, 0x69,
+	0x4d, 0x8f, 0x62, 0x0a, 0xea, 0x7a, 0x3e, 0x7a, 0x54, 0x4a, 0xfa, 0x30, 0x7d, 0xea, 0xd3, 0xda,
+	0x9e, 0x5f, 0xca, 0x43, 0x7e, 0xb6, 0x07, 0xc2, 0xb0, 0xbd, 0xdf, 0xf9, 0xde, 0x04, 0x9a, 0x31,
+	0xee, 0xc3, 0x0e, 0x37, 0xf6, 0x48, 0x03, 0x67, 0x61, 0x14, 0x03, 0x27, 0x31, 0xcb, 0x6b, 0x7e,
+	0x6d, 0xe2, 0x4a, 0x76, 0x25, 0x37, 0xfb, 0x9e, 0x32, 0xef, 0x6a, 0xc0, 0x18, 0x0c, 0xa1, 0x23,
+	0xb8, 0xb9, 0x13, 0x2b, 0x19, 0xb3, 0x23, 0x95, 0x4d, 0x2b, 0x99, 0x61, 0x19, 0x22, 0x11, 0x28,
+	0xf2, 0x11, 0x1e, 0xee, 0xef, 0xa8, 0x22, 0x50, 0x24, 0x06, 0x34, 0x2a, 0xe2, 0x45, 0x4c, 0x2c,
+	0x2d, 0xb8, 0xcd, 0x93, 0x16, 0xca, 0x9b, 0x92, 0xeb, 0x14, 0x0a, 0x1c, 0x4b, 0x8c, 0xd8, 0xba,
+	0x7d, 0x84, 0xe7, 0x22, 0x3c, 0xab, 0x8c, 0x62, 0x0a, 0x07, 0x06, 0x25, 0xa9, 0x3a, 0x8e, 0xa2,
+	0x30, 0xaa, 0x56, 0xcc, 0xaa, 0x53, 0x1b, 0x07, 0x30, 0x1c, 0xbd, 0xb9, 0x4b, 0x99, 0x3f, 0xa8,
+	0xd6, 0x59, 0xd6, 0x6e, 0xee, 0x52, 0x78, 0x18, 0x28, 0x41, 0x3e, 0x86, 0xbb, 0x6a, 0x4e, 0xb3,
+	0xf0, 0xe2, 0x21, 0x4e, 0x96, 0x9f, 0xd3, 0x4d, 0xbb, 0x39, 0xee, 0xc5, 0x6c, 0xd4, 0x8e, 0x60,
+	0xdb, 0x7d, 0x11, 0xd9, 0x83, 0x16, 0x0f, 0x9e, 0x8c, 0x41, 0x5e, 0xe0, 0x0d, 0x1a, 0x4b, 0x20,
+	0xa3, 0xd4, 0x78, 0x16, 0xdd, 0xfb, 0x62, 0xe2, 0xf1, 0xcc, 0x5a, 0xc7, 0xb1, 0xe4, 0xbe, 0x03,
+	0x4d, 0xb0, 0x0c, 0x66, 0xdc, 0x94, 0x2b, 0x5b, 0x9f, 0xf1, 0x04, 0x8e, 0x75, 0x3e, 0x6f, 0xa1,
+	0x59, 0xf3, 0x80, 0x97, 0xb7, 0xf3, 0x93, 0xfd, 0x76, 0x34, 0x99, 0xf0, 0x58, 0xb1, 0x22, 0xbd,
+	0x2a, 0xa2, 0xeb, 0x2d, 0x0f, 0xff, 0x02, 0x81, 0x23, 0x3e, 0x52, 0xd9, 0x2b, 0xe4, 0x28, 0x3e,
+	0x52, 0x7f, 0x7d, 0x02, 0x9d, 0xb9, 0xd5, 0xf1, 0x82, 0xf4, 0x83, 0x0f, 0x59, 0xef, 0x0f, 0x5b,
+	0x23, 0xbf, 0x3f, 0x2c, 0x33, 0x13, 0xf1, 0xd7, 0x7d, 0xb3, 0x33, 0x13, 0x71, 0x24, 0x98, 0xb4,
+	0xf6, 0x1f, 0x58, 0xe8, 0x69, 0xe5, 0xc0, 0xc4, 0xa1, 0x35, 0xed, 0xf5, 0x4d, 0xb6, 0xec, 0xc5,
+	0x63, 0x6e, 0x32, 0x83, 0x1f, 0xbf, 0x58, 0x3b, 0x40, 0x2a, 0x9b, 0x16, 0x6f, 0xe3, 0x5f, 0xf0,
+	0xf4, 0x41, 0xa4, 0x70, 0x60, 0xf5, 0xed, 0x3f, 0x87, 0xe6, 0x8c, 0x0f, 0x96, 0x1e, 0x5d, 0xd4,
+	0x13, 0xa9, 0x69, 0xa2, 0x20, 0x4d, 0x6b, 0xff, 0x8e, 0x85, 0xaa, 0xec, 0xbe, 0x2d, 0xa3, 0x69,
+	0x98, 0x17, 0x6b, 0x98, 0x7f, 0xd3, 0x2c, 0x0d, 0x91, 0xc8, 0x9a, 0x45, 0xb9, 0x03, 0x0c, 0x21,
+	0x83, 0xa1, 0x55, 0xbe, 0x70, 0x1b, 0xbd, 0xf5, 0xd0, 0x76, 0x1f, 0xe9, 0x55, 0xd3, 0x97, 0xd0,
+	0x33, 0x07, 0xd6, 0x76, 0xa4, 0x25, 0xe6, 0x9b, 0x16, 0x9a, 0xd1, 0xd3, 0xcd, 0x53, 0xe7, 0xd2,
+	0x70, 0x1b, 0x07, 0x77, 0x22, 0x3f, 0x9d, 0x42, 0x7d, 0x9d, 0xc2, 0x61, 0x15, 0x24, 0x05, 0xa1,
+	0x6e, 0xf9, 0x1e, 0x0e, 0x92, 0x95, 0x81, 0x14, 0xea, 0x4b, 0x0c, 0xbe, 0x0c, 0x92, 0x82, 0xde,
+	0xb9, 0xd2, 0xff, 0x59, 0xe0, 0xe5, 0xc0, 0x9d, 0xab, 0x86, 0x03, 0x83, 0x92, 0x78, 0x73, 0x71,
+	0x37, 0x84, 0x92, 0xf2, 0xe6, 0x32, 0xdd, 0x06, 0x9c, 0x5f, 0x28, 0xa0, 0xb3, 0xec, 0x53, 0x52,
+	0xcb, 0xc6, 0x49, 0x7e, 0xd2, 0x4f, 0x5a, 0x68, 0x4e, 0xaf, 0x29, 0xb1, 0x0c, 0x14, 0x73, 0xcf,
+	0xb2, 0x4e, 0x67, 0xce, 0x92, 0x29, 0x06, 0xd2, 0x72, 0x8f, 0xd4, 0x48, 0xbf, 0x62, 0xa1, 0x0a,
+	0x3b, 0xb2, 0x91, 0x12, 0x66, 0xa4, 0x6b, 0x6a, 0x43, 0xa9, 0x35, 0x56, 0xb2, 0xc2, 0x94, 0x2f,
+	0xa1, 0xd2, 0xb6, 0x17, 0x88, 0xb6, 0x91, 0xda, 0xdf, 0x4b, 0x5e, 0xd0, 0x06, 0x8a, 0x91, 0xfa,
+	0x61, 0x71, 0xa8, 0x7e, 0x78, 0x05, 0x55, 0x64, 0x58, 0x07, 0xd7, 0xb2, 0x54, 0xb4, 0xb1, 0x40,
+	0x80, 0xa2, 0x71, 0xfe, 0x67, 0x11, 0xcd, 0xa7, 0xcd, 0x14, 0x23, 0xfa, 0x31, 0x7b, 0x41, 0x1b,
+	0x3f, 0x4c, 0xef, 0x4f, 0x2b, 0x04, 0x08, 0x0c, 0xa7, 0x36, 0xb1, 0xe2, 0x01, 0x9b, 0xd8, 0x0d,
+	0x34, 0xe5, 0xbb, 0x41, 0xa7, 0xaf, 0xf4, 0xc3, 0x77, 0xc9, 0x33, 0x21, 0x87, 0x93, 0xb8, 0x3a,
+	0x55, 0x59, 0x5a, 0x5a, 0xa0, 0x40, 0x16, 0x26, 0x6d, 0x40, 0xa7, 0x21, 0xf5, 0xdc, 0x2a, 0x9b,
+	0x6d, 0x70, 0x57, 0x20, 0x40, 0xd1, 0x98, 0xb1, 0xde, 0x13, 0x6f, 0x6c, 0xac, 0xf7, 0xe4, 0x78,
+	0xb1, 0xde, 0x64, 0x92, 0x79, 0x54, 0x57, 0xe2, 0x0f, 0x6f, 0x6a, 0x2e, 0x3e, 0x2b, 0x1c, 0x0e,
+	0x92, 0xc2, 0xf9, 0x45, 0x0b, 0xcd, 0xd2, 0xac, 0x9f, 0xea, 0x8e, 0xf3, 0x03, 0x32, 0xce, 0x8e,
+	0x75, 0xfd, 0x33, 0x66, 0x9c, 0xdd, 0xa3, 0xbd, 0x85, 0x69, 0x5a, 0x22, 0x15, 0x76, 0xf7, 0x31,
+	0xee, 0x18, 0x41, 0xea, 0x51, 0x2d, 0x8c, 0x7c, 0x6f, 0xaf, 0x9a, 0x49, 0x30, 0x01, 0xc5, 0xcf,
+	0xf9, 0x14, 0x9a, 0xd1, 0x53, 0x5a, 0x11, 0x7f, 0xb9, 0x1e, 0x79, 0xef, 0xca, 0x48, 0x7d, 0x28,
+	0xfd, 0xe5, 0x1a, 0x0a, 0x05, 0x3a, 0x1d, 0x2d, 0x16, 0xaa, 0x62, 0x29, 0x37, 0xbb, 0x46, 0xa8,
+	0x17, 0x53, 0x3f, 0x9c, 0x00, 0x21, 0x95, 0x1d, 0xf2, 0x48, 0x17, 0xf2, 0x13, 0xcc, 0xaa, 0xc8,
+	0x2c, 0x47, 0x34, 0xd3, 0xef, 0x04, 0x5b, 0x31, 0x1f, 0xed, 0x1d, 0x64, 0x99, 0x62, 0xa5, 0xe8,
+	0x9b, 0xe6, 0x19, 0xa9, 0xda, 0x72, 0x7f, 0xd3, 0x3c, 0x43, 0xc6, 0x1b, 0xf7, 0xa6, 0x79, 0x56,
+	0x65, 0xfe, 0xcf, 0x7a, 0xd3, 0xfc, 0x23, 0x68, 0xd4, 0xb7, 0xec, 0xb4, 0x23, 0x84, 0x75, 0xe0,
+	0x11, 0xe2, 0x6f, 0x17, 0x50, 0x85, 0xda, 0x56, 0x49, 0xd4, 0xca, 0x28, 0xab, 0xf3, 0x3b, 0xd1,
+	0x64, 0x6c, 0x8c, 0x76, 0x49, 0x2a, 0x46, 0xba, 0xc0, 0xdb, 0x3f, 0xa2, 0x9d, 0x11, 0x99, 0x9e,
+	0xbc, 0x96, 0xd3, 0x6d, 0x21, 0x8b, 0x0a, 0x39, 0xf0, 0x60, 0xf8, 0x0c, 0x2a, 0x26, 0x7e, 0xcc,
+	0x83, 0x2f, 0x65, 0x66, 0x18, 0xe2, 0xe1, 0x4d, 0xe0, 0xc6, 0xa2, 0x56, 0x3e, 0x74, 0x51, 0xfb,
+	0x7b, 0xa2, 0xb5, 0x48, 0x50, 0x10, 0x61, 0xdd, 0x97, 0xea, 0x89, 0x64, 0x4d, 0x34, 0x13, 0x02,
+	0x27, 0x2e, 0xc8, 0xc4, 0x14, 0x16, 0x8a, 0x6d, 0xf7, 0xad, 0x5a, 0x0a, 0xac, 0xad, 0xb0, 0x4d,
+	0x5c, 0x90, 0xe5, 0x87, 0x30, 0x10, 0xf0, 0x02, 0xf6, 0x43, 0x34, 0xc9, 0x62, 0x5c, 0xe2, 0x93,
+	0x69, 0x30, 0xd9, 0x57, 0xec, 0x77, 0x0c, 0x42, 0x1c, 0x59, 0x83, 0x36, 0xc2, 0xf6, 0x6e, 0x3a,
+	0x27, 0x56, 0x3d, 0x6c, 0xef, 0x02, 0xc5, 0x8c, 0xd8, 0x62, 0xff, 0xb1, 0x80, 0xa6, 0x35, 0x63,
+	0xbe, 0x8d, 0x51, 0x69, 0x2b, 0x49, 0x7a, 0x55, 0x2b, 0x8f, 0xbd, 0x50, 0x76, 0x45, 0x7d, 0x8a,
+	0x54, 0x92, 0xfc, 0x07, 0x94, 0x3d, 0x11, 0xd3, 0x89, 0x7a, 0xc2, 0x98, 0x9d, 0x87, 0x18, 0x32,
+	0x3f, 0x98, 0x18, 0xf2, 0x1f, 0x50, 0xf6, 0xa4, 0x2d, 0xf8, 0x26, 0x29, 0xe2, 0x84, 0x65, 0x5b,
+	0xf0, 0xed, 0x35, 0x06, 0x49, 0x41, 0xc6, 0x4b, 0xd4, 0x63, 0x43, 0xb1, 0xac, 0xc6, 0x0b, 0x34,
+	0x9a, 0x40, 0xe0, 0xf6, 0xf3, 0xea, 0xa8, 0x5d, 0x36, 0x06, 0xcc, 0xe4, 0xf0, 0x3d, 0x5a, 0x1e,
+	0xc0, 0x7f, 0xbb, 0x84, 0xe6, 0xd3, 0x1e, 0x03, 0x79, 0x07, 0x8d, 0x11, 0xef, 0xd7, 0x59, 0xd7,
+	0x78, 0xb5, 0xab, 0x5a, 0xcc, 0xe3, 0x42, 0xd3, 0x7c, 0x09, 0x4c, 0x7b, 0x06, 0xc8, 0x80, 0x43,
+	0x4a, 0xb6, 0x6e, 0x9b, 0x28, 0x0d, 0xb7, 0x4d, 0x8c, 0x36, 0x60, 0xf5, 0xa9, 0x37, 0xf1, 0x78,
+	0xa7, 0x1e, 0x31, 0xdc, 0x47, 0x6e, 0xd0, 0xc1, 0xb4, 0xcd, 0xab, 0x93, 0xf9, 0x1a, 0xee, 0x41,
+	0x72, 0x26, 0x59, 0x2d, 0x78, 0xbe, 0x40, 0x09, 0x03, 0x4d, 0xb2, 0xf3, 0x33, 0x45, 0x74, 0x69,
+	0xd0, 0xe2, 0xff, 0x06, 0x3f, 0xd7, 0xf6, 0x6a, 0xea, 0xb9, 0x36, 0xc8, 0xe3, 0xb9, 0xb6, 0xf4,
+	0x6d, 0xc6, 0x90, 0x67, 0xdb, 0x3e, 0x67, 0x0d, 0xbe, 0xdb, 0x76, 0x27, 0xa7, 0x77, 0xdb, 0x52,
+	0x55, 0x38, 0xf8, 0xfd, 0xb6, 0x5f, 0x2d, 0xa2, 0xea, 0xb0, 0xbb, 0x98, 0x51, 0x66, 0xfb, 0xd7,
+	0x06, 0x27, 0x32, 0x6b, 0xd5, 0x4f, 0xe4, 0x7d, 0x4f, 0x34, 0xfe, 0xd4, 0x2e, 0x1e, 0x71, 0x6a,
+	0x97, 0x46, 0x99, 0xda, 0xe5, 0xc7, 0x3a, 0xb5, 0x9d, 0xaf, 0x58, 0x7a, 0xbf, 0x99, 0x53, 0x91,
+	0x2c, 0xbd, 0xf4, 0x3c, 0xc2, 0x7b, 0x4d, 0x0d, 0x7f, 0x02, 0x04, 0x86, 0x23, 0x7b, 0x07, 0x96,
+	0x07, 0x78, 0xb9, 0x77, 0x5c, 0x0b, 0xda, 0x40, 0xe0, 0xf6, 0x55, 0x92, 0xec, 0x12, 0xf7, 0x52,
+	0xc9, 0x81, 0x4a, 0xe4, 0x58, 0x91, 0xb1, 0x6b, 0x50, 0x5a, 0xe7, 0x15, 0x34, 0x34, 0xb5, 0xad,
+	0xfd, 0x5e, 0x23, 0x03, 0xcd, 0xd3, 0xa9, 0x0c, 0x34, 0x33, 0xb2, 0x80, 0x4a, 0x3b, 0x63, 0x64,
+	0x72, 0x2c, 0x0f, 0xc9, 0xe4, 0xf8, 0x5e, 0x34, 0xe2, 0x3b, 0xc4, 0xce, 0x35, 0x64, 0x43, 0xe8,
+	0xfb, 0x24, 0x6a, 0xe4, 0x9e, 0x17, 0xb4, 0xc3, 0x07, 0xf4, 0x94, 0x76, 0x05, 0x55, 0x22, 0x9e,
+	0x93, 0x39, 0xe6, 0x0a, 0xae, 0x9c, 0x38, 0x22, 0x59, 0x73, 0x0c, 0x8a, 0x86, 0xc4, 0x24, 0x4e,
+	0xf2, 0x04, 0xe2, 0x8f, 0xe1, 0x2a, 0x7d, 0xdb, 0xb8, 0x4a, 0x5f, 0xc9, 0x25, 0xef, 0xf9, 0xd0,
+	0x80, 0xbb, 0x38, 0x95, 0x09, 0xeb, 0xa5, 0x7c, 0xc4, 0x1d, 0x9c, 0x06, 0xeb, 0xd7, 0xcb, 0x68,
+	0x2e, 0x95, 0x90, 0x3d, 0xf5, 0x50, 0xba, 0xf5, 0xc6, 0x3c, 0x94, 0x1e, 0x1b, 0x8f, 0xe5, 0xe7,
+	0x97, 0x3e, 0xe3, 0xcf, 0xde, 0xcd, 0x1f, 0x35, 0xb1, 0xc9, 0xcf, 0x0d, 0x49, 0x6c, 0x52, 0x3e,
+	0xa9, 0xc4, 0x26, 0xe7, 0x47, 0x4a, 0x6a, 0xf2, 0x1f, 0x2c, 0xf4, 0xe4, 0xd0, 0x27, 0x05, 0xe8,
+	0xd3, 0x60, 0x91, 0x89, 0xe5, 0x6b, 0x45, 0xce, 0x8f, 0xc4, 0xc8, 0x58, 0xaf, 0x14, 0x02, 0xd2,
+	0xe2, 0x49, 0x86, 0x34, 0xba, 0x15, 0x90, 0x55, 0x93, 0x2c, 0xf5, 0x6c, 0x9d, 0x9d, 0xe7, 0xc1,
+	0x56, 0x12, 0x0e, 0x06, 0x95, 0xf3, 0x75, 0x0b, 0x55, 0x87, 0x3d, 0x14, 0x75, 0x04, 0x83, 0xd3,
+	0xff, 0x9f, 0x4a, 0x26, 0xb6, 0x30, 0x90, 0x4c, 0x2c, 0xe5, 0x16, 0xc0, 0xc9, 0xf5, 0x1b, 0xf9,
+	0xe2, 0x21, 0xb9, 0xb2, 0x7e, 0xb7, 0x88, 0xe6, 0x79, 0x15, 0x95, 0xad, 0xf0, 0x83, 0xc6, 0x06,
+	0xf4, 0xb6, 0xd4, 0x06, 0x74, 0x36, 0x4d, 0xff, 0x67, 0xf9, 0xcf, 0xde, 0x5c, 0xf9, 0xcf, 0xbe,
+	0x5e, 0x42, 0xe7, 0x78, 0x1f, 0x29, 0xdd, 0x83, 0x36, 0xa8, 0x8f, 0xe6, 0x23, 0xb9, 0xc5, 0xf0,
+	0x68, 0x37, 0x6b, 0xe4, 0x4f, 0xa4, 0xd1, 0x02, 0x90, 0xe2, 0x03, 0x03, 0x9c, 0xed, 0x87, 0xe8,
+	0x6c, 0xd7, 0x0d, 0xfa, 0xae, 0x4f, 0x0d, 0xcb, 0x4a, 0xe2, 0xe8, 0x66, 0x64, 0xf6, 0x6a, 0x41,
+	0x06, 0x2f, 0xc8, 0x94, 0x60, 0x77, 0xd1, 0x42, 0x12, 0x26, 0xae, 0xaf, 0x15, 0x91, 0x2d, 0xa1,
+	0x65, 0x16, 0x2b, 0xd6, 0x9f, 0xdd, 0xdf, 0x5b, 0x58, 0x58, 0x3f, 0x98, 0x14, 0x0e, 0xe3, 0x75,
+	0xa2, 0x41, 0x7e, 0xeb, 0xc4, 0xa5, 0x44, 0x24, 0x2d, 0xd4, 0xde, 0x8f, 0xad, 0xd4, 0x2f, 0x33,
+	0x77, 0x12, 0x13, 0xf7, 0x28, 0x03, 0x06, 0x03, 0x1c, 0x9c, 0x7f, 0x5b, 0x96, 0x43, 0xc4, 0x7c,
+	0xb5, 0x8b, 0x3c, 0x05, 0x35, 0xa0, 0x48, 0xdc, 0xcb, 0xf9, 0x79, 0x30, 0x99, 0x84, 0xfa, 0x64,
+	0xf3, 0xca, 0xfd, 0xb4, 0x9e, 0xcf, 0x8d, 0x29, 0x07, 0x9b, 0x27, 0xf0, 0xd0, 0xd9, 0xa8, 0xa9,
+	0xdd, 0x94, 0xc2, 0x52, 0x7a, 0x0c, 0x0a, 0xcb, 0xd7, 0x1f, 0xb7, 0x26, 0x30, 0x72, 0x8a, 0xb3,
+	0xdc, 0x73, 0xdd, 0x39, 0x9f, 0x2b, 0xa2, 0xcb, 0x47, 0xed, 0xaa, 0x37, 0x61, 0x62, 0xd5, 0xd8,
+	0x48, 0xac, 0xfa, 0x98, 0xd4, 0xe8, 0x13, 0xc9, 0xb1, 0xfa, 0xd7, 0x4a, 0xe8, 0xc9, 0x81, 0x8e,
+	0x10, 0xed, 0x75, 0xa4, 0x2b, 0xb7, 0x49, 0x72, 0xcc, 0x12, 0x2f, 0xb1, 0x2b, 0x5d, 0x64, 0xb2,
+	0xc9, 0xc0, 0x8f, 0xf6, 0x16, 0x4e, 0xab, 0xd7, 0x6a, 0x38, 0x10, 0x44, 0x21, 0xfb, 0x32, 0x31,
+	0x11, 0x53, 0xac, 0x30, 0x11, 0xf3, 0x40, 0x62, 0x06, 0x03, 0x89, 0xb5, 0x5f, 0xd3, 0xce, 0xa5,
+	0xa5, 0x93, 0x7a, 0x94, 0xe9, 0xa0, 0xab, 0x92, 0x8f, 0xa3, 0xa9, 0x58, 0x3c, 0xc8, 0xce, 0xe6,
+	0xe6, 0xfb, 0x8f, 0xe8, 0x6a, 0x4d, 0xee, 0xc5, 0xc4, 0xeb, 0xec, 0xec, 0xfb, 0xc4, 0x2f, 0x90,
+	0x2c, 0x89, 0xab, 0x03, 0xbf, 0x92, 0x62, 0x93, 0x0a, 0x0d, 0x5e, 0x47, 0xd9, 0x89, 0xba, 0x55,
+	0x9a, 0xcc, 0x43, 0xdd, 0x96, 0x29, 0xfd, 0x18, 0x53, 0x66, 0x46, 0x4a, 0x5f, 0x50, 0x91, 0xa4,
+	0xce, 0xd3, 0x7c, 0x8c, 0x3c, 0x06, 0x9f, 0xf3, 0xfb, 0xa6, 0xcf, 0xf9, 0xb5, 0x5c, 0xf6, 0x83,
+	0x21, 0x6e, 0xe6, 0xf7, 0xd1, 0x8c, 0xfe, 0x18, 0x27, 0x79, 0xf2, 0x4d, 0xee, 0x67, 0xd6, 0x38,
+	0x4f, 0xbe, 0x89, 0x1d, 0x4f, 0xed, 0x75, 0xce, 0xdf, 0xa9, 0xc8, 0x56, 0xa4, 0x46, 0x1a, 0x7d,
+	0xe4, 0x5b, 0x07, 0x8e, 0x7c, 0x7d, 0xe0, 0x15, 0xf2, 0x1f, 0x78, 0x2f, 0xa3, 0x29, 0xb1, 0x24,
+	0x72, 0xed, 0xfd, 0x59, 0x8d, 0xfd, 0x62, 0x2b, 0x8c, 0xf0, 0xe2, 0x8e, 0x31, 0x5d, 0xa8, 0xb1,
+	0x45, 0x39, 0x1c, 0x71, 0x28, 0x48, 0x36, 0xf6, 0xab, 0x68, 0xfa, 0x41, 0x18, 0x6d, 0xfb, 0xa1,
+	0x4b, 0xb2, 0x11, 0x56, 0x51, 0x1e, 0xd7, 0x4c, 0xd2, 0xc5, 0x87, 0x85, 0x34, 0xdd, 0x53, 0xfc,
+	0x41, 0x17, 0x66, 0xd7, 0xd0, 0x5c, 0xd7, 0x0b, 0x00, 0xbb, 0x6d, 0xb9, 0x4b, 0xb1, 0x2b, 0x25,
+	0x79, 0x96, 0x5c, 0x33, 0xd1, 0x90, 0xa6, 0xa7, 0x17, 0x39, 0x91, 0x61, 0x56, 0xe3, 0xd1, 0x59,
+	0x8d, 0xf1, 0x07, 0xa3, 0x69, 0xaa, 0x63, 0xc9, 0xd5, 0x4c, 0x38, 0xa4, 0x64, 0x93, 0x0b, 0xe2,
+	0x98, 0xbf, 0x3e, 0x99, 0x4f, 0xc0, 0xa5, 0x3c, 0x19, 0x30, 0xa6, 0xaa, 0x2b, 0x05, 0x04, 0xa4,
+	0x40, 0xf2, 0x58, 0x99, 0xb0, 0x13, 0xde, 0xf4, 0xe2, 0x24, 0x8c, 0x76, 0x59, 0x58, 0xf6, 0x84,
+	0x7a, 0xac, 0x0c, 0x32, 0xf0, 0x90, 0x59, 0x8a, 0x9c, 0xa5, 0xe8, 0x23, 0xb7, 0xcc, 0x0b, 0x5c,
+	0x73, 0x9c, 0xa6, 0xf3, 0x8f, 0xbc, 0x4e, 0x44, 0xff, 0x1e, 0x94, 0x70, 0x78, 0x6a, 0x8c, 0x84,
+	0xc3, 0x4d, 0x74, 0x2e, 0x8d, 0xa2, 0xaf, 0xd0, 0x55, 0x67, 0xcc, 0x2d, 0xb4, 0x91, 0x45, 0x04,
+	0xd9, 0x65, 0x49, 0x66, 0x92, 0x08, 0x53, 0xab, 0x42, 0x4d, 0xc4, 0xeb, 0x8f, 0x9c, 0x99, 0x04,
+	0x04, 0x03, 0x50, 0xbc, 0x48, 0xbf, 0xbb, 0xe6, 0x9b, 0xf0, 0xf9, 0x69, 0x1a, 0xb2, 0xef, 0x87,
+	0xbc, 0x0e, 0xe9, 0xfc, 0xf3, 0x79, 0x74, 0xca, 0x30, 0x76, 0x12, 0x13, 0x36, 0x7d, 0x96, 0x8f,
+	0xae, 0x56, 0x53, 0x6a, 0x45, 0x65, 0x8d, 0xc3, 0x70, 0xe4, 0xd1, 0xd0, 0xb9, 0x9e, 0xe1, 0xd7,
+	0x24, 0x16, 0xf2, 0x31, 0x2f, 0x41, 0x4d, 0x67, 0x29, 0x35, 0x99, 0x4d, 0x78, 0x0c, 0x69, 0xe9,
+	0x64, 0x3d, 0xe0, 0x39, 0x8a, 0x7c, 0x1c, 0x51, 0x6a, 0xae, 0xe4, 0x49, 0x16, 0x4b, 0x26, 0x1a,
+	0xd2, 0xf4, 0xa4, 0x87, 0xe9, 0xd7, 0x1d, 0xf3, 0xf0, 0x48, 0x7b, 0xb8, 0x26, 0x18, 0x80, 0xe2,
+	0x65, 0xbf, 0x80, 0x66, 0xf9, 0x53, 0xe0, 0x8d, 0xb0, 0x7d, 0xd3, 0x8d, 0x85, 0xd7, 0x9c, 0x34,
+	0x89, 0x2c, 0x19, 0x58, 0x48, 0x51, 0xd3, 0x6f, 0x53, 0xef, 0xad, 0x53, 0x06, 0xcc, 0xf4, 0xa0,
+	0xbe, 0xcd, 0x44, 0x43, 0x9a, 0x9e, 0xdd, 0xd1, 0xf3, 0x6d, 0x68, 0x32, 0x7d, 0x47, 0x3f, 0xb0,
+	0x15, 0xd5, 0xd0, 0x5c, 0x9f, 0x5a, 0x64, 0xda, 0x02, 0xc9, 0xe7, 0xa3, 0x14, 0x78, 0xc7, 0x44,
+	0x43, 0x9a, 0x9e, 0x38, 0x9a, 0x47, 0x64, 0xb1, 0x95, 0x0c, 0x58, 0xb8, 0x86, 0x74, 0x34, 0x07,
+	0x1d, 0x09, 0x26, 0x2d, 0x79, 0x41, 0x48, 0x3d, 0xd8, 0x2a, 0x18, 0xb0, 0xf8, 0x0d, 0xf9, 0x82,
+	0x50, 0x2d, 0x4d, 0x00, 0x83, 0x65, 0xec, 0xbf, 0x80, 0xe6, 0xb5, 0x96, 0xa0, 0x3e, 0x93, 0xfc,
+	0x51, 0x4d, 0x6a, 0x3b, 0x59, 0x4a, 0xe1, 0x60, 0x80, 0xda, 0xfe, 0x10, 0x9a, 0x6d, 0x85, 0xbe,
+	0x4f, 0xd7, 0x38, 0x1a, 0x1d, 0xc3, 0x5f, 0xcf, 0x64, 0xef, 0x8c, 0x1a, 0x18, 0x48, 0x51, 0x92,
+	0x70, 0x8c, 0x70, 0x83, 0xa8, 0x57, 0xb8, 0x7d, 0x03, 0x07, 0x98, 0x6b, 0x1c, 0xa7, 0xcc, 0x7c,
+	0x6a, 0xb7, 0x07, 0x28, 0x20, 0xa3, 0x14, 0x7d, 0xc9, 0x4f, 0x4b, 0x8a, 0x3c, 0x9b, 0xc7, 0x63,
+	0xeb, 0x69, 0xfb, 0xe1, 0xa1, 0x19, 0x91, 0x23, 0x34, 0xc1, 0xbc, 0xc5, 0xf3, 0x79, 0x46, 0x53,
+	0xbc, 0x4d, 0x6c, 0x5e, 0xb2, 0x30, 0x28, 0x70, 0x49, 0xf6, 0xa7, 0x51, 0x65, 0xc3, 0xef, 0xe3,
+	0x1b, 0x11, 0xc6, 0x41, 0x75, 0x3e, 0x8f, 0x7d, 0xb1, 0x2e, 0xd8, 0x71, 0xc9, 0xea, 0xb6, 0x59,
+	0x20, 0x40, 0x89, 0xb4, 0xdf, 0x81, 0xa6, 0x6f, 0x36, 0x6a, 0x72, 0x14, 0x9e, 0xa6, 0xbd, 0x5f,
+	0x22, 0x45, 0x40, 0x47, 0x90, 0x19, 0x26, 0xd5, 0x37, 0xdb, 0xf4, 0xbe, 0xce, 0xd0, 0xc6, 0x08,
+	0x35, 0x0d, 0x1f, 0x80, 0x66, 0xf5, 0x4c, 0x8a, 0x9a, 0xc3, 0x41, 0x52, 0x90, 0x84, 0xdb, 0x7c,
+	0xbf, 0xa0, 0x6b, 0xd3, 0xd9, 0xe3, 0x25, 0xdc, 0x06, 0xc5, 0x02, 0x74, 0x7e, 0xd4, 0x6f, 0x33,
+	0x0a, 0xbb, 0x61, 0x82, 0xaf, 0xf7, 0x7d, 0xbf, 0x7a, 0x8e, 0xae, 0x9b, 0xca, 0x6f, 0x53, 0xa1,
+	0x40, 0xa7, 0xb3, 0xdf, 0x2f, 0x62, 0xe5, 0x9e, 0x30, 0x1c, 0x59, 0x65, 0xac, 0x9c, 0x54, 0xba,
+	0x87, 0xe4, 0x02, 0x3b, 0x7f, 0x48, 0x90, 0xda, 0x06, 0xba, 0x20, 0x34, 0xbe, 0xc1, 0x49, 0x52,
+	0xad, 0x1a, 0x86, 0xa8, 0x0b, 0xf7, 0x86, 0x52, 0xc2, 0x01, 0x5c, 0x48, 0x50, 0xb8, 0xeb, 0x6f,
+	0x54, 0x9f, 0xcc, 0x43, 0x75, 0xad, 0xad, 0xd6, 0xf9, 0x88, 0xa2, 0x41, 0xe1, 0xb5, 0xd5, 0x3a,
+	0x10, 0xe6, 0xb6, 0x87, 0x4a, 0xae, 0xbf, 0x11, 0x57, 0x2f, 0x5c, 0x2a, 0xe6, 0x29, 0x44, 0x19,
+	0x0f, 0x56, 0xeb, 0xc4, 0x78, 0xe0, 0x6f, 0xc4, 0xf6, 0x8f, 0x6a, 0x27, 0x9b, 0xa7, 0x72, 0x7c,
+	0xc5, 0xdb, 0x34, 0x5f, 0x0f, 0x3d, 0xfc, 0x7c, 0xb6, 0x20, 0x2f, 0x44, 0xe5, 0x43, 0xea, 0x9f,
+	0xd2, 0xe7, 0xaf, 0x95, 0x47, 0xf6, 0x03, 0x6d, 0xfe, 0x72, 0xed, 0xe6, 0xd4, 0xd0, 0xd9, 0xdb,
+	0x93, 0x2b, 0x56, 0x2e, 0x6f, 0x32, 0x99, 0x8f, 0xc4, 0xb3, 0xc3, 0xbb, 0xb9, 0x5e, 0x39, 0x7f,
+	0x3a, 0x27, 0x2d, 0xba, 0xa9, 0x08, 0xae, 0x08, 0x95, 0xbd, 0x38, 0xf1, 0xc2, 0x1c, 0x73, 0x38,
+	0x9b, 0x12, 0x58, 0x92, 0x00, 0x8a, 0x00, 0x26, 0x8a, 0xc8, 0x0c, 0x48, 0xd0, 0x50, 0xb5, 0x90,
+	0x87, 0xcc, 0x8c, 0xf8, 0x23, 0x26, 0x93, 0x22, 0x80, 0x89, 0xb2, 0xef, 0xb3, 0x39, 0x55, 0xcc,
+	0xa3, 0xaf, 0x6b, 0xab, 0xf5, 0x94, 0x3c, 0x73, 0x6e, 0xdd, 0x47, 0xc5, 0xb8, 0xeb, 0x55, 0x4b,
+	0x79, 0xc8, 0x6a, 0xae, 0xad, 0x64, 0xc9, 0x6a, 0xae, 0xad, 0x00, 0x11, 0x42, 0x5d, 0xd3, 0xdc,
+	0xee, 0x86, 0x1b, 0xc7, 0x6e, 0x5b, 0x1a, 0x87, 0xc6, 0x74, 0x4d, 0xab, 0x49, 0x7e, 0x29, 0xd1,
+	0xf4, 0x2a, 0x42, 0x61, 0x41, 0x93, 0x4c, 0x62, 0xca, 0xdd, 0x5e, 0x6f, 0x0d, 0x73, 0x3d, 0x70,
+	0xec, 0x49, 0x5e, 0x63, 0xcc, 0x52, 0x35, 0xa0, 0x56, 0x22, 0x8e, 0x02, 0x21, 0x90, 0xc8, 0x4e,
+	0x22, 0x17, 0x6f, 0x7a, 0xdb, 0xd5, 0xc9, 0x3c, 0x64, 0xaf, 0x33, 0x66, 0x59, 0xb2, 0x39, 0x0a,
+	0x84, 0x40, 0x92, 0x3f, 0xec, 0x54, 0xd7, 0x0d, 0x5c, 0x99, 0xc1, 0x32, 0x9f, 0xd4, 0xae, 0x7a,
+	0x4e, 0x4c, 0xa5, 0xa0, 0xae, 0xe9, 0x82, 0xc0, 0x94, 0x4b, 0x1e, 0x5e, 0x23, 0xcc, 0xbc, 0x87,
+	0xfc, 0x24, 0x38, 0xee, 0x83, 0xa8, 0x94, 0x57, 0xaa, 0x0d, 0xe8, 0xe2, 0xc2, 0x30, 0xc0, 0xa5,
+	0xd9, 0xbf, 0x6c, 0xa1, 0x49, 0x96, 0x5f, 0x83, 0xe8, 0xc3, 0xe4, 0xdb, 0x3f, 0x99, 0xcb, 0xfa,
+	0x6e, 0x8a, 0xe6, 0xb9, 0x3f, 0x78, 0x50, 0xc0, 0xbb, 0x64, 0xa8, 0x2a, 0x83, 0x1e, 0x98, 0xfd,
+	0x43, 0xd4, 0x8e, 0x68, 0xde, 0x5d, 0x57, 0x7c, 0x12, 0xb3, 0x6f, 0xea, 0x9a, 0xf7, 0x5a, 0x0a,
+	0x07, 0x03, 0xd4, 0x74, 0xba, 0x75, 0xe4, 0x5b, 0x15, 0xd5, 0x99, 0x3c, 0xa6, 0xdb, 0xb0, 0xb7,
+	0x2f, 0xd8, 0x74, 0x53, 0x58, 0xd0, 0x24, 0xdb, 0xaf, 0x21, 0x14, 0x27, 0x5e, 0x6b, 0xdb, 0x0b,
+	0x44, 0x34, 0xf5, 0xd8, 0x4b, 0x0d, 0x97, 0xde, 0x94, 0x6c, 0x59, 0x05, 0xd4, 0x6f, 0xd0, 0x44,
+	0x92, 0x67, 0x05, 0xb7, 0xc3, 0xa0, 0x53, 0x9d, 0xcd, 0xc3, 0x3a, 0x35, 0x98, 0xc5, 0x96, 0x39,
+	0x74, 0x13, 0x38, 0x50, 0x39, 0x64, 0x8e, 0xb7, 0xd8, 0xbb, 0xab, 0xd5, 0xb9, 0x3c, 0xe6, 0x78,
+	0xe6, 0x23, 0xae, 0x6c, 0x8e, 0x73, 0x14, 0x08, 0x81, 0x64, 0x66, 0xb5, 0xe8, 0x1b, 0xb5, 0xd5,
+	0xf9, 0x3c, 0x66, 0x56, 0xd6, 0x7b, 0xb7, 0x7c, 0xdb, 0xa6, 0x18, 0xe0, 0xd2, 0xc8, 0x1b, 0x9e,
+	0xfa, 0xa8, 0x1f, 0x29, 0x5f, 0xcd, 0x9f, 0x14, 0x11, 0x22, 0xbc, 0x31, 0x7b, 0x3e, 0xa4, 0x2b,
+	0x43, 0x1e, 0xac, 0xbc, 0x5f, 0x01, 0x41, 0x2a, 0x72, 0x42, 0x86, 0x49, 0x74, 0x48, 0xaa, 0xe3,
+	0x64, 0x2b, 0xff, 0x27, 0x47, 0xa6, 0x58, 0xc6, 0xe4, 0x64, 0x0b, 0xa8, 0x00, 0x92, 0x74, 0x30,
+	0x15, 0x90, 0x71, 0x67, 0xdc, 0xc5, 0x47, 0xb4, 0xd9, 0x22, 0x77, 0x16, 0x4d, 0x3d, 0x64, 0x9b,
+	0x76, 0x21, 0xbd, 0xf0, 0xba, 0x85, 0x66, 0x74, 0xd2, 0x8c, 0x6e, 0xfa, 0x61, 0xbd, 0x9b, 0xf2,
+	0x6c, 0x0f, 0xbd, 0xc7, 0xff, 0xb3, 0x85, 0x10, 0x31, 0xaf, 0xf5, 0xbb, 0x5d, 0x72, 0x46, 0x95,
+	0xe9, 0x34, 0xac, 0x23, 0xa7, 0xd3, 0x28, 0x8c, 0x98, 0x4e, 0xa3, 0x38, 0x52, 0x3a, 0x8d, 0xd2,
+	0xe8, 0xe9, 0x34, 0xca, 0xc3, 0xd3, 0x69, 0x38, 0xff, 0xab, 0x80, 0x4e, 0x0f, 0xe4, 0x1c, 0xa3,
+	0x26, 0x89, 0x13, 0xcf, 0xf7, 0x28, 0x5b, 0x68, 0x48, 0x7e, 0x9d, 0x1a, 0x9a, 0xa3, 0x75, 0x04,
+	0x37, 0xf1, 0xc2, 0x97, 0xb5, 0xe0, 0x0d, 0x95, 0x3f, 0xdc, 0x44, 0x43, 0x9a, 0x9e, 0x34, 0x72,
+	0xc2, 0x92, 0x70, 0x17, 0x4d, 0xcf, 0x1f, 0x9e, 0x7e, 0x9b, 0x63, 0xc9, 0xb2, 0xf8, 0x80, 0x9a,
+	0xea, 0x85, 0xe7, 0x74, 0x7e, 0x59, 0xdc, 0xd8, 0x15, 0x80, 0x1a, 0xf8, 0xec, 0x77, 0x0c, 0x42,
+	0xa0, 0xf3, 0x2d, 0xcb, 0xe8, 0x01, 0x86, 0xb7, 0x6f, 0xa0, 0xe9, 0x78, 0x2b, 0x8c, 0x12, 0xf6,
+	0x93, 0xdf, 0xdf, 0xbe, 0x5d, 0x1c, 0xdc, 0x9b, 0x0a, 0x95, 0xe1, 0x9e, 0xa1, 0x97, 0xb4, 0x97,
+	0x11, 0xf2, 0xc3, 0xa0, 0xc3, 0xf9, 0x98, 0x57, 0xbc, 0x68, 0x55, 0x62, 0x32, 0xd8, 0x68, 0xe5,
+	0x88, 0x51, 0x63, 0x83, 0x57, 0x30, 0xfd, 0xbc, 0x93, 0xa8, 0x38, 0x48, 0x0a, 0xe7, 0xcb, 0xe4,
+	0x93, 0xd2, 0x2a, 0x37, 0xb1, 0x45, 0x44, 0x61, 0x98, 0x0c, 0x09, 0x3d, 0x05, 0x85, 0x02, 0x9d,
+	0x8e, 0x64, 0xc7, 0x48, 0xf8, 0x9e, 0xda, 0xf3, 0xbd, 0xcc, 0xe7, 0x81, 0xd6, 0x53, 0x78, 0x18,
+	0x28, 0xe1, 0xfc, 0x83, 0x02, 0xaa, 0xc8, 0xd4, 0x6e, 0x66, 0xd8, 0xb2, 0xf5, 0x38, 0xc3, 0x96,
+	0x8f, 0x14, 0x87, 0xf4, 0x34, 0xf7, 0x4e, 0x28, 0xd2, 0x90, 0xf9, 0xa9, 0x94, 0x1b, 0xc1, 0xf3,
+	0x66, 0x58, 0xd0, 0x48, 0x71, 0x54, 0xcc, 0xb3, 0x9c, 0x3e, 0x0a, 0x82, 0x13, 0xee, 0x77, 0xa0,
+	0x79, 0x96, 0x73, 0x04, 0x28, 0x1a, 0xe7, 0x9f, 0x58, 0x68, 0x5a, 0x4b, 0xde, 0x4f, 0x3e, 0x80,
+	0x86, 0xed, 0x0f, 0x78, 0xf3, 0x13, 0x20, 0x30, 0x1c, 0xf3, 0xb8, 0xeb, 0x28, 0xa7, 0x22, 0xcd,
+	0xe3, 0xae, 0xe3, 0x31, 0x8f, 0xbb, 0x0e, 0x8f, 0xdb, 0x97, 0x6e, 0xfd, 0x5a, 0x2e, 0x7f, 0xea,
+	0xe7, 0x49, 0x31, 0x2a, 0x78, 0xa0, 0x74, 0x78, 0xf0, 0x40, 0x39, 0x3b, 0x78, 0x80, 0x3c, 0xa1,
+	0xd5, 0x6c, 0x85, 0x11, 0x3e, 0xb9, 0x37, 0x04, 0x6e, 0xa3, 0x19, 0x3d, 0x37, 0xc2, 0xf8, 0x0f,
+	0xfa, 0xbb, 0x48, 0x0d, 0x9f, 0x23, 0x70, 0xbb, 0x8a, 0x10, 0xf9, 0x1b, 0xf7, 0xdc, 0x16, 0x66,
+	0x41, 0x14, 0x53, 0x6a, 0x79, 0xbd, 0x25, 0x31, 0xa0, 0x51, 0x91, 0x07, 0xe3, 0x66, 0x9b, 0x38,
+	0xe1, 0x76, 0x8f, 0x96, 0xeb, 0x63, 0xcd, 0x83, 0xc1, 0x1a, 0xea, 0xc1, 0xa0, 0xdf, 0x7a, 0x17,
+	0x0e, 0xbc, 0xf5, 0x26, 0xaf, 0xa3, 0x90, 0xdd, 0xd5, 0x3c, 0x29, 0xb0, 0xab, 0x1b, 0xf5, 0x3a,
+	0xca, 0x00, 0x05, 0x64, 0x94, 0x72, 0xfe, 0x26, 0xab, 0xac, 0x7a, 0xd1, 0xed, 0x28, 0xae, 0x2d,
+	0x7d, 0x54, 0xa6, 0xac, 0xf8, 0xfd, 0xd5, 0x98, 0xda, 0xf5, 0xe0, 0x6b, 0x72, 0x6a, 0x34, 0x72,
+	0x2d, 0x82, 0x4a, 0x73, 0x1e, 0xa0, 0xe9, 0x26, 0x4e, 0x56, 0xc3, 0x96, 0xeb, 0x7b, 0xc9, 0xee,
+	0x11, 0xea, 0xb9, 0x80, 0xca, 0xaf, 0x86, 0x81, 0x7c, 0x15, 0x8a, 0x9a, 0x5d, 0x3e, 0x4a, 0x00,
+	0xc0, 0xe0, 0x24, 0x5a, 0x88, 0x4d, 0x18, 0xb1, 0x24, 0x50, 0x05, 0x9b, 0xcd, 0xa5, 0x18, 0x04,
+	0xce, 0xf9, 0x5d, 0xd6, 0x48, 0x6b, 0x1e, 0xdd, 0x05, 0x8f, 0xd8, 0x48, 0x5d, 0xb3, 0x91, 0x6e,
+	0xe6, 0xa5, 0xf7, 0x65, 0x37, 0x8e, 0xbd, 0x88, 0x50, 0x0f, 0x47, 0x2d, 0x1c, 0x24, 0x22, 0x59,
+	0x45, 0x99, 0x27, 0xd9, 0x93, 0x50, 0xd0, 0x28, 0x9c, 0x2f, 0x92, 0xe5, 0x47, 0x05, 0xcf, 0xd9,
+	0x97, 0xd3, 0x41, 0x60, 0xe9, 0xa5, 0x45, 0x0f, 0xe0, 0x16, 0x89, 0x99, 0x0a, 0x87, 0x64, 0x8a,
+	0x7a, 0x27, 0x9a, 0x8c, 0x42, 0x1f, 0xd7, 0xa2, 0x20, 0xed, 0xcc, 0x0d, 0x04, 0x0c, 0xb7, 0x40,
+	0xe0, 0x9d, 0x5f, 0xb0, 0xd0, 0x7c, 0x3a, 0x4b, 0x6c, 0xee, 0x71, 0xa8, 0xfa, 0xbb, 0x04, 0xc5,
+	0xd1, 0xdf, 0x25, 0x70, 0xbe, 0x5b, 0x46, 0xf3, 0x64, 0x0d, 0x15, 0xb9, 0x17, 0xc4, 0xed, 0x2f,
+	0x4b, 0x37, 0x92, 0xd2, 0x64, 0x8d, 0x74, 0x23, 0x62, 0xbc, 0x14, 0x86, 0x8e, 0x97, 0xeb, 0xa8,
+	0x12, 0xf6, 0x84, 0xa5, 0xbe, 0x68, 0xa4, 0xdc, 0xa8, 0xdc, 0x16, 0x88, 0x47, 0x7b, 0x0b, 0x67,
+	0x54, 0x05, 0x24, 0x18, 0x54, 0x51, 0xfb, 0xfb, 0xc5, 0x15, 0x43, 0xc9, 0x78, 0xdc, 0x48, 0x5e,
+	0x31, 0xcc, 0xa9, 0xf2, 0xc3, 0x6e, 0x19, 0xca, 0xa3, 0xbc, 0x38, 0x32, 0x91, 0xe3, 0x8b, 0x23,
+	0xf7, 0x50, 0x85, 0x5f, 0x8a, 0x1e, 0xeb, 0xa5, 0x0d, 0xca, 0xf8, 0x8e, 0x60, 0x00, 0x8a, 0x57,
+	0xca, 0xcb, 0x79, 0x2a, 0x57, 0x2f, 0xe7, 0xe7, 0xd1, 0x24, 0x71, 0x49, 0x09, 0x37, 0x37, 0xab,
+	0x15, 0x53, 0x6d, 0xa8, 0x33, 0x70, 0x96, 0xda, 0xc0, 0x4b, 0x90, 0x0d, 0x06, 0x8b, 0x98, 0x35,
+	0x71, 0x5f, 0x2b, 0x37, 0x18, 0x19, 0xcd, 0x16, 0x83, 0x46, 0x45, 0xb6, 0xd0, 0xb6, 0x17, 0x93,
+	0x7b, 0xae, 0x36, 0xcf, 0xb2, 0x27, 0xb7, 0xd0, 0x65, 0x0e, 0x07, 0x49, 0x41, 0x52, 0x7f, 0xf0,
+	0xb0, 0x86, 0x19, 0x95, 0xfa, 0x43, 0x3a, 0x5c, 0x1f, 0x90, 0xfa, 0x83, 0x95, 0x72, 0x3e, 0x43,
+	0x26, 0xa6, 0x34, 0xac, 0xa8, 0x90, 0x51, 0x1c, 0xb0, 0x1a, 0x30, 0x9f, 0x07, 0x39, 0x58, 0xae,
+	0x31, 0x30, 0x08, 0x3c, 0x39, 0x6d, 0xb4, 0x53, 0xfe, 0xeb, 0x6c, 0xdf, 0x97, 0xa7, 0x8d, 0xb4,
+	0xcf, 0x7a, 0x9a, 0xde, 0x79, 0x0d, 0x4d, 0x6b, 0x87, 0x4a, 0x7a, 0xfe, 0x7a, 0xe8, 0xb6, 0x06,
+	0xe2, 0x1e, 0xaf, 0x11, 0x20, 0x30, 0x1c, 0xf5, 0xa7, 0x61, 0x19, 0xdf, 0x52, 0x9a, 0x12, 0xcf,
+	0xf3, 0xc6, 0xb1, 0x84, 0x59, 0x84, 0x3b, 0xf8, 0x61, 0x3a, 0x0f, 0x10, 0x10, 0x20, 0x30, 0x9c,
+	0xf3, 0x6e, 0x24, 0x1f, 0x5b, 0xa5, 0x2a, 0x8e, 0xf0, 0xf5, 0xd0, 0x55, 0x9c, 0x30, 0x4a, 0x80,
+	0x62, 0x9c, 0xbb, 0x68, 0x4a, 0x3c, 0x04, 0x78, 0x38, 0x35, 0xd9, 0xf7, 0xe3, 0xc0, 0xbb, 0x19,
+	0xc6, 0x89, 0xd8, 0xa7, 0x98, 0x3b, 0xda, 0xad, 0x15, 0x0a, 0x03, 0x89, 0x75, 0xbe, 0x67, 0xa1,
+	0xe9, 0xf5, 0xf5, 0x55, 0x79, 0x4d, 0x04, 0xe8, 0x89, 0x98, 0xb5, 0x50, 0x6d, 0x33, 0xc1, 0xba,
+	0xdf, 0x2b, 0x5b, 0x89, 0x2e, 0xec, 0xef, 0x2d, 0x3c, 0xd1, 0xcc, 0xa4, 0x80, 0x21, 0x25, 0xed,
+	0x15, 0x74, 0x46, 0xc7, 0xf0, 0x07, 0x41, 0xb8, 0x42, 0x42, 0x03, 0xa5, 0x9a, 0x83, 0x68, 0xc8,
+	0x2a, 0x93, 0x66, 0x25, 0x52, 0x2b, 0x16, 0xb3, 0x59, 0x71, 0x34, 0x64, 0x95, 0x71, 0xde, 0x8f,
+	0xe6, 0x52, 0x0e, 0x99, 0x47, 0x48, 0x76, 0xfb, 0x5b, 0x45, 0x34, 0xa3, 0xfb, 0xe5, 0x1d, 0x5e,
+	0x64, 0x04, 0x1d, 0x2c, 0xc3, 0x97, 0xae, 0x38, 0xa2, 0x2f, 0x9d, 0xee, 0xbc, 0x58, 0x3a, 0x59,
+	0xe7, 0xc5, 0x72, 0x3e, 0xce, 0x8b, 0x9a, 0x93, 0xed, 0xc4, 0xe3, 0x73, 0xb2, 0xfd, 0xb5, 0x32,
+	0x9a, 0x35, 0x5f, 0xd8, 0x3e, 0x42, 0x4f, 0xbe, 0x7b, 0xa0, 0x27, 0x47, 0x74, 0xde, 0x29, 0x8e,
+	0xeb, 0xbc, 0x53, 0x1a, 0xd7, 0x79, 0xa7, 0x7c, 0x0c, 0xe7, 0x9d, 0x41, 0xd7, 0x9b, 0x89, 0x23,
+	0xbb, 0xde, 0x7c, 0x58, 0x6e, 0x14, 0x93, 0x86, 0x31, 0x43, 0x6d, 0x16, 0xb6, 0xd9, 0x0d, 0x4b,
+	0x61, 0x3b, 0x33, 0x6e, 0x6f, 0xea, 0x10, 0xf5, 0x21, 0xca, 0x0c, 0x57, 0x1b, 0xdd, 0x3f, 0xf0,
+	0x89, 0x11, 0x42, 0xd5, 0x3e, 0x80, 0xa6, 0xf9, 0x78, 0xa2, 0x76, 0x0e, 0x64, 0xda, 0x48, 0x9a,
+	0x0a, 0x05, 0x3a, 0x1d, 0x19, 0x18, 0x3d, 0x35, 0x41, 0xa8, 0x1b, 0xd9, 0xb4, 0x69, 0x2a, 0x6b,
+	0x98, 0x68, 0x48, 0xd3, 0x3b, 0x3f, 0x5f, 0x40, 0xe7, 0x32, 0x6f, 0xec, 0xa8, 0xb3, 0x06, 0x3d,
+	0x85, 0xe1, 0x36, 0x27, 0xd0, 0xea, 0x51, 0xb5, 0x0c, 0xfd, 0xf4, 0xc2, 0xbd, 0xa1, 0x94, 0x70,
+	0x00, 0x17, 0xf2, 0xfa, 0x65, 0x97, 0x1e, 0x5b, 0x32, 0x24, 0x14, 0xcc, 0xd7, 0x2f, 0xd7, 0x86,
+	0xd0, 0xc1, 0x50, 0x0e, 0xc4, 0x84, 0xe4, 0xf1, 0xbc, 0xa7, 0x64, 0xb7, 0xcb, 0x7a, 0xed, 0x73,
+	0x25, 0x85, 0x87, 0x81, 0x12, 0xce, 0x2f, 0x59, 0xe8, 0xf4, 0xc0, 0xed, 0x0e, 0xd9, 0xc0, 0x5b,
+	0x61, 0xb8, 0xed, 0xe1, 0xf4, 0x79, 0x64, 0x89, 0x42, 0x81, 0x63, 0x09, 0x1d, 0x33, 0x75, 0xa7,
+	0x37, 0x7a, 0x7e, 0xae, 0xe4, 0xd8, 0x2c, 0x3d, 0xa4, 0x38, 0xa2, 0x1e, 0xf2, 0x8d, 0x22, 0x9a,
+	0x35, 0x8e, 0xcf, 0xe4, 0x71, 0x5f, 0xe1, 0x2c, 0x91, 0x8b, 0x9f, 0x06, 0x63, 0xab, 0x3d, 0xe0,
+	0x3c, 0xd4, 0xc7, 0xeb, 0x01, 0x9d, 0xad, 0x1b, 0xf2, 0x35, 0xe9, 0x93, 0x13, 0xcc, 0x9d, 0xab,
+	0xb8, 0x38, 0x92, 0xb8, 0x1b, 0xa9, 0x94, 0xb0, 0xfc, 0x56, 0x23, 0x77, 0xe9, 0x2a, 0x31, 0xa5,
+	0x14, 0x05, 0x9a, 0x58, 0xb2, 0x53, 0xef, 0xe0, 0xc8, 0xdb, 0xf4, 0x70, 0x9b, 0x27, 0xf0, 0xa0,
+	0xfb, 0xe0, 0x5d, 0x0e, 0x03, 0x89, 0x75, 0xbe, 0x54, 0x44, 0x2c, 0xc5, 0xe2, 0xf5, 0x28, 0xec,
+	0xd2, 0x57, 0xa0, 0x62, 0xcd, 0xa2, 0xc4, 0xbb, 0x2d, 0xcf, 0xfc, 0x9d, 0x2c, 0xb2, 0x5a, 0x83,
+	0x80, 0x21, 0xd1, 0xee, 0xa1, 0xa9, 0x4d, 0x0f, 0xfb, 0x6d, 0x11, 0x19, 0x34, 0xf6, 0x73, 0xd0,
+	0xd7, 0x39, 0x37, 0xd6, 0x04, 0xe2, 0x17, 0x48, 0x29, 0xf4, 0xf1, 0x51, 0x96, 0xc8, 0x6f, 0xcd,
+	0xed, 0xf1, 0xef, 0xce, 0xe5, 0x91, 0xe5, 0x25, 0x93, 0x29, 0x4f, 0x5d, 0x6a, 0x02, 0x21, 0x2d,
+	0xda, 0x71, 0xd1, 0x5c, 0xea, 0x61, 0xa3, 0xbc, 0x0f, 0xfa, 0xce, 0x5f, 0x9a, 0x44, 0x15, 0x99,
+	0x65, 0x45, 0x4b, 0xa8, 0x66, 0x8d, 0x9a, 0x50, 0x8d, 0xa7, 0x6a, 0x2b, 0x0c, 0x49, 0xd5, 0xf6,
+	0x66, 0xce, 0xb7, 0xf6, 0x02, 0x9a, 0xe5, 0xc6, 0x69, 0xb1, 0xde, 0x95, 0xe9, 0x7a, 0x27, 0x5d,
+	0xa8, 0xd7, 0x0d, 0x2c, 0xa4, 0xa8, 0x8d, 0xc7, 0xa6, 0x27, 0x0e, 0x7b, 0x6c, 0xda, 0xc8, 0xa8,
+	0x33, 0x79, 0x68, 0x46, 0x9d, 0x65, 0xc6, 0x9b, 0xd4, 0x96, 0xaa, 0x0b, 0x33, 0xf5, 0xcb, 0x82,
+	0x2f, 0x81, 0x1d, 0x78, 0x30, 0x95, 0x25, 0xb3, 0xd2, 0x8a, 0x55, 0xde, 0xc0, 0xb4, 0x62, 0x98,
+	0x65, 0x0c, 0x44, 0x79, 0xac, 0x28, 0x72, 0x20, 0xac, 0xaf, 0x36, 0x99, 0x4f, 0x95, 0xcc, 0x3c,
+	0xd8, 0x25, 0x27, 0xd6, 0x24, 0xda, 0xad, 0x4e, 0xe7, 0xf1, 0xad, 0x52, 0x10, 0x10, 0x9e, 0xcc,
+	0x46, 0x4a, 0xff, 0x05, 0x26, 0x85, 0xee, 0xf1, 0x34, 0xc5, 0x8e, 0x52, 0xfa, 0x78, 0x50, 0x88,
+	0xda, 0xe3, 0x53, 0x78, 0x18, 0x28, 0xe1, 0xdc, 0x41, 0x73, 0xa9, 0xb1, 0x2d, 0x2c, 0xf5, 0x56,
+	0xb6, 0xa5, 0xde, 0xcc, 0x0b, 0x34, 0xe4, 0x85, 0x58, 0x27, 0x42, 0xb3, 0xe6, 0x07, 0xa8, 0xc7,
+	0x4c, 0xad, 0xe1, 0x8f, 0x99, 0xea, 0x26, 0x9b, 0xc2, 0xa8, 0x26, 0x1b, 0xe7, 0xf5, 0x02, 0x9a,
+	0xd1, 0xbb, 0xc7, 0xfe, 0x8a, 0x85, 0xce, 0xb0, 0xf4, 0xcc, 0x4b, 0x38, 0xd2, 0x52, 0x43, 0xe7,
+	0x7c, 0xff, 0x45, 0x8f, 0xcc, 0x4b, 0x83, 0x72, 0x20, 0x4b, 0x38, 0x99, 0x8f, 0x2d, 0xb7, 0xde,
+	0x0f, 0xda, 0xd2, 0x4c, 0xab, 0x32, 0x5b, 0xd7, 0x18, 0x1c, 0x24, 0x05, 0xbd, 0x68, 0xc7, 0xd1,
+	0x0e, 0x8e, 0x34, 0x15, 0x4e, 0x5d, 0xb4, 0x4b, 0x0c, 0x68, 0x54, 0xce, 0xaf, 0x5a, 0xe8, 0xf4,
+	0xc0, 0xc6, 0x7d, 0xd4, 0xfc, 0xa1, 0x69, 0x85, 0xbc, 0x70, 0x7c, 0x85, 0xbc, 0x38, 0x9a, 0x42,
+	0x5e, 0xdf, 0xf8, 0xe6, 0x77, 0x2e, 0xbe, 0xe5, 0x5b, 0xdf, 0xb9, 0xf8, 0x96, 0x6f, 0x7f, 0xe7,
+	0xe2, 0x5b, 0x3e, 0xb3, 0x7f, 0xd1, 0xfa, 0xe6, 0xfe, 0x45, 0xeb, 0x5b, 0xfb, 0x17, 0xad, 0x6f,
+	0xef, 0x5f, 0xb4, 0xfe, 0xfd, 0xfe, 0x45, 0xeb, 0xcb, 0x7f, 0x74, 0xf1, 0x2d, 0x1f, 0xfd, 0xb0,
+	0xea, 0xb5, 0x2b, 0xa2, 0xd7, 0xe8, 0x3f, 0xef, 0x11, 0x7d, 0x74, 0xa5, 0xb7, 0xdd, 0x21, 0xa9,
+	0x40, 0xe2, 0x2b, 0x12, 0x22, 0x7a, 0xed, 0x7f, 0x0f, 0x00, 0x62, 0x00, 0x1d, 0x7b, 0x73, 0xdd,
+	0x00, 0x00,
 }
 
 func (m *ALBStatus) Marshal() (dAtA []byte, err error) {
@@ -4260,6 +5331,39 @@ func (m *ALBTrafficRouting) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *AdaptiveInterval) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AdaptiveInterval) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AdaptiveInterval) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i -= len(m.Condition)
+	copy(dAtA[i:], m.Condition)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Condition)))
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.MinInterval)
+	copy(dAtA[i:], m.MinInterval)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.MinInterval)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *AmbassadorTrafficRouting) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -4528,6 +5632,18 @@ func (m *AnalysisRunSpec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Scoring != nil {
+		{
+			size, err := m.Scoring.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
 	if m.TTLStrategy != nil {
 		{
 			size, err := m.TTLStrategy.MarshalToSizedBuffer(dAtA[:i])
@@ -4627,6 +5743,11 @@ func (m *AnalysisRunStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Score != nil {
+		i = encodeVarintGenerated(dAtA, i, uint64(*m.Score))
+		i--
+		dAtA[i] = 0x40
+	}
 	if m.CompletedAt != nil {
 		{
 			size, err := m.CompletedAt.MarshalToSizedBuffer(dAtA[:i])
@@ -4733,6 +5854,53 @@ func (m *AnalysisRunStrategy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *AnalysisScoring) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AnalysisScoring) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AnalysisScoring) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Threshold.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Weights) > 0 {
+		for iNdEx := len(m.Weights) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Weights[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *AnalysisTemplate) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -4881,6 +6049,18 @@ func (m *AnalysisTemplateSpec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Scoring != nil {
+		{
+			size, err := m.Scoring.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
 	if len(m.Templates) > 0 {
 		for iNdEx := len(m.Templates) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -5299,6 +6479,13 @@ func (m *ArgumentValueFrom) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.RolloutValue != nil {
+		i -= len(*m.RolloutValue)
+		copy(dAtA[i:], *m.RolloutValue)
+		i = encodeVarintGenerated(dAtA, i, uint64(len(*m.RolloutValue)))
+		i--
+		dAtA[i] = 0x1a
+	}
 	if m.FieldRef != nil {
 		{
 			size, err := m.FieldRef.MarshalToSizedBuffer(dAtA[:i])
@@ -5445,6 +6632,53 @@ func (m *BasicAuthConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *BasicAuthProviderConfig) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BasicAuthProviderConfig) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BasicAuthProviderConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.PasswordSecretRef != nil {
+		{
+			size, err := m.PasswordSecretRef.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.UsernameSecretRef != nil {
+		{
+			size, err := m.UsernameSecretRef.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *BlueGreenStatus) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -5751,6 +6985,18 @@ func (m *CanaryStep) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.SetLocality != nil {
+		{
+			size, err := m.SetLocality.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x52
+	}
 	if m.Plugin != nil {
 		{
 			size, err := m.Plugin.MarshalToSizedBuffer(dAtA[:i])
@@ -6373,6 +7619,104 @@ func (m *ClusterAnalysisTemplateList) MarshalToSizedBuffer(dAtA []byte) (int, er
 	return len(dAtA) - i, nil
 }
 
+func (m *ConfigMapKeyRef) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ConfigMapKeyRef) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ConfigMapKeyRef) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i -= len(m.Key)
+	copy(dAtA[i:], m.Key)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Key)))
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.Name)
+	copy(dAtA[i:], m.Name)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Name)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *ConsulTrafficRouting) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ConsulTrafficRouting) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ConsulTrafficRouting) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i -= len(m.ServiceRouterName)
+	copy(dAtA[i:], m.ServiceRouterName)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.ServiceRouterName)))
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.ServiceSplitterName)
+	copy(dAtA[i:], m.ServiceSplitterName)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.ServiceSplitterName)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *ContourTrafficRouting) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ContourTrafficRouting) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ContourTrafficRouting) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.HTTPProxies) > 0 {
+		for iNdEx := len(m.HTTPProxies) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.HTTPProxies[iNdEx])
+			copy(dAtA[i:], m.HTTPProxies[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(m.HTTPProxies[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *DatadogMetric) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -6460,6 +7804,39 @@ func (m *DatadogMetric) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *DatadogProviderConfig) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DatadogProviderConfig) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DatadogProviderConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i -= len(m.ApiVersion)
+	copy(dAtA[i:], m.ApiVersion)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.ApiVersion)))
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.SecretName)
+	copy(dAtA[i:], m.SecretName)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.SecretName)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *DryRun) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -6488,6 +7865,37 @@ func (m *DryRun) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *EarlySuccess) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EarlySuccess) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EarlySuccess) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i -= len(m.Condition)
+	copy(dAtA[i:], m.Condition)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Condition)))
+	i--
+	dAtA[i] = 0x12
+	i = encodeVarintGenerated(dAtA, i, uint64(m.ConsecutiveSuccessLimit))
+	i--
+	dAtA[i] = 0x8
+	return len(dAtA) - i, nil
+}
+
 func (m *Experiment) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -6986,6 +8394,44 @@ func (m *FieldRef) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *GatewayAPITrafficRouting) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GatewayAPITrafficRouting) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GatewayAPITrafficRouting) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i -= len(m.TCPRoute)
+	copy(dAtA[i:], m.TCPRoute)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.TCPRoute)))
+	i--
+	dAtA[i] = 0x1a
+	i -= len(m.GRPCRoute)
+	copy(dAtA[i:], m.GRPCRoute)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.GRPCRoute)))
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.HTTPRoute)
+	copy(dAtA[i:], m.HTTPRoute)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.HTTPRoute)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *GraphiteMetric) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -7092,6 +8538,34 @@ func (m *InfluxdbMetric) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *InfluxdbProviderConfig) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *InfluxdbProviderConfig) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *InfluxdbProviderConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i -= len(m.Profile)
+	copy(dAtA[i:], m.Profile)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Profile)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *IstioDestinationRule) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -7285,6 +8759,18 @@ func (m *JobMetric) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Result != nil {
+		{
+			size, err := m.Result.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
 	{
 		size, err := m.Spec.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -7308,6 +8794,49 @@ func (m *JobMetric) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *JobMetricResult) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *JobMetricResult) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *JobMetricResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i -= len(m.JSONPath)
+	copy(dAtA[i:], m.JSONPath)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.JSONPath)))
+	i--
+	dAtA[i] = 0x22
+	i -= len(m.LogPrefix)
+	copy(dAtA[i:], m.LogPrefix)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.LogPrefix)))
+	i--
+	dAtA[i] = 0x1a
+	i -= len(m.Container)
+	copy(dAtA[i:], m.Container)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Container)))
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.Source)
+	copy(dAtA[i:], m.Source)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Source)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *KayentaMetric) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -7470,6 +8999,44 @@ func (m *KayentaThreshold) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *KongTrafficRouting) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *KongTrafficRouting) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *KongTrafficRouting) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i -= len(m.CanaryPlugin)
+	copy(dAtA[i:], m.CanaryPlugin)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.CanaryPlugin)))
+	i--
+	dAtA[i] = 0x1a
+	i -= len(m.CanaryTarget)
+	copy(dAtA[i:], m.CanaryTarget)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.CanaryTarget)))
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.StableTarget)
+	copy(dAtA[i:], m.StableTarget)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.StableTarget)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *MangedRoutes) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -7647,6 +9214,39 @@ func (m *Metric) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.EarlySuccess != nil {
+		{
+			size, err := m.EarlySuccess.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x72
+	}
+	if m.AdaptiveInterval != nil {
+		{
+			size, err := m.AdaptiveInterval.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x6a
+	}
+	if len(m.DependsOn) > 0 {
+		for iNdEx := len(m.DependsOn) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.DependsOn[iNdEx])
+			copy(dAtA[i:], m.DependsOn[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(m.DependsOn[iNdEx])))
+			i--
+			dAtA[i] = 0x62
+		}
+	}
 	if m.ConsecutiveSuccessLimit != nil {
 		{
 			size, err := m.ConsecutiveSuccessLimit.MarshalToSizedBuffer(dAtA[:i])
@@ -7765,6 +9365,70 @@ func (m *MetricProvider) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Probe != nil {
+		{
+			size, err := m.Probe.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x8a
+	}
+	if m.SQL != nil {
+		{
+			size, err := m.SQL.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x82
+	}
+	if m.OpenSearch != nil {
+		{
+			size, err := m.OpenSearch.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x7a
+	}
+	if m.ConfigRef != nil {
+		{
+			size, err := m.ConfigRef.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x72
+	}
+	if m.SLOBurnRate != nil {
+		{
+			size, err := m.SLOBurnRate.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x6a
+	}
 	if len(m.Plugin) > 0 {
 		keysForPlugin := make([]string, 0, len(m.Plugin))
 		for k := range m.Plugin {
@@ -7926,6 +9590,195 @@ func (m *MetricProvider) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *MetricProviderConfig) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MetricProviderConfig) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MetricProviderConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Spec.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.ObjectMeta.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MetricProviderConfigList) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MetricProviderConfigList) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MetricProviderConfigList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Items) > 0 {
+		for iNdEx := len(m.Items) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Items[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	{
+		size, err := m.ListMeta.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MetricProviderConfigRef) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MetricProviderConfigRef) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MetricProviderConfigRef) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i -= len(m.Name)
+	copy(dAtA[i:], m.Name)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Name)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MetricProviderConfigSpec) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MetricProviderConfigSpec) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MetricProviderConfigSpec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Influxdb != nil {
+		{
+			size, err := m.Influxdb.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.NewRelic != nil {
+		{
+			size, err := m.NewRelic.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Datadog != nil {
+		{
+			size, err := m.Datadog.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Prometheus != nil {
+		{
+			size, err := m.Prometheus.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *MetricResult) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -8031,6 +9884,37 @@ func (m *MetricResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *MetricWeight) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MetricWeight) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MetricWeight) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i = encodeVarintGenerated(dAtA, i, uint64(m.Weight))
+	i--
+	dAtA[i] = 0x10
+	i -= len(m.MetricName)
+	copy(dAtA[i:], m.MetricName)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.MetricName)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *NewRelicMetric) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -8069,6 +9953,34 @@ func (m *NewRelicMetric) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *NewRelicProviderConfig) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *NewRelicProviderConfig) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *NewRelicProviderConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i -= len(m.Profile)
+	copy(dAtA[i:], m.Profile)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Profile)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *NginxTrafficRouting) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -8206,6 +10118,60 @@ func (m *OAuth2Config) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *OAuth2ProviderConfig) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OAuth2ProviderConfig) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OAuth2ProviderConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Scopes) > 0 {
+		for iNdEx := len(m.Scopes) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Scopes[iNdEx])
+			copy(dAtA[i:], m.Scopes[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(m.Scopes[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.ClientSecretRef != nil {
+		{
+			size, err := m.ClientSecretRef.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	i -= len(m.ClientID)
+	copy(dAtA[i:], m.ClientID)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.ClientID)))
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.TokenURL)
+	copy(dAtA[i:], m.TokenURL)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.TokenURL)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *ObjectRef) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -8249,6 +10215,77 @@ func (m *ObjectRef) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *OpenSearchMetric) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OpenSearchMetric) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OpenSearchMetric) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i--
+	if m.Insecure {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i--
+	dAtA[i] = 0x40
+	i -= len(m.RequestTimeout)
+	copy(dAtA[i:], m.RequestTimeout)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.RequestTimeout)))
+	i--
+	dAtA[i] = 0x3a
+	{
+		size, err := m.SecretRef.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	i -= len(m.ValuePath)
+	copy(dAtA[i:], m.ValuePath)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.ValuePath)))
+	i--
+	dAtA[i] = 0x2a
+	i -= len(m.Language)
+	copy(dAtA[i:], m.Language)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Language)))
+	i--
+	dAtA[i] = 0x22
+	i -= len(m.Query)
+	copy(dAtA[i:], m.Query)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Query)))
+	i--
+	dAtA[i] = 0x1a
+	i -= len(m.Index)
+	copy(dAtA[i:], m.Index)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Index)))
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.Address)
+	copy(dAtA[i:], m.Address)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Address)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *PauseCondition) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -8452,6 +10489,187 @@ func (m *PreferredDuringSchedulingIgnoredDuringExecution) MarshalToSizedBuffer(d
 	return len(dAtA) - i, nil
 }
 
+func (m *ProbeGRPC) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ProbeGRPC) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ProbeGRPC) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i--
+	if m.Insecure {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i--
+	dAtA[i] = 0x28
+	i--
+	if m.TLS {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i--
+	dAtA[i] = 0x20
+	if len(m.Metadata) > 0 {
+		for iNdEx := len(m.Metadata) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Metadata[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	i -= len(m.Service)
+	copy(dAtA[i:], m.Service)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Service)))
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.Address)
+	copy(dAtA[i:], m.Address)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Address)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *ProbeHTTP) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ProbeHTTP) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ProbeHTTP) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i--
+	if m.Insecure {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i--
+	dAtA[i] = 0x28
+	i -= len(m.Body)
+	copy(dAtA[i:], m.Body)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Body)))
+	i--
+	dAtA[i] = 0x22
+	if len(m.Headers) > 0 {
+		for iNdEx := len(m.Headers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Headers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	i -= len(m.Method)
+	copy(dAtA[i:], m.Method)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Method)))
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.URL)
+	copy(dAtA[i:], m.URL)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.URL)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *ProbeMetric) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ProbeMetric) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ProbeMetric) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i -= len(m.Timeout)
+	copy(dAtA[i:], m.Timeout)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Timeout)))
+	i--
+	dAtA[i] = 0x2a
+	i = encodeVarintGenerated(dAtA, i, uint64(m.RPS))
+	i--
+	dAtA[i] = 0x20
+	i = encodeVarintGenerated(dAtA, i, uint64(m.Requests))
+	i--
+	dAtA[i] = 0x18
+	if m.GRPC != nil {
+		{
+			size, err := m.GRPC.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.HTTP != nil {
+		{
+			size, err := m.HTTP.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *PrometheusMetric) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -8534,6 +10752,124 @@ func (m *PrometheusMetric) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *PrometheusProviderAuthentication) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PrometheusProviderAuthentication) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PrometheusProviderAuthentication) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.BasicAuth.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size, err := m.OAuth2.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.Sigv4.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *PrometheusProviderConfig) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PrometheusProviderConfig) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PrometheusProviderConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Headers) > 0 {
+		for iNdEx := len(m.Headers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Headers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	i--
+	if m.Insecure {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i--
+	dAtA[i] = 0x20
+	if m.Timeout != nil {
+		i = encodeVarintGenerated(dAtA, i, uint64(*m.Timeout))
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size, err := m.Authentication.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.Address)
+	copy(dAtA[i:], m.Address)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Address)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *PrometheusRangeQueryArgs) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -9765,6 +12101,68 @@ func (m *RolloutTrafficRouting) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Consul != nil {
+		{
+			size, err := m.Consul.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x82
+	}
+	if m.Contour != nil {
+		{
+			size, err := m.Contour.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x7a
+	}
+	if m.Kong != nil {
+		{
+			size, err := m.Kong.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x72
+	}
+	if m.Stickiness != nil {
+		{
+			size, err := m.Stickiness.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x6a
+	}
+	if m.GatewayAPI != nil {
+		{
+			size, err := m.GatewayAPI.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x62
+	}
 	if m.MaxTrafficWeight != nil {
 		i = encodeVarintGenerated(dAtA, i, uint64(*m.MaxTrafficWeight))
 		i--
@@ -10023,6 +12421,101 @@ func (m *RunSummary) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *SLOBurnRateMetric) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SLOBurnRateMetric) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SLOBurnRateMetric) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Windows) > 0 {
+		for iNdEx := len(m.Windows) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Windows[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	i -= len(m.Target)
+	copy(dAtA[i:], m.Target)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Target)))
+	i--
+	dAtA[i] = 0x1a
+	i -= len(m.ErrorRatioQuery)
+	copy(dAtA[i:], m.ErrorRatioQuery)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.ErrorRatioQuery)))
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.Prometheus.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *SLOBurnRateWindow) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SLOBurnRateWindow) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SLOBurnRateWindow) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i -= len(m.BurnRate)
+	copy(dAtA[i:], m.BurnRate)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.BurnRate)))
+	i--
+	dAtA[i] = 0x1a
+	i -= len(m.LongWindow)
+	copy(dAtA[i:], m.LongWindow)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.LongWindow)))
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.ShortWindow)
+	copy(dAtA[i:], m.ShortWindow)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.ShortWindow)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *SMITrafficRouting) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -10056,6 +12549,66 @@ func (m *SMITrafficRouting) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *SQLMetric) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SQLMetric) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SQLMetric) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i--
+	if m.ResultSet {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i--
+	dAtA[i] = 0x28
+	i -= len(m.Timeout)
+	copy(dAtA[i:], m.Timeout)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Timeout)))
+	i--
+	dAtA[i] = 0x22
+	if len(m.Args) > 0 {
+		for iNdEx := len(m.Args) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Args[iNdEx])
+			copy(dAtA[i:], m.Args[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(m.Args[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	i -= len(m.Query)
+	copy(dAtA[i:], m.Query)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Query)))
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.SecretRef.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *ScopeDetail) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -10102,6 +12655,35 @@ func (m *ScopeDetail) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ScoreThreshold) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ScoreThreshold) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ScoreThreshold) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i = encodeVarintGenerated(dAtA, i, uint64(m.Marginal))
+	i--
+	dAtA[i] = 0x10
+	i = encodeVarintGenerated(dAtA, i, uint64(m.Pass))
+	i--
+	dAtA[i] = 0x8
+	return len(dAtA) - i, nil
+}
+
 func (m *SecretKeyRef) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -10254,6 +12836,52 @@ func (m *SetHeaderRoute) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *SetLocality) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SetLocality) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SetLocality) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Regions) > 0 {
+		for iNdEx := len(m.Regions) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Regions[iNdEx])
+			copy(dAtA[i:], m.Regions[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(m.Regions[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Zones) > 0 {
+		for iNdEx := len(m.Zones) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Zones[iNdEx])
+			copy(dAtA[i:], m.Zones[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(m.Zones[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	i -= len(m.Name)
+	copy(dAtA[i:], m.Name)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Name)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *SetMirrorRoute) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -10848,6 +13476,16 @@ func (m *TraefikTrafficRouting) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	i -= len(m.IngressRouteName)
+	copy(dAtA[i:], m.IngressRouteName)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.IngressRouteName)))
+	i--
+	dAtA[i] = 0x1a
+	i -= len(m.MirrorTraefikServiceName)
+	copy(dAtA[i:], m.MirrorTraefikServiceName)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.MirrorTraefikServiceName)))
+	i--
+	dAtA[i] = 0x12
 	i -= len(m.WeightedTraefikServiceName)
 	copy(dAtA[i:], m.WeightedTraefikServiceName)
 	i = encodeVarintGenerated(dAtA, i, uint64(len(m.WeightedTraefikServiceName)))
@@ -10856,6 +13494,42 @@ func (m *TraefikTrafficRouting) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *TrafficStickiness) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TrafficStickiness) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TrafficStickiness) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i = encodeVarintGenerated(dAtA, i, uint64(m.DurationSeconds))
+	i--
+	dAtA[i] = 0x18
+	i -= len(m.Header)
+	copy(dAtA[i:], m.Header)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Header)))
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.Cookie)
+	copy(dAtA[i:], m.Cookie)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Cookie)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *TrafficWeights) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -10943,6 +13617,18 @@ func (m *ValueFrom) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.ConfigMapKeyRef != nil {
+		{
+			size, err := m.ConfigMapKeyRef.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
 	if m.FieldRef != nil {
 		{
 			size, err := m.FieldRef.MarshalToSizedBuffer(dAtA[:i])
@@ -11023,6 +13709,38 @@ func (m *WebMetric) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	i--
+	if m.IgnoreStatusCode {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i--
+	dAtA[i] = 0x60
+	if m.Retry != nil {
+		{
+			size, err := m.Retry.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x5a
+	}
+	if m.TLS != nil {
+		{
+			size, err := m.TLS.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x52
+	}
 	{
 		size, err := m.Authentication.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -11121,6 +13839,82 @@ func (m *WebMetricHeader) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *WebMetricRetry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WebMetricRetry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WebMetricRetry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i -= len(m.Backoff)
+	copy(dAtA[i:], m.Backoff)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Backoff)))
+	i--
+	dAtA[i] = 0x12
+	i = encodeVarintGenerated(dAtA, i, uint64(m.Limit))
+	i--
+	dAtA[i] = 0x8
+	return len(dAtA) - i, nil
+}
+
+func (m *WebMetricTLS) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WebMetricTLS) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WebMetricTLS) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i -= len(m.ServerName)
+	copy(dAtA[i:], m.ServerName)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.ServerName)))
+	i--
+	dAtA[i] = 0x1a
+	i -= len(m.CABundle)
+	copy(dAtA[i:], m.CABundle)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.CABundle)))
+	i--
+	dAtA[i] = 0x12
+	if m.ClientCertSecretRef != nil {
+		{
+			size, err := m.ClientCertSecretRef.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *WeightDestination) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -11211,6 +14005,19 @@ func (m *ALBTrafficRouting) Size() (n int) {
 	return n
 }
 
+func (m *AdaptiveInterval) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MinInterval)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Condition)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
 func (m *AmbassadorTrafficRouting) Size() (n int) {
 	if m == nil {
 		return 0
@@ -11335,6 +14142,10 @@ func (m *AnalysisRunSpec) Size() (n int) {
 		l = m.TTLStrategy.Size()
 		n += 1 + l + sovGenerated(uint64(l))
 	}
+	if m.Scoring != nil {
+		l = m.Scoring.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
 	return n
 }
 
@@ -11368,6 +14179,9 @@ func (m *AnalysisRunStatus) Size() (n int) {
 		l = m.CompletedAt.Size()
 		n += 1 + l + sovGenerated(uint64(l))
 	}
+	if m.Score != nil {
+		n += 1 + sovGenerated(uint64(*m.Score))
+	}
 	return n
 }
 
@@ -11386,6 +14200,23 @@ func (m *AnalysisRunStrategy) Size() (n int) {
 	return n
 }
 
+func (m *AnalysisScoring) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Weights) > 0 {
+		for _, e := range m.Weights {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	l = m.Threshold.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
 func (m *AnalysisTemplate) Size() (n int) {
 	if m == nil {
 		return 0
@@ -11466,6 +14297,10 @@ func (m *AnalysisTemplateSpec) Size() (n int) {
 			n += 1 + l + sovGenerated(uint64(l))
 		}
 	}
+	if m.Scoring != nil {
+		l = m.Scoring.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
 	return n
 }
 
@@ -11611,6 +14446,10 @@ func (m *ArgumentValueFrom) Size() (n int) {
 		l = m.FieldRef.Size()
 		n += 1 + l + sovGenerated(uint64(l))
 	}
+	if m.RolloutValue != nil {
+		l = len(*m.RolloutValue)
+		n += 1 + l + sovGenerated(uint64(l))
+	}
 	return n
 }
 
@@ -11657,6 +14496,23 @@ func (m *BasicAuthConfig) Size() (n int) {
 	return n
 }
 
+func (m *BasicAuthProviderConfig) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.UsernameSecretRef != nil {
+		l = m.UsernameSecretRef.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.PasswordSecretRef != nil {
+		l = m.PasswordSecretRef.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	return n
+}
+
 func (m *BlueGreenStatus) Size() (n int) {
 	if m == nil {
 		return 0
@@ -11800,6 +14656,10 @@ func (m *CanaryStep) Size() (n int) {
 		l = m.Plugin.Size()
 		n += 1 + l + sovGenerated(uint64(l))
 	}
+	if m.SetLocality != nil {
+		l = m.SetLocality.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
 	return n
 }
 
@@ -11999,6 +14859,47 @@ func (m *ClusterAnalysisTemplateList) Size() (n int) {
 	return n
 }
 
+func (m *ConfigMapKeyRef) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Key)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
+func (m *ConsulTrafficRouting) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ServiceSplitterName)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.ServiceRouterName)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
+func (m *ContourTrafficRouting) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.HTTPProxies) > 0 {
+		for _, s := range m.HTTPProxies {
+			l = len(s)
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	return n
+}
+
 func (m *DatadogMetric) Size() (n int) {
 	if m == nil {
 		return 0
@@ -12030,6 +14931,19 @@ func (m *DatadogMetric) Size() (n int) {
 	return n
 }
 
+func (m *DatadogProviderConfig) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.SecretName)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.ApiVersion)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
 func (m *DryRun) Size() (n int) {
 	if m == nil {
 		return 0
@@ -12041,6 +14955,18 @@ func (m *DryRun) Size() (n int) {
 	return n
 }
 
+func (m *EarlySuccess) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	n += 1 + sovGenerated(uint64(m.ConsecutiveSuccessLimit))
+	l = len(m.Condition)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
 func (m *Experiment) Size() (n int) {
 	if m == nil {
 		return 0
@@ -12224,6 +15150,21 @@ func (m *FieldRef) Size() (n int) {
 	return n
 }
 
+func (m *GatewayAPITrafficRouting) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.HTTPRoute)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.GRPCRoute)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.TCPRoute)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
 func (m *GraphiteMetric) Size() (n int) {
 	if m == nil {
 		return 0
@@ -12265,6 +15206,17 @@ func (m *InfluxdbMetric) Size() (n int) {
 	return n
 }
 
+func (m *InfluxdbProviderConfig) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Profile)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
 func (m *IstioDestinationRule) Size() (n int) {
 	if m == nil {
 		return 0
@@ -12348,6 +15300,27 @@ func (m *JobMetric) Size() (n int) {
 	n += 1 + l + sovGenerated(uint64(l))
 	l = m.Spec.Size()
 	n += 1 + l + sovGenerated(uint64(l))
+	if m.Result != nil {
+		l = m.Result.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	return n
+}
+
+func (m *JobMetricResult) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Source)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Container)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.LogPrefix)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.JSONPath)
+	n += 1 + l + sovGenerated(uint64(l))
 	return n
 }
 
@@ -12407,6 +15380,21 @@ func (m *KayentaThreshold) Size() (n int) {
 	return n
 }
 
+func (m *KongTrafficRouting) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.StableTarget)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.CanaryTarget)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.CanaryPlugin)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
 func (m *MangedRoutes) Size() (n int) {
 	if m == nil {
 		return 0
@@ -12503,6 +15491,20 @@ func (m *Metric) Size() (n int) {
 		l = m.ConsecutiveSuccessLimit.Size()
 		n += 1 + l + sovGenerated(uint64(l))
 	}
+	if len(m.DependsOn) > 0 {
+		for _, s := range m.DependsOn {
+			l = len(s)
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	if m.AdaptiveInterval != nil {
+		l = m.AdaptiveInterval.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.EarlySuccess != nil {
+		l = m.EarlySuccess.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
 	return n
 }
 
@@ -12568,6 +15570,92 @@ func (m *MetricProvider) Size() (n int) {
 			n += mapEntrySize + 1 + sovGenerated(uint64(mapEntrySize))
 		}
 	}
+	if m.SLOBurnRate != nil {
+		l = m.SLOBurnRate.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.ConfigRef != nil {
+		l = m.ConfigRef.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.OpenSearch != nil {
+		l = m.OpenSearch.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.SQL != nil {
+		l = m.SQL.Size()
+		n += 2 + l + sovGenerated(uint64(l))
+	}
+	if m.Probe != nil {
+		l = m.Probe.Size()
+		n += 2 + l + sovGenerated(uint64(l))
+	}
+	return n
+}
+
+func (m *MetricProviderConfig) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ObjectMeta.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	l = m.Spec.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
+func (m *MetricProviderConfigList) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ListMeta.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Items) > 0 {
+		for _, e := range m.Items {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MetricProviderConfigRef) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
+func (m *MetricProviderConfigSpec) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Prometheus != nil {
+		l = m.Prometheus.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.Datadog != nil {
+		l = m.Datadog.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.NewRelic != nil {
+		l = m.NewRelic.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.Influxdb != nil {
+		l = m.Influxdb.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
 	return n
 }
 
@@ -12608,6 +15696,18 @@ func (m *MetricResult) Size() (n int) {
 	return n
 }
 
+func (m *MetricWeight) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MetricName)
+	n += 1 + l + sovGenerated(uint64(l))
+	n += 1 + sovGenerated(uint64(m.Weight))
+	return n
+}
+
 func (m *NewRelicMetric) Size() (n int) {
 	if m == nil {
 		return 0
@@ -12624,6 +15724,17 @@ func (m *NewRelicMetric) Size() (n int) {
 	return n
 }
 
+func (m *NewRelicProviderConfig) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Profile)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
 func (m *NginxTrafficRouting) Size() (n int) {
 	if m == nil {
 		return 0
@@ -12680,6 +15791,29 @@ func (m *OAuth2Config) Size() (n int) {
 	return n
 }
 
+func (m *OAuth2ProviderConfig) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TokenURL)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.ClientID)
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.ClientSecretRef != nil {
+		l = m.ClientSecretRef.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if len(m.Scopes) > 0 {
+		for _, s := range m.Scopes {
+			l = len(s)
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	return n
+}
+
 func (m *ObjectRef) Size() (n int) {
 	if m == nil {
 		return 0
@@ -12697,6 +15831,30 @@ func (m *ObjectRef) Size() (n int) {
 	return n
 }
 
+func (m *OpenSearchMetric) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Index)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Query)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Language)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.ValuePath)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = m.SecretRef.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.RequestTimeout)
+	n += 1 + l + sovGenerated(uint64(l))
+	n += 2
+	return n
+}
+
 func (m *PauseCondition) Size() (n int) {
 	if m == nil {
 		return 0
@@ -12773,6 +15931,70 @@ func (m *PreferredDuringSchedulingIgnoredDuringExecution) Size() (n int) {
 	return n
 }
 
+func (m *ProbeGRPC) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Service)
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Metadata) > 0 {
+		for _, e := range m.Metadata {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	n += 2
+	n += 2
+	return n
+}
+
+func (m *ProbeHTTP) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.URL)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Method)
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Headers) > 0 {
+		for _, e := range m.Headers {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	l = len(m.Body)
+	n += 1 + l + sovGenerated(uint64(l))
+	n += 2
+	return n
+}
+
+func (m *ProbeMetric) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.HTTP != nil {
+		l = m.HTTP.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.GRPC != nil {
+		l = m.GRPC.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	n += 1 + sovGenerated(uint64(m.Requests))
+	n += 1 + sovGenerated(uint64(m.RPS))
+	l = len(m.Timeout)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
 func (m *PrometheusMetric) Size() (n int) {
 	if m == nil {
 		return 0
@@ -12802,6 +16024,44 @@ func (m *PrometheusMetric) Size() (n int) {
 	return n
 }
 
+func (m *PrometheusProviderAuthentication) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Sigv4.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	l = m.OAuth2.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	l = m.BasicAuth.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
+func (m *PrometheusProviderConfig) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = m.Authentication.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.Timeout != nil {
+		n += 1 + sovGenerated(uint64(*m.Timeout))
+	}
+	n += 2
+	if len(m.Headers) > 0 {
+		for _, e := range m.Headers {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	return n
+}
+
 func (m *PrometheusRangeQueryArgs) Size() (n int) {
 	if m == nil {
 		return 0
@@ -13292,6 +16552,26 @@ func (m *RolloutTrafficRouting) Size() (n int) {
 	if m.MaxTrafficWeight != nil {
 		n += 1 + sovGenerated(uint64(*m.MaxTrafficWeight))
 	}
+	if m.GatewayAPI != nil {
+		l = m.GatewayAPI.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.Stickiness != nil {
+		l = m.Stickiness.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.Kong != nil {
+		l = m.Kong.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.Contour != nil {
+		l = m.Contour.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.Consul != nil {
+		l = m.Consul.Size()
+		n += 2 + l + sovGenerated(uint64(l))
+	}
 	return n
 }
 
@@ -13335,6 +16615,42 @@ func (m *RunSummary) Size() (n int) {
 	return n
 }
 
+func (m *SLOBurnRateMetric) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Prometheus.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.ErrorRatioQuery)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Target)
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Windows) > 0 {
+		for _, e := range m.Windows {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *SLOBurnRateWindow) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ShortWindow)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.LongWindow)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.BurnRate)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
 func (m *SMITrafficRouting) Size() (n int) {
 	if m == nil {
 		return 0
@@ -13348,6 +16664,28 @@ func (m *SMITrafficRouting) Size() (n int) {
 	return n
 }
 
+func (m *SQLMetric) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.SecretRef.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Query)
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Args) > 0 {
+		for _, s := range m.Args {
+			l = len(s)
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	l = len(m.Timeout)
+	n += 1 + l + sovGenerated(uint64(l))
+	n += 2
+	return n
+}
+
 func (m *ScopeDetail) Size() (n int) {
 	if m == nil {
 		return 0
@@ -13366,6 +16704,17 @@ func (m *ScopeDetail) Size() (n int) {
 	return n
 }
 
+func (m *ScoreThreshold) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	n += 1 + sovGenerated(uint64(m.Pass))
+	n += 1 + sovGenerated(uint64(m.Marginal))
+	return n
+}
+
 func (m *SecretKeyRef) Size() (n int) {
 	if m == nil {
 		return 0
@@ -13424,6 +16773,29 @@ func (m *SetHeaderRoute) Size() (n int) {
 	return n
 }
 
+func (m *SetLocality) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Zones) > 0 {
+		for _, s := range m.Zones {
+			l = len(s)
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	if len(m.Regions) > 0 {
+		for _, s := range m.Regions {
+			l = len(s)
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	return n
+}
+
 func (m *SetMirrorRoute) Size() (n int) {
 	if m == nil {
 		return 0
@@ -13656,6 +17028,24 @@ func (m *TraefikTrafficRouting) Size() (n int) {
 	_ = l
 	l = len(m.WeightedTraefikServiceName)
 	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.MirrorTraefikServiceName)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.IngressRouteName)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
+func (m *TrafficStickiness) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Cookie)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Header)
+	n += 1 + l + sovGenerated(uint64(l))
+	n += 1 + sovGenerated(uint64(m.DurationSeconds))
 	return n
 }
 
@@ -13695,6 +17085,10 @@ func (m *ValueFrom) Size() (n int) {
 		l = m.FieldRef.Size()
 		n += 1 + l + sovGenerated(uint64(l))
 	}
+	if m.ConfigMapKeyRef != nil {
+		l = m.ConfigMapKeyRef.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
 	return n
 }
 
@@ -13739,6 +17133,15 @@ func (m *WebMetric) Size() (n int) {
 	}
 	l = m.Authentication.Size()
 	n += 1 + l + sovGenerated(uint64(l))
+	if m.TLS != nil {
+		l = m.TLS.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.Retry != nil {
+		l = m.Retry.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	n += 2
 	return n
 }
 
@@ -13755,6 +17158,35 @@ func (m *WebMetricHeader) Size() (n int) {
 	return n
 }
 
+func (m *WebMetricRetry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	n += 1 + sovGenerated(uint64(m.Limit))
+	l = len(m.Backoff)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
+func (m *WebMetricTLS) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ClientCertSecretRef != nil {
+		l = m.ClientCertSecretRef.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	l = len(m.CABundle)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.ServerName)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
 func (m *WeightDestination) Size() (n int) {
 	if m == nil {
 		return 0
@@ -13803,6 +17235,17 @@ func (this *ALBTrafficRouting) String() string {
 	}, "")
 	return s
 }
+func (this *AdaptiveInterval) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&AdaptiveInterval{`,
+		`MinInterval:` + fmt.Sprintf("%v", this.MinInterval) + `,`,
+		`Condition:` + fmt.Sprintf("%v", this.Condition) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *AmbassadorTrafficRouting) String() string {
 	if this == nil {
 		return "nil"
@@ -13915,6 +17358,7 @@ func (this *AnalysisRunSpec) String() string {
 		`DryRun:` + repeatedStringForDryRun + `,`,
 		`MeasurementRetention:` + repeatedStringForMeasurementRetention + `,`,
 		`TTLStrategy:` + strings.Replace(this.TTLStrategy.String(), "TTLStrategy", "TTLStrategy", 1) + `,`,
+		`Scoring:` + strings.Replace(this.Scoring.String(), "AnalysisScoring", "AnalysisScoring", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -13936,6 +17380,7 @@ func (this *AnalysisRunStatus) String() string {
 		`RunSummary:` + strings.Replace(strings.Replace(this.RunSummary.String(), "RunSummary", "RunSummary", 1), `&`, ``, 1) + `,`,
 		`DryRunSummary:` + strings.Replace(this.DryRunSummary.String(), "RunSummary", "RunSummary", 1) + `,`,
 		`CompletedAt:` + strings.Replace(fmt.Sprintf("%v", this.CompletedAt), "Time", "v1.Time", 1) + `,`,
+		`Score:` + valueToStringGenerated(this.Score) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -13951,6 +17396,22 @@ func (this *AnalysisRunStrategy) String() string {
 	}, "")
 	return s
 }
+func (this *AnalysisScoring) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForWeights := "[]MetricWeight{"
+	for _, f := range this.Weights {
+		repeatedStringForWeights += strings.Replace(strings.Replace(f.String(), "MetricWeight", "MetricWeight", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForWeights += "}"
+	s := strings.Join([]string{`&AnalysisScoring{`,
+		`Weights:` + repeatedStringForWeights + `,`,
+		`Threshold:` + strings.Replace(strings.Replace(this.Threshold.String(), "ScoreThreshold", "ScoreThreshold", 1), `&`, ``, 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *AnalysisTemplate) String() string {
 	if this == nil {
 		return "nil"
@@ -14024,6 +17485,7 @@ func (this *AnalysisTemplateSpec) String() string {
 		`DryRun:` + repeatedStringForDryRun + `,`,
 		`MeasurementRetention:` + repeatedStringForMeasurementRetention + `,`,
 		`Templates:` + repeatedStringForTemplates + `,`,
+		`Scoring:` + strings.Replace(this.Scoring.String(), "AnalysisScoring", "AnalysisScoring", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -14122,6 +17584,7 @@ func (this *ArgumentValueFrom) String() string {
 	s := strings.Join([]string{`&ArgumentValueFrom{`,
 		`PodTemplateHashValue:` + valueToStringGenerated(this.PodTemplateHashValue) + `,`,
 		`FieldRef:` + strings.Replace(this.FieldRef.String(), "FieldRef", "FieldRef", 1) + `,`,
+		`RolloutValue:` + valueToStringGenerated(this.RolloutValue) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -14161,6 +17624,17 @@ func (this *BasicAuthConfig) String() string {
 	}, "")
 	return s
 }
+func (this *BasicAuthProviderConfig) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&BasicAuthProviderConfig{`,
+		`UsernameSecretRef:` + strings.Replace(this.UsernameSecretRef.String(), "SecretKeyRef", "SecretKeyRef", 1) + `,`,
+		`PasswordSecretRef:` + strings.Replace(this.PasswordSecretRef.String(), "SecretKeyRef", "SecretKeyRef", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *BlueGreenStatus) String() string {
 	if this == nil {
 		return "nil"
@@ -14231,6 +17705,7 @@ func (this *CanaryStep) String() string {
 		`SetHeaderRoute:` + strings.Replace(this.SetHeaderRoute.String(), "SetHeaderRoute", "SetHeaderRoute", 1) + `,`,
 		`SetMirrorRoute:` + strings.Replace(this.SetMirrorRoute.String(), "SetMirrorRoute", "SetMirrorRoute", 1) + `,`,
 		`Plugin:` + strings.Replace(this.Plugin.String(), "PluginStep", "PluginStep", 1) + `,`,
+		`SetLocality:` + strings.Replace(this.SetLocality.String(), "SetLocality", "SetLocality", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -14365,6 +17840,38 @@ func (this *ClusterAnalysisTemplateList) String() string {
 	}, "")
 	return s
 }
+func (this *ConfigMapKeyRef) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ConfigMapKeyRef{`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`Key:` + fmt.Sprintf("%v", this.Key) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ConsulTrafficRouting) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ConsulTrafficRouting{`,
+		`ServiceSplitterName:` + fmt.Sprintf("%v", this.ServiceSplitterName) + `,`,
+		`ServiceRouterName:` + fmt.Sprintf("%v", this.ServiceRouterName) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ContourTrafficRouting) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ContourTrafficRouting{`,
+		`HTTPProxies:` + fmt.Sprintf("%v", this.HTTPProxies) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *DatadogMetric) String() string {
 	if this == nil {
 		return "nil"
@@ -14392,6 +17899,17 @@ func (this *DatadogMetric) String() string {
 	}, "")
 	return s
 }
+func (this *DatadogProviderConfig) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&DatadogProviderConfig{`,
+		`SecretName:` + fmt.Sprintf("%v", this.SecretName) + `,`,
+		`ApiVersion:` + fmt.Sprintf("%v", this.ApiVersion) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *DryRun) String() string {
 	if this == nil {
 		return "nil"
@@ -14402,6 +17920,17 @@ func (this *DryRun) String() string {
 	}, "")
 	return s
 }
+func (this *EarlySuccess) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&EarlySuccess{`,
+		`ConsecutiveSuccessLimit:` + fmt.Sprintf("%v", this.ConsecutiveSuccessLimit) + `,`,
+		`Condition:` + fmt.Sprintf("%v", this.Condition) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *Experiment) String() string {
 	if this == nil {
 		return "nil"
@@ -14555,6 +18084,18 @@ func (this *FieldRef) String() string {
 	}, "")
 	return s
 }
+func (this *GatewayAPITrafficRouting) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&GatewayAPITrafficRouting{`,
+		`HTTPRoute:` + fmt.Sprintf("%v", this.HTTPRoute) + `,`,
+		`GRPCRoute:` + fmt.Sprintf("%v", this.GRPCRoute) + `,`,
+		`TCPRoute:` + fmt.Sprintf("%v", this.TCPRoute) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *GraphiteMetric) String() string {
 	if this == nil {
 		return "nil"
@@ -14588,6 +18129,16 @@ func (this *InfluxdbMetric) String() string {
 	}, "")
 	return s
 }
+func (this *InfluxdbProviderConfig) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&InfluxdbProviderConfig{`,
+		`Profile:` + fmt.Sprintf("%v", this.Profile) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *IstioDestinationRule) String() string {
 	if this == nil {
 		return "nil"
@@ -14648,6 +18199,20 @@ func (this *JobMetric) String() string {
 	s := strings.Join([]string{`&JobMetric{`,
 		`Metadata:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Metadata), "ObjectMeta", "v1.ObjectMeta", 1), `&`, ``, 1) + `,`,
 		`Spec:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Spec), "JobSpec", "v11.JobSpec", 1), `&`, ``, 1) + `,`,
+		`Result:` + strings.Replace(this.Result.String(), "JobMetricResult", "JobMetricResult", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *JobMetricResult) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&JobMetricResult{`,
+		`Source:` + fmt.Sprintf("%v", this.Source) + `,`,
+		`Container:` + fmt.Sprintf("%v", this.Container) + `,`,
+		`LogPrefix:` + fmt.Sprintf("%v", this.LogPrefix) + `,`,
+		`JSONPath:` + fmt.Sprintf("%v", this.JSONPath) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -14698,6 +18263,18 @@ func (this *KayentaThreshold) String() string {
 	}, "")
 	return s
 }
+func (this *KongTrafficRouting) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&KongTrafficRouting{`,
+		`StableTarget:` + fmt.Sprintf("%v", this.StableTarget) + `,`,
+		`CanaryTarget:` + fmt.Sprintf("%v", this.CanaryTarget) + `,`,
+		`CanaryPlugin:` + fmt.Sprintf("%v", this.CanaryPlugin) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *MangedRoutes) String() string {
 	if this == nil {
 		return "nil"
@@ -14761,6 +18338,9 @@ func (this *Metric) String() string {
 		`ConsecutiveErrorLimit:` + strings.Replace(fmt.Sprintf("%v", this.ConsecutiveErrorLimit), "IntOrString", "intstr.IntOrString", 1) + `,`,
 		`Provider:` + strings.Replace(strings.Replace(this.Provider.String(), "MetricProvider", "MetricProvider", 1), `&`, ``, 1) + `,`,
 		`ConsecutiveSuccessLimit:` + strings.Replace(fmt.Sprintf("%v", this.ConsecutiveSuccessLimit), "IntOrString", "intstr.IntOrString", 1) + `,`,
+		`DependsOn:` + fmt.Sprintf("%v", this.DependsOn) + `,`,
+		`AdaptiveInterval:` + strings.Replace(this.AdaptiveInterval.String(), "AdaptiveInterval", "AdaptiveInterval", 1) + `,`,
+		`EarlySuccess:` + strings.Replace(this.EarlySuccess.String(), "EarlySuccess", "EarlySuccess", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -14792,6 +18372,61 @@ func (this *MetricProvider) String() string {
 		`Influxdb:` + strings.Replace(this.Influxdb.String(), "InfluxdbMetric", "InfluxdbMetric", 1) + `,`,
 		`SkyWalking:` + strings.Replace(this.SkyWalking.String(), "SkyWalkingMetric", "SkyWalkingMetric", 1) + `,`,
 		`Plugin:` + mapStringForPlugin + `,`,
+		`SLOBurnRate:` + strings.Replace(this.SLOBurnRate.String(), "SLOBurnRateMetric", "SLOBurnRateMetric", 1) + `,`,
+		`ConfigRef:` + strings.Replace(this.ConfigRef.String(), "MetricProviderConfigRef", "MetricProviderConfigRef", 1) + `,`,
+		`OpenSearch:` + strings.Replace(this.OpenSearch.String(), "OpenSearchMetric", "OpenSearchMetric", 1) + `,`,
+		`SQL:` + strings.Replace(this.SQL.String(), "SQLMetric", "SQLMetric", 1) + `,`,
+		`Probe:` + strings.Replace(this.Probe.String(), "ProbeMetric", "ProbeMetric", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *MetricProviderConfig) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&MetricProviderConfig{`,
+		`ObjectMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ObjectMeta), "ObjectMeta", "v1.ObjectMeta", 1), `&`, ``, 1) + `,`,
+		`Spec:` + strings.Replace(strings.Replace(this.Spec.String(), "MetricProviderConfigSpec", "MetricProviderConfigSpec", 1), `&`, ``, 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *MetricProviderConfigList) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForItems := "[]MetricProviderConfig{"
+	for _, f := range this.Items {
+		repeatedStringForItems += strings.Replace(strings.Replace(f.String(), "MetricProviderConfig", "MetricProviderConfig", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForItems += "}"
+	s := strings.Join([]string{`&MetricProviderConfigList{`,
+		`ListMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ListMeta), "ListMeta", "v1.ListMeta", 1), `&`, ``, 1) + `,`,
+		`Items:` + repeatedStringForItems + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *MetricProviderConfigRef) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&MetricProviderConfigRef{`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *MetricProviderConfigSpec) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&MetricProviderConfigSpec{`,
+		`Prometheus:` + strings.Replace(this.Prometheus.String(), "PrometheusProviderConfig", "PrometheusProviderConfig", 1) + `,`,
+		`Datadog:` + strings.Replace(this.Datadog.String(), "DatadogProviderConfig", "DatadogProviderConfig", 1) + `,`,
+		`NewRelic:` + strings.Replace(this.NewRelic.String(), "NewRelicProviderConfig", "NewRelicProviderConfig", 1) + `,`,
+		`Influxdb:` + strings.Replace(this.Influxdb.String(), "InfluxdbProviderConfig", "InfluxdbProviderConfig", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -14833,6 +18468,17 @@ func (this *MetricResult) String() string {
 	}, "")
 	return s
 }
+func (this *MetricWeight) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&MetricWeight{`,
+		`MetricName:` + fmt.Sprintf("%v", this.MetricName) + `,`,
+		`Weight:` + fmt.Sprintf("%v", this.Weight) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *NewRelicMetric) String() string {
 	if this == nil {
 		return "nil"
@@ -14845,6 +18491,16 @@ func (this *NewRelicMetric) String() string {
 	}, "")
 	return s
 }
+func (this *NewRelicProviderConfig) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&NewRelicProviderConfig{`,
+		`Profile:` + fmt.Sprintf("%v", this.Profile) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *NginxTrafficRouting) String() string {
 	if this == nil {
 		return "nil"
@@ -14892,6 +18548,19 @@ func (this *OAuth2Config) String() string {
 	}, "")
 	return s
 }
+func (this *OAuth2ProviderConfig) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&OAuth2ProviderConfig{`,
+		`TokenURL:` + fmt.Sprintf("%v", this.TokenURL) + `,`,
+		`ClientID:` + fmt.Sprintf("%v", this.ClientID) + `,`,
+		`ClientSecretRef:` + strings.Replace(this.ClientSecretRef.String(), "SecretKeyRef", "SecretKeyRef", 1) + `,`,
+		`Scopes:` + fmt.Sprintf("%v", this.Scopes) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *ObjectRef) String() string {
 	if this == nil {
 		return "nil"
@@ -14905,6 +18574,23 @@ func (this *ObjectRef) String() string {
 	}, "")
 	return s
 }
+func (this *OpenSearchMetric) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&OpenSearchMetric{`,
+		`Address:` + fmt.Sprintf("%v", this.Address) + `,`,
+		`Index:` + fmt.Sprintf("%v", this.Index) + `,`,
+		`Query:` + fmt.Sprintf("%v", this.Query) + `,`,
+		`Language:` + fmt.Sprintf("%v", this.Language) + `,`,
+		`ValuePath:` + fmt.Sprintf("%v", this.ValuePath) + `,`,
+		`SecretRef:` + strings.Replace(strings.Replace(this.SecretRef.String(), "SecretRef", "SecretRef", 1), `&`, ``, 1) + `,`,
+		`RequestTimeout:` + fmt.Sprintf("%v", this.RequestTimeout) + `,`,
+		`Insecure:` + fmt.Sprintf("%v", this.Insecure) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *PauseCondition) String() string {
 	if this == nil {
 		return "nil"
@@ -14979,6 +18665,58 @@ func (this *PreferredDuringSchedulingIgnoredDuringExecution) String() string {
 	}, "")
 	return s
 }
+func (this *ProbeGRPC) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForMetadata := "[]WebMetricHeader{"
+	for _, f := range this.Metadata {
+		repeatedStringForMetadata += strings.Replace(strings.Replace(f.String(), "WebMetricHeader", "WebMetricHeader", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForMetadata += "}"
+	s := strings.Join([]string{`&ProbeGRPC{`,
+		`Address:` + fmt.Sprintf("%v", this.Address) + `,`,
+		`Service:` + fmt.Sprintf("%v", this.Service) + `,`,
+		`Metadata:` + repeatedStringForMetadata + `,`,
+		`TLS:` + fmt.Sprintf("%v", this.TLS) + `,`,
+		`Insecure:` + fmt.Sprintf("%v", this.Insecure) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ProbeHTTP) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForHeaders := "[]WebMetricHeader{"
+	for _, f := range this.Headers {
+		repeatedStringForHeaders += strings.Replace(strings.Replace(f.String(), "WebMetricHeader", "WebMetricHeader", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForHeaders += "}"
+	s := strings.Join([]string{`&ProbeHTTP{`,
+		`URL:` + fmt.Sprintf("%v", this.URL) + `,`,
+		`Method:` + fmt.Sprintf("%v", this.Method) + `,`,
+		`Headers:` + repeatedStringForHeaders + `,`,
+		`Body:` + fmt.Sprintf("%v", this.Body) + `,`,
+		`Insecure:` + fmt.Sprintf("%v", this.Insecure) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ProbeMetric) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ProbeMetric{`,
+		`HTTP:` + strings.Replace(this.HTTP.String(), "ProbeHTTP", "ProbeHTTP", 1) + `,`,
+		`GRPC:` + strings.Replace(this.GRPC.String(), "ProbeGRPC", "ProbeGRPC", 1) + `,`,
+		`Requests:` + fmt.Sprintf("%v", this.Requests) + `,`,
+		`RPS:` + fmt.Sprintf("%v", this.RPS) + `,`,
+		`Timeout:` + fmt.Sprintf("%v", this.Timeout) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *PrometheusMetric) String() string {
 	if this == nil {
 		return "nil"
@@ -15000,6 +18738,37 @@ func (this *PrometheusMetric) String() string {
 	}, "")
 	return s
 }
+func (this *PrometheusProviderAuthentication) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&PrometheusProviderAuthentication{`,
+		`Sigv4:` + strings.Replace(strings.Replace(this.Sigv4.String(), "Sigv4Config", "Sigv4Config", 1), `&`, ``, 1) + `,`,
+		`OAuth2:` + strings.Replace(strings.Replace(this.OAuth2.String(), "OAuth2ProviderConfig", "OAuth2ProviderConfig", 1), `&`, ``, 1) + `,`,
+		`BasicAuth:` + strings.Replace(strings.Replace(this.BasicAuth.String(), "BasicAuthProviderConfig", "BasicAuthProviderConfig", 1), `&`, ``, 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *PrometheusProviderConfig) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForHeaders := "[]WebMetricHeader{"
+	for _, f := range this.Headers {
+		repeatedStringForHeaders += strings.Replace(strings.Replace(f.String(), "WebMetricHeader", "WebMetricHeader", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForHeaders += "}"
+	s := strings.Join([]string{`&PrometheusProviderConfig{`,
+		`Address:` + fmt.Sprintf("%v", this.Address) + `,`,
+		`Authentication:` + strings.Replace(strings.Replace(this.Authentication.String(), "PrometheusProviderAuthentication", "PrometheusProviderAuthentication", 1), `&`, ``, 1) + `,`,
+		`Timeout:` + valueToStringGenerated(this.Timeout) + `,`,
+		`Insecure:` + fmt.Sprintf("%v", this.Insecure) + `,`,
+		`Headers:` + repeatedStringForHeaders + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *PrometheusRangeQueryArgs) String() string {
 	if this == nil {
 		return "nil"
@@ -15346,6 +19115,11 @@ func (this *RolloutTrafficRouting) String() string {
 		`Apisix:` + strings.Replace(this.Apisix.String(), "ApisixTrafficRouting", "ApisixTrafficRouting", 1) + `,`,
 		`Plugins:` + mapStringForPlugins + `,`,
 		`MaxTrafficWeight:` + valueToStringGenerated(this.MaxTrafficWeight) + `,`,
+		`GatewayAPI:` + strings.Replace(this.GatewayAPI.String(), "GatewayAPITrafficRouting", "GatewayAPITrafficRouting", 1) + `,`,
+		`Stickiness:` + strings.Replace(this.Stickiness.String(), "TrafficStickiness", "TrafficStickiness", 1) + `,`,
+		`Kong:` + strings.Replace(this.Kong.String(), "KongTrafficRouting", "KongTrafficRouting", 1) + `,`,
+		`Contour:` + strings.Replace(this.Contour.String(), "ContourTrafficRouting", "ContourTrafficRouting", 1) + `,`,
+		`Consul:` + strings.Replace(this.Consul.String(), "ConsulTrafficRouting", "ConsulTrafficRouting", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -15386,6 +19160,36 @@ func (this *RunSummary) String() string {
 	}, "")
 	return s
 }
+func (this *SLOBurnRateMetric) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForWindows := "[]SLOBurnRateWindow{"
+	for _, f := range this.Windows {
+		repeatedStringForWindows += strings.Replace(strings.Replace(f.String(), "SLOBurnRateWindow", "SLOBurnRateWindow", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForWindows += "}"
+	s := strings.Join([]string{`&SLOBurnRateMetric{`,
+		`Prometheus:` + strings.Replace(strings.Replace(this.Prometheus.String(), "PrometheusMetric", "PrometheusMetric", 1), `&`, ``, 1) + `,`,
+		`ErrorRatioQuery:` + fmt.Sprintf("%v", this.ErrorRatioQuery) + `,`,
+		`Target:` + fmt.Sprintf("%v", this.Target) + `,`,
+		`Windows:` + repeatedStringForWindows + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *SLOBurnRateWindow) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&SLOBurnRateWindow{`,
+		`ShortWindow:` + fmt.Sprintf("%v", this.ShortWindow) + `,`,
+		`LongWindow:` + fmt.Sprintf("%v", this.LongWindow) + `,`,
+		`BurnRate:` + fmt.Sprintf("%v", this.BurnRate) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *SMITrafficRouting) String() string {
 	if this == nil {
 		return "nil"
@@ -15397,6 +19201,20 @@ func (this *SMITrafficRouting) String() string {
 	}, "")
 	return s
 }
+func (this *SQLMetric) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&SQLMetric{`,
+		`SecretRef:` + strings.Replace(strings.Replace(this.SecretRef.String(), "SecretRef", "SecretRef", 1), `&`, ``, 1) + `,`,
+		`Query:` + fmt.Sprintf("%v", this.Query) + `,`,
+		`Args:` + fmt.Sprintf("%v", this.Args) + `,`,
+		`Timeout:` + fmt.Sprintf("%v", this.Timeout) + `,`,
+		`ResultSet:` + fmt.Sprintf("%v", this.ResultSet) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *ScopeDetail) String() string {
 	if this == nil {
 		return "nil"
@@ -15411,6 +19229,17 @@ func (this *ScopeDetail) String() string {
 	}, "")
 	return s
 }
+func (this *ScoreThreshold) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ScoreThreshold{`,
+		`Pass:` + fmt.Sprintf("%v", this.Pass) + `,`,
+		`Marginal:` + fmt.Sprintf("%v", this.Marginal) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *SecretKeyRef) String() string {
 	if this == nil {
 		return "nil"
@@ -15461,6 +19290,18 @@ func (this *SetHeaderRoute) String() string {
 	}, "")
 	return s
 }
+func (this *SetLocality) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&SetLocality{`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`Zones:` + fmt.Sprintf("%v", this.Zones) + `,`,
+		`Regions:` + fmt.Sprintf("%v", this.Regions) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *SetMirrorRoute) String() string {
 	if this == nil {
 		return "nil"
@@ -15630,6 +19471,20 @@ func (this *TraefikTrafficRouting) String() string {
 	}
 	s := strings.Join([]string{`&TraefikTrafficRouting{`,
 		`WeightedTraefikServiceName:` + fmt.Sprintf("%v", this.WeightedTraefikServiceName) + `,`,
+		`MirrorTraefikServiceName:` + fmt.Sprintf("%v", this.MirrorTraefikServiceName) + `,`,
+		`IngressRouteName:` + fmt.Sprintf("%v", this.IngressRouteName) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *TrafficStickiness) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&TrafficStickiness{`,
+		`Cookie:` + fmt.Sprintf("%v", this.Cookie) + `,`,
+		`Header:` + fmt.Sprintf("%v", this.Header) + `,`,
+		`DurationSeconds:` + fmt.Sprintf("%v", this.DurationSeconds) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -15659,6 +19514,7 @@ func (this *ValueFrom) String() string {
 	s := strings.Join([]string{`&ValueFrom{`,
 		`SecretKeyRef:` + strings.Replace(this.SecretKeyRef.String(), "SecretKeyRef", "SecretKeyRef", 1) + `,`,
 		`FieldRef:` + strings.Replace(this.FieldRef.String(), "FieldRef", "FieldRef", 1) + `,`,
+		`ConfigMapKeyRef:` + strings.Replace(this.ConfigMapKeyRef.String(), "ConfigMapKeyRef", "ConfigMapKeyRef", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -15693,6 +19549,9 @@ func (this *WebMetric) String() string {
 		`Insecure:` + fmt.Sprintf("%v", this.Insecure) + `,`,
 		`JSONBody:` + valueToStringGenerated(this.JSONBody) + `,`,
 		`Authentication:` + strings.Replace(strings.Replace(this.Authentication.String(), "Authentication", "Authentication", 1), `&`, ``, 1) + `,`,
+		`TLS:` + strings.Replace(this.TLS.String(), "WebMetricTLS", "WebMetricTLS", 1) + `,`,
+		`Retry:` + strings.Replace(this.Retry.String(), "WebMetricRetry", "WebMetricRetry", 1) + `,`,
+		`IgnoreStatusCode:` + fmt.Sprintf("%v", this.IgnoreStatusCode) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -15708,6 +19567,29 @@ func (this *WebMetricHeader) String() string {
 	}, "")
 	return s
 }
+func (this *WebMetricRetry) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&WebMetricRetry{`,
+		`Limit:` + fmt.Sprintf("%v", this.Limit) + `,`,
+		`Backoff:` + fmt.Sprintf("%v", this.Backoff) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *WebMetricTLS) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&WebMetricTLS{`,
+		`ClientCertSecretRef:` + strings.Replace(this.ClientCertSecretRef.String(), "SecretRef", "SecretRef", 1) + `,`,
+		`CABundle:` + fmt.Sprintf("%v", this.CABundle) + `,`,
+		`ServerName:` + fmt.Sprintf("%v", this.ServerName) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *WeightDestination) String() string {
 	if this == nil {
 		return "nil"
@@ -16142,7 +20024,7 @@ func (m *ALBTrafficRouting) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AmbassadorTrafficRouting) Unmarshal(dAtA []byte) error {
+func (m *AdaptiveInterval) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -16165,15 +20047,15 @@ func (m *AmbassadorTrafficRouting) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AmbassadorTrafficRouting: wiretype end group for non-group")
+			return fmt.Errorf("proto: AdaptiveInterval: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AmbassadorTrafficRouting: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AdaptiveInterval: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Mappings", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MinInterval", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -16201,7 +20083,39 @@ func (m *AmbassadorTrafficRouting) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Mappings = append(m.Mappings, string(dAtA[iNdEx:postIndex]))
+			m.MinInterval = DurationString(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Condition", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Condition = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -16224,7 +20138,7 @@ func (m *AmbassadorTrafficRouting) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AnalysisRun) Unmarshal(dAtA []byte) error {
+func (m *AmbassadorTrafficRouting) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -16247,83 +20161,17 @@ func (m *AnalysisRun) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AnalysisRun: wiretype end group for non-group")
+			return fmt.Errorf("proto: AmbassadorTrafficRouting: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AnalysisRun: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AmbassadorTrafficRouting: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ObjectMeta", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.ObjectMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Spec", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Spec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Mappings", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -16333,24 +20181,23 @@ func (m *AnalysisRun) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Status.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Mappings = append(m.Mappings, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -16373,7 +20220,7 @@ func (m *AnalysisRun) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AnalysisRunArgument) Unmarshal(dAtA []byte) error {
+func (m *AnalysisRun) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -16396,17 +20243,17 @@ func (m *AnalysisRunArgument) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AnalysisRunArgument: wiretype end group for non-group")
+			return fmt.Errorf("proto: AnalysisRun: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AnalysisRunArgument: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AnalysisRun: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectMeta", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -16416,59 +20263,28 @@ func (m *AnalysisRunArgument) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			if err := m.ObjectMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.Value = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValueFrom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Spec", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -16495,66 +20311,246 @@ func (m *AnalysisRunArgument) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ValueFrom == nil {
-				m.ValueFrom = &ArgumentValueFrom{}
-			}
-			if err := m.ValueFrom.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Spec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *AnalysisRunList) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: AnalysisRunList: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AnalysisRunList: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ListMeta", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Status.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AnalysisRunArgument) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AnalysisRunArgument: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AnalysisRunArgument: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Value = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValueFrom", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ValueFrom == nil {
+				m.ValueFrom = &ArgumentValueFrom{}
+			}
+			if err := m.ValueFrom.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AnalysisRunList) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AnalysisRunList: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AnalysisRunList: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ListMeta", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -17165,6 +21161,42 @@ func (m *AnalysisRunSpec) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Scoring", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Scoring == nil {
+				m.Scoring = &AnalysisScoring{}
+			}
+			if err := m.Scoring.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -17454,6 +21486,26 @@ func (m *AnalysisRunStatus) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Score", wireType)
+			}
+			var v int64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Score = &v
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -17565,6 +21617,123 @@ func (m *AnalysisRunStrategy) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *AnalysisScoring) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AnalysisScoring: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AnalysisScoring: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Weights", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Weights = append(m.Weights, MetricWeight{})
+			if err := m.Weights[len(m.Weights)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Threshold", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Threshold.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *AnalysisTemplate) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -18100,6 +22269,42 @@ func (m *AnalysisTemplateSpec) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Scoring", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Scoring == nil {
+				m.Scoring = &AnalysisScoring{}
+			}
+			if err := m.Scoring.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -19132,6 +23337,39 @@ func (m *ArgumentValueFrom) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RolloutValue", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := ValueFromRollout(dAtA[iNdEx:postIndex])
+			m.RolloutValue = &s
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -19562,7 +23800,7 @@ func (m *BasicAuthConfig) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *BlueGreenStatus) Unmarshal(dAtA []byte) error {
+func (m *BasicAuthProviderConfig) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -19585,17 +23823,17 @@ func (m *BlueGreenStatus) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: BlueGreenStatus: wiretype end group for non-group")
+			return fmt.Errorf("proto: BasicAuthProviderConfig: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: BlueGreenStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: BasicAuthProviderConfig: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PreviewSelector", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UsernameSecretRef", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -19605,79 +23843,201 @@ func (m *BlueGreenStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PreviewSelector = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ActiveSelector", wireType)
+			if m.UsernameSecretRef == nil {
+				m.UsernameSecretRef = &SecretKeyRef{}
 			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			if err := m.UsernameSecretRef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.ActiveSelector = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ScaleUpPreviewCheckPoint", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.ScaleUpPreviewCheckPoint = bool(v != 0)
-		case 4:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PrePromotionAnalysisRunStatus", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PasswordSecretRef", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PasswordSecretRef == nil {
+				m.PasswordSecretRef = &SecretKeyRef{}
+			}
+			if err := m.PasswordSecretRef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BlueGreenStatus) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BlueGreenStatus: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BlueGreenStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PreviewSelector", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PreviewSelector = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActiveSelector", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ActiveSelector = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScaleUpPreviewCheckPoint", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ScaleUpPreviewCheckPoint = bool(v != 0)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PrePromotionAnalysisRunStatus", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -20775,123 +25135,159 @@ func (m *CanaryStep) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *CanaryStrategy) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: CanaryStrategy: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CanaryStrategy: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CanaryService", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.CanaryService = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StableService", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.StableService = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
+		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Steps", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SetLocality", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SetLocality == nil {
+				m.SetLocality = &SetLocality{}
+			}
+			if err := m.SetLocality.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CanaryStrategy) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CanaryStrategy: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CanaryStrategy: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CanaryService", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CanaryService = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StableService", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StableService = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Steps", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -22401,7 +26797,317 @@ func (m *ClusterAnalysisTemplateList) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DatadogMetric) Unmarshal(dAtA []byte) error {
+func (m *ConfigMapKeyRef) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ConfigMapKeyRef: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ConfigMapKeyRef: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Key = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ConsulTrafficRouting) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ConsulTrafficRouting: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ConsulTrafficRouting: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ServiceSplitterName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ServiceSplitterName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ServiceRouterName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ServiceRouterName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ContourTrafficRouting) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ContourTrafficRouting: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ContourTrafficRouting: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HTTPProxies", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HTTPProxies = append(m.HTTPProxies, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DatadogMetric) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -22803,7 +27509,7 @@ func (m *DatadogMetric) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DryRun) Unmarshal(dAtA []byte) error {
+func (m *DatadogProviderConfig) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -22826,15 +27532,15 @@ func (m *DryRun) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DryRun: wiretype end group for non-group")
+			return fmt.Errorf("proto: DatadogProviderConfig: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DryRun: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DatadogProviderConfig: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MetricName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SecretName", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -22862,7 +27568,39 @@ func (m *DryRun) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MetricName = string(dAtA[iNdEx:postIndex])
+			m.SecretName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ApiVersion", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ApiVersion = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -22885,7 +27623,7 @@ func (m *DryRun) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Experiment) Unmarshal(dAtA []byte) error {
+func (m *DryRun) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -22908,83 +27646,17 @@ func (m *Experiment) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Experiment: wiretype end group for non-group")
+			return fmt.Errorf("proto: DryRun: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Experiment: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DryRun: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ObjectMeta", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.ObjectMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Spec", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Spec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MetricName", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -22994,24 +27666,23 @@ func (m *Experiment) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Status.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.MetricName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -23034,7 +27705,257 @@ func (m *Experiment) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ExperimentAnalysisRunStatus) Unmarshal(dAtA []byte) error {
+func (m *EarlySuccess) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EarlySuccess: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EarlySuccess: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsecutiveSuccessLimit", wireType)
+			}
+			m.ConsecutiveSuccessLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ConsecutiveSuccessLimit |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Condition", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Condition = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Experiment) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Experiment: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Experiment: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectMeta", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ObjectMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Spec", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Spec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Status.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ExperimentAnalysisRunStatus) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -24407,7 +29328,7 @@ func (m *FieldRef) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GraphiteMetric) Unmarshal(dAtA []byte) error {
+func (m *GatewayAPITrafficRouting) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -24430,15 +29351,15 @@ func (m *GraphiteMetric) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GraphiteMetric: wiretype end group for non-group")
+			return fmt.Errorf("proto: GatewayAPITrafficRouting: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GraphiteMetric: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GatewayAPITrafficRouting: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field HTTPRoute", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -24466,11 +29387,11 @@ func (m *GraphiteMetric) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
+			m.HTTPRoute = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Query", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GRPCRoute", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -24498,7 +29419,153 @@ func (m *GraphiteMetric) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Query = string(dAtA[iNdEx:postIndex])
+			m.GRPCRoute = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TCPRoute", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TCPRoute = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GraphiteMetric) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GraphiteMetric: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GraphiteMetric: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Query", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Query = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -24753,6 +29820,88 @@ func (m *InfluxdbMetric) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *InfluxdbProviderConfig) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: InfluxdbProviderConfig: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: InfluxdbProviderConfig: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Profile", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Profile = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *IstioDestinationRule) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -25364,6 +30513,42 @@ func (m *JobMetric) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Result == nil {
+				m.Result = &JobMetricResult{}
+			}
+			if err := m.Result.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -25385,7 +30570,7 @@ func (m *JobMetric) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *KayentaMetric) Unmarshal(dAtA []byte) error {
+func (m *JobMetricResult) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -25408,15 +30593,193 @@ func (m *KayentaMetric) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: KayentaMetric: wiretype end group for non-group")
+			return fmt.Errorf("proto: JobMetricResult: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: KayentaMetric: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: JobMetricResult: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Source = JobResultSource(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Container", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Container = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LogPrefix", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LogPrefix = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JSONPath", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.JSONPath = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *KayentaMetric) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: KayentaMetric: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: KayentaMetric: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -25950,7 +31313,7 @@ func (m *KayentaThreshold) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MangedRoutes) Unmarshal(dAtA []byte) error {
+func (m *KongTrafficRouting) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -25973,15 +31336,15 @@ func (m *MangedRoutes) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MangedRoutes: wiretype end group for non-group")
+			return fmt.Errorf("proto: KongTrafficRouting: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MangedRoutes: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: KongTrafficRouting: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StableTarget", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -26009,7 +31372,71 @@ func (m *MangedRoutes) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.StableTarget = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CanaryTarget", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CanaryTarget = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CanaryPlugin", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CanaryPlugin = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -26032,7 +31459,7 @@ func (m *MangedRoutes) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Measurement) Unmarshal(dAtA []byte) error {
+func (m *MangedRoutes) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -26055,15 +31482,15 @@ func (m *Measurement) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Measurement: wiretype end group for non-group")
+			return fmt.Errorf("proto: MangedRoutes: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Measurement: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MangedRoutes: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Phase", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -26091,11 +31518,93 @@ func (m *Measurement) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Phase = AnalysisPhase(dAtA[iNdEx:postIndex])
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Measurement) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Measurement: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Measurement: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Phase", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Phase = AnalysisPhase(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -26916,6 +32425,110 @@ func (m *Metric) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DependsOn", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DependsOn = append(m.DependsOn, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AdaptiveInterval", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.AdaptiveInterval == nil {
+				m.AdaptiveInterval = &AdaptiveInterval{}
+			}
+			if err := m.AdaptiveInterval.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EarlySuccess", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.EarlySuccess == nil {
+				m.EarlySuccess = &EarlySuccess{}
+			}
+			if err := m.EarlySuccess.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -27490,61 +33103,83 @@ func (m *MetricProvider) Unmarshal(dAtA []byte) error {
 			}
 			m.Plugin[mapkey] = ((encoding_json.RawMessage)(mapvalue))
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SLOBurnRate", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			if (iNdEx + skippy) > l {
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *MetricResult) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
+			if m.SLOBurnRate == nil {
+				m.SLOBurnRate = &SLOBurnRateMetric{}
 			}
-			if iNdEx >= l {
+			if err := m.SLOBurnRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConfigRef", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if m.ConfigRef == nil {
+				m.ConfigRef = &MetricProviderConfigRef{}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MetricResult: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MetricResult: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			if err := m.ConfigRef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OpenSearch", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -27554,29 +33189,33 @@ func (m *MetricResult) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			if m.OpenSearch == nil {
+				m.OpenSearch = &OpenSearchMetric{}
+			}
+			if err := m.OpenSearch.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		case 16:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Phase", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SQL", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -27586,27 +33225,690 @@ func (m *MetricResult) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Phase = AnalysisPhase(dAtA[iNdEx:postIndex])
+			if m.SQL == nil {
+				m.SQL = &SQLMetric{}
+			}
+			if err := m.SQL.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 3:
+		case 17:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Measurements", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Probe", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Probe == nil {
+				m.Probe = &ProbeMetric{}
+			}
+			if err := m.Probe.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MetricProviderConfig) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MetricProviderConfig: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MetricProviderConfig: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectMeta", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ObjectMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Spec", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Spec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MetricProviderConfigList) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MetricProviderConfigList: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MetricProviderConfigList: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ListMeta", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ListMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Items = append(m.Items, MetricProviderConfig{})
+			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MetricProviderConfigRef) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MetricProviderConfigRef: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MetricProviderConfigRef: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MetricProviderConfigSpec) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MetricProviderConfigSpec: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MetricProviderConfigSpec: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Prometheus", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Prometheus == nil {
+				m.Prometheus = &PrometheusProviderConfig{}
+			}
+			if err := m.Prometheus.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Datadog", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Datadog == nil {
+				m.Datadog = &DatadogProviderConfig{}
+			}
+			if err := m.Datadog.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewRelic", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.NewRelic == nil {
+				m.NewRelic = &NewRelicProviderConfig{}
+			}
+			if err := m.NewRelic.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Influxdb", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Influxdb == nil {
+				m.Influxdb = &InfluxdbProviderConfig{}
+			}
+			if err := m.Influxdb.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MetricResult) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MetricResult: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MetricResult: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Phase", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Phase = AnalysisPhase(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Measurements", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -27637,10 +33939,1627 @@ func (m *MetricResult) Unmarshal(dAtA []byte) error {
 			if err := m.Measurements[len(m.Measurements)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			iNdEx = postIndex
-		case 4:
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Message = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
+			}
+			m.Count = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Count |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Successful", wireType)
+			}
+			m.Successful = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Successful |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Failed", wireType)
+			}
+			m.Failed = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Failed |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Inconclusive", wireType)
+			}
+			m.Inconclusive = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Inconclusive |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			m.Error = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Error |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsecutiveError", wireType)
+			}
+			m.ConsecutiveError = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ConsecutiveError |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DryRun", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.DryRun = bool(v != 0)
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Metadata == nil {
+				m.Metadata = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Metadata[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsecutiveSuccess", wireType)
+			}
+			m.ConsecutiveSuccess = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ConsecutiveSuccess |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MetricWeight) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MetricWeight: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MetricWeight: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MetricName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MetricName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Weight", wireType)
+			}
+			m.Weight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Weight |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *NewRelicMetric) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: NewRelicMetric: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: NewRelicMetric: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Profile", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Profile = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Query", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Query = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timeout", wireType)
+			}
+			var v int64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Timeout = &v
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *NewRelicProviderConfig) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: NewRelicProviderConfig: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: NewRelicProviderConfig: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Profile", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Profile = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *NginxTrafficRouting) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: NginxTrafficRouting: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: NginxTrafficRouting: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AnnotationPrefix", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AnnotationPrefix = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StableIngress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StableIngress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AdditionalIngressAnnotations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.AdditionalIngressAnnotations == nil {
+				m.AdditionalIngressAnnotations = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.AdditionalIngressAnnotations[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StableIngresses", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StableIngresses = append(m.StableIngresses, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CanaryIngressAnnotations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.CanaryIngressAnnotations == nil {
+				m.CanaryIngressAnnotations = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.CanaryIngressAnnotations[mapkey] = mapvalue
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *OAuth2Config) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OAuth2Config: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OAuth2Config: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenURL", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenURL = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClientID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientSecret", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClientSecret = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Scopes", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Scopes = append(m.Scopes, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *OAuth2ProviderConfig) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OAuth2ProviderConfig: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OAuth2ProviderConfig: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenURL", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenURL = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClientID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientSecretRef", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ClientSecretRef == nil {
+				m.ClientSecretRef = &SecretKeyRef{}
+			}
+			if err := m.ClientSecretRef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Scopes", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Scopes = append(m.Scopes, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ObjectRef) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ObjectRef: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ObjectRef: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field APIVersion", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.APIVersion = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Kind = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScaleDown", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ScaleDown = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *OpenSearchMetric) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OpenSearchMetric: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OpenSearchMetric: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -27668,13 +35587,13 @@ func (m *MetricResult) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Message = string(dAtA[iNdEx:postIndex])
+			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
 			}
-			m.Count = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -27684,16 +35603,29 @@ func (m *MetricResult) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Count |= int32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Successful", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			m.Successful = 0
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Index = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Query", wireType)
+			}
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -27703,16 +35635,29 @@ func (m *MetricResult) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Successful |= int32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 7:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Failed", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			m.Failed = 0
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Query = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Language", wireType)
+			}
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -27722,16 +35667,29 @@ func (m *MetricResult) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Failed |= int32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 8:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Inconclusive", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			m.Inconclusive = 0
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Language = OpenSearchQueryLanguage(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValuePath", wireType)
+			}
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -27741,16 +35699,29 @@ func (m *MetricResult) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Inconclusive |= int32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 9:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			m.Error = 0
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValuePath = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SecretRef", wireType)
+			}
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -27760,16 +35731,30 @@ func (m *MetricResult) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Error |= int32(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 10:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConsecutiveError", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			m.ConsecutiveError = 0
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SecretRef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestTimeout", wireType)
+			}
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -27779,14 +35764,27 @@ func (m *MetricResult) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ConsecutiveError |= int32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 11:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RequestTimeout = DurationString(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DryRun", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Insecure", wireType)
 			}
 			var v int
 			for shift := uint(0); ; shift += 7 {
@@ -27803,12 +35801,62 @@ func (m *MetricResult) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-			m.DryRun = bool(v != 0)
-		case 12:
+			m.Insecure = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PauseCondition) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PauseCondition: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PauseCondition: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -27818,124 +35866,29 @@ func (m *MetricResult) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Metadata == nil {
-				m.Metadata = make(map[string]string)
-			}
-			var mapkey string
-			var mapvalue string
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowGenerated
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var stringLenmapvalue uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapvalue |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapvalue := int(stringLenmapvalue)
-					if intStringLenmapvalue < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
-					if postStringIndexmapvalue < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postStringIndexmapvalue > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
-					iNdEx = postStringIndexmapvalue
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipGenerated(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if (skippy < 0) || (iNdEx+skippy) < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
-				}
-			}
-			m.Metadata[mapkey] = mapvalue
+			m.Reason = PauseReason(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 13:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConsecutiveSuccess", wireType)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
 			}
-			m.ConsecutiveSuccess = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -27945,11 +35898,25 @@ func (m *MetricResult) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ConsecutiveSuccess |= int32(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.StartTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -27971,7 +35938,7 @@ func (m *MetricResult) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *NewRelicMetric) Unmarshal(dAtA []byte) error {
+func (m *PingPongSpec) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -27994,15 +35961,15 @@ func (m *NewRelicMetric) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: NewRelicMetric: wiretype end group for non-group")
+			return fmt.Errorf("proto: PingPongSpec: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: NewRelicMetric: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PingPongSpec: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Profile", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PingService", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -28030,11 +35997,11 @@ func (m *NewRelicMetric) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Profile = string(dAtA[iNdEx:postIndex])
+			m.PingService = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Query", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PongService", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -28062,28 +36029,8 @@ func (m *NewRelicMetric) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Query = string(dAtA[iNdEx:postIndex])
+			m.PongService = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Timeout", wireType)
-			}
-			var v int64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.Timeout = &v
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -28105,7 +36052,7 @@ func (m *NewRelicMetric) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *NginxTrafficRouting) Unmarshal(dAtA []byte) error {
+func (m *PluginStep) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -28128,15 +36075,15 @@ func (m *NginxTrafficRouting) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: NginxTrafficRouting: wiretype end group for non-group")
+			return fmt.Errorf("proto: PluginStep: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: NginxTrafficRouting: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PluginStep: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AnnotationPrefix", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -28164,13 +36111,13 @@ func (m *NginxTrafficRouting) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AnnotationPrefix = string(dAtA[iNdEx:postIndex])
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StableIngress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Config", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -28180,27 +36127,79 @@ func (m *NginxTrafficRouting) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.StableIngress = string(dAtA[iNdEx:postIndex])
+			m.Config = append(m.Config[:0], dAtA[iNdEx:postIndex]...)
+			if m.Config == nil {
+				m.Config = []byte{}
+			}
 			iNdEx = postIndex
-		case 3:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PodTemplateMetadata) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PodTemplateMetadata: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PodTemplateMetadata: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AdditionalIngressAnnotations", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Labels", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -28227,8 +36226,8 @@ func (m *NginxTrafficRouting) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.AdditionalIngressAnnotations == nil {
-				m.AdditionalIngressAnnotations = make(map[string]string)
+			if m.Labels == nil {
+				m.Labels = make(map[string]string)
 			}
 			var mapkey string
 			var mapvalue string
@@ -28323,43 +36322,11 @@ func (m *NginxTrafficRouting) Unmarshal(dAtA []byte) error {
 					iNdEx += skippy
 				}
 			}
-			m.AdditionalIngressAnnotations[mapkey] = mapvalue
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StableIngresses", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.StableIngresses = append(m.StableIngresses, string(dAtA[iNdEx:postIndex]))
+			m.Labels[mapkey] = mapvalue
 			iNdEx = postIndex
-		case 5:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CanaryIngressAnnotations", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Annotations", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -28386,8 +36353,8 @@ func (m *NginxTrafficRouting) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.CanaryIngressAnnotations == nil {
-				m.CanaryIngressAnnotations = make(map[string]string)
+			if m.Annotations == nil {
+				m.Annotations = make(map[string]string)
 			}
 			var mapkey string
 			var mapvalue string
@@ -28482,7 +36449,7 @@ func (m *NginxTrafficRouting) Unmarshal(dAtA []byte) error {
 					iNdEx += skippy
 				}
 			}
-			m.CanaryIngressAnnotations[mapkey] = mapvalue
+			m.Annotations[mapkey] = mapvalue
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -28505,7 +36472,7 @@ func (m *NginxTrafficRouting) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *OAuth2Config) Unmarshal(dAtA []byte) error {
+func (m *PreferredDuringSchedulingIgnoredDuringExecution) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -28528,17 +36495,17 @@ func (m *OAuth2Config) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: OAuth2Config: wiretype end group for non-group")
+			return fmt.Errorf("proto: PreferredDuringSchedulingIgnoredDuringExecution: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: OAuth2Config: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PreferredDuringSchedulingIgnoredDuringExecution: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TokenURL", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Weight", wireType)
 			}
-			var stringLen uint64
+			m.Weight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -28548,27 +36515,64 @@ func (m *OAuth2Config) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Weight |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TokenURL = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ProbeGRPC) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ProbeGRPC: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ProbeGRPC: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClientID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -28596,11 +36600,11 @@ func (m *OAuth2Config) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ClientID = string(dAtA[iNdEx:postIndex])
+			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClientSecret", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Service", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -28628,13 +36632,13 @@ func (m *OAuth2Config) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ClientSecret = string(dAtA[iNdEx:postIndex])
+			m.Service = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Scopes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -28644,24 +36648,66 @@ func (m *OAuth2Config) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Scopes = append(m.Scopes, string(dAtA[iNdEx:postIndex]))
+			m.Metadata = append(m.Metadata, WebMetricHeader{})
+			if err := m.Metadata[len(m.Metadata)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TLS", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.TLS = bool(v != 0)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Insecure", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Insecure = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -28683,7 +36729,7 @@ func (m *OAuth2Config) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ObjectRef) Unmarshal(dAtA []byte) error {
+func (m *ProbeHTTP) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -28706,15 +36752,15 @@ func (m *ObjectRef) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ObjectRef: wiretype end group for non-group")
+			return fmt.Errorf("proto: ProbeHTTP: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ObjectRef: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ProbeHTTP: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field APIVersion", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field URL", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -28742,11 +36788,11 @@ func (m *ObjectRef) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.APIVersion = string(dAtA[iNdEx:postIndex])
+			m.URL = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Method", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -28774,13 +36820,13 @@ func (m *ObjectRef) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Kind = string(dAtA[iNdEx:postIndex])
+			m.Method = WebMetricMethod(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Headers", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -28790,27 +36836,29 @@ func (m *ObjectRef) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.Headers = append(m.Headers, WebMetricHeader{})
+			if err := m.Headers[len(m.Headers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ScaleDown", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Body", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -28838,8 +36886,28 @@ func (m *ObjectRef) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ScaleDown = string(dAtA[iNdEx:postIndex])
+			m.Body = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Insecure", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Insecure = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -28861,7 +36929,7 @@ func (m *ObjectRef) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PauseCondition) Unmarshal(dAtA []byte) error {
+func (m *ProbeMetric) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -28884,17 +36952,17 @@ func (m *PauseCondition) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PauseCondition: wiretype end group for non-group")
+			return fmt.Errorf("proto: ProbeMetric: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PauseCondition: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ProbeMetric: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field HTTP", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -28904,27 +36972,31 @@ func (m *PauseCondition) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Reason = PauseReason(dAtA[iNdEx:postIndex])
+			if m.HTTP == nil {
+				m.HTTP = &ProbeHTTP{}
+			}
+			if err := m.HTTP.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GRPC", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -28951,10 +37023,83 @@ func (m *PauseCondition) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.StartTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.GRPC == nil {
+				m.GRPC = &ProbeGRPC{}
+			}
+			if err := m.GRPC.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Requests", wireType)
+			}
+			m.Requests = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Requests |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RPS", wireType)
+			}
+			m.RPS = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RPS |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timeout", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Timeout = DurationString(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -28976,7 +37121,7 @@ func (m *PauseCondition) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PingPongSpec) Unmarshal(dAtA []byte) error {
+func (m *PrometheusMetric) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -28999,15 +37144,15 @@ func (m *PingPongSpec) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PingPongSpec: wiretype end group for non-group")
+			return fmt.Errorf("proto: PrometheusMetric: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PingPongSpec: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PrometheusMetric: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PingService", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -29035,11 +37180,11 @@ func (m *PingPongSpec) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PingService = string(dAtA[iNdEx:postIndex])
+			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PongService", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Query", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -29067,63 +37212,86 @@ func (m *PingPongSpec) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PongService = string(dAtA[iNdEx:postIndex])
+			m.Query = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authentication", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			if (iNdEx + skippy) > l {
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *PluginStep) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
+			if err := m.Authentication.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timeout", wireType)
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			var v int64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: PluginStep: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PluginStep: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.Timeout = &v
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Insecure", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Insecure = bool(v != 0)
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Headers", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -29133,29 +37301,31 @@ func (m *PluginStep) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.Headers = append(m.Headers, WebMetricHeader{})
+			if err := m.Headers[len(m.Headers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Config", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RangeQuery", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -29165,24 +37335,26 @@ func (m *PluginStep) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Config = append(m.Config[:0], dAtA[iNdEx:postIndex]...)
-			if m.Config == nil {
-				m.Config = []byte{}
+			if m.RangeQuery == nil {
+				m.RangeQuery = &PrometheusRangeQueryArgs{}
+			}
+			if err := m.RangeQuery.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
 		default:
@@ -29206,7 +37378,7 @@ func (m *PluginStep) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PodTemplateMetadata) Unmarshal(dAtA []byte) error {
+func (m *PrometheusProviderAuthentication) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -29229,15 +37401,15 @@ func (m *PodTemplateMetadata) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PodTemplateMetadata: wiretype end group for non-group")
+			return fmt.Errorf("proto: PrometheusProviderAuthentication: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PodTemplateMetadata: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PrometheusProviderAuthentication: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Labels", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sigv4", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -29264,107 +37436,13 @@ func (m *PodTemplateMetadata) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Labels == nil {
-				m.Labels = make(map[string]string)
-			}
-			var mapkey string
-			var mapvalue string
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowGenerated
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var stringLenmapvalue uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapvalue |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapvalue := int(stringLenmapvalue)
-					if intStringLenmapvalue < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
-					if postStringIndexmapvalue < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postStringIndexmapvalue > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
-					iNdEx = postStringIndexmapvalue
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipGenerated(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if (skippy < 0) || (iNdEx+skippy) < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
-				}
+			if err := m.Sigv4.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.Labels[mapkey] = mapvalue
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Annotations", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OAuth2", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -29391,159 +37469,15 @@ func (m *PodTemplateMetadata) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Annotations == nil {
-				m.Annotations = make(map[string]string)
-			}
-			var mapkey string
-			var mapvalue string
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowGenerated
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var stringLenmapvalue uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapvalue |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapvalue := int(stringLenmapvalue)
-					if intStringLenmapvalue < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
-					if postStringIndexmapvalue < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postStringIndexmapvalue > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
-					iNdEx = postStringIndexmapvalue
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipGenerated(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if (skippy < 0) || (iNdEx+skippy) < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
-				}
-			}
-			m.Annotations[mapkey] = mapvalue
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
+			if err := m.OAuth2.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *PreferredDuringSchedulingIgnoredDuringExecution) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: PreferredDuringSchedulingIgnoredDuringExecution: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PreferredDuringSchedulingIgnoredDuringExecution: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Weight", wireType)
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BasicAuth", wireType)
 			}
-			m.Weight = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -29553,11 +37487,25 @@ func (m *PreferredDuringSchedulingIgnoredDuringExecution) Unmarshal(dAtA []byte)
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Weight |= int32(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BasicAuth.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -29579,7 +37527,7 @@ func (m *PreferredDuringSchedulingIgnoredDuringExecution) Unmarshal(dAtA []byte)
 	}
 	return nil
 }
-func (m *PrometheusMetric) Unmarshal(dAtA []byte) error {
+func (m *PrometheusProviderConfig) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -29602,10 +37550,10 @@ func (m *PrometheusMetric) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PrometheusMetric: wiretype end group for non-group")
+			return fmt.Errorf("proto: PrometheusProviderConfig: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PrometheusMetric: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PrometheusProviderConfig: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -29641,38 +37589,6 @@ func (m *PrometheusMetric) Unmarshal(dAtA []byte) error {
 			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Query", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Query = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Authentication", wireType)
 			}
@@ -29705,7 +37621,7 @@ func (m *PrometheusMetric) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		case 3:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Timeout", wireType)
 			}
@@ -29725,7 +37641,7 @@ func (m *PrometheusMetric) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.Timeout = &v
-		case 5:
+		case 4:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Insecure", wireType)
 			}
@@ -29745,7 +37661,7 @@ func (m *PrometheusMetric) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.Insecure = bool(v != 0)
-		case 6:
+		case 5:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Headers", wireType)
 			}
@@ -29779,42 +37695,6 @@ func (m *PrometheusMetric) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		case 7:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RangeQuery", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.RangeQuery == nil {
-				m.RangeQuery = &PrometheusRangeQueryArgs{}
-			}
-			if err := m.RangeQuery.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -33534,21 +41414,471 @@ func (m *RolloutTrafficRouting) Unmarshal(dAtA []byte) error {
 			if b < 0x80 {
 				break
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: RolloutTrafficRouting: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RolloutTrafficRouting: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Istio", wireType)
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RolloutTrafficRouting: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RolloutTrafficRouting: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Istio", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Istio == nil {
+				m.Istio = &IstioTrafficRouting{}
+			}
+			if err := m.Istio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nginx", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Nginx == nil {
+				m.Nginx = &NginxTrafficRouting{}
+			}
+			if err := m.Nginx.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ALB", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ALB == nil {
+				m.ALB = &ALBTrafficRouting{}
+			}
+			if err := m.ALB.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SMI", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SMI == nil {
+				m.SMI = &SMITrafficRouting{}
+			}
+			if err := m.SMI.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ambassador", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Ambassador == nil {
+				m.Ambassador = &AmbassadorTrafficRouting{}
+			}
+			if err := m.Ambassador.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AppMesh", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.AppMesh == nil {
+				m.AppMesh = &AppMeshTrafficRouting{}
+			}
+			if err := m.AppMesh.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Traefik", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Traefik == nil {
+				m.Traefik = &TraefikTrafficRouting{}
+			}
+			if err := m.Traefik.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ManagedRoutes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ManagedRoutes = append(m.ManagedRoutes, MangedRoutes{})
+			if err := m.ManagedRoutes[len(m.ManagedRoutes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Apisix", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Apisix == nil {
+				m.Apisix = &ApisixTrafficRouting{}
+			}
+			if err := m.Apisix.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Plugins", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Plugins == nil {
+				m.Plugins = make(map[string]encoding_json.RawMessage)
+			}
+			var mapkey string
+			mapvalue := []byte{}
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapbyteLen uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapbyteLen |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intMapbyteLen := int(mapbyteLen)
+					if intMapbyteLen < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postbytesIndex := iNdEx + intMapbyteLen
+					if postbytesIndex < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postbytesIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = make([]byte, mapbyteLen)
+					copy(mapvalue, dAtA[iNdEx:postbytesIndex])
+					iNdEx = postbytesIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Plugins[mapkey] = ((encoding_json.RawMessage)(mapvalue))
+			iNdEx = postIndex
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxTrafficWeight", wireType)
 			}
-			var msglen int
+			var v int32
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -33558,31 +41888,15 @@ func (m *RolloutTrafficRouting) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Istio == nil {
-				m.Istio = &IstioTrafficRouting{}
-			}
-			if err := m.Istio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
+			m.MaxTrafficWeight = &v
+		case 12:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Nginx", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GatewayAPI", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -33609,16 +41923,16 @@ func (m *RolloutTrafficRouting) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Nginx == nil {
-				m.Nginx = &NginxTrafficRouting{}
+			if m.GatewayAPI == nil {
+				m.GatewayAPI = &GatewayAPITrafficRouting{}
 			}
-			if err := m.Nginx.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.GatewayAPI.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 13:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ALB", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Stickiness", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -33645,16 +41959,16 @@ func (m *RolloutTrafficRouting) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ALB == nil {
-				m.ALB = &ALBTrafficRouting{}
+			if m.Stickiness == nil {
+				m.Stickiness = &TrafficStickiness{}
 			}
-			if err := m.ALB.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Stickiness.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		case 14:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SMI", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Kong", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -33681,16 +41995,16 @@ func (m *RolloutTrafficRouting) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.SMI == nil {
-				m.SMI = &SMITrafficRouting{}
+			if m.Kong == nil {
+				m.Kong = &KongTrafficRouting{}
 			}
-			if err := m.SMI.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Kong.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
+		case 15:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Ambassador", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Contour", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -33717,16 +42031,16 @@ func (m *RolloutTrafficRouting) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Ambassador == nil {
-				m.Ambassador = &AmbassadorTrafficRouting{}
+			if m.Contour == nil {
+				m.Contour = &ContourTrafficRouting{}
 			}
-			if err := m.Ambassador.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Contour.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 6:
+		case 16:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AppMesh", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Consul", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -33753,52 +42067,66 @@ func (m *RolloutTrafficRouting) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.AppMesh == nil {
-				m.AppMesh = &AppMeshTrafficRouting{}
+			if m.Consul == nil {
+				m.Consul = &ConsulTrafficRouting{}
 			}
-			if err := m.AppMesh.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Consul.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 7:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Traefik", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Traefik == nil {
-				m.Traefik = &TraefikTrafficRouting{}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RouteMatch) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
 			}
-			if err := m.Traefik.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			iNdEx = postIndex
-		case 8:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RouteMatch: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RouteMatch: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ManagedRoutes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Method", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -33825,14 +42153,16 @@ func (m *RolloutTrafficRouting) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ManagedRoutes = append(m.ManagedRoutes, MangedRoutes{})
-			if err := m.ManagedRoutes[len(m.ManagedRoutes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Method == nil {
+				m.Method = &StringMatch{}
+			}
+			if err := m.Method.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 9:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Apisix", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -33859,16 +42189,16 @@ func (m *RolloutTrafficRouting) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Apisix == nil {
-				m.Apisix = &ApisixTrafficRouting{}
+			if m.Path == nil {
+				m.Path = &StringMatch{}
 			}
-			if err := m.Apisix.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Path.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 10:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Plugins", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Headers", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -33895,11 +42225,11 @@ func (m *RolloutTrafficRouting) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Plugins == nil {
-				m.Plugins = make(map[string]encoding_json.RawMessage)
+			if m.Headers == nil {
+				m.Headers = make(map[string]StringMatch)
 			}
 			var mapkey string
-			mapvalue := []byte{}
+			mapvalue := &StringMatch{}
 			for iNdEx < postIndex {
 				entryPreIndex := iNdEx
 				var wire uint64
@@ -33948,7 +42278,7 @@ func (m *RolloutTrafficRouting) Unmarshal(dAtA []byte) error {
 					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
 					iNdEx = postStringIndexmapkey
 				} else if fieldNum == 2 {
-					var mapbyteLen uint64
+					var mapmsglen int
 					for shift := uint(0); ; shift += 7 {
 						if shift >= 64 {
 							return ErrIntOverflowGenerated
@@ -33958,25 +42288,26 @@ func (m *RolloutTrafficRouting) Unmarshal(dAtA []byte) error {
 						}
 						b := dAtA[iNdEx]
 						iNdEx++
-						mapbyteLen |= uint64(b&0x7F) << shift
+						mapmsglen |= int(b&0x7F) << shift
 						if b < 0x80 {
 							break
 						}
 					}
-					intMapbyteLen := int(mapbyteLen)
-					if intMapbyteLen < 0 {
+					if mapmsglen < 0 {
 						return ErrInvalidLengthGenerated
 					}
-					postbytesIndex := iNdEx + intMapbyteLen
-					if postbytesIndex < 0 {
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
 						return ErrInvalidLengthGenerated
 					}
-					if postbytesIndex > l {
+					if postmsgIndex > l {
 						return io.ErrUnexpectedEOF
 					}
-					mapvalue = make([]byte, mapbyteLen)
-					copy(mapvalue, dAtA[iNdEx:postbytesIndex])
-					iNdEx = postbytesIndex
+					mapvalue = &StringMatch{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
 				} else {
 					iNdEx = entryPreIndex
 					skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -33992,13 +42323,63 @@ func (m *RolloutTrafficRouting) Unmarshal(dAtA []byte) error {
 					iNdEx += skippy
 				}
 			}
-			m.Plugins[mapkey] = ((encoding_json.RawMessage)(mapvalue))
+			m.Headers[mapkey] = *mapvalue
 			iNdEx = postIndex
-		case 11:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RunSummary) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RunSummary: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RunSummary: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxTrafficWeight", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
 			}
-			var v int32
+			m.Count = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -34008,12 +42389,87 @@ func (m *RolloutTrafficRouting) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int32(b&0x7F) << shift
+				m.Count |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Successful", wireType)
+			}
+			m.Successful = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Successful |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Failed", wireType)
+			}
+			m.Failed = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Failed |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Inconclusive", wireType)
+			}
+			m.Inconclusive = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Inconclusive |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			m.Error = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Error |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.MaxTrafficWeight = &v
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -34035,7 +42491,7 @@ func (m *RolloutTrafficRouting) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RouteMatch) Unmarshal(dAtA []byte) error {
+func (m *SLOBurnRateMetric) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -34058,15 +42514,15 @@ func (m *RouteMatch) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RouteMatch: wiretype end group for non-group")
+			return fmt.Errorf("proto: SLOBurnRateMetric: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RouteMatch: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SLOBurnRateMetric: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Method", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Prometheus", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -34093,18 +42549,195 @@ func (m *RouteMatch) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Method == nil {
-				m.Method = &StringMatch{}
+			if err := m.Prometheus.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			if err := m.Method.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ErrorRatioQuery", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ErrorRatioQuery = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Target", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Target = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Windows", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Windows = append(m.Windows, SLOBurnRateWindow{})
+			if err := m.Windows[len(m.Windows)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SLOBurnRateWindow) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SLOBurnRateWindow: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SLOBurnRateWindow: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ShortWindow", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ShortWindow = DurationString(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LongWindow", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -34114,33 +42747,29 @@ func (m *RouteMatch) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Path == nil {
-				m.Path = &StringMatch{}
-			}
-			if err := m.Path.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.LongWindow = DurationString(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Headers", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BurnRate", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -34150,120 +42779,23 @@ func (m *RouteMatch) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Headers == nil {
-				m.Headers = make(map[string]StringMatch)
-			}
-			var mapkey string
-			mapvalue := &StringMatch{}
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowGenerated
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var mapmsglen int
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						mapmsglen |= int(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					if mapmsglen < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postmsgIndex := iNdEx + mapmsglen
-					if postmsgIndex < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postmsgIndex > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = &StringMatch{}
-					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
-						return err
-					}
-					iNdEx = postmsgIndex
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipGenerated(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if (skippy < 0) || (iNdEx+skippy) < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
-				}
-			}
-			m.Headers[mapkey] = *mapvalue
+			m.BurnRate = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -34286,7 +42818,7 @@ func (m *RouteMatch) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RunSummary) Unmarshal(dAtA []byte) error {
+func (m *SMITrafficRouting) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -34309,17 +42841,17 @@ func (m *RunSummary) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RunSummary: wiretype end group for non-group")
+			return fmt.Errorf("proto: SMITrafficRouting: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RunSummary: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SMITrafficRouting: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RootService", wireType)
 			}
-			m.Count = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -34329,54 +42861,29 @@ func (m *RunSummary) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Count |= int32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Successful", wireType)
-			}
-			m.Successful = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Successful |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
 			}
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Failed", wireType)
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			m.Failed = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Failed |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Inconclusive", wireType)
+			m.RootService = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TrafficSplitName", wireType)
 			}
-			m.Inconclusive = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -34386,30 +42893,24 @@ func (m *RunSummary) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Inconclusive |= int32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			m.Error = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Error |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
+			m.TrafficSplitName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -34431,7 +42932,7 @@ func (m *RunSummary) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SMITrafficRouting) Unmarshal(dAtA []byte) error {
+func (m *SQLMetric) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -34454,15 +42955,48 @@ func (m *SMITrafficRouting) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SMITrafficRouting: wiretype end group for non-group")
+			return fmt.Errorf("proto: SQLMetric: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SMITrafficRouting: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SQLMetric: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RootService", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SecretRef", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SecretRef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Query", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -34490,11 +43024,11 @@ func (m *SMITrafficRouting) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RootService = string(dAtA[iNdEx:postIndex])
+			m.Query = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TrafficSplitName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Args", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -34522,8 +43056,60 @@ func (m *SMITrafficRouting) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TrafficSplitName = string(dAtA[iNdEx:postIndex])
+			m.Args = append(m.Args, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timeout", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Timeout = DurationString(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResultSet", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ResultSet = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -34742,6 +43328,94 @@ func (m *ScopeDetail) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *ScoreThreshold) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ScoreThreshold: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ScoreThreshold: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pass", wireType)
+			}
+			m.Pass = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Pass |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Marginal", wireType)
+			}
+			m.Marginal = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Marginal |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *SecretKeyRef) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -34981,17 +43655,127 @@ func (m *SetCanaryScale) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SetCanaryScale: wiretype end group for non-group")
+			return fmt.Errorf("proto: SetCanaryScale: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SetCanaryScale: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Weight", wireType)
+			}
+			var v int32
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Weight = &v
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Replicas", wireType)
+			}
+			var v int32
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Replicas = &v
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MatchTrafficWeight", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.MatchTrafficWeight = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SetHeaderRoute) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SetHeaderRoute: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SetCanaryScale: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SetHeaderRoute: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Weight", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
-			var v int32
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -35001,17 +43785,29 @@ func (m *SetCanaryScale) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Weight = &v
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Replicas", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Match", wireType)
 			}
-			var v int32
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -35021,32 +43817,26 @@ func (m *SetCanaryScale) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int32(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Replicas = &v
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MatchTrafficWeight", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			m.MatchTrafficWeight = bool(v != 0)
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Match = append(m.Match, HeaderRoutingMatch{})
+			if err := m.Match[len(m.Match)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -35068,7 +43858,7 @@ func (m *SetCanaryScale) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SetHeaderRoute) Unmarshal(dAtA []byte) error {
+func (m *SetLocality) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -35091,10 +43881,10 @@ func (m *SetHeaderRoute) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SetHeaderRoute: wiretype end group for non-group")
+			return fmt.Errorf("proto: SetLocality: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SetHeaderRoute: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SetLocality: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -35131,9 +43921,9 @@ func (m *SetHeaderRoute) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Match", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Zones", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -35143,25 +43933,55 @@ func (m *SetHeaderRoute) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Match = append(m.Match, HeaderRoutingMatch{})
-			if err := m.Match[len(m.Match)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Zones = append(m.Zones, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Regions", wireType)
 			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Regions = append(m.Regions, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -37248,6 +46068,203 @@ func (m *TraefikTrafficRouting) Unmarshal(dAtA []byte) error {
 			}
 			m.WeightedTraefikServiceName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MirrorTraefikServiceName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MirrorTraefikServiceName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IngressRouteName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IngressRouteName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TrafficStickiness) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TrafficStickiness: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TrafficStickiness: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cookie", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Cookie = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Header", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Header = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DurationSeconds", wireType)
+			}
+			m.DurationSeconds = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DurationSeconds |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -37541,6 +46558,42 @@ func (m *ValueFrom) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConfigMapKeyRef", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ConfigMapKeyRef == nil {
+				m.ConfigMapKeyRef = &ConfigMapKeyRef{}
+			}
+			if err := m.ConfigMapKeyRef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -37973,6 +47026,98 @@ func (m *WebMetric) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TLS", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.TLS == nil {
+				m.TLS = &WebMetricTLS{}
+			}
+			if err := m.TLS.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Retry", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Retry == nil {
+				m.Retry = &WebMetricRetry{}
+			}
+			if err := m.Retry.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IgnoreStatusCode", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IgnoreStatusCode = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -38108,6 +47253,257 @@ func (m *WebMetricHeader) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *WebMetricRetry) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WebMetricRetry: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WebMetricRetry: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+			}
+			m.Limit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Limit |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Backoff", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Backoff = DurationString(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *WebMetricTLS) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WebMetricTLS: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WebMetricTLS: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientCertSecretRef", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ClientCertSecretRef == nil {
+				m.ClientCertSecretRef = &SecretRef{}
+			}
+			if err := m.ClientCertSecretRef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CABundle", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CABundle = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ServerName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ServerName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *WeightDestination) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
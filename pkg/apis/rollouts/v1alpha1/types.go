@@ -412,6 +412,34 @@ type RolloutTrafficRouting struct {
 
 	// MaxTrafficWeight The total weight of traffic. If unspecified, it defaults to 100
 	MaxTrafficWeight *int32 `json:"maxTrafficWeight,omitempty" protobuf:"varint,11,opt,name=maxTrafficWeight"`
+	// GatewayAPI holds specific configuration to use the Gateway API to route traffic
+	GatewayAPI *GatewayAPITrafficRouting `json:"gatewayAPI,omitempty" protobuf:"bytes,12,opt,name=gatewayAPI"`
+	// Stickiness keeps the clients served by the canary on the canary for the duration of a step
+	// +optional
+	Stickiness *TrafficStickiness `json:"stickiness,omitempty" protobuf:"bytes,13,opt,name=stickiness"`
+	// Kong holds specific configuration to use Kong upstream targets or canary plugins to route traffic
+	// +optional
+	Kong *KongTrafficRouting `json:"kong,omitempty" protobuf:"bytes,14,opt,name=kong"`
+	// Contour holds specific configuration to use Contour HTTPProxies to route traffic
+	// +optional
+	Contour *ContourTrafficRouting `json:"contour,omitempty" protobuf:"bytes,15,opt,name=contour"`
+	// Consul holds specific configuration to use the Consul service mesh to route traffic
+	// +optional
+	Consul *ConsulTrafficRouting `json:"consul,omitempty" protobuf:"bytes,16,opt,name=consul"`
+}
+
+// TrafficStickiness defines how the clients of a canary are identified to keep them on the same version. Exactly
+// one of Cookie or Header must be set
+type TrafficStickiness struct {
+	// Cookie is the name of the cookie identifying the clients
+	// +optional
+	Cookie string `json:"cookie,omitempty" protobuf:"bytes,1,opt,name=cookie"`
+	// Header is the name of the request header identifying the clients
+	// +optional
+	Header string `json:"header,omitempty" protobuf:"bytes,2,opt,name=header"`
+	// DurationSeconds is the lifetime of the cookie. If unset, the cookie expires with the browser session
+	// +optional
+	DurationSeconds int64 `json:"durationSeconds,omitempty" protobuf:"varint,3,opt,name=durationSeconds"`
 }
 
 type MangedRoutes struct {
@@ -424,6 +452,48 @@ type MangedRoutes struct {
 type TraefikTrafficRouting struct {
 	// TraefikServiceName refer to the name of the Traefik service used to route traffic to the service
 	WeightedTraefikServiceName string `json:"weightedTraefikServiceName" protobuf:"bytes,1,name=weightedTraefikServiceName"`
+	// MirrorTraefikServiceName refers to the name of the Traefik mirroring service whose mirrors are managed to mirror
+	// traffic to the canary service
+	// +optional
+	MirrorTraefikServiceName string `json:"mirrorTraefikServiceName,omitempty" protobuf:"bytes,2,opt,name=mirrorTraefikServiceName"`
+	// IngressRouteName refers to the name of the IngressRoute routing to the weighted Traefik service, to which the
+	// header routes are added
+	// +optional
+	IngressRouteName string `json:"ingressRouteName,omitempty" protobuf:"bytes,3,opt,name=ingressRouteName"`
+}
+
+// KongTrafficRouting defines the configuration required to use Kong as traffic router. Either the stable and
+// canary targets of a Kong Gateway Operator upstream, or the canary plugin of the Kong Ingress Controller
+// must be set.
+type KongTrafficRouting struct {
+	// StableTarget refers to the name of the KongTarget of the upstream pointing to the stable service
+	// +optional
+	StableTarget string `json:"stableTarget,omitempty" protobuf:"bytes,1,opt,name=stableTarget"`
+	// CanaryTarget refers to the name of the KongTarget of the upstream pointing to the canary service
+	// +optional
+	CanaryTarget string `json:"canaryTarget,omitempty" protobuf:"bytes,2,opt,name=canaryTarget"`
+	// CanaryPlugin refers to the name of the KongPlugin of the Kong Ingress Controller which configures the
+	// canary plugin on the routes of the stable service. Its percentage is set to the canary weight.
+	// +optional
+	CanaryPlugin string `json:"canaryPlugin,omitempty" protobuf:"bytes,3,opt,name=canaryPlugin"`
+}
+
+// ContourTrafficRouting defines the configuration required to use Contour as traffic router. The routes of
+// the HTTPProxies referencing both the stable and the canary service are the ones shaping traffic.
+type ContourTrafficRouting struct {
+	// HTTPProxies refer to the names of the HTTPProxies used to route traffic to the services
+	HTTPProxies []string `json:"httpProxies" protobuf:"bytes,1,rep,name=httpProxies"`
+}
+
+// ConsulTrafficRouting defines the configuration required to use the Consul service mesh as traffic router
+type ConsulTrafficRouting struct {
+	// ServiceSplitterName refers to the name of the ServiceSplitter config entry splitting the traffic between
+	// the stable and the canary service
+	ServiceSplitterName string `json:"serviceSplitterName" protobuf:"bytes,1,name=serviceSplitterName"`
+	// ServiceRouterName refers to the name of the ServiceRouter config entry to which the header routes are
+	// added
+	// +optional
+	ServiceRouterName string `json:"serviceRouterName,omitempty" protobuf:"bytes,2,opt,name=serviceRouterName"`
 }
 
 // ApisixTrafficRouting defines the configuration required to use APISIX as traffic router
@@ -440,6 +510,21 @@ type ApisixRoute struct {
 	Rules []string `json:"rules,omitempty" protobuf:"bytes,2,rep,name=rules"`
 }
 
+// GatewayAPITrafficRouting defines the configuration required to use the Gateway API as traffic router.
+// The routes are looked up in the namespace of the rollout. Their rules referencing both the stable and
+// the canary service are the ones shaping traffic.
+type GatewayAPITrafficRouting struct {
+	// HTTPRoute refers to the name of the HTTPRoute used to route traffic to the services
+	// +optional
+	HTTPRoute string `json:"httpRoute,omitempty" protobuf:"bytes,1,opt,name=httpRoute"`
+	// GRPCRoute refers to the name of the GRPCRoute used to route traffic to the services
+	// +optional
+	GRPCRoute string `json:"grpcRoute,omitempty" protobuf:"bytes,2,opt,name=grpcRoute"`
+	// TCPRoute refers to the name of the TCPRoute used to route traffic to the services
+	// +optional
+	TCPRoute string `json:"tcpRoute,omitempty" protobuf:"bytes,3,opt,name=tcpRoute"`
+}
+
 // AmbassadorTrafficRouting defines the configuration required to use Ambassador as traffic
 // router
 type AmbassadorTrafficRouting struct {
@@ -677,6 +762,9 @@ type CanaryStep struct {
 	SetMirrorRoute *SetMirrorRoute `json:"setMirrorRoute,omitempty" protobuf:"bytes,8,opt,name=setMirrorRoute"`
 	// Plugin defines a plugin to execute for a step
 	Plugin *PluginStep `json:"plugin,omitempty" protobuf:"bytes,9,opt,name=plugin"`
+	// SetLocality defines the route sending 100% of the traffic originating from the given localities to the canary service
+	// +optional
+	SetLocality *SetLocality `json:"setLocality,omitempty" protobuf:"bytes,10,opt,name=setLocality"`
 }
 
 type PluginStep struct {
@@ -726,6 +814,22 @@ type StringMatch struct {
 	Regex string `json:"regex,omitempty" protobuf:"bytes,3,opt,name=regex"`
 }
 
+// SetLocality defines the route sending 100% of the traffic originating from the given localities to the canary service.
+// Setting neither zones nor regions removes the route.
+type SetLocality struct {
+	// Name this is the name of the route to use for the traffic of the localities this also needs
+	// to be included in the `spec.strategy.canary.trafficRouting.managedRoutes` field
+	Name string `json:"name" protobuf:"bytes,1,opt,name=name"`
+	// Zones are the zones, matched against the topology.kubernetes.io/zone label of the source workloads, whose traffic
+	// is sent to the canary service
+	// +optional
+	Zones []string `json:"zones,omitempty" protobuf:"bytes,2,rep,name=zones"`
+	// Regions are the regions, matched against the topology.kubernetes.io/region label of the source workloads, whose
+	// traffic is sent to the canary service
+	// +optional
+	Regions []string `json:"regions,omitempty" protobuf:"bytes,3,rep,name=regions"`
+}
+
 // SetHeaderRoute defines the route with specified header name to send 100% of traffic to the canary service
 type SetHeaderRoute struct {
 	// Name this is the name of the route to use for the mirroring of traffic this also needs
@@ -818,6 +922,8 @@ type ArgumentValueFrom struct {
 	PodTemplateHashValue *ValueFromPodTemplateHash `json:"podTemplateHashValue,omitempty" protobuf:"bytes,1,opt,name=podTemplateHashValue,casttype=ValueFromPodTemplateHash"`
 	//FieldRef
 	FieldRef *FieldRef `json:"fieldRef,omitempty" protobuf:"bytes,2,opt,name=fieldRef"`
+	// RolloutValue gets the value from the state of the rollout, such as its current weight or step
+	RolloutValue *ValueFromRollout `json:"rolloutValue,omitempty" protobuf:"bytes,3,opt,name=rolloutValue,casttype=ValueFromRollout"`
 }
 
 type FieldRef struct {
@@ -835,6 +941,25 @@ const (
 	Latest ValueFromPodTemplateHash = "Latest"
 )
 
+// ValueFromRollout indicates which value computed by the rollout to use
+// +kubebuilder:validation:Enum=CurrentWeight;CurrentStepIndex;StablePodTemplateHash;CanaryPodTemplateHash;StableImage
+type ValueFromRollout string
+
+const (
+	// RolloutValueCurrentWeight is the traffic weight of the canary, or the weight of the current setWeight step
+	// when the rollout does not use traffic routing
+	RolloutValueCurrentWeight ValueFromRollout = "CurrentWeight"
+	// RolloutValueCurrentStepIndex is the index of the current step of the canary
+	RolloutValueCurrentStepIndex ValueFromRollout = "CurrentStepIndex"
+	// RolloutValueStablePodTemplateHash is the pod template hash of the stable ReplicaSet recorded in the status
+	RolloutValueStablePodTemplateHash ValueFromRollout = "StablePodTemplateHash"
+	// RolloutValueCanaryPodTemplateHash is the pod template hash of the current pod template recorded in the status
+	RolloutValueCanaryPodTemplateHash ValueFromRollout = "CanaryPodTemplateHash"
+	// RolloutValueStableImage is the image of the stable ReplicaSet, which is the previous revision during an update.
+	// The images of ReplicaSets with several containers are separated by commas
+	RolloutValueStableImage ValueFromRollout = "StableImage"
+)
+
 const (
 	// RolloutTypeLabel indicates how the rollout created the analysisRun
 	RolloutTypeLabel = "rollout-type"
@@ -1322,6 +1447,9 @@ const (
 	// RolloutHealthy means that rollout is in a completed state and is healthy. Which means that all the pods have been updated
 	// and are passing their health checks and are ready to serve traffic.
 	RolloutHealthy RolloutConditionType = "Healthy"
+	// RolloutTrafficRoutingDrift means that the resources managed by the traffic router of the rollout were modified
+	// and no longer have the weights set by the rollout
+	RolloutTrafficRoutingDrift RolloutConditionType = "TrafficRoutingDrift"
 )
 
 // RolloutCondition describes the state of a rollout at a certain point.
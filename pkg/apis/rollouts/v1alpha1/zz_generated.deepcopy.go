@@ -74,6 +74,22 @@ func (in *ALBTrafficRouting) DeepCopy() *ALBTrafficRouting {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AdaptiveInterval) DeepCopyInto(out *AdaptiveInterval) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AdaptiveInterval.
+func (in *AdaptiveInterval) DeepCopy() *AdaptiveInterval {
+	if in == nil {
+		return nil
+	}
+	out := new(AdaptiveInterval)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *AmbassadorTrafficRouting) DeepCopyInto(out *AmbassadorTrafficRouting) {
 	*out = *in
@@ -239,6 +255,11 @@ func (in *AnalysisRunSpec) DeepCopyInto(out *AnalysisRunSpec) {
 		*out = new(TTLStrategy)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Scoring != nil {
+		in, out := &in.Scoring, &out.Scoring
+		*out = new(AnalysisScoring)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -276,6 +297,11 @@ func (in *AnalysisRunStatus) DeepCopyInto(out *AnalysisRunStatus) {
 		in, out := &in.CompletedAt, &out.CompletedAt
 		*out = (*in).DeepCopy()
 	}
+	if in.Score != nil {
+		in, out := &in.Score, &out.Score
+		*out = new(int64)
+		**out = **in
+	}
 	return
 }
 
@@ -315,6 +341,28 @@ func (in *AnalysisRunStrategy) DeepCopy() *AnalysisRunStrategy {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AnalysisScoring) DeepCopyInto(out *AnalysisScoring) {
+	*out = *in
+	if in.Weights != nil {
+		in, out := &in.Weights, &out.Weights
+		*out = make([]MetricWeight, len(*in))
+		copy(*out, *in)
+	}
+	out.Threshold = in.Threshold
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AnalysisScoring.
+func (in *AnalysisScoring) DeepCopy() *AnalysisScoring {
+	if in == nil {
+		return nil
+	}
+	out := new(AnalysisScoring)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *AnalysisTemplate) DeepCopyInto(out *AnalysisTemplate) {
 	*out = *in
@@ -430,6 +478,11 @@ func (in *AnalysisTemplateSpec) DeepCopyInto(out *AnalysisTemplateSpec) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.Scoring != nil {
+		in, out := &in.Scoring, &out.Scoring
+		*out = new(AnalysisScoring)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -639,6 +692,11 @@ func (in *ArgumentValueFrom) DeepCopyInto(out *ArgumentValueFrom) {
 		*out = new(FieldRef)
 		**out = **in
 	}
+	if in.RolloutValue != nil {
+		in, out := &in.RolloutValue, &out.RolloutValue
+		*out = new(ValueFromRollout)
+		**out = **in
+	}
 	return
 }
 
@@ -703,6 +761,32 @@ func (in *BasicAuthConfig) DeepCopy() *BasicAuthConfig {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BasicAuthProviderConfig) DeepCopyInto(out *BasicAuthProviderConfig) {
+	*out = *in
+	if in.UsernameSecretRef != nil {
+		in, out := &in.UsernameSecretRef, &out.UsernameSecretRef
+		*out = new(SecretKeyRef)
+		**out = **in
+	}
+	if in.PasswordSecretRef != nil {
+		in, out := &in.PasswordSecretRef, &out.PasswordSecretRef
+		*out = new(SecretKeyRef)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BasicAuthProviderConfig.
+func (in *BasicAuthProviderConfig) DeepCopy() *BasicAuthProviderConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(BasicAuthProviderConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *BlueGreenStatus) DeepCopyInto(out *BlueGreenStatus) {
 	*out = *in
@@ -881,6 +965,11 @@ func (in *CanaryStep) DeepCopyInto(out *CanaryStep) {
 		*out = new(PluginStep)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.SetLocality != nil {
+		in, out := &in.SetLocality, &out.SetLocality
+		*out = new(SetLocality)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -1166,6 +1255,59 @@ func (in *ClusterAnalysisTemplateList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConfigMapKeyRef) DeepCopyInto(out *ConfigMapKeyRef) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigMapKeyRef.
+func (in *ConfigMapKeyRef) DeepCopy() *ConfigMapKeyRef {
+	if in == nil {
+		return nil
+	}
+	out := new(ConfigMapKeyRef)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConsulTrafficRouting) DeepCopyInto(out *ConsulTrafficRouting) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConsulTrafficRouting.
+func (in *ConsulTrafficRouting) DeepCopy() *ConsulTrafficRouting {
+	if in == nil {
+		return nil
+	}
+	out := new(ConsulTrafficRouting)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ContourTrafficRouting) DeepCopyInto(out *ContourTrafficRouting) {
+	*out = *in
+	if in.HTTPProxies != nil {
+		in, out := &in.HTTPProxies, &out.HTTPProxies
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ContourTrafficRouting.
+func (in *ContourTrafficRouting) DeepCopy() *ContourTrafficRouting {
+	if in == nil {
+		return nil
+	}
+	out := new(ContourTrafficRouting)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *DatadogMetric) DeepCopyInto(out *DatadogMetric) {
 	*out = *in
@@ -1190,6 +1332,22 @@ func (in *DatadogMetric) DeepCopy() *DatadogMetric {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DatadogProviderConfig) DeepCopyInto(out *DatadogProviderConfig) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DatadogProviderConfig.
+func (in *DatadogProviderConfig) DeepCopy() *DatadogProviderConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(DatadogProviderConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *DryRun) DeepCopyInto(out *DryRun) {
 	*out = *in
@@ -1206,6 +1364,22 @@ func (in *DryRun) DeepCopy() *DryRun {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EarlySuccess) DeepCopyInto(out *EarlySuccess) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EarlySuccess.
+func (in *EarlySuccess) DeepCopy() *EarlySuccess {
+	if in == nil {
+		return nil
+	}
+	out := new(EarlySuccess)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Experiment) DeepCopyInto(out *Experiment) {
 	*out = *in
@@ -1435,6 +1609,22 @@ func (in *FieldRef) DeepCopy() *FieldRef {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GatewayAPITrafficRouting) DeepCopyInto(out *GatewayAPITrafficRouting) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GatewayAPITrafficRouting.
+func (in *GatewayAPITrafficRouting) DeepCopy() *GatewayAPITrafficRouting {
+	if in == nil {
+		return nil
+	}
+	out := new(GatewayAPITrafficRouting)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *GraphiteMetric) DeepCopyInto(out *GraphiteMetric) {
 	*out = *in
@@ -1488,6 +1678,22 @@ func (in *InfluxdbMetric) DeepCopy() *InfluxdbMetric {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InfluxdbProviderConfig) DeepCopyInto(out *InfluxdbProviderConfig) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InfluxdbProviderConfig.
+func (in *InfluxdbProviderConfig) DeepCopy() *InfluxdbProviderConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(InfluxdbProviderConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *IstioDestinationRule) DeepCopyInto(out *IstioDestinationRule) {
 	*out = *in
@@ -1580,6 +1786,11 @@ func (in *JobMetric) DeepCopyInto(out *JobMetric) {
 	*out = *in
 	in.Metadata.DeepCopyInto(&out.Metadata)
 	in.Spec.DeepCopyInto(&out.Spec)
+	if in.Result != nil {
+		in, out := &in.Result, &out.Result
+		*out = new(JobMetricResult)
+		**out = **in
+	}
 	return
 }
 
@@ -1593,6 +1804,22 @@ func (in *JobMetric) DeepCopy() *JobMetric {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *JobMetricResult) DeepCopyInto(out *JobMetricResult) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new JobMetricResult.
+func (in *JobMetricResult) DeepCopy() *JobMetricResult {
+	if in == nil {
+		return nil
+	}
+	out := new(JobMetricResult)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *KayentaMetric) DeepCopyInto(out *KayentaMetric) {
 	*out = *in
@@ -1649,6 +1876,22 @@ func (in *KayentaThreshold) DeepCopy() *KayentaThreshold {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KongTrafficRouting) DeepCopyInto(out *KongTrafficRouting) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KongTrafficRouting.
+func (in *KongTrafficRouting) DeepCopy() *KongTrafficRouting {
+	if in == nil {
+		return nil
+	}
+	out := new(KongTrafficRouting)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *MangedRoutes) DeepCopyInto(out *MangedRoutes) {
 	*out = *in
@@ -1745,6 +1988,21 @@ func (in *Metric) DeepCopyInto(out *Metric) {
 		*out = new(intstr.IntOrString)
 		**out = **in
 	}
+	if in.DependsOn != nil {
+		in, out := &in.DependsOn, &out.DependsOn
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.AdaptiveInterval != nil {
+		in, out := &in.AdaptiveInterval, &out.AdaptiveInterval
+		*out = new(AdaptiveInterval)
+		**out = **in
+	}
+	if in.EarlySuccess != nil {
+		in, out := &in.EarlySuccess, &out.EarlySuccess
+		*out = new(EarlySuccess)
+		**out = **in
+	}
 	return
 }
 
@@ -1816,6 +2074,31 @@ func (in *MetricProvider) DeepCopyInto(out *MetricProvider) {
 		*out = new(SkyWalkingMetric)
 		**out = **in
 	}
+	if in.SLOBurnRate != nil {
+		in, out := &in.SLOBurnRate, &out.SLOBurnRate
+		*out = new(SLOBurnRateMetric)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ConfigRef != nil {
+		in, out := &in.ConfigRef, &out.ConfigRef
+		*out = new(MetricProviderConfigRef)
+		**out = **in
+	}
+	if in.OpenSearch != nil {
+		in, out := &in.OpenSearch, &out.OpenSearch
+		*out = new(OpenSearchMetric)
+		**out = **in
+	}
+	if in.SQL != nil {
+		in, out := &in.SQL, &out.SQL
+		*out = new(SQLMetric)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Probe != nil {
+		in, out := &in.Probe, &out.Probe
+		*out = new(ProbeMetric)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.Plugin != nil {
 		in, out := &in.Plugin, &out.Plugin
 		*out = make(map[string]json.RawMessage, len(*in))
@@ -1845,68 +2128,212 @@ func (in *MetricProvider) DeepCopy() *MetricProvider {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *MetricResult) DeepCopyInto(out *MetricResult) {
+func (in *MetricProviderConfig) DeepCopyInto(out *MetricProviderConfig) {
 	*out = *in
-	if in.Measurements != nil {
-		in, out := &in.Measurements, &out.Measurements
-		*out = make([]Measurement, len(*in))
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MetricProviderConfig.
+func (in *MetricProviderConfig) DeepCopy() *MetricProviderConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(MetricProviderConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *MetricProviderConfig) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MetricProviderConfigList) DeepCopyInto(out *MetricProviderConfigList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]MetricProviderConfig, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
-	if in.Metadata != nil {
-		in, out := &in.Metadata, &out.Metadata
-		*out = make(map[string]string, len(*in))
-		for key, val := range *in {
-			(*out)[key] = val
-		}
-	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MetricResult.
-func (in *MetricResult) DeepCopy() *MetricResult {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MetricProviderConfigList.
+func (in *MetricProviderConfigList) DeepCopy() *MetricProviderConfigList {
 	if in == nil {
 		return nil
 	}
-	out := new(MetricResult)
+	out := new(MetricProviderConfigList)
 	in.DeepCopyInto(out)
 	return out
 }
 
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *MetricProviderConfigList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NewRelicMetric) DeepCopyInto(out *NewRelicMetric) {
+func (in *MetricProviderConfigRef) DeepCopyInto(out *MetricProviderConfigRef) {
 	*out = *in
-	if in.Timeout != nil {
-		in, out := &in.Timeout, &out.Timeout
-		*out = new(int64)
-		**out = **in
-	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NewRelicMetric.
-func (in *NewRelicMetric) DeepCopy() *NewRelicMetric {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MetricProviderConfigRef.
+func (in *MetricProviderConfigRef) DeepCopy() *MetricProviderConfigRef {
 	if in == nil {
 		return nil
 	}
-	out := new(NewRelicMetric)
+	out := new(MetricProviderConfigRef)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NginxTrafficRouting) DeepCopyInto(out *NginxTrafficRouting) {
+func (in *MetricProviderConfigSpec) DeepCopyInto(out *MetricProviderConfigSpec) {
 	*out = *in
-	if in.AdditionalIngressAnnotations != nil {
-		in, out := &in.AdditionalIngressAnnotations, &out.AdditionalIngressAnnotations
-		*out = make(map[string]string, len(*in))
-		for key, val := range *in {
-			(*out)[key] = val
-		}
+	if in.Prometheus != nil {
+		in, out := &in.Prometheus, &out.Prometheus
+		*out = new(PrometheusProviderConfig)
+		(*in).DeepCopyInto(*out)
 	}
-	if in.StableIngresses != nil {
-		in, out := &in.StableIngresses, &out.StableIngresses
+	if in.Datadog != nil {
+		in, out := &in.Datadog, &out.Datadog
+		*out = new(DatadogProviderConfig)
+		**out = **in
+	}
+	if in.NewRelic != nil {
+		in, out := &in.NewRelic, &out.NewRelic
+		*out = new(NewRelicProviderConfig)
+		**out = **in
+	}
+	if in.Influxdb != nil {
+		in, out := &in.Influxdb, &out.Influxdb
+		*out = new(InfluxdbProviderConfig)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MetricProviderConfigSpec.
+func (in *MetricProviderConfigSpec) DeepCopy() *MetricProviderConfigSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(MetricProviderConfigSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MetricResult) DeepCopyInto(out *MetricResult) {
+	*out = *in
+	if in.Measurements != nil {
+		in, out := &in.Measurements, &out.Measurements
+		*out = make([]Measurement, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Metadata != nil {
+		in, out := &in.Metadata, &out.Metadata
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MetricResult.
+func (in *MetricResult) DeepCopy() *MetricResult {
+	if in == nil {
+		return nil
+	}
+	out := new(MetricResult)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MetricWeight) DeepCopyInto(out *MetricWeight) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MetricWeight.
+func (in *MetricWeight) DeepCopy() *MetricWeight {
+	if in == nil {
+		return nil
+	}
+	out := new(MetricWeight)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NewRelicMetric) DeepCopyInto(out *NewRelicMetric) {
+	*out = *in
+	if in.Timeout != nil {
+		in, out := &in.Timeout, &out.Timeout
+		*out = new(int64)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NewRelicMetric.
+func (in *NewRelicMetric) DeepCopy() *NewRelicMetric {
+	if in == nil {
+		return nil
+	}
+	out := new(NewRelicMetric)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NewRelicProviderConfig) DeepCopyInto(out *NewRelicProviderConfig) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NewRelicProviderConfig.
+func (in *NewRelicProviderConfig) DeepCopy() *NewRelicProviderConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(NewRelicProviderConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NginxTrafficRouting) DeepCopyInto(out *NginxTrafficRouting) {
+	*out = *in
+	if in.AdditionalIngressAnnotations != nil {
+		in, out := &in.AdditionalIngressAnnotations, &out.AdditionalIngressAnnotations
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.StableIngresses != nil {
+		in, out := &in.StableIngresses, &out.StableIngresses
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
@@ -1951,6 +2378,32 @@ func (in *OAuth2Config) DeepCopy() *OAuth2Config {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OAuth2ProviderConfig) DeepCopyInto(out *OAuth2ProviderConfig) {
+	*out = *in
+	if in.ClientSecretRef != nil {
+		in, out := &in.ClientSecretRef, &out.ClientSecretRef
+		*out = new(SecretKeyRef)
+		**out = **in
+	}
+	if in.Scopes != nil {
+		in, out := &in.Scopes, &out.Scopes
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OAuth2ProviderConfig.
+func (in *OAuth2ProviderConfig) DeepCopy() *OAuth2ProviderConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(OAuth2ProviderConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ObjectRef) DeepCopyInto(out *ObjectRef) {
 	*out = *in
@@ -1967,6 +2420,23 @@ func (in *ObjectRef) DeepCopy() *ObjectRef {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OpenSearchMetric) DeepCopyInto(out *OpenSearchMetric) {
+	*out = *in
+	out.SecretRef = in.SecretRef
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OpenSearchMetric.
+func (in *OpenSearchMetric) DeepCopy() *OpenSearchMetric {
+	if in == nil {
+		return nil
+	}
+	out := new(OpenSearchMetric)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *PauseCondition) DeepCopyInto(out *PauseCondition) {
 	*out = *in
@@ -2067,6 +2537,74 @@ func (in *PreferredDuringSchedulingIgnoredDuringExecution) DeepCopy() *Preferred
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProbeGRPC) DeepCopyInto(out *ProbeGRPC) {
+	*out = *in
+	if in.Metadata != nil {
+		in, out := &in.Metadata, &out.Metadata
+		*out = make([]WebMetricHeader, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProbeGRPC.
+func (in *ProbeGRPC) DeepCopy() *ProbeGRPC {
+	if in == nil {
+		return nil
+	}
+	out := new(ProbeGRPC)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProbeHTTP) DeepCopyInto(out *ProbeHTTP) {
+	*out = *in
+	if in.Headers != nil {
+		in, out := &in.Headers, &out.Headers
+		*out = make([]WebMetricHeader, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProbeHTTP.
+func (in *ProbeHTTP) DeepCopy() *ProbeHTTP {
+	if in == nil {
+		return nil
+	}
+	out := new(ProbeHTTP)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProbeMetric) DeepCopyInto(out *ProbeMetric) {
+	*out = *in
+	if in.HTTP != nil {
+		in, out := &in.HTTP, &out.HTTP
+		*out = new(ProbeHTTP)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.GRPC != nil {
+		in, out := &in.GRPC, &out.GRPC
+		*out = new(ProbeGRPC)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProbeMetric.
+func (in *ProbeMetric) DeepCopy() *ProbeMetric {
+	if in == nil {
+		return nil
+	}
+	out := new(ProbeMetric)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *PrometheusMetric) DeepCopyInto(out *PrometheusMetric) {
 	*out = *in
@@ -2099,6 +2637,52 @@ func (in *PrometheusMetric) DeepCopy() *PrometheusMetric {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PrometheusProviderAuthentication) DeepCopyInto(out *PrometheusProviderAuthentication) {
+	*out = *in
+	out.Sigv4 = in.Sigv4
+	in.OAuth2.DeepCopyInto(&out.OAuth2)
+	in.BasicAuth.DeepCopyInto(&out.BasicAuth)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PrometheusProviderAuthentication.
+func (in *PrometheusProviderAuthentication) DeepCopy() *PrometheusProviderAuthentication {
+	if in == nil {
+		return nil
+	}
+	out := new(PrometheusProviderAuthentication)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PrometheusProviderConfig) DeepCopyInto(out *PrometheusProviderConfig) {
+	*out = *in
+	in.Authentication.DeepCopyInto(&out.Authentication)
+	if in.Timeout != nil {
+		in, out := &in.Timeout, &out.Timeout
+		*out = new(int64)
+		**out = **in
+	}
+	if in.Headers != nil {
+		in, out := &in.Headers, &out.Headers
+		*out = make([]WebMetricHeader, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PrometheusProviderConfig.
+func (in *PrometheusProviderConfig) DeepCopy() *PrometheusProviderConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(PrometheusProviderConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *PrometheusRangeQueryArgs) DeepCopyInto(out *PrometheusRangeQueryArgs) {
 	*out = *in
@@ -2706,6 +3290,31 @@ func (in *RolloutTrafficRouting) DeepCopyInto(out *RolloutTrafficRouting) {
 		*out = new(int32)
 		**out = **in
 	}
+	if in.GatewayAPI != nil {
+		in, out := &in.GatewayAPI, &out.GatewayAPI
+		*out = new(GatewayAPITrafficRouting)
+		**out = **in
+	}
+	if in.Stickiness != nil {
+		in, out := &in.Stickiness, &out.Stickiness
+		*out = new(TrafficStickiness)
+		**out = **in
+	}
+	if in.Kong != nil {
+		in, out := &in.Kong, &out.Kong
+		*out = new(KongTrafficRouting)
+		**out = **in
+	}
+	if in.Contour != nil {
+		in, out := &in.Contour, &out.Contour
+		*out = new(ContourTrafficRouting)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Consul != nil {
+		in, out := &in.Consul, &out.Consul
+		*out = new(ConsulTrafficRouting)
+		**out = **in
+	}
 	return
 }
 
@@ -2768,6 +3377,44 @@ func (in *RunSummary) DeepCopy() *RunSummary {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SLOBurnRateMetric) DeepCopyInto(out *SLOBurnRateMetric) {
+	*out = *in
+	in.Prometheus.DeepCopyInto(&out.Prometheus)
+	if in.Windows != nil {
+		in, out := &in.Windows, &out.Windows
+		*out = make([]SLOBurnRateWindow, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SLOBurnRateMetric.
+func (in *SLOBurnRateMetric) DeepCopy() *SLOBurnRateMetric {
+	if in == nil {
+		return nil
+	}
+	out := new(SLOBurnRateMetric)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SLOBurnRateWindow) DeepCopyInto(out *SLOBurnRateWindow) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SLOBurnRateWindow.
+func (in *SLOBurnRateWindow) DeepCopy() *SLOBurnRateWindow {
+	if in == nil {
+		return nil
+	}
+	out := new(SLOBurnRateWindow)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SMITrafficRouting) DeepCopyInto(out *SMITrafficRouting) {
 	*out = *in
@@ -2784,6 +3431,28 @@ func (in *SMITrafficRouting) DeepCopy() *SMITrafficRouting {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SQLMetric) DeepCopyInto(out *SQLMetric) {
+	*out = *in
+	out.SecretRef = in.SecretRef
+	if in.Args != nil {
+		in, out := &in.Args, &out.Args
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SQLMetric.
+func (in *SQLMetric) DeepCopy() *SQLMetric {
+	if in == nil {
+		return nil
+	}
+	out := new(SQLMetric)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ScopeDetail) DeepCopyInto(out *ScopeDetail) {
 	*out = *in
@@ -2800,6 +3469,22 @@ func (in *ScopeDetail) DeepCopy() *ScopeDetail {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ScoreThreshold) DeepCopyInto(out *ScoreThreshold) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ScoreThreshold.
+func (in *ScoreThreshold) DeepCopy() *ScoreThreshold {
+	if in == nil {
+		return nil
+	}
+	out := new(ScoreThreshold)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SecretKeyRef) DeepCopyInto(out *SecretKeyRef) {
 	*out = *in
@@ -2881,6 +3566,32 @@ func (in *SetHeaderRoute) DeepCopy() *SetHeaderRoute {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SetLocality) DeepCopyInto(out *SetLocality) {
+	*out = *in
+	if in.Zones != nil {
+		in, out := &in.Zones, &out.Zones
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Regions != nil {
+		in, out := &in.Regions, &out.Regions
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SetLocality.
+func (in *SetLocality) DeepCopy() *SetLocality {
+	if in == nil {
+		return nil
+	}
+	out := new(SetLocality)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SetMirrorRoute) DeepCopyInto(out *SetMirrorRoute) {
 	*out = *in
@@ -3163,6 +3874,22 @@ func (in *TraefikTrafficRouting) DeepCopy() *TraefikTrafficRouting {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TrafficStickiness) DeepCopyInto(out *TrafficStickiness) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TrafficStickiness.
+func (in *TrafficStickiness) DeepCopy() *TrafficStickiness {
+	if in == nil {
+		return nil
+	}
+	out := new(TrafficStickiness)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *TrafficWeights) DeepCopyInto(out *TrafficWeights) {
 	*out = *in
@@ -3204,6 +3931,11 @@ func (in *ValueFrom) DeepCopyInto(out *ValueFrom) {
 		*out = new(FieldRef)
 		**out = **in
 	}
+	if in.ConfigMapKeyRef != nil {
+		in, out := &in.ConfigMapKeyRef, &out.ConfigMapKeyRef
+		*out = new(ConfigMapKeyRef)
+		**out = **in
+	}
 	return
 }
 
@@ -3247,6 +3979,16 @@ func (in *WebMetric) DeepCopyInto(out *WebMetric) {
 		copy(*out, *in)
 	}
 	in.Authentication.DeepCopyInto(&out.Authentication)
+	if in.TLS != nil {
+		in, out := &in.TLS, &out.TLS
+		*out = new(WebMetricTLS)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Retry != nil {
+		in, out := &in.Retry, &out.Retry
+		*out = new(WebMetricRetry)
+		**out = **in
+	}
 	return
 }
 
@@ -3276,6 +4018,43 @@ func (in *WebMetricHeader) DeepCopy() *WebMetricHeader {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WebMetricRetry) DeepCopyInto(out *WebMetricRetry) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WebMetricRetry.
+func (in *WebMetricRetry) DeepCopy() *WebMetricRetry {
+	if in == nil {
+		return nil
+	}
+	out := new(WebMetricRetry)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WebMetricTLS) DeepCopyInto(out *WebMetricTLS) {
+	*out = *in
+	if in.ClientCertSecretRef != nil {
+		in, out := &in.ClientCertSecretRef, &out.ClientCertSecretRef
+		*out = new(SecretRef)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WebMetricTLS.
+func (in *WebMetricTLS) DeepCopy() *WebMetricTLS {
+	if in == nil {
+		return nil
+	}
+	out := new(WebMetricTLS)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *WeightDestination) DeepCopyInto(out *WeightDestination) {
 	*out = *in
@@ -35,15 +35,41 @@ const (
 	// InvalidSetCanaryScaleTrafficPolicy indicates that TrafficRouting, required for SetCanaryScale, is missing
 	InvalidSetCanaryScaleTrafficPolicy = "SetCanaryScale requires TrafficRouting to be set"
 	// InvalidSetHeaderRouteTrafficPolicy indicates that TrafficRouting required for SetHeaderRoute is missing
-	InvalidSetHeaderRouteTrafficPolicy = "SetHeaderRoute requires TrafficRouting, supports Istio and ALB and Apisix"
+	InvalidSetHeaderRouteTrafficPolicy = "SetHeaderRoute requires TrafficRouting, supports Istio and ALB and Apisix and Gateway API and Nginx and Traefik and SMI and Ambassador and AppMesh and Contour and Consul"
 	// InvalidSetMirrorRouteTrafficPolicy indicates that TrafficRouting, required for SetMirrorRoute, is missing
-	InvalidSetMirrorRouteTrafficPolicy = "SetMirrorRoute requires TrafficRouting, supports Istio and Plugins"
+	InvalidSetMirrorRouteTrafficPolicy = "SetMirrorRoute requires TrafficRouting, supports Istio and Gateway API and Nginx and Traefik and Apisix and Plugins"
+	// InvalidSetMirrorRouteNginxPolicy indicates that SetMirrorRoute using with Nginx has matches or a percentage
+	InvalidSetMirrorRouteNginxPolicy = "SetMirrorRoute invalid. Nginx mirrors all requests and supports neither matches nor a percentage other than 100"
+	// InvalidSetMirrorRouteTraefikMatchPolicy indicates that SetMirrorRoute using with Traefik has matches
+	InvalidSetMirrorRouteTraefikMatchPolicy = "SetMirrorRoute match invalid. Traefik mirrors all requests and does not support matches"
+	// MissingSetMirrorRouteTraefikServiceMessage indicates that SetMirrorRoute using with Traefik misses the mirroring service
+	MissingSetMirrorRouteTraefikServiceMessage = "SetMirrorRoute with Traefik requires trafficRouting.traefik.mirrorTraefikServiceName"
+	// InvalidSetMirrorRouteApisixMatchPolicy indicates that SetMirrorRoute using with Apisix has several matches or a non exact method
+	InvalidSetMirrorRouteApisixMatchPolicy = "SetMirrorRoute match invalid. Apisix supports a single match with an 'exact' method only"
 	// InvalidStringMatchMultipleValuePolicy indicates that SetCanaryScale, has multiple values set
 	InvalidStringMatchMultipleValuePolicy = "StringMatch match value must have exactly one of the following: exact, regex, prefix"
 	// InvalidStringMatchMissedValuePolicy indicates that SetCanaryScale, has multiple values set
 	InvalidStringMatchMissedValuePolicy = "StringMatch value missed, match value must have one of the following: exact, regex, prefix"
 	// InvalidSetHeaderRouteALBValuePolicy indicates that SetHeaderRouting using with ALB missed the 'exact' value
 	InvalidSetHeaderRouteALBValuePolicy = "SetHeaderRoute match value invalid. ALB supports 'exact' value only"
+	// InvalidSetHeaderRouteNginxMatchPolicy indicates that SetHeaderRouting using with Nginx has more than one match
+	InvalidSetHeaderRouteNginxMatchPolicy = "SetHeaderRoute match invalid. Nginx supports a single header match only"
+	// InvalidSetLocalityTrafficPolicy indicates that TrafficRouting, required for SetLocality, is missing
+	InvalidSetLocalityTrafficPolicy = "SetLocality requires TrafficRouting, supports Istio"
+	// InvalidSetLocalityValueMessage indicates that SetLocality has an empty zone or region
+	InvalidSetLocalityValueMessage = "SetLocality zones and regions cannot be empty strings"
+	// MissingSetHeaderRouteTraefikIngressRouteMessage indicates that SetHeaderRoute using with Traefik misses the IngressRoute
+	MissingSetHeaderRouteTraefikIngressRouteMessage = "SetHeaderRoute with Traefik requires trafficRouting.traefik.ingressRouteName"
+	// MissingSetHeaderRouteConsulServiceRouterMessage indicates that SetHeaderRoute using with Consul misses the ServiceRouter
+	MissingSetHeaderRouteConsulServiceRouterMessage = "SetHeaderRoute with Consul requires trafficRouting.consul.serviceRouterName"
+	// InvalidStickinessTrafficPolicy indicates that stickiness is configured with a traffic router which does not support it
+	InvalidStickinessTrafficPolicy = "Stickiness is only supported with Istio and Nginx at this time"
+	// InvalidStickinessIdentifierMessage indicates that stickiness does not set exactly one of cookie or header
+	InvalidStickinessIdentifierMessage = "Stickiness requires exactly one of cookie or header"
+	// MissingStickinessIstioDestinationRuleMessage indicates that stickiness with Istio misses the DestinationRule
+	MissingStickinessIstioDestinationRuleMessage = "Stickiness with Istio requires trafficRouting.istio.destinationRule"
+	// InvalidStickinessDurationMessage indicates that the stickiness cookie has a negative lifetime
+	InvalidStickinessDurationMessage = "Stickiness durationSeconds must be greater than or equal to 0"
 	// InvalidDurationMessage indicates the Duration value needs to be greater than 0
 	InvalidDurationMessage = "Duration needs to be greater than 0"
 	// InvalidMaxSurgeMaxUnavailable indicates both maxSurge and MaxUnavailable can not be set to zero
@@ -79,6 +105,10 @@ const (
 	InvalidPingPongProvidedMessage = "Ping service and Pong service must to be set to use Ping-Pong feature"
 	// DuplicatedPingPongServicesMessage indicates that the rollout uses the same service for the ping and pong services
 	DuplicatedPingPongServicesMessage = "This rollout uses the same service for the ping and pong services, but two different services are required."
+	// MissingGatewayAPIRouteMessage indicates that the Gateway API TrafficRouting does not reference any route
+	MissingGatewayAPIRouteMessage = "Gateway API traffic routing requires at least one of httpRoute, grpcRoute or tcpRoute"
+	// InvalidKongTrafficRoutingMessage indicates that the Kong TrafficRouting does not reference either both targets or a canary plugin
+	InvalidKongTrafficRoutingMessage = "Kong traffic routing requires either both stableTarget and canaryTarget, or canaryPlugin"
 	// MissedAlbRootServiceMessage indicates that the rollout with ALB TrafficRouting and ping pong feature enabled must have root service provided
 	MissedAlbRootServiceMessage = "Root service field is required for the configuration with ALB and ping-pong feature enabled"
 	// PingPongWithRouterOnlyMessage At this moment ping-pong feature works with the ALB, Istio, and plugin-based traffic routers only
@@ -250,13 +280,37 @@ func requireCanaryStableServices(rollout *v1alpha1.Rollout) bool {
 		canary.TrafficRouting.Ambassador != nil,
 		canary.TrafficRouting.Nginx != nil,
 		canary.TrafficRouting.AppMesh != nil,
-		canary.TrafficRouting.Traefik != nil:
+		canary.TrafficRouting.Traefik != nil,
+		canary.TrafficRouting.GatewayAPI != nil,
+		canary.TrafficRouting.Kong != nil,
+		canary.TrafficRouting.Contour != nil,
+		canary.TrafficRouting.Consul != nil:
 		return true
 	default:
 		return false
 	}
 }
 
+// invalidStickiness validates the stickiness of the traffic routing against the configured traffic router
+func invalidStickiness(trafficRouting *v1alpha1.RolloutTrafficRouting, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	stickiness := trafficRouting.Stickiness
+	stickinessFldPath := fldPath.Child("stickiness")
+	if trafficRouting.Istio == nil && trafficRouting.Nginx == nil {
+		allErrs = append(allErrs, field.Invalid(stickinessFldPath, stickiness, InvalidStickinessTrafficPolicy))
+	}
+	if (stickiness.Cookie == "") == (stickiness.Header == "") {
+		allErrs = append(allErrs, field.Invalid(stickinessFldPath, stickiness, InvalidStickinessIdentifierMessage))
+	}
+	if trafficRouting.Istio != nil && trafficRouting.Istio.DestinationRule == nil {
+		allErrs = append(allErrs, field.Required(fldPath.Child("istio").Child("destinationRule"), MissingStickinessIstioDestinationRuleMessage))
+	}
+	if stickiness.DurationSeconds < 0 {
+		allErrs = append(allErrs, field.Invalid(stickinessFldPath.Child("durationSeconds"), stickiness.DurationSeconds, InvalidStickinessDurationMessage))
+	}
+	return allErrs
+}
+
 func ValidateRolloutStrategyCanary(rollout *v1alpha1.Rollout, fldPath *field.Path) field.ErrorList {
 	canary := rollout.Spec.Strategy.Canary
 	allErrs := field.ErrorList{}
@@ -301,6 +355,18 @@ func ValidateRolloutStrategyCanary(rollout *v1alpha1.Rollout, fldPath *field.Pat
 		if canary.ScaleDownDelaySeconds != nil && canary.DynamicStableScale {
 			allErrs = append(allErrs, field.Invalid(fldPath.Child("dynamicStableScale"), canary.DynamicStableScale, InvalidCanaryDynamicStableScaleWithScaleDownDelay))
 		}
+		if gatewayAPI := canary.TrafficRouting.GatewayAPI; gatewayAPI != nil && gatewayAPI.HTTPRoute == "" && gatewayAPI.GRPCRoute == "" && gatewayAPI.TCPRoute == "" {
+			allErrs = append(allErrs, field.Required(fldPath.Child("trafficRouting").Child("gatewayAPI"), MissingGatewayAPIRouteMessage))
+		}
+		if kong := canary.TrafficRouting.Kong; kong != nil {
+			usesTargets := kong.StableTarget != "" || kong.CanaryTarget != ""
+			if usesTargets == (kong.CanaryPlugin != "") || usesTargets && (kong.StableTarget == "" || kong.CanaryTarget == "") {
+				allErrs = append(allErrs, field.Invalid(fldPath.Child("trafficRouting").Child("kong"), kong, InvalidKongTrafficRoutingMessage))
+			}
+		}
+		if canary.TrafficRouting.Stickiness != nil {
+			allErrs = append(allErrs, invalidStickiness(canary.TrafficRouting, fldPath.Child("trafficRouting"))...)
+		}
 		// only the nginx and plugin have this support for now
 		if canary.TrafficRouting.MaxTrafficWeight != nil {
 			if canary.TrafficRouting.Nginx == nil && len(canary.TrafficRouting.Plugins) == 0 {
@@ -313,9 +379,9 @@ func ValidateRolloutStrategyCanary(rollout *v1alpha1.Rollout, fldPath *field.Pat
 		stepFldPath := fldPath.Child("steps").Index(i)
 		allErrs = append(allErrs, hasMultipleStepsType(step, stepFldPath)...)
 		if step.Experiment == nil && step.Pause == nil && step.SetWeight == nil && step.Analysis == nil && step.SetCanaryScale == nil &&
-			step.SetHeaderRoute == nil && step.SetMirrorRoute == nil && step.Plugin == nil {
-			errVal := fmt.Sprintf("step.Experiment: %t step.Pause: %t step.SetWeight: %t step.Analysis: %t step.SetCanaryScale: %t step.SetHeaderRoute: %t step.SetMirrorRoute: %t step.Plugin: %t",
-				step.Experiment == nil, step.Pause == nil, step.SetWeight == nil, step.Analysis == nil, step.SetCanaryScale == nil, step.SetHeaderRoute == nil, step.SetMirrorRoute == nil, step.Plugin == nil)
+			step.SetHeaderRoute == nil && step.SetMirrorRoute == nil && step.Plugin == nil && step.SetLocality == nil {
+			errVal := fmt.Sprintf("step.Experiment: %t step.Pause: %t step.SetWeight: %t step.Analysis: %t step.SetCanaryScale: %t step.SetHeaderRoute: %t step.SetMirrorRoute: %t step.Plugin: %t step.SetLocality: %t",
+				step.Experiment == nil, step.Pause == nil, step.SetWeight == nil, step.Analysis == nil, step.SetCanaryScale == nil, step.SetHeaderRoute == nil, step.SetMirrorRoute == nil, step.Plugin == nil, step.SetLocality == nil)
 			allErrs = append(allErrs, field.Invalid(stepFldPath, errVal, InvalidStepMessage))
 		}
 
@@ -333,9 +399,20 @@ func ValidateRolloutStrategyCanary(rollout *v1alpha1.Rollout, fldPath *field.Pat
 
 		if step.SetHeaderRoute != nil {
 			trafficRouting := rollout.Spec.Strategy.Canary.TrafficRouting
-			if trafficRouting == nil || (trafficRouting.Istio == nil && trafficRouting.ALB == nil && trafficRouting.Apisix == nil && len(trafficRouting.Plugins) == 0) {
+			if trafficRouting == nil || (trafficRouting.Istio == nil && trafficRouting.ALB == nil && trafficRouting.Apisix == nil && trafficRouting.GatewayAPI == nil && trafficRouting.Nginx == nil && trafficRouting.Traefik == nil &&
+				trafficRouting.SMI == nil && trafficRouting.Ambassador == nil && trafficRouting.AppMesh == nil && trafficRouting.Contour == nil && trafficRouting.Consul == nil &&
+				len(trafficRouting.Plugins) == 0) {
 				allErrs = append(allErrs, field.Invalid(stepFldPath.Child("setHeaderRoute"), step.SetHeaderRoute, InvalidSetHeaderRouteTrafficPolicy))
 			} else if step.SetHeaderRoute.Match != nil && len(step.SetHeaderRoute.Match) > 0 {
+				if trafficRouting.Traefik != nil && trafficRouting.Traefik.IngressRouteName == "" {
+					allErrs = append(allErrs, field.Required(stepFldPath.Child("setHeaderRoute"), MissingSetHeaderRouteTraefikIngressRouteMessage))
+				}
+				if trafficRouting.Consul != nil && trafficRouting.Consul.ServiceRouterName == "" {
+					allErrs = append(allErrs, field.Required(stepFldPath.Child("setHeaderRoute"), MissingSetHeaderRouteConsulServiceRouterMessage))
+				}
+				if trafficRouting.Nginx != nil && len(step.SetHeaderRoute.Match) > 1 {
+					allErrs = append(allErrs, field.Invalid(stepFldPath.Child("setHeaderRoute").Child("match"), step.SetHeaderRoute.Match, InvalidSetHeaderRouteNginxMatchPolicy))
+				}
 				for j, match := range step.SetHeaderRoute.Match {
 					if trafficRouting.ALB != nil {
 						matchFld := stepFldPath.Child("setHeaderRoute").Child("match").Index(j)
@@ -350,8 +427,11 @@ func ValidateRolloutStrategyCanary(rollout *v1alpha1.Rollout, fldPath *field.Pat
 
 		if step.SetMirrorRoute != nil {
 			trafficRouting := rollout.Spec.Strategy.Canary.TrafficRouting
-			if trafficRouting == nil || (trafficRouting.Istio == nil && len(trafficRouting.Plugins) == 0) {
+			if trafficRouting == nil || (trafficRouting.Istio == nil && trafficRouting.GatewayAPI == nil && trafficRouting.Nginx == nil &&
+				trafficRouting.Traefik == nil && trafficRouting.Apisix == nil && len(trafficRouting.Plugins) == 0) {
 				allErrs = append(allErrs, field.Invalid(stepFldPath.Child("setMirrorRoute"), step.SetMirrorRoute, InvalidSetMirrorRouteTrafficPolicy))
+			} else if step.SetMirrorRoute.Match != nil || step.SetMirrorRoute.Percentage != nil {
+				allErrs = append(allErrs, validateSetMirrorRouteForRouter(trafficRouting, step.SetMirrorRoute, stepFldPath.Child("setMirrorRoute"))...)
 			}
 			if step.SetMirrorRoute.Match != nil && len(step.SetMirrorRoute.Match) > 0 {
 				for j, match := range step.SetMirrorRoute.Match {
@@ -369,8 +449,25 @@ func ValidateRolloutStrategyCanary(rollout *v1alpha1.Rollout, fldPath *field.Pat
 			}
 		}
 
+		if step.SetLocality != nil {
+			trafficRouting := rollout.Spec.Strategy.Canary.TrafficRouting
+			if trafficRouting == nil || trafficRouting.Istio == nil {
+				allErrs = append(allErrs, field.Invalid(stepFldPath.Child("setLocality"), step.SetLocality, InvalidSetLocalityTrafficPolicy))
+			}
+			for j, zone := range step.SetLocality.Zones {
+				if zone == "" {
+					allErrs = append(allErrs, field.Invalid(stepFldPath.Child("setLocality").Child("zones").Index(j), zone, InvalidSetLocalityValueMessage))
+				}
+			}
+			for j, region := range step.SetLocality.Regions {
+				if region == "" {
+					allErrs = append(allErrs, field.Invalid(stepFldPath.Child("setLocality").Child("regions").Index(j), region, InvalidSetLocalityValueMessage))
+				}
+			}
+		}
+
 		if rollout.Spec.Strategy.Canary.TrafficRouting != nil {
-			if step.SetHeaderRoute != nil || step.SetMirrorRoute != nil {
+			if step.SetHeaderRoute != nil || step.SetMirrorRoute != nil || step.SetLocality != nil {
 				if rollout.Spec.Strategy.Canary.TrafficRouting.ManagedRoutes == nil {
 					message := fmt.Sprintf(MissingFieldMessage, "spec.strategy.canary.trafficRouting.managedRoutes")
 					allErrs = append(allErrs, field.Required(fldPath.Child("trafficRouting", "managedRoutes"), message))
@@ -384,6 +481,9 @@ func ValidateRolloutStrategyCanary(rollout *v1alpha1.Rollout, fldPath *field.Pat
 			if step.SetMirrorRoute != nil {
 				allErrs = append(allErrs, ValidateStepRouteFoundInManagedRoute(stepFldPath.Child("setMirrorRoute"), step.SetMirrorRoute.Name, rollout.Spec.Strategy.Canary.TrafficRouting.ManagedRoutes)...)
 			}
+			if step.SetLocality != nil {
+				allErrs = append(allErrs, ValidateStepRouteFoundInManagedRoute(stepFldPath.Child("setLocality"), step.SetLocality.Name, rollout.Spec.Strategy.Canary.TrafficRouting.ManagedRoutes)...)
+			}
 		}
 
 		analysisRunArgs := make([]v1alpha1.AnalysisRunArgument, 0)
@@ -392,8 +492,9 @@ func ValidateRolloutStrategyCanary(rollout *v1alpha1.Rollout, fldPath *field.Pat
 				if template.Weight != nil {
 					if canary.TrafficRouting == nil {
 						allErrs = append(allErrs, field.Invalid(stepFldPath.Child("experiment").Child("templates").Index(tmplIndex).Child("weight"), *canary.Steps[i].Experiment.Templates[tmplIndex].Weight, InvalidCanaryExperimentTemplateWeightWithoutTrafficRouting))
-					} else if canary.TrafficRouting.ALB == nil && canary.TrafficRouting.SMI == nil && canary.TrafficRouting.Istio == nil && len(canary.TrafficRouting.Plugins) == 0 {
-						allErrs = append(allErrs, field.Invalid(stepFldPath.Child("experiment").Child("templates").Index(tmplIndex).Child("weight"), *canary.Steps[i].Experiment.Templates[tmplIndex].Weight, "Experiment template weight is only available for TrafficRouting with SMI, ALB, Istio and Plugins at this time"))
+					} else if canary.TrafficRouting.ALB == nil && canary.TrafficRouting.SMI == nil && canary.TrafficRouting.Istio == nil && canary.TrafficRouting.Traefik == nil &&
+						canary.TrafficRouting.Contour == nil && canary.TrafficRouting.Consul == nil && len(canary.TrafficRouting.Plugins) == 0 {
+						allErrs = append(allErrs, field.Invalid(stepFldPath.Child("experiment").Child("templates").Index(tmplIndex).Child("weight"), *canary.Steps[i].Experiment.Templates[tmplIndex].Weight, "Experiment template weight is only available for TrafficRouting with SMI, ALB, Istio, Traefik, Contour, Consul and Plugins at this time"))
 					}
 				}
 			}
@@ -506,6 +607,39 @@ func hasMultipleStepsType(s v1alpha1.CanaryStep, fldPath *field.Path) field.Erro
 	return allErrs
 }
 
+// validateSetMirrorRouteForRouter checks that the traffic router of the rollout can express a mirror route
+func validateSetMirrorRouteForRouter(trafficRouting *v1alpha1.RolloutTrafficRouting, setMirrorRoute *v1alpha1.SetMirrorRoute, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	hasMatchValues := false
+	for _, match := range setMirrorRoute.Match {
+		if match.Method != nil || match.Path != nil || len(match.Headers) > 0 {
+			hasMatchValues = true
+		}
+	}
+	if trafficRouting.Nginx != nil {
+		if hasMatchValues || (setMirrorRoute.Percentage != nil && *setMirrorRoute.Percentage != 100) {
+			allErrs = append(allErrs, field.Invalid(fldPath, setMirrorRoute, InvalidSetMirrorRouteNginxPolicy))
+		}
+	}
+	if trafficRouting.Traefik != nil {
+		if hasMatchValues {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("match"), setMirrorRoute.Match, InvalidSetMirrorRouteTraefikMatchPolicy))
+		}
+		if trafficRouting.Traefik.MirrorTraefikServiceName == "" {
+			allErrs = append(allErrs, field.Required(fldPath, MissingSetMirrorRouteTraefikServiceMessage))
+		}
+	}
+	if trafficRouting.Apisix != nil {
+		for _, match := range setMirrorRoute.Match {
+			if len(setMirrorRoute.Match) > 1 || (match.Method != nil && match.Method.Exact == "") {
+				allErrs = append(allErrs, field.Invalid(fldPath.Child("match"), setMirrorRoute.Match, InvalidSetMirrorRouteApisixMatchPolicy))
+				break
+			}
+		}
+	}
+	return allErrs
+}
+
 func hasALBInvalidValues(match *v1alpha1.StringMatch, fldPath *field.Path) field.ErrorList {
 	allErrs := field.ErrorList{}
 	if match == nil {
@@ -17,7 +17,7 @@ import (
 )
 
 const (
-	errTrafficRoutingWithExperimentSupport = "Experiment template weight is only available for TrafficRouting with SMI, ALB, Istio and Plugins at this time"
+	errTrafficRoutingWithExperimentSupport = "Experiment template weight is only available for TrafficRouting with SMI, ALB, Istio, Traefik, Contour, Consul and Plugins at this time"
 )
 
 func TestValidateRollout(t *testing.T) {
@@ -182,6 +182,12 @@ func TestValidateRolloutStrategyCanaryMissingServiceNames(t *testing.T) {
 				Traefik: &v1alpha1.TraefikTrafficRouting{},
 			},
 		},
+		{
+			name: "GatewayAPI",
+			trafficRouting: &v1alpha1.RolloutTrafficRouting{
+				GatewayAPI: &v1alpha1.GatewayAPITrafficRouting{HTTPRoute: "http-route"},
+			},
+		},
 		{
 			name: "Traefik and Istio Subset Routing",
 			trafficRouting: &v1alpha1.RolloutTrafficRouting{
@@ -542,6 +548,107 @@ func TestValidateRolloutStrategyCanarySetHeaderRoute(t *testing.T) {
 		allErrs := ValidateRolloutStrategyCanary(invalidRo, field.NewPath(""))
 		assert.Equal(t, InvalidSetHeaderRouteTrafficPolicy, allErrs[0].Detail)
 	})
+
+	t.Run("using SetHeaderRoute step with SMI, Ambassador and AppMesh", func(t *testing.T) {
+		for _, trafficRouting := range []v1alpha1.RolloutTrafficRouting{
+			{SMI: &v1alpha1.SMITrafficRouting{}},
+			{Ambassador: &v1alpha1.AmbassadorTrafficRouting{Mappings: []string{"mapping"}}},
+			{AppMesh: &v1alpha1.AppMeshTrafficRouting{}},
+		} {
+			validRo := ro.DeepCopy()
+			validRo.Spec.Strategy.Canary.TrafficRouting = trafficRouting.DeepCopy()
+			validRo.Spec.Strategy.Canary.TrafficRouting.ManagedRoutes = []v1alpha1.MangedRoutes{{Name: "agent"}}
+			validRo.Spec.Strategy.Canary.Steps = []v1alpha1.CanaryStep{{
+				SetHeaderRoute: &v1alpha1.SetHeaderRoute{
+					Name: "agent",
+					Match: []v1alpha1.HeaderRoutingMatch{{
+						HeaderName:  "agent",
+						HeaderValue: &v1alpha1.StringMatch{Exact: "chrome"},
+					}},
+				},
+			}}
+			allErrs := ValidateRolloutStrategyCanary(validRo, field.NewPath(""))
+			for _, err := range allErrs {
+				assert.NotEqual(t, InvalidSetHeaderRouteTrafficPolicy, err.Detail)
+			}
+		}
+	})
+}
+
+func TestValidateRolloutStrategyCanaryKong(t *testing.T) {
+	ro := &v1alpha1.Rollout{}
+	ro.Spec.Strategy.Canary = &v1alpha1.CanaryStrategy{
+		CanaryService: "canary",
+		StableService: "stable",
+		TrafficRouting: &v1alpha1.RolloutTrafficRouting{
+			Kong: &v1alpha1.KongTrafficRouting{StableTarget: "stable-target", CanaryTarget: "canary-target"},
+		},
+	}
+
+	t.Run("using targets", func(t *testing.T) {
+		allErrs := ValidateRolloutStrategyCanary(ro, field.NewPath(""))
+		assert.Empty(t, allErrs)
+	})
+
+	t.Run("using a canary plugin", func(t *testing.T) {
+		validRo := ro.DeepCopy()
+		validRo.Spec.Strategy.Canary.TrafficRouting.Kong = &v1alpha1.KongTrafficRouting{CanaryPlugin: "canary-plugin"}
+		allErrs := ValidateRolloutStrategyCanary(validRo, field.NewPath(""))
+		assert.Empty(t, allErrs)
+	})
+
+	for name, kong := range map[string]*v1alpha1.KongTrafficRouting{
+		"without targets nor canary plugin":   {},
+		"with a single target":                {CanaryTarget: "canary-target", CanaryPlugin: "canary-plugin"},
+		"with both targets and canary plugin": {StableTarget: "stable-target", CanaryTarget: "canary-target", CanaryPlugin: "canary-plugin"},
+	} {
+		t.Run(name, func(t *testing.T) {
+			invalidRo := ro.DeepCopy()
+			invalidRo.Spec.Strategy.Canary.TrafficRouting.Kong = kong
+			allErrs := ValidateRolloutStrategyCanary(invalidRo, field.NewPath(""))
+			assert.Len(t, allErrs, 1)
+			assert.Equal(t, InvalidKongTrafficRoutingMessage, allErrs[0].Detail)
+		})
+	}
+}
+
+func TestValidateRolloutStrategyCanaryGatewayAPI(t *testing.T) {
+	ro := &v1alpha1.Rollout{}
+	ro.Spec.Strategy.Canary = &v1alpha1.CanaryStrategy{
+		CanaryService: "canary",
+		StableService: "stable",
+		TrafficRouting: &v1alpha1.RolloutTrafficRouting{
+			GatewayAPI:    &v1alpha1.GatewayAPITrafficRouting{HTTPRoute: "http-route"},
+			ManagedRoutes: []v1alpha1.MangedRoutes{{Name: "header"}, {Name: "mirror"}},
+		},
+		Steps: []v1alpha1.CanaryStep{{
+			SetHeaderRoute: &v1alpha1.SetHeaderRoute{
+				Name: "header",
+				Match: []v1alpha1.HeaderRoutingMatch{{
+					HeaderName:  "agent",
+					HeaderValue: &v1alpha1.StringMatch{Exact: "chrome"},
+				}},
+			},
+		}, {
+			SetMirrorRoute: &v1alpha1.SetMirrorRoute{
+				Name:  "mirror",
+				Match: []v1alpha1.RouteMatch{{Path: &v1alpha1.StringMatch{Prefix: "/api"}}},
+			},
+		}},
+	}
+
+	t.Run("using SetHeaderRoute and SetMirrorRoute steps", func(t *testing.T) {
+		allErrs := ValidateRolloutStrategyCanary(ro, field.NewPath(""))
+		assert.Empty(t, allErrs)
+	})
+
+	t.Run("without any route", func(t *testing.T) {
+		invalidRo := ro.DeepCopy()
+		invalidRo.Spec.Strategy.Canary.TrafficRouting.GatewayAPI = &v1alpha1.GatewayAPITrafficRouting{}
+		allErrs := ValidateRolloutStrategyCanary(invalidRo, field.NewPath(""))
+		assert.Len(t, allErrs, 1)
+		assert.Equal(t, MissingGatewayAPIRouteMessage, allErrs[0].Detail)
+	})
 }
 
 func TestValidateRolloutStrategyCanarySetHeaderRoutePlugins(t *testing.T) {
@@ -771,6 +878,282 @@ func TestValidateRolloutStrategyCanarySetHeaderRoutingALB(t *testing.T) {
 	})
 }
 
+func TestValidateRolloutStrategyCanarySetHeaderRoutingNginx(t *testing.T) {
+	ro := &v1alpha1.Rollout{}
+	ro.Spec.Strategy.Canary = &v1alpha1.CanaryStrategy{
+		CanaryService: "canary",
+		StableService: "stable",
+		TrafficRouting: &v1alpha1.RolloutTrafficRouting{
+			Nginx: &v1alpha1.NginxTrafficRouting{
+				StableIngress: "stable-ingress",
+			},
+			ManagedRoutes: []v1alpha1.MangedRoutes{{Name: "header"}},
+		},
+	}
+
+	t.Run("using SetHeaderRouting step with a single match", func(t *testing.T) {
+		validRo := ro.DeepCopy()
+		validRo.Spec.Strategy.Canary.Steps = []v1alpha1.CanaryStep{{
+			SetHeaderRoute: &v1alpha1.SetHeaderRoute{
+				Name: "header",
+				Match: []v1alpha1.HeaderRoutingMatch{
+					{
+						HeaderName:  "agent",
+						HeaderValue: &v1alpha1.StringMatch{Prefix: "chrome"},
+					},
+				},
+			},
+		}}
+		allErrs := ValidateRolloutStrategyCanary(validRo, field.NewPath(""))
+		assert.Empty(t, allErrs)
+	})
+
+	t.Run("using SetHeaderRouting step with multiple matches", func(t *testing.T) {
+		invalidRo := ro.DeepCopy()
+		invalidRo.Spec.Strategy.Canary.Steps = []v1alpha1.CanaryStep{{
+			SetHeaderRoute: &v1alpha1.SetHeaderRoute{
+				Name: "header",
+				Match: []v1alpha1.HeaderRoutingMatch{
+					{
+						HeaderName:  "agent",
+						HeaderValue: &v1alpha1.StringMatch{Exact: "chrome"},
+					},
+					{
+						HeaderName:  "version",
+						HeaderValue: &v1alpha1.StringMatch{Exact: "2"},
+					},
+				},
+			},
+		}}
+		allErrs := ValidateRolloutStrategyCanary(invalidRo, field.NewPath(""))
+		assert.Len(t, allErrs, 1)
+		assert.Equal(t, InvalidSetHeaderRouteNginxMatchPolicy, allErrs[0].Detail)
+	})
+}
+
+func TestValidateRolloutStrategyCanarySetHeaderRoutingTraefik(t *testing.T) {
+	ro := &v1alpha1.Rollout{}
+	ro.Spec.Strategy.Canary = &v1alpha1.CanaryStrategy{
+		CanaryService: "canary",
+		StableService: "stable",
+		TrafficRouting: &v1alpha1.RolloutTrafficRouting{
+			Traefik: &v1alpha1.TraefikTrafficRouting{
+				WeightedTraefikServiceName: "weighted",
+				IngressRouteName:           "ingress-route",
+			},
+			ManagedRoutes: []v1alpha1.MangedRoutes{{Name: "header"}},
+		},
+		Steps: []v1alpha1.CanaryStep{{
+			SetHeaderRoute: &v1alpha1.SetHeaderRoute{
+				Name: "header",
+				Match: []v1alpha1.HeaderRoutingMatch{
+					{
+						HeaderName:  "agent",
+						HeaderValue: &v1alpha1.StringMatch{Prefix: "chrome"},
+					},
+					{
+						HeaderName:  "version",
+						HeaderValue: &v1alpha1.StringMatch{Exact: "2"},
+					},
+				},
+			},
+		}},
+	}
+
+	t.Run("using SetHeaderRouting step with an ingress route", func(t *testing.T) {
+		allErrs := ValidateRolloutStrategyCanary(ro, field.NewPath(""))
+		assert.Empty(t, allErrs)
+	})
+
+	t.Run("using SetHeaderRouting step without an ingress route", func(t *testing.T) {
+		invalidRo := ro.DeepCopy()
+		invalidRo.Spec.Strategy.Canary.TrafficRouting.Traefik.IngressRouteName = ""
+		allErrs := ValidateRolloutStrategyCanary(invalidRo, field.NewPath(""))
+		assert.Len(t, allErrs, 1)
+		assert.Equal(t, MissingSetHeaderRouteTraefikIngressRouteMessage, allErrs[0].Detail)
+	})
+}
+
+func TestValidateRolloutStrategyCanaryContourConsul(t *testing.T) {
+	ro := &v1alpha1.Rollout{}
+	ro.Spec.Strategy.Canary = &v1alpha1.CanaryStrategy{
+		CanaryService: "canary",
+		StableService: "stable",
+		TrafficRouting: &v1alpha1.RolloutTrafficRouting{
+			ManagedRoutes: []v1alpha1.MangedRoutes{{Name: "header"}},
+		},
+		Steps: []v1alpha1.CanaryStep{{
+			SetHeaderRoute: &v1alpha1.SetHeaderRoute{
+				Name: "header",
+				Match: []v1alpha1.HeaderRoutingMatch{{
+					HeaderName:  "agent",
+					HeaderValue: &v1alpha1.StringMatch{Prefix: "chrome"},
+				}},
+			},
+		}, {
+			Experiment: &v1alpha1.RolloutExperimentStep{
+				Templates: []v1alpha1.RolloutExperimentTemplate{{
+					Name:     "template",
+					SpecRef:  v1alpha1.CanarySpecRef,
+					Selector: &metav1.LabelSelector{MatchLabels: map[string]string{"app": "experiment"}},
+					Weight:   ptr.To[int32](10),
+				}},
+			},
+		}},
+	}
+
+	t.Run("using SetHeaderRoute and experiment weights with Contour", func(t *testing.T) {
+		validRo := ro.DeepCopy()
+		validRo.Spec.Strategy.Canary.TrafficRouting.Contour = &v1alpha1.ContourTrafficRouting{HTTPProxies: []string{"http-proxy"}}
+		allErrs := ValidateRolloutStrategyCanary(validRo, field.NewPath(""))
+		assert.Empty(t, allErrs)
+	})
+
+	t.Run("using SetHeaderRoute and experiment weights with Consul", func(t *testing.T) {
+		validRo := ro.DeepCopy()
+		validRo.Spec.Strategy.Canary.TrafficRouting.Consul = &v1alpha1.ConsulTrafficRouting{ServiceSplitterName: "splitter", ServiceRouterName: "router"}
+		allErrs := ValidateRolloutStrategyCanary(validRo, field.NewPath(""))
+		assert.Empty(t, allErrs)
+	})
+
+	t.Run("using SetHeaderRoute step with Consul without a service router", func(t *testing.T) {
+		invalidRo := ro.DeepCopy()
+		invalidRo.Spec.Strategy.Canary.TrafficRouting.Consul = &v1alpha1.ConsulTrafficRouting{ServiceSplitterName: "splitter"}
+		allErrs := ValidateRolloutStrategyCanary(invalidRo, field.NewPath(""))
+		assert.Len(t, allErrs, 1)
+		assert.Equal(t, MissingSetHeaderRouteConsulServiceRouterMessage, allErrs[0].Detail)
+	})
+
+	t.Run("using SetHeaderRoute and experiment weights with Kong", func(t *testing.T) {
+		invalidRo := ro.DeepCopy()
+		invalidRo.Spec.Strategy.Canary.TrafficRouting.Kong = &v1alpha1.KongTrafficRouting{StableTarget: "stable", CanaryTarget: "canary"}
+		allErrs := ValidateRolloutStrategyCanary(invalidRo, field.NewPath(""))
+		assert.Len(t, allErrs, 2)
+		assert.Equal(t, InvalidSetHeaderRouteTrafficPolicy, allErrs[0].Detail)
+		assert.Equal(t, errTrafficRoutingWithExperimentSupport, allErrs[1].Detail)
+	})
+}
+
+func TestValidateRolloutStrategyCanarySetLocality(t *testing.T) {
+	ro := &v1alpha1.Rollout{}
+	ro.Spec.Strategy.Canary = &v1alpha1.CanaryStrategy{
+		CanaryService: "canary",
+		StableService: "stable",
+		TrafficRouting: &v1alpha1.RolloutTrafficRouting{
+			Istio: &v1alpha1.IstioTrafficRouting{
+				VirtualService: &v1alpha1.IstioVirtualService{Name: "virtual-service"},
+			},
+			ManagedRoutes: []v1alpha1.MangedRoutes{{Name: "locality"}},
+		},
+		Steps: []v1alpha1.CanaryStep{{
+			SetLocality: &v1alpha1.SetLocality{
+				Name:    "locality",
+				Zones:   []string{"us-east-1a"},
+				Regions: []string{"eu-west-1"},
+			},
+		}},
+	}
+
+	t.Run("using SetLocality step with Istio", func(t *testing.T) {
+		allErrs := ValidateRolloutStrategyCanary(ro, field.NewPath(""))
+		assert.Empty(t, allErrs)
+	})
+
+	t.Run("using SetLocality step without Istio", func(t *testing.T) {
+		invalidRo := ro.DeepCopy()
+		invalidRo.Spec.Strategy.Canary.TrafficRouting.Istio = nil
+		invalidRo.Spec.Strategy.Canary.TrafficRouting.Nginx = &v1alpha1.NginxTrafficRouting{StableIngress: "stable-ingress"}
+		allErrs := ValidateRolloutStrategyCanary(invalidRo, field.NewPath(""))
+		assert.Len(t, allErrs, 1)
+		assert.Equal(t, InvalidSetLocalityTrafficPolicy, allErrs[0].Detail)
+	})
+
+	t.Run("using SetLocality step with an empty zone", func(t *testing.T) {
+		invalidRo := ro.DeepCopy()
+		invalidRo.Spec.Strategy.Canary.Steps[0].SetLocality.Zones = []string{""}
+		allErrs := ValidateRolloutStrategyCanary(invalidRo, field.NewPath(""))
+		assert.Len(t, allErrs, 1)
+		assert.Equal(t, InvalidSetLocalityValueMessage, allErrs[0].Detail)
+	})
+
+	t.Run("using SetLocality step with an unknown managed route", func(t *testing.T) {
+		invalidRo := ro.DeepCopy()
+		invalidRo.Spec.Strategy.Canary.Steps[0].SetLocality.Name = "unknown"
+		allErrs := ValidateRolloutStrategyCanary(invalidRo, field.NewPath(""))
+		assert.Len(t, allErrs, 1)
+	})
+}
+
+func TestValidateRolloutStrategyCanaryStickiness(t *testing.T) {
+	ro := &v1alpha1.Rollout{}
+	ro.Spec.Strategy.Canary = &v1alpha1.CanaryStrategy{
+		TrafficRouting: &v1alpha1.RolloutTrafficRouting{
+			Istio: &v1alpha1.IstioTrafficRouting{
+				VirtualService: &v1alpha1.IstioVirtualService{Name: "virtual-service"},
+				DestinationRule: &v1alpha1.IstioDestinationRule{
+					Name:             "destination-rule",
+					CanarySubsetName: "canary",
+					StableSubsetName: "stable",
+				},
+			},
+			Stickiness: &v1alpha1.TrafficStickiness{Cookie: "session", DurationSeconds: 3600},
+		},
+	}
+
+	t.Run("using stickiness with Istio", func(t *testing.T) {
+		allErrs := ValidateRolloutStrategyCanary(ro, field.NewPath(""))
+		assert.Empty(t, allErrs)
+	})
+
+	t.Run("using stickiness with Istio without a DestinationRule", func(t *testing.T) {
+		invalidRo := ro.DeepCopy()
+		invalidRo.Spec.Strategy.Canary.CanaryService = "canary"
+		invalidRo.Spec.Strategy.Canary.StableService = "stable"
+		invalidRo.Spec.Strategy.Canary.TrafficRouting.Istio.DestinationRule = nil
+		allErrs := ValidateRolloutStrategyCanary(invalidRo, field.NewPath(""))
+		assert.Len(t, allErrs, 1)
+		assert.Equal(t, MissingStickinessIstioDestinationRuleMessage, allErrs[0].Detail)
+	})
+
+	t.Run("using stickiness with both a cookie and a header", func(t *testing.T) {
+		invalidRo := ro.DeepCopy()
+		invalidRo.Spec.Strategy.Canary.TrafficRouting.Stickiness.Header = "x-user"
+		allErrs := ValidateRolloutStrategyCanary(invalidRo, field.NewPath(""))
+		assert.Len(t, allErrs, 1)
+		assert.Equal(t, InvalidStickinessIdentifierMessage, allErrs[0].Detail)
+	})
+
+	t.Run("using stickiness with a negative duration", func(t *testing.T) {
+		invalidRo := ro.DeepCopy()
+		invalidRo.Spec.Strategy.Canary.TrafficRouting.Stickiness.DurationSeconds = -1
+		allErrs := ValidateRolloutStrategyCanary(invalidRo, field.NewPath(""))
+		assert.Len(t, allErrs, 1)
+		assert.Equal(t, InvalidStickinessDurationMessage, allErrs[0].Detail)
+	})
+
+	t.Run("using header stickiness with Nginx", func(t *testing.T) {
+		validRo := ro.DeepCopy()
+		validRo.Spec.Strategy.Canary.CanaryService = "canary"
+		validRo.Spec.Strategy.Canary.StableService = "stable"
+		validRo.Spec.Strategy.Canary.TrafficRouting.Istio = nil
+		validRo.Spec.Strategy.Canary.TrafficRouting.Nginx = &v1alpha1.NginxTrafficRouting{StableIngress: "stable-ingress"}
+		validRo.Spec.Strategy.Canary.TrafficRouting.Stickiness = &v1alpha1.TrafficStickiness{Header: "x-user"}
+		allErrs := ValidateRolloutStrategyCanary(validRo, field.NewPath(""))
+		assert.Empty(t, allErrs)
+	})
+
+	t.Run("using stickiness with SMI", func(t *testing.T) {
+		invalidRo := ro.DeepCopy()
+		invalidRo.Spec.Strategy.Canary.CanaryService = "canary"
+		invalidRo.Spec.Strategy.Canary.StableService = "stable"
+		invalidRo.Spec.Strategy.Canary.TrafficRouting.Istio = nil
+		invalidRo.Spec.Strategy.Canary.TrafficRouting.SMI = &v1alpha1.SMITrafficRouting{}
+		allErrs := ValidateRolloutStrategyCanary(invalidRo, field.NewPath(""))
+		assert.Len(t, allErrs, 1)
+		assert.Equal(t, InvalidStickinessTrafficPolicy, allErrs[0].Detail)
+	})
+}
+
 func TestValidateRolloutStrategyCanarySetMirrorRouteIstio(t *testing.T) {
 	ro := &v1alpha1.Rollout{}
 	ro.Spec.Strategy.Canary = &v1alpha1.CanaryStrategy{
@@ -898,6 +1281,57 @@ func TestValidateRolloutStrategyCanarySetMirrorRouteIstio(t *testing.T) {
 	})
 }
 
+func TestValidateRolloutStrategyCanarySetMirrorRouteIngressRouters(t *testing.T) {
+	newRollout := func(trafficRouting v1alpha1.RolloutTrafficRouting, setMirrorRoute v1alpha1.SetMirrorRoute) *v1alpha1.Rollout {
+		trafficRouting.ManagedRoutes = []v1alpha1.MangedRoutes{{Name: "mirror"}}
+		setMirrorRoute.Name = "mirror"
+		ro := &v1alpha1.Rollout{}
+		ro.Spec.Strategy.Canary = &v1alpha1.CanaryStrategy{
+			CanaryService:  "canary",
+			StableService:  "stable",
+			TrafficRouting: &trafficRouting,
+			Steps:          []v1alpha1.CanaryStep{{SetMirrorRoute: &setMirrorRoute}},
+		}
+		return ro
+	}
+	nginx := v1alpha1.RolloutTrafficRouting{Nginx: &v1alpha1.NginxTrafficRouting{StableIngress: "stable-ingress"}}
+	traefik := v1alpha1.RolloutTrafficRouting{Traefik: &v1alpha1.TraefikTrafficRouting{
+		WeightedTraefikServiceName: "weighted",
+		MirrorTraefikServiceName:   "mirror",
+	}}
+	apisix := v1alpha1.RolloutTrafficRouting{Apisix: &v1alpha1.ApisixTrafficRouting{Route: &v1alpha1.ApisixRoute{Name: "route"}}}
+	getMatch := []v1alpha1.RouteMatch{{Method: &v1alpha1.StringMatch{Exact: "GET"}}}
+
+	tests := []struct {
+		name           string
+		trafficRouting v1alpha1.RolloutTrafficRouting
+		setMirrorRoute v1alpha1.SetMirrorRoute
+		expectedError  string
+	}{
+		{"nginx mirroring all requests", nginx, v1alpha1.SetMirrorRoute{Percentage: ptr.To[int32](100)}, ""},
+		{"nginx removing mirror route", nginx, v1alpha1.SetMirrorRoute{}, ""},
+		{"nginx with match", nginx, v1alpha1.SetMirrorRoute{Match: getMatch}, InvalidSetMirrorRouteNginxPolicy},
+		{"nginx with percentage", nginx, v1alpha1.SetMirrorRoute{Percentage: ptr.To[int32](50)}, InvalidSetMirrorRouteNginxPolicy},
+		{"traefik with percentage", traefik, v1alpha1.SetMirrorRoute{Percentage: ptr.To[int32](50)}, ""},
+		{"traefik with match", traefik, v1alpha1.SetMirrorRoute{Match: getMatch}, InvalidSetMirrorRouteTraefikMatchPolicy},
+		{"traefik without mirroring service", v1alpha1.RolloutTrafficRouting{Traefik: &v1alpha1.TraefikTrafficRouting{WeightedTraefikServiceName: "weighted"}}, v1alpha1.SetMirrorRoute{Percentage: ptr.To[int32](50)}, MissingSetMirrorRouteTraefikServiceMessage},
+		{"apisix with match", apisix, v1alpha1.SetMirrorRoute{Match: getMatch, Percentage: ptr.To[int32](50)}, ""},
+		{"apisix with multiple matches", apisix, v1alpha1.SetMirrorRoute{Match: append(getMatch, getMatch...)}, InvalidSetMirrorRouteApisixMatchPolicy},
+		{"apisix with regex method", apisix, v1alpha1.SetMirrorRoute{Match: []v1alpha1.RouteMatch{{Method: &v1alpha1.StringMatch{Regex: "GET|POST"}}}}, InvalidSetMirrorRouteApisixMatchPolicy},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			allErrs := ValidateRolloutStrategyCanary(newRollout(test.trafficRouting, test.setMirrorRoute), field.NewPath(""))
+			if test.expectedError == "" {
+				assert.Empty(t, allErrs)
+				return
+			}
+			assert.Len(t, allErrs, 1)
+			assert.Equal(t, test.expectedError, allErrs[0].Detail)
+		})
+	}
+}
+
 func TestInvalidMaxSurgeMaxUnavailable(t *testing.T) {
 	r := func(maxSurge, maxUnavailable intstr.IntOrString) *v1alpha1.Rollout {
 		return &v1alpha1.Rollout{
@@ -1103,6 +1537,17 @@ func TestCanaryExperimentStepWithWeight(t *testing.T) {
 		assert.Equal(t, errTrafficRoutingWithExperimentSupport, allErrs[0].Detail)
 	})
 
+	t.Run("supported - Traefik TrafficRouting", func(t *testing.T) {
+		validRo := ro.DeepCopy()
+		validRo.Spec.Strategy.Canary.TrafficRouting = &v1alpha1.RolloutTrafficRouting{
+			Traefik: &v1alpha1.TraefikTrafficRouting{
+				WeightedTraefikServiceName: "traefik-service",
+			},
+		}
+		allErrs := ValidateRolloutStrategyCanary(validRo, field.NewPath(""))
+		assert.Empty(t, allErrs)
+	})
+
 	t.Run("unsupported - Nginx TrafficRouting", func(t *testing.T) {
 		invalidRo := ro.DeepCopy()
 		invalidRo.Spec.Strategy.Canary.TrafficRouting = &v1alpha1.RolloutTrafficRouting{
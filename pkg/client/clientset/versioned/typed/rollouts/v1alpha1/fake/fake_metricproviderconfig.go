@@ -0,0 +1,52 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package fake
+
+import (
+	v1alpha1 "github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
+	rolloutsv1alpha1 "github.com/argoproj/argo-rollouts/pkg/client/clientset/versioned/typed/rollouts/v1alpha1"
+	gentype "k8s.io/client-go/gentype"
+)
+
+// fakeMetricProviderConfigs implements MetricProviderConfigInterface
+type fakeMetricProviderConfigs struct {
+	*gentype.FakeClientWithList[*v1alpha1.MetricProviderConfig, *v1alpha1.MetricProviderConfigList]
+	Fake *FakeArgoprojV1alpha1
+}
+
+func newFakeMetricProviderConfigs(fake *FakeArgoprojV1alpha1, namespace string) rolloutsv1alpha1.MetricProviderConfigInterface {
+	return &fakeMetricProviderConfigs{
+		gentype.NewFakeClientWithList[*v1alpha1.MetricProviderConfig, *v1alpha1.MetricProviderConfigList](
+			fake.Fake,
+			namespace,
+			v1alpha1.SchemeGroupVersion.WithResource("metricproviderconfigs"),
+			v1alpha1.SchemeGroupVersion.WithKind("MetricProviderConfig"),
+			func() *v1alpha1.MetricProviderConfig { return &v1alpha1.MetricProviderConfig{} },
+			func() *v1alpha1.MetricProviderConfigList { return &v1alpha1.MetricProviderConfigList{} },
+			func(dst, src *v1alpha1.MetricProviderConfigList) { dst.ListMeta = src.ListMeta },
+			func(list *v1alpha1.MetricProviderConfigList) []*v1alpha1.MetricProviderConfig {
+				return gentype.ToPointerSlice(list.Items)
+			},
+			func(list *v1alpha1.MetricProviderConfigList, items []*v1alpha1.MetricProviderConfig) {
+				list.Items = gentype.FromPointerSlice(items)
+			},
+		),
+		fake,
+	}
+}
@@ -44,6 +44,10 @@ func (c *FakeArgoprojV1alpha1) Experiments(namespace string) v1alpha1.Experiment
 	return newFakeExperiments(c, namespace)
 }
 
+func (c *FakeArgoprojV1alpha1) MetricProviderConfigs(namespace string) v1alpha1.MetricProviderConfigInterface {
+	return newFakeMetricProviderConfigs(c, namespace)
+}
+
 func (c *FakeArgoprojV1alpha1) Rollouts(namespace string) v1alpha1.RolloutInterface {
 	return newFakeRollouts(c, namespace)
 }
@@ -32,6 +32,7 @@ type ArgoprojV1alpha1Interface interface {
 	AnalysisTemplatesGetter
 	ClusterAnalysisTemplatesGetter
 	ExperimentsGetter
+	MetricProviderConfigsGetter
 	RolloutsGetter
 }
 
@@ -56,6 +57,10 @@ func (c *ArgoprojV1alpha1Client) Experiments(namespace string) ExperimentInterfa
 	return newExperiments(c, namespace)
 }
 
+func (c *ArgoprojV1alpha1Client) MetricProviderConfigs(namespace string) MetricProviderConfigInterface {
+	return newMetricProviderConfigs(c, namespace)
+}
+
 func (c *ArgoprojV1alpha1Client) Rollouts(namespace string) RolloutInterface {
 	return newRollouts(c, namespace)
 }
@@ -32,6 +32,8 @@ type Interface interface {
 	ClusterAnalysisTemplates() ClusterAnalysisTemplateInformer
 	// Experiments returns a ExperimentInformer.
 	Experiments() ExperimentInformer
+	// MetricProviderConfigs returns a MetricProviderConfigInformer.
+	MetricProviderConfigs() MetricProviderConfigInformer
 	// Rollouts returns a RolloutInformer.
 	Rollouts() RolloutInformer
 }
@@ -67,6 +69,11 @@ func (v *version) Experiments() ExperimentInformer {
 	return &experimentInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
 }
 
+// MetricProviderConfigs returns a MetricProviderConfigInformer.
+func (v *version) MetricProviderConfigs() MetricProviderConfigInformer {
+	return &metricProviderConfigInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
+
 // Rollouts returns a RolloutInformer.
 func (v *version) Rollouts() RolloutInformer {
 	return &rolloutInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
@@ -46,6 +46,14 @@ type ExperimentListerExpansion interface{}
 // ExperimentNamespaceLister.
 type ExperimentNamespaceListerExpansion interface{}
 
+// MetricProviderConfigListerExpansion allows custom methods to be added to
+// MetricProviderConfigLister.
+type MetricProviderConfigListerExpansion interface{}
+
+// MetricProviderConfigNamespaceListerExpansion allows custom methods to be added to
+// MetricProviderConfigNamespaceLister.
+type MetricProviderConfigNamespaceListerExpansion interface{}
+
 // RolloutListerExpansion allows custom methods to be added to
 // RolloutLister.
 type RolloutListerExpansion interface{}
@@ -0,0 +1,70 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by lister-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	rolloutsv1alpha1 "github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
+	labels "k8s.io/apimachinery/pkg/labels"
+	listers "k8s.io/client-go/listers"
+	cache "k8s.io/client-go/tools/cache"
+)
+
+// MetricProviderConfigLister helps list MetricProviderConfigs.
+// All objects returned here must be treated as read-only.
+type MetricProviderConfigLister interface {
+	// List lists all MetricProviderConfigs in the indexer.
+	// Objects returned here must be treated as read-only.
+	List(selector labels.Selector) (ret []*rolloutsv1alpha1.MetricProviderConfig, err error)
+	// MetricProviderConfigs returns an object that can list and get MetricProviderConfigs.
+	MetricProviderConfigs(namespace string) MetricProviderConfigNamespaceLister
+	MetricProviderConfigListerExpansion
+}
+
+// metricProviderConfigLister implements the MetricProviderConfigLister interface.
+type metricProviderConfigLister struct {
+	listers.ResourceIndexer[*rolloutsv1alpha1.MetricProviderConfig]
+}
+
+// NewMetricProviderConfigLister returns a new MetricProviderConfigLister.
+func NewMetricProviderConfigLister(indexer cache.Indexer) MetricProviderConfigLister {
+	return &metricProviderConfigLister{listers.New[*rolloutsv1alpha1.MetricProviderConfig](indexer, rolloutsv1alpha1.Resource("metricproviderconfig"))}
+}
+
+// MetricProviderConfigs returns an object that can list and get MetricProviderConfigs.
+func (s *metricProviderConfigLister) MetricProviderConfigs(namespace string) MetricProviderConfigNamespaceLister {
+	return metricProviderConfigNamespaceLister{listers.NewNamespaced[*rolloutsv1alpha1.MetricProviderConfig](s.ResourceIndexer, namespace)}
+}
+
+// MetricProviderConfigNamespaceLister helps list and get MetricProviderConfigs.
+// All objects returned here must be treated as read-only.
+type MetricProviderConfigNamespaceLister interface {
+	// List lists all MetricProviderConfigs in the indexer for a given namespace.
+	// Objects returned here must be treated as read-only.
+	List(selector labels.Selector) (ret []*rolloutsv1alpha1.MetricProviderConfig, err error)
+	// Get retrieves the MetricProviderConfig from the indexer for a given namespace and name.
+	// Objects returned here must be treated as read-only.
+	Get(name string) (*rolloutsv1alpha1.MetricProviderConfig, error)
+	MetricProviderConfigNamespaceListerExpansion
+}
+
+// metricProviderConfigNamespaceLister implements the MetricProviderConfigNamespaceLister
+// interface.
+type metricProviderConfigNamespaceLister struct {
+	listers.ResourceIndexer[*rolloutsv1alpha1.MetricProviderConfig]
+}
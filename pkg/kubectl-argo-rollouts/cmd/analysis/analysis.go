@@ -0,0 +1,29 @@
+package analysis
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/argoproj/argo-rollouts/pkg/kubectl-argo-rollouts/options"
+)
+
+const (
+	analysisExample = `
+	# Run the metrics of an AnalysisTemplate locally
+	%[1]s analysis run --from-template success-rate -a service-name=guestbook-canary`
+)
+
+// NewCmdAnalysis returns a new instance of an `rollouts analysis` command
+func NewCmdAnalysis(o *options.ArgoRolloutsOptions) *cobra.Command {
+	var cmd = &cobra.Command{
+		Use:          "analysis <run>",
+		Short:        "Debug analysis templates",
+		Long:         "This command consists of multiple subcommands which can be used to debug AnalysisTemplates and ClusterAnalysisTemplates without a rollout.",
+		Example:      o.Example(analysisExample),
+		SilenceUsage: true,
+		RunE: func(c *cobra.Command, args []string) error {
+			return o.UsageErr(c)
+		},
+	}
+	cmd.AddCommand(NewCmdAnalysisRun(o))
+	return cmd
+}
@@ -0,0 +1,480 @@
+package analysis
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/yaml"
+
+	"github.com/argoproj/argo-rollouts/metric"
+	"github.com/argoproj/argo-rollouts/metricproviders"
+	"github.com/argoproj/argo-rollouts/metricproviders/job"
+	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts"
+	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
+	listers "github.com/argoproj/argo-rollouts/pkg/client/listers/rollouts/v1alpha1"
+	"github.com/argoproj/argo-rollouts/pkg/kubectl-argo-rollouts/cmd/create"
+	"github.com/argoproj/argo-rollouts/pkg/kubectl-argo-rollouts/options"
+	analysisutil "github.com/argoproj/argo-rollouts/utils/analysis"
+	timeutil "github.com/argoproj/argo-rollouts/utils/time"
+)
+
+const (
+	analysisRunExample = `
+	# Run the metrics of an AnalysisTemplate in the cluster
+	%[1]s analysis run --from-template success-rate -a service-name=guestbook-canary
+
+	# Run the metrics of a ClusterAnalysisTemplate in the cluster
+	%[1]s analysis run --global --from-template success-rate -a service-name=guestbook-canary
+
+	# Run the metrics of a local AnalysisTemplate file
+	%[1]s analysis run --from-file success-rate.yaml -a service-name=guestbook-canary
+
+	# Replay the metrics over a past time window, and take 3 measurements of each metric
+	%[1]s analysis run --from-template success-rate --start 2024-05-01T10:00:00Z --end 2024-05-01T11:00:00Z --count 3`
+
+	analysisRunUsage = `This command instantiates an AnalysisTemplate or ClusterAnalysisTemplate with the given arguments and measures
+its metrics locally, using the same metric providers as the controller. No AnalysisRun is created in the cluster.
+
+Each metric is measured once, or --count times with the interval of the metric between measurements. Metrics are
+measured one after the other, each after the metrics it depends on. A metric depending on a metric which did not
+succeed is not measured and is Inconclusive. With --start,
+Prometheus metrics are replayed as range queries over the window, other providers are measured at the current time.
+The command fails unless the resulting phase is Successful.`
+
+	// DefaultMeasurementTimeout bounds the time waiting for an asynchronous measurement to complete
+	DefaultMeasurementTimeout = 10 * time.Minute
+	// defaultWindowStep is the step of range queries replaying a window for metrics without an interval
+	defaultWindowStep = "1m"
+)
+
+type AnalysisRunOptions struct {
+	FromTemplate string
+	FromFile     string
+	Global       bool
+	ArgFlags     []string
+	Start        string
+	End          string
+	Count        int
+	Timeout      time.Duration
+
+	options.ArgoRolloutsOptions
+}
+
+// NewCmdAnalysisRun returns a new instance of an `rollouts analysis run` command
+func NewCmdAnalysisRun(o *options.ArgoRolloutsOptions) *cobra.Command {
+	runOptions := AnalysisRunOptions{
+		ArgoRolloutsOptions: *o,
+	}
+	var cmd = &cobra.Command{
+		Use:          "run",
+		Short:        "Run the metrics of an AnalysisTemplate or ClusterAnalysisTemplate locally",
+		Long:         analysisRunUsage,
+		Example:      o.Example(analysisRunExample),
+		SilenceUsage: true,
+		RunE: func(c *cobra.Command, args []string) error {
+			if (runOptions.FromTemplate == "") == (runOptions.FromFile == "") {
+				return errors.New("one of --from-template or --from-file must be specified")
+			}
+			if runOptions.Count < 1 {
+				return errors.New("--count must be at least 1")
+			}
+			run, err := runOptions.newAnalysisRun()
+			if err != nil {
+				return err
+			}
+			window, err := runOptions.parseWindow()
+			if err != nil {
+				return err
+			}
+			if err := analysisutil.ValidateMetricTemplates(run.Spec.Metrics); err != nil {
+				return err
+			}
+			metrics, err := runOptions.resolveMetrics(run)
+			if err != nil {
+				return err
+			}
+			if err := analysisutil.ValidateMetrics(metrics); err != nil {
+				return err
+			}
+			dryRunMetrics, err := analysisutil.GetDryRunMetrics(run.Spec.DryRun, metrics)
+			if err != nil {
+				return err
+			}
+
+			configLister, err := runOptions.newMetricProviderConfigLister(run.Namespace, metrics)
+			if err != nil {
+				return err
+			}
+			factory := &metricproviders.ProviderFactory{
+				KubeClient:                 runOptions.KubeClientset(),
+				MetricProviderConfigLister: configLister,
+			}
+			for _, metric := range orderMetrics(metrics) {
+				if failed := analysisutil.FailedDependencies(run, metric); len(failed) > 0 {
+					// the controller never starts a metric which depends on a metric which did not succeed
+					analysisutil.SetResult(run, v1alpha1.MetricResult{
+						Name:    metric.Name,
+						Phase:   v1alpha1.AnalysisPhaseInconclusive,
+						DryRun:  dryRunMetrics[metric.Name],
+						Message: fmt.Sprintf("Metric(s) it depends on did not succeed: %s", strings.Join(failed, ", ")),
+					})
+					continue
+				}
+				if window != nil {
+					metric = runOptions.applyWindow(metric, window)
+				}
+				result := runOptions.measure(factory, run, metric, dryRunMetrics[metric.Name])
+				analysisutil.SetResult(run, result)
+			}
+			run.Status.Phase, run.Status.Message = assessRunPhase(run, metrics, dryRunMetrics)
+			printAnalysisRun(runOptions.Out, run)
+			if run.Status.Phase != v1alpha1.AnalysisPhaseSuccessful {
+				return fmt.Errorf("analysis phase is %s", run.Status.Phase)
+			}
+			return nil
+		},
+	}
+	cmd.Flags().StringVar(&runOptions.FromTemplate, "from-template", "", "Run the metrics of an AnalysisTemplate or ClusterAnalysisTemplate in the cluster")
+	cmd.Flags().StringVar(&runOptions.FromFile, "from-file", "", "Run the metrics of an AnalysisTemplate or ClusterAnalysisTemplate in a local file")
+	cmd.Flags().BoolVar(&runOptions.Global, "global", false, "Use a ClusterAnalysisTemplate instead of a AnalysisTemplate")
+	cmd.Flags().StringArrayVarP(&runOptions.ArgFlags, "argument", "a", []string{}, "Arguments to the parameter template")
+	cmd.Flags().StringVar(&runOptions.Start, "start", "", "Start of a past time window to replay, in RFC 3339 format")
+	cmd.Flags().StringVar(&runOptions.End, "end", "", "End of the time window to replay, in RFC 3339 format (defaults to now)")
+	cmd.Flags().IntVar(&runOptions.Count, "count", 1, "Number of measurements of each metric")
+	cmd.Flags().DurationVar(&runOptions.Timeout, "timeout", DefaultMeasurementTimeout, "Time to wait for an asynchronous measurement to complete")
+	return cmd
+}
+
+// newAnalysisRun instantiates the template with the arguments of the command. The run is never created.
+func (o *AnalysisRunOptions) newAnalysisRun() (*v1alpha1.AnalysisRun, error) {
+	ctx := context.TODO()
+	args, err := (&create.CreateAnalysisRunOptions{ArgFlags: o.ArgFlags}).ParseArgFlags()
+	if err != nil {
+		return nil, err
+	}
+	ns := o.Namespace()
+	var templates []*v1alpha1.AnalysisTemplate
+	var clusterTemplates []*v1alpha1.ClusterAnalysisTemplate
+	switch {
+	case o.FromFile != "":
+		fileBytes, err := os.ReadFile(o.FromFile)
+		if err != nil {
+			return nil, err
+		}
+		var template v1alpha1.AnalysisTemplate
+		if err := yaml.Unmarshal(fileBytes, &template); err != nil {
+			return nil, err
+		}
+		if template.Kind != rollouts.AnalysisTemplateKind && template.Kind != rollouts.ClusterAnalysisTemplateKind {
+			return nil, fmt.Errorf("%s is not an AnalysisTemplate or ClusterAnalysisTemplate", o.FromFile)
+		}
+		templates = append(templates, &template)
+	case o.Global:
+		template, err := o.RolloutsClientset().ArgoprojV1alpha1().ClusterAnalysisTemplates().Get(ctx, o.FromTemplate, metav1.GetOptions{})
+		if err != nil {
+			return nil, err
+		}
+		clusterTemplates = append(clusterTemplates, template)
+	default:
+		template, err := o.RolloutsClientset().ArgoprojV1alpha1().AnalysisTemplates(ns).Get(ctx, o.FromTemplate, metav1.GetOptions{})
+		if err != nil {
+			return nil, err
+		}
+		templates = append(templates, template)
+	}
+
+	run, err := analysisutil.NewAnalysisRunFromTemplates(templates, clusterTemplates, args, nil, nil, nil, nil, "", "", ns)
+	if err != nil {
+		return nil, err
+	}
+	if len(templates) > 0 {
+		run.Name = templates[0].Name
+	} else {
+		run.Name = clusterTemplates[0].Name
+	}
+	now := timeutil.MetaNow()
+	run.Status.StartedAt = &now
+	return run, nil
+}
+
+// newMetricProviderConfigLister returns a lister of the MetricProviderConfigs referenced by the metrics. The
+// configs are fetched once, as the command does not run informers.
+func (o *AnalysisRunOptions) newMetricProviderConfigLister(namespace string, metrics []v1alpha1.Metric) (listers.MetricProviderConfigLister, error) {
+	indexer := cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc})
+	for _, metric := range metrics {
+		ref := metric.Provider.ConfigRef
+		if ref == nil {
+			continue
+		}
+		if _, exists, _ := indexer.GetByKey(namespace + "/" + ref.Name); exists {
+			continue
+		}
+		config, err := o.RolloutsClientset().ArgoprojV1alpha1().MetricProviderConfigs(namespace).Get(context.TODO(), ref.Name, metav1.GetOptions{})
+		if k8serrors.IsNotFound(err) {
+			// reported as an error of the measurements of the metric
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		if err := indexer.Add(config); err != nil {
+			return nil, err
+		}
+	}
+	return listers.NewMetricProviderConfigLister(indexer), nil
+}
+
+// orderMetrics orders the metrics so that every metric comes after the metrics it depends on, keeping the order
+// of the spec otherwise. The dependencies must have been validated not to form a cycle.
+func orderMetrics(metrics []v1alpha1.Metric) []v1alpha1.Metric {
+	ordered := make([]v1alpha1.Metric, 0, len(metrics))
+	added := make(map[string]bool)
+	for len(ordered) < len(metrics) {
+		for _, metric := range metrics {
+			if added[metric.Name] {
+				continue
+			}
+			ready := true
+			for _, dep := range metric.DependsOn {
+				ready = ready && added[dep]
+			}
+			if ready {
+				ordered = append(ordered, metric)
+				added[metric.Name] = true
+			}
+		}
+	}
+	return ordered
+}
+
+// resolveMetrics resolves the arguments of the run, including secret and config map references, in its metrics
+func (o *AnalysisRunOptions) resolveMetrics(run *v1alpha1.AnalysisRun) ([]v1alpha1.Metric, error) {
+	args := make([]v1alpha1.Argument, len(run.Spec.Args))
+	for i, arg := range run.Spec.Args {
+		args[i] = arg
+		if arg.ValueFrom == nil {
+			continue
+		}
+		if arg.ValueFrom.ConfigMapKeyRef != nil {
+			value, err := analysisutil.GetConfigMapKeyRefValue(o.KubeClientset(), run.Namespace, arg.ValueFrom.ConfigMapKeyRef)
+			if err != nil {
+				return nil, err
+			}
+			args[i].Value = ptr.To(value)
+			args[i].ValueFrom = nil
+			continue
+		}
+		if arg.ValueFrom.SecretKeyRef == nil {
+			return nil, fmt.Errorf("args.%s is resolved from a rollout, use --argument to give it a value", arg.Name)
+		}
+		secret, err := o.KubeClientset().CoreV1().Secrets(run.Namespace).Get(context.TODO(), arg.ValueFrom.SecretKeyRef.Name, metav1.GetOptions{})
+		if err != nil {
+			return nil, err
+		}
+		value, ok := secret.Data[arg.ValueFrom.SecretKeyRef.Key]
+		if !ok {
+			return nil, fmt.Errorf("key '%s' does not exist in secret '%s'", arg.ValueFrom.SecretKeyRef.Key, arg.ValueFrom.SecretKeyRef.Name)
+		}
+		args[i].Value = ptr.To(string(value))
+		args[i].ValueFrom = nil
+	}
+
+	metrics := make([]v1alpha1.Metric, len(run.Spec.Metrics))
+	for i, metric := range run.Spec.Metrics {
+		if metric.Provider.Job != nil {
+			return nil, fmt.Errorf("metric %s: %s metrics cannot be run locally", metric.Name, job.ProviderType)
+		}
+		resolvedMetric, err := analysisutil.ResolveMetricArgs(metric, args)
+		if err != nil {
+			return nil, err
+		}
+		metrics[i] = *resolvedMetric
+	}
+	return metrics, nil
+}
+
+// parseWindow returns the time window to replay, or nil if no window was given
+func (o *AnalysisRunOptions) parseWindow() (*[2]time.Time, error) {
+	if o.Start == "" {
+		if o.End != "" {
+			return nil, errors.New("--end can only be used with --start")
+		}
+		return nil, nil
+	}
+	start, err := time.Parse(time.RFC3339, o.Start)
+	if err != nil {
+		return nil, fmt.Errorf("invalid --start: %v", err)
+	}
+	end := o.Now().Time
+	if o.End != "" {
+		end, err = time.Parse(time.RFC3339, o.End)
+		if err != nil {
+			return nil, fmt.Errorf("invalid --end: %v", err)
+		}
+	}
+	if !start.Before(end) {
+		return nil, errors.New("--start must be before --end")
+	}
+	return &[2]time.Time{start, end}, nil
+}
+
+// applyWindow replays Prometheus metrics as range queries over the window. Other providers cannot query the
+// past, so their metrics are measured at the current time.
+func (o *AnalysisRunOptions) applyWindow(metric v1alpha1.Metric, window *[2]time.Time) v1alpha1.Metric {
+	if metric.Provider.Prometheus == nil {
+		o.Log.Warnf("Metric %s cannot be replayed over a time window, it is measured at the current time", metric.Name)
+		return metric
+	}
+	metric = *metric.DeepCopy()
+	step := v1alpha1.DurationString(defaultWindowStep)
+	if metric.Provider.Prometheus.RangeQuery != nil && metric.Provider.Prometheus.RangeQuery.Step != "" {
+		step = metric.Provider.Prometheus.RangeQuery.Step
+	} else if metric.Interval != "" {
+		step = metric.Interval
+	}
+	metric.Provider.Prometheus.RangeQuery = &v1alpha1.PrometheusRangeQueryArgs{
+		Start: fmt.Sprintf("date(%q)", window[0].Format(time.RFC3339)),
+		End:   fmt.Sprintf("date(%q)", window[1].Format(time.RFC3339)),
+		Step:  step,
+	}
+	return metric
+}
+
+// measure takes the measurements of a metric, waiting for the interval of the metric between them, and
+// assesses the result the same way the controller does once a metric completes
+func (o *AnalysisRunOptions) measure(factory *metricproviders.ProviderFactory, run *v1alpha1.AnalysisRun, metric v1alpha1.Metric, dryRun bool) v1alpha1.MetricResult {
+	logCtx := log.NewEntry(o.Log).WithField("metric", metric.Name)
+	result := v1alpha1.MetricResult{
+		Name:   metric.Name,
+		Phase:  v1alpha1.AnalysisPhaseRunning,
+		DryRun: dryRun,
+	}
+	provider, err := factory.NewProvider(*logCtx, run.Namespace, metric)
+	if err == nil {
+		result.Metadata = provider.GetMetadata(metric)
+	}
+	for i := 0; i < o.Count; i++ {
+		if i > 0 && metric.Interval != "" {
+			if interval, err := metric.Interval.Duration(); err == nil {
+				time.Sleep(interval)
+			}
+		}
+		var measurement v1alpha1.Measurement
+		if err != nil {
+			startedAt := timeutil.MetaNow()
+			measurement = v1alpha1.Measurement{
+				Phase:      v1alpha1.AnalysisPhaseError,
+				Message:    err.Error(),
+				StartedAt:  &startedAt,
+				FinishedAt: &startedAt,
+			}
+		} else {
+			measurement = o.takeMeasurement(provider, run, metric)
+		}
+		logCtx.Infof("Measurement Completed. Result: %s", measurement.Phase)
+		analysisutil.CountMeasurement(&result, measurement.Phase)
+		result.Measurements = append(result.Measurements, measurement)
+		// the result is set after each measurement so that conditions can use the previous measurements
+		analysisutil.SetResult(run, result)
+	}
+	result.Phase = analysisutil.AssessCompletedMetric(metric, result)
+	if result.Phase == v1alpha1.AnalysisPhaseSuccessful && result.Count == 0 {
+		// the controller would retry the errors until the consecutive error limit, but no measurement is left
+		result.Phase = v1alpha1.AnalysisPhaseError
+	}
+	return result
+}
+
+// takeMeasurement runs a measurement and resumes it until it completes, terminating it after the timeout
+func (o *AnalysisRunOptions) takeMeasurement(provider metric.Provider, run *v1alpha1.AnalysisRun, metric v1alpha1.Metric) v1alpha1.Measurement {
+	measurement := provider.Run(run, metric)
+	deadline := time.Now().Add(o.Timeout)
+	for !measurement.Phase.Completed() {
+		wait := time.Second
+		if measurement.ResumeAt != nil {
+			wait = time.Until(measurement.ResumeAt.Time)
+		}
+		if time.Now().Add(wait).After(deadline) {
+			measurement = provider.Terminate(run, metric, measurement)
+			if !measurement.Phase.Completed() {
+				measurement.Phase = v1alpha1.AnalysisPhaseError
+			}
+			measurement.Message = fmt.Sprintf("measurement did not complete within %v", o.Timeout)
+			break
+		}
+		time.Sleep(wait)
+		measurement = provider.Resume(run, metric, measurement)
+	}
+	if measurement.FinishedAt == nil {
+		finishedAt := timeutil.MetaNow()
+		measurement.FinishedAt = &finishedAt
+	}
+	return measurement
+}
+
+// assessRunPhase returns the worst phase of the metrics which are not dry-run, or the phase of the score of the
+// run when it defines scoring and none of its metrics errored
+func assessRunPhase(run *v1alpha1.AnalysisRun, metrics []v1alpha1.Metric, dryRunMetrics map[string]bool) (v1alpha1.AnalysisPhase, string) {
+	phase := v1alpha1.AnalysisPhaseSuccessful
+	errored := false
+	for _, result := range run.Status.MetricResults {
+		if !result.DryRun {
+			phase = analysisutil.Worst(phase, result.Phase)
+			errored = errored || result.Phase == v1alpha1.AnalysisPhaseError
+		}
+	}
+	if run.Spec.Scoring == nil {
+		return phase, ""
+	}
+	run.Status.Score = analysisutil.ScoreRun(run, metrics, dryRunMetrics)
+	// errors cannot be scored, they still decide the phase of the run
+	if errored {
+		return v1alpha1.AnalysisPhaseError, ""
+	}
+	if run.Status.Score == nil {
+		return phase, ""
+	}
+	return analysisutil.AssessScore(*run.Spec.Scoring, *run.Status.Score)
+}
+
+func printAnalysisRun(out io.Writer, run *v1alpha1.AnalysisRun) {
+	w := tabwriter.NewWriter(out, 0, 0, 2, ' ', 0)
+	fmt.Fprintf(w, "METRIC\t#\tSTARTED\tPHASE\tVALUE\tMESSAGE\n")
+	for _, result := range run.Status.MetricResults {
+		for i, measurement := range result.Measurements {
+			var started string
+			if measurement.StartedAt != nil {
+				started = measurement.StartedAt.Format(time.RFC3339)
+			}
+			fmt.Fprintf(w, "%s\t%d\t%s\t%s\t%s\t%s\n", result.Name, i+1, started, measurement.Phase, measurement.Value, measurement.Message)
+		}
+	}
+	fmt.Fprintf(w, "\nMETRIC\tPHASE\n")
+	for _, result := range run.Status.MetricResults {
+		phase := string(result.Phase)
+		if result.DryRun {
+			phase += " (dry-run)"
+		}
+		fmt.Fprintf(w, "%s\t%s\n", result.Name, phase)
+	}
+	w.Flush()
+	if run.Status.Score != nil {
+		fmt.Fprintf(out, "\nScore: %d (pass: %d, marginal: %d)", *run.Status.Score, run.Spec.Scoring.Threshold.Pass, run.Spec.Scoring.Threshold.Marginal)
+	}
+	fmt.Fprintf(out, "\nPhase: %s\n", run.Status.Phase)
+	if run.Status.Message != "" {
+		fmt.Fprintf(out, "Message: %s\n", run.Status.Message)
+	}
+}
@@ -0,0 +1,339 @@
+package analysis
+
+import (
+	"bytes"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/utils/ptr"
+
+	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
+	options "github.com/argoproj/argo-rollouts/pkg/kubectl-argo-rollouts/options/fake"
+)
+
+func newWebTemplate(url string) *v1alpha1.AnalysisTemplate {
+	return &v1alpha1.AnalysisTemplate{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "success-rate",
+			Namespace: metav1.NamespaceDefault,
+		},
+		Spec: v1alpha1.AnalysisTemplateSpec{
+			Args: []v1alpha1.Argument{
+				{Name: "service-name"},
+				{Name: "min-rate", Value: ptr.To("0.95")},
+			},
+			Metrics: []v1alpha1.Metric{
+				{
+					Name:             "success-rate",
+					SuccessCondition: "result.rate >= {{args.min-rate}}",
+					Provider: v1alpha1.MetricProvider{
+						Web: &v1alpha1.WebMetric{
+							URL:      url + "/{{args.service-name}}",
+							JSONPath: "{$}",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func newWebServer() *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		switch req.URL.Path {
+		case "/guestbook-canary":
+			io.WriteString(rw, `{"rate": 0.99}`)
+		case "/guestbook-stable":
+			io.WriteString(rw, `{"rate": 0.90}`)
+		default:
+			rw.WriteHeader(http.StatusNotFound)
+		}
+	}))
+}
+
+func TestAnalysisRunFromTemplate(t *testing.T) {
+	server := newWebServer()
+	defer server.Close()
+	tf, o := options.NewFakeArgoRolloutsOptions(newWebTemplate(server.URL))
+	defer tf.Cleanup()
+
+	cmd := NewCmdAnalysisRun(o)
+	cmd.PersistentPreRunE = o.PersistentPreRunE
+	cmd.SetArgs([]string{"--from-template", "success-rate", "-a", "service-name=guestbook-canary", "--count", "2"})
+	err := cmd.Execute()
+	assert.NoError(t, err)
+	stdout := o.Out.(*bytes.Buffer).String()
+	assert.Contains(t, stdout, "METRIC        #  STARTED")
+	assert.Regexp(t, `success-rate  1  \S+  Successful  {"rate":0.99}`, stdout)
+	assert.Regexp(t, `success-rate  2  \S+  Successful  {"rate":0.99}`, stdout)
+	assert.Contains(t, stdout, "METRIC        PHASE\nsuccess-rate  Successful\n")
+	assert.Contains(t, stdout, "Phase: Successful\n")
+}
+
+func TestAnalysisRunFailed(t *testing.T) {
+	server := newWebServer()
+	defer server.Close()
+	template := newWebTemplate(server.URL)
+	template.Spec.Metrics = append(template.Spec.Metrics, v1alpha1.Metric{
+		Name:             "canary-up",
+		SuccessCondition: "result.rate > 0",
+		Provider: v1alpha1.MetricProvider{
+			Web: &v1alpha1.WebMetric{URL: server.URL + "/missing", JSONPath: "{$}"},
+		},
+	})
+	template.Spec.DryRun = []v1alpha1.DryRun{{MetricName: "canary-up"}}
+	tf, o := options.NewFakeArgoRolloutsOptions(template)
+	defer tf.Cleanup()
+
+	cmd := NewCmdAnalysisRun(o)
+	cmd.PersistentPreRunE = o.PersistentPreRunE
+	cmd.SetArgs([]string{"--from-template", "success-rate", "-a", "service-name=guestbook-stable"})
+	err := cmd.Execute()
+	assert.EqualError(t, err, "analysis phase is Failed")
+	stdout := o.Out.(*bytes.Buffer).String()
+	assert.Contains(t, stdout, "success-rate  Failed\n")
+	// the error of the dry-run metric does not change the phase of the analysis
+	assert.Contains(t, stdout, "canary-up     Error (dry-run)\n")
+	assert.Contains(t, stdout, "Phase: Failed\n")
+}
+
+func TestAnalysisRunFromFile(t *testing.T) {
+	server := newWebServer()
+	defer server.Close()
+	path := filepath.Join(t.TempDir(), "template.yaml")
+	assert.NoError(t, os.WriteFile(path, []byte(`
+apiVersion: argoproj.io/v1alpha1
+kind: ClusterAnalysisTemplate
+metadata:
+  name: success-rate
+spec:
+  args:
+  - name: service-name
+  - name: token
+    valueFrom:
+      secretKeyRef:
+        name: web-token
+        key: token
+  metrics:
+  - name: success-rate
+    successCondition: result.rate >= 0.95
+    provider:
+      web:
+        url: `+server.URL+`/{{args.service-name}}
+        jsonPath: "{$}"
+        headers:
+        - key: Authorization
+          value: "Bearer {{args.token}}"
+`), 0644))
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "web-token", Namespace: metav1.NamespaceDefault},
+		Data:       map[string][]byte{"token": []byte("secret")},
+	}
+	tf, o := options.NewFakeArgoRolloutsOptions(secret)
+	defer tf.Cleanup()
+
+	cmd := NewCmdAnalysisRun(o)
+	cmd.PersistentPreRunE = o.PersistentPreRunE
+	cmd.SetArgs([]string{"--from-file", path, "-a", "service-name=guestbook-canary"})
+	assert.NoError(t, cmd.Execute())
+	assert.Contains(t, o.Out.(*bytes.Buffer).String(), "Phase: Successful\n")
+}
+
+func TestAnalysisRunWindow(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		assert.Equal(t, "/api/v1/query_range", req.URL.Path)
+		assert.NoError(t, req.ParseForm())
+		assert.Equal(t, "1714557600", req.Form.Get("start"))
+		assert.Equal(t, "1714561200", req.Form.Get("end"))
+		assert.Equal(t, "300", req.Form.Get("step"))
+		io.WriteString(rw, `{"status": "success", "data": {"resultType": "matrix", "result": [{"metric": {}, "values": [[1714557600, "0.99"], [1714557900, "0.97"]]}]}}`)
+	}))
+	defer server.Close()
+	template := &v1alpha1.ClusterAnalysisTemplate{
+		ObjectMeta: metav1.ObjectMeta{Name: "success-rate"},
+		Spec: v1alpha1.AnalysisTemplateSpec{
+			Metrics: []v1alpha1.Metric{
+				{
+					Name:             "success-rate",
+					Interval:         "5m",
+					Count:            ptr.To(intstr.FromInt32(1)),
+					SuccessCondition: "all(result, {# >= 0.95})",
+					Provider: v1alpha1.MetricProvider{
+						Prometheus: &v1alpha1.PrometheusMetric{
+							Address: server.URL,
+							Query:   "sum(rate(requests_total{code!~'5.*'}[5m])) / sum(rate(requests_total[5m]))",
+						},
+					},
+				},
+			},
+		},
+	}
+	tf, o := options.NewFakeArgoRolloutsOptions(template)
+	defer tf.Cleanup()
+
+	cmd := NewCmdAnalysisRun(o)
+	cmd.PersistentPreRunE = o.PersistentPreRunE
+	cmd.SetArgs([]string{"--global", "--from-template", "success-rate", "--start", "2024-05-01T10:00:00Z", "--end", "2024-05-01T11:00:00Z"})
+	assert.NoError(t, cmd.Execute())
+	assert.Regexp(t, `success-rate  1  \S+  Successful  \[0.99,0.97\]`, o.Out.(*bytes.Buffer).String())
+}
+
+func TestAnalysisRunErrors(t *testing.T) {
+	template := newWebTemplate("http://web")
+	template.Spec.Args = append(template.Spec.Args, v1alpha1.Argument{
+		Name:      "canary-hash",
+		ValueFrom: &v1alpha1.ValueFrom{FieldRef: &v1alpha1.FieldRef{FieldPath: "metadata.labels['rollouts-pod-template-hash']"}},
+	})
+	jobTemplate := &v1alpha1.AnalysisTemplate{
+		ObjectMeta: metav1.ObjectMeta{Name: "job", Namespace: metav1.NamespaceDefault},
+		Spec: v1alpha1.AnalysisTemplateSpec{
+			Metrics: []v1alpha1.Metric{{Name: "smoke-test", Provider: v1alpha1.MetricProvider{Job: &v1alpha1.JobMetric{}}}},
+		},
+	}
+	tests := []struct {
+		args          []string
+		expectedError string
+	}{
+		{
+			args:          []string{},
+			expectedError: "one of --from-template or --from-file must be specified",
+		},
+		{
+			args:          []string{"--from-template", "success-rate", "--count", "0"},
+			expectedError: "--count must be at least 1",
+		},
+		{
+			args:          []string{"--from-template", "success-rate"},
+			expectedError: "args.service-name was not resolved",
+		},
+		{
+			args:          []string{"--from-template", "success-rate", "-a", "service-name=guestbook"},
+			expectedError: "args.canary-hash is resolved from a rollout, use --argument to give it a value",
+		},
+		{
+			args:          []string{"--from-template", "success-rate", "-a", "service-name=guestbook", "--end", "2024-05-01T11:00:00Z"},
+			expectedError: "--end can only be used with --start",
+		},
+		{
+			args:          []string{"--from-template", "success-rate", "-a", "service-name=guestbook", "--start", "2024-05-01T11:00:00Z", "--end", "2024-05-01T10:00:00Z"},
+			expectedError: "--start must be before --end",
+		},
+		{
+			args:          []string{"--from-template", "job"},
+			expectedError: "metric smoke-test: Job metrics cannot be run locally",
+		},
+		{
+			args:          []string{"--global", "--from-template", "success-rate"},
+			expectedError: `clusteranalysistemplates.argoproj.io "success-rate" not found`,
+		},
+	}
+	for _, test := range tests {
+		tf, o := options.NewFakeArgoRolloutsOptions(template.DeepCopy(), jobTemplate.DeepCopy())
+		cmd := NewCmdAnalysisRun(o)
+		cmd.PersistentPreRunE = o.PersistentPreRunE
+		cmd.SetArgs(test.args)
+		err := cmd.Execute()
+		assert.EqualError(t, err, test.expectedError)
+		tf.Cleanup()
+	}
+}
+
+func TestAnalysisRunScoring(t *testing.T) {
+	server := newWebServer()
+	defer server.Close()
+	template := newWebTemplate(server.URL)
+	template.Spec.Metrics = append(template.Spec.Metrics, v1alpha1.Metric{
+		Name:             "stable-rate",
+		SuccessCondition: "result.rate >= 0.95",
+		Provider: v1alpha1.MetricProvider{
+			Web: &v1alpha1.WebMetric{URL: server.URL + "/guestbook-stable", JSONPath: "{$}"},
+		},
+	})
+	template.Spec.Scoring = &v1alpha1.AnalysisScoring{
+		Weights:   []v1alpha1.MetricWeight{{MetricName: "success-rate", Weight: 3}},
+		Threshold: v1alpha1.ScoreThreshold{Pass: 80, Marginal: 50},
+	}
+	tf, o := options.NewFakeArgoRolloutsOptions(template)
+	defer tf.Cleanup()
+
+	cmd := NewCmdAnalysisRun(o)
+	cmd.PersistentPreRunE = o.PersistentPreRunE
+	cmd.SetArgs([]string{"--from-template", "success-rate", "-a", "service-name=guestbook-canary"})
+	err := cmd.Execute()
+	assert.EqualError(t, err, "analysis phase is Inconclusive")
+	stdout := o.Out.(*bytes.Buffer).String()
+	assert.Contains(t, stdout, "stable-rate   Failed\n")
+	assert.Contains(t, stdout, "Score: 75 (pass: 80, marginal: 50)\n")
+	assert.Contains(t, stdout, "Phase: Inconclusive\n")
+	assert.Contains(t, stdout, "Message: Score (75) < pass threshold (80)\n")
+}
+
+func TestAnalysisRunDependsOn(t *testing.T) {
+	server := newWebServer()
+	defer server.Close()
+	template := newWebTemplate(server.URL)
+	newMetric := func(name, path string, dependsOn ...string) v1alpha1.Metric {
+		return v1alpha1.Metric{
+			Name:             name,
+			SuccessCondition: "result.rate >= 0.95",
+			DependsOn:        dependsOn,
+			Provider: v1alpha1.MetricProvider{
+				Web: &v1alpha1.WebMetric{URL: server.URL + path, JSONPath: "{$}"},
+			},
+		}
+	}
+	// metrics are listed before the metrics they depend on
+	template.Spec.Metrics = []v1alpha1.Metric{
+		newMetric("after-stable", "/guestbook-canary", "stable-rate"),
+		newMetric("after-canary", "/guestbook-canary", "success-rate"),
+		newMetric("stable-rate", "/guestbook-stable"),
+		newMetric("success-rate", "/guestbook-canary"),
+	}
+	tf, o := options.NewFakeArgoRolloutsOptions(template)
+	defer tf.Cleanup()
+
+	cmd := NewCmdAnalysisRun(o)
+	cmd.PersistentPreRunE = o.PersistentPreRunE
+	cmd.SetArgs([]string{"--from-template", "success-rate", "-a", "service-name=guestbook-canary"})
+	err := cmd.Execute()
+	assert.EqualError(t, err, "analysis phase is Failed")
+	stdout := o.Out.(*bytes.Buffer).String()
+	assert.Regexp(t, `(?s)success-rate\s+1 .*after-canary\s+1 `, stdout)
+	assert.Regexp(t, `after-canary\s+Successful\n`, stdout)
+	assert.Regexp(t, `stable-rate\s+Failed\n`, stdout)
+	// a metric depending on a metric which did not succeed is not measured
+	assert.NotRegexp(t, `after-stable\s+1 `, stdout)
+	assert.Regexp(t, `after-stable\s+Inconclusive\n`, stdout)
+}
+
+func TestAnalysisRunConfigMapArg(t *testing.T) {
+	server := newWebServer()
+	defer server.Close()
+	template := newWebTemplate(server.URL)
+	template.Spec.Args[1] = v1alpha1.Argument{
+		Name: "min-rate",
+		ValueFrom: &v1alpha1.ValueFrom{
+			ConfigMapKeyRef: &v1alpha1.ConfigMapKeyRef{Name: "slo", Key: "success-rate"},
+		},
+	}
+	configMap := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Name: "slo", Namespace: metav1.NamespaceDefault},
+		Data:       map[string]string{"success-rate": "0.99"},
+	}
+	tf, o := options.NewFakeArgoRolloutsOptions(template, configMap)
+	defer tf.Cleanup()
+
+	cmd := NewCmdAnalysisRun(o)
+	cmd.PersistentPreRunE = o.PersistentPreRunE
+	cmd.SetArgs([]string{"--from-template", "success-rate", "-a", "service-name=guestbook-canary"})
+	assert.NoError(t, cmd.Execute())
+	assert.Contains(t, o.Out.(*bytes.Buffer).String(), "Phase: Successful\n")
+}
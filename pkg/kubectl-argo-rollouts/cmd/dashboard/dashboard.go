@@ -5,6 +5,7 @@ import (
 
 	"github.com/spf13/cobra"
 
+	"github.com/argoproj/argo-rollouts/analysis/history"
 	"github.com/argoproj/argo-rollouts/pkg/kubectl-argo-rollouts/options"
 	"github.com/argoproj/argo-rollouts/server"
 )
@@ -15,12 +16,20 @@ var (
 	%[1]s dashboard
 
 	# Start UI dashboard on a specific port
-	%[1]s dashboard --port 8080`
+	%[1]s dashboard --port 8080
+
+	# Start UI dashboard serving the analysis history exported by the controller
+	%[1]s dashboard --analysis-history-sink dir:/mnt/analysis-history
+
+	# Start UI dashboard serving the analysis history served by the controller, forwarded to localhost
+	kubectl port-forward -n argo-rollouts deployment/argo-rollouts 8090 &
+	%[1]s dashboard --analysis-history-sink http://localhost:8090`
 )
 
 func NewCmdDashboard(o *options.ArgoRolloutsOptions) *cobra.Command {
 	var rootPath string
 	var port int
+	var analysisHistorySinkSpec string
 	var cmd = &cobra.Command{
 		Use:     "dashboard",
 		Short:   "Start UI dashboard",
@@ -29,6 +38,14 @@ func NewCmdDashboard(o *options.ArgoRolloutsOptions) *cobra.Command {
 			namespace := o.Namespace()
 			kubeclientset := o.KubeClientset()
 			rolloutclientset := o.RolloutsClientset()
+			var analysisHistory history.Lister
+			if analysisHistorySinkSpec != "" {
+				lister, err := history.NewLister(analysisHistorySinkSpec)
+				if err != nil {
+					return err
+				}
+				analysisHistory = lister
+			}
 
 			opts := server.ServerOptions{
 				Namespace:         namespace,
@@ -36,6 +53,7 @@ func NewCmdDashboard(o *options.ArgoRolloutsOptions) *cobra.Command {
 				RolloutsClientset: rolloutclientset,
 				DynamicClientset:  o.DynamicClientset(),
 				RootPath:          rootPath,
+				AnalysisHistory:   analysisHistory,
 			}
 
 			for {
@@ -49,6 +67,7 @@ func NewCmdDashboard(o *options.ArgoRolloutsOptions) *cobra.Command {
 	}
 	cmd.Flags().StringVar(&rootPath, "root-path", "rollouts", "changes the root path of the dashboard")
 	cmd.Flags().IntVarP(&port, "port", "p", 3100, "port to listen on")
+	cmd.Flags().StringVar(&analysisHistorySinkSpec, "analysis-history-sink", "", "serves the analysis history exported to this sink, one of file:<path>, dir:<path> or sql:<driver>:<dsn>, or served by the controller at this http(s) address of its metrics server")
 
 	return cmd
 }
@@ -263,6 +263,9 @@ func (o *GetOptions) PrintAnalysisRunInfo(w io.Writer, arInfo rollout.AnalysisRu
 	if arInfo.Error > 0 {
 		infoCols = append(infoCols, fmt.Sprintf("%s %d", o.colorize(info.IconWarning), arInfo.Error))
 	}
+	if arInfo.SpecAndStatus != nil && arInfo.SpecAndStatus.Status != nil && arInfo.SpecAndStatus.Status.Score != nil {
+		infoCols = append(infoCols, fmt.Sprintf("score:%d", *arInfo.SpecAndStatus.Status.Score))
+	}
 	fmt.Fprintf(w, "%s%s %s\t%s\t%s %s\t%s\t%v\n", prefix, IconAnalysis, name, "AnalysisRun", o.colorize(arInfo.Icon), arInfo.Status, info.Age(*arInfo.ObjectMeta), strings.Join(infoCols, ","))
 	for i, jobInfo := range arInfo.Jobs {
 		isLast := i == len(arInfo.Jobs)-1
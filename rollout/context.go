@@ -55,6 +55,11 @@ type rolloutContext struct {
 	// since we do not want to continually verify weight in case it could incur rate-limiting or other expenses.
 	targetsVerified *bool
 
+	// trafficRoutingDriftChecked indicates if a traffic router checked its managed resources for drift, and
+	// trafficRoutingDrifts holds the drifts it found. They are reflected in the TrafficRoutingDrift condition.
+	trafficRoutingDriftChecked bool
+	trafficRoutingDrifts       []string
+
 	// newRSWithinDelay indicates if the newRS has a valid (non-expired) scale-down-deadline
 	// annotation at the start of reconciliation (before it may be removed).
 	// Used to detect fast rollbacks where we skip pause/analysis steps.
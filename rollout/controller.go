@@ -20,6 +20,7 @@ import (
 	"k8s.io/apimachinery/pkg/util/validation/field"
 	"k8s.io/apimachinery/pkg/util/wait"
 	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/dynamic/dynamicinformer"
 	appsinformers "k8s.io/client-go/informers/apps/v1"
 	coreinformers "k8s.io/client-go/informers/core/v1"
 	"k8s.io/client-go/kubernetes"
@@ -42,6 +43,7 @@ import (
 	"github.com/argoproj/argo-rollouts/rollout/trafficrouting"
 	"github.com/argoproj/argo-rollouts/rollout/trafficrouting/ambassador"
 	"github.com/argoproj/argo-rollouts/rollout/trafficrouting/appmesh"
+	"github.com/argoproj/argo-rollouts/rollout/trafficrouting/drift"
 	"github.com/argoproj/argo-rollouts/rollout/trafficrouting/istio"
 	analysisutil "github.com/argoproj/argo-rollouts/utils/analysis"
 	"github.com/argoproj/argo-rollouts/utils/conditions"
@@ -84,6 +86,9 @@ type Controller struct {
 	// rolloutVersionTracker remembers ResourceVersions from our last successful writes so
 	// syncHandler can requeue when the informer cache hasn't caught up yet.
 	rolloutVersionTracker *resourceversionutil.Tracker
+
+	// driftController watches the traffic routing resources managed by rollouts
+	driftController *drift.Controller
 }
 
 // ControllerConfig describes the data required to instantiate a new rollout controller
@@ -105,6 +110,7 @@ type ControllerConfig struct {
 	IstioPrimaryDynamicClient       dynamic.Interface
 	IstioVirtualServiceInformer     cache.SharedIndexInformer
 	IstioDestinationRuleInformer    cache.SharedIndexInformer
+	TrafficRoutingInformerFactory   dynamicinformer.DynamicSharedInformerFactory
 	ResyncPeriod                    time.Duration
 	RolloutWorkQueue                workqueue.RateLimitingInterface
 	ServiceWorkQueue                workqueue.RateLimitingInterface
@@ -238,6 +244,13 @@ func NewController(cfg ControllerConfig) *Controller {
 		VirtualServiceInformer:  cfg.IstioVirtualServiceInformer,
 		DestinationRuleInformer: cfg.IstioDestinationRuleInformer,
 	})
+	controller.driftController = drift.NewController(drift.ControllerConfig{
+		DynamicClientSet:       cfg.DynamicClientSet,
+		DynamicInformerFactory: cfg.TrafficRoutingInformerFactory,
+		Namespace:              cfg.Namespace,
+		EnqueueRollout:         controller.enqueueRollout,
+		RolloutsInformer:       cfg.RolloutsInformer,
+	})
 	controller.newTrafficRoutingReconciler = controller.NewTrafficRoutingReconciler
 
 	log.Info("Setting up event handlers")
@@ -377,6 +390,12 @@ func (c *Controller) Run(ctx context.Context, threadiness int) error {
 	wg.Add(1)
 	go c.IstioController.Run(ctx)
 
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		c.driftController.Run(ctx)
+	}()
+
 	<-ctx.Done()
 	c.IstioController.ShutDownWithDrain()
 	wg.Done()
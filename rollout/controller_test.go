@@ -49,6 +49,8 @@ import (
 	informers "github.com/argoproj/argo-rollouts/pkg/client/informers/externalversions"
 	"github.com/argoproj/argo-rollouts/rollout/mocks"
 	"github.com/argoproj/argo-rollouts/rollout/trafficrouting"
+	"github.com/argoproj/argo-rollouts/rollout/trafficrouting/ambassador"
+	"github.com/argoproj/argo-rollouts/rollout/trafficrouting/smi"
 	"github.com/argoproj/argo-rollouts/utils/annotations"
 	"github.com/argoproj/argo-rollouts/utils/conditions"
 	controllerutil "github.com/argoproj/argo-rollouts/utils/controller"
@@ -143,6 +145,8 @@ type fixture struct {
 	// events holds all the K8s Event Reasons emitted during the run
 	events             []string
 	fakeTrafficRouting *mocks.TrafficRoutingReconciler
+	// trafficRoutingDrift, if set, is reported as drift of the fake traffic routing reconciler
+	trafficRoutingDrift string
 	// reseedRolloutMutator, if set, is applied to the rollout when re-seeding between syncs (for multi-sync tests).
 	reseedRolloutMutator func(*v1alpha1.Rollout)
 	// allowErrorOnLastSync, if set, do not fail the test when the final sync returns an error (e.g. "delaying destination rule switch").
@@ -632,9 +636,11 @@ func (f *fixture) newController(resync resyncFunc) (*Controller, informers.Share
 	vsvcGVR := istioutil.GetIstioVirtualServiceGVR()
 	destGVR := istioutil.GetIstioDestinationRuleGVR()
 	listMapping := map[schema.GroupVersionResource]string{
-		tgbGVR:  "TargetGroupBindingList",
-		vsvcGVR: vsvcGVR.Resource + "List",
-		destGVR: destGVR.Resource + "List",
+		tgbGVR:                     "TargetGroupBindingList",
+		vsvcGVR:                    vsvcGVR.Resource + "List",
+		destGVR:                    destGVR.Resource + "List",
+		ambassador.GetMappingGVR(): "MappingList",
+		smi.GetTrafficSplitGVR():   "TrafficSplitList",
 	}
 
 	dynamicClient := dynamicfake.NewSimpleDynamicClientWithCustomListKinds(scheme, listMapping, dynamicClientObjects...)
@@ -667,6 +673,7 @@ func (f *fixture) newController(resync resyncFunc) (*Controller, informers.Share
 		IstioPrimaryDynamicClient:       dynamicClient,
 		IstioVirtualServiceInformer:     istioVirtualServiceInformer,
 		IstioDestinationRuleInformer:    istioDestinationRuleInformer,
+		TrafficRoutingInformerFactory:   dynamicInformerFactory,
 		ResyncPeriod:                    resync(),
 		RolloutWorkQueue:                rolloutWorkqueue,
 		ServiceWorkQueue:                serviceWorkqueue,
@@ -704,6 +711,10 @@ func (f *fixture) newController(resync resyncFunc) (*Controller, informers.Share
 				return nil, nil
 			}
 			var reconcilers = []trafficrouting.TrafficRoutingReconciler{}
+			if f.trafficRoutingDrift != "" {
+				reconcilers = append(reconcilers, &driftDetectingTrafficRoutingReconciler{TrafficRoutingReconciler: f.fakeTrafficRouting, drift: f.trafficRoutingDrift})
+				return reconcilers, nil
+			}
 			reconcilers = append(reconcilers, f.fakeTrafficRouting)
 			return reconcilers, nil
 		}
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"sort"
 	"strconv"
+	"strings"
 	"time"
 
 	appsv1 "k8s.io/api/apps/v1"
@@ -860,6 +861,15 @@ func (c *rolloutContext) calculateRolloutConditions(newStatus *v1alpha1.RolloutS
 	isPaused := len(newStatus.PauseConditions) > 0 || c.rollout.Spec.Paused
 	isAborted := c.pauseContext.IsAborted()
 
+	if len(c.trafficRoutingDrifts) > 0 {
+		msg := fmt.Sprintf(conditions.TrafficRoutingDriftMessage, strings.Join(c.trafficRoutingDrifts, "; "))
+		driftCond := conditions.NewRolloutCondition(v1alpha1.RolloutTrafficRoutingDrift, corev1.ConditionTrue, conditions.TrafficRoutingDriftReason, msg)
+		conditions.SetRolloutCondition(newStatus, *driftCond)
+	} else if c.trafficRoutingDriftChecked && conditions.GetRolloutCondition(*newStatus, v1alpha1.RolloutTrafficRoutingDrift) != nil {
+		inSyncCond := conditions.NewRolloutCondition(v1alpha1.RolloutTrafficRoutingDrift, corev1.ConditionFalse, conditions.TrafficRoutingInSyncReason, conditions.TrafficRoutingInSyncMessage)
+		conditions.SetRolloutCondition(newStatus, *inSyncCond)
+	}
+
 	var becameUnhealthy bool // remember if we transitioned from healthy to unhealthy
 	currentHealthyCond := conditions.GetRolloutCondition(c.rollout.Status, v1alpha1.RolloutHealthy)
 	if !isPaused && conditions.RolloutHealthy(c.rollout, newStatus) {
@@ -13,6 +13,7 @@ import (
 	"github.com/argoproj/argo-rollouts/rollout/trafficrouting/plugin"
 
 	appsv1 "k8s.io/api/apps/v1"
+	"k8s.io/utils/ptr"
 
 	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
 	"github.com/argoproj/argo-rollouts/rollout/trafficrouting"
@@ -20,7 +21,11 @@ import (
 	"github.com/argoproj/argo-rollouts/rollout/trafficrouting/ambassador"
 	a6 "github.com/argoproj/argo-rollouts/rollout/trafficrouting/apisix"
 	"github.com/argoproj/argo-rollouts/rollout/trafficrouting/appmesh"
+	"github.com/argoproj/argo-rollouts/rollout/trafficrouting/consul"
+	"github.com/argoproj/argo-rollouts/rollout/trafficrouting/contour"
+	"github.com/argoproj/argo-rollouts/rollout/trafficrouting/gatewayapi"
 	"github.com/argoproj/argo-rollouts/rollout/trafficrouting/istio"
+	"github.com/argoproj/argo-rollouts/rollout/trafficrouting/kong"
 	"github.com/argoproj/argo-rollouts/rollout/trafficrouting/nginx"
 	"github.com/argoproj/argo-rollouts/rollout/trafficrouting/smi"
 	"github.com/argoproj/argo-rollouts/rollout/trafficrouting/traefik"
@@ -77,6 +82,7 @@ func (c *Controller) NewTrafficRoutingReconciler(roCtx *rolloutContext) ([]traff
 		smi_reconcilier, err := smi.NewReconciler(smi.ReconcilerConfig{
 			Rollout:        rollout,
 			Client:         c.smiclientset,
+			DynamicClient:  c.dynamicclientset,
 			Recorder:       c.recorder,
 			ControllerKind: controllerKind,
 		})
@@ -99,9 +105,10 @@ func (c *Controller) NewTrafficRoutingReconciler(roCtx *rolloutContext) ([]traff
 	if rollout.Spec.Strategy.Canary.TrafficRouting.Traefik != nil {
 		dynamicClient := traefik.NewDynamicClient(c.dynamicclientset, rollout.GetNamespace())
 		trafficReconcilers = append(trafficReconcilers, traefik.NewReconciler(&traefik.ReconcilerConfig{
-			Rollout:  rollout,
-			Client:   dynamicClient,
-			Recorder: c.recorder,
+			Rollout:            rollout,
+			Client:             dynamicClient,
+			IngressRouteClient: traefik.NewIngressRouteDynamicClient(c.dynamicclientset, rollout.GetNamespace()),
+			Recorder:           c.recorder,
 		}))
 	}
 
@@ -114,6 +121,38 @@ func (c *Controller) NewTrafficRoutingReconciler(roCtx *rolloutContext) ([]traff
 		}))
 	}
 
+	if rollout.Spec.Strategy.Canary.TrafficRouting.GatewayAPI != nil {
+		trafficReconcilers = append(trafficReconcilers, gatewayapi.NewReconciler(gatewayapi.ReconcilerConfig{
+			Rollout:  rollout,
+			Client:   c.dynamicclientset,
+			Recorder: c.recorder,
+		}))
+	}
+
+	if rollout.Spec.Strategy.Canary.TrafficRouting.Kong != nil {
+		trafficReconcilers = append(trafficReconcilers, kong.NewReconciler(kong.ReconcilerConfig{
+			Rollout:  rollout,
+			Client:   c.dynamicclientset,
+			Recorder: c.recorder,
+		}))
+	}
+
+	if rollout.Spec.Strategy.Canary.TrafficRouting.Contour != nil {
+		trafficReconcilers = append(trafficReconcilers, contour.NewReconciler(contour.ReconcilerConfig{
+			Rollout:  rollout,
+			Client:   c.dynamicclientset,
+			Recorder: c.recorder,
+		}))
+	}
+
+	if rollout.Spec.Strategy.Canary.TrafficRouting.Consul != nil {
+		trafficReconcilers = append(trafficReconcilers, consul.NewReconciler(consul.ReconcilerConfig{
+			Rollout:  rollout,
+			Client:   c.dynamicclientset,
+			Recorder: c.recorder,
+		}))
+	}
+
 	if rollout.Spec.Strategy.Canary.TrafficRouting.Plugins != nil {
 		for pluginName := range rollout.Spec.Strategy.Canary.TrafficRouting.Plugins {
 			pluginReconciler, err := plugin.NewReconciler(&plugin.ReconcilerConfig{
@@ -287,6 +326,11 @@ func (c *rolloutContext) reconcileTrafficRouting() error {
 					return err
 				}
 			}
+			if localityReconciler, ok := reconciler.(trafficrouting.LocalityRoutingReconciler); ok && currentStep.SetLocality != nil {
+				if err = localityReconciler.SetLocality(currentStep.SetLocality); err != nil {
+					return err
+				}
+			}
 		}
 
 		// If there was a previous canary weight > 0 and the new canary has no available
@@ -308,6 +352,24 @@ func (c *rolloutContext) reconcileTrafficRouting() error {
 			return err
 		}
 
+		// Only look for drift when a previous reconciliation already set the desired weights, since the managed
+		// resources are otherwise expected to differ from them
+		if weightsModified, _ := calculateWeightStatus(c.rollout, canaryHash, stableHash, desiredWeight, weightDestinations...); !weightsModified {
+			if c.detectTrafficRoutingDrift(reconciler, desiredWeight, weightDestinations...) && !defaults.SelfHealTrafficRouting() {
+				// The drift is only reported, so the desired weight is not in effect. Hold the rollout as an unverified
+				// weight does until the drift is resolved
+				c.log.Infof("Desired weight %d not verified: %s traffic routing drifted and self-healing is disabled", desiredWeight, reconciler.Type())
+				if c.newStatus.Canary.Weights != nil {
+					c.newStatus.Canary.Weights.Verified = ptr.To[bool](false)
+				}
+				c.enqueueRolloutAfter(c.rollout, defaults.GetRolloutVerifyRetryInterval())
+				if desiredWeight == weightutil.MaxTrafficWeight(c.rollout) {
+					return fmt.Errorf("end of rollout, desired weight %d not yet verified", desiredWeight)
+				}
+				continue
+			}
+		}
+
 		err = reconciler.SetWeight(desiredWeight, weightDestinations...)
 		if err != nil {
 			c.recorder.Warnf(c.rollout, record.EventOptions{EventReason: "TrafficRoutingError"}, err.Error())
@@ -355,6 +417,27 @@ func (c *rolloutContext) reconcileTrafficRouting() error {
 	return nil
 }
 
+// detectTrafficRoutingDrift returns whether the resources managed by the reconciler drifted from the desired weights,
+// and records the drift for the TrafficRoutingDrift condition
+func (c *rolloutContext) detectTrafficRoutingDrift(reconciler trafficrouting.TrafficRoutingReconciler, desiredWeight int32, weightDestinations ...v1alpha1.WeightDestination) bool {
+	driftDetector, ok := reconciler.(trafficrouting.DriftDetector)
+	if !ok {
+		return false
+	}
+	drift, err := driftDetector.DetectDrift(desiredWeight, weightDestinations...)
+	if err != nil {
+		c.log.Warnf("Failed to detect %s traffic routing drift: %v", reconciler.Type(), err)
+		return false
+	}
+	c.trafficRoutingDriftChecked = true
+	if drift == "" {
+		return false
+	}
+	c.trafficRoutingDrifts = append(c.trafficRoutingDrifts, drift)
+	c.recorder.Warnf(c.rollout, record.EventOptions{EventReason: conditions.TrafficRoutingDriftReason}, conditions.TrafficRoutingDriftMessage, drift)
+	return true
+}
+
 // calculateDesiredWeightOnAbortOrStableRollback returns the desired weight to use when we are either
 // aborting, or rolling back to stable RS.
 func (c *rolloutContext) calculateDesiredWeightOnAbortOrStableRollback() int32 {
@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"regexp"
 	"strings"
 	"sync"
 	"time"
@@ -31,6 +32,7 @@ const (
 	CanaryMappingCreationError   = "CanaryMappingCreationError"
 	CanaryMappingUpdateError     = "CanaryMappingUpdateError"
 	CanaryMappingWeightUpdate    = "CanaryMappingWeightUpdate"
+	HeaderRouteMappingError      = "HeaderRouteMappingError"
 )
 
 var (
@@ -114,10 +116,115 @@ func (r *Reconciler) SetWeight(desiredWeight int32, additionalDestinations ...v1
 	return formatErrors(errs)
 }
 
+// SetHeaderRoute will configure a header route mapping for each of the mappings provided in the
+// ambassador configuration. The header route mapping is a clone of the base mapping which sends
+// the requests matching the given headers to the canary service. It is given a higher precedence
+// than the base and canary mappings so that Ambassador evaluates it first. The header route
+// mappings are deleted when the header route has no matches.
 func (r *Reconciler) SetHeaderRoute(headerRouting *v1alpha1.SetHeaderRoute) error {
+	ctx := context.TODO()
+	errs := []error{}
+	for _, baseMappingName := range r.Rollout.Spec.Strategy.Canary.TrafficRouting.Ambassador.Mappings {
+		var err error
+		if len(headerRouting.Match) == 0 {
+			err = r.deleteHeaderRouteMapping(ctx, HeaderRouteMappingName(baseMappingName, headerRouting.Name))
+		} else {
+			err = r.handleHeaderRouteMapping(ctx, baseMappingName, headerRouting)
+		}
+		if err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return formatErrors(errs)
+}
+
+func (r *Reconciler) handleHeaderRouteMapping(ctx context.Context, baseMappingName string, headerRouting *v1alpha1.SetHeaderRoute) error {
+	baseMapping, err := r.Client.Get(ctx, baseMappingName, metav1.GetOptions{})
+	if err != nil {
+		if k8serrors.IsNotFound(err) {
+			msg := fmt.Sprintf("Ambassador mapping %q not found", baseMappingName)
+			r.sendWarningEvent(AmbassadorMappingNotFound, msg)
+		}
+		return err
+	}
+	canarySvc := r.Rollout.Spec.Strategy.Canary.CanaryService
+	stableService := r.Rollout.Spec.Strategy.Canary.StableService
+	headerMapping := buildHeaderRouteMapping(baseMapping, headerRouting, canarySvc, stableService)
+
+	existingMapping, err := r.Client.Get(ctx, headerMapping.GetName(), metav1.GetOptions{})
+	if k8serrors.IsNotFound(err) {
+		r.Log.Infof("creating header route mapping %q based on %q", headerMapping.GetName(), baseMappingName)
+		_, err = r.Client.Create(ctx, headerMapping, metav1.CreateOptions{})
+		if err != nil {
+			msg := fmt.Sprintf("Error creating header route mapping: %s", err)
+			r.sendWarningEvent(HeaderRouteMappingError, msg)
+		}
+		return err
+	}
+	if err != nil {
+		return err
+	}
+	headerMapping.SetResourceVersion(existingMapping.GetResourceVersion())
+	r.Log.Infof("updating header route mapping %q", headerMapping.GetName())
+	_, err = r.Client.Update(ctx, headerMapping, metav1.UpdateOptions{})
+	if err != nil {
+		msg := fmt.Sprintf("Error updating header route mapping %q: %s", headerMapping.GetName(), err)
+		r.sendWarningEvent(HeaderRouteMappingError, msg)
+	}
+	return err
+}
+
+func (r *Reconciler) deleteHeaderRouteMapping(ctx context.Context, name string) error {
+	err := r.Client.Delete(ctx, name, metav1.DeleteOptions{})
+	if err != nil && !k8serrors.IsNotFound(err) {
+		msg := fmt.Sprintf("Error deleting header route mapping %q: %s", name, err)
+		r.sendWarningEvent(HeaderRouteMappingError, msg)
+		return err
+	}
 	return nil
 }
 
+func buildHeaderRouteMapping(baseMapping *unstructured.Unstructured, headerRouting *v1alpha1.SetHeaderRoute, canarySvc string, stableService string) *unstructured.Unstructured {
+	headerMapping := baseMapping.DeepCopy()
+	svc := buildCanaryService(baseMapping, canarySvc, stableService)
+	unstructured.RemoveNestedField(headerMapping.Object, "metadata")
+	headerMapping.SetName(HeaderRouteMappingName(baseMapping.GetName(), headerRouting.Name))
+	headerMapping.SetNamespace(baseMapping.GetNamespace())
+	unstructured.SetNestedField(headerMapping.Object, svc, "spec", "service")
+	unstructured.RemoveNestedField(headerMapping.Object, "spec", "weight")
+	precedence, _, _ := unstructured.NestedInt64(headerMapping.Object, "spec", "precedence")
+	unstructured.SetNestedField(headerMapping.Object, precedence+1, "spec", "precedence")
+
+	headers, _, _ := unstructured.NestedMap(headerMapping.Object, "spec", "headers")
+	regexHeaders, _, _ := unstructured.NestedMap(headerMapping.Object, "spec", "regex_headers")
+	if headers == nil {
+		headers = map[string]any{}
+	}
+	if regexHeaders == nil {
+		regexHeaders = map[string]any{}
+	}
+	for _, match := range headerRouting.Match {
+		if match.HeaderValue == nil {
+			continue
+		}
+		switch {
+		case match.HeaderValue.Exact != "":
+			headers[match.HeaderName] = match.HeaderValue.Exact
+		case match.HeaderValue.Prefix != "":
+			regexHeaders[match.HeaderName] = "^" + regexp.QuoteMeta(match.HeaderValue.Prefix) + ".*"
+		case match.HeaderValue.Regex != "":
+			regexHeaders[match.HeaderName] = match.HeaderValue.Regex
+		}
+	}
+	if len(headers) > 0 {
+		unstructured.SetNestedMap(headerMapping.Object, headers, "spec", "headers")
+	}
+	if len(regexHeaders) > 0 {
+		unstructured.SetNestedMap(headerMapping.Object, regexHeaders, "spec", "regex_headers")
+	}
+	return headerMapping
+}
+
 func formatErrors(errs []error) error {
 	errorsCount := len(errs)
 	if errorsCount == 0 {
@@ -135,7 +242,7 @@ func formatErrors(errs []error) error {
 
 // handleCanaryMapping has the logic to create, update or delete canary mappings
 func (r *Reconciler) handleCanaryMapping(ctx context.Context, baseMappingName string, desiredWeight int32) error {
-	canaryMappingName := buildCanaryMappingName(baseMappingName)
+	canaryMappingName := CanaryMappingName(baseMappingName)
 	canaryMapping, err := r.Client.Get(ctx, canaryMappingName, metav1.GetOptions{})
 	if err != nil {
 		if k8serrors.IsNotFound(err) {
@@ -231,7 +338,7 @@ func buildCanaryMapping(baseMapping *unstructured.Unstructured, canarySvc string
 	canaryMapping := baseMapping.DeepCopy()
 	svc := buildCanaryService(baseMapping, canarySvc, stableService)
 	unstructured.RemoveNestedField(canaryMapping.Object, "metadata")
-	cMappingName := buildCanaryMappingName(baseMapping.GetName())
+	cMappingName := CanaryMappingName(baseMapping.GetName())
 	canaryMapping.SetName(cMappingName)
 	canaryMapping.SetNamespace(baseMapping.GetNamespace())
 	unstructured.SetNestedField(canaryMapping.Object, svc, "spec", "service")
@@ -248,6 +355,29 @@ func (r *Reconciler) VerifyWeight(desiredWeight int32, additionalDestinations ..
 	return nil, nil
 }
 
+// DetectDrift returns which canary mappings are missing or do not have the desired weight
+func (r *Reconciler) DetectDrift(desiredWeight int32, additionalDestinations ...v1alpha1.WeightDestination) (string, error) {
+	ctx := context.TODO()
+	var drifted []string
+	for _, baseMappingName := range r.Rollout.Spec.Strategy.Canary.TrafficRouting.Ambassador.Mappings {
+		canaryMappingName := CanaryMappingName(baseMappingName)
+		canaryMapping, err := r.Client.Get(ctx, canaryMappingName, metav1.GetOptions{})
+		if k8serrors.IsNotFound(err) {
+			if desiredWeight > 0 {
+				drifted = append(drifted, fmt.Sprintf("canary mapping `%s` not found", canaryMappingName))
+			}
+			continue
+		}
+		if err != nil {
+			return "", err
+		}
+		if weight := GetMappingWeight(canaryMapping); weight != int64(desiredWeight) {
+			drifted = append(drifted, fmt.Sprintf("canary mapping `%s` has weight %d instead of %d", canaryMappingName, weight, desiredWeight))
+		}
+	}
+	return strings.Join(drifted, ", "), nil
+}
+
 func (r *Reconciler) Type() string {
 	return Type
 }
@@ -272,7 +402,17 @@ func GetMappingService(obj *unstructured.Unstructured) string {
 	return svc
 }
 
-func buildCanaryMappingName(name string) string {
+// HeaderRouteMappingName returns the name of the header route mapping cloned from the given mapping
+func HeaderRouteMappingName(name, headerRouteName string) string {
+	n := name
+	if maxLen := 253 - len(headerRouteName) - 1; len(name) > maxLen && maxLen > 0 {
+		n = name[:maxLen]
+	}
+	return fmt.Sprintf("%s-%s", n, headerRouteName)
+}
+
+// CanaryMappingName returns the name of the canary mapping cloned from the given mapping
+func CanaryMappingName(name string) string {
 	n := name
 	if len(name) > 246 {
 		n = name[:246]
@@ -326,6 +466,16 @@ func (r *Reconciler) SetMirrorRoute(setMirrorRoute *v1alpha1.SetMirrorRoute) err
 	return nil
 }
 
+// RemoveManagedRoutes deletes the header route mappings of all the managed routes
 func (r *Reconciler) RemoveManagedRoutes() error {
-	return nil
+	ctx := context.TODO()
+	errs := []error{}
+	for _, managedRoute := range r.Rollout.Spec.Strategy.Canary.TrafficRouting.ManagedRoutes {
+		for _, baseMappingName := range r.Rollout.Spec.Strategy.Canary.TrafficRouting.Ambassador.Mappings {
+			if err := r.deleteHeaderRouteMapping(ctx, HeaderRouteMappingName(baseMappingName, managedRoute.Name)); err != nil {
+				errs = append(errs, err)
+			}
+		}
+	}
+	return formatErrors(errs)
 }
@@ -521,6 +521,48 @@ func TestReconciler_SetWeight(t *testing.T) {
 	})
 }
 
+func TestReconcilerDetectDrift(t *testing.T) {
+	newReconciler := func(getReturns ...*getReturn) (*ambassador.Reconciler, *fakeClient) {
+		fakeClient := &fakeClient{getReturns: getReturns}
+		l, _ := test.NewNullLogger()
+		return &ambassador.Reconciler{
+			Rollout:  rollout("main-service", "canary-service", []string{"myapp-mapping"}),
+			Client:   fakeClient,
+			Recorder: record.NewFakeEventRecorder(),
+			Log:      l.WithContext(context.TODO()),
+		}, fakeClient
+	}
+	t.Run("will not report drift when the canary mapping has the desired weight", func(t *testing.T) {
+		r, fakeClient := newReconciler(&getReturn{obj: toUnstructured(t, canaryMapping)})
+		drift, err := r.DetectDrift(20)
+		assert.NoError(t, err)
+		assert.Empty(t, drift)
+		assert.Equal(t, "myapp-mapping-canary", fakeClient.getInvokations[0].name)
+		assert.Empty(t, fakeClient.updateInvokations)
+	})
+	t.Run("will report drift when the canary mapping weight was modified", func(t *testing.T) {
+		r, _ := newReconciler(&getReturn{obj: toUnstructured(t, canaryMapping)})
+		drift, err := r.DetectDrift(30)
+		assert.NoError(t, err)
+		assert.Equal(t, "canary mapping `myapp-mapping-canary` has weight 20 instead of 30", drift)
+	})
+	t.Run("will report drift when the canary mapping was deleted", func(t *testing.T) {
+		r, _ := newReconciler(&getReturn{err: k8serrors.NewNotFound(schema.GroupResource{}, "canary-mapping")})
+		drift, err := r.DetectDrift(30)
+		assert.NoError(t, err)
+		assert.Equal(t, "canary mapping `myapp-mapping-canary` not found", drift)
+
+		drift, err = r.DetectDrift(0)
+		assert.NoError(t, err)
+		assert.Empty(t, drift)
+	})
+	t.Run("will return the error getting the canary mapping", func(t *testing.T) {
+		r, _ := newReconciler(&getReturn{err: errors.New("some error")})
+		_, err := r.DetectDrift(30)
+		assert.EqualError(t, err, "some error")
+	})
+}
+
 func TestReconcilerSetHeaderRoute(t *testing.T) {
 	type fixture struct {
 		rollout    *v1alpha1.Rollout
@@ -546,28 +588,136 @@ func TestReconcilerSetHeaderRoute(t *testing.T) {
 			},
 		}
 	}
+	setHeaderRoute := &v1alpha1.SetHeaderRoute{
+		Name: "set-header",
+		Match: []v1alpha1.HeaderRoutingMatch{
+			{
+				HeaderName: "header-name",
+				HeaderValue: &v1alpha1.StringMatch{
+					Exact: "value",
+				},
+			},
+			{
+				HeaderName: "user-agent",
+				HeaderValue: &v1alpha1.StringMatch{
+					Prefix: "Mozilla.",
+				},
+			},
+		},
+	}
 	t.Run("SetHeaderRoute", func(t *testing.T) {
-		t.Run("will always return nil", func(t *testing.T) {
+		t.Run("will create header route mapping successfully", func(t *testing.T) {
 			// given
 			t.Parallel()
 			f := setup()
+			f.fakeClient.getReturns = []*getReturn{
+				{obj: toUnstructured(t, baseMapping)},
+				{err: k8serrors.NewNotFound(schema.GroupResource{}, "header-mapping")},
+			}
 
 			// when
-			err := f.reconciler.SetHeaderRoute(&v1alpha1.SetHeaderRoute{
-				Name: "set-header",
-				Match: []v1alpha1.HeaderRoutingMatch{{
-					HeaderName: "header-name",
-					HeaderValue: &v1alpha1.StringMatch{
-						Exact: "value",
-					},
-				}},
-			})
+			err := f.reconciler.SetHeaderRoute(setHeaderRoute)
 
 			// then
-			assert.Nil(t, err)
+			assert.NoError(t, err)
+			assert.Equal(t, 2, len(f.fakeClient.getInvokations))
+			assert.Equal(t, "myapp-mapping", f.fakeClient.getInvokations[0].name)
+			assert.Equal(t, "myapp-mapping-set-header", f.fakeClient.getInvokations[1].name)
+			assert.Equal(t, 1, len(f.fakeClient.createInvokations))
+			assert.Equal(t, 0, len(f.fakeClient.updateInvokations))
+			headerMapping := f.fakeClient.createInvokations[0].obj
+			assert.Equal(t, "myapp-mapping-set-header", headerMapping.GetName())
+			assert.Equal(t, "canary-service:8080", ambassador.GetMappingService(headerMapping))
+			headers, _, _ := unstructured.NestedStringMap(headerMapping.Object, "spec", "headers")
+			assert.Equal(t, map[string]string{"header-name": "value"}, headers)
+			regexHeaders, _, _ := unstructured.NestedStringMap(headerMapping.Object, "spec", "regex_headers")
+			assert.Equal(t, map[string]string{"user-agent": `^Mozilla\..*`}, regexHeaders)
+			precedence, _, _ := unstructured.NestedInt64(headerMapping.Object, "spec", "precedence")
+			assert.Equal(t, int64(1), precedence)
+		})
+		t.Run("will update existing header route mapping", func(t *testing.T) {
+			// given
+			t.Parallel()
+			f := setup()
+			existing := toUnstructured(t, baseMapping)
+			existing.SetName("myapp-mapping-set-header")
+			existing.SetResourceVersion("12345")
+			f.fakeClient.getReturns = []*getReturn{
+				{obj: toUnstructured(t, baseMapping)},
+				{obj: existing},
+			}
 
-			err = f.reconciler.RemoveManagedRoutes()
-			assert.Nil(t, err)
+			// when
+			err := f.reconciler.SetHeaderRoute(setHeaderRoute)
+
+			// then
+			assert.NoError(t, err)
+			assert.Equal(t, 0, len(f.fakeClient.createInvokations))
+			assert.Equal(t, 1, len(f.fakeClient.updateInvokations))
+			headerMapping := f.fakeClient.updateInvokations[0].obj
+			assert.Equal(t, "myapp-mapping-set-header", headerMapping.GetName())
+			assert.Equal(t, "12345", headerMapping.GetResourceVersion())
+		})
+		t.Run("will delete header route mapping when there are no matches", func(t *testing.T) {
+			// given
+			t.Parallel()
+			f := setup()
+			f.fakeClient.deleteReturns = []error{k8serrors.NewNotFound(schema.GroupResource{}, "header-mapping")}
+
+			// when
+			err := f.reconciler.SetHeaderRoute(&v1alpha1.SetHeaderRoute{Name: "set-header"})
+
+			// then
+			assert.NoError(t, err)
+			assert.Equal(t, 0, len(f.fakeClient.getInvokations))
+			assert.Equal(t, 1, len(f.fakeClient.deleteInvokations))
+			assert.Equal(t, "myapp-mapping-set-header", f.fakeClient.deleteInvokations[0].name)
+		})
+		t.Run("will return error if base mapping is not found", func(t *testing.T) {
+			// given
+			t.Parallel()
+			f := setup()
+			f.fakeClient.getReturns = []*getReturn{
+				{err: k8serrors.NewNotFound(schema.GroupResource{}, "base-mapping")},
+			}
+
+			// when
+			err := f.reconciler.SetHeaderRoute(setHeaderRoute)
+
+			// then
+			assert.Error(t, err)
+			assert.Equal(t, 0, len(f.fakeClient.createInvokations))
+		})
+	})
+	t.Run("RemoveManagedRoutes", func(t *testing.T) {
+		t.Run("will delete the header route mappings of all managed routes", func(t *testing.T) {
+			// given
+			t.Parallel()
+			f := setup()
+			f.rollout.Spec.Strategy.Canary.TrafficRouting.ManagedRoutes = []v1alpha1.MangedRoutes{{Name: "set-header"}, {Name: "other-header"}}
+			f.fakeClient.deleteReturns = []error{nil, k8serrors.NewNotFound(schema.GroupResource{}, "header-mapping")}
+
+			// when
+			err := f.reconciler.RemoveManagedRoutes()
+
+			// then
+			assert.NoError(t, err)
+			assert.Equal(t, 2, len(f.fakeClient.deleteInvokations))
+			assert.Equal(t, "myapp-mapping-set-header", f.fakeClient.deleteInvokations[0].name)
+			assert.Equal(t, "myapp-mapping-other-header", f.fakeClient.deleteInvokations[1].name)
+		})
+		t.Run("will return the error deleting a header route mapping", func(t *testing.T) {
+			// given
+			t.Parallel()
+			f := setup()
+			f.rollout.Spec.Strategy.Canary.TrafficRouting.ManagedRoutes = []v1alpha1.MangedRoutes{{Name: "set-header"}}
+			f.fakeClient.deleteReturns = []error{errors.New("delete error")}
+
+			// when
+			err := f.reconciler.RemoveManagedRoutes()
+
+			// then
+			assert.EqualError(t, err, "delete error")
 		})
 	})
 }
@@ -3,6 +3,7 @@ package apisix
 import (
 	"context"
 	"fmt"
+	"sort"
 	"time"
 
 	"errors"
@@ -26,6 +27,9 @@ const apisixRouteCreateError = "ApisixRouteCreateError"
 const apisixRouteDeleteError = "ApisixRouteDeleteError"
 const failedToTypeAssertion = "Failed type assertion for Apisix http route"
 
+// MirrorRouteAnnotation marks the ApisixRoutes created by the rollout to mirror traffic to the canary service
+const MirrorRouteAnnotation = "rollouts.argoproj.io/apisix-mirror-route"
+
 type ReconcilerConfig struct {
 	Rollout  *v1alpha1.Rollout
 	Client   ClientInterface
@@ -88,9 +92,45 @@ func (r *Reconciler) SetWeight(desiredWeight int32, additionalDestinations ...v1
 	if err != nil {
 		msg := fmt.Sprintf("Error updating apisix route %q: %s", apisixRoute.GetName(), err)
 		r.sendWarningEvent(apisixRouteUpdateError, msg)
+		return err
 	}
 
-	return err
+	return r.setMirrorRoutesWeight(ctx, desiredWeight, apisixRouteName)
+}
+
+// setMirrorRoutesWeight applies the weights to the ApisixRoutes mirroring traffic, which route the requests they match
+// like the ApisixRoute of the rollout
+func (r *Reconciler) setMirrorRoutesWeight(ctx context.Context, desiredWeight int32, apisixRouteName string) error {
+	for _, managedRoute := range r.Rollout.Spec.Strategy.Canary.TrafficRouting.ManagedRoutes {
+		mirrorRoute, err := r.Client.Get(ctx, managedRoute.Name, metav1.GetOptions{})
+		if err != nil {
+			if k8serrors.IsNotFound(err) {
+				continue
+			}
+			return err
+		}
+		if mirrorRoute == nil || !metav1.IsControlledBy(mirrorRoute, r.Rollout) {
+			continue
+		}
+		if _, ok := mirrorRoute.GetAnnotations()[MirrorRouteAnnotation]; !ok {
+			continue
+		}
+		httpRoutes, err := r.processSetWeightRoutes(desiredWeight, mirrorRoute, r.Rollout, apisixRouteName)
+		if err != nil {
+			return err
+		}
+		err = unstructured.SetNestedSlice(mirrorRoute.Object, httpRoutes, "spec", "http")
+		if err != nil {
+			return err
+		}
+		_, err = r.Client.Update(ctx, mirrorRoute, metav1.UpdateOptions{})
+		if err != nil {
+			msg := fmt.Sprintf("Error updating apisix route %q: %s", mirrorRoute.GetName(), err)
+			r.sendWarningEvent(apisixRouteUpdateError, msg)
+			return err
+		}
+	}
+	return nil
 }
 
 func (r *Reconciler) processSetWeightRoutes(desiredWeight int32, apisixRoute *unstructured.Unstructured, rollout *v1alpha1.Rollout, apisixRouteName string) ([]any, error) {
@@ -298,19 +338,25 @@ func (r *Reconciler) processSetHeaderApisixRoute(headerRouting *v1alpha1.SetHead
 }
 
 func (r *Reconciler) makeSetHeaderRoute(ctx context.Context, headerRouting *v1alpha1.SetHeaderRoute, apisixRoute *unstructured.Unstructured) (*unstructured.Unstructured, bool, error) {
-	setHeaderApisixRoute, err := r.Client.Get(ctx, headerRouting.Name, metav1.GetOptions{})
+	return r.makeManagedRoute(ctx, headerRouting.Name, apisixRoute)
+}
+
+// makeManagedRoute returns the ApisixRoute of a managed route, or a copy of the ApisixRoute of the rollout owned by the
+// rollout if it does not exist yet
+func (r *Reconciler) makeManagedRoute(ctx context.Context, name string, apisixRoute *unstructured.Unstructured) (*unstructured.Unstructured, bool, error) {
+	managedApisixRoute, err := r.Client.Get(ctx, name, metav1.GetOptions{})
 	isNew := false
 
 	if err != nil {
 		// create new ApisixRoute CR
 		if k8serrors.IsNotFound(err) {
-			setHeaderApisixRoute = apisixRoute.DeepCopy()
-			setHeaderApisixRoute.SetName(headerRouting.Name)
-			setHeaderApisixRoute.SetResourceVersion("")
-			setHeaderApisixRoute.SetGeneration(0)
-			setHeaderApisixRoute.SetUID("")
-			setHeaderApisixRoute.SetCreationTimestamp(metav1.NewTime(time.Time{}))
-			setHeaderApisixRoute.SetOwnerReferences([]metav1.OwnerReference{
+			managedApisixRoute = apisixRoute.DeepCopy()
+			managedApisixRoute.SetName(name)
+			managedApisixRoute.SetResourceVersion("")
+			managedApisixRoute.SetGeneration(0)
+			managedApisixRoute.SetUID("")
+			managedApisixRoute.SetCreationTimestamp(metav1.NewTime(time.Time{}))
+			managedApisixRoute.SetOwnerReferences([]metav1.OwnerReference{
 				*metav1.NewControllerRef(r.Rollout, controllerKind),
 			})
 			isNew = true
@@ -318,11 +364,11 @@ func (r *Reconciler) makeSetHeaderRoute(ctx context.Context, headerRouting *v1al
 			return nil, false, err
 		}
 	} else {
-		if !metav1.IsControlledBy(setHeaderApisixRoute, r.Rollout) {
-			return nil, false, fmt.Errorf("duplicate ApisixRoute [%s] already exists", headerRouting.Name)
+		if !metav1.IsControlledBy(managedApisixRoute, r.Rollout) {
+			return nil, false, fmt.Errorf("duplicate ApisixRoute [%s] already exists", name)
 		}
 	}
-	return setHeaderApisixRoute, isNew, nil
+	return managedApisixRoute, isNew, nil
 }
 
 func removeBackend(route any, backendName string, backends []any) error {
@@ -418,8 +464,201 @@ func (r *Reconciler) Type() string {
 	return Type
 }
 
+// SetMirrorRoute mirrors the requests matching the mirror route to the canary service through the proxy-mirror plugin
+// of an ApisixRoute named after the mirror route, which routes the requests like the ApisixRoute of the rollout
 func (r *Reconciler) SetMirrorRoute(setMirrorRoute *v1alpha1.SetMirrorRoute) error {
-	return nil
+	ctx := context.TODO()
+	rollout := r.Rollout
+	apisixRouteName := rollout.Spec.Strategy.Canary.TrafficRouting.Apisix.Route.Name
+	apisixRoute, err := r.Client.Get(ctx, apisixRouteName, metav1.GetOptions{})
+	if err != nil {
+		return err
+	}
+
+	mirrorApisixRoute, isNew, err := r.makeManagedRoute(ctx, setMirrorRoute.Name, apisixRoute)
+	if err != nil {
+		return err
+	}
+
+	if setMirrorRoute.Match == nil && setMirrorRoute.Percentage == nil {
+		if isNew {
+			return nil
+		}
+		err = r.Client.Delete(ctx, setMirrorRoute.Name, metav1.DeleteOptions{})
+		if err != nil {
+			msg := fmt.Sprintf("Error delete apisix route %q: %s", mirrorApisixRoute.GetName(), err)
+			r.sendWarningEvent(apisixRouteDeleteError, msg)
+		}
+		return err
+	}
+
+	err = r.processSetMirrorApisixRoute(setMirrorRoute, apisixRoute, mirrorApisixRoute)
+	if err != nil {
+		return err
+	}
+	if isNew {
+		_, err = r.Client.Create(ctx, mirrorApisixRoute, metav1.CreateOptions{})
+		if err != nil {
+			msg := fmt.Sprintf("Error create apisix route %q: %s", mirrorApisixRoute.GetName(), err)
+			r.sendWarningEvent(apisixRouteCreateError, msg)
+		}
+		return err
+	}
+	_, err = r.Client.Update(ctx, mirrorApisixRoute, metav1.UpdateOptions{})
+	if err != nil {
+		msg := fmt.Sprintf("Error update apisix route %q: %s", mirrorApisixRoute.GetName(), err)
+		r.sendWarningEvent(apisixRouteUpdateError, msg)
+	}
+	return err
+}
+
+func (r *Reconciler) processSetMirrorApisixRoute(setMirrorRoute *v1alpha1.SetMirrorRoute, apisixRoute, mirrorApisixRoute *unstructured.Unstructured) error {
+	if len(setMirrorRoute.Match) > 1 {
+		return fmt.Errorf("mirror route %q has %d matches but apisix supports a single match", setMirrorRoute.Name, len(setMirrorRoute.Match))
+	}
+	// The mirror rules start from the current rules of the ApisixRoute of the rollout, so they route by the same weights
+	httpRoutes, isFound, err := unstructured.NestedSlice(apisixRoute.Object, "spec", "http")
+	if err != nil {
+		return err
+	}
+	if !isFound {
+		return errors.New("spec.http was not found in Apisix Route manifest")
+	}
+	rules := r.Rollout.Spec.Strategy.Canary.TrafficRouting.Apisix.Route.Rules
+	if rules == nil {
+		rules = append(rules, r.Rollout.Spec.Strategy.Canary.TrafficRouting.Apisix.Route.Name)
+	}
+	for _, ruleName := range rules {
+		httpRoute, err := GetHttpRoute(httpRoutes, ruleName)
+		if err != nil {
+			return err
+		}
+		if err = processRulePriority(httpRoute); err != nil {
+			return err
+		}
+		if len(setMirrorRoute.Match) == 1 {
+			if err = setApisixRouteMatch(httpRoute, setMirrorRoute.Match[0]); err != nil {
+				return err
+			}
+		}
+		if err = r.setProxyMirrorPlugin(httpRoute, setMirrorRoute.Percentage); err != nil {
+			return err
+		}
+	}
+	annotations := mirrorApisixRoute.GetAnnotations()
+	if annotations == nil {
+		annotations = map[string]string{}
+	}
+	annotations[MirrorRouteAnnotation] = setMirrorRoute.Name
+	mirrorApisixRoute.SetAnnotations(annotations)
+	return unstructured.SetNestedSlice(mirrorApisixRoute.Object, httpRoutes, "spec", "http")
+}
+
+// setApisixRouteMatch narrows the match of an http rule to the requests matching the route match
+func setApisixRouteMatch(route any, match v1alpha1.RouteMatch) error {
+	typedRoute, ok := route.(map[string]any)
+	if !ok {
+		return errors.New(failedToTypeAssertion)
+	}
+	if match.Method != nil {
+		if match.Method.Exact == "" {
+			return errors.New("apisix only supports exact method matches")
+		}
+		if err := unstructured.SetNestedStringSlice(typedRoute, []string{match.Method.Exact}, "match", "methods"); err != nil {
+			return err
+		}
+	}
+	exprs, _, err := unstructured.NestedSlice(typedRoute, "match", "exprs")
+	if err != nil {
+		return err
+	}
+	if match.Path != nil {
+		switch {
+		case match.Path.Exact != "":
+			err = unstructured.SetNestedStringSlice(typedRoute, []string{match.Path.Exact}, "match", "paths")
+		case match.Path.Prefix != "":
+			err = unstructured.SetNestedStringSlice(typedRoute, []string{match.Path.Prefix + "*"}, "match", "paths")
+		case match.Path.Regex != "":
+			exprs = append(exprs, map[string]any{
+				"subject": map[string]any{"scope": "Path"},
+				"op":      "RegexMatch",
+				"value":   match.Path.Regex,
+			})
+		}
+		if err != nil {
+			return err
+		}
+	}
+	headerNames := make([]string, 0, len(match.Headers))
+	for headerName := range match.Headers {
+		headerNames = append(headerNames, headerName)
+	}
+	sort.Strings(headerNames)
+	for _, headerName := range headerNames {
+		headerValue := match.Headers[headerName]
+		exprs = append(exprs, apisixExprs(headerName, headerValue.Exact, headerValue.Regex, headerValue.Prefix)...)
+	}
+	if len(exprs) == 0 {
+		return nil
+	}
+	return unstructured.SetNestedSlice(typedRoute, exprs, "match", "exprs")
+}
+
+// setProxyMirrorPlugin configures the proxy-mirror plugin of an http rule to mirror requests to the canary service
+func (r *Reconciler) setProxyMirrorPlugin(route any, percentage *int32) error {
+	typedRoute, ok := route.(map[string]any)
+	if !ok {
+		return errors.New(failedToTypeAssertion)
+	}
+	backends, err := GetBackends(route)
+	if err != nil {
+		return err
+	}
+	canaryServiceName := r.Rollout.Spec.Strategy.Canary.CanaryService
+	var port int64
+	for _, backend := range backends {
+		typedBackend, ok := backend.(map[string]any)
+		if !ok {
+			return fmt.Errorf("%s backends", failedToTypeAssertion)
+		}
+		if typedBackend["serviceName"] != canaryServiceName {
+			continue
+		}
+		port, ok, err = unstructured.NestedInt64(typedBackend, "servicePort")
+		if err != nil || !ok {
+			return fmt.Errorf("apisix route %s backend has no numeric servicePort", canaryServiceName)
+		}
+	}
+	if port == 0 {
+		return fmt.Errorf("apisix route %s backend was not found", canaryServiceName)
+	}
+
+	config := map[string]any{
+		"host": fmt.Sprintf("http://%s.%s.svc.cluster.local:%d", canaryServiceName, r.Rollout.Namespace, port),
+	}
+	if percentage != nil && *percentage != 100 {
+		config["sample_ratio"] = float64(*percentage) / 100
+	}
+	plugins, _, err := unstructured.NestedSlice(typedRoute, "plugins")
+	if err != nil {
+		return err
+	}
+	result := []any{}
+	for _, plugin := range plugins {
+		typedPlugin, ok := plugin.(map[string]any)
+		if !ok {
+			return fmt.Errorf("%s plugins", failedToTypeAssertion)
+		}
+		if typedPlugin["name"] != "proxy-mirror" {
+			result = append(result, plugin)
+		}
+	}
+	result = append(result, map[string]any{
+		"name":   "proxy-mirror",
+		"enable": true,
+		"config": config,
+	})
+	return unstructured.SetNestedSlice(typedRoute, result, "plugins")
 }
 
 func (r *Reconciler) RemoveManagedRoutes() error {
@@ -1,14 +1,21 @@
 package apisix
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/runtime/serializer/yaml"
+	"k8s.io/client-go/dynamic"
+	dynamicfake "k8s.io/client-go/dynamic/fake"
+	"k8s.io/utils/ptr"
 
 	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
 	"github.com/argoproj/argo-rollouts/rollout/trafficrouting/apisix/mocks"
@@ -770,29 +777,175 @@ func assertExpr(t *testing.T, expr any, op, name, scope, value string) {
 	assert.Equal(t, value, valueAct)
 }
 
+const apisixMirrorRoute = `
+apiVersion: apisix.apache.org/v2
+kind: ApisixRoute
+metadata:
+  name: mocks-apisix-route
+  namespace: default
+spec:
+  http:
+    - name: mocks-apisix-route
+      match:
+        paths:
+          - /*
+        methods:
+          - GET
+          - POST
+      plugins:
+        - name: proxy-mirror
+          enable: true
+          config:
+            host: http://shadow:80
+        - name: cors
+          enable: true
+      backends:
+        - serviceName: stable-rollout
+          servicePort: 80
+          weight: 100
+        - serviceName: canary-rollout
+          servicePort: 80
+          weight: 0
+`
+
+func newMirrorReconciler(t *testing.T) (*Reconciler, dynamic.ResourceInterface) {
+	t.Helper()
+	rollout := newRollout(stableServiceName, canaryServiceName, apisixRouteName)
+	rollout.Spec.Strategy.Canary.TrafficRouting.ManagedRoutes = []v1alpha1.MangedRoutes{{Name: "mirror-route"}}
+	gvr := schema.GroupVersionResource{Group: "apisix.apache.org", Version: "v2", Resource: "apisixroutes"}
+	client := dynamicfake.NewSimpleDynamicClient(runtime.NewScheme(), toUnstructured(t, apisixMirrorRoute)).Resource(gvr).Namespace("default")
+	r := NewReconciler(&ReconcilerConfig{
+		Rollout:  rollout,
+		Client:   client,
+		Recorder: &mocks.FakeRecorder{},
+	})
+	return r, client
+}
+
+func getMirrorRule(t *testing.T, client dynamic.ResourceInterface) map[string]any {
+	t.Helper()
+	mirrorRoute, err := client.Get(context.TODO(), "mirror-route", metav1.GetOptions{})
+	assert.NoError(t, err)
+	assert.True(t, metav1.IsControlledBy(mirrorRoute, &v1alpha1.Rollout{ObjectMeta: metav1.ObjectMeta{UID: "1a2b2d82-50a4-4d83-9ff4-cdc6f5197d30"}}))
+	assert.Equal(t, "mirror-route", mirrorRoute.GetAnnotations()[MirrorRouteAnnotation])
+	httpRoutes, _, err := unstructured.NestedSlice(mirrorRoute.Object, "spec", "http")
+	assert.NoError(t, err)
+	assert.Len(t, httpRoutes, 1)
+	return httpRoutes[0].(map[string]any)
+}
+
 func TestSetMirrorRoute(t *testing.T) {
 	t.Run("SetMirrorRoute", func(t *testing.T) {
 		// Given
-		t.Parallel()
-		cfg := ReconcilerConfig{
-			Rollout: newRollout(stableServiceName, canaryServiceName, apisixRouteName),
-			Client:  &mocks.FakeClient{},
-		}
-		r := NewReconciler(&cfg)
+		r, client := newMirrorReconciler(t)
 
 		// When
 		err := r.SetMirrorRoute(&v1alpha1.SetMirrorRoute{
 			Name: "mirror-route",
 			Match: []v1alpha1.RouteMatch{{
-				Method: &v1alpha1.StringMatch{Exact: "GET"},
+				Method:  &v1alpha1.StringMatch{Exact: "GET"},
+				Path:    &v1alpha1.StringMatch{Prefix: "/api"},
+				Headers: map[string]v1alpha1.StringMatch{"trace": {Exact: "debug"}},
 			}},
+			Percentage: ptr.To[int32](25),
 		})
 
 		// Then
 		assert.NoError(t, err)
+		rule := getMirrorRule(t, client)
+		assert.Equal(t, map[string]any{
+			"paths":   []any{"/api*"},
+			"methods": []any{"GET"},
+			"exprs": []any{map[string]any{
+				"subject": map[string]any{"scope": "Header", "name": "trace"},
+				"op":      "Equal",
+				"value":   "debug",
+			}},
+		}, rule["match"])
+		assert.Equal(t, int64(1), rule["priority"])
+		assert.Equal(t, []any{
+			map[string]any{"name": "cors", "enable": true},
+			map[string]any{"name": "proxy-mirror", "enable": true, "config": map[string]any{
+				"host":         "http://canary-rollout.default.svc.cluster.local:80",
+				"sample_ratio": 0.25,
+			}},
+		}, rule["plugins"])
+
+		// When
+		err = r.SetWeight(40)
+
+		// Then
+		assert.NoError(t, err)
+		backends, err := GetBackends(getMirrorRule(t, client))
+		assert.NoError(t, err)
+		assert.Equal(t, int64(60), backends[0].(map[string]any)["weight"])
+		assert.Equal(t, int64(40), backends[1].(map[string]any)["weight"])
+
+		// When
+		err = r.SetMirrorRoute(&v1alpha1.SetMirrorRoute{Name: "mirror-route"})
+
+		// Then
+		assert.NoError(t, err)
+		_, err = client.Get(context.TODO(), "mirror-route", metav1.GetOptions{})
+		assert.True(t, k8serrors.IsNotFound(err))
+	})
+	t.Run("SetMirrorRouteAllRequests", func(t *testing.T) {
+		// Given
+		r, client := newMirrorReconciler(t)
 
+		// When
+		err := r.SetMirrorRoute(&v1alpha1.SetMirrorRoute{Name: "mirror-route", Percentage: ptr.To[int32](100)})
+
+		// Then
+		assert.NoError(t, err)
+		rule := getMirrorRule(t, client)
+		assert.Equal(t, map[string]any{"paths": []any{"/*"}, "methods": []any{"GET", "POST"}}, rule["match"])
+		plugins := rule["plugins"].([]any)
+		assert.Equal(t, map[string]any{"host": "http://canary-rollout.default.svc.cluster.local:80"}, plugins[1].(map[string]any)["config"])
+
+		// When
 		err = r.RemoveManagedRoutes()
-		assert.Nil(t, err)
+
+		// Then
+		assert.NoError(t, err)
+		_, err = client.Get(context.TODO(), "mirror-route", metav1.GetOptions{})
+		assert.True(t, k8serrors.IsNotFound(err))
+	})
+	t.Run("SetMirrorRouteInvalidMatches", func(t *testing.T) {
+		// Given
+		r, _ := newMirrorReconciler(t)
+
+		// When
+		err := r.SetMirrorRoute(&v1alpha1.SetMirrorRoute{
+			Name:  "mirror-route",
+			Match: []v1alpha1.RouteMatch{{}, {}},
+		})
+
+		// Then
+		assert.EqualError(t, err, `mirror route "mirror-route" has 2 matches but apisix supports a single match`)
+
+		// When
+		err = r.SetMirrorRoute(&v1alpha1.SetMirrorRoute{
+			Name:  "mirror-route",
+			Match: []v1alpha1.RouteMatch{{Method: &v1alpha1.StringMatch{Regex: "GET|POST"}}},
+		})
+
+		// Then
+		assert.EqualError(t, err, "apisix only supports exact method matches")
+	})
+	t.Run("SetMirrorRouteDuplicateRoute", func(t *testing.T) {
+		// Given
+		r, client := newMirrorReconciler(t)
+		route := toUnstructured(t, apisixMirrorRoute)
+		route.SetName("mirror-route")
+		_, err := client.Create(context.TODO(), route, metav1.CreateOptions{})
+		assert.NoError(t, err)
+
+		// When
+		err = r.SetMirrorRoute(&v1alpha1.SetMirrorRoute{Name: "mirror-route", Percentage: ptr.To[int32](100)})
+
+		// Then
+		assert.EqualError(t, err, "duplicate ApisixRoute [mirror-route] already exists")
 	})
 }
 
@@ -30,6 +30,8 @@ const (
 var (
 	// Only following route-types are supported when it comes to traffic splitting
 	supportedRouteTypes = []string{"httpRoute", "tcpRoute", "http2Route", "grpcRoute"}
+	// Only following route-types are supported when it comes to header routing
+	headerRouteTypes = []string{"httpRoute", "http2Route"}
 )
 
 // ReconcilerConfig describes static configuration data for the AppMesh reconciler
@@ -107,37 +109,173 @@ func (r *Reconciler) SetWeight(desiredWeight int32, additionalDestinations ...v1
 
 	r.log.Debugf("SetWeight: setting desired-weight to %d", desiredWeight)
 
+	rVirtualService := r.rollout.Spec.Strategy.Canary.TrafficRouting.AppMesh.VirtualService
+	uVr, err := r.getVirtualRouter(ctx)
+	if err != nil {
+		return err
+	}
+
+	err = r.reconcileVirtualRouter(ctx, rVirtualService.Routes, uVr, desiredWeight)
+	if err != nil {
+		return err
+	}
+
+	r.log.Debugf("SetWeight: updated virtual router (%s) with desiredWeight (%d)", uVr.GetName(), desiredWeight)
+
+	return nil
+}
+
+// getVirtualRouter returns the virtual-router associated with the virtual-service of the rollout
+func (r *Reconciler) getVirtualRouter(ctx context.Context) (*unstructured.Unstructured, error) {
 	rVirtualService := r.rollout.Spec.Strategy.Canary.TrafficRouting.AppMesh.VirtualService
 	uVsvc, err := r.client.GetVirtualServiceCR(ctx, r.rollout.Namespace, rVirtualService.Name)
 	if err != nil {
 		if k8serrors.IsNotFound(err) {
 			r.recorder.Warnf(r.rollout, record.EventOptions{EventReason: "VirtualServiceNotFound"}, "VirtualService `%s` not found in namespace `%s`", rVirtualService.Name, r.rollout.Namespace)
-			return errors.New(ErrVirtualServiceMissing)
+			return nil, errors.New(ErrVirtualServiceMissing)
 		}
-		return err
+		return nil, err
 	}
 
 	uVr, err := r.client.GetVirtualRouterCRForVirtualService(ctx, uVsvc)
 	if err != nil {
 		if k8serrors.IsNotFound(err) {
 			r.recorder.Warnf(r.rollout, record.EventOptions{EventReason: "VirtualRouterNotFound"}, "VirtualRouter for `%s` not found in namespace `%s`", rVirtualService.Name, r.rollout.Namespace)
-			return errors.New(ErrVirtualRouterMissing)
+			return nil, errors.New(ErrVirtualRouterMissing)
 		}
-		return err
+		return nil, err
 	}
+	return uVr, nil
+}
 
-	err = r.reconcileVirtualRouter(ctx, rVirtualService.Routes, uVr, desiredWeight)
+// SetHeaderRoute adds a route named after the header route to the virtual-router, which sends the requests
+// matching the given headers to the canary virtual-node. The route is cloned from the first http or http2 route
+// reconciled by the rollout, keeping its match, and is given the highest priority (0) so that App Mesh evaluates
+// it first. The route is removed when the header route has no matches.
+func (r *Reconciler) SetHeaderRoute(headerRouting *v1alpha1.SetHeaderRoute) error {
+	ctx := context.TODO()
+	uVr, err := r.getVirtualRouter(ctx)
 	if err != nil {
 		return err
 	}
+	uVrCopy := uVr.DeepCopy()
+	routesI, found, err := unstructured.NestedSlice(uVrCopy.Object, "spec", "routes")
+	if !found || err != nil {
+		return field.Invalid(field.NewPath("spec", "routes"), uVrCopy.GetName(), "No routes found")
+	}
 
-	r.log.Debugf("SetWeight: updated virtual router (%s) with desiredWeight (%d)", uVr.GetName(), desiredWeight)
+	routesI, removed := removeRoutes(routesI, map[string]bool{headerRouting.Name: true})
+	if len(headerRouting.Match) > 0 {
+		headerRoute, err := r.buildHeaderRoute(routesI, headerRouting)
+		if err != nil {
+			return field.Invalid(field.NewPath("spec", "routes"), uVrCopy.GetName(), err.Error())
+		}
+		routesI = append([]any{headerRoute}, routesI...)
+	} else if !removed {
+		return nil
+	}
 
+	err = unstructured.SetNestedSlice(uVrCopy.Object, routesI, "spec", "routes")
+	if err != nil {
+		return err
+	}
+	_, err = r.client.UpdateVirtualRouterCR(ctx, uVrCopy)
+	if err != nil {
+		return err
+	}
+	r.log.Debugf("SetHeaderRoute: updated virtual router (%s) with header route (%s)", uVr.GetName(), headerRouting.Name)
 	return nil
 }
 
-func (r *Reconciler) SetHeaderRoute(headerRouting *v1alpha1.SetHeaderRoute) error {
-	return nil
+// buildHeaderRoute clones the first http or http2 route reconciled by the rollout into a route which sends the
+// requests matching the headers of the header route to the canary virtual-node
+func (r *Reconciler) buildHeaderRoute(routesI []any, headerRouting *v1alpha1.SetHeaderRoute) (map[string]any, error) {
+	routesFilterMap := make(map[string]bool)
+	for _, routeName := range r.rollout.Spec.Strategy.Canary.TrafficRouting.AppMesh.VirtualService.Routes {
+		routesFilterMap[routeName] = true
+	}
+	for _, routeI := range routesI {
+		route, ok := routeI.(map[string]any)
+		if !ok {
+			continue
+		}
+		routeName, _ := route["name"].(string)
+		if len(routesFilterMap) > 0 && !routesFilterMap[routeName] {
+			continue
+		}
+		for _, routeType := range headerRouteTypes {
+			routeRule, found, err := unstructured.NestedMap(route, routeType)
+			if err != nil || !found {
+				continue
+			}
+			headers, _, _ := unstructured.NestedSlice(routeRule, "match", "headers")
+			for _, match := range headerRouting.Match {
+				if match.HeaderValue == nil {
+					continue
+				}
+				headerMatch := map[string]any{}
+				switch {
+				case match.HeaderValue.Exact != "":
+					headerMatch["exact"] = match.HeaderValue.Exact
+				case match.HeaderValue.Prefix != "":
+					headerMatch["prefix"] = match.HeaderValue.Prefix
+				case match.HeaderValue.Regex != "":
+					headerMatch["regex"] = match.HeaderValue.Regex
+				}
+				headers = append(headers, map[string]any{
+					"name":  match.HeaderName,
+					"match": headerMatch,
+				})
+			}
+			if err := unstructured.SetNestedSlice(routeRule, headers, "match", "headers"); err != nil {
+				return nil, err
+			}
+			rCanaryVnodeRef := r.rollout.Spec.Strategy.Canary.TrafficRouting.AppMesh.VirtualNodeGroup.CanaryVirtualNodeRef
+			weightedTargets := []any{
+				map[string]any{
+					"virtualNodeRef": map[string]any{
+						"name": rCanaryVnodeRef.Name,
+					},
+					"weight": int64(100),
+				},
+			}
+			if err := unstructured.SetNestedSlice(routeRule, weightedTargets, "action", "weightedTargets"); err != nil {
+				return nil, err
+			}
+			return map[string]any{
+				"name":     headerRouting.Name,
+				"priority": int64(0),
+				routeType:  routeRule,
+			}, nil
+		}
+	}
+	return nil, fmt.Errorf("No http or http2 route found to create header route `%s`", headerRouting.Name)
+}
+
+// removeRoutes returns the routes without the routes with the given names, and whether any route was removed
+func removeRoutes(routesI []any, names map[string]bool) ([]any, bool) {
+	var routes []any
+	removed := false
+	for _, routeI := range routesI {
+		if route, ok := routeI.(map[string]any); ok {
+			if routeName, _ := route["name"].(string); names[routeName] {
+				removed = true
+				continue
+			}
+		}
+		routes = append(routes, routeI)
+	}
+	return routes, removed
+}
+
+// isManagedRoute returns whether the route was created by the rollout for a header route
+func (r *Reconciler) isManagedRoute(routeName string) bool {
+	for _, managedRoute := range r.rollout.Spec.Strategy.Canary.TrafficRouting.ManagedRoutes {
+		if managedRoute.Name == routeName {
+			return true
+		}
+	}
+	return false
 }
 
 type routeReconcileContext struct {
@@ -211,6 +349,11 @@ func (r *Reconciler) reconcileRoute(ctx context.Context, uVr *unstructured.Unstr
 		return false, field.Invalid(routeCtx.routeFldPath.Child("name"), uVr.GetName(), ErrNotWellFormed)
 	}
 
+	if r.isManagedRoute(routeName) {
+		// header routes are not weighted
+		return false, nil
+	}
+
 	if len(routeCtx.routesFilterMap) > 0 {
 		// filter out the routes that are not specified in route filter
 		if _, ok := routeCtx.routesFilterMap[routeName]; !ok {
@@ -396,6 +539,34 @@ func (r *Reconciler) SetMirrorRoute(setMirrorRoute *v1alpha1.SetMirrorRoute) err
 	return nil
 }
 
+// RemoveManagedRoutes removes the header routes of all the managed routes from the virtual-router
 func (r *Reconciler) RemoveManagedRoutes() error {
-	return nil
+	managedRoutes := r.rollout.Spec.Strategy.Canary.TrafficRouting.ManagedRoutes
+	if len(managedRoutes) == 0 {
+		return nil
+	}
+	ctx := context.TODO()
+	uVr, err := r.getVirtualRouter(ctx)
+	if err != nil {
+		return err
+	}
+	uVrCopy := uVr.DeepCopy()
+	routesI, found, err := unstructured.NestedSlice(uVrCopy.Object, "spec", "routes")
+	if !found || err != nil {
+		return nil
+	}
+	names := make(map[string]bool)
+	for _, managedRoute := range managedRoutes {
+		names[managedRoute.Name] = true
+	}
+	routesI, removed := removeRoutes(routesI, names)
+	if !removed {
+		return nil
+	}
+	err = unstructured.SetNestedSlice(uVrCopy.Object, routesI, "spec", "routes")
+	if err != nil {
+		return err
+	}
+	_, err = r.client.UpdateVirtualRouterCR(ctx, uVrCopy)
+	return err
 }
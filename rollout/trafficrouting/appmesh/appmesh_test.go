@@ -483,32 +483,149 @@ func TestUpdateHash(t *testing.T) {
 }
 
 func TestSetHeaderRoute(t *testing.T) {
-	t.Run("not implemented check", func(t *testing.T) {
-		t.Parallel()
-		client := testutil.NewFakeDynamicClient()
-		cfg := ReconcilerConfig{
-			Rollout:  fakeRollout(),
-			Client:   client,
-			Recorder: record.NewFakeEventRecorder(),
-		}
-		r := NewReconciler(cfg)
-
-		err := r.SetHeaderRoute(&v1alpha1.SetHeaderRoute{
-			Name: "set-header",
-			Match: []v1alpha1.HeaderRoutingMatch{{
+	setHeaderRoute := &v1alpha1.SetHeaderRoute{
+		Name: "set-header",
+		Match: []v1alpha1.HeaderRoutingMatch{
+			{
 				HeaderName: "header-name",
 				HeaderValue: &v1alpha1.StringMatch{
 					Exact: "value",
 				},
-			}},
+			},
+			{
+				HeaderName: "user-agent",
+				HeaderValue: &v1alpha1.StringMatch{
+					Regex: "Mozilla(.*)",
+				},
+			},
+		},
+	}
+	headerRolloutFixture := func() *v1alpha1.Rollout {
+		ro := fakeRollout()
+		ro.Spec.Strategy.Canary.TrafficRouting.ManagedRoutes = []v1alpha1.MangedRoutes{{Name: "set-header"}}
+		return ro
+	}
+
+	for _, routeType := range []string{"httpRoute", "http2Route"} {
+		vrouter := vrouterWithHTTPRoutes
+		if routeType == "http2Route" {
+			vrouter = vrouterWithHTTP2Routes
+		}
+		t.Run(routeType, func(t *testing.T) {
+			t.Parallel()
+			vsvc := unstructuredutil.StrToUnstructuredUnsafe(vsvcWithVrouter)
+			vr := unstructuredutil.StrToUnstructuredUnsafe(vrouter)
+			client := testutil.NewFakeDynamicClient(vsvc, vr)
+			r := NewReconciler(ReconcilerConfig{
+				Rollout:  headerRolloutFixture(),
+				Client:   client,
+				Recorder: record.NewFakeEventRecorder(),
+			})
+
+			err := r.SetHeaderRoute(setHeaderRoute)
+			assert.Nil(t, err)
+			actions := client.Actions()
+			assert.Len(t, actions, 3)
+			assert.True(t, actions[2].Matches("update", "virtualrouters"))
+
+			updated := actions[2].(k8stesting.UpdateAction).GetObject().(*unstructured.Unstructured)
+			routes, _, _ := unstructured.NestedSlice(updated.Object, "spec", "routes")
+			assert.Len(t, routes, 2)
+			headerRoute := routes[0].(map[string]any)
+			assert.Equal(t, "set-header", headerRoute["name"])
+			assert.Equal(t, int64(0), headerRoute["priority"])
+			prefix, _, _ := unstructured.NestedString(headerRoute, routeType, "match", "prefix")
+			assert.Equal(t, "/", prefix)
+			headers, _, _ := unstructured.NestedSlice(headerRoute, routeType, "match", "headers")
+			assert.Equal(t, []any{
+				map[string]any{"name": "header-name", "match": map[string]any{"exact": "value"}},
+				map[string]any{"name": "user-agent", "match": map[string]any{"regex": "Mozilla(.*)"}},
+			}, headers)
+			weightedTargets, _, _ := unstructured.NestedSlice(headerRoute, routeType, "action", "weightedTargets")
+			assert.Equal(t, []any{
+				map[string]any{"virtualNodeRef": map[string]any{"name": "mysvc-canary-vn"}, "weight": int64(100)},
+			}, weightedTargets)
+			assert.Equal(t, "primary", routes[1].(map[string]any)["name"])
+
+			// SetWeight does not change the weights of the header route
+			client.ClearActions()
+			err = r.SetWeight(50)
+			assert.Nil(t, err)
+			actions = client.Actions()
+			assert.Len(t, actions, 3)
+			updated = actions[2].(k8stesting.UpdateAction).GetObject().(*unstructured.Unstructured)
+			routes, _, _ = unstructured.NestedSlice(updated.Object, "spec", "routes")
+			weight, _, _ := unstructured.NestedSlice(routes[0].(map[string]any), routeType, "action", "weightedTargets")
+			assert.Equal(t, int64(100), weight[0].(map[string]any)["weight"])
+
+			// removing the header route only removes the managed route
+			client.ClearActions()
+			err = r.SetHeaderRoute(&v1alpha1.SetHeaderRoute{Name: "set-header"})
+			assert.Nil(t, err)
+			actions = client.Actions()
+			assert.Len(t, actions, 3)
+			updated = actions[2].(k8stesting.UpdateAction).GetObject().(*unstructured.Unstructured)
+			routes, _, _ = unstructured.NestedSlice(updated.Object, "spec", "routes")
+			assert.Len(t, routes, 1)
+			assert.Equal(t, "primary", routes[0].(map[string]any)["name"])
+
+			// nothing to remove
+			client.ClearActions()
+			err = r.RemoveManagedRoutes()
+			assert.Nil(t, err)
+			assert.Len(t, client.Actions(), 2)
 		})
+	}
+
+	t.Run("RemoveManagedRoutes", func(t *testing.T) {
+		t.Parallel()
+		vsvc := unstructuredutil.StrToUnstructuredUnsafe(vsvcWithVrouter)
+		vr := unstructuredutil.StrToUnstructuredUnsafe(vrouterWithHTTPRoutes)
+		client := testutil.NewFakeDynamicClient(vsvc, vr)
+		r := NewReconciler(ReconcilerConfig{
+			Rollout:  headerRolloutFixture(),
+			Client:   client,
+			Recorder: record.NewFakeEventRecorder(),
+		})
+		err := r.SetHeaderRoute(setHeaderRoute)
 		assert.Nil(t, err)
 
+		client.ClearActions()
 		err = r.RemoveManagedRoutes()
 		assert.Nil(t, err)
-
 		actions := client.Actions()
-		assert.Len(t, actions, 0)
+		assert.Len(t, actions, 3)
+		assert.True(t, actions[2].Matches("update", "virtualrouters"))
+		updated := actions[2].(k8stesting.UpdateAction).GetObject().(*unstructured.Unstructured)
+		routes, _, _ := unstructured.NestedSlice(updated.Object, "spec", "routes")
+		assert.Len(t, routes, 1)
+		assert.Equal(t, "primary", routes[0].(map[string]any)["name"])
+	})
+
+	t.Run("no http route", func(t *testing.T) {
+		t.Parallel()
+		vsvc := unstructuredutil.StrToUnstructuredUnsafe(vsvcWithVrouter)
+		vr := unstructuredutil.StrToUnstructuredUnsafe(vrouterWithTCPRoutes)
+		client := testutil.NewFakeDynamicClient(vsvc, vr)
+		r := NewReconciler(ReconcilerConfig{
+			Rollout:  headerRolloutFixture(),
+			Client:   client,
+			Recorder: record.NewFakeEventRecorder(),
+		})
+		err := r.SetHeaderRoute(setHeaderRoute)
+		assert.EqualError(t, err, "spec.routes: Invalid value: \"mysvc-vrouter\": No http or http2 route found to create header route `set-header`")
+	})
+
+	t.Run("missing virtual-service", func(t *testing.T) {
+		t.Parallel()
+		client := testutil.NewFakeDynamicClient()
+		r := NewReconciler(ReconcilerConfig{
+			Rollout:  headerRolloutFixture(),
+			Client:   client,
+			Recorder: record.NewFakeEventRecorder(),
+		})
+		err := r.SetHeaderRoute(setHeaderRoute)
+		assert.EqualError(t, err, ErrVirtualServiceMissing)
 	})
 }
 
@@ -0,0 +1,385 @@
+package consul
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"reflect"
+	"sort"
+
+	"github.com/sirupsen/logrus"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/utils/ptr"
+
+	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
+	"github.com/argoproj/argo-rollouts/rollout/trafficrouting"
+	logutil "github.com/argoproj/argo-rollouts/utils/log"
+	"github.com/argoproj/argo-rollouts/utils/record"
+	"github.com/argoproj/argo-rollouts/utils/weightutil"
+)
+
+// Type holds this controller type
+const Type = "Consul"
+
+const (
+	// ConsulAPIGroup is the API group of the Consul config entries
+	ConsulAPIGroup = "consul.hashicorp.com"
+	// ManagedRoutesAnnotation records on the ServiceRouter, per managed route, the route added for it, so it
+	// can be told apart from the routes owned by the user
+	ManagedRoutesAnnotation = "rollouts.argoproj.io/consul-managed-routes"
+
+	serviceSplitterUpdateError = "ConsulServiceSplitterUpdateError"
+	serviceRouterUpdateError   = "ConsulServiceRouterUpdateError"
+)
+
+// GetServiceSplitterGVR returns the GroupVersionResource of the Consul ServiceSplitters
+func GetServiceSplitterGVR() schema.GroupVersionResource {
+	return schema.GroupVersionResource{
+		Group:    ConsulAPIGroup,
+		Version:  "v1alpha1",
+		Resource: "servicesplitters",
+	}
+}
+
+// GetServiceRouterGVR returns the GroupVersionResource of the Consul ServiceRouters
+func GetServiceRouterGVR() schema.GroupVersionResource {
+	return schema.GroupVersionResource{
+		Group:    ConsulAPIGroup,
+		Version:  "v1alpha1",
+		Resource: "servicerouters",
+	}
+}
+
+// ReconcilerConfig describes static configuration data for the Consul reconciler
+type ReconcilerConfig struct {
+	Rollout  *v1alpha1.Rollout
+	Client   dynamic.Interface
+	Recorder record.EventRecorder
+}
+
+// Reconciler holds required fields to reconcile the Consul config entries
+type Reconciler struct {
+	cfg ReconcilerConfig
+	log *logrus.Entry
+}
+
+// NewReconciler returns a reconciler struct that brings the Consul config entries into the desired state
+func NewReconciler(cfg ReconcilerConfig) *Reconciler {
+	return &Reconciler{
+		cfg: cfg,
+		log: logutil.WithRollout(cfg.Rollout),
+	}
+}
+
+// Type indicates this reconciler is a Consul reconciler
+func (r *Reconciler) Type() string {
+	return Type
+}
+
+// UpdateHash informs a traffic routing reconciler about new canary/stable pod hashes
+func (r *Reconciler) UpdateHash(canaryHash, stableHash string, additionalDestinations ...v1alpha1.WeightDestination) error {
+	return nil
+}
+
+// desiredSplits returns the services of the splits with the percentage of traffic they should receive
+func (r *Reconciler) desiredSplits(desiredWeight int32, additionalDestinations []v1alpha1.WeightDestination) ([]string, []any) {
+	canary := r.cfg.Rollout.Spec.Strategy.Canary
+	maxWeight := float64(weightutil.MaxTrafficWeight(r.cfg.Rollout))
+	services := []string{canary.CanaryService}
+	weights := []float64{float64(desiredWeight)}
+	for _, dest := range additionalDestinations {
+		services = append(services, dest.ServiceName)
+		weights = append(weights, float64(dest.Weight))
+	}
+	// Consul expects percentages with a precision of 0.01 adding up to 100, so the rounding remainder
+	// goes to the stable service
+	stablePercent := 100.0
+	percents := []any{}
+	for _, weight := range weights {
+		percent := math.Round(weight*100*100/maxWeight) / 100
+		stablePercent -= percent
+		percents = append(percents, splitWeight(percent))
+	}
+	stablePercent = math.Round(stablePercent*100) / 100
+	return append([]string{canary.StableService}, services...), append([]any{splitWeight(stablePercent)}, percents...)
+}
+
+// SetWeight sets the splits of the ServiceSplitter to the stable, the canary and the additional destination
+// services. The ServiceSplitter is managed by the rollout, so splits to other services are dropped.
+func (r *Reconciler) SetWeight(desiredWeight int32, additionalDestinations ...v1alpha1.WeightDestination) error {
+	ctx := context.TODO()
+	name := r.cfg.Rollout.Spec.Strategy.Canary.TrafficRouting.Consul.ServiceSplitterName
+	client := r.cfg.Client.Resource(GetServiceSplitterGVR()).Namespace(r.cfg.Rollout.Namespace)
+	splitter, err := client.Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		return err
+	}
+	splits, _, err := unstructured.NestedSlice(splitter.Object, "spec", "splits")
+	if err != nil {
+		return err
+	}
+	services, weights := r.desiredSplits(desiredWeight, additionalDestinations)
+	newSplits := []any{}
+	for i, service := range services {
+		split := findSplit(splits, service)
+		if split == nil {
+			split = map[string]any{"service": service}
+		} else {
+			split = runtime.DeepCopyJSONValue(split).(map[string]any)
+		}
+		split["weight"] = weights[i]
+		newSplits = append(newSplits, split)
+	}
+	if reflect.DeepEqual(splits, newSplits) {
+		return nil
+	}
+	if err := unstructured.SetNestedSlice(splitter.Object, newSplits, "spec", "splits"); err != nil {
+		return err
+	}
+	if _, err := client.Update(ctx, splitter, metav1.UpdateOptions{}); err != nil {
+		msg := fmt.Sprintf("Error updating ServiceSplitter %q: %s", name, err)
+		r.sendWarningEvent(serviceSplitterUpdateError, msg)
+		return err
+	}
+	r.log.Infof("Updated ServiceSplitter %q", name)
+	return nil
+}
+
+// updateRouter applies mutate to the routes of the ServiceRouter of the rollout, split between the routes
+// added for the managed routes and the routes of the user. The managed routes are put in front of the
+// routes of the user, in the order of the managed routes of the rollout, as Consul uses the first route
+// matching a request.
+func (r *Reconciler) updateRouter(mutate func(managed map[string]any)) error {
+	ctx := context.TODO()
+	name := r.cfg.Rollout.Spec.Strategy.Canary.TrafficRouting.Consul.ServiceRouterName
+	client := r.cfg.Client.Resource(GetServiceRouterGVR()).Namespace(r.cfg.Rollout.Namespace)
+	router, err := client.Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		return err
+	}
+	routes, _, err := unstructured.NestedSlice(router.Object, "spec", "routes")
+	if err != nil {
+		return err
+	}
+	state := map[string]any{}
+	if err := trafficrouting.GetAnnotationState(router, ManagedRoutesAnnotation, &state); err != nil {
+		return err
+	}
+
+	managed := map[string]any{}
+	userRoutes := []any{}
+	for _, route := range routes {
+		if managedRoute := managedRouteOf(route, state); managedRoute != "" {
+			managed[managedRoute] = route
+			continue
+		}
+		userRoutes = append(userRoutes, route)
+	}
+	mutate(managed)
+
+	names := []string{}
+	for managedRoute := range managed {
+		names = append(names, managedRoute)
+	}
+	order := map[string]int{}
+	for i, managedRoute := range r.cfg.Rollout.Spec.Strategy.Canary.TrafficRouting.ManagedRoutes {
+		order[managedRoute.Name] = i
+	}
+	sort.Slice(names, func(i, j int) bool {
+		oi, iOk := order[names[i]]
+		oj, jOk := order[names[j]]
+		if iOk != jOk {
+			return iOk
+		}
+		if oi != oj {
+			return oi < oj
+		}
+		return names[i] < names[j]
+	})
+	newRoutes := []any{}
+	newState := map[string]any{}
+	for _, managedRoute := range names {
+		newRoutes = append(newRoutes, managed[managedRoute])
+		newState[managedRoute] = routeIdentity(managed[managedRoute])
+	}
+	newRoutes = append(newRoutes, userRoutes...)
+
+	if (reflect.DeepEqual(routes, newRoutes) || len(routes)+len(newRoutes) == 0) && reflect.DeepEqual(state, newState) {
+		return nil
+	}
+	if err := unstructured.SetNestedSlice(router.Object, newRoutes, "spec", "routes"); err != nil {
+		return err
+	}
+	trafficrouting.SetAnnotationState(router, ManagedRoutesAnnotation, newState, len(newState) == 0)
+	if _, err := client.Update(ctx, router, metav1.UpdateOptions{}); err != nil {
+		msg := fmt.Sprintf("Error updating ServiceRouter %q: %s", name, err)
+		r.sendWarningEvent(serviceRouterUpdateError, msg)
+		return err
+	}
+	r.log.Infof("Updated ServiceRouter %q", name)
+	return nil
+}
+
+// SetHeaderRoute adds a route to the ServiceRouter sending the requests matching the headers to the canary
+// service
+func (r *Reconciler) SetHeaderRoute(headerRouting *v1alpha1.SetHeaderRoute) error {
+	if headerRouting == nil {
+		return nil
+	}
+	if r.cfg.Rollout.Spec.Strategy.Canary.TrafficRouting.Consul.ServiceRouterName == "" {
+		if headerRouting.Match == nil {
+			return nil
+		}
+		return fmt.Errorf("Consul header routing requires trafficRouting.consul.serviceRouterName")
+	}
+	headers := []any{}
+	for _, match := range headerRouting.Match {
+		headers = append(headers, headerMatch(match.HeaderName, match.HeaderValue))
+	}
+	return r.updateRouter(func(managed map[string]any) {
+		delete(managed, headerRouting.Name)
+		if headerRouting.Match == nil {
+			return
+		}
+		managed[headerRouting.Name] = map[string]any{
+			"match": map[string]any{
+				"http": map[string]any{
+					"header": headers,
+				},
+			},
+			"destination": map[string]any{
+				"service": r.cfg.Rollout.Spec.Strategy.Canary.CanaryService,
+			},
+		}
+	})
+}
+
+// SetMirrorRoute is not supported by Consul
+func (r *Reconciler) SetMirrorRoute(setMirrorRoute *v1alpha1.SetMirrorRoute) error {
+	return nil
+}
+
+// RemoveManagedRoutes removes the routes added to the ServiceRouter for the managed routes of the rollout
+func (r *Reconciler) RemoveManagedRoutes() error {
+	if r.cfg.Rollout.Spec.Strategy.Canary.TrafficRouting.Consul.ServiceRouterName == "" {
+		return nil
+	}
+	return r.updateRouter(func(managed map[string]any) {
+		for managedRoute := range managed {
+			delete(managed, managedRoute)
+		}
+	})
+}
+
+// VerifyWeight returns true when the splits of the ServiceSplitter carry the desired weights and the
+// ServiceSplitter is synced to Consul
+func (r *Reconciler) VerifyWeight(desiredWeight int32, additionalDestinations ...v1alpha1.WeightDestination) (*bool, error) {
+	ctx := context.TODO()
+	name := r.cfg.Rollout.Spec.Strategy.Canary.TrafficRouting.Consul.ServiceSplitterName
+	splitter, err := r.cfg.Client.Resource(GetServiceSplitterGVR()).Namespace(r.cfg.Rollout.Namespace).Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+	splits, _, err := unstructured.NestedSlice(splitter.Object, "spec", "splits")
+	if err != nil {
+		return nil, err
+	}
+	services, weights := r.desiredSplits(desiredWeight, additionalDestinations)
+	for i, service := range services {
+		split := findSplit(splits, service)
+		if split == nil || toFloat(split["weight"]) != toFloat(weights[i]) {
+			return ptr.To(false), nil
+		}
+	}
+	if !entrySynced(splitter) {
+		r.log.Infof("ServiceSplitter %q is not yet synced to Consul", name)
+		return ptr.To(false), nil
+	}
+	return ptr.To(true), nil
+}
+
+// entrySynced returns true when the Synced condition of the config entry is true
+func entrySynced(entry *unstructured.Unstructured) bool {
+	conditions, _, _ := unstructured.NestedSlice(entry.Object, "status", "conditions")
+	for _, condition := range conditions {
+		typedCondition, ok := condition.(map[string]any)
+		if ok && typedCondition["type"] == "Synced" {
+			return typedCondition["status"] == string(metav1.ConditionTrue)
+		}
+	}
+	return false
+}
+
+// managedRouteOf returns the managed route the route was added for, or an empty string for the routes of
+// the user
+func managedRouteOf(route any, state map[string]any) string {
+	identity := routeIdentity(route)
+	for managedRoute, managedIdentity := range state {
+		if reflect.DeepEqual(identity, managedIdentity) {
+			return managedRoute
+		}
+	}
+	return ""
+}
+
+// routeIdentity returns a route in a canonical form comparable with the routes stored in the
+// ManagedRoutesAnnotation
+func routeIdentity(route any) any {
+	return trafficrouting.CanonicalJSON(route)
+}
+
+func findSplit(splits []any, service string) map[string]any {
+	for _, split := range splits {
+		typedSplit, ok := split.(map[string]any)
+		if ok && typedSplit["service"] == service {
+			return typedSplit
+		}
+	}
+	return nil
+}
+
+// splitWeight returns a percentage as an integer when it is a whole number, as it is read back from the
+// API server
+func splitWeight(percent float64) any {
+	if percent == math.Trunc(percent) {
+		return int64(percent)
+	}
+	return percent
+}
+
+func toFloat(value any) float64 {
+	switch v := value.(type) {
+	case int64:
+		return float64(v)
+	case float64:
+		return v
+	}
+	return -1
+}
+
+// headerMatch converts a header match of the rollout to a Consul ServiceRouter header match
+func headerMatch(name string, value *v1alpha1.StringMatch) map[string]any {
+	match := map[string]any{
+		"name": name,
+	}
+	switch {
+	case value == nil:
+		match["present"] = true
+	case value.Exact != "":
+		match["exact"] = value.Exact
+	case value.Prefix != "":
+		match["prefix"] = value.Prefix
+	case value.Regex != "":
+		match["regex"] = value.Regex
+	}
+	return match
+}
+
+func (r *Reconciler) sendWarningEvent(id, msg string) {
+	r.cfg.Recorder.Eventf(r.cfg.Rollout, record.EventOptions{EventType: corev1.EventTypeWarning, EventReason: id}, msg)
+}
@@ -0,0 +1,276 @@
+package consul
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/client-go/dynamic/fake"
+	"k8s.io/utils/ptr"
+
+	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
+	testutil "github.com/argoproj/argo-rollouts/test/util"
+	"github.com/argoproj/argo-rollouts/utils/record"
+)
+
+const serviceSplitter = `
+apiVersion: consul.hashicorp.com/v1alpha1
+kind: ServiceSplitter
+metadata:
+  name: web
+  namespace: default
+spec:
+  splits:
+  - service: stable-service
+    weight: 100
+    requestHeaders:
+      set:
+        x-version: stable
+  - service: canary-service
+    weight: 0
+status:
+  conditions:
+  - type: Synced
+    status: "True"
+`
+
+const serviceRouter = `
+apiVersion: consul.hashicorp.com/v1alpha1
+kind: ServiceRouter
+metadata:
+  name: web
+  namespace: default
+spec:
+  routes:
+  - match:
+      http:
+        pathPrefix: /admin
+    destination:
+      service: admin-service
+`
+
+func newReconciler(entries ...string) (*Reconciler, *fake.FakeDynamicClient) {
+	client := testutil.NewFakeDynamicClientFromYAML(entries...)
+	r := NewReconciler(ReconcilerConfig{
+		Rollout: testutil.NewCanaryRollout(&v1alpha1.RolloutTrafficRouting{
+			Consul: &v1alpha1.ConsulTrafficRouting{
+				ServiceSplitterName: "web",
+				ServiceRouterName:   "web",
+			},
+			ManagedRoutes: []v1alpha1.MangedRoutes{
+				{Name: "first-route"},
+				{Name: "second-route"},
+			},
+		}),
+		Client:   client,
+		Recorder: record.NewFakeEventRecorder(),
+	})
+	return r, client
+}
+
+func getSplits(t *testing.T, client *fake.FakeDynamicClient) []any {
+	t.Helper()
+	splitter, err := client.Resource(GetServiceSplitterGVR()).Namespace("default").Get(context.TODO(), "web", metav1.GetOptions{})
+	assert.NoError(t, err)
+	splits, _, err := unstructured.NestedSlice(splitter.Object, "spec", "splits")
+	assert.NoError(t, err)
+	return splits
+}
+
+func getRouter(t *testing.T, client *fake.FakeDynamicClient) *unstructured.Unstructured {
+	t.Helper()
+	router, err := client.Resource(GetServiceRouterGVR()).Namespace("default").Get(context.TODO(), "web", metav1.GetOptions{})
+	assert.NoError(t, err)
+	return router
+}
+
+func getRoutes(t *testing.T, client *fake.FakeDynamicClient) []any {
+	t.Helper()
+	routes, _, err := unstructured.NestedSlice(getRouter(t, client).Object, "spec", "routes")
+	assert.NoError(t, err)
+	return routes
+}
+
+func headerRoute(name string, match ...v1alpha1.HeaderRoutingMatch) *v1alpha1.SetHeaderRoute {
+	return &v1alpha1.SetHeaderRoute{Name: name, Match: match}
+}
+
+func canaryRoute(headers ...any) map[string]any {
+	return map[string]any{
+		"match":       map[string]any{"http": map[string]any{"header": headers}},
+		"destination": map[string]any{"service": "canary-service"},
+	}
+}
+
+func TestType(t *testing.T) {
+	r, _ := newReconciler()
+	assert.Equal(t, Type, r.Type())
+	assert.NoError(t, r.UpdateHash("canary", "stable"))
+	assert.NoError(t, r.SetMirrorRoute(&v1alpha1.SetMirrorRoute{Name: "mirror-route"}))
+}
+
+func TestSetWeight(t *testing.T) {
+	r, client := newReconciler(serviceSplitter)
+	assert.NoError(t, r.SetWeight(30))
+	assert.Equal(t, []any{
+		map[string]any{
+			"service":        "stable-service",
+			"weight":         int64(70),
+			"requestHeaders": map[string]any{"set": map[string]any{"x-version": "stable"}},
+		},
+		map[string]any{"service": "canary-service", "weight": int64(30)},
+	}, getSplits(t, client))
+	assert.Equal(t, 1, testutil.CountUpdates(client))
+
+	// a splitter already at the desired weight is not updated
+	assert.NoError(t, r.SetWeight(30))
+	assert.Equal(t, 1, testutil.CountUpdates(client))
+}
+
+func TestSetWeightPercentages(t *testing.T) {
+	r, client := newReconciler(serviceSplitter)
+	r.cfg.Rollout.Spec.Strategy.Canary.TrafficRouting.MaxTrafficWeight = ptr.To[int32](3)
+	assert.NoError(t, r.SetWeight(1))
+	splits := getSplits(t, client)
+	assert.Equal(t, 66.67, splits[0].(map[string]any)["weight"])
+	assert.Equal(t, 33.33, splits[1].(map[string]any)["weight"])
+
+	verified, err := r.VerifyWeight(1)
+	assert.NoError(t, err)
+	assert.True(t, *verified)
+}
+
+func TestSetWeightWithAdditionalDestinations(t *testing.T) {
+	r, client := newReconciler(serviceSplitter)
+	assert.NoError(t, r.SetWeight(20, v1alpha1.WeightDestination{ServiceName: "experiment-service", Weight: 10}))
+	splits := getSplits(t, client)
+	assert.Len(t, splits, 3)
+	assert.Equal(t, map[string]any{"service": "experiment-service", "weight": int64(10)}, splits[2])
+	assert.Equal(t, int64(70), splits[0].(map[string]any)["weight"])
+
+	verified, err := r.VerifyWeight(20, v1alpha1.WeightDestination{ServiceName: "experiment-service", Weight: 10})
+	assert.NoError(t, err)
+	assert.True(t, *verified)
+
+	// the split of the experiment is removed once the experiment is over
+	assert.NoError(t, r.SetWeight(20))
+	splits = getSplits(t, client)
+	assert.Len(t, splits, 2)
+	assert.Equal(t, int64(80), splits[0].(map[string]any)["weight"])
+}
+
+func TestSetWeightMissingSplitter(t *testing.T) {
+	r, _ := newReconciler()
+	assert.Error(t, r.SetWeight(30))
+}
+
+func TestSetWeightUpdateError(t *testing.T) {
+	r, client := newReconciler(serviceSplitter)
+	testutil.FailUpdates(client, "servicesplitters")
+	recorder := record.NewFakeEventRecorder()
+	r.cfg.Recorder = recorder
+	assert.EqualError(t, r.SetWeight(30), testutil.UpdateError)
+	assert.Equal(t, []string{serviceSplitterUpdateError}, recorder.Events())
+}
+
+func TestSetHeaderRoute(t *testing.T) {
+	r, client := newReconciler(serviceRouter)
+	second := headerRoute("second-route", v1alpha1.HeaderRoutingMatch{HeaderName: "canary"})
+	assert.NoError(t, r.SetHeaderRoute(second))
+	first := headerRoute("first-route",
+		v1alpha1.HeaderRoutingMatch{HeaderName: "agent", HeaderValue: &v1alpha1.StringMatch{Exact: "chrome"}},
+		v1alpha1.HeaderRoutingMatch{HeaderName: "version", HeaderValue: &v1alpha1.StringMatch{Prefix: "2."}},
+		v1alpha1.HeaderRoutingMatch{HeaderName: "build", HeaderValue: &v1alpha1.StringMatch{Regex: "[0-9]+"}},
+	)
+	assert.NoError(t, r.SetHeaderRoute(first))
+	assert.Equal(t, 2, testutil.CountUpdates(client))
+
+	// managed routes come first, in the order of the managed routes of the rollout
+	routes := getRoutes(t, client)
+	assert.Len(t, routes, 3)
+	assert.Equal(t, canaryRoute(
+		map[string]any{"name": "agent", "exact": "chrome"},
+		map[string]any{"name": "version", "prefix": "2."},
+		map[string]any{"name": "build", "regex": "[0-9]+"},
+	), routes[0])
+	assert.Equal(t, canaryRoute(map[string]any{"name": "canary", "present": true}), routes[1])
+	assert.Equal(t, "admin-service", routes[2].(map[string]any)["destination"].(map[string]any)["service"])
+
+	// setting the same header route again changes nothing
+	assert.NoError(t, r.SetHeaderRoute(first))
+	assert.Equal(t, 2, testutil.CountUpdates(client))
+
+	// a header route without matches removes its route
+	assert.NoError(t, r.SetHeaderRoute(headerRoute("first-route")))
+	routes = getRoutes(t, client)
+	assert.Len(t, routes, 2)
+	assert.Equal(t, canaryRoute(map[string]any{"name": "canary", "present": true}), routes[0])
+
+	assert.NoError(t, r.RemoveManagedRoutes())
+	assert.Len(t, getRoutes(t, client), 1)
+	assert.NotContains(t, getRouter(t, client).GetAnnotations(), ManagedRoutesAnnotation)
+
+	// nothing to remove
+	updates := testutil.CountUpdates(client)
+	assert.NoError(t, r.RemoveManagedRoutes())
+	assert.Equal(t, updates, testutil.CountUpdates(client))
+}
+
+func TestSetHeaderRouteWithoutServiceRouter(t *testing.T) {
+	r, client := newReconciler()
+	r.cfg.Rollout.Spec.Strategy.Canary.TrafficRouting.Consul.ServiceRouterName = ""
+	err := r.SetHeaderRoute(headerRoute("first-route", v1alpha1.HeaderRoutingMatch{HeaderName: "canary"}))
+	assert.EqualError(t, err, "Consul header routing requires trafficRouting.consul.serviceRouterName")
+	assert.NoError(t, r.SetHeaderRoute(headerRoute("first-route")))
+	assert.NoError(t, r.RemoveManagedRoutes())
+	assert.Empty(t, client.Actions())
+}
+
+func TestSetHeaderRouteUpdateError(t *testing.T) {
+	r, client := newReconciler(serviceRouter)
+	testutil.FailUpdates(client, "servicerouters")
+	recorder := record.NewFakeEventRecorder()
+	r.cfg.Recorder = recorder
+	err := r.SetHeaderRoute(headerRoute("first-route", v1alpha1.HeaderRoutingMatch{HeaderName: "canary"}))
+	assert.EqualError(t, err, testutil.UpdateError)
+	assert.Equal(t, []string{serviceRouterUpdateError}, recorder.Events())
+}
+
+func TestVerifyWeight(t *testing.T) {
+	t.Run("weights applied and splitter synced", func(t *testing.T) {
+		r, _ := newReconciler(serviceSplitter)
+		assert.NoError(t, r.SetWeight(30))
+		verified, err := r.VerifyWeight(30)
+		assert.NoError(t, err)
+		assert.True(t, *verified)
+	})
+
+	t.Run("weights not applied", func(t *testing.T) {
+		r, _ := newReconciler(serviceSplitter)
+		verified, err := r.VerifyWeight(30)
+		assert.NoError(t, err)
+		assert.False(t, *verified)
+	})
+
+	t.Run("splitter not synced", func(t *testing.T) {
+		r, client := newReconciler(serviceSplitter)
+		assert.NoError(t, r.SetWeight(30))
+		splitter, err := client.Resource(GetServiceSplitterGVR()).Namespace("default").Get(context.TODO(), "web", metav1.GetOptions{})
+		assert.NoError(t, err)
+		unstructured.RemoveNestedField(splitter.Object, "status")
+		_, err = client.Resource(GetServiceSplitterGVR()).Namespace("default").Update(context.TODO(), splitter, metav1.UpdateOptions{})
+		assert.NoError(t, err)
+		verified, err := r.VerifyWeight(30)
+		assert.NoError(t, err)
+		assert.False(t, *verified)
+	})
+
+	t.Run("missing splitter", func(t *testing.T) {
+		r, _ := newReconciler()
+		verified, err := r.VerifyWeight(30)
+		assert.Error(t, err)
+		assert.Nil(t, verified)
+	})
+}
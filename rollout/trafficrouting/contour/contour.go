@@ -0,0 +1,322 @@
+package contour
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+
+	"github.com/sirupsen/logrus"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/utils/ptr"
+
+	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
+	"github.com/argoproj/argo-rollouts/rollout/trafficrouting"
+	logutil "github.com/argoproj/argo-rollouts/utils/log"
+	"github.com/argoproj/argo-rollouts/utils/record"
+	"github.com/argoproj/argo-rollouts/utils/weightutil"
+)
+
+// Type holds this controller type
+const Type = "Contour"
+
+const (
+	// ContourAPIGroup is the API group of the Contour HTTPProxies
+	ContourAPIGroup = "projectcontour.io"
+	// ManagedRoutesAnnotation records on an HTTPProxy the routes added for the managed routes of the rollout
+	// and the services added for additional weight destinations, so they can be told apart from the routes
+	// and services owned by the user
+	ManagedRoutesAnnotation = "rollouts.argoproj.io/contour-managed-routes"
+
+	httpProxyUpdateError = "ContourHTTPProxyUpdateError"
+)
+
+// GetHTTPProxyGVR returns the GroupVersionResource of the Contour HTTPProxies
+func GetHTTPProxyGVR() schema.GroupVersionResource {
+	return schema.GroupVersionResource{
+		Group:    ContourAPIGroup,
+		Version:  "v1",
+		Resource: "httpproxies",
+	}
+}
+
+// managedRoutes tracks the routes of an HTTPProxy added for the managed routes of the rollout, identified by
+// their conditions
+var managedRoutes = trafficrouting.ManagedEntries{
+	Annotation: ManagedRoutesAnnotation,
+	Identity: func(route map[string]any) any {
+		return trafficrouting.CanonicalJSON(route["conditions"])
+	},
+}
+
+// ReconcilerConfig describes static configuration data for the Contour reconciler
+type ReconcilerConfig struct {
+	Rollout  *v1alpha1.Rollout
+	Client   dynamic.Interface
+	Recorder record.EventRecorder
+}
+
+// Reconciler holds required fields to reconcile Contour HTTPProxies
+type Reconciler struct {
+	cfg ReconcilerConfig
+	log *logrus.Entry
+}
+
+// NewReconciler returns a reconciler struct that brings the Contour HTTPProxies into the desired state
+func NewReconciler(cfg ReconcilerConfig) *Reconciler {
+	return &Reconciler{
+		cfg: cfg,
+		log: logutil.WithRollout(cfg.Rollout),
+	}
+}
+
+// Type indicates this reconciler is a Contour reconciler
+func (r *Reconciler) Type() string {
+	return Type
+}
+
+// UpdateHash informs a traffic routing reconciler about new canary/stable pod hashes
+func (r *Reconciler) UpdateHash(canaryHash, stableHash string, additionalDestinations ...v1alpha1.WeightDestination) error {
+	return nil
+}
+
+// updateProxies applies mutate to the routes of every HTTPProxy of the rollout, updating the proxies
+// whose routes or managed state changed
+func (r *Reconciler) updateProxies(mutate func(name string, routes []any, state *trafficrouting.ManagedState) ([]any, error)) error {
+	ctx := context.TODO()
+	client := r.cfg.Client.Resource(GetHTTPProxyGVR()).Namespace(r.cfg.Rollout.Namespace)
+	for _, name := range r.cfg.Rollout.Spec.Strategy.Canary.TrafficRouting.Contour.HTTPProxies {
+		proxy, err := client.Get(ctx, name, metav1.GetOptions{})
+		if err != nil {
+			return err
+		}
+		changed, err := managedRoutes.Update(proxy, func(routes []any, state *trafficrouting.ManagedState) ([]any, error) {
+			return mutate(name, routes, state)
+		}, "spec", "routes")
+		if err != nil {
+			return err
+		}
+		if !changed {
+			continue
+		}
+		if _, err := client.Update(ctx, proxy, metav1.UpdateOptions{}); err != nil {
+			msg := fmt.Sprintf("Error updating HTTPProxy %q: %s", name, err)
+			r.sendWarningEvent(httpProxyUpdateError, msg)
+			return err
+		}
+		r.log.Infof("Updated HTTPProxy %q", name)
+	}
+	return nil
+}
+
+// SetWeight modifies the services of the routes referencing both the stable and the canary service
+func (r *Reconciler) SetWeight(desiredWeight int32, additionalDestinations ...v1alpha1.WeightDestination) error {
+	canaryService := r.cfg.Rollout.Spec.Strategy.Canary.CanaryService
+	stableService := r.cfg.Rollout.Spec.Strategy.Canary.StableService
+	stableWeight := weightutil.MaxTrafficWeight(r.cfg.Rollout) - desiredWeight
+	destinations := []string{}
+	for _, dest := range additionalDestinations {
+		stableWeight -= dest.Weight
+		destinations = append(destinations, dest.ServiceName)
+	}
+
+	return r.updateProxies(func(name string, routes []any, state *trafficrouting.ManagedState) ([]any, error) {
+		weighted := 0
+		for _, route := range routes {
+			typedRoute, ok := route.(map[string]any)
+			if !ok {
+				return nil, fmt.Errorf("HTTPProxy %s has an invalid route", name)
+			}
+			if managedRoutes.ManagedRouteOf(typedRoute, state) != "" {
+				continue
+			}
+			services, _, err := unstructured.NestedSlice(typedRoute, "services")
+			if err != nil {
+				return nil, err
+			}
+			canary, stable := findService(services, canaryService), findService(services, stableService)
+			if canary == nil || stable == nil {
+				continue
+			}
+			weighted++
+			canary["weight"] = int64(desiredWeight)
+			stable["weight"] = int64(stableWeight)
+
+			services = trafficrouting.PruneDestinations(services, state, destinations)
+			for _, dest := range additionalDestinations {
+				destService := findService(services, dest.ServiceName)
+				if destService == nil {
+					destService = runtime.DeepCopyJSONValue(canary).(map[string]any)
+					destService["name"] = dest.ServiceName
+					services = append(services, destService)
+				}
+				destService["weight"] = int64(dest.Weight)
+			}
+			typedRoute["services"] = services
+		}
+		if weighted == 0 {
+			return nil, fmt.Errorf("HTTPProxy %s has no route with services to both the stable service %s and the canary service %s", name, stableService, canaryService)
+		}
+		state.Destinations = destinations
+		return routes, nil
+	})
+}
+
+// SetHeaderRoute adds, for every weighted route of the HTTPProxies, a route sending the requests matching
+// the headers to the canary service
+func (r *Reconciler) SetHeaderRoute(headerRouting *v1alpha1.SetHeaderRoute) error {
+	if headerRouting == nil {
+		return nil
+	}
+	canaryService := r.cfg.Rollout.Spec.Strategy.Canary.CanaryService
+	stableService := r.cfg.Rollout.Spec.Strategy.Canary.StableService
+	headerConditions := []any{}
+	for _, match := range headerRouting.Match {
+		headerConditions = append(headerConditions, map[string]any{
+			"header": headerCondition(match.HeaderName, match.HeaderValue),
+		})
+	}
+
+	return r.updateProxies(func(name string, routes []any, state *trafficrouting.ManagedState) ([]any, error) {
+		routes = managedRoutes.Remove(routes, state, headerRouting.Name)
+		if headerRouting.Match == nil {
+			return routes, nil
+		}
+		headerRoutes := []any{}
+		for _, route := range routes {
+			typedRoute, ok := route.(map[string]any)
+			if !ok || managedRoutes.ManagedRouteOf(typedRoute, state) != "" {
+				continue
+			}
+			services, _, _ := unstructured.NestedSlice(typedRoute, "services")
+			canary := findService(services, canaryService)
+			if canary == nil || findService(services, stableService) == nil {
+				continue
+			}
+			headerRoute := runtime.DeepCopyJSONValue(typedRoute).(map[string]any)
+			canary = runtime.DeepCopyJSONValue(canary).(map[string]any)
+			delete(canary, "weight")
+			headerRoute["services"] = []any{canary}
+			conditions, _, _ := unstructured.NestedSlice(typedRoute, "conditions")
+			headerRoute["conditions"] = append(conditions, runtime.DeepCopyJSONValue(headerConditions).([]any)...)
+			headerRoutes = append(headerRoutes, headerRoute)
+		}
+		return managedRoutes.Add(routes, state, headerRouting.Name, headerRoutes), nil
+	})
+}
+
+// SetMirrorRoute is not supported by the Contour reconciler
+func (r *Reconciler) SetMirrorRoute(setMirrorRoute *v1alpha1.SetMirrorRoute) error {
+	return nil
+}
+
+// RemoveManagedRoutes removes the routes added for the managed routes of the rollout
+func (r *Reconciler) RemoveManagedRoutes() error {
+	return r.updateProxies(func(name string, routes []any, state *trafficrouting.ManagedState) ([]any, error) {
+		for managedRoute := range state.Routes {
+			routes = managedRoutes.Remove(routes, state, managedRoute)
+		}
+		return routes, nil
+	})
+}
+
+// VerifyWeight returns true when the weighted routes of every HTTPProxy carry the desired weights and
+// Contour validated the current generation of the HTTPProxies
+func (r *Reconciler) VerifyWeight(desiredWeight int32, additionalDestinations ...v1alpha1.WeightDestination) (*bool, error) {
+	ctx := context.TODO()
+	canaryService := r.cfg.Rollout.Spec.Strategy.Canary.CanaryService
+	stableService := r.cfg.Rollout.Spec.Strategy.Canary.StableService
+	stableWeight := weightutil.MaxTrafficWeight(r.cfg.Rollout) - desiredWeight
+	for _, dest := range additionalDestinations {
+		stableWeight -= dest.Weight
+	}
+
+	client := r.cfg.Client.Resource(GetHTTPProxyGVR()).Namespace(r.cfg.Rollout.Namespace)
+	for _, name := range r.cfg.Rollout.Spec.Strategy.Canary.TrafficRouting.Contour.HTTPProxies {
+		proxy, err := client.Get(ctx, name, metav1.GetOptions{})
+		if err != nil {
+			return nil, err
+		}
+		routes, _, err := unstructured.NestedSlice(proxy.Object, "spec", "routes")
+		if err != nil {
+			return nil, err
+		}
+		for _, route := range routes {
+			services, _, _ := unstructured.NestedSlice(route.(map[string]any), "services")
+			canary, stable := findService(services, canaryService), findService(services, stableService)
+			if canary == nil || stable == nil {
+				continue
+			}
+			if serviceWeight(canary) != int64(desiredWeight) || serviceWeight(stable) != int64(stableWeight) {
+				return ptr.To(false), nil
+			}
+			for _, dest := range additionalDestinations {
+				destService := findService(services, dest.ServiceName)
+				if destService == nil || serviceWeight(destService) != int64(dest.Weight) {
+					return ptr.To(false), nil
+				}
+			}
+		}
+		if !proxyValid(proxy) {
+			r.log.Infof("HTTPProxy %q is not yet valid", name)
+			return ptr.To(false), nil
+		}
+	}
+	return ptr.To(true), nil
+}
+
+// proxyValid returns true when the Valid condition of the HTTPProxy is true for its current generation
+func proxyValid(proxy *unstructured.Unstructured) bool {
+	conditions, _, _ := unstructured.NestedSlice(proxy.Object, "status", "conditions")
+	for _, condition := range conditions {
+		typedCondition, ok := condition.(map[string]any)
+		if !ok || typedCondition["type"] != "Valid" {
+			continue
+		}
+		observedGeneration, ok, _ := unstructured.NestedInt64(typedCondition, "observedGeneration")
+		return typedCondition["status"] == string(metav1.ConditionTrue) && (!ok || observedGeneration == proxy.GetGeneration())
+	}
+	return false
+}
+
+func findService(services []any, name string) map[string]any {
+	for _, service := range services {
+		typedService, ok := service.(map[string]any)
+		if ok && typedService["name"] == name {
+			return typedService
+		}
+	}
+	return nil
+}
+
+// serviceWeight returns the weight of a route service, which defaults to 0
+func serviceWeight(service map[string]any) int64 {
+	weight, _, _ := unstructured.NestedInt64(service, "weight")
+	return weight
+}
+
+// headerCondition converts a header match of the rollout to a Contour header match condition
+func headerCondition(name string, value *v1alpha1.StringMatch) map[string]any {
+	condition := map[string]any{
+		"name": name,
+	}
+	switch {
+	case value == nil:
+		condition["present"] = true
+	case value.Exact != "":
+		condition["exact"] = value.Exact
+	case value.Prefix != "":
+		condition["regex"] = "^" + regexp.QuoteMeta(value.Prefix) + ".*"
+	case value.Regex != "":
+		condition["regex"] = value.Regex
+	}
+	return condition
+}
+
+func (r *Reconciler) sendWarningEvent(id, msg string) {
+	r.cfg.Recorder.Eventf(r.cfg.Rollout, record.EventOptions{EventType: corev1.EventTypeWarning, EventReason: id}, msg)
+}
@@ -0,0 +1,270 @@
+package contour
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/client-go/dynamic/fake"
+
+	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
+	testutil "github.com/argoproj/argo-rollouts/test/util"
+	"github.com/argoproj/argo-rollouts/utils/record"
+)
+
+const httpProxy = `
+apiVersion: projectcontour.io/v1
+kind: HTTPProxy
+metadata:
+  name: http-proxy
+  namespace: default
+  generation: 2
+spec:
+  virtualhost:
+    fqdn: app.example.com
+  routes:
+  - conditions:
+    - prefix: /
+    services:
+    - name: stable-service
+      port: 80
+      weight: 100
+    - name: canary-service
+      port: 80
+      weight: 0
+  - conditions:
+    - prefix: /admin
+    services:
+    - name: admin-service
+      port: 80
+status:
+  currentStatus: valid
+  conditions:
+  - type: Valid
+    status: "True"
+    observedGeneration: 2
+`
+
+const otherProxy = `
+apiVersion: projectcontour.io/v1
+kind: HTTPProxy
+metadata:
+  name: other-proxy
+  namespace: default
+spec:
+  routes:
+  - conditions:
+    - prefix: /api
+    services:
+    - name: stable-service
+      port: 8080
+    - name: canary-service
+      port: 8080
+`
+
+func newReconciler(names []string, proxies ...string) (*Reconciler, *fake.FakeDynamicClient) {
+	client := testutil.NewFakeDynamicClientFromYAML(proxies...)
+	r := NewReconciler(ReconcilerConfig{
+		Rollout: testutil.NewCanaryRollout(&v1alpha1.RolloutTrafficRouting{
+			Contour: &v1alpha1.ContourTrafficRouting{HTTPProxies: names},
+		}),
+		Client:   client,
+		Recorder: record.NewFakeEventRecorder(),
+	})
+	return r, client
+}
+
+func getProxy(t *testing.T, client *fake.FakeDynamicClient, name string) *unstructured.Unstructured {
+	t.Helper()
+	proxy, err := client.Resource(GetHTTPProxyGVR()).Namespace("default").Get(context.TODO(), name, metav1.GetOptions{})
+	assert.NoError(t, err)
+	return proxy
+}
+
+func getRoutes(t *testing.T, client *fake.FakeDynamicClient, name string) []any {
+	t.Helper()
+	routes, _, err := unstructured.NestedSlice(getProxy(t, client, name).Object, "spec", "routes")
+	assert.NoError(t, err)
+	return routes
+}
+
+// serviceWeights returns the weights of the services of a route by service name
+func serviceWeights(route any) map[string]int64 {
+	weights := map[string]int64{}
+	services, _, _ := unstructured.NestedSlice(route.(map[string]any), "services")
+	for _, service := range services {
+		typed := service.(map[string]any)
+		weights[typed["name"].(string)] = serviceWeight(typed)
+	}
+	return weights
+}
+
+func TestType(t *testing.T) {
+	r, _ := newReconciler([]string{"http-proxy"})
+	assert.Equal(t, Type, r.Type())
+	assert.NoError(t, r.UpdateHash("canary", "stable"))
+	assert.NoError(t, r.SetMirrorRoute(&v1alpha1.SetMirrorRoute{Name: "mirror-route"}))
+}
+
+func TestSetWeight(t *testing.T) {
+	r, client := newReconciler([]string{"http-proxy", "other-proxy"}, httpProxy, otherProxy)
+	assert.NoError(t, r.SetWeight(30))
+
+	routes := getRoutes(t, client, "http-proxy")
+	assert.Equal(t, map[string]int64{"stable-service": 70, "canary-service": 30}, serviceWeights(routes[0]))
+	assert.Equal(t, map[string]int64{"admin-service": 0}, serviceWeights(routes[1]))
+	routes = getRoutes(t, client, "other-proxy")
+	assert.Equal(t, map[string]int64{"stable-service": 70, "canary-service": 30}, serviceWeights(routes[0]))
+	assert.Equal(t, 2, testutil.CountUpdates(client))
+
+	// proxies already at the desired weight are not updated
+	assert.NoError(t, r.SetWeight(30))
+	assert.Equal(t, 2, testutil.CountUpdates(client))
+}
+
+func TestSetWeightWithoutWeightedRoute(t *testing.T) {
+	r, _ := newReconciler([]string{"http-proxy"}, httpProxy)
+	r.cfg.Rollout.Spec.Strategy.Canary.CanaryService = "other-service"
+	err := r.SetWeight(30)
+	assert.EqualError(t, err, "HTTPProxy http-proxy has no route with services to both the stable service stable-service and the canary service other-service")
+}
+
+func TestSetWeightMissingProxy(t *testing.T) {
+	r, _ := newReconciler([]string{"http-proxy"})
+	assert.Error(t, r.SetWeight(30))
+}
+
+func TestSetWeightUpdateError(t *testing.T) {
+	r, client := newReconciler([]string{"http-proxy"}, httpProxy)
+	testutil.FailUpdates(client, "httpproxies")
+	recorder := record.NewFakeEventRecorder()
+	r.cfg.Recorder = recorder
+	assert.EqualError(t, r.SetWeight(30), testutil.UpdateError)
+	assert.Equal(t, []string{httpProxyUpdateError}, recorder.Events())
+}
+
+func TestSetWeightWithAdditionalDestinations(t *testing.T) {
+	r, client := newReconciler([]string{"http-proxy"}, httpProxy)
+	assert.NoError(t, r.SetWeight(20, v1alpha1.WeightDestination{ServiceName: "experiment-service", Weight: 10}))
+
+	proxy := getProxy(t, client, "http-proxy")
+	routes, _, _ := unstructured.NestedSlice(proxy.Object, "spec", "routes")
+	assert.Equal(t, map[string]int64{"stable-service": 70, "canary-service": 20, "experiment-service": 10}, serviceWeights(routes[0]))
+	assert.Equal(t, `{"destinations":["experiment-service"]}`, proxy.GetAnnotations()[ManagedRoutesAnnotation])
+
+	verified, err := r.VerifyWeight(20, v1alpha1.WeightDestination{ServiceName: "experiment-service", Weight: 10})
+	assert.NoError(t, err)
+	assert.True(t, *verified)
+
+	// the service of the experiment is removed once the experiment is over
+	assert.NoError(t, r.SetWeight(20))
+	proxy = getProxy(t, client, "http-proxy")
+	routes, _, _ = unstructured.NestedSlice(proxy.Object, "spec", "routes")
+	assert.Equal(t, map[string]int64{"stable-service": 80, "canary-service": 20}, serviceWeights(routes[0]))
+	assert.NotContains(t, proxy.GetAnnotations(), ManagedRoutesAnnotation)
+}
+
+func TestSetHeaderRoute(t *testing.T) {
+	r, client := newReconciler([]string{"http-proxy", "other-proxy"}, httpProxy, otherProxy)
+	headerRoute := &v1alpha1.SetHeaderRoute{
+		Name: "header-route",
+		Match: []v1alpha1.HeaderRoutingMatch{
+			{HeaderName: "agent", HeaderValue: &v1alpha1.StringMatch{Exact: "chrome"}},
+			{HeaderName: "version", HeaderValue: &v1alpha1.StringMatch{Prefix: "2."}},
+			{HeaderName: "build", HeaderValue: &v1alpha1.StringMatch{Regex: "[0-9]+"}},
+		},
+	}
+	assert.NoError(t, r.SetHeaderRoute(headerRoute))
+	assert.Equal(t, 2, testutil.CountUpdates(client))
+
+	routes := getRoutes(t, client, "http-proxy")
+	assert.Len(t, routes, 3)
+	assert.Equal(t, map[string]any{
+		"conditions": []any{
+			map[string]any{"prefix": "/"},
+			map[string]any{"header": map[string]any{"name": "agent", "exact": "chrome"}},
+			map[string]any{"header": map[string]any{"name": "version", "regex": `^2\..*`}},
+			map[string]any{"header": map[string]any{"name": "build", "regex": "[0-9]+"}},
+		},
+		"services": []any{map[string]any{"name": "canary-service", "port": int64(80)}},
+	}, routes[2])
+	assert.Len(t, getRoutes(t, client, "other-proxy"), 2)
+
+	// setting the same header route again changes nothing
+	assert.NoError(t, r.SetHeaderRoute(headerRoute))
+	assert.Equal(t, 2, testutil.CountUpdates(client))
+
+	// weights only apply to the routes of the user
+	assert.NoError(t, r.SetWeight(40))
+	routes = getRoutes(t, client, "http-proxy")
+	assert.Equal(t, map[string]int64{"stable-service": 60, "canary-service": 40}, serviceWeights(routes[0]))
+	assert.Equal(t, map[string]int64{"canary-service": 0}, serviceWeights(routes[2]))
+
+	// a header route without matches removes its routes
+	assert.NoError(t, r.SetHeaderRoute(&v1alpha1.SetHeaderRoute{Name: "header-route"}))
+	assert.Len(t, getRoutes(t, client, "http-proxy"), 2)
+	assert.Len(t, getRoutes(t, client, "other-proxy"), 1)
+	assert.NotContains(t, getProxy(t, client, "http-proxy").GetAnnotations(), ManagedRoutesAnnotation)
+}
+
+func TestRemoveManagedRoutes(t *testing.T) {
+	r, client := newReconciler([]string{"http-proxy"}, httpProxy)
+	assert.NoError(t, r.SetHeaderRoute(&v1alpha1.SetHeaderRoute{
+		Name:  "header-route",
+		Match: []v1alpha1.HeaderRoutingMatch{{HeaderName: "canary"}},
+	}))
+	routes := getRoutes(t, client, "http-proxy")
+	assert.Len(t, routes, 3)
+	assert.Equal(t, []any{
+		map[string]any{"prefix": "/"},
+		map[string]any{"header": map[string]any{"name": "canary", "present": true}},
+	}, routes[2].(map[string]any)["conditions"])
+
+	assert.NoError(t, r.RemoveManagedRoutes())
+	assert.Len(t, getRoutes(t, client, "http-proxy"), 2)
+	assert.NotContains(t, getProxy(t, client, "http-proxy").GetAnnotations(), ManagedRoutesAnnotation)
+
+	// nothing to remove
+	updates := testutil.CountUpdates(client)
+	assert.NoError(t, r.RemoveManagedRoutes())
+	assert.Equal(t, updates, testutil.CountUpdates(client))
+}
+
+func TestVerifyWeight(t *testing.T) {
+	t.Run("weights applied and proxy valid", func(t *testing.T) {
+		r, _ := newReconciler([]string{"http-proxy"}, httpProxy)
+		assert.NoError(t, r.SetWeight(30))
+		verified, err := r.VerifyWeight(30)
+		assert.NoError(t, err)
+		assert.True(t, *verified)
+	})
+
+	t.Run("weights not applied", func(t *testing.T) {
+		r, _ := newReconciler([]string{"http-proxy"}, httpProxy)
+		verified, err := r.VerifyWeight(30)
+		assert.NoError(t, err)
+		assert.False(t, *verified)
+	})
+
+	t.Run("proxy not yet valid for the current generation", func(t *testing.T) {
+		r, client := newReconciler([]string{"http-proxy"}, httpProxy)
+		assert.NoError(t, r.SetWeight(30))
+		proxy := getProxy(t, client, "http-proxy")
+		proxy.SetGeneration(3)
+		_, err := client.Resource(GetHTTPProxyGVR()).Namespace("default").Update(context.TODO(), proxy, metav1.UpdateOptions{})
+		assert.NoError(t, err)
+		verified, err := r.VerifyWeight(30)
+		assert.NoError(t, err)
+		assert.False(t, *verified)
+	})
+
+	t.Run("proxy without status", func(t *testing.T) {
+		r, _ := newReconciler([]string{"other-proxy"}, otherProxy)
+		assert.NoError(t, r.SetWeight(30))
+		verified, err := r.VerifyWeight(30)
+		assert.NoError(t, err)
+		assert.False(t, *verified)
+	})
+}
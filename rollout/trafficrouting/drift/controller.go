@@ -0,0 +1,195 @@
+package drift
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/dynamic/dynamicinformer"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/kubectl/pkg/cmd/util"
+
+	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
+	informers "github.com/argoproj/argo-rollouts/pkg/client/informers/externalversions/rollouts/v1alpha1"
+	"github.com/argoproj/argo-rollouts/rollout/trafficrouting/ambassador"
+	"github.com/argoproj/argo-rollouts/rollout/trafficrouting/smi"
+	unstructuredutil "github.com/argoproj/argo-rollouts/utils/unstructured"
+)
+
+const (
+	// names for the rollout indexer
+	mappingIndexName      = "byAmbassadorMapping"
+	trafficSplitIndexName = "byTrafficSplit"
+
+	// how often to check whether a managed resource's API has been installed
+	resourceDiscoveryInterval = 10 * time.Minute
+)
+
+type ControllerConfig struct {
+	DynamicClientSet dynamic.Interface
+	// DynamicInformerFactory provides the informers of the managed resources. It must not filter
+	// by the instance ID label, which the traffic routing resources do not carry.
+	DynamicInformerFactory dynamicinformer.DynamicSharedInformerFactory
+	Namespace              string
+	EnqueueRollout         func(ro any)
+	RolloutsInformer       informers.RolloutInformer
+}
+
+// Controller watches the traffic routing resources managed by rollouts (Ambassador canary Mappings
+// and SMI TrafficSplits) and enqueues the owning rollout whenever one of them is modified or
+// deleted, so that drift from the desired weights is detected without waiting for a resync.
+// Istio VirtualServices are watched by the IstioController.
+type Controller struct {
+	ControllerConfig
+	resources []*managedResource
+}
+
+type managedResource struct {
+	kind      string
+	gvr       schema.GroupVersionResource
+	indexName string
+	// informer is only created once the resource's API is found to be installed
+	informer cache.SharedIndexInformer
+}
+
+func NewController(cfg ControllerConfig) *Controller {
+	c := Controller{
+		ControllerConfig: cfg,
+	}
+	c.addResource("Mapping", ambassador.GetMappingGVR(), mappingIndexName, GetRolloutMappingKeys)
+	c.addResource("TrafficSplit", smi.GetTrafficSplitGVR(), trafficSplitIndexName, GetRolloutTrafficSplitKeys)
+	return &c
+}
+
+func (c *Controller) addResource(kind string, gvr schema.GroupVersionResource, indexName string, keyFunc func(ro *v1alpha1.Rollout) []string) {
+	r := &managedResource{
+		kind:      kind,
+		gvr:       gvr,
+		indexName: indexName,
+	}
+	util.CheckErr(c.RolloutsInformer.Informer().AddIndexers(cache.Indexers{
+		indexName: func(obj any) (strings []string, e error) {
+			if ro := unstructuredutil.ObjectToRollout(obj); ro != nil {
+				return keyFunc(ro), nil
+			}
+			return
+		},
+	}))
+	c.resources = append(c.resources, r)
+}
+
+// watchResource gets the informer of the managed resource from the shared factory and registers
+// the handlers which enqueue the owning rollouts
+func (c *Controller) watchResource(r *managedResource) {
+	r.informer = c.DynamicInformerFactory.ForResource(r.gvr).Informer()
+	r.informer.AddEventHandler(cache.ResourceEventHandlerFuncs{
+		UpdateFunc: func(old, new any) {
+			oldAcc, err := meta.Accessor(old)
+			if err != nil {
+				return
+			}
+			newAcc, err := meta.Accessor(new)
+			if err != nil {
+				return
+			}
+			if oldAcc.GetResourceVersion() == newAcc.GetResourceVersion() {
+				// Periodic resync will send update events for all known objects, which the
+				// periodic rollout resync already covers
+				return
+			}
+			c.enqueueRolloutFromResource(r, new)
+		},
+		DeleteFunc: func(obj any) {
+			c.enqueueRolloutFromResource(r, obj)
+		},
+	})
+}
+
+// Run starts an informer for each managed resource whose API is installed in the cluster. APIs
+// which are not installed are periodically checked for, so that the informers can be started
+// regardless of the order in which the service mesh and Argo Rollouts were installed. Informers
+// are only requested from the shared factory once their API exists and are run here rather than
+// through the factory's Start, which would also start the factory's other informers (e.g. the
+// Istio ones when Istio is not installed).
+func (c *Controller) Run(ctx context.Context) {
+	wg := sync.WaitGroup{}
+	for _, r := range c.resources {
+		wg.Add(1)
+		go func(r *managedResource) {
+			defer wg.Done()
+			c.runInformer(ctx, r)
+		}(r)
+	}
+	wg.Wait()
+	log.Info("Traffic routing drift watchers have stopped")
+}
+
+func (c *Controller) runInformer(ctx context.Context, r *managedResource) {
+	if !c.resourceExists(r) {
+		ticker := time.NewTicker(resourceDiscoveryInterval)
+		for !c.resourceExists(r) {
+			select {
+			case <-ctx.Done():
+				ticker.Stop()
+				return
+			case <-ticker.C:
+			}
+		}
+		ticker.Stop()
+	}
+	log.Infof("Watching %s resources for traffic routing drift", r.kind)
+	c.watchResource(r)
+	r.informer.Run(ctx.Done())
+}
+
+func (c *Controller) resourceExists(r *managedResource) bool {
+	_, err := c.DynamicClientSet.Resource(r.gvr).Namespace(c.Namespace).List(context.TODO(), metav1.ListOptions{Limit: 1})
+	return err == nil
+}
+
+// enqueueRolloutFromResource finds the rollouts managing the given resource and enqueues them for
+// reconciliation
+func (c *Controller) enqueueRolloutFromResource(r *managedResource, obj any) {
+	if tombstone, ok := obj.(cache.DeletedFinalStateUnknown); ok {
+		obj = tombstone.Obj
+	}
+	acc, err := meta.Accessor(obj)
+	if err != nil {
+		log.Errorf("Error processing %s from watch: %v: %v", r.kind, err, obj)
+		return
+	}
+	rolloutsToEnqueue, err := c.RolloutsInformer.Informer().GetIndexer().ByIndex(r.indexName, fmt.Sprintf("%s/%s", acc.GetNamespace(), acc.GetName()))
+	if err != nil {
+		log.Errorf("Cannot process indexer: %s", err.Error())
+		return
+	}
+	for i := range rolloutsToEnqueue {
+		c.EnqueueRollout(rolloutsToEnqueue[i])
+	}
+}
+
+// GetRolloutMappingKeys returns the keys of the canary Ambassador Mappings managed by the rollout
+func GetRolloutMappingKeys(ro *v1alpha1.Rollout) []string {
+	if ro.Spec.Strategy.Canary == nil || ro.Spec.Strategy.Canary.TrafficRouting == nil || ro.Spec.Strategy.Canary.TrafficRouting.Ambassador == nil {
+		return nil
+	}
+	var keys []string
+	for _, mapping := range ro.Spec.Strategy.Canary.TrafficRouting.Ambassador.Mappings {
+		keys = append(keys, fmt.Sprintf("%s/%s", ro.Namespace, ambassador.CanaryMappingName(mapping)))
+	}
+	return keys
+}
+
+// GetRolloutTrafficSplitKeys returns the key of the SMI TrafficSplit managed by the rollout
+func GetRolloutTrafficSplitKeys(ro *v1alpha1.Rollout) []string {
+	if ro.Spec.Strategy.Canary == nil || ro.Spec.Strategy.Canary.TrafficRouting == nil || ro.Spec.Strategy.Canary.TrafficRouting.SMI == nil {
+		return nil
+	}
+	return []string{fmt.Sprintf("%s/%s", ro.Namespace, smi.TrafficSplitName(ro))}
+}
@@ -0,0 +1,186 @@
+package drift
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/dynamic/dynamicinformer"
+	dynamicfake "k8s.io/client-go/dynamic/fake"
+	k8stesting "k8s.io/client-go/testing"
+	"k8s.io/client-go/tools/cache"
+
+	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
+	rolloutfake "github.com/argoproj/argo-rollouts/pkg/client/clientset/versioned/fake"
+	rolloutinformers "github.com/argoproj/argo-rollouts/pkg/client/informers/externalversions"
+	"github.com/argoproj/argo-rollouts/rollout/trafficrouting/ambassador"
+	"github.com/argoproj/argo-rollouts/rollout/trafficrouting/smi"
+	unstructuredutil "github.com/argoproj/argo-rollouts/utils/unstructured"
+)
+
+const canaryMapping = `apiVersion: getambassador.io/v2
+kind: Mapping
+metadata:
+  name: myapp-mapping-canary
+  namespace: default
+spec:
+  prefix: /myapp/
+  service: canary-service
+  weight: 20`
+
+const trafficSplit = `apiVersion: split.smi-spec.io/v1alpha1
+kind: TrafficSplit
+metadata:
+  name: traffic-split
+  namespace: default
+spec:
+  service: root-service
+  backends:
+  - service: stable-service
+    weight: 80
+  - service: canary-service
+    weight: 20`
+
+func newRollout(trafficRouting *v1alpha1.RolloutTrafficRouting) *v1alpha1.Rollout {
+	return &v1alpha1.Rollout{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "rollout",
+			Namespace: metav1.NamespaceDefault,
+		},
+		Spec: v1alpha1.RolloutSpec{
+			Strategy: v1alpha1.RolloutStrategy{
+				Canary: &v1alpha1.CanaryStrategy{
+					StableService:  "stable-service",
+					CanaryService:  "canary-service",
+					TrafficRouting: trafficRouting,
+				},
+			},
+		},
+	}
+}
+
+func newFakeController(t *testing.T, rollouts ...*v1alpha1.Rollout) (*Controller, *[]any) {
+	var enqueued []any
+	rolloutClient := rolloutfake.NewSimpleClientset()
+	rolloutInformerFactory := rolloutinformers.NewSharedInformerFactory(rolloutClient, 0)
+	dynamicClient := dynamicfake.NewSimpleDynamicClientWithCustomListKinds(runtime.NewScheme(), map[schema.GroupVersionResource]string{
+		ambassador.GetMappingGVR(): "MappingList",
+		smi.GetTrafficSplitGVR():   "TrafficSplitList",
+	})
+	c := NewController(ControllerConfig{
+		DynamicClientSet:       dynamicClient,
+		DynamicInformerFactory: dynamicinformer.NewDynamicSharedInformerFactory(dynamicClient, 0),
+		Namespace:              metav1.NamespaceAll,
+		EnqueueRollout: func(ro any) {
+			enqueued = append(enqueued, ro)
+		},
+		RolloutsInformer: rolloutInformerFactory.Argoproj().V1alpha1().Rollouts(),
+	})
+	for _, ro := range rollouts {
+		assert.NoError(t, rolloutInformerFactory.Argoproj().V1alpha1().Rollouts().Informer().GetIndexer().Add(ro))
+	}
+	return c, &enqueued
+}
+
+func (c *Controller) resource(kind string) *managedResource {
+	for _, r := range c.resources {
+		if r.kind == kind {
+			return r
+		}
+	}
+	return nil
+}
+
+func TestGetRolloutMappingKeys(t *testing.T) {
+	assert.Nil(t, GetRolloutMappingKeys(newRollout(nil)))
+	ro := newRollout(&v1alpha1.RolloutTrafficRouting{
+		Ambassador: &v1alpha1.AmbassadorTrafficRouting{
+			Mappings: []string{"myapp-mapping", "other-mapping"},
+		},
+	})
+	assert.Equal(t, []string{"default/myapp-mapping-canary", "default/other-mapping-canary"}, GetRolloutMappingKeys(ro))
+}
+
+func TestGetRolloutTrafficSplitKeys(t *testing.T) {
+	assert.Nil(t, GetRolloutTrafficSplitKeys(newRollout(nil)))
+	ro := newRollout(&v1alpha1.RolloutTrafficRouting{SMI: &v1alpha1.SMITrafficRouting{}})
+	assert.Equal(t, []string{"default/rollout"}, GetRolloutTrafficSplitKeys(ro))
+	ro.Spec.Strategy.Canary.TrafficRouting.SMI.TrafficSplitName = "traffic-split"
+	assert.Equal(t, []string{"default/traffic-split"}, GetRolloutTrafficSplitKeys(ro))
+}
+
+func TestEnqueueRolloutFromMapping(t *testing.T) {
+	ro := newRollout(&v1alpha1.RolloutTrafficRouting{
+		Ambassador: &v1alpha1.AmbassadorTrafficRouting{
+			Mappings: []string{"myapp-mapping"},
+		},
+	})
+	c, enqueued := newFakeController(t, ro)
+	r := c.resource("Mapping")
+	mapping := unstructuredutil.StrToUnstructuredUnsafe(canaryMapping)
+
+	c.enqueueRolloutFromResource(r, mapping)
+	assert.Len(t, *enqueued, 1)
+	assert.Equal(t, ro, (*enqueued)[0])
+
+	c.enqueueRolloutFromResource(r, cache.DeletedFinalStateUnknown{Key: "default/myapp-mapping-canary", Obj: mapping})
+	assert.Len(t, *enqueued, 2)
+
+	other := mapping.DeepCopy()
+	other.SetName("myapp-mapping")
+	c.enqueueRolloutFromResource(r, other)
+	assert.Len(t, *enqueued, 2)
+}
+
+func TestEnqueueRolloutFromTrafficSplit(t *testing.T) {
+	ro := newRollout(&v1alpha1.RolloutTrafficRouting{
+		SMI: &v1alpha1.SMITrafficRouting{
+			TrafficSplitName: "traffic-split",
+		},
+	})
+	c, enqueued := newFakeController(t, ro)
+	r := c.resource("TrafficSplit")
+	ts := unstructuredutil.StrToUnstructuredUnsafe(trafficSplit)
+
+	c.enqueueRolloutFromResource(r, ts)
+	assert.Len(t, *enqueued, 1)
+	assert.Equal(t, ro, (*enqueued)[0])
+
+	// a mapping with the same name must not enqueue the rollout
+	c.enqueueRolloutFromResource(c.resource("Mapping"), ts)
+	assert.Len(t, *enqueued, 1)
+}
+
+func TestResourceExists(t *testing.T) {
+	c, _ := newFakeController(t)
+	assert.True(t, c.resourceExists(c.resource("Mapping")))
+	assert.True(t, c.resourceExists(c.resource("TrafficSplit")))
+
+	c.DynamicClientSet.(*dynamicfake.FakeDynamicClient).PrependReactor("list", "trafficsplits", func(action k8stesting.Action) (bool, runtime.Object, error) {
+		return true, nil, k8serrors.NewNotFound(schema.GroupResource{Group: "split.smi-spec.io", Resource: "trafficsplits"}, "")
+	})
+	assert.False(t, c.resourceExists(c.resource("TrafficSplit")))
+}
+
+func TestRunInformerUsesSharedFactory(t *testing.T) {
+	c, _ := newFakeController(t)
+	r := c.resource("Mapping")
+	assert.Nil(t, r.informer)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	c.runInformer(ctx, r)
+	assert.Same(t, c.DynamicInformerFactory.ForResource(ambassador.GetMappingGVR()).Informer(), r.informer)
+
+	// informers are not requested for APIs which are not installed
+	c.DynamicClientSet.(*dynamicfake.FakeDynamicClient).PrependReactor("list", "trafficsplits", func(action k8stesting.Action) (bool, runtime.Object, error) {
+		return true, nil, k8serrors.NewNotFound(schema.GroupResource{Group: "split.smi-spec.io", Resource: "trafficsplits"}, "")
+	})
+	r = c.resource("TrafficSplit")
+	c.runInformer(ctx, r)
+	assert.Nil(t, r.informer)
+}
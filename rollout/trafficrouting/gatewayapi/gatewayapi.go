@@ -0,0 +1,514 @@
+package gatewayapi
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"sort"
+
+	"github.com/sirupsen/logrus"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/utils/ptr"
+
+	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
+	"github.com/argoproj/argo-rollouts/rollout/trafficrouting"
+	logutil "github.com/argoproj/argo-rollouts/utils/log"
+	"github.com/argoproj/argo-rollouts/utils/record"
+	"github.com/argoproj/argo-rollouts/utils/weightutil"
+)
+
+// Type holds this controller type
+const Type = "GatewayAPI"
+
+const (
+	// GatewayAPIGroup is the API group of the Gateway API routes
+	GatewayAPIGroup = "gateway.networking.k8s.io"
+	// ManagedRoutesAnnotation records on a route the rules added for the managed routes of the rollout
+	// and the backends added for additional weight destinations, so they can be told apart from the
+	// rules and backends owned by the user
+	ManagedRoutesAnnotation = "rollouts.argoproj.io/gatewayapi-managed-routes"
+
+	gatewayAPIRouteUpdateError = "GatewayAPIRouteUpdateError"
+)
+
+var (
+	httpRouteGVR = schema.GroupVersionResource{Group: GatewayAPIGroup, Version: "v1", Resource: "httproutes"}
+	grpcRouteGVR = schema.GroupVersionResource{Group: GatewayAPIGroup, Version: "v1", Resource: "grpcroutes"}
+	tcpRouteGVR  = schema.GroupVersionResource{Group: GatewayAPIGroup, Version: "v1alpha2", Resource: "tcproutes"}
+)
+
+// routeKind describes what a kind of Gateway API route supports
+type routeKind struct {
+	kind string
+	gvr  schema.GroupVersionResource
+	// headerMatches is true when the rules of the route can match on request headers
+	headerMatches bool
+	// mirroring is true when the rules of the route can mirror HTTP requests
+	mirroring bool
+}
+
+var (
+	httpRouteKind = routeKind{kind: "HTTPRoute", gvr: httpRouteGVR, headerMatches: true, mirroring: true}
+	grpcRouteKind = routeKind{kind: "GRPCRoute", gvr: grpcRouteGVR, headerMatches: true}
+	tcpRouteKind  = routeKind{kind: "TCPRoute", gvr: tcpRouteGVR}
+)
+
+// managedRules tracks the rules of a route added for the managed routes of the rollout, identified by
+// their matches and filters
+var managedRules = trafficrouting.ManagedEntries{
+	Annotation: ManagedRoutesAnnotation,
+	Identity: func(rule map[string]any) any {
+		identity := map[string]any{}
+		for _, field := range []string{"matches", "filters"} {
+			if value, ok := rule[field]; ok {
+				identity[field] = value
+			}
+		}
+		return trafficrouting.CanonicalJSON(identity)
+	},
+}
+
+// ReconcilerConfig describes static configuration data for the Gateway API reconciler
+type ReconcilerConfig struct {
+	Rollout  *v1alpha1.Rollout
+	Client   dynamic.Interface
+	Recorder record.EventRecorder
+}
+
+// Reconciler holds required fields to reconcile Gateway API routes
+type Reconciler struct {
+	cfg ReconcilerConfig
+	log *logrus.Entry
+}
+
+// NewReconciler returns a reconciler struct that brings the Gateway API routes into the desired state
+func NewReconciler(cfg ReconcilerConfig) *Reconciler {
+	return &Reconciler{
+		cfg: cfg,
+		log: logutil.WithRollout(cfg.Rollout),
+	}
+}
+
+// Type indicates this reconciler is a Gateway API reconciler
+func (r *Reconciler) Type() string {
+	return Type
+}
+
+// UpdateHash informs a traffic routing reconciler about new canary/stable pod hashes
+func (r *Reconciler) UpdateHash(canaryHash, stableHash string, additionalDestinations ...v1alpha1.WeightDestination) error {
+	return nil
+}
+
+// routes returns the kinds and names of the routes referenced by the rollout
+func (r *Reconciler) routes() ([]routeKind, []string) {
+	gatewayAPI := r.cfg.Rollout.Spec.Strategy.Canary.TrafficRouting.GatewayAPI
+	kinds := []routeKind{}
+	names := []string{}
+	for _, route := range []struct {
+		kind routeKind
+		name string
+	}{
+		{httpRouteKind, gatewayAPI.HTTPRoute},
+		{grpcRouteKind, gatewayAPI.GRPCRoute},
+		{tcpRouteKind, gatewayAPI.TCPRoute},
+	} {
+		if route.name != "" {
+			kinds = append(kinds, route.kind)
+			names = append(names, route.name)
+		}
+	}
+	return kinds, names
+}
+
+// updateRoutes applies mutate to the rules of every route accepted by the filter, updating the routes
+// whose rules or managed state changed
+func (r *Reconciler) updateRoutes(filter func(kind routeKind) bool, mutate func(kind routeKind, name string, rules []any, state *trafficrouting.ManagedState) ([]any, error)) error {
+	ctx := context.TODO()
+	kinds, names := r.routes()
+	for i, kind := range kinds {
+		if !filter(kind) {
+			continue
+		}
+		client := r.cfg.Client.Resource(kind.gvr).Namespace(r.cfg.Rollout.Namespace)
+		route, err := client.Get(ctx, names[i], metav1.GetOptions{})
+		if err != nil {
+			return err
+		}
+		changed, err := managedRules.Update(route, func(rules []any, state *trafficrouting.ManagedState) ([]any, error) {
+			return mutate(kind, names[i], rules, state)
+		}, "spec", "rules")
+		if err != nil {
+			return err
+		}
+		if !changed {
+			continue
+		}
+		if _, err := client.Update(ctx, route, metav1.UpdateOptions{}); err != nil {
+			msg := fmt.Sprintf("Error updating %s %q: %s", kind.kind, route.GetName(), err)
+			r.sendWarningEvent(gatewayAPIRouteUpdateError, msg)
+			return err
+		}
+		r.log.Infof("Updated %s %q", kind.kind, route.GetName())
+	}
+	return nil
+}
+
+func allRoutes(routeKind) bool {
+	return true
+}
+
+// SetWeight modifies the backendRefs of the route rules referencing both the stable and the canary service
+func (r *Reconciler) SetWeight(desiredWeight int32, additionalDestinations ...v1alpha1.WeightDestination) error {
+	canaryService := r.cfg.Rollout.Spec.Strategy.Canary.CanaryService
+	stableService := r.cfg.Rollout.Spec.Strategy.Canary.StableService
+	stableWeight := weightutil.MaxTrafficWeight(r.cfg.Rollout) - desiredWeight
+	destinations := []string{}
+	for _, dest := range additionalDestinations {
+		stableWeight -= dest.Weight
+		destinations = append(destinations, dest.ServiceName)
+	}
+
+	return r.updateRoutes(allRoutes, func(kind routeKind, name string, rules []any, state *trafficrouting.ManagedState) ([]any, error) {
+		weighted := 0
+		for _, rule := range rules {
+			typedRule, ok := rule.(map[string]any)
+			if !ok {
+				return nil, fmt.Errorf("%s %s has an invalid rule", kind.kind, name)
+			}
+			backendRefs, _, err := unstructured.NestedSlice(typedRule, "backendRefs")
+			if err != nil {
+				return nil, err
+			}
+			canaryRef, stableRef := findBackendRef(backendRefs, canaryService), findBackendRef(backendRefs, stableService)
+			if canaryRef == nil || stableRef == nil {
+				continue
+			}
+			weighted++
+			canaryRef["weight"] = int64(desiredWeight)
+			stableRef["weight"] = int64(stableWeight)
+
+			backendRefs = trafficrouting.PruneDestinations(backendRefs, state, destinations)
+			for _, dest := range additionalDestinations {
+				destRef := findBackendRef(backendRefs, dest.ServiceName)
+				if destRef == nil {
+					destRef = runtimeCopy(canaryRef)
+					destRef["name"] = dest.ServiceName
+					backendRefs = append(backendRefs, destRef)
+				}
+				destRef["weight"] = int64(dest.Weight)
+			}
+			typedRule["backendRefs"] = backendRefs
+		}
+		if weighted == 0 {
+			return nil, fmt.Errorf("%s %s has no rule with backendRefs to both the stable service %s and the canary service %s", kind.kind, name, stableService, canaryService)
+		}
+		state.Destinations = destinations
+		return rules, nil
+	})
+}
+
+// SetHeaderRoute adds, for every weighted rule of the HTTPRoute and GRPCRoute, a rule sending the
+// requests matching the headers to the canary service
+func (r *Reconciler) SetHeaderRoute(headerRouting *v1alpha1.SetHeaderRoute) error {
+	if headerRouting == nil {
+		return nil
+	}
+	canaryService := r.cfg.Rollout.Spec.Strategy.Canary.CanaryService
+	stableService := r.cfg.Rollout.Spec.Strategy.Canary.StableService
+	headers := []any{}
+	for _, match := range headerRouting.Match {
+		headers = append(headers, headerMatch(match.HeaderName, match.HeaderValue))
+	}
+
+	return r.updateRoutes(func(kind routeKind) bool { return kind.headerMatches }, func(kind routeKind, name string, rules []any, state *trafficrouting.ManagedState) ([]any, error) {
+		rules = managedRules.Remove(rules, state, headerRouting.Name)
+		if headerRouting.Match == nil {
+			return rules, nil
+		}
+		headerRules := []any{}
+		for _, rule := range weightedRules(rules, state, canaryService, stableService) {
+			headerRule := runtimeCopy(rule)
+			// rule names must be unique within a route
+			delete(headerRule, "name")
+			backendRefs, _, _ := unstructured.NestedSlice(rule, "backendRefs")
+			canaryRef := runtimeCopy(findBackendRef(backendRefs, canaryService))
+			delete(canaryRef, "weight")
+			headerRule["backendRefs"] = []any{canaryRef}
+
+			matches, _, _ := unstructured.NestedSlice(rule, "matches")
+			if len(matches) == 0 {
+				matches = []any{map[string]any{}}
+			}
+			for _, match := range matches {
+				typedMatch := match.(map[string]any)
+				if kind.kind == httpRouteKind.kind {
+					if _, ok := typedMatch["path"]; !ok {
+						typedMatch["path"] = defaultPathMatch()
+					}
+				}
+				matchHeaders, _, _ := unstructured.NestedSlice(typedMatch, "headers")
+				typedMatch["headers"] = append(matchHeaders, runtimeSliceCopy(headers)...)
+			}
+			headerRule["matches"] = matches
+			headerRules = append(headerRules, headerRule)
+		}
+		return managedRules.Add(rules, state, headerRouting.Name, headerRules), nil
+	})
+}
+
+// SetMirrorRoute adds, for every weighted rule of the HTTPRoute, a rule mirroring the matching requests
+// to the canary service
+func (r *Reconciler) SetMirrorRoute(setMirrorRoute *v1alpha1.SetMirrorRoute) error {
+	if setMirrorRoute == nil {
+		return nil
+	}
+	canaryService := r.cfg.Rollout.Spec.Strategy.Canary.CanaryService
+	stableService := r.cfg.Rollout.Spec.Strategy.Canary.StableService
+	matches := []any{}
+	for _, match := range setMirrorRoute.Match {
+		httpMatch, err := httpRouteMatch(match)
+		if err != nil {
+			return err
+		}
+		matches = append(matches, httpMatch)
+	}
+
+	return r.updateRoutes(func(kind routeKind) bool { return kind.mirroring }, func(kind routeKind, name string, rules []any, state *trafficrouting.ManagedState) ([]any, error) {
+		rules = managedRules.Remove(rules, state, setMirrorRoute.Name)
+		if setMirrorRoute.Match == nil {
+			return rules, nil
+		}
+		mirrorRules := []any{}
+		for _, rule := range weightedRules(rules, state, canaryService, stableService) {
+			mirrorRule := runtimeCopy(rule)
+			delete(mirrorRule, "name")
+			mirrorRule["matches"] = runtimeSliceCopy(matches)
+			backendRefs, _, _ := unstructured.NestedSlice(rule, "backendRefs")
+			canaryRef := findBackendRef(backendRefs, canaryService)
+			mirror := map[string]any{
+				"backendRef": map[string]any{
+					"group": "",
+					"kind":  "Service",
+					"name":  canaryService,
+					"port":  canaryRef["port"],
+				},
+			}
+			if setMirrorRoute.Percentage != nil && *setMirrorRoute.Percentage != 100 {
+				mirror["percent"] = int64(*setMirrorRoute.Percentage)
+			}
+			filters, _, _ := unstructured.NestedSlice(rule, "filters")
+			mirrorRule["filters"] = append(filters, map[string]any{
+				"type":          "RequestMirror",
+				"requestMirror": mirror,
+			})
+			mirrorRules = append(mirrorRules, mirrorRule)
+		}
+		return managedRules.Add(rules, state, setMirrorRoute.Name, mirrorRules), nil
+	})
+}
+
+// RemoveManagedRoutes removes the rules added for the managed routes of the rollout
+func (r *Reconciler) RemoveManagedRoutes() error {
+	return r.updateRoutes(allRoutes, func(kind routeKind, name string, rules []any, state *trafficrouting.ManagedState) ([]any, error) {
+		for managedRoute := range state.Routes {
+			rules = managedRules.Remove(rules, state, managedRoute)
+		}
+		return rules, nil
+	})
+}
+
+// VerifyWeight returns true when the weighted rules of every route carry the desired weights and every
+// parent of the routes accepted the current generation of the route with all its references resolved
+func (r *Reconciler) VerifyWeight(desiredWeight int32, additionalDestinations ...v1alpha1.WeightDestination) (*bool, error) {
+	ctx := context.TODO()
+	canaryService := r.cfg.Rollout.Spec.Strategy.Canary.CanaryService
+	stableService := r.cfg.Rollout.Spec.Strategy.Canary.StableService
+	stableWeight := weightutil.MaxTrafficWeight(r.cfg.Rollout) - desiredWeight
+	for _, dest := range additionalDestinations {
+		stableWeight -= dest.Weight
+	}
+
+	kinds, names := r.routes()
+	for i, kind := range kinds {
+		route, err := r.cfg.Client.Resource(kind.gvr).Namespace(r.cfg.Rollout.Namespace).Get(ctx, names[i], metav1.GetOptions{})
+		if err != nil {
+			return nil, err
+		}
+		rules, _, err := unstructured.NestedSlice(route.Object, "spec", "rules")
+		if err != nil {
+			return nil, err
+		}
+		for _, rule := range rules {
+			backendRefs, _, _ := unstructured.NestedSlice(rule.(map[string]any), "backendRefs")
+			canaryRef, stableRef := findBackendRef(backendRefs, canaryService), findBackendRef(backendRefs, stableService)
+			if canaryRef == nil || stableRef == nil {
+				continue
+			}
+			if backendWeight(canaryRef) != int64(desiredWeight) || backendWeight(stableRef) != int64(stableWeight) {
+				return ptr.To(false), nil
+			}
+			for _, dest := range additionalDestinations {
+				destRef := findBackendRef(backendRefs, dest.ServiceName)
+				if destRef == nil || backendWeight(destRef) != int64(dest.Weight) {
+					return ptr.To(false), nil
+				}
+			}
+		}
+		if !routeAccepted(route) {
+			r.log.Infof("%s %q is not yet accepted by all its parents", kind.kind, route.GetName())
+			return ptr.To(false), nil
+		}
+	}
+	return ptr.To(true), nil
+}
+
+// routeAccepted returns true when every parent of the route reports the Accepted and ResolvedRefs
+// conditions as true for the current generation of the route
+func routeAccepted(route *unstructured.Unstructured) bool {
+	parents, _, _ := unstructured.NestedSlice(route.Object, "status", "parents")
+	if len(parents) == 0 {
+		return false
+	}
+	for _, parent := range parents {
+		conditions, _, _ := unstructured.NestedSlice(parent.(map[string]any), "conditions")
+		for _, conditionType := range []string{"Accepted", "ResolvedRefs"} {
+			found := false
+			for _, condition := range conditions {
+				typedCondition := condition.(map[string]any)
+				if typedCondition["type"] != conditionType {
+					continue
+				}
+				observedGeneration, ok, _ := unstructured.NestedInt64(typedCondition, "observedGeneration")
+				if typedCondition["status"] != string(metav1.ConditionTrue) || (ok && observedGeneration != route.GetGeneration()) {
+					return false
+				}
+				found = true
+			}
+			if !found {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// weightedRules returns the rules, not added for a managed route, with backendRefs to both services
+func weightedRules(rules []any, state *trafficrouting.ManagedState, canaryService, stableService string) []map[string]any {
+	weighted := []map[string]any{}
+	for _, rule := range rules {
+		typedRule, ok := rule.(map[string]any)
+		if !ok || managedRules.ManagedRouteOf(typedRule, state) != "" {
+			continue
+		}
+		backendRefs, _, _ := unstructured.NestedSlice(typedRule, "backendRefs")
+		if findBackendRef(backendRefs, canaryService) != nil && findBackendRef(backendRefs, stableService) != nil {
+			weighted = append(weighted, typedRule)
+		}
+	}
+	return weighted
+}
+
+func findBackendRef(backendRefs []any, service string) map[string]any {
+	for _, backendRef := range backendRefs {
+		typedBackendRef, ok := backendRef.(map[string]any)
+		if !ok {
+			continue
+		}
+		kind, _, _ := unstructured.NestedString(typedBackendRef, "kind")
+		if (kind == "" || kind == "Service") && typedBackendRef["name"] == service {
+			return typedBackendRef
+		}
+	}
+	return nil
+}
+
+// backendWeight returns the weight of a backendRef, which defaults to 1
+func backendWeight(backendRef map[string]any) int64 {
+	weight, ok, _ := unstructured.NestedInt64(backendRef, "weight")
+	if !ok {
+		return 1
+	}
+	return weight
+}
+
+// headerMatch converts a header match of the rollout to a Gateway API header match. Every field is set
+// explicitly, so the API server does not default them and the rule identity is kept.
+func headerMatch(name string, value *v1alpha1.StringMatch) map[string]any {
+	match := map[string]any{
+		"type":  "Exact",
+		"name":  name,
+		"value": "",
+	}
+	if value == nil {
+		return match
+	}
+	switch {
+	case value.Exact != "":
+		match["value"] = value.Exact
+	case value.Prefix != "":
+		match["type"] = "RegularExpression"
+		match["value"] = "^" + regexp.QuoteMeta(value.Prefix) + ".*"
+	case value.Regex != "":
+		match["type"] = "RegularExpression"
+		match["value"] = value.Regex
+	}
+	return match
+}
+
+// httpRouteMatch converts a mirror route match to an HTTPRoute match
+func httpRouteMatch(routeMatch v1alpha1.RouteMatch) (map[string]any, error) {
+	match := map[string]any{
+		"path": defaultPathMatch(),
+	}
+	if routeMatch.Method != nil {
+		if routeMatch.Method.Exact == "" {
+			return nil, fmt.Errorf("Gateway API only supports exact method matches")
+		}
+		match["method"] = routeMatch.Method.Exact
+	}
+	if routeMatch.Path != nil {
+		switch {
+		case routeMatch.Path.Exact != "":
+			match["path"] = map[string]any{"type": "Exact", "value": routeMatch.Path.Exact}
+		case routeMatch.Path.Prefix != "":
+			match["path"] = map[string]any{"type": "PathPrefix", "value": routeMatch.Path.Prefix}
+		case routeMatch.Path.Regex != "":
+			match["path"] = map[string]any{"type": "RegularExpression", "value": routeMatch.Path.Regex}
+		}
+	}
+	if len(routeMatch.Headers) > 0 {
+		names := []string{}
+		for name := range routeMatch.Headers {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		headers := []any{}
+		for _, name := range names {
+			value := routeMatch.Headers[name]
+			headers = append(headers, headerMatch(name, &value))
+		}
+		match["headers"] = headers
+	}
+	return match, nil
+}
+
+func defaultPathMatch() map[string]any {
+	return map[string]any{"type": "PathPrefix", "value": "/"}
+}
+
+func runtimeCopy(obj map[string]any) map[string]any {
+	return runtime.DeepCopyJSONValue(obj).(map[string]any)
+}
+
+func runtimeSliceCopy(objs []any) []any {
+	return runtime.DeepCopyJSONValue(objs).([]any)
+}
+
+func (r *Reconciler) sendWarningEvent(id, msg string) {
+	r.cfg.Recorder.Eventf(r.cfg.Rollout, record.EventOptions{EventType: corev1.EventTypeWarning, EventReason: id}, msg)
+}
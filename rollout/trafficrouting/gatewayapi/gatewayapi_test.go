@@ -0,0 +1,343 @@
+package gatewayapi
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/client-go/dynamic/fake"
+	"k8s.io/utils/ptr"
+
+	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
+	testutil "github.com/argoproj/argo-rollouts/test/util"
+	"github.com/argoproj/argo-rollouts/utils/record"
+)
+
+const httpRoute = `
+apiVersion: gateway.networking.k8s.io/v1
+kind: HTTPRoute
+metadata:
+  name: http-route
+  namespace: default
+  generation: 2
+spec:
+  parentRefs:
+  - name: gateway
+  rules:
+  - matches:
+    - path:
+        type: PathPrefix
+        value: /
+    backendRefs:
+    - name: stable-service
+      port: 80
+      weight: 100
+    - name: canary-service
+      port: 80
+      weight: 0
+  - matches:
+    - path:
+        type: PathPrefix
+        value: /admin
+    backendRefs:
+    - name: admin-service
+      port: 80
+status:
+  parents:
+  - parentRef:
+      name: gateway
+    conditions:
+    - type: Accepted
+      status: "True"
+      observedGeneration: 2
+    - type: ResolvedRefs
+      status: "True"
+      observedGeneration: 2
+`
+
+const grpcRoute = `
+apiVersion: gateway.networking.k8s.io/v1
+kind: GRPCRoute
+metadata:
+  name: grpc-route
+  namespace: default
+spec:
+  rules:
+  - matches:
+    - method:
+        service: checkout.Checkout
+    backendRefs:
+    - name: stable-service
+      port: 9090
+    - name: canary-service
+      port: 9090
+`
+
+const tcpRoute = `
+apiVersion: gateway.networking.k8s.io/v1alpha2
+kind: TCPRoute
+metadata:
+  name: tcp-route
+  namespace: default
+spec:
+  rules:
+  - backendRefs:
+    - name: stable-service
+      port: 5432
+    - name: canary-service
+      port: 5432
+`
+
+func newReconciler(gatewayAPI *v1alpha1.GatewayAPITrafficRouting, routes ...string) (*Reconciler, *fake.FakeDynamicClient) {
+	client := testutil.NewFakeDynamicClientFromYAML(routes...)
+	r := NewReconciler(ReconcilerConfig{
+		Rollout:  testutil.NewCanaryRollout(&v1alpha1.RolloutTrafficRouting{GatewayAPI: gatewayAPI}),
+		Client:   client,
+		Recorder: record.NewFakeEventRecorder(),
+	})
+	return r, client
+}
+
+func allRoutesConfig() *v1alpha1.GatewayAPITrafficRouting {
+	return &v1alpha1.GatewayAPITrafficRouting{HTTPRoute: "http-route", GRPCRoute: "grpc-route", TCPRoute: "tcp-route"}
+}
+
+func getRoute(t *testing.T, client *fake.FakeDynamicClient, kind routeKind, name string) *unstructured.Unstructured {
+	t.Helper()
+	route, err := client.Resource(kind.gvr).Namespace("default").Get(context.TODO(), name, metav1.GetOptions{})
+	assert.NoError(t, err)
+	return route
+}
+
+func getRules(t *testing.T, client *fake.FakeDynamicClient, kind routeKind, name string) []any {
+	t.Helper()
+	rules, _, err := unstructured.NestedSlice(getRoute(t, client, kind, name).Object, "spec", "rules")
+	assert.NoError(t, err)
+	return rules
+}
+
+// backendWeights returns the weights of the backends of a rule by service name
+func backendWeights(rule any) map[string]int64 {
+	weights := map[string]int64{}
+	backendRefs, _, _ := unstructured.NestedSlice(rule.(map[string]any), "backendRefs")
+	for _, backendRef := range backendRefs {
+		typed := backendRef.(map[string]any)
+		weights[typed["name"].(string)] = backendWeight(typed)
+	}
+	return weights
+}
+
+func TestType(t *testing.T) {
+	r, _ := newReconciler(allRoutesConfig())
+	assert.Equal(t, Type, r.Type())
+	assert.NoError(t, r.UpdateHash("canary", "stable"))
+}
+
+func TestSetWeight(t *testing.T) {
+	r, client := newReconciler(allRoutesConfig(), httpRoute, grpcRoute, tcpRoute)
+	assert.NoError(t, r.SetWeight(30))
+
+	rules := getRules(t, client, httpRouteKind, "http-route")
+	assert.Equal(t, map[string]int64{"stable-service": 70, "canary-service": 30}, backendWeights(rules[0]))
+	assert.Equal(t, map[string]int64{"admin-service": 1}, backendWeights(rules[1]))
+	rules = getRules(t, client, grpcRouteKind, "grpc-route")
+	assert.Equal(t, map[string]int64{"stable-service": 70, "canary-service": 30}, backendWeights(rules[0]))
+	rules = getRules(t, client, tcpRouteKind, "tcp-route")
+	assert.Equal(t, map[string]int64{"stable-service": 70, "canary-service": 30}, backendWeights(rules[0]))
+	assert.Equal(t, 3, testutil.CountUpdates(client))
+
+	// routes already at the desired weight are not updated
+	assert.NoError(t, r.SetWeight(30))
+	assert.Equal(t, 3, testutil.CountUpdates(client))
+}
+
+func TestSetWeightWithoutWeightedRule(t *testing.T) {
+	r, _ := newReconciler(&v1alpha1.GatewayAPITrafficRouting{HTTPRoute: "http-route"}, httpRoute)
+	r.cfg.Rollout.Spec.Strategy.Canary.CanaryService = "other-service"
+	err := r.SetWeight(30)
+	assert.EqualError(t, err, "HTTPRoute http-route has no rule with backendRefs to both the stable service stable-service and the canary service other-service")
+}
+
+func TestSetWeightMissingRoute(t *testing.T) {
+	r, _ := newReconciler(&v1alpha1.GatewayAPITrafficRouting{HTTPRoute: "http-route"})
+	err := r.SetWeight(30)
+	assert.Error(t, err)
+}
+
+func TestSetWeightUpdateError(t *testing.T) {
+	r, client := newReconciler(&v1alpha1.GatewayAPITrafficRouting{HTTPRoute: "http-route"}, httpRoute)
+	testutil.FailUpdates(client, "httproutes")
+	recorder := record.NewFakeEventRecorder()
+	r.cfg.Recorder = recorder
+	err := r.SetWeight(30)
+	assert.EqualError(t, err, testutil.UpdateError)
+	assert.Equal(t, []string{gatewayAPIRouteUpdateError}, recorder.Events())
+}
+
+func TestSetWeightWithAdditionalDestinations(t *testing.T) {
+	r, client := newReconciler(&v1alpha1.GatewayAPITrafficRouting{HTTPRoute: "http-route"}, httpRoute)
+	assert.NoError(t, r.SetWeight(20, v1alpha1.WeightDestination{ServiceName: "experiment-service", Weight: 10}))
+
+	route := getRoute(t, client, httpRouteKind, "http-route")
+	rules, _, _ := unstructured.NestedSlice(route.Object, "spec", "rules")
+	assert.Equal(t, map[string]int64{"stable-service": 70, "canary-service": 20, "experiment-service": 10}, backendWeights(rules[0]))
+	assert.Equal(t, `{"destinations":["experiment-service"]}`, route.GetAnnotations()[ManagedRoutesAnnotation])
+
+	verified, err := r.VerifyWeight(20, v1alpha1.WeightDestination{ServiceName: "experiment-service", Weight: 10})
+	assert.NoError(t, err)
+	assert.True(t, *verified)
+
+	// the backend of the experiment is removed once the experiment is over
+	assert.NoError(t, r.SetWeight(20))
+	route = getRoute(t, client, httpRouteKind, "http-route")
+	rules, _, _ = unstructured.NestedSlice(route.Object, "spec", "rules")
+	assert.Equal(t, map[string]int64{"stable-service": 80, "canary-service": 20}, backendWeights(rules[0]))
+	assert.NotContains(t, route.GetAnnotations(), ManagedRoutesAnnotation)
+}
+
+func TestSetHeaderRoute(t *testing.T) {
+	r, client := newReconciler(allRoutesConfig(), httpRoute, grpcRoute, tcpRoute)
+	headerRoute := &v1alpha1.SetHeaderRoute{
+		Name: "header-route",
+		Match: []v1alpha1.HeaderRoutingMatch{
+			{HeaderName: "agent", HeaderValue: &v1alpha1.StringMatch{Exact: "chrome"}},
+			{HeaderName: "version", HeaderValue: &v1alpha1.StringMatch{Prefix: "2."}},
+		},
+	}
+	assert.NoError(t, r.SetHeaderRoute(headerRoute))
+	assert.Equal(t, 2, testutil.CountUpdates(client))
+
+	rules := getRules(t, client, httpRouteKind, "http-route")
+	assert.Len(t, rules, 3)
+	assert.Equal(t, map[string]any{
+		"matches": []any{map[string]any{
+			"path": map[string]any{"type": "PathPrefix", "value": "/"},
+			"headers": []any{
+				map[string]any{"type": "Exact", "name": "agent", "value": "chrome"},
+				map[string]any{"type": "RegularExpression", "name": "version", "value": `^2\..*`},
+			},
+		}},
+		"backendRefs": []any{map[string]any{"name": "canary-service", "port": int64(80)}},
+	}, rules[2])
+
+	rules = getRules(t, client, grpcRouteKind, "grpc-route")
+	assert.Len(t, rules, 2)
+	assert.Equal(t, []any{map[string]any{
+		"method": map[string]any{"service": "checkout.Checkout"},
+		"headers": []any{
+			map[string]any{"type": "Exact", "name": "agent", "value": "chrome"},
+			map[string]any{"type": "RegularExpression", "name": "version", "value": `^2\..*`},
+		},
+	}}, rules[1].(map[string]any)["matches"])
+
+	// setting the same header route again changes nothing
+	assert.NoError(t, r.SetHeaderRoute(headerRoute))
+	assert.Equal(t, 2, testutil.CountUpdates(client))
+
+	// weights only apply to the rules of the user
+	assert.NoError(t, r.SetWeight(40))
+	rules = getRules(t, client, httpRouteKind, "http-route")
+	assert.Equal(t, map[string]int64{"stable-service": 60, "canary-service": 40}, backendWeights(rules[0]))
+	assert.Equal(t, map[string]int64{"canary-service": 1}, backendWeights(rules[2]))
+
+	// a header route without matches removes its rules
+	assert.NoError(t, r.SetHeaderRoute(&v1alpha1.SetHeaderRoute{Name: "header-route"}))
+	assert.Len(t, getRules(t, client, httpRouteKind, "http-route"), 2)
+	assert.Len(t, getRules(t, client, grpcRouteKind, "grpc-route"), 1)
+	assert.NotContains(t, getRoute(t, client, httpRouteKind, "http-route").GetAnnotations(), ManagedRoutesAnnotation)
+}
+
+func TestSetMirrorRoute(t *testing.T) {
+	r, client := newReconciler(allRoutesConfig(), httpRoute, grpcRoute, tcpRoute)
+	mirrorRoute := &v1alpha1.SetMirrorRoute{
+		Name: "mirror-route",
+		Match: []v1alpha1.RouteMatch{{
+			Method:  &v1alpha1.StringMatch{Exact: "GET"},
+			Path:    &v1alpha1.StringMatch{Prefix: "/api"},
+			Headers: map[string]v1alpha1.StringMatch{"agent": {Regex: "chrome.*"}},
+		}},
+		Percentage: ptr.To[int32](50),
+	}
+	assert.NoError(t, r.SetMirrorRoute(mirrorRoute))
+	assert.Equal(t, 1, testutil.CountUpdates(client))
+
+	rules := getRules(t, client, httpRouteKind, "http-route")
+	assert.Len(t, rules, 3)
+	mirrorRule := rules[2].(map[string]any)
+	assert.Equal(t, []any{map[string]any{
+		"method":  "GET",
+		"path":    map[string]any{"type": "PathPrefix", "value": "/api"},
+		"headers": []any{map[string]any{"type": "RegularExpression", "name": "agent", "value": "chrome.*"}},
+	}}, mirrorRule["matches"])
+	assert.Equal(t, []any{map[string]any{
+		"type": "RequestMirror",
+		"requestMirror": map[string]any{
+			"backendRef": map[string]any{"group": "", "kind": "Service", "name": "canary-service", "port": int64(80)},
+			"percent":    int64(50),
+		},
+	}}, mirrorRule["filters"])
+
+	// the mirror rule keeps routing traffic by weight
+	assert.NoError(t, r.SetWeight(10))
+	rules = getRules(t, client, httpRouteKind, "http-route")
+	assert.Equal(t, map[string]int64{"stable-service": 90, "canary-service": 10}, backendWeights(rules[2]))
+
+	assert.NoError(t, r.RemoveManagedRoutes())
+	assert.Len(t, getRules(t, client, httpRouteKind, "http-route"), 2)
+	assert.NotContains(t, getRoute(t, client, httpRouteKind, "http-route").GetAnnotations(), ManagedRoutesAnnotation)
+}
+
+func TestSetMirrorRouteInvalidMethod(t *testing.T) {
+	r, _ := newReconciler(allRoutesConfig(), httpRoute)
+	err := r.SetMirrorRoute(&v1alpha1.SetMirrorRoute{
+		Name:  "mirror-route",
+		Match: []v1alpha1.RouteMatch{{Method: &v1alpha1.StringMatch{Prefix: "G"}}},
+	})
+	assert.EqualError(t, err, "Gateway API only supports exact method matches")
+}
+
+func TestRemoveManagedRoutesIgnoresUserRules(t *testing.T) {
+	r, client := newReconciler(&v1alpha1.GatewayAPITrafficRouting{HTTPRoute: "http-route"}, httpRoute)
+	assert.NoError(t, r.RemoveManagedRoutes())
+	assert.Len(t, getRules(t, client, httpRouteKind, "http-route"), 2)
+	assert.Equal(t, 0, testutil.CountUpdates(client))
+}
+
+func TestVerifyWeight(t *testing.T) {
+	t.Run("weights applied and route accepted", func(t *testing.T) {
+		r, _ := newReconciler(&v1alpha1.GatewayAPITrafficRouting{HTTPRoute: "http-route"}, httpRoute)
+		assert.NoError(t, r.SetWeight(30))
+		verified, err := r.VerifyWeight(30)
+		assert.NoError(t, err)
+		assert.True(t, *verified)
+	})
+
+	t.Run("weights not applied", func(t *testing.T) {
+		r, _ := newReconciler(&v1alpha1.GatewayAPITrafficRouting{HTTPRoute: "http-route"}, httpRoute)
+		verified, err := r.VerifyWeight(30)
+		assert.NoError(t, err)
+		assert.False(t, *verified)
+	})
+
+	t.Run("route not yet accepted for the current generation", func(t *testing.T) {
+		r, client := newReconciler(&v1alpha1.GatewayAPITrafficRouting{HTTPRoute: "http-route"}, httpRoute)
+		assert.NoError(t, r.SetWeight(30))
+		route := getRoute(t, client, httpRouteKind, "http-route")
+		route.SetGeneration(3)
+		_, err := client.Resource(httpRouteGVR).Namespace("default").Update(context.TODO(), route, metav1.UpdateOptions{})
+		assert.NoError(t, err)
+		verified, err := r.VerifyWeight(30)
+		assert.NoError(t, err)
+		assert.False(t, *verified)
+	})
+
+	t.Run("route without status", func(t *testing.T) {
+		r, _ := newReconciler(&v1alpha1.GatewayAPITrafficRouting{GRPCRoute: "grpc-route"}, grpcRoute)
+		assert.NoError(t, r.SetWeight(30))
+		verified, err := r.VerifyWeight(30)
+		assert.NoError(t, err)
+		assert.False(t, *verified)
+	})
+}
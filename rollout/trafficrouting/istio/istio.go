@@ -4,11 +4,14 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"regexp"
 	"slices"
+	"strconv"
 	"strings"
 
 	"github.com/mitchellh/mapstructure"
 	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
 
 	replicasetutil "github.com/argoproj/argo-rollouts/utils/replicaset"
 
@@ -19,10 +22,13 @@ import (
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/client-go/dynamic"
 	"k8s.io/client-go/dynamic/dynamiclister"
+	"k8s.io/utils/ptr"
 
 	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
+	"github.com/argoproj/argo-rollouts/utils/defaults"
 	evalUtils "github.com/argoproj/argo-rollouts/utils/evaluate"
 	istioutil "github.com/argoproj/argo-rollouts/utils/istio"
 	logutil "github.com/argoproj/argo-rollouts/utils/log"
@@ -37,6 +43,17 @@ const Type = "Istio"
 
 const SpecHttpNotFound = "spec.http not found"
 
+// StickyCookieAnnotation holds the name of the cookie pinning the clients served by the canary, set on the
+// VirtualServices whose weighted routes set it
+const StickyCookieAnnotation = "rollouts.argoproj.io/istio-sticky-cookie"
+
+// StickySubsetsAnnotation marks the DestinationRules whose subsets load balance with the consistent hash of the
+// rollout stickiness
+const StickySubsetsAnnotation = "rollouts.argoproj.io/istio-sticky-subsets"
+
+// stickyRoutePrefix prefixes the name of the routes sending the pinned clients to the canary
+const stickyRoutePrefix = "rollouts-sticky"
+
 // NewReconciler returns a reconciler struct that brings the Virtual Service into the desired state.
 func NewReconciler(r *v1alpha1.Rollout, client dynamic.Interface, recorder record.EventRecorder, virtualServiceLister, destinationRuleLister dynamiclister.Lister, replicaSets []*appsv1.ReplicaSet) *Reconciler {
 	return &Reconciler{
@@ -426,6 +443,15 @@ func (r *Reconciler) UpdateHash(canaryHash, stableHash string, additionalDestina
 		dRuleNew.Annotations = make(map[string]string)
 	}
 	dRuleNew.Annotations[v1alpha1.ManagedByRolloutsKey] = r.rollout.Name
+	// Only revert the consistent hash of the subsets when it was set by the rollout
+	stickiness := r.rollout.Spec.Strategy.Canary.TrafficRouting.Stickiness
+	_, sticky := dRuleNew.Annotations[StickySubsetsAnnotation]
+	manageStickiness := stickiness != nil || sticky
+	if stickiness != nil {
+		dRuleNew.Annotations[StickySubsetsAnnotation] = "true"
+	} else {
+		delete(dRuleNew.Annotations, StickySubsetsAnnotation)
+	}
 	// Maps service to WeightDestination object
 	svcToDest := map[string]v1alpha1.WeightDestination{}
 	for _, dest := range additionalDestinations {
@@ -442,6 +468,9 @@ func (r *Reconciler) UpdateHash(canaryHash, stableHash string, additionalDestina
 			} else {
 				delete(subset.Labels, v1alpha1.DefaultRolloutUniqueLabelKey)
 			}
+			if manageStickiness {
+				setSubsetStickiness(&subset, stickiness)
+			}
 		} else if subset.Name == dRuleSpec.StableSubsetName { // Stable Subset
 			if subset.Labels == nil {
 				subset.Labels = make(map[string]string)
@@ -451,6 +480,9 @@ func (r *Reconciler) UpdateHash(canaryHash, stableHash string, additionalDestina
 			} else {
 				delete(subset.Labels, v1alpha1.DefaultRolloutUniqueLabelKey)
 			}
+			if manageStickiness {
+				setSubsetStickiness(&subset, stickiness)
+			}
 		} else if dest, ok := svcToDest[subset.Name]; ok { // Current experiment steps
 			if dest.PodTemplateHash != "" {
 				subset.Labels[v1alpha1.DefaultRolloutUniqueLabelKey] = dest.PodTemplateHash
@@ -482,6 +514,53 @@ func (r *Reconciler) UpdateHash(canaryHash, stableHash string, additionalDestina
 	return nil
 }
 
+// setSubsetStickiness sets the consistent hash of the load balancer of the subset to the cookie or header of the
+// stickiness, or removes it when stickiness is nil
+func setSubsetStickiness(subset *Subset, stickiness *v1alpha1.TrafficStickiness) {
+	trafficPolicy, _ := subset.Extra["trafficPolicy"].(map[string]any)
+	loadBalancer, _ := trafficPolicy["loadBalancer"].(map[string]any)
+	if stickiness == nil {
+		if loadBalancer == nil {
+			return
+		}
+		delete(loadBalancer, "consistentHash")
+		if len(loadBalancer) == 0 {
+			delete(trafficPolicy, "loadBalancer")
+		}
+		if len(trafficPolicy) == 0 {
+			delete(subset.Extra, "trafficPolicy")
+		}
+		if len(subset.Extra) == 0 {
+			subset.Extra = nil
+		}
+		return
+	}
+
+	consistentHash := map[string]any{}
+	if stickiness.Cookie != "" {
+		consistentHash["httpCookie"] = map[string]any{
+			"name": stickiness.Cookie,
+			"ttl":  fmt.Sprintf("%ds", stickiness.DurationSeconds),
+		}
+	} else {
+		consistentHash["httpHeaderName"] = stickiness.Header
+	}
+	if loadBalancer == nil {
+		loadBalancer = map[string]any{}
+	}
+	// simple and consistentHash are mutually exclusive load balancer settings
+	delete(loadBalancer, "simple")
+	loadBalancer["consistentHash"] = consistentHash
+	if trafficPolicy == nil {
+		trafficPolicy = map[string]any{}
+	}
+	trafficPolicy["loadBalancer"] = loadBalancer
+	if subset.Extra == nil {
+		subset.Extra = map[string]any{}
+	}
+	subset.Extra["trafficPolicy"] = trafficPolicy
+}
+
 // destinationRuleReplaceExtraMarshal relace the key of "Extra" with the actual content
 // e.g., "trafficpolicy" and return the bytes of the new object
 func destinationRuleReplaceExtraMarshal(dRule *DestinationRule) []byte {
@@ -765,7 +844,11 @@ func (r *Reconciler) SetWeight(desiredWeight int32, additionalDestinations ...v1
 		if err != nil {
 			return err
 		}
-		if !modified {
+		stickinessModified, err := r.reconcileVirtualServiceStickiness(virtualService, modifiedVirtualService, desiredWeight)
+		if err != nil {
+			return err
+		}
+		if !modified && !stickinessModified {
 			continue
 		}
 
@@ -808,10 +891,11 @@ func (r *Reconciler) getVirtualService(namespace string, vsvcName string, client
 	return vsvc, err
 }
 
-func (r *Reconciler) reconcileVirtualServiceHeaderRoutes(virtualService v1alpha1.IstioVirtualService, obj *unstructured.Unstructured, headerRouting *v1alpha1.SetHeaderRoute) error {
+// getCanaryHostAndSubset returns the host and subset of the destination of the routes sending traffic to the canary
+func (r *Reconciler) getCanaryHostAndSubset() (string, string, error) {
 	destRuleHost, err := r.getDestinationRuleHost()
 	if err != nil {
-		return err
+		return "", "", err
 	}
 
 	_, canarySvc := trafficrouting.GetStableAndCanaryServices(r.rollout, false)
@@ -822,6 +906,14 @@ func (r *Reconciler) reconcileVirtualServiceHeaderRoutes(virtualService v1alpha1
 	if r.rollout.Spec.Strategy.Canary.TrafficRouting.Istio.DestinationRule != nil {
 		canarySubset = r.rollout.Spec.Strategy.Canary.TrafficRouting.Istio.DestinationRule.CanarySubsetName
 	}
+	return canarySvc, canarySubset, nil
+}
+
+func (r *Reconciler) reconcileVirtualServiceHeaderRoutes(virtualService v1alpha1.IstioVirtualService, obj *unstructured.Unstructured, headerRouting *v1alpha1.SetHeaderRoute) error {
+	canarySvc, canarySubset, err := r.getCanaryHostAndSubset()
+	if err != nil {
+		return err
+	}
 
 	if headerRouting.Match == nil {
 		//Remove mirror route
@@ -888,6 +980,259 @@ func (r *Reconciler) SetHeaderRoute(headerRouting *v1alpha1.SetHeaderRoute) erro
 	return nil
 }
 
+// SetLocality adds a route sending the traffic originating from the zones and regions of the step to the canary, or
+// removes it when the step has neither zones nor regions. Istio labels every proxy with the topology labels of its
+// locality, so the route matches them as source labels.
+func (r *Reconciler) SetLocality(setLocality *v1alpha1.SetLocality) error {
+	ctx := context.TODO()
+	virtualServices := r.getVirtualServices()
+	for _, virtualService := range virtualServices {
+		name := virtualService.Name
+		namespace, vsvcName := istioutil.GetVirtualServiceNamespaceName(name)
+		if namespace == "" {
+			namespace = r.rollout.Namespace
+		}
+
+		client := r.client.Resource(istioutil.GetIstioVirtualServiceGVR()).Namespace(namespace)
+		vsvc, err := r.getVirtualService(namespace, vsvcName, client, ctx)
+		if err != nil {
+			return fmt.Errorf("[SetLocality] failed to get istio virtual service: %w", err)
+		}
+
+		err = r.reconcileVirtualServiceLocalityRoutes(virtualService, vsvc, setLocality)
+		if err != nil {
+			return fmt.Errorf("[SetLocality] failed to reconcile locality routes: %w", err)
+		}
+
+		if err := r.orderRoutes(vsvc); err != nil && err.Error() != SpecHttpNotFound {
+			return fmt.Errorf("[SetLocality] failed to order routes: %w", err)
+		}
+		_, err = client.Update(ctx, vsvc, metav1.UpdateOptions{})
+		if err == nil {
+			r.log.Debugf("Updated VirtualService: %s", vsvc)
+			r.recorder.Eventf(r.rollout, record.EventOptions{EventReason: "Updated VirtualService"}, "VirtualService `%s` set locality route '%v'", vsvcName, setLocality.Name)
+		} else {
+			return fmt.Errorf("[SetLocality] failed to update routes: %w", err)
+		}
+	}
+	return nil
+}
+
+func (r *Reconciler) reconcileVirtualServiceLocalityRoutes(virtualService v1alpha1.IstioVirtualService, obj *unstructured.Unstructured, setLocality *v1alpha1.SetLocality) error {
+	//Remove route first to avoid duplicates
+	err := removeRoute(obj, setLocality.Name)
+	if err != nil {
+		return fmt.Errorf("[reconcileVirtualServiceLocalityRoutes] failed to remove http route from virtual service: %w", err)
+	}
+	if len(setLocality.Zones) == 0 && len(setLocality.Regions) == 0 {
+		return nil
+	}
+
+	canarySvc, canarySubset, err := r.getCanaryHostAndSubset()
+	if err != nil {
+		return err
+	}
+
+	// HTTP Routes
+	httpRoutesI, err := GetHttpRoutesI(obj)
+	if err != nil {
+		return err
+	}
+
+	httpRoutesI = append(httpRoutesI, createLocalityRoute(virtualService, obj, setLocality, canarySvc, canarySubset))
+
+	err = unstructured.SetNestedSlice(obj.Object, httpRoutesI, "spec", Http)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+// createLocalityRoute creates a route sending the traffic of the source workloads in any of the zones or regions to
+// the canary
+func createLocalityRoute(virtualService v1alpha1.IstioVirtualService, unVsvc *unstructured.Unstructured, setLocality *v1alpha1.SetLocality, host string, subset string) map[string]any {
+	var routeMatches []any
+	for _, zone := range setLocality.Zones {
+		routeMatches = append(routeMatches, map[string]any{
+			"sourceLabels": map[string]any{corev1.LabelTopologyZone: zone},
+		})
+	}
+	for _, region := range setLocality.Regions {
+		routeMatches = append(routeMatches, map[string]any{
+			"sourceLabels": map[string]any{corev1.LabelTopologyRegion: region},
+		})
+	}
+
+	port, err := getVirtualServiceCanaryPort(unVsvc, virtualService)
+	if err != nil {
+		port = Port{Number: 0}
+	}
+
+	return map[string]any{
+		"name":  setLocality.Name,
+		"match": routeMatches,
+		"route": []any{routeDestination(host, port.Number, subset, 100)},
+	}
+}
+
+// reconcileVirtualServiceStickiness pins the clients served by the canary through the weighted routes of the
+// VirtualService to the canary. The canary destinations set a cookie holding the canary pod template hash, and a
+// route placed before each weighted route sends the requests carrying it to the canary while the canary has weight.
+func (r *Reconciler) reconcileVirtualServiceStickiness(virtualService v1alpha1.IstioVirtualService, obj *unstructured.Unstructured, desiredWeight int32) (bool, error) {
+	httpRoutesI, err := GetHttpRoutesI(obj)
+	if err != nil {
+		return false, nil
+	}
+	origBytes, err := json.Marshal(httpRoutesI)
+	if err != nil {
+		return false, err
+	}
+	annotations := obj.GetAnnotations()
+
+	// Remove the sticky routes and cookies of the previous reconciliation
+	var newRoutesI []any
+	for _, routeI := range httpRoutesI {
+		route, ok := routeI.(map[string]any)
+		if !ok {
+			return false, fmt.Errorf("could not cast type to map[string]any to find route name in Istio Virtual Service")
+		}
+		if name, _ := route["name"].(string); isStickyRoute(name) {
+			continue
+		}
+		if cookie, ok := annotations[StickyCookieAnnotation]; ok {
+			removeStickyCookie(route, cookie)
+		}
+		newRoutesI = append(newRoutesI, route)
+	}
+	delete(annotations, StickyCookieAnnotation)
+
+	stickiness := r.rollout.Spec.Strategy.Canary.TrafficRouting.Stickiness
+	canaryHash := r.rollout.Status.CurrentPodHash
+	if stickiness != nil && stickiness.Cookie != "" && canaryHash != "" && desiredWeight > 0 {
+		httpRoutes, err := GetHttpRoutes(newRoutesI)
+		if err != nil {
+			return false, err
+		}
+		routeIndexes, err := getHttpRouteIndexesToPatch(virtualService.Routes, httpRoutes)
+		if err != nil {
+			return false, err
+		}
+		cookie := stickyCookieName(stickiness)
+		setCookie := fmt.Sprintf("%s=%s; Path=/", cookie, canaryHash)
+		if stickiness.DurationSeconds > 0 {
+			setCookie = fmt.Sprintf("%s; Max-Age=%d", setCookie, stickiness.DurationSeconds)
+		}
+		canarySubset := r.rollout.Spec.Strategy.Canary.TrafficRouting.Istio.DestinationRule.CanarySubsetName
+		// Insert the sticky routes from the last weighted route so that the indexes of the others remain valid
+		slices.Sort(routeIndexes)
+		for i := len(routeIndexes) - 1; i >= 0; i-- {
+			idx := routeIndexes[i]
+			route := newRoutesI[idx].(map[string]any)
+			stickyRoute := createStickyRoute(route, httpRoutes[idx].Name, canarySubset, setCookie, cookie, canaryHash)
+			if stickyRoute == nil {
+				continue
+			}
+			newRoutesI = slices.Insert(newRoutesI, idx, any(stickyRoute))
+		}
+		if annotations == nil {
+			annotations = map[string]string{}
+		}
+		annotations[StickyCookieAnnotation] = cookie
+	}
+
+	newBytes, err := json.Marshal(newRoutesI)
+	if err != nil {
+		return false, err
+	}
+	if string(origBytes) == string(newBytes) {
+		return false, nil
+	}
+	if err := unstructured.SetNestedSlice(obj.Object, newRoutesI, "spec", Http); err != nil {
+		return false, err
+	}
+	obj.SetAnnotations(annotations)
+	return true, nil
+}
+
+// stickyCookieName returns the name of the cookie pinning the clients to the canary. It differs from the cookie of
+// the stickiness, which the DestinationRule subsets hash to keep the clients on the same pod.
+func stickyCookieName(stickiness *v1alpha1.TrafficStickiness) string {
+	return fmt.Sprintf("%s-canary", stickiness.Cookie)
+}
+
+func isStickyRoute(name string) bool {
+	return name == stickyRoutePrefix || strings.HasPrefix(name, stickyRoutePrefix+"-")
+}
+
+// createStickyRoute sets the cookie on the canary destinations of the weighted route and returns a copy of the route
+// matching the requests which carry the cookie and sending them to the canary, or nil when the route has no canary
+// destination
+func createStickyRoute(route map[string]any, routeName, canarySubset, setCookie, cookie, canaryHash string) map[string]any {
+	destinations, _ := route["route"].([]any)
+	var canaryDestinations []any
+	for _, destI := range destinations {
+		dest, ok := destI.(map[string]any)
+		if !ok {
+			continue
+		}
+		if subset, _, _ := unstructured.NestedString(dest, "destination", "subset"); subset != canarySubset {
+			continue
+		}
+		_ = unstructured.SetNestedField(dest, setCookie, "headers", "response", "set", "Set-Cookie")
+		canaryDestination := runtime.DeepCopyJSONValue(dest).(map[string]any)
+		delete(canaryDestination, "weight")
+		canaryDestinations = append(canaryDestinations, canaryDestination)
+	}
+	if len(canaryDestinations) == 0 {
+		return nil
+	}
+
+	stickyRoute := runtime.DeepCopyJSONValue(route).(map[string]any)
+	stickyRoute["name"] = stickyRoutePrefix
+	if routeName != "" {
+		stickyRoute["name"] = fmt.Sprintf("%s-%s", stickyRoutePrefix, routeName)
+	}
+	stickyRoute["route"] = canaryDestinations
+	delete(stickyRoute, "mirror")
+	delete(stickyRoute, "mirrorPercentage")
+
+	cookieMatch := map[string]any{
+		"regex": fmt.Sprintf(`^(.*;\s*)?%s=%s(;.*)?$`, regexp.QuoteMeta(cookie), regexp.QuoteMeta(canaryHash)),
+	}
+	matches, _ := stickyRoute["match"].([]any)
+	if len(matches) == 0 {
+		matches = []any{map[string]any{}}
+	}
+	for _, matchI := range matches {
+		if match, ok := matchI.(map[string]any); ok {
+			_ = unstructured.SetNestedField(match, cookieMatch, "headers", "cookie")
+		}
+	}
+	stickyRoute["match"] = matches
+	return stickyRoute
+}
+
+// removeStickyCookie removes the cookie pinning the clients to the canary from the destinations of the route
+func removeStickyCookie(route map[string]any, cookie string) {
+	destinations, _ := route["route"].([]any)
+	for _, destI := range destinations {
+		dest, ok := destI.(map[string]any)
+		if !ok {
+			continue
+		}
+		setCookie, _, _ := unstructured.NestedString(dest, "headers", "response", "set", "Set-Cookie")
+		if !strings.HasPrefix(setCookie, cookie+"=") {
+			continue
+		}
+		unstructured.RemoveNestedField(dest, "headers", "response", "set", "Set-Cookie")
+		for _, fields := range [][]string{{"headers", "response", "set"}, {"headers", "response"}, {"headers"}} {
+			if m, found, _ := unstructured.NestedMap(dest, fields...); found && len(m) == 0 {
+				unstructured.RemoveNestedField(dest, fields...)
+			}
+		}
+	}
+}
+
 func (r *Reconciler) getDestinationRuleHost() (string, error) {
 	if r.rollout.Spec.Strategy.Canary.TrafficRouting.Istio.DestinationRule != nil {
 		ctx := context.TODO()
@@ -976,8 +1321,107 @@ func routeDestination(host string, port uint32, subset string, weight int64) map
 	return routeValue
 }
 
+// VerifyWeight verifies that the VirtualServices have the desired weights and that istiod reconciled their current
+// generation with every proxy. It relies on istiod status reporting and is only performed when enabled with the
+// --istio-verify-weight flag.
 func (r *Reconciler) VerifyWeight(desiredWeight int32, additionalDestinations ...v1alpha1.WeightDestination) (*bool, error) {
-	return nil, nil
+	if !defaults.VerifyIstioWeight() {
+		return nil, nil
+	}
+	ctx := context.TODO()
+	for _, virtualService := range r.getVirtualServices() {
+		namespace, vsvcName := istioutil.GetVirtualServiceNamespaceName(virtualService.Name)
+		if namespace == "" {
+			namespace = r.rollout.Namespace
+		}
+		client := r.client.Resource(istioutil.GetIstioVirtualServiceGVR()).Namespace(namespace)
+		vsvc, err := r.getVirtualService(namespace, vsvcName, client, ctx)
+		if err != nil {
+			return nil, err
+		}
+		_, modified, err := r.reconcileVirtualService(vsvc, virtualService.Routes, virtualService.TLSRoutes, virtualService.TCPRoutes, desiredWeight, additionalDestinations...)
+		if err != nil {
+			return nil, err
+		}
+		if modified {
+			r.log.Infof("VirtualService `%s` does not have the desired weights yet", vsvcName)
+			return ptr.To(false), nil
+		}
+		if reconciled, reason := virtualServiceReconciled(vsvc); !reconciled {
+			r.log.Infof("VirtualService `%s` is not reconciled: %s", vsvcName, reason)
+			return ptr.To(false), nil
+		}
+	}
+	return ptr.To(true), nil
+}
+
+// DetectDrift returns which VirtualServices have routes whose weights differ from the desired weights
+func (r *Reconciler) DetectDrift(desiredWeight int32, additionalDestinations ...v1alpha1.WeightDestination) (string, error) {
+	ctx := context.TODO()
+	var drifted []string
+	for _, virtualService := range r.getVirtualServices() {
+		namespace, vsvcName := istioutil.GetVirtualServiceNamespaceName(virtualService.Name)
+		if namespace == "" {
+			namespace = r.rollout.Namespace
+		}
+		client := r.client.Resource(istioutil.GetIstioVirtualServiceGVR()).Namespace(namespace)
+		vsvc, err := r.getVirtualService(namespace, vsvcName, client, ctx)
+		if err != nil {
+			return "", err
+		}
+		_, modified, err := r.reconcileVirtualService(vsvc, virtualService.Routes, virtualService.TLSRoutes, virtualService.TCPRoutes, desiredWeight, additionalDestinations...)
+		if err != nil {
+			return "", err
+		}
+		if modified {
+			drifted = append(drifted, fmt.Sprintf("VirtualService `%s` routes do not have the desired weights", vsvcName))
+		}
+	}
+	return strings.Join(drifted, ", "), nil
+}
+
+// virtualServiceReconciled returns whether istiod reports the current generation of the VirtualService as valid and
+// pushed to every proxy, and the reason when it does not
+func virtualServiceReconciled(vsvc *unstructured.Unstructured) (bool, string) {
+	observedGeneration, found, err := unstructured.NestedInt64(vsvc.Object, "status", "observedGeneration")
+	if err != nil || !found {
+		// istiod reports the observed generation as a string in some versions
+		value, _, _ := unstructured.NestedString(vsvc.Object, "status", "observedGeneration")
+		if value == "" {
+			return false, "no status reported by istiod, status reporting may be disabled"
+		}
+		observedGeneration, err = strconv.ParseInt(value, 10, 64)
+		if err != nil {
+			return false, fmt.Sprintf("invalid observed generation %q", value)
+		}
+	}
+	if observedGeneration != vsvc.GetGeneration() {
+		return false, fmt.Sprintf("observed generation %d is not the current generation %d", observedGeneration, vsvc.GetGeneration())
+	}
+	validationMessages, _, _ := unstructured.NestedSlice(vsvc.Object, "status", "validationMessages")
+	for _, message := range validationMessages {
+		typedMessage, ok := message.(map[string]any)
+		if !ok {
+			continue
+		}
+		if level, _ := typedMessage["level"].(string); strings.EqualFold(level, "error") {
+			code, _, _ := unstructured.NestedString(typedMessage, "type", "code")
+			return false, fmt.Sprintf("validation error %s", code)
+		}
+	}
+	conditions, _, _ := unstructured.NestedSlice(vsvc.Object, "status", "conditions")
+	for _, condition := range conditions {
+		typedCondition, ok := condition.(map[string]any)
+		if !ok || typedCondition["type"] != "Reconciled" {
+			continue
+		}
+		if typedCondition["status"] != string(metav1.ConditionTrue) {
+			message, _ := typedCondition["message"].(string)
+			return false, fmt.Sprintf("not pushed to every proxy: %s", message)
+		}
+		return true, ""
+	}
+	return false, "no Reconciled condition reported by istiod"
 }
 
 // getHttpRouteIndexesToPatch returns array indices of the httpRoutes which need to be patched when updating weights
@@ -1616,7 +2060,7 @@ func (r *Reconciler) RemoveManagedRoutes() error {
 			return nil
 		}
 
-		// First, remove any header/mirror routes created by SetHeaderRoute/SetMirrorRoute steps
+		// First, remove any header/mirror/locality routes created by SetHeaderRoute/SetMirrorRoute/SetLocality steps
 		modified := false
 		if r.rollout.Spec.Strategy.Canary != nil && len(r.rollout.Spec.Strategy.Canary.Steps) > 0 {
 			for _, step := range r.rollout.Spec.Strategy.Canary.Steps {
@@ -1638,6 +2082,15 @@ func (r *Reconciler) RemoveManagedRoutes() error {
 						r.log.Infof("Removed mirror route '%s' from VirtualService", step.SetMirrorRoute.Name)
 					}
 				}
+				if step.SetLocality != nil {
+					err := removeRoute(istioVirtualService, step.SetLocality.Name)
+					if err != nil {
+						log.Warnf("[RemoveManagedRoutes] failed to remove locality route '%s': %v", step.SetLocality.Name, err)
+					} else {
+						modified = true
+						r.log.Infof("Removed locality route '%s' from VirtualService", step.SetLocality.Name)
+					}
+				}
 			}
 		}
 
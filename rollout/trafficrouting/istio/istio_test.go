@@ -23,6 +23,7 @@ import (
 
 	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
 	testutil "github.com/argoproj/argo-rollouts/test/util"
+	"github.com/argoproj/argo-rollouts/utils/defaults"
 	evalUtils "github.com/argoproj/argo-rollouts/utils/evaluate"
 	istioutil "github.com/argoproj/argo-rollouts/utils/istio"
 	"github.com/argoproj/argo-rollouts/utils/record"
@@ -972,6 +973,153 @@ func TestHttpReconcileHeaderRouteHostBased(t *testing.T) {
 	assert.Equal(t, httpRoutes[1].Name, "secondary")
 }
 
+func TestHttpReconcileLocalityRoute(t *testing.T) {
+	ro := rolloutWithHttpRoutes("stable", "canary", "vsvc", []string{"primary"})
+	obj := unstructuredutil.StrToUnstructuredUnsafe(regularVsvc)
+	client := testutil.NewFakeDynamicClient(obj)
+	vsvcLister, druleLister := getIstioListers(client)
+	r := NewReconciler(ro, client, record.NewFakeEventRecorder(), vsvcLister, druleLister, nil)
+	client.ClearActions()
+
+	const localityName = "test-locality-route"
+	r.rollout.Spec.Strategy.Canary.TrafficRouting.ManagedRoutes = []v1alpha1.MangedRoutes{{Name: localityName}}
+	r.rollout.Spec.Strategy.Canary.Steps = []v1alpha1.CanaryStep{{SetLocality: &v1alpha1.SetLocality{Name: localityName}}}
+	getVirtualService := func() *unstructured.Unstructured {
+		iVirtualService, err := client.Resource(istioutil.GetIstioVirtualServiceGVR()).Namespace(r.rollout.Namespace).Get(context.TODO(), ro.Spec.Strategy.Canary.TrafficRouting.Istio.VirtualService.Name, metav1.GetOptions{})
+		assert.NoError(t, err)
+		return iVirtualService
+	}
+
+	err := r.SetLocality(&v1alpha1.SetLocality{
+		Name:  localityName,
+		Zones: []string{"us-east-1a"},
+	})
+	assert.Nil(t, err)
+
+	iVirtualService := getVirtualService()
+	httpRoutes := extractHttpRoutes(t, iVirtualService)
+	assert.Equal(t, localityName, httpRoutes[0].Name)
+	checkDestination(t, httpRoutes[0].Route, "canary", 100)
+	assert.Equal(t, "primary", httpRoutes[1].Name)
+	assert.Equal(t, "secondary", httpRoutes[2].Name)
+	httpRoutesI, err := GetHttpRoutesI(iVirtualService)
+	assert.NoError(t, err)
+	assert.Equal(t, []any{
+		map[string]any{"sourceLabels": map[string]any{"topology.kubernetes.io/zone": "us-east-1a"}},
+	}, httpRoutesI[0].(map[string]any)["match"])
+
+	// widen the canary to a region
+	err = r.SetLocality(&v1alpha1.SetLocality{
+		Name:    localityName,
+		Zones:   []string{"us-east-1a"},
+		Regions: []string{"eu-west-1"},
+	})
+	assert.Nil(t, err)
+
+	iVirtualService = getVirtualService()
+	httpRoutesI, err = GetHttpRoutesI(iVirtualService)
+	assert.NoError(t, err)
+	assert.Len(t, httpRoutesI, 3)
+	assert.Equal(t, []any{
+		map[string]any{"sourceLabels": map[string]any{"topology.kubernetes.io/zone": "us-east-1a"}},
+		map[string]any{"sourceLabels": map[string]any{"topology.kubernetes.io/region": "eu-west-1"}},
+	}, httpRoutesI[0].(map[string]any)["match"])
+
+	err = r.RemoveManagedRoutes()
+	assert.Nil(t, err)
+
+	httpRoutes = extractHttpRoutes(t, getVirtualService())
+	assert.Len(t, httpRoutes, 2)
+	assert.Equal(t, "primary", httpRoutes[0].Name)
+	assert.Equal(t, "secondary", httpRoutes[1].Name)
+
+	// a step without zones and regions removes the route
+	assert.Nil(t, r.SetLocality(&v1alpha1.SetLocality{Name: localityName, Regions: []string{"eu-west-1"}}))
+	assert.Len(t, extractHttpRoutes(t, getVirtualService()), 3)
+	assert.Nil(t, r.SetLocality(&v1alpha1.SetLocality{Name: localityName}))
+	assert.Len(t, extractHttpRoutes(t, getVirtualService()), 2)
+}
+
+const stickyVsvc = `apiVersion: networking.istio.io/v1alpha3
+kind: VirtualService
+metadata:
+  name: vsvc
+  namespace: default
+spec:
+  hosts:
+  - istio-rollout.dev.argoproj.io
+  http:
+  - name: primary
+    match:
+    - uri:
+        prefix: /api
+    route:
+    - destination:
+        host: rollout-service
+        subset: stable
+      weight: 100
+    - destination:
+        host: rollout-service
+        subset: canary
+      weight: 0`
+
+func TestHttpReconcileStickiness(t *testing.T) {
+	ro := rolloutWithDestinationRule(nil)
+	ro.Spec.Strategy.Canary.TrafficRouting.Istio.VirtualService.Name = "vsvc"
+	ro.Spec.Strategy.Canary.TrafficRouting.Stickiness = &v1alpha1.TrafficStickiness{Cookie: "session", DurationSeconds: 60}
+	ro.Status.CurrentPodHash = "abc123"
+	client := testutil.NewFakeDynamicClient(unstructuredutil.StrToUnstructuredUnsafe(stickyVsvc))
+	r := NewReconciler(ro, client, record.NewFakeEventRecorder(), nil, nil, nil)
+	getVirtualService := func() *unstructured.Unstructured {
+		iVirtualService, err := client.Resource(istioutil.GetIstioVirtualServiceGVR()).Namespace(r.rollout.Namespace).Get(context.TODO(), "vsvc", metav1.GetOptions{})
+		assert.NoError(t, err)
+		return iVirtualService
+	}
+	setCookie := map[string]any{"response": map[string]any{"set": map[string]any{"Set-Cookie": "session-canary=abc123; Path=/; Max-Age=60"}}}
+
+	err := r.SetWeight(20)
+	assert.NoError(t, err)
+	iVirtualService := getVirtualService()
+	assert.Equal(t, "session-canary", iVirtualService.GetAnnotations()[StickyCookieAnnotation])
+	httpRoutes := extractHttpRoutes(t, iVirtualService)
+	assert.Len(t, httpRoutes, 2)
+	assert.Equal(t, "rollouts-sticky-primary", httpRoutes[0].Name)
+	assert.Len(t, httpRoutes[0].Route, 1)
+	assert.Equal(t, "canary", httpRoutes[0].Route[0].Destination.Subset)
+	assert.Equal(t, "primary", httpRoutes[1].Name)
+	httpRoutesI, err := GetHttpRoutesI(iVirtualService)
+	assert.NoError(t, err)
+	stickyRoute := httpRoutesI[0].(map[string]any)
+	assert.Equal(t, []any{map[string]any{
+		"uri":     map[string]any{"prefix": "/api"},
+		"headers": map[string]any{"cookie": map[string]any{"regex": `^(.*;\s*)?session-canary=abc123(;.*)?$`}},
+	}}, stickyRoute["match"])
+	assert.Equal(t, setCookie, stickyRoute["route"].([]any)[0].(map[string]any)["headers"])
+	weightedDestinations := httpRoutesI[1].(map[string]any)["route"].([]any)
+	assert.NotContains(t, weightedDestinations[0].(map[string]any), "headers")
+	assert.Equal(t, setCookie, weightedDestinations[1].(map[string]any)["headers"])
+
+	// reconciling the same weight leaves the VirtualService untouched
+	client.ClearActions()
+	err = r.SetWeight(20)
+	assert.NoError(t, err)
+	for _, action := range client.Actions() {
+		assert.NotEqual(t, "update", action.GetVerb())
+	}
+
+	// the pinned clients are released once the canary has no weight
+	err = r.SetWeight(0)
+	assert.NoError(t, err)
+	iVirtualService = getVirtualService()
+	assert.NotContains(t, iVirtualService.GetAnnotations(), StickyCookieAnnotation)
+	httpRoutesI, err = GetHttpRoutesI(iVirtualService)
+	assert.NoError(t, err)
+	assert.Len(t, httpRoutesI, 1)
+	for _, dest := range httpRoutesI[0].(map[string]any)["route"].([]any) {
+		assert.NotContains(t, dest.(map[string]any), "headers")
+	}
+}
+
 func TestHttpReconcileHeaderRouteSubsetBased(t *testing.T) {
 	ro := rolloutWithDestinationRule(nil)
 	const StableSubsetName = "stable-subset"
@@ -1795,6 +1943,24 @@ func TestReconcileInferredSingleRoute(t *testing.T) {
 	checkDestination(t, tcpRoute.Route, "canary", 10)
 }
 
+func TestDetectDrift(t *testing.T) {
+	ro := rolloutWithHttpRoutes("stable", "canary", "vsvc", []string{"primary"})
+	client := testutil.NewFakeDynamicClient(unstructuredutil.StrToUnstructuredUnsafe(reconciledVsvc))
+	r := NewReconciler(ro, client, record.NewFakeEventRecorder(), nil, nil, nil)
+
+	drift, err := r.DetectDrift(10)
+	assert.NoError(t, err)
+	assert.Empty(t, drift)
+
+	drift, err = r.DetectDrift(20)
+	assert.NoError(t, err)
+	assert.Equal(t, "VirtualService `vsvc` routes do not have the desired weights", drift)
+	// detecting drift never updates the VirtualService
+	for _, action := range client.Actions() {
+		assert.Equal(t, "get", action.GetVerb())
+	}
+}
+
 func TestType(t *testing.T) {
 	client := testutil.NewFakeDynamicClient()
 	ro := rolloutWithHttpRoutes("stable", "canary", "vsvc", []string{"primary"})
@@ -1802,6 +1968,105 @@ func TestType(t *testing.T) {
 	assert.Equal(t, Type, r.Type())
 }
 
+const reconciledVsvc = `apiVersion: networking.istio.io/v1alpha3
+kind: VirtualService
+metadata:
+  name: vsvc
+  namespace: default
+  generation: 2
+spec:
+  hosts:
+  - istio-rollout.dev.argoproj.io
+  http:
+  - name: primary
+    route:
+    - destination:
+        host: stable
+      weight: 90
+    - destination:
+        host: canary
+      weight: 10
+status:
+  observedGeneration: 2
+  conditions:
+  - type: Reconciled
+    status: "True"
+    message: 3/3 proxies up to date.`
+
+func TestVerifyWeight(t *testing.T) {
+	newVirtualService := func(t *testing.T, mutate func(obj *unstructured.Unstructured)) *unstructured.Unstructured {
+		t.Helper()
+		// round trip through JSON so that numbers are decoded as int64
+		data, err := unstructuredutil.StrToUnstructuredUnsafe(reconciledVsvc).MarshalJSON()
+		assert.NoError(t, err)
+		obj := &unstructured.Unstructured{}
+		assert.NoError(t, obj.UnmarshalJSON(data))
+		if mutate != nil {
+			mutate(obj)
+		}
+		return obj
+	}
+	verifyWeight := func(t *testing.T, obj *unstructured.Unstructured, desiredWeight int32) *bool {
+		t.Helper()
+		ro := rolloutWithHttpRoutes("stable", "canary", "vsvc", []string{"primary"})
+		r := NewReconciler(ro, testutil.NewFakeDynamicClient(obj), record.NewFakeEventRecorder(), nil, nil, nil)
+		verified, err := r.VerifyWeight(desiredWeight)
+		assert.NoError(t, err)
+		return verified
+	}
+
+	t.Run("disabled", func(t *testing.T) {
+		assert.Nil(t, verifyWeight(t, newVirtualService(t, nil), 10))
+	})
+
+	defaults.SetVerifyIstioWeight(true)
+	defer defaults.SetVerifyIstioWeight(false)
+
+	t.Run("verified", func(t *testing.T) {
+		assert.Equal(t, ptr.To(true), verifyWeight(t, newVirtualService(t, nil), 10))
+	})
+	t.Run("weights not updated", func(t *testing.T) {
+		assert.Equal(t, ptr.To(false), verifyWeight(t, newVirtualService(t, nil), 20))
+	})
+	t.Run("generation not observed", func(t *testing.T) {
+		obj := newVirtualService(t, func(obj *unstructured.Unstructured) {
+			obj.SetGeneration(3)
+		})
+		assert.Equal(t, ptr.To(false), verifyWeight(t, obj, 10))
+	})
+	t.Run("not pushed to every proxy", func(t *testing.T) {
+		obj := newVirtualService(t, func(obj *unstructured.Unstructured) {
+			unstructured.SetNestedSlice(obj.Object, []any{map[string]any{"type": "Reconciled", "status": "False", "message": "2/3 proxies up to date."}}, "status", "conditions")
+		})
+		assert.Equal(t, ptr.To(false), verifyWeight(t, obj, 10))
+	})
+	t.Run("validation error", func(t *testing.T) {
+		obj := newVirtualService(t, func(obj *unstructured.Unstructured) {
+			unstructured.SetNestedSlice(obj.Object, []any{map[string]any{"level": "ERROR", "type": map[string]any{"code": "IST0101"}}}, "status", "validationMessages")
+		})
+		assert.Equal(t, ptr.To(false), verifyWeight(t, obj, 10))
+	})
+	t.Run("status reporting disabled", func(t *testing.T) {
+		obj := newVirtualService(t, func(obj *unstructured.Unstructured) {
+			unstructured.RemoveNestedField(obj.Object, "status")
+		})
+		assert.Equal(t, ptr.To(false), verifyWeight(t, obj, 10))
+	})
+	t.Run("observed generation as string", func(t *testing.T) {
+		obj := newVirtualService(t, func(obj *unstructured.Unstructured) {
+			unstructured.SetNestedField(obj.Object, "2", "status", "observedGeneration")
+		})
+		assert.Equal(t, ptr.To(true), verifyWeight(t, obj, 10))
+	})
+	t.Run("virtual service not found", func(t *testing.T) {
+		ro := rolloutWithHttpRoutes("stable", "canary", "vsvc", []string{"primary"})
+		r := NewReconciler(ro, testutil.NewFakeDynamicClient(), record.NewFakeEventRecorder(), nil, nil, nil)
+		verified, err := r.VerifyWeight(10)
+		assert.Error(t, err)
+		assert.Nil(t, verified)
+	})
+}
+
 func TestInvalidPatches(t *testing.T) {
 	patches := virtualServicePatches{{
 		routeIndex:       0,
@@ -2199,6 +2464,67 @@ spec:
 		string(jsonBytes))
 }
 
+// TestUpdateHashWithStickiness verifies UpdateHash sets and reverts the consistent hash of the subsets
+func TestUpdateHashWithStickiness(t *testing.T) {
+	ro := rolloutWithDestinationRule(nil)
+	ro.Spec.Strategy.Canary.TrafficRouting.Stickiness = &v1alpha1.TrafficStickiness{Cookie: "session", DurationSeconds: 60}
+	obj := unstructuredutil.StrToUnstructuredUnsafe(`
+apiVersion: networking.istio.io/v1alpha3
+kind: DestinationRule
+metadata:
+  name: istio-destrule
+  namespace: default
+spec:
+  host: ratings.prod.svc.cluster.local
+  subsets:
+  - name: stable
+  - name: canary
+    trafficPolicy:
+      connectionPool:
+        tcp:
+          maxConnections: 100
+      loadBalancer:
+        simple: ROUND_ROBIN
+`)
+	client := testutil.NewFakeDynamicClient(obj)
+	r := NewReconciler(ro, client, record.NewFakeEventRecorder(), nil, nil, nil)
+	getDestinationRule := func() *DestinationRule {
+		dRuleUn, err := client.Resource(istioutil.GetIstioDestinationRuleGVR()).Namespace(r.rollout.Namespace).Get(context.TODO(), "istio-destrule", metav1.GetOptions{})
+		assert.NoError(t, err)
+		_, dRule, _, err := unstructuredToDestinationRules(dRuleUn)
+		assert.NoError(t, err)
+		return dRule
+	}
+
+	err := r.UpdateHash("abc123", "def456")
+	assert.NoError(t, err)
+	dRule := getDestinationRule()
+	assert.Equal(t, "true", dRule.Annotations[StickySubsetsAnnotation])
+	consistentHash := map[string]any{
+		"consistentHash": map[string]any{"httpCookie": map[string]any{"name": "session", "ttl": "60s"}},
+	}
+	assert.Equal(t, map[string]any{"trafficPolicy": map[string]any{"loadBalancer": consistentHash}}, dRule.Spec.Subsets[0].Extra)
+	assert.Equal(t, consistentHash, dRule.Spec.Subsets[1].Extra["trafficPolicy"].(map[string]any)["loadBalancer"])
+
+	ro.Spec.Strategy.Canary.TrafficRouting.Stickiness.Cookie = ""
+	ro.Spec.Strategy.Canary.TrafficRouting.Stickiness.Header = "x-user"
+	err = r.UpdateHash("abc123", "def456")
+	assert.NoError(t, err)
+	dRule = getDestinationRule()
+	assert.Equal(t, map[string]any{"consistentHash": map[string]any{"httpHeaderName": "x-user"}},
+		dRule.Spec.Subsets[0].Extra["trafficPolicy"].(map[string]any)["loadBalancer"])
+
+	// removing the stickiness reverts the consistent hash and keeps the rest of the traffic policy
+	ro.Spec.Strategy.Canary.TrafficRouting.Stickiness = nil
+	err = r.UpdateHash("abc123", "def456")
+	assert.NoError(t, err)
+	dRule = getDestinationRule()
+	assert.NotContains(t, dRule.Annotations, StickySubsetsAnnotation)
+	assert.Nil(t, dRule.Spec.Subsets[0].Extra)
+	assert.Equal(t, map[string]any{"connectionPool": map[string]any{"tcp": map[string]any{"maxConnections": float64(100)}}},
+		dRule.Spec.Subsets[1].Extra["trafficPolicy"])
+}
+
 // TestUpdateHashWithListers verifies behavior of UpdateHash when using informers/listers
 func TestUpdateHashWithListers(t *testing.T) {
 	ro := rolloutWithDestinationRule(nil)
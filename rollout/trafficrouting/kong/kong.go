@@ -0,0 +1,265 @@
+package kong
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/sirupsen/logrus"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/utils/ptr"
+
+	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
+	logutil "github.com/argoproj/argo-rollouts/utils/log"
+	"github.com/argoproj/argo-rollouts/utils/record"
+	"github.com/argoproj/argo-rollouts/utils/weightutil"
+)
+
+// Type holds this controller type
+const Type = "Kong"
+
+const (
+	// KongAPIGroup is the API group of the configuration resources of Kong. The KongTargets are provided by the
+	// Kong Gateway Operator and the KongPlugins by the Kong Ingress Controller.
+	KongAPIGroup = "configuration.konghq.com"
+
+	// CanaryPluginName is the name of the Kong plugin sending a percentage of the requests to the canary upstream
+	CanaryPluginName = "canary"
+
+	// maxTargetWeight is the highest weight Kong accepts for an upstream target
+	maxTargetWeight = 65535
+
+	kongTargetUpdateError = "KongTargetUpdateError"
+	kongPluginUpdateError = "KongPluginUpdateError"
+)
+
+// GetKongTargetGVR returns the GroupVersionResource of the KongTargets
+func GetKongTargetGVR() schema.GroupVersionResource {
+	return schema.GroupVersionResource{
+		Group:    KongAPIGroup,
+		Version:  "v1alpha1",
+		Resource: "kongtargets",
+	}
+}
+
+// GetKongPluginGVR returns the GroupVersionResource of the KongPlugins
+func GetKongPluginGVR() schema.GroupVersionResource {
+	return schema.GroupVersionResource{
+		Group:    KongAPIGroup,
+		Version:  "v1",
+		Resource: "kongplugins",
+	}
+}
+
+// ReconcilerConfig describes static configuration data for the Kong reconciler
+type ReconcilerConfig struct {
+	Rollout  *v1alpha1.Rollout
+	Client   dynamic.Interface
+	Recorder record.EventRecorder
+}
+
+// Reconciler holds required fields to reconcile the Kong upstream targets or canary plugin
+type Reconciler struct {
+	cfg ReconcilerConfig
+	log *logrus.Entry
+}
+
+// NewReconciler returns a reconciler struct that brings the Kong upstream targets or canary plugin into the
+// desired state
+func NewReconciler(cfg ReconcilerConfig) *Reconciler {
+	return &Reconciler{
+		cfg: cfg,
+		log: logutil.WithRollout(cfg.Rollout),
+	}
+}
+
+// Type indicates this reconciler is a Kong reconciler
+func (r *Reconciler) Type() string {
+	return Type
+}
+
+// UpdateHash informs a traffic routing reconciler about new canary/stable pod hashes
+func (r *Reconciler) UpdateHash(canaryHash, stableHash string, additionalDestinations ...v1alpha1.WeightDestination) error {
+	return nil
+}
+
+// targets returns the names of the stable and the canary targets with their desired weights
+func (r *Reconciler) targets(desiredWeight int32) ([]string, []int64) {
+	kong := r.cfg.Rollout.Spec.Strategy.Canary.TrafficRouting.Kong
+	stableWeight := weightutil.MaxTrafficWeight(r.cfg.Rollout) - desiredWeight
+	return []string{kong.StableTarget, kong.CanaryTarget}, []int64{int64(stableWeight), int64(desiredWeight)}
+}
+
+// canaryPercentage returns the percentage of the requests the canary plugin sends to the canary
+func (r *Reconciler) canaryPercentage(desiredWeight int32) float64 {
+	return float64(desiredWeight) * 100 / float64(weightutil.MaxTrafficWeight(r.cfg.Rollout))
+}
+
+// SetWeight modifies the weights of the stable and the canary targets of the upstream, or the percentage of the
+// canary plugin. Neither splits traffic between more than two destinations, so additional weight destinations are
+// not supported.
+func (r *Reconciler) SetWeight(desiredWeight int32, additionalDestinations ...v1alpha1.WeightDestination) error {
+	ctx := context.TODO()
+	if pluginName := r.cfg.Rollout.Spec.Strategy.Canary.TrafficRouting.Kong.CanaryPlugin; pluginName != "" {
+		return r.setCanaryPercentage(ctx, pluginName, r.canaryPercentage(desiredWeight))
+	}
+	if weightutil.MaxTrafficWeight(r.cfg.Rollout) > maxTargetWeight {
+		return fmt.Errorf("Kong target weights cannot exceed %d", maxTargetWeight)
+	}
+	client := r.cfg.Client.Resource(GetKongTargetGVR()).Namespace(r.cfg.Rollout.Namespace)
+	names, weights := r.targets(desiredWeight)
+	for i, name := range names {
+		target, err := client.Get(ctx, name, metav1.GetOptions{})
+		if err != nil {
+			return err
+		}
+		weight, ok, err := unstructured.NestedInt64(target.Object, "spec", "weight")
+		if err != nil {
+			return err
+		}
+		if ok && weight == weights[i] {
+			continue
+		}
+		if err := unstructured.SetNestedField(target.Object, weights[i], "spec", "weight"); err != nil {
+			return err
+		}
+		if _, err := client.Update(ctx, target, metav1.UpdateOptions{}); err != nil {
+			msg := fmt.Sprintf("Error updating KongTarget %q: %s", name, err)
+			r.sendWarningEvent(kongTargetUpdateError, msg)
+			return err
+		}
+		r.log.Infof("Updated KongTarget %q weight to %d", name, weights[i])
+	}
+	return nil
+}
+
+// setCanaryPercentage sets the percentage of the canary plugin configured by the KongPlugin
+func (r *Reconciler) setCanaryPercentage(ctx context.Context, name string, percentage float64) error {
+	client := r.cfg.Client.Resource(GetKongPluginGVR()).Namespace(r.cfg.Rollout.Namespace)
+	plugin, err := client.Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		return err
+	}
+	current, ok, err := pluginPercentage(plugin)
+	if err != nil {
+		return err
+	}
+	if ok && current == percentage {
+		return nil
+	}
+	if err := unstructured.SetNestedField(plugin.Object, percentage, "config", "percentage"); err != nil {
+		return err
+	}
+	if _, err := client.Update(ctx, plugin, metav1.UpdateOptions{}); err != nil {
+		msg := fmt.Sprintf("Error updating KongPlugin %q: %s", name, err)
+		r.sendWarningEvent(kongPluginUpdateError, msg)
+		return err
+	}
+	r.log.Infof("Updated KongPlugin %q percentage to %v", name, percentage)
+	return nil
+}
+
+// pluginPercentage returns the percentage of the canary plugin configured by the KongPlugin. The configuration
+// must be inline, as the controller cannot update a configuration read from a secret.
+func pluginPercentage(plugin *unstructured.Unstructured) (float64, bool, error) {
+	pluginName, _, _ := unstructured.NestedString(plugin.Object, "plugin")
+	if pluginName != CanaryPluginName {
+		return 0, false, fmt.Errorf("KongPlugin %q configures the %q plugin instead of %q", plugin.GetName(), pluginName, CanaryPluginName)
+	}
+	if _, ok, _ := unstructured.NestedFieldNoCopy(plugin.Object, "configFrom"); ok {
+		return 0, false, fmt.Errorf("KongPlugin %q must configure the %s plugin inline instead of with configFrom", plugin.GetName(), CanaryPluginName)
+	}
+	percentage, ok, err := unstructured.NestedFieldNoCopy(plugin.Object, "config", "percentage")
+	if err != nil || !ok {
+		return 0, false, err
+	}
+	switch value := percentage.(type) {
+	case int64:
+		return float64(value), true, nil
+	case float64:
+		return value, true, nil
+	default:
+		return 0, false, fmt.Errorf("KongPlugin %q has a non-numeric percentage %v", plugin.GetName(), percentage)
+	}
+}
+
+// SetHeaderRoute is not supported by Kong upstream targets nor the canary plugin
+func (r *Reconciler) SetHeaderRoute(headerRouting *v1alpha1.SetHeaderRoute) error {
+	return nil
+}
+
+// SetMirrorRoute is not supported by Kong upstream targets nor the canary plugin
+func (r *Reconciler) SetMirrorRoute(setMirrorRoute *v1alpha1.SetMirrorRoute) error {
+	return nil
+}
+
+// RemoveManagedRoutes is a no-op as Kong upstream targets and the canary plugin have no managed routes
+func (r *Reconciler) RemoveManagedRoutes() error {
+	return nil
+}
+
+// VerifyWeight returns true when the targets carry the desired weights, or the canary plugin the desired
+// percentage, and, for the resources reporting a Programmed condition, Kong programmed their current generation
+func (r *Reconciler) VerifyWeight(desiredWeight int32, additionalDestinations ...v1alpha1.WeightDestination) (*bool, error) {
+	ctx := context.TODO()
+	if pluginName := r.cfg.Rollout.Spec.Strategy.Canary.TrafficRouting.Kong.CanaryPlugin; pluginName != "" {
+		plugin, err := r.cfg.Client.Resource(GetKongPluginGVR()).Namespace(r.cfg.Rollout.Namespace).Get(ctx, pluginName, metav1.GetOptions{})
+		if err != nil {
+			return nil, err
+		}
+		percentage, _, err := pluginPercentage(plugin)
+		if err != nil {
+			return nil, err
+		}
+		if percentage != r.canaryPercentage(desiredWeight) {
+			return ptr.To(false), nil
+		}
+		if !programmed(plugin) {
+			r.log.Infof("KongPlugin %q is not yet programmed", pluginName)
+			return ptr.To(false), nil
+		}
+		return ptr.To(true), nil
+	}
+	client := r.cfg.Client.Resource(GetKongTargetGVR()).Namespace(r.cfg.Rollout.Namespace)
+	names, weights := r.targets(desiredWeight)
+	for i, name := range names {
+		target, err := client.Get(ctx, name, metav1.GetOptions{})
+		if err != nil {
+			return nil, err
+		}
+		weight, _, err := unstructured.NestedInt64(target.Object, "spec", "weight")
+		if err != nil {
+			return nil, err
+		}
+		if weight != weights[i] {
+			return ptr.To(false), nil
+		}
+		if !programmed(target) {
+			r.log.Infof("KongTarget %q is not yet programmed", name)
+			return ptr.To(false), nil
+		}
+	}
+	return ptr.To(true), nil
+}
+
+// programmed returns false when the Programmed condition of the resource is not true for its current
+// generation. Resources without the condition are considered programmed.
+func programmed(resource *unstructured.Unstructured) bool {
+	conditions, _, _ := unstructured.NestedSlice(resource.Object, "status", "conditions")
+	for _, condition := range conditions {
+		typedCondition, ok := condition.(map[string]any)
+		if !ok || typedCondition["type"] != "Programmed" {
+			continue
+		}
+		observedGeneration, ok, _ := unstructured.NestedInt64(typedCondition, "observedGeneration")
+		return typedCondition["status"] == string(metav1.ConditionTrue) && (!ok || observedGeneration == resource.GetGeneration())
+	}
+	return true
+}
+
+func (r *Reconciler) sendWarningEvent(id, msg string) {
+	r.cfg.Recorder.Eventf(r.cfg.Rollout, record.EventOptions{EventType: corev1.EventTypeWarning, EventReason: id}, msg)
+}
@@ -0,0 +1,288 @@
+package kong
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/client-go/dynamic/fake"
+	"k8s.io/utils/ptr"
+
+	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
+	testutil "github.com/argoproj/argo-rollouts/test/util"
+	"github.com/argoproj/argo-rollouts/utils/record"
+)
+
+const stableTarget = `
+apiVersion: configuration.konghq.com/v1alpha1
+kind: KongTarget
+metadata:
+  name: stable-target
+  namespace: default
+  generation: 1
+spec:
+  upstreamRef:
+    name: upstream
+  target: stable-service.default.svc:80
+  weight: 100
+`
+
+const canaryTarget = `
+apiVersion: configuration.konghq.com/v1alpha1
+kind: KongTarget
+metadata:
+  name: canary-target
+  namespace: default
+  generation: 1
+spec:
+  upstreamRef:
+    name: upstream
+  target: canary-service.default.svc:80
+  weight: 0
+status:
+  conditions:
+  - type: Programmed
+    status: "True"
+    observedGeneration: 1
+`
+
+func newReconciler(targets ...string) (*Reconciler, *fake.FakeDynamicClient) {
+	client := testutil.NewFakeDynamicClientFromYAML(targets...)
+	r := NewReconciler(ReconcilerConfig{
+		Rollout: testutil.NewCanaryRollout(&v1alpha1.RolloutTrafficRouting{
+			Kong: &v1alpha1.KongTrafficRouting{
+				StableTarget: "stable-target",
+				CanaryTarget: "canary-target",
+			},
+		}),
+		Client:   client,
+		Recorder: record.NewFakeEventRecorder(),
+	})
+	return r, client
+}
+
+func getTarget(t *testing.T, client *fake.FakeDynamicClient, name string) *unstructured.Unstructured {
+	t.Helper()
+	target, err := client.Resource(GetKongTargetGVR()).Namespace("default").Get(context.TODO(), name, metav1.GetOptions{})
+	assert.NoError(t, err)
+	return target
+}
+
+func getWeight(t *testing.T, client *fake.FakeDynamicClient, name string) int64 {
+	t.Helper()
+	weight, _, err := unstructured.NestedInt64(getTarget(t, client, name).Object, "spec", "weight")
+	assert.NoError(t, err)
+	return weight
+}
+
+func TestType(t *testing.T) {
+	r, _ := newReconciler()
+	assert.Equal(t, Type, r.Type())
+	assert.NoError(t, r.UpdateHash("canary", "stable"))
+	assert.NoError(t, r.SetHeaderRoute(&v1alpha1.SetHeaderRoute{Name: "header-route"}))
+	assert.NoError(t, r.SetMirrorRoute(&v1alpha1.SetMirrorRoute{Name: "mirror-route"}))
+	assert.NoError(t, r.RemoveManagedRoutes())
+}
+
+func TestSetWeight(t *testing.T) {
+	r, client := newReconciler(stableTarget, canaryTarget)
+	assert.NoError(t, r.SetWeight(30))
+	assert.Equal(t, int64(70), getWeight(t, client, "stable-target"))
+	assert.Equal(t, int64(30), getWeight(t, client, "canary-target"))
+	assert.Equal(t, 2, testutil.CountUpdates(client))
+
+	// targets already at the desired weight are not updated
+	assert.NoError(t, r.SetWeight(30))
+	assert.Equal(t, 2, testutil.CountUpdates(client))
+
+	r.cfg.Rollout.Spec.Strategy.Canary.TrafficRouting.MaxTrafficWeight = ptr.To[int32](1000)
+	assert.NoError(t, r.SetWeight(300))
+	assert.Equal(t, int64(700), getWeight(t, client, "stable-target"))
+	assert.Equal(t, int64(300), getWeight(t, client, "canary-target"))
+}
+
+func TestSetWeightMaxTrafficWeightTooHigh(t *testing.T) {
+	r, _ := newReconciler(stableTarget, canaryTarget)
+	r.cfg.Rollout.Spec.Strategy.Canary.TrafficRouting.MaxTrafficWeight = ptr.To[int32](100000)
+	assert.EqualError(t, r.SetWeight(30), "Kong target weights cannot exceed 65535")
+}
+
+func TestSetWeightMissingTarget(t *testing.T) {
+	r, _ := newReconciler(stableTarget)
+	assert.Error(t, r.SetWeight(30))
+}
+
+func TestSetWeightUpdateError(t *testing.T) {
+	r, client := newReconciler(stableTarget, canaryTarget)
+	testutil.FailUpdates(client, "kongtargets")
+	recorder := record.NewFakeEventRecorder()
+	r.cfg.Recorder = recorder
+	assert.EqualError(t, r.SetWeight(30), testutil.UpdateError)
+	assert.Equal(t, []string{kongTargetUpdateError}, recorder.Events())
+}
+
+func TestVerifyWeight(t *testing.T) {
+	t.Run("weights applied and targets programmed", func(t *testing.T) {
+		r, _ := newReconciler(stableTarget, canaryTarget)
+		assert.NoError(t, r.SetWeight(30))
+		verified, err := r.VerifyWeight(30)
+		assert.NoError(t, err)
+		assert.True(t, *verified)
+	})
+
+	t.Run("weights not applied", func(t *testing.T) {
+		r, _ := newReconciler(stableTarget, canaryTarget)
+		verified, err := r.VerifyWeight(30)
+		assert.NoError(t, err)
+		assert.False(t, *verified)
+	})
+
+	t.Run("target not yet programmed for the current generation", func(t *testing.T) {
+		r, client := newReconciler(stableTarget, canaryTarget)
+		assert.NoError(t, r.SetWeight(30))
+		target := getTarget(t, client, "canary-target")
+		target.SetGeneration(2)
+		_, err := client.Resource(GetKongTargetGVR()).Namespace("default").Update(context.TODO(), target, metav1.UpdateOptions{})
+		assert.NoError(t, err)
+		verified, err := r.VerifyWeight(30)
+		assert.NoError(t, err)
+		assert.False(t, *verified)
+	})
+
+	t.Run("missing target", func(t *testing.T) {
+		r, _ := newReconciler(stableTarget)
+		verified, err := r.VerifyWeight(0)
+		assert.Error(t, err)
+		assert.Nil(t, verified)
+	})
+}
+
+const canaryPlugin = `
+apiVersion: configuration.konghq.com/v1
+kind: KongPlugin
+metadata:
+  name: canary-plugin
+  namespace: default
+  generation: 1
+plugin: canary
+config:
+  percentage: 0
+  upstream_host: canary-service.default.svc
+  upstream_port: 80
+  hash: none
+status:
+  conditions:
+  - type: Programmed
+    status: "True"
+    observedGeneration: 1
+`
+
+func newPluginReconciler(plugins ...string) (*Reconciler, *fake.FakeDynamicClient) {
+	r, client := newReconciler(plugins...)
+	r.cfg.Rollout.Spec.Strategy.Canary.TrafficRouting.Kong = &v1alpha1.KongTrafficRouting{CanaryPlugin: "canary-plugin"}
+	return r, client
+}
+
+func getPlugin(t *testing.T, client *fake.FakeDynamicClient) *unstructured.Unstructured {
+	t.Helper()
+	plugin, err := client.Resource(GetKongPluginGVR()).Namespace("default").Get(context.TODO(), "canary-plugin", metav1.GetOptions{})
+	assert.NoError(t, err)
+	return plugin
+}
+
+func TestSetWeightCanaryPlugin(t *testing.T) {
+	r, client := newPluginReconciler(canaryPlugin)
+	assert.NoError(t, r.SetWeight(30))
+	plugin := getPlugin(t, client)
+	percentage, _, err := pluginPercentage(plugin)
+	assert.NoError(t, err)
+	assert.Equal(t, float64(30), percentage)
+	upstreamHost, _, _ := unstructured.NestedString(plugin.Object, "config", "upstream_host")
+	assert.Equal(t, "canary-service.default.svc", upstreamHost)
+	assert.Equal(t, 1, testutil.CountUpdates(client))
+
+	// a plugin already at the desired percentage is not updated
+	assert.NoError(t, r.SetWeight(30))
+	assert.Equal(t, 1, testutil.CountUpdates(client))
+
+	r.cfg.Rollout.Spec.Strategy.Canary.TrafficRouting.MaxTrafficWeight = ptr.To[int32](1000)
+	assert.NoError(t, r.SetWeight(125))
+	percentage, _, err = pluginPercentage(getPlugin(t, client))
+	assert.NoError(t, err)
+	assert.Equal(t, 12.5, percentage)
+}
+
+func TestSetWeightCanaryPluginInvalid(t *testing.T) {
+	t.Run("missing plugin", func(t *testing.T) {
+		r, _ := newPluginReconciler()
+		assert.Error(t, r.SetWeight(30))
+	})
+
+	t.Run("other plugin", func(t *testing.T) {
+		r, _ := newPluginReconciler(strings.Replace(canaryPlugin, "plugin: canary", "plugin: rate-limiting", 1))
+		assert.EqualError(t, r.SetWeight(30), `KongPlugin "canary-plugin" configures the "rate-limiting" plugin instead of "canary"`)
+	})
+
+	t.Run("configuration from a secret", func(t *testing.T) {
+		r, _ := newPluginReconciler(`
+apiVersion: configuration.konghq.com/v1
+kind: KongPlugin
+metadata:
+  name: canary-plugin
+  namespace: default
+plugin: canary
+configFrom:
+  secretKeyRef:
+    name: canary-config
+    key: config
+`)
+		assert.EqualError(t, r.SetWeight(30), `KongPlugin "canary-plugin" must configure the canary plugin inline instead of with configFrom`)
+	})
+
+	t.Run("update error", func(t *testing.T) {
+		r, client := newPluginReconciler(canaryPlugin)
+		testutil.FailUpdates(client, "kongplugins")
+		recorder := record.NewFakeEventRecorder()
+		r.cfg.Recorder = recorder
+		assert.EqualError(t, r.SetWeight(30), testutil.UpdateError)
+		assert.Equal(t, []string{kongPluginUpdateError}, recorder.Events())
+	})
+}
+
+func TestVerifyWeightCanaryPlugin(t *testing.T) {
+	t.Run("percentage applied and plugin programmed", func(t *testing.T) {
+		r, _ := newPluginReconciler(canaryPlugin)
+		verified, err := r.VerifyWeight(0)
+		assert.NoError(t, err)
+		assert.True(t, *verified)
+	})
+
+	t.Run("percentage not applied", func(t *testing.T) {
+		r, _ := newPluginReconciler(canaryPlugin)
+		verified, err := r.VerifyWeight(30)
+		assert.NoError(t, err)
+		assert.False(t, *verified)
+	})
+
+	t.Run("plugin not yet programmed for the current generation", func(t *testing.T) {
+		r, client := newPluginReconciler(canaryPlugin)
+		assert.NoError(t, r.SetWeight(30))
+		plugin := getPlugin(t, client)
+		plugin.SetGeneration(2)
+		_, err := client.Resource(GetKongPluginGVR()).Namespace("default").Update(context.TODO(), plugin, metav1.UpdateOptions{})
+		assert.NoError(t, err)
+		verified, err := r.VerifyWeight(30)
+		assert.NoError(t, err)
+		assert.False(t, *verified)
+	})
+
+	t.Run("missing plugin", func(t *testing.T) {
+		r, _ := newPluginReconciler()
+		verified, err := r.VerifyWeight(0)
+		assert.Error(t, err)
+		assert.Nil(t, verified)
+	})
+}
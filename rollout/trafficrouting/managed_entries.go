@@ -0,0 +1,158 @@
+package trafficrouting
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"slices"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+// ManagedState is stored in an annotation of the traffic routing resources whose entries (e.g. routes or
+// rules) are shared between the user and the rollout. It records the entries added for the managed routes
+// of the rollout and the services added for additional weight destinations, so they can be told apart
+// from the entries and services owned by the user.
+type ManagedState struct {
+	// Routes holds, per managed route, the identities of the entries added for it
+	Routes map[string][]any `json:"routes,omitempty"`
+	// Destinations holds the services of additional weight destinations added to the weighted entries
+	Destinations []string `json:"destinations,omitempty"`
+}
+
+// ManagedEntries tracks the entries of a traffic routing resource added for the managed routes of a
+// rollout
+type ManagedEntries struct {
+	// Annotation is the annotation of the resource holding its ManagedState
+	Annotation string
+	// Identity returns the parts of an entry which the controller never changes after adding it, in the
+	// form returned by CanonicalJSON
+	Identity func(entry map[string]any) any
+}
+
+// Update applies mutate to the entries found at the given fields of the resource and to its managed
+// state. It returns true when the entries or the state changed, in which case the resource is modified
+// and must be updated.
+func (m ManagedEntries) Update(obj *unstructured.Unstructured, mutate func(entries []any, state *ManagedState) ([]any, error), fields ...string) (bool, error) {
+	entries, _, err := unstructured.NestedSlice(obj.Object, fields...)
+	if err != nil {
+		return false, err
+	}
+	state := &ManagedState{}
+	if err := GetAnnotationState(obj, m.Annotation, state); err != nil {
+		return false, err
+	}
+	origState, _ := json.Marshal(state)
+	newEntries, err := mutate(entries, state)
+	if err != nil {
+		return false, err
+	}
+	newState, _ := json.Marshal(state)
+	origEntries, _, _ := unstructured.NestedSlice(obj.Object, fields...)
+	if reflect.DeepEqual(origEntries, newEntries) && string(origState) == string(newState) {
+		return false, nil
+	}
+	if err := unstructured.SetNestedSlice(obj.Object, newEntries, fields...); err != nil {
+		return false, err
+	}
+	SetAnnotationState(obj, m.Annotation, state, len(state.Routes) == 0 && len(state.Destinations) == 0)
+	return true, nil
+}
+
+// ManagedRouteOf returns the managed route the entry was added for, or an empty string for the entries of
+// the user
+func (m ManagedEntries) ManagedRouteOf(entry map[string]any, state *ManagedState) string {
+	identity := m.Identity(entry)
+	for managedRoute, identities := range state.Routes {
+		for _, managedIdentity := range identities {
+			if reflect.DeepEqual(identity, managedIdentity) {
+				return managedRoute
+			}
+		}
+	}
+	return ""
+}
+
+// Add appends the entries added for a managed route and records their identities
+func (m ManagedEntries) Add(entries []any, state *ManagedState, managedRoute string, managedEntries []any) []any {
+	if len(managedEntries) == 0 {
+		return entries
+	}
+	if state.Routes == nil {
+		state.Routes = map[string][]any{}
+	}
+	for _, entry := range managedEntries {
+		state.Routes[managedRoute] = append(state.Routes[managedRoute], m.Identity(entry.(map[string]any)))
+	}
+	return append(entries, managedEntries...)
+}
+
+// Remove removes the entries added for a managed route and forgets their identities
+func (m ManagedEntries) Remove(entries []any, state *ManagedState, managedRoute string) []any {
+	if _, ok := state.Routes[managedRoute]; !ok {
+		return entries
+	}
+	remaining := []any{}
+	for _, entry := range entries {
+		if typedEntry, ok := entry.(map[string]any); ok && m.ManagedRouteOf(typedEntry, state) == managedRoute {
+			continue
+		}
+		remaining = append(remaining, entry)
+	}
+	delete(state.Routes, managedRoute)
+	return remaining
+}
+
+// PruneDestinations drops, from the backends of a weighted entry, the services of the additional weight
+// destinations recorded in the state which are no longer part of the canary
+func PruneDestinations(backends []any, state *ManagedState, destinations []string) []any {
+	remaining := []any{}
+	for _, backend := range backends {
+		if typedBackend, ok := backend.(map[string]any); ok {
+			name, _, _ := unstructured.NestedString(typedBackend, "name")
+			if slices.Contains(state.Destinations, name) && !slices.Contains(destinations, name) {
+				continue
+			}
+		}
+		remaining = append(remaining, backend)
+	}
+	return remaining
+}
+
+// CanonicalJSON returns the value in the form it has once stored in an annotation and decoded again, so
+// it can be compared with the values read from the annotation
+func CanonicalJSON(value any) any {
+	data, _ := json.Marshal(value)
+	var canonical any
+	_ = json.Unmarshal(data, &canonical)
+	return canonical
+}
+
+// GetAnnotationState decodes the JSON stored in the annotation of the resource into state, which is left
+// untouched when the annotation is not set
+func GetAnnotationState(obj *unstructured.Unstructured, annotation string, state any) error {
+	value, ok := obj.GetAnnotations()[annotation]
+	if !ok {
+		return nil
+	}
+	if err := json.Unmarshal([]byte(value), state); err != nil {
+		return fmt.Errorf("failed to decode annotation %s of %s: %w", annotation, obj.GetName(), err)
+	}
+	return nil
+}
+
+// SetAnnotationState stores state as JSON in the annotation of the resource, or removes the annotation
+// when the state is empty
+func SetAnnotationState(obj *unstructured.Unstructured, annotation string, state any, empty bool) {
+	annotations := obj.GetAnnotations()
+	if empty {
+		delete(annotations, annotation)
+	} else {
+		if annotations == nil {
+			annotations = map[string]string{}
+		}
+		value, _ := json.Marshal(state)
+		annotations[annotation] = string(value)
+	}
+	obj.SetAnnotations(annotations)
+}
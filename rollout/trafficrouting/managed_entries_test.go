@@ -0,0 +1,82 @@
+package trafficrouting
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+const testAnnotation = "rollouts.argoproj.io/test-managed-routes"
+
+var testEntries = ManagedEntries{
+	Annotation: testAnnotation,
+	Identity: func(entry map[string]any) any {
+		return CanonicalJSON(entry["match"])
+	},
+}
+
+func newResource(entries ...any) *unstructured.Unstructured {
+	obj := &unstructured.Unstructured{Object: map[string]any{}}
+	obj.SetName("resource")
+	_ = unstructured.SetNestedSlice(obj.Object, entries, "spec", "entries")
+	return obj
+}
+
+func TestManagedEntriesUpdate(t *testing.T) {
+	userEntry := map[string]any{"match": "user", "backends": []any{map[string]any{"name": "stable-service"}}}
+	obj := newResource(userEntry)
+
+	changed, err := testEntries.Update(obj, func(entries []any, state *ManagedState) ([]any, error) {
+		return testEntries.Add(entries, state, "header-route", []any{map[string]any{"match": "header"}}), nil
+	}, "spec", "entries")
+	assert.NoError(t, err)
+	assert.True(t, changed)
+	assert.Equal(t, `{"routes":{"header-route":["header"]}}`, obj.GetAnnotations()[testAnnotation])
+	entries, _, _ := unstructured.NestedSlice(obj.Object, "spec", "entries")
+	assert.Len(t, entries, 2)
+
+	state := &ManagedState{}
+	assert.NoError(t, GetAnnotationState(obj, testAnnotation, state))
+	assert.Equal(t, "header-route", testEntries.ManagedRouteOf(entries[1].(map[string]any), state))
+	assert.Equal(t, "", testEntries.ManagedRouteOf(userEntry, state))
+
+	// nothing changes when the entries and the state are kept
+	changed, err = testEntries.Update(obj, func(entries []any, state *ManagedState) ([]any, error) {
+		return entries, nil
+	}, "spec", "entries")
+	assert.NoError(t, err)
+	assert.False(t, changed)
+
+	// the annotation is removed with the last managed entry
+	changed, err = testEntries.Update(obj, func(entries []any, state *ManagedState) ([]any, error) {
+		return testEntries.Remove(entries, state, "header-route"), nil
+	}, "spec", "entries")
+	assert.NoError(t, err)
+	assert.True(t, changed)
+	assert.NotContains(t, obj.GetAnnotations(), testAnnotation)
+	entries, _, _ = unstructured.NestedSlice(obj.Object, "spec", "entries")
+	assert.Equal(t, []any{userEntry}, entries)
+}
+
+func TestManagedEntriesUpdateInvalidAnnotation(t *testing.T) {
+	obj := newResource()
+	obj.SetAnnotations(map[string]string{testAnnotation: "{"})
+	_, err := testEntries.Update(obj, func(entries []any, state *ManagedState) ([]any, error) {
+		return entries, nil
+	}, "spec", "entries")
+	assert.EqualError(t, err, "failed to decode annotation "+testAnnotation+" of resource: unexpected end of JSON input")
+}
+
+func TestPruneDestinations(t *testing.T) {
+	backends := []any{
+		map[string]any{"name": "stable-service"},
+		map[string]any{"name": "canary-service"},
+		map[string]any{"name": "old-experiment-service"},
+		map[string]any{"name": "experiment-service"},
+		map[string]any{"name": "user-service"},
+	}
+	state := &ManagedState{Destinations: []string{"old-experiment-service", "experiment-service"}}
+	remaining := PruneDestinations(backends, state, []string{"experiment-service"})
+	assert.Equal(t, []any{backends[0], backends[1], backends[3], backends[4]}, remaining)
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"regexp"
 	"strings"
 
 	"github.com/sirupsen/logrus"
@@ -25,6 +26,16 @@ import (
 // Type holds this controller type
 const Type = "Nginx"
 
+// HeaderRouteAnnotation holds the name of the managed route whose header match is set on the canary ingress
+const HeaderRouteAnnotation = "rollouts.argoproj.io/nginx-header-route"
+
+// MirrorRouteAnnotation holds the name of the managed route mirroring the requests of the stable ingress to the canary
+const MirrorRouteAnnotation = "rollouts.argoproj.io/nginx-mirror-route"
+
+// headerRouteAnnotationKeys are the canary ingress annotations configuring header based routing, relative to the
+// canary ingress annotation prefix
+var headerRouteAnnotationKeys = []string{"canary-by-header", "canary-by-header-value", "canary-by-header-pattern"}
+
 // ReconcilerConfig describes static configuration data for the nginx reconciler
 type ReconcilerConfig struct {
 	Rollout        *v1alpha1.Rollout
@@ -135,6 +146,8 @@ func (r *Reconciler) buildCanaryIngress(stableIngress *networkingv1.Ingress, nam
 		desiredCanaryIngress.Annotations[k] = v
 	}
 
+	r.setStickinessAnnotation(desiredCanaryIngress.Annotations, annotationPrefix)
+
 	// Always set `canary` and `canary-weight` - `canary-by-header` and `canary-by-cookie`, if set,  will always take precedence
 	desiredCanaryIngress.Annotations[fmt.Sprintf("%s/canary", annotationPrefix)] = "true"
 	desiredCanaryIngress.Annotations[fmt.Sprintf("%s/canary-weight", annotationPrefix)] = fmt.Sprintf("%d", desiredWeight)
@@ -146,6 +159,19 @@ func (r *Reconciler) buildCanaryIngress(stableIngress *networkingv1.Ingress, nam
 	return ingressutil.NewIngress(desiredCanaryIngress), nil
 }
 
+// setStickinessAnnotation keeps the clients whose stickiness cookie or header is set to `always` on the canary and
+// the clients whose cookie or header is set to `never` on the stable version
+func (r *Reconciler) setStickinessAnnotation(annotations map[string]string, annotationPrefix string) {
+	stickiness := r.cfg.Rollout.Spec.Strategy.Canary.TrafficRouting.Stickiness
+	switch {
+	case stickiness == nil:
+	case stickiness.Cookie != "":
+		annotations[fmt.Sprintf("%s/canary-by-cookie", annotationPrefix)] = stickiness.Cookie
+	case stickiness.Header != "":
+		annotations[fmt.Sprintf("%s/canary-by-header", annotationPrefix)] = stickiness.Header
+	}
+}
+
 func (r *Reconciler) buildLegacyCanaryIngress(stableIngress *extensionsv1beta1.Ingress, name string, desiredWeight int32) (*ingressutil.Ingress, error) {
 	stableIngressName := r.cfg.Rollout.Spec.Strategy.Canary.TrafficRouting.Nginx.StableIngress
 	stableServiceName := r.cfg.Rollout.Spec.Strategy.Canary.StableService
@@ -221,6 +247,8 @@ func (r *Reconciler) buildLegacyCanaryIngress(stableIngress *extensionsv1beta1.I
 		desiredCanaryIngress.Annotations[k] = v
 	}
 
+	r.setStickinessAnnotation(desiredCanaryIngress.Annotations, annotationPrefix)
+
 	// Always set `canary` and `canary-weight` - `canary-by-header` and `canary-by-cookie`, if set,  will always take precedence
 	desiredCanaryIngress.Annotations[fmt.Sprintf("%s/canary", annotationPrefix)] = "true"
 	desiredCanaryIngress.Annotations[fmt.Sprintf("%s/canary-weight", annotationPrefix)] = fmt.Sprintf("%d", desiredWeight)
@@ -254,11 +282,7 @@ func (r *Reconciler) canaryIngress(stableIngress *ingressutil.Ingress, name stri
 
 // SetWeight modifies Nginx Ingress resources to reach desired state
 func (r *Reconciler) SetWeight(desiredWeight int32, additionalDestinations ...v1alpha1.WeightDestination) error {
-	if ingresses := r.cfg.Rollout.Spec.Strategy.Canary.TrafficRouting.Nginx.StableIngresses; ingresses != nil {
-		return r.SetWeightPerIngress(desiredWeight, ingresses)
-	} else {
-		return r.SetWeightPerIngress(desiredWeight, []string{r.cfg.Rollout.Spec.Strategy.Canary.TrafficRouting.Nginx.StableIngress})
-	}
+	return r.SetWeightPerIngress(desiredWeight, r.stableIngresses())
 }
 
 // SetWeightMultiIngress modifies each Nginx Ingress resource to reach desired state in the scenario of a rollout
@@ -325,7 +349,7 @@ func (r *Reconciler) SetWeightPerIngress(desiredWeight int32, ingresses []string
 		}
 
 		// Make patches
-		desiredCanaryIngress.SetAnnotations(getDesiredAnnotations(canaryIngress, desiredCanaryIngress))
+		desiredCanaryIngress.SetAnnotations(r.preserveHeaderRoute(canaryIngress, getDesiredAnnotations(canaryIngress, desiredCanaryIngress)))
 		patch, modified, err := ingressutil.BuildIngressPatch(canaryIngress.Mode(), canaryIngress,
 			desiredCanaryIngress, ingressutil.WithAnnotations(), ingressutil.WithLabels(), ingressutil.WithSpec())
 
@@ -352,7 +376,193 @@ func (r *Reconciler) SetWeightPerIngress(desiredWeight int32, ingresses []string
 	return nil
 }
 
+// stableIngresses returns the names of the stable ingresses of the rollout
+func (r *Reconciler) stableIngresses() []string {
+	if ingresses := r.cfg.Rollout.Spec.Strategy.Canary.TrafficRouting.Nginx.StableIngresses; ingresses != nil {
+		return ingresses
+	}
+	return []string{r.cfg.Rollout.Spec.Strategy.Canary.TrafficRouting.Nginx.StableIngress}
+}
+
+// SetHeaderRoute routes the requests matching the header of the route to the canary through the canary-by-header
+// annotations of the canary ingresses. NGINX supports a single header match, so only one header route is active at a
+// time and setting another one replaces it.
 func (r *Reconciler) SetHeaderRoute(headerRouting *v1alpha1.SetHeaderRoute) error {
+	if headerRouting == nil {
+		return nil
+	}
+	if headerRouting.Match == nil {
+		return r.removeHeaderRoute(headerRouting.Name)
+	}
+	headerAnnotations, err := r.headerRouteAnnotations(headerRouting)
+	if err != nil {
+		return err
+	}
+	for _, stableIngressName := range r.stableIngresses() {
+		canaryIngressName := ingressutil.GetCanaryIngressName(r.cfg.Rollout.GetName(), stableIngressName)
+		canaryIngress, err := r.cfg.IngressWrapper.GetCached(r.cfg.Rollout.Namespace, canaryIngressName)
+		if err != nil {
+			if !k8serrors.IsNotFound(err) {
+				return fmt.Errorf("error retrieving canary ingress `%s` from cache: %v", canaryIngressName, err)
+			}
+			// The header route step may run before any weight is set, so the canary ingress is created without
+			// weight here and SetWeight patches its weight afterwards
+			if err := r.createHeaderRouteCanaryIngress(stableIngressName, canaryIngressName, headerAnnotations); err != nil {
+				return err
+			}
+			continue
+		}
+		err = r.patchCanaryIngressAnnotations(canaryIngress, func(annotations map[string]string) {
+			r.deleteHeaderRouteAnnotations(annotations)
+			for k, v := range headerAnnotations {
+				annotations[k] = v
+			}
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// headerRouteAnnotations returns the canary ingress annotations routing the requests matching the header route to the
+// canary
+func (r *Reconciler) headerRouteAnnotations(headerRouting *v1alpha1.SetHeaderRoute) (map[string]string, error) {
+	if len(headerRouting.Match) != 1 {
+		return nil, fmt.Errorf("header route `%s` has %d matches but nginx supports a single header match", headerRouting.Name, len(headerRouting.Match))
+	}
+	match := headerRouting.Match[0]
+	if match.HeaderValue == nil {
+		return nil, fmt.Errorf("header route `%s` has no header value", headerRouting.Name)
+	}
+	annotationPrefix := defaults.GetCanaryIngressAnnotationPrefixOrDefault(r.cfg.Rollout)
+	annotations := map[string]string{
+		HeaderRouteAnnotation:                                headerRouting.Name,
+		fmt.Sprintf("%s/canary-by-header", annotationPrefix): match.HeaderName,
+	}
+	switch {
+	case match.HeaderValue.Exact != "":
+		annotations[fmt.Sprintf("%s/canary-by-header-value", annotationPrefix)] = match.HeaderValue.Exact
+	case match.HeaderValue.Regex != "":
+		annotations[fmt.Sprintf("%s/canary-by-header-pattern", annotationPrefix)] = match.HeaderValue.Regex
+	case match.HeaderValue.Prefix != "":
+		annotations[fmt.Sprintf("%s/canary-by-header-pattern", annotationPrefix)] = "^" + regexp.QuoteMeta(match.HeaderValue.Prefix) + ".*"
+	default:
+		return nil, fmt.Errorf("header route `%s` has no header value", headerRouting.Name)
+	}
+	return annotations, nil
+}
+
+// deleteHeaderRouteAnnotations removes the header route from the annotations of a canary ingress
+func (r *Reconciler) deleteHeaderRouteAnnotations(annotations map[string]string) {
+	annotationPrefix := defaults.GetCanaryIngressAnnotationPrefixOrDefault(r.cfg.Rollout)
+	delete(annotations, HeaderRouteAnnotation)
+	for _, key := range headerRouteAnnotationKeys {
+		delete(annotations, fmt.Sprintf("%s/%s", annotationPrefix, key))
+	}
+}
+
+// preserveHeaderRoute keeps the header route set on the current canary ingress in its desired annotations, which
+// would otherwise be overwritten by any canary-by-header annotations configured on the rollout
+func (r *Reconciler) preserveHeaderRoute(current *ingressutil.Ingress, desiredAnnotations map[string]string) map[string]string {
+	currentAnnotations := current.GetAnnotations()
+	if _, ok := currentAnnotations[HeaderRouteAnnotation]; !ok {
+		return desiredAnnotations
+	}
+	annotationPrefix := defaults.GetCanaryIngressAnnotationPrefixOrDefault(r.cfg.Rollout)
+	for _, key := range headerRouteAnnotationKeys {
+		key = fmt.Sprintf("%s/%s", annotationPrefix, key)
+		if value, ok := currentAnnotations[key]; ok {
+			desiredAnnotations[key] = value
+		} else {
+			delete(desiredAnnotations, key)
+		}
+	}
+	return desiredAnnotations
+}
+
+// removeHeaderRoute removes the header route from the canary ingresses if it is the active one. An empty name
+// removes any header route.
+func (r *Reconciler) removeHeaderRoute(name string) error {
+	for _, stableIngressName := range r.stableIngresses() {
+		canaryIngressName := ingressutil.GetCanaryIngressName(r.cfg.Rollout.GetName(), stableIngressName)
+		canaryIngress, err := r.cfg.IngressWrapper.GetCached(r.cfg.Rollout.Namespace, canaryIngressName)
+		if err != nil {
+			if k8serrors.IsNotFound(err) {
+				continue
+			}
+			return fmt.Errorf("error retrieving canary ingress `%s` from cache: %v", canaryIngressName, err)
+		}
+		activeRoute, ok := canaryIngress.GetAnnotations()[HeaderRouteAnnotation]
+		if !ok || (name != "" && activeRoute != name) {
+			continue
+		}
+		if err := r.patchCanaryIngressAnnotations(canaryIngress, r.deleteHeaderRouteAnnotations); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// createHeaderRouteCanaryIngress creates the canary ingress of a stable ingress without weight and with a header route
+func (r *Reconciler) createHeaderRouteCanaryIngress(stableIngressName, canaryIngressName string, headerAnnotations map[string]string) error {
+	stableIngress, err := r.cfg.IngressWrapper.GetCached(r.cfg.Rollout.Namespace, stableIngressName)
+	if err != nil {
+		return fmt.Errorf("error retrieving stableIngress `%s` from cache: %v", stableIngressName, err)
+	}
+	desiredCanaryIngress, err := r.canaryIngress(stableIngress, canaryIngressName, 0)
+	if err != nil {
+		return err
+	}
+	annotations := desiredCanaryIngress.GetAnnotations()
+	r.deleteHeaderRouteAnnotations(annotations)
+	for k, v := range headerAnnotations {
+		annotations[k] = v
+	}
+	desiredCanaryIngress.SetAnnotations(annotations)
+
+	r.cfg.Recorder.Eventf(r.cfg.Rollout, record.EventOptions{EventReason: "CreatingCanaryIngress"}, "Creating canary ingress `%s` with header route `%s`", canaryIngressName, headerAnnotations[HeaderRouteAnnotation])
+	_, err = r.cfg.IngressWrapper.Create(context.TODO(), r.cfg.Rollout.Namespace, desiredCanaryIngress, metav1.CreateOptions{})
+	if err != nil {
+		return fmt.Errorf("error creating canary ingress `%s`: %v", canaryIngressName, err)
+	}
+	return nil
+}
+
+// patchCanaryIngressAnnotations patches the header route annotations of a canary ingress controlled by the rollout
+func (r *Reconciler) patchCanaryIngressAnnotations(canaryIngress *ingressutil.Ingress, mutate func(annotations map[string]string)) error {
+	if !metav1.IsControlledBy(canaryIngress.GetObjectMeta(), r.cfg.Rollout) {
+		return fmt.Errorf("canary ingress `%s` controlled by different object", canaryIngress.GetName())
+	}
+	return r.patchIngressAnnotations(canaryIngress, "header route", mutate)
+}
+
+// patchIngressAnnotations patches the annotations of an ingress, if mutating them changed them
+func (r *Reconciler) patchIngressAnnotations(ingress *ingressutil.Ingress, route string, mutate func(annotations map[string]string)) error {
+	ingressName := ingress.GetName()
+	desiredIngress := ingress.DeepCopy()
+	annotations := map[string]string{}
+	for k, v := range ingress.GetAnnotations() {
+		annotations[k] = v
+	}
+	mutate(annotations)
+	desiredIngress.SetAnnotations(annotations)
+
+	patch, modified, err := ingressutil.BuildIngressPatch(ingress.Mode(), ingress, desiredIngress, ingressutil.WithAnnotations())
+	if err != nil {
+		return fmt.Errorf("error constructing ingress patch for `%s`: %v", ingressName, err)
+	}
+	if !modified {
+		r.log.WithField(logutil.IngressKey, ingressName).Infof("No changes to ingress %s - skipping patch", route)
+		return nil
+	}
+	r.log.WithField(logutil.IngressKey, ingressName).WithField("patch", string(patch)).Debugf("applying Ingress %s patch", route)
+	r.cfg.Recorder.Eventf(r.cfg.Rollout, record.EventOptions{EventReason: "PatchingIngress"}, "Updating %s of Ingress `%s`", route, ingressName)
+	_, err = r.cfg.IngressWrapper.Patch(context.TODO(), r.cfg.Rollout.Namespace, ingressName, types.MergePatchType, patch, metav1.PatchOptions{})
+	if err != nil {
+		r.log.WithField(logutil.IngressKey, ingressName).WithField("err", err.Error()).Error("error patching ingress")
+		return fmt.Errorf("error patching ingress `%s`: %v", ingressName, err)
+	}
 	return nil
 }
 
@@ -365,14 +575,127 @@ func (r *Reconciler) UpdateHash(canaryHash, stableHash string, additionalDestina
 	return nil
 }
 
+// SetMirrorRoute mirrors the requests of the stable ingresses to the canary service through their mirror-target
+// annotation. NGINX ignores the mirror annotations of canary ingresses, so they are set on the stable ingresses, and
+// since it mirrors all requests of an ingress, only one mirror route without matches is active at a time.
 func (r *Reconciler) SetMirrorRoute(setMirrorRoute *v1alpha1.SetMirrorRoute) error {
+	if setMirrorRoute == nil {
+		return nil
+	}
+	if setMirrorRoute.Match == nil && setMirrorRoute.Percentage == nil {
+		return r.removeMirrorRoute(setMirrorRoute.Name)
+	}
+	for _, match := range setMirrorRoute.Match {
+		if match.Method != nil || match.Path != nil || len(match.Headers) > 0 {
+			return fmt.Errorf("mirror route `%s` has matches but nginx mirrors all requests", setMirrorRoute.Name)
+		}
+	}
+	if setMirrorRoute.Percentage != nil && *setMirrorRoute.Percentage != 100 {
+		return fmt.Errorf("mirror route `%s` mirrors %d%% of requests but nginx mirrors all requests", setMirrorRoute.Name, *setMirrorRoute.Percentage)
+	}
+
+	annotationPrefix := defaults.GetCanaryIngressAnnotationPrefixOrDefault(r.cfg.Rollout)
+	mirrorTargetKey := fmt.Sprintf("%s/mirror-target", annotationPrefix)
+	for _, stableIngressName := range r.stableIngresses() {
+		stableIngress, err := r.cfg.IngressWrapper.GetCached(r.cfg.Rollout.Namespace, stableIngressName)
+		if err != nil {
+			return fmt.Errorf("error retrieving stableIngress `%s` from cache: %v", stableIngressName, err)
+		}
+		annotations := stableIngress.GetAnnotations()
+		if _, managed := annotations[MirrorRouteAnnotation]; !managed && annotations[mirrorTargetKey] != "" {
+			return fmt.Errorf("stable ingress `%s` already mirrors requests to `%s`", stableIngressName, annotations[mirrorTargetKey])
+		}
+		port, err := stableServicePort(stableIngress, r.cfg.Rollout.Spec.Strategy.Canary.StableService)
+		if err != nil {
+			return err
+		}
+		mirrorTarget := fmt.Sprintf("http://%s.%s.svc:%d$request_uri", r.cfg.Rollout.Spec.Strategy.Canary.CanaryService, r.cfg.Rollout.Namespace, port)
+		err = r.patchIngressAnnotations(stableIngress, "mirror route", func(annotations map[string]string) {
+			annotations[MirrorRouteAnnotation] = setMirrorRoute.Name
+			annotations[mirrorTargetKey] = mirrorTarget
+		})
+		if err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
-func (r *Reconciler) RemoveManagedRoutes() error {
+// removeMirrorRoute removes the mirror route from the stable ingresses if it is the active one. An empty name
+// removes any mirror route.
+func (r *Reconciler) removeMirrorRoute(name string) error {
+	annotationPrefix := defaults.GetCanaryIngressAnnotationPrefixOrDefault(r.cfg.Rollout)
+	for _, stableIngressName := range r.stableIngresses() {
+		stableIngress, err := r.cfg.IngressWrapper.GetCached(r.cfg.Rollout.Namespace, stableIngressName)
+		if err != nil {
+			if k8serrors.IsNotFound(err) {
+				continue
+			}
+			return fmt.Errorf("error retrieving stableIngress `%s` from cache: %v", stableIngressName, err)
+		}
+		activeRoute, ok := stableIngress.GetAnnotations()[MirrorRouteAnnotation]
+		if !ok || (name != "" && activeRoute != name) {
+			continue
+		}
+		err = r.patchIngressAnnotations(stableIngress, "mirror route", func(annotations map[string]string) {
+			delete(annotations, MirrorRouteAnnotation)
+			delete(annotations, fmt.Sprintf("%s/mirror-target", annotationPrefix))
+		})
+		if err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
+// stableServicePort returns the port number through which the stable ingress routes to the stable service, which the
+// canary ingress uses for the canary service too
+func stableServicePort(stableIngress *ingressutil.Ingress, stableServiceName string) (int32, error) {
+	switch stableIngress.Mode() {
+	case ingressutil.IngressModeNetworking:
+		networkingIngress, err := stableIngress.GetNetworkingIngress()
+		if err != nil {
+			return 0, err
+		}
+		for _, rule := range networkingIngress.Spec.Rules {
+			if rule.HTTP == nil {
+				continue
+			}
+			for _, path := range rule.HTTP.Paths {
+				if path.Backend.Service != nil && path.Backend.Service.Name == stableServiceName && path.Backend.Service.Port.Number != 0 {
+					return path.Backend.Service.Port.Number, nil
+				}
+			}
+		}
+	case ingressutil.IngressModeExtensions:
+		extensionsIngress, err := stableIngress.GetExtensionsIngress()
+		if err != nil {
+			return 0, err
+		}
+		for _, rule := range extensionsIngress.Spec.Rules {
+			if rule.HTTP == nil {
+				continue
+			}
+			for _, path := range rule.HTTP.Paths {
+				if path.Backend.ServiceName == stableServiceName && path.Backend.ServicePort.IntVal != 0 {
+					return path.Backend.ServicePort.IntVal, nil
+				}
+			}
+		}
+	default:
+		return 0, errors.New("undefined ingress mode")
+	}
+	return 0, fmt.Errorf("ingress `%s` has no rules using service %s backend with a port number", stableIngress.GetName(), stableServiceName)
+}
+
+// RemoveManagedRoutes removes the header route from the canary ingresses and the mirror route from the stable ingresses
+func (r *Reconciler) RemoveManagedRoutes() error {
+	if err := r.removeHeaderRoute(""); err != nil {
+		return err
+	}
+	return r.removeMirrorRoute("")
+}
+
 func getDesiredAnnotations(current, desired *ingressutil.Ingress) map[string]string {
 	// Merge existing annotations into the desired Ingress (giving precedence to the desired values)
 	// This is necessary because the desired Ingress may not have all annotations previously added
@@ -1,6 +1,7 @@
 package nginx
 
 import (
+	"context"
 	"fmt"
 	"testing"
 
@@ -578,6 +579,39 @@ func TestCanaryIngressAdditionalAnnotations(t *testing.T) {
 	}
 }
 
+func TestCanaryIngressStickiness(t *testing.T) {
+	tests := generateMultiIngressTestData()
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			for _, ing := range test.ingresses {
+				r := Reconciler{
+					cfg: ReconcilerConfig{
+						Rollout: fakeRollout(stableService, canaryService, test.singleIngress, test.multiIngress),
+					},
+				}
+				r.cfg.Rollout.Spec.Strategy.Canary.TrafficRouting.Stickiness = &v1alpha1.TrafficStickiness{Cookie: "session"}
+				stable := networkingIngress(ing, 80, stableService)
+				stableIngress := ingressutil.NewIngress(stable)
+
+				desiredCanaryIngress, err := r.canaryIngress(stableIngress, ingressutil.GetCanaryIngressName(r.cfg.Rollout.GetName(), ing), 15)
+				assert.Nil(t, err, "No error returned when calling canaryIngress")
+
+				annotations := desiredCanaryIngress.GetAnnotations()
+				assert.Equal(t, "session", annotations["nginx.ingress.kubernetes.io/canary-by-cookie"], "canary-by-cookie annotation set")
+				assert.Equal(t, "15", annotations["nginx.ingress.kubernetes.io/canary-weight"], "canary-weight annotation set to expected value")
+
+				r.cfg.Rollout.Spec.Strategy.Canary.TrafficRouting.Stickiness = &v1alpha1.TrafficStickiness{Header: "x-user"}
+				desiredCanaryIngress, err = r.canaryIngress(stableIngress, ingressutil.GetCanaryIngressName(r.cfg.Rollout.GetName(), ing), 15)
+				assert.Nil(t, err, "No error returned when calling canaryIngress")
+
+				annotations = desiredCanaryIngress.GetAnnotations()
+				assert.Equal(t, "x-user", annotations["nginx.ingress.kubernetes.io/canary-by-header"], "canary-by-header annotation set")
+				assert.NotContains(t, annotations, "nginx.ingress.kubernetes.io/canary-by-cookie")
+			}
+		})
+	}
+}
+
 func TestCanaryIngressAdditionalAnnotationsNewIngress(t *testing.T) {
 	tests := generateMultiIngressTestData()
 	for _, test := range tests {
@@ -1166,3 +1200,259 @@ func TestReconcileCanaryCreateErrorAlreadyExistsPatch(t *testing.T) {
 		})
 	}
 }
+
+func newManagedRoutesReconciler(t *testing.T, rollout *v1alpha1.Rollout, stableAnnotations, canaryAnnotations map[string]string) (*Reconciler, *fake.Clientset) {
+	t.Helper()
+	stableIngress := networkingIngress(StableIngress, 80, stableService)
+	if stableAnnotations != nil {
+		stableIngress.SetAnnotations(stableAnnotations)
+	}
+	objects := []runtime.Object{stableIngress}
+	if canaryAnnotations != nil {
+		canaryIngress := networkingIngress(CanaryIngress, 80, canaryService)
+		canaryIngress.SetAnnotations(canaryAnnotations)
+		canaryIngress.SetOwnerReferences([]metav1.OwnerReference{*metav1.NewControllerRef(rollout, schema.GroupVersionKind{Group: "argoproj.io", Version: "v1alpha1", Kind: "Rollout"})})
+		objects = append(objects, canaryIngress)
+	}
+	client := fake.NewSimpleClientset(objects...)
+	k8sI := kubeinformers.NewSharedInformerFactory(client, 0)
+	for _, obj := range objects {
+		k8sI.Networking().V1().Ingresses().Informer().GetIndexer().Add(obj)
+	}
+	ingressWrapper, err := ingressutil.NewIngressWrapper(ingressutil.IngressModeNetworking, client, k8sI)
+	if err != nil {
+		t.Fatal(err)
+	}
+	r := NewReconciler(ReconcilerConfig{
+		Rollout:        rollout,
+		Client:         client,
+		Recorder:       record.NewFakeEventRecorder(),
+		ControllerKind: schema.GroupVersionKind{Group: "argoproj.io", Version: "v1alpha1", Kind: "Rollout"},
+		IngressWrapper: ingressWrapper,
+	})
+	return r, client
+}
+
+func getIngressAnnotations(t *testing.T, client *fake.Clientset, name string) map[string]string {
+	t.Helper()
+	ingress, err := client.NetworkingV1().Ingresses(metav1.NamespaceDefault).Get(context.TODO(), name, metav1.GetOptions{})
+	assert.NoError(t, err)
+	return ingress.GetAnnotations()
+}
+
+func TestSetHeaderRoute(t *testing.T) {
+	weightedAnnotations := map[string]string{
+		"nginx.ingress.kubernetes.io/canary":        "true",
+		"nginx.ingress.kubernetes.io/canary-weight": "15",
+	}
+
+	t.Run("exact header value", func(t *testing.T) {
+		r, client := newManagedRoutesReconciler(t, fakeRollout(stableService, canaryService, StableIngress, nil), nil, weightedAnnotations)
+		err := r.SetHeaderRoute(&v1alpha1.SetHeaderRoute{
+			Name:  "header-route",
+			Match: []v1alpha1.HeaderRoutingMatch{{HeaderName: "agent", HeaderValue: &v1alpha1.StringMatch{Exact: "chrome"}}},
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, map[string]string{
+			"nginx.ingress.kubernetes.io/canary":                 "true",
+			"nginx.ingress.kubernetes.io/canary-weight":          "15",
+			"nginx.ingress.kubernetes.io/canary-by-header":       "agent",
+			"nginx.ingress.kubernetes.io/canary-by-header-value": "chrome",
+			HeaderRouteAnnotation:                                "header-route",
+		}, getIngressAnnotations(t, client, CanaryIngress))
+	})
+
+	t.Run("prefix header value replaces exact header value", func(t *testing.T) {
+		annotations := map[string]string{
+			"nginx.ingress.kubernetes.io/canary":                 "true",
+			"nginx.ingress.kubernetes.io/canary-weight":          "15",
+			"nginx.ingress.kubernetes.io/canary-by-header":       "agent",
+			"nginx.ingress.kubernetes.io/canary-by-header-value": "chrome",
+			HeaderRouteAnnotation:                                "header-route",
+		}
+		r, client := newManagedRoutesReconciler(t, fakeRollout(stableService, canaryService, StableIngress, nil), nil, annotations)
+		err := r.SetHeaderRoute(&v1alpha1.SetHeaderRoute{
+			Name:  "other-route",
+			Match: []v1alpha1.HeaderRoutingMatch{{HeaderName: "version", HeaderValue: &v1alpha1.StringMatch{Prefix: "2."}}},
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, map[string]string{
+			"nginx.ingress.kubernetes.io/canary":                   "true",
+			"nginx.ingress.kubernetes.io/canary-weight":            "15",
+			"nginx.ingress.kubernetes.io/canary-by-header":         "version",
+			"nginx.ingress.kubernetes.io/canary-by-header-pattern": `^2\..*`,
+			HeaderRouteAnnotation:                                  "other-route",
+		}, getIngressAnnotations(t, client, CanaryIngress))
+	})
+
+	t.Run("unchanged header route is not patched", func(t *testing.T) {
+		annotations := map[string]string{
+			"nginx.ingress.kubernetes.io/canary":                   "true",
+			"nginx.ingress.kubernetes.io/canary-by-header":         "agent",
+			"nginx.ingress.kubernetes.io/canary-by-header-pattern": "chrome.*",
+			HeaderRouteAnnotation:                                  "header-route",
+		}
+		r, client := newManagedRoutesReconciler(t, fakeRollout(stableService, canaryService, StableIngress, nil), nil, annotations)
+		err := r.SetHeaderRoute(&v1alpha1.SetHeaderRoute{
+			Name:  "header-route",
+			Match: []v1alpha1.HeaderRoutingMatch{{HeaderName: "agent", HeaderValue: &v1alpha1.StringMatch{Regex: "chrome.*"}}},
+		})
+		assert.NoError(t, err)
+		assert.Empty(t, client.Actions())
+	})
+
+	t.Run("canary ingress is created when missing", func(t *testing.T) {
+		r, client := newManagedRoutesReconciler(t, fakeRollout(stableService, canaryService, StableIngress, nil), nil, nil)
+		err := r.SetHeaderRoute(&v1alpha1.SetHeaderRoute{
+			Name:  "header-route",
+			Match: []v1alpha1.HeaderRoutingMatch{{HeaderName: "agent", HeaderValue: &v1alpha1.StringMatch{Exact: "chrome"}}},
+		})
+		assert.NoError(t, err)
+		annotations := getIngressAnnotations(t, client, CanaryIngress)
+		assert.Equal(t, "0", annotations["nginx.ingress.kubernetes.io/canary-weight"])
+		assert.Equal(t, "agent", annotations["nginx.ingress.kubernetes.io/canary-by-header"])
+		assert.Equal(t, "chrome", annotations["nginx.ingress.kubernetes.io/canary-by-header-value"])
+	})
+
+	t.Run("multiple matches are rejected", func(t *testing.T) {
+		r, client := newManagedRoutesReconciler(t, fakeRollout(stableService, canaryService, StableIngress, nil), nil, weightedAnnotations)
+		err := r.SetHeaderRoute(&v1alpha1.SetHeaderRoute{
+			Name: "header-route",
+			Match: []v1alpha1.HeaderRoutingMatch{
+				{HeaderName: "agent", HeaderValue: &v1alpha1.StringMatch{Exact: "chrome"}},
+				{HeaderName: "version", HeaderValue: &v1alpha1.StringMatch{Exact: "2"}},
+			},
+		})
+		assert.EqualError(t, err, "header route `header-route` has 2 matches but nginx supports a single header match")
+		assert.Empty(t, client.Actions())
+	})
+
+	t.Run("canary ingress controlled by different object", func(t *testing.T) {
+		r, _ := newManagedRoutesReconciler(t, fakeRollout(stableService, canaryService, StableIngress, nil), nil, weightedAnnotations)
+		r.cfg.Rollout = r.cfg.Rollout.DeepCopy()
+		r.cfg.Rollout.UID = "other"
+		err := r.SetHeaderRoute(&v1alpha1.SetHeaderRoute{
+			Name:  "header-route",
+			Match: []v1alpha1.HeaderRoutingMatch{{HeaderName: "agent", HeaderValue: &v1alpha1.StringMatch{Exact: "chrome"}}},
+		})
+		assert.EqualError(t, err, fmt.Sprintf("canary ingress `%s` controlled by different object", CanaryIngress))
+	})
+}
+
+func TestRemoveHeaderRoute(t *testing.T) {
+	headerRouteAnnotations := map[string]string{
+		"nginx.ingress.kubernetes.io/canary":                 "true",
+		"nginx.ingress.kubernetes.io/canary-weight":          "15",
+		"nginx.ingress.kubernetes.io/canary-by-header":       "agent",
+		"nginx.ingress.kubernetes.io/canary-by-header-value": "chrome",
+		HeaderRouteAnnotation:                                "header-route",
+	}
+	weightedAnnotations := map[string]string{
+		"nginx.ingress.kubernetes.io/canary":        "true",
+		"nginx.ingress.kubernetes.io/canary-weight": "15",
+	}
+
+	t.Run("header route without match removes the active route", func(t *testing.T) {
+		r, client := newManagedRoutesReconciler(t, fakeRollout(stableService, canaryService, StableIngress, nil), nil, headerRouteAnnotations)
+		assert.NoError(t, r.SetHeaderRoute(&v1alpha1.SetHeaderRoute{Name: "header-route"}))
+		assert.Equal(t, weightedAnnotations, getIngressAnnotations(t, client, CanaryIngress))
+	})
+
+	t.Run("header route without match keeps another active route", func(t *testing.T) {
+		r, client := newManagedRoutesReconciler(t, fakeRollout(stableService, canaryService, StableIngress, nil), nil, headerRouteAnnotations)
+		assert.NoError(t, r.SetHeaderRoute(&v1alpha1.SetHeaderRoute{Name: "other-route"}))
+		assert.Empty(t, client.Actions())
+	})
+
+	t.Run("managed routes are removed", func(t *testing.T) {
+		r, client := newManagedRoutesReconciler(t, fakeRollout(stableService, canaryService, StableIngress, nil), nil, headerRouteAnnotations)
+		assert.NoError(t, r.RemoveManagedRoutes())
+		assert.Equal(t, weightedAnnotations, getIngressAnnotations(t, client, CanaryIngress))
+	})
+
+	t.Run("nothing to remove", func(t *testing.T) {
+		r, client := newManagedRoutesReconciler(t, fakeRollout(stableService, canaryService, StableIngress, nil), nil, nil)
+		assert.NoError(t, r.RemoveManagedRoutes())
+		assert.Empty(t, client.Actions())
+	})
+}
+
+func TestSetWeightPreservesHeaderRoute(t *testing.T) {
+	rollout := fakeRollout(stableService, canaryService, StableIngress, nil)
+	rollout.Spec.Strategy.Canary.TrafficRouting.Nginx.AdditionalIngressAnnotations = map[string]string{
+		"canary-by-header": "X-Canary",
+	}
+	r, client := newManagedRoutesReconciler(t, rollout, nil, map[string]string{
+		"nginx.ingress.kubernetes.io/canary":                 "true",
+		"nginx.ingress.kubernetes.io/canary-weight":          "15",
+		"nginx.ingress.kubernetes.io/canary-by-header":       "agent",
+		"nginx.ingress.kubernetes.io/canary-by-header-value": "chrome",
+		HeaderRouteAnnotation:                                "header-route",
+	})
+	assert.NoError(t, r.SetWeight(30))
+	annotations := getIngressAnnotations(t, client, CanaryIngress)
+	assert.Equal(t, "30", annotations["nginx.ingress.kubernetes.io/canary-weight"])
+	assert.Equal(t, "agent", annotations["nginx.ingress.kubernetes.io/canary-by-header"])
+	assert.Equal(t, "chrome", annotations["nginx.ingress.kubernetes.io/canary-by-header-value"])
+}
+
+func TestSetMirrorRoute(t *testing.T) {
+	t.Run("mirror all requests", func(t *testing.T) {
+		r, client := newManagedRoutesReconciler(t, fakeRollout(stableService, canaryService, StableIngress, nil), nil, nil)
+		err := r.SetMirrorRoute(&v1alpha1.SetMirrorRoute{Name: "mirror-route", Percentage: ptr.To[int32](100)})
+		assert.NoError(t, err)
+		assert.Equal(t, map[string]string{
+			"annotation-key1": "annotation-value1",
+			"nginx.ingress.kubernetes.io/mirror-target": "http://canary-service.default.svc:80$request_uri",
+			MirrorRouteAnnotation:                       "mirror-route",
+		}, getIngressAnnotations(t, client, StableIngress))
+	})
+
+	t.Run("empty match mirrors all requests", func(t *testing.T) {
+		r, client := newManagedRoutesReconciler(t, fakeRollout(stableService, canaryService, StableIngress, nil), nil, nil)
+		err := r.SetMirrorRoute(&v1alpha1.SetMirrorRoute{Name: "mirror-route", Match: []v1alpha1.RouteMatch{{}}})
+		assert.NoError(t, err)
+		assert.Equal(t, "mirror-route", getIngressAnnotations(t, client, StableIngress)[MirrorRouteAnnotation])
+	})
+
+	t.Run("matches are rejected", func(t *testing.T) {
+		r, client := newManagedRoutesReconciler(t, fakeRollout(stableService, canaryService, StableIngress, nil), nil, nil)
+		err := r.SetMirrorRoute(&v1alpha1.SetMirrorRoute{
+			Name:  "mirror-route",
+			Match: []v1alpha1.RouteMatch{{Method: &v1alpha1.StringMatch{Exact: "GET"}}},
+		})
+		assert.EqualError(t, err, "mirror route `mirror-route` has matches but nginx mirrors all requests")
+		assert.Empty(t, client.Actions())
+	})
+
+	t.Run("percentage is rejected", func(t *testing.T) {
+		r, _ := newManagedRoutesReconciler(t, fakeRollout(stableService, canaryService, StableIngress, nil), nil, nil)
+		err := r.SetMirrorRoute(&v1alpha1.SetMirrorRoute{Name: "mirror-route", Percentage: ptr.To[int32](50)})
+		assert.EqualError(t, err, "mirror route `mirror-route` mirrors 50% of requests but nginx mirrors all requests")
+	})
+
+	t.Run("mirror target set by the user", func(t *testing.T) {
+		r, client := newManagedRoutesReconciler(t, fakeRollout(stableService, canaryService, StableIngress, nil), map[string]string{
+			"nginx.ingress.kubernetes.io/mirror-target": "http://shadow",
+		}, nil)
+		err := r.SetMirrorRoute(&v1alpha1.SetMirrorRoute{Name: "mirror-route", Percentage: ptr.To[int32](100)})
+		assert.EqualError(t, err, "stable ingress `stable-ingress` already mirrors requests to `http://shadow`")
+		assert.Empty(t, client.Actions())
+	})
+
+	t.Run("mirror route is removed", func(t *testing.T) {
+		mirrorAnnotations := map[string]string{
+			"nginx.ingress.kubernetes.io/mirror-target": "http://canary-service.default.svc:80$request_uri",
+			MirrorRouteAnnotation:                       "mirror-route",
+		}
+		r, client := newManagedRoutesReconciler(t, fakeRollout(stableService, canaryService, StableIngress, nil), mirrorAnnotations, nil)
+		assert.NoError(t, r.SetMirrorRoute(&v1alpha1.SetMirrorRoute{Name: "other-route"}))
+		assert.Empty(t, client.Actions())
+		assert.NoError(t, r.SetMirrorRoute(&v1alpha1.SetMirrorRoute{Name: "mirror-route"}))
+		assert.Empty(t, getIngressAnnotations(t, client, StableIngress))
+
+		r, client = newManagedRoutesReconciler(t, fakeRollout(stableService, canaryService, StableIngress, nil), mirrorAnnotations, nil)
+		assert.NoError(t, r.RemoveManagedRoutes())
+		assert.Empty(t, getIngressAnnotations(t, client, StableIngress))
+	})
+}
@@ -3,17 +3,25 @@ package smi
 import (
 	"context"
 	"fmt"
+	"regexp"
 
+	smispecsv1alpha3 "github.com/servicemeshinterface/smi-sdk-go/pkg/apis/specs/v1alpha3"
 	smiv1alpha1 "github.com/servicemeshinterface/smi-sdk-go/pkg/apis/split/v1alpha1"
 	smiv1alpha2 "github.com/servicemeshinterface/smi-sdk-go/pkg/apis/split/v1alpha2"
 	smiv1alpha3 "github.com/servicemeshinterface/smi-sdk-go/pkg/apis/split/v1alpha3"
 	smiclientset "github.com/servicemeshinterface/smi-sdk-go/pkg/gen/client/split/clientset/versioned"
 	"github.com/sirupsen/logrus"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	patchtypes "k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/utils/ptr"
 
 	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
 	"github.com/argoproj/argo-rollouts/utils/defaults"
@@ -25,12 +33,17 @@ import (
 const (
 	// Type holds this controller type
 	Type = "SMI"
+
+	// headerRouteAPIVersion is the TrafficSplit API version supporting HTTPRouteGroup matches
+	headerRouteAPIVersion = "v1alpha3"
+	httpRouteGroupKind    = "HTTPRouteGroup"
 )
 
 // ReconcilerConfig describes static configuration data for the SMI reconciler
 type ReconcilerConfig struct {
 	Rollout        *v1alpha1.Rollout
 	Client         smiclientset.Interface
+	DynamicClient  dynamic.Interface
 	Recorder       record.EventRecorder
 	ControllerKind schema.GroupVersionKind
 }
@@ -185,11 +198,7 @@ func (r *Reconciler) Type() string {
 
 // SetWeight creates and modifies traffic splits based on the desired weight
 func (r *Reconciler) SetWeight(desiredWeight int32, additionalDestinations ...v1alpha1.WeightDestination) error {
-	// If TrafficSplitName not set, then set to Rollout name
-	trafficSplitName := r.cfg.Rollout.Spec.Strategy.Canary.TrafficRouting.SMI.TrafficSplitName
-	if trafficSplitName == "" {
-		trafficSplitName = r.cfg.Rollout.Name
-	}
+	trafficSplitName := TrafficSplitName(r.cfg.Rollout)
 	trafficSplits := r.generateTrafficSplits(trafficSplitName, desiredWeight, additionalDestinations...)
 
 	// Check if Traffic Split exists in namespace
@@ -218,16 +227,200 @@ func (r *Reconciler) SetWeight(desiredWeight int32, additionalDestinations ...v1
 	return r.patchTrafficSplit(existingTrafficSplit, trafficSplits)
 }
 
+// DetectDrift returns whether the TrafficSplit is missing or its spec differs from the desired spec
+func (r *Reconciler) DetectDrift(desiredWeight int32, additionalDestinations ...v1alpha1.WeightDestination) (string, error) {
+	trafficSplitName := TrafficSplitName(r.cfg.Rollout)
+	existingTrafficSplit, err := r.getTrafficSplit(trafficSplitName)
+	if k8serrors.IsNotFound(err) {
+		return fmt.Sprintf("TrafficSplit `%s` not found", trafficSplitName), nil
+	}
+	if err != nil {
+		return "", err
+	}
+	if !r.trafficSplitIsControlledBy(existingTrafficSplit) {
+		return "", nil
+	}
+	desiredTrafficSplit := r.generateTrafficSplits(trafficSplitName, desiredWeight, additionalDestinations...)
+	if !trafficSplitSpecsEqual(existingTrafficSplit, desiredTrafficSplit) {
+		return fmt.Sprintf("TrafficSplit `%s` does not have the desired backends", trafficSplitName), nil
+	}
+	return "", nil
+}
+
+// TrafficSplitName returns the name of the TrafficSplit managed by the rollout, which defaults to the rollout name
+func TrafficSplitName(ro *v1alpha1.Rollout) string {
+	if trafficSplitName := ro.Spec.Strategy.Canary.TrafficRouting.SMI.TrafficSplitName; trafficSplitName != "" {
+		return trafficSplitName
+	}
+	return ro.Name
+}
+
+// GetTrafficSplitGVR returns the TrafficSplit GVR for the configured SMI API version
+func GetTrafficSplitGVR() schema.GroupVersionResource {
+	return schema.GroupVersionResource{
+		Group:    "split.smi-spec.io",
+		Version:  defaults.GetSMIAPIVersion(),
+		Resource: "trafficsplits",
+	}
+}
+
+func trafficSplitSpecsEqual(existing, desired VersionedTrafficSplits) bool {
+	switch {
+	case existing.ts1 != nil && desired.ts1 != nil:
+		return equality.Semantic.DeepEqual(existing.ts1.Spec, desired.ts1.Spec)
+	case existing.ts2 != nil && desired.ts2 != nil:
+		return equality.Semantic.DeepEqual(existing.ts2.Spec, desired.ts2.Spec)
+	case existing.ts3 != nil && desired.ts3 != nil:
+		return equality.Semantic.DeepEqual(existing.ts3.Spec, desired.ts3.Spec)
+	}
+	return false
+}
+
+// SetHeaderRoute creates an HTTPRouteGroup matching the given headers and a TrafficSplit which
+// sends all the matching requests to the canary service. Both are deleted when the header route has
+// no matches. Header routing relies on TrafficSplit matches, which require the v1alpha3 TrafficSplit API.
 func (r *Reconciler) SetHeaderRoute(headerRouting *v1alpha1.SetHeaderRoute) error {
+	if defaults.GetSMIAPIVersion() != headerRouteAPIVersion {
+		return fmt.Errorf("SMI header routing requires TrafficSplit API version `%s`", headerRouteAPIVersion)
+	}
+	ctx := context.TODO()
+	name := HeaderRouteName(r.cfg.Rollout, headerRouting.Name)
+	if len(headerRouting.Match) == 0 {
+		return r.removeHeaderRoute(ctx, name)
+	}
+
+	err := r.applyHTTPRouteGroup(ctx, r.generateHTTPRouteGroup(name, headerRouting))
+	if err != nil {
+		return err
+	}
+
+	ts3 := trafficSplitV1Alpha3(r.cfg.Rollout, objectMeta(name, r.cfg.Rollout, r.cfg.ControllerKind), r.rootService(), 100)
+	ts3.Spec.Matches = []corev1.TypedLocalObjectReference{{
+		APIGroup: ptr.To(GetHTTPRouteGroupGVR().Group),
+		Kind:     httpRouteGroupKind,
+		Name:     name,
+	}}
+	trafficSplits := VersionedTrafficSplits{ts3: ts3}
+
+	existingTrafficSplit, err := r.getTrafficSplit(name)
+	if k8serrors.IsNotFound(err) {
+		err = r.createTrafficSplit(trafficSplits)
+		if err == nil {
+			r.cfg.Recorder.Eventf(r.cfg.Rollout, record.EventOptions{EventReason: "TrafficSplitCreated"}, "TrafficSplit `%s` created", name)
+		} else {
+			r.cfg.Recorder.Eventf(r.cfg.Rollout, record.EventOptions{EventReason: "TrafficSplitNotCreated"}, "TrafficSplit `%s` failed creation: %v", name, err)
+		}
+		return err
+	}
+	if err != nil {
+		return err
+	}
+	if !r.trafficSplitIsControlledBy(existingTrafficSplit) {
+		return fmt.Errorf("Rollout does not own TrafficSplit `%s`", name)
+	}
+	return r.patchTrafficSplit(existingTrafficSplit, trafficSplits)
+}
+
+func (r *Reconciler) generateHTTPRouteGroup(name string, headerRouting *v1alpha1.SetHeaderRoute) *smispecsv1alpha3.HTTPRouteGroup {
+	headers := map[string]string{}
+	for _, match := range headerRouting.Match {
+		if match.HeaderValue == nil {
+			continue
+		}
+		// SMI header values are regular expressions
+		switch {
+		case match.HeaderValue.Exact != "":
+			headers[match.HeaderName] = "^" + regexp.QuoteMeta(match.HeaderValue.Exact) + "$"
+		case match.HeaderValue.Prefix != "":
+			headers[match.HeaderName] = "^" + regexp.QuoteMeta(match.HeaderValue.Prefix) + ".*"
+		case match.HeaderValue.Regex != "":
+			headers[match.HeaderName] = match.HeaderValue.Regex
+		}
+	}
+	return &smispecsv1alpha3.HTTPRouteGroup{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: GetHTTPRouteGroupGVR().GroupVersion().String(),
+			Kind:       httpRouteGroupKind,
+		},
+		ObjectMeta: objectMeta(name, r.cfg.Rollout, r.cfg.ControllerKind),
+		Spec: smispecsv1alpha3.HTTPRouteGroupSpec{
+			Matches: []smispecsv1alpha3.HTTPMatch{{
+				Name:    headerRouting.Name,
+				Headers: headers,
+			}},
+		},
+	}
+}
+
+func (r *Reconciler) applyHTTPRouteGroup(ctx context.Context, routeGroup *smispecsv1alpha3.HTTPRouteGroup) error {
+	obj, err := runtime.DefaultUnstructuredConverter.ToUnstructured(routeGroup)
+	if err != nil {
+		return err
+	}
+	desired := &unstructured.Unstructured{Object: obj}
+	client := r.cfg.DynamicClient.Resource(GetHTTPRouteGroupGVR()).Namespace(r.cfg.Rollout.Namespace)
+	existing, err := client.Get(ctx, routeGroup.Name, metav1.GetOptions{})
+	if k8serrors.IsNotFound(err) {
+		_, err = client.Create(ctx, desired, metav1.CreateOptions{})
+		if err == nil {
+			r.cfg.Recorder.Eventf(r.cfg.Rollout, record.EventOptions{EventReason: "HTTPRouteGroupCreated"}, "HTTPRouteGroup `%s` created", routeGroup.Name)
+		}
+		return err
+	}
+	if err != nil {
+		return err
+	}
+	if !metav1.IsControlledBy(existing, r.cfg.Rollout) {
+		return fmt.Errorf("Rollout does not own HTTPRouteGroup `%s`", routeGroup.Name)
+	}
+	// compare the typed specs since headers are serialized from a map in random order
+	existingRouteGroup := &smispecsv1alpha3.HTTPRouteGroup{}
+	err = runtime.DefaultUnstructuredConverter.FromUnstructured(existing.Object, existingRouteGroup)
+	if err == nil && equality.Semantic.DeepEqual(existingRouteGroup.Spec, routeGroup.Spec) {
+		return nil
+	}
+	existing.Object["spec"] = desired.Object["spec"]
+	_, err = client.Update(ctx, existing, metav1.UpdateOptions{})
+	return err
+}
+
+// removeHeaderRoute deletes the TrafficSplit and HTTPRouteGroup of a header route
+func (r *Reconciler) removeHeaderRoute(ctx context.Context, name string) error {
+	err := r.cfg.Client.SplitV1alpha3().TrafficSplits(r.cfg.Rollout.Namespace).Delete(ctx, name, metav1.DeleteOptions{})
+	if err != nil && !k8serrors.IsNotFound(err) {
+		return err
+	}
+	err = r.cfg.DynamicClient.Resource(GetHTTPRouteGroupGVR()).Namespace(r.cfg.Rollout.Namespace).Delete(ctx, name, metav1.DeleteOptions{})
+	if err != nil && !k8serrors.IsNotFound(err) {
+		return err
+	}
 	return nil
 }
 
-func (r *Reconciler) generateTrafficSplits(trafficSplitName string, desiredWeight int32, additionalDestinations ...v1alpha1.WeightDestination) VersionedTrafficSplits {
-	// If root service not set, then set root service to be stable service
-	rootSvc := r.cfg.Rollout.Spec.Strategy.Canary.TrafficRouting.SMI.RootService
-	if rootSvc == "" {
-		rootSvc = r.cfg.Rollout.Spec.Strategy.Canary.StableService
+// HeaderRouteName returns the name of the TrafficSplit and HTTPRouteGroup of a header route
+func HeaderRouteName(ro *v1alpha1.Rollout, headerRouteName string) string {
+	return fmt.Sprintf("%s-%s", TrafficSplitName(ro), headerRouteName)
+}
+
+// GetHTTPRouteGroupGVR returns the HTTPRouteGroup GVR referenced by v1alpha3 TrafficSplits
+func GetHTTPRouteGroupGVR() schema.GroupVersionResource {
+	return schema.GroupVersionResource{
+		Group:    "specs.smi-spec.io",
+		Version:  "v1alpha3",
+		Resource: "httproutegroups",
 	}
+}
+
+// rootService returns the root service of the traffic split, which defaults to the stable service
+func (r *Reconciler) rootService() string {
+	if rootSvc := r.cfg.Rollout.Spec.Strategy.Canary.TrafficRouting.SMI.RootService; rootSvc != "" {
+		return rootSvc
+	}
+	return r.cfg.Rollout.Spec.Strategy.Canary.StableService
+}
+
+func (r *Reconciler) generateTrafficSplits(trafficSplitName string, desiredWeight int32, additionalDestinations ...v1alpha1.WeightDestination) VersionedTrafficSplits {
+	rootSvc := r.rootService()
 
 	trafficSplits := VersionedTrafficSplits{}
 
@@ -356,6 +549,16 @@ func (r *Reconciler) SetMirrorRoute(setMirrorRoute *v1alpha1.SetMirrorRoute) err
 	return nil
 }
 
+// RemoveManagedRoutes deletes the TrafficSplits and HTTPRouteGroups of all the managed routes
 func (r *Reconciler) RemoveManagedRoutes() error {
+	if defaults.GetSMIAPIVersion() != headerRouteAPIVersion {
+		return nil
+	}
+	ctx := context.TODO()
+	for _, managedRoute := range r.cfg.Rollout.Spec.Strategy.Canary.TrafficRouting.ManagedRoutes {
+		if err := r.removeHeaderRoute(ctx, HeaderRouteName(r.cfg.Rollout, managedRoute.Name)); err != nil {
+			return err
+		}
+	}
 	return nil
 }
@@ -2,6 +2,7 @@ package smi
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"strings"
 	"testing"
@@ -15,8 +16,10 @@ import (
 	"k8s.io/apimachinery/pkg/api/equality"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+	dynamicfake "k8s.io/client-go/dynamic/fake"
 	core "k8s.io/client-go/testing"
 	k8stesting "k8s.io/client-go/testing"
 
@@ -352,6 +355,71 @@ func TestReconcilePatchExistingTrafficSplitNoChange(t *testing.T) {
 	})
 }
 
+func TestDetectDrift(t *testing.T) {
+	ro := fakeRollout("stable-service", "canary-service", "root-service", "traffic-split-name")
+	objMeta := objectMeta("traffic-split-name", ro, schema.GroupVersionKind{})
+
+	t.Run("v1alpha1", func(t *testing.T) {
+		ts1 := trafficSplitV1Alpha1(ro, objMeta, "root-service", int32(10))
+		client := fake.NewSimpleClientset(ts1)
+		r, err := NewReconciler(ReconcilerConfig{
+			Rollout:        ro,
+			Client:         client,
+			Recorder:       record.NewFakeEventRecorder(),
+			ControllerKind: schema.GroupVersionKind{},
+		})
+		assert.Nil(t, err)
+
+		drift, err := r.DetectDrift(10)
+		assert.Nil(t, err)
+		assert.Empty(t, drift)
+
+		drift, err = r.DetectDrift(50)
+		assert.Nil(t, err)
+		assert.Equal(t, "TrafficSplit `traffic-split-name` does not have the desired backends", drift)
+	})
+
+	t.Run("v1alpha3", func(t *testing.T) {
+		ts3 := trafficSplitV1Alpha3(ro, objMeta, "root-service", int32(10))
+		client := fake.NewSimpleClientset(ts3)
+		defaults.SetSMIAPIVersion("v1alpha3")
+		defer defaults.SetSMIAPIVersion(defaults.DefaultSMITrafficSplitVersion)
+		r, err := NewReconciler(ReconcilerConfig{
+			Rollout:        ro,
+			Client:         client,
+			Recorder:       record.NewFakeEventRecorder(),
+			ControllerKind: schema.GroupVersionKind{},
+		})
+		assert.Nil(t, err)
+
+		drift, err := r.DetectDrift(10)
+		assert.Nil(t, err)
+		assert.Empty(t, drift)
+
+		drift, err = r.DetectDrift(50)
+		assert.Nil(t, err)
+		assert.Equal(t, "TrafficSplit `traffic-split-name` does not have the desired backends", drift)
+	})
+
+	t.Run("NotFound", func(t *testing.T) {
+		client := fake.NewSimpleClientset()
+		r, err := NewReconciler(ReconcilerConfig{
+			Rollout:        ro,
+			Client:         client,
+			Recorder:       record.NewFakeEventRecorder(),
+			ControllerKind: schema.GroupVersionKind{},
+		})
+		assert.Nil(t, err)
+
+		drift, err := r.DetectDrift(10)
+		assert.Nil(t, err)
+		assert.Equal(t, "TrafficSplit `traffic-split-name` not found", drift)
+		actions := client.Actions()
+		assert.Len(t, actions, 1)
+		assert.Equal(t, "get", actions[0].GetVerb())
+	})
+}
+
 func TestReconcileGetTrafficSplitError(t *testing.T) {
 	rollout := fakeRollout("stable-service", "canary-service", "root-service", "traffic-split-name")
 	client := fake.NewSimpleClientset()
@@ -578,33 +646,123 @@ func TestCreateTrafficSplitForMultipleBackends(t *testing.T) {
 }
 
 func TestReconcileSetHeaderRoute(t *testing.T) {
-	t.Run("not implemented", func(t *testing.T) {
-		ro := fakeRollout("stable-service", "canary-service", "", "")
-		client := fake.NewSimpleClientset()
+	setHeaderRoute := &v1alpha1.SetHeaderRoute{
+		Name: "set-header",
+		Match: []v1alpha1.HeaderRoutingMatch{
+			{
+				HeaderName: "header-name",
+				HeaderValue: &v1alpha1.StringMatch{
+					Exact: "value",
+				},
+			},
+			{
+				HeaderName: "user-agent",
+				HeaderValue: &v1alpha1.StringMatch{
+					Prefix: "Mozilla",
+				},
+			},
+		},
+	}
+	newReconciler := func(t *testing.T, ro *v1alpha1.Rollout, objs ...runtime.Object) (*Reconciler, *fake.Clientset, *dynamicfake.FakeDynamicClient) {
+		client := fake.NewSimpleClientset(objs...)
+		dynamicClient := dynamicfake.NewSimpleDynamicClient(runtime.NewScheme())
 		r, err := NewReconciler(ReconcilerConfig{
 			Rollout:        ro,
 			Client:         client,
+			DynamicClient:  dynamicClient,
 			Recorder:       record.NewFakeEventRecorder(),
 			ControllerKind: schema.GroupVersionKind{},
 		})
 		assert.Nil(t, err)
+		return r, client, dynamicClient
+	}
 
-		err = r.SetHeaderRoute(&v1alpha1.SetHeaderRoute{
-			Name: "set-header",
-			Match: []v1alpha1.HeaderRoutingMatch{{
-				HeaderName: "header-name",
-				HeaderValue: &v1alpha1.StringMatch{
-					Exact: "value",
-				},
-			}},
-		})
-		assert.Nil(t, err)
+	t.Run("unsupported TrafficSplit API version", func(t *testing.T) {
+		ro := fakeRollout("stable-service", "canary-service", "", "")
+		r, client, _ := newReconciler(t, ro)
+
+		err := r.SetHeaderRoute(setHeaderRoute)
+		assert.EqualError(t, err, "SMI header routing requires TrafficSplit API version `v1alpha3`")
 
 		err = r.RemoveManagedRoutes()
 		assert.Nil(t, err)
+		assert.Len(t, client.Actions(), 0)
+	})
+
+	t.Run("create header route", func(t *testing.T) {
+		defaults.SetSMIAPIVersion("v1alpha3")
+		defer defaults.SetSMIAPIVersion(defaults.DefaultSMITrafficSplitVersion)
+		ro := fakeRollout("stable-service", "canary-service", "root-service", "traffic-split")
+		r, client, dynamicClient := newReconciler(t, ro)
+
+		err := r.SetHeaderRoute(setHeaderRoute)
+		assert.Nil(t, err)
+
+		routeGroup, err := dynamicClient.Resource(GetHTTPRouteGroupGVR()).Namespace(metav1.NamespaceDefault).Get(context.TODO(), "traffic-split-set-header", metav1.GetOptions{})
+		assert.Nil(t, err)
+		matches, _, _ := unstructured.NestedSlice(routeGroup.Object, "spec", "matches")
+		assert.Len(t, matches, 1)
+		headers, _, _ := unstructured.NestedSlice(matches[0].(map[string]any), "headers")
+		assert.ElementsMatch(t, []any{map[string]any{"header-name": "^value$"}, map[string]any{"user-agent": "^Mozilla.*"}}, headers)
 
+		ts3, err := client.SplitV1alpha3().TrafficSplits(metav1.NamespaceDefault).Get(context.TODO(), "traffic-split-set-header", metav1.GetOptions{})
+		assert.Nil(t, err)
+		assert.Equal(t, "root-service", ts3.Spec.Service)
+		assert.Equal(t, "canary-service", ts3.Spec.Backends[0].Service)
+		assert.Equal(t, 100, ts3.Spec.Backends[0].Weight)
+		assert.Equal(t, "stable-service", ts3.Spec.Backends[1].Service)
+		assert.Equal(t, 0, ts3.Spec.Backends[1].Weight)
+		assert.Len(t, ts3.Spec.Matches, 1)
+		assert.Equal(t, "HTTPRouteGroup", ts3.Spec.Matches[0].Kind)
+		assert.Equal(t, "traffic-split-set-header", ts3.Spec.Matches[0].Name)
+
+		// setting the same header route again does not modify the resources
+		client.ClearActions()
+		dynamicClient.ClearActions()
+		err = r.SetHeaderRoute(setHeaderRoute)
+		assert.Nil(t, err)
 		actions := client.Actions()
-		assert.Len(t, actions, 0)
+		assert.Len(t, actions, 1)
+		assert.Equal(t, "get", actions[0].GetVerb())
+		dynamicActions := dynamicClient.Actions()
+		assert.Len(t, dynamicActions, 1)
+		assert.Equal(t, "get", dynamicActions[0].GetVerb())
+	})
+
+	t.Run("remove header route", func(t *testing.T) {
+		defaults.SetSMIAPIVersion("v1alpha3")
+		defer defaults.SetSMIAPIVersion(defaults.DefaultSMITrafficSplitVersion)
+		ro := fakeRollout("stable-service", "canary-service", "root-service", "traffic-split")
+		ro.Spec.Strategy.Canary.TrafficRouting.ManagedRoutes = []v1alpha1.MangedRoutes{{Name: "set-header"}, {Name: "other-header"}}
+		r, client, dynamicClient := newReconciler(t, ro)
+
+		err := r.SetHeaderRoute(setHeaderRoute)
+		assert.Nil(t, err)
+
+		err = r.SetHeaderRoute(&v1alpha1.SetHeaderRoute{Name: "set-header"})
+		assert.Nil(t, err)
+		_, err = client.SplitV1alpha3().TrafficSplits(metav1.NamespaceDefault).Get(context.TODO(), "traffic-split-set-header", metav1.GetOptions{})
+		assert.True(t, k8serrors.IsNotFound(err))
+		_, err = dynamicClient.Resource(GetHTTPRouteGroupGVR()).Namespace(metav1.NamespaceDefault).Get(context.TODO(), "traffic-split-set-header", metav1.GetOptions{})
+		assert.True(t, k8serrors.IsNotFound(err))
+
+		err = r.SetHeaderRoute(setHeaderRoute)
+		assert.Nil(t, err)
+		err = r.RemoveManagedRoutes()
+		assert.Nil(t, err)
+		_, err = client.SplitV1alpha3().TrafficSplits(metav1.NamespaceDefault).Get(context.TODO(), "traffic-split-set-header", metav1.GetOptions{})
+		assert.True(t, k8serrors.IsNotFound(err))
+	})
+
+	t.Run("header route TrafficSplit not owned by rollout", func(t *testing.T) {
+		defaults.SetSMIAPIVersion("v1alpha3")
+		defer defaults.SetSMIAPIVersion(defaults.DefaultSMITrafficSplitVersion)
+		ro := fakeRollout("stable-service", "canary-service", "root-service", "traffic-split")
+		ts3 := trafficSplitV1Alpha3(ro, metav1.ObjectMeta{Name: "traffic-split-set-header", Namespace: metav1.NamespaceDefault}, "root-service", 0)
+		r, _, _ := newReconciler(t, ro, ts3)
+
+		err := r.SetHeaderRoute(setHeaderRoute)
+		assert.EqualError(t, err, "Rollout does not own TrafficSplit `traffic-split-set-header`")
 	})
 }
 
@@ -2,7 +2,11 @@ package traefik
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"regexp"
+	"slices"
+	"strconv"
 	"strings"
 
 	"errors"
@@ -10,6 +14,7 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/client-go/dynamic"
 
@@ -22,18 +27,36 @@ import (
 const Type = "Traefik"
 
 const traefikServices = "traefikservices"
+const ingressRoutes = "ingressroutes"
 const TraefikServiceUpdateError = "TraefikServiceUpdateError"
+const TraefikIngressRouteUpdateError = "TraefikIngressRouteUpdateError"
+
+// legacyTraefikAPIGroup is the API group of Traefik v2, whose rules use the v2 syntax
+const legacyTraefikAPIGroup = "traefik.containo.us"
+
+// ManagedRoutesAnnotation records on the IngressRoute, per managed route, the match rules of the routes added for it
+const ManagedRoutesAnnotation = "rollouts.argoproj.io/traefik-managed-routes"
+
+// WeightDestinationsAnnotation records on the weighted Traefik service the services added for the additional weight
+// destinations of experiments
+const WeightDestinationsAnnotation = "rollouts.argoproj.io/traefik-weight-destinations"
+
+// MirrorRouteAnnotation holds the name of the managed route mirroring traffic to the canary service through the
+// Traefik mirroring service
+const MirrorRouteAnnotation = "rollouts.argoproj.io/traefik-mirror-route"
 
 type ReconcilerConfig struct {
-	Rollout  *v1alpha1.Rollout
-	Client   ClientInterface
-	Recorder record.EventRecorder
+	Rollout            *v1alpha1.Rollout
+	Client             ClientInterface
+	IngressRouteClient ClientInterface
+	Recorder           record.EventRecorder
 }
 
 type Reconciler struct {
-	Rollout  *v1alpha1.Rollout
-	Client   ClientInterface
-	Recorder record.EventRecorder
+	Rollout            *v1alpha1.Rollout
+	Client             ClientInterface
+	IngressRouteClient ClientInterface
+	Recorder           record.EventRecorder
 }
 
 func apiGroupToResource(group string) string {
@@ -58,9 +81,10 @@ type ClientInterface interface {
 
 func NewReconciler(cfg *ReconcilerConfig) *Reconciler {
 	reconciler := &Reconciler{
-		Rollout:  cfg.Rollout,
-		Client:   cfg.Client,
-		Recorder: cfg.Recorder,
+		Rollout:            cfg.Rollout,
+		Client:             cfg.Client,
+		IngressRouteClient: cfg.IngressRouteClient,
+		Recorder:           cfg.Recorder,
 	}
 	return reconciler
 }
@@ -69,6 +93,13 @@ func NewDynamicClient(di dynamic.Interface, namespace string) dynamic.ResourceIn
 	return di.Resource(GetMappingGVR()).Namespace(namespace)
 }
 
+// NewIngressRouteDynamicClient returns a client of the Traefik IngressRoutes of the namespace
+func NewIngressRouteDynamicClient(di dynamic.Interface, namespace string) dynamic.ResourceInterface {
+	gvr := GetMappingGVR()
+	gvr.Resource = ingressRoutes
+	return di.Resource(gvr).Namespace(namespace)
+}
+
 func GetMappingGVR() schema.GroupVersionResource {
 	group := defaults.GetTraefikAPIGroup()
 	parts := strings.Split(defaults.GetTraefikVersion(), "/")
@@ -121,7 +152,15 @@ func (r *Reconciler) SetWeight(desiredWeight int32, additionalDestinations ...v1
 	if stableService == nil {
 		return errors.New("traefik stable service was not found")
 	}
-	err = unstructured.SetNestedField(stableService, int64(100-desiredWeight), "weight")
+	stableWeight := 100 - desiredWeight
+	for _, dest := range additionalDestinations {
+		stableWeight -= dest.Weight
+	}
+	err = unstructured.SetNestedField(stableService, int64(stableWeight), "weight")
+	if err != nil {
+		return err
+	}
+	services, err = setWeightDestinations(traefikService, services, canaryService, additionalDestinations)
 	if err != nil {
 		return err
 	}
@@ -137,6 +176,56 @@ func (r *Reconciler) SetWeight(desiredWeight int32, additionalDestinations ...v1
 	return err
 }
 
+// setWeightDestinations adds the services of the additional weight destinations next to the canary service and
+// drops the ones of destinations which are no longer part of the canary
+func setWeightDestinations(traefikService *unstructured.Unstructured, services []any, canaryService map[string]any, additionalDestinations []v1alpha1.WeightDestination) ([]any, error) {
+	annotations := traefikService.GetAnnotations()
+	var previous []string
+	if value := annotations[WeightDestinationsAnnotation]; value != "" {
+		previous = strings.Split(value, ",")
+	}
+	destinations := []string{}
+	for _, dest := range additionalDestinations {
+		destinations = append(destinations, dest.ServiceName)
+	}
+	result := []any{}
+	for _, service := range services {
+		typedService, ok := service.(map[string]any)
+		if !ok {
+			return nil, errors.New("Failed type assertion setting weight for traefik service")
+		}
+		name, _ := typedService["name"].(string)
+		if slices.Contains(previous, name) && !slices.Contains(destinations, name) {
+			continue
+		}
+		result = append(result, service)
+	}
+	for _, dest := range additionalDestinations {
+		destService, err := getService(dest.ServiceName, result)
+		if err != nil {
+			return nil, err
+		}
+		if destService == nil {
+			destService = map[string]any{"name": dest.ServiceName}
+			if port, ok := canaryService["port"]; ok {
+				destService["port"] = port
+			}
+			result = append(result, destService)
+		}
+		destService["weight"] = int64(dest.Weight)
+	}
+	if len(destinations) == 0 {
+		delete(annotations, WeightDestinationsAnnotation)
+	} else {
+		if annotations == nil {
+			annotations = map[string]string{}
+		}
+		annotations[WeightDestinationsAnnotation] = strings.Join(destinations, ",")
+	}
+	traefikService.SetAnnotations(annotations)
+	return result, nil
+}
+
 func getService(serviceName string, services []any) (map[string]any, error) {
 	var selectedService map[string]any
 	for _, service := range services {
@@ -159,8 +248,209 @@ func getService(serviceName string, services []any) (map[string]any, error) {
 	return selectedService, nil
 }
 
+// SetHeaderRoute adds, for every route of the IngressRoute sending traffic to the weighted Traefik service, a route
+// sending the requests matching the headers to the canary service. The rule of the added route is longer than the
+// one of the original route, so it takes precedence unless priorities are set explicitly, in which case it gets a
+// higher priority.
 func (r *Reconciler) SetHeaderRoute(headerRouting *v1alpha1.SetHeaderRoute) error {
-	return nil
+	if headerRouting.Match == nil {
+		return r.removeHeaderRoute(headerRouting.Name)
+	}
+	if r.Rollout.Spec.Strategy.Canary.TrafficRouting.Traefik.IngressRouteName == "" {
+		return fmt.Errorf("header route %q requires a traefik ingress route", headerRouting.Name)
+	}
+	ctx := context.TODO()
+	port, err := r.canaryServicePort(ctx)
+	if err != nil {
+		return err
+	}
+	headerRule := headerMatchRule(headerRouting.Match)
+	weightedServiceName := r.Rollout.Spec.Strategy.Canary.TrafficRouting.Traefik.WeightedTraefikServiceName
+	return r.updateIngressRoute(ctx, func(routes []any, managed map[string][]string) ([]any, bool, error) {
+		routes, _ = dropManagedRoutes(routes, managed, headerRouting.Name)
+		headerRoutes := []any{}
+		rules := []string{}
+		for _, route := range routes {
+			typedRoute, ok := route.(map[string]any)
+			if !ok {
+				return nil, false, errors.New("Failed type assertion reading traefik ingress route")
+			}
+			match, _, err := unstructured.NestedString(typedRoute, "match")
+			if err != nil {
+				return nil, false, err
+			}
+			if managedRouteOf(match, managed) != "" {
+				continue
+			}
+			services, _, err := unstructured.NestedSlice(typedRoute, "services")
+			if err != nil {
+				return nil, false, err
+			}
+			weightedService, err := getService(weightedServiceName, services)
+			if err != nil {
+				return nil, false, err
+			}
+			if weightedService == nil {
+				continue
+			}
+			headerRoute := map[string]any{
+				"kind":  "Rule",
+				"match": fmt.Sprintf("(%s) && %s", match, headerRule),
+				"services": []any{map[string]any{
+					"name": r.Rollout.Spec.Strategy.Canary.CanaryService,
+					"port": port,
+				}},
+			}
+			if middlewares, ok := typedRoute["middlewares"]; ok {
+				headerRoute["middlewares"] = runtime.DeepCopyJSONValue(middlewares)
+			}
+			if priority, ok := typedRoute["priority"].(int64); ok && priority > 0 {
+				headerRoute["priority"] = priority + 1
+			}
+			headerRoutes = append(headerRoutes, headerRoute)
+			rules = append(rules, headerRoute["match"].(string))
+		}
+		if len(headerRoutes) == 0 {
+			return nil, false, fmt.Errorf("traefik ingress route has no route to the weighted traefik service %q", weightedServiceName)
+		}
+		managed[headerRouting.Name] = rules
+		return append(routes, headerRoutes...), true, nil
+	})
+}
+
+// removeHeaderRoute removes the routes added for the header route from the IngressRoute. An empty name removes the
+// routes of every header route.
+func (r *Reconciler) removeHeaderRoute(name string) error {
+	if r.Rollout.Spec.Strategy.Canary.TrafficRouting.Traefik.IngressRouteName == "" {
+		return nil
+	}
+	return r.updateIngressRoute(context.TODO(), func(routes []any, managed map[string][]string) ([]any, bool, error) {
+		routes, changed := dropManagedRoutes(routes, managed, name)
+		return routes, changed, nil
+	})
+}
+
+// updateIngressRoute applies mutate to the routes of the IngressRoute and the managed routes recorded in its
+// ManagedRoutesAnnotation, and updates the IngressRoute if they changed
+func (r *Reconciler) updateIngressRoute(ctx context.Context, mutate func(routes []any, managed map[string][]string) ([]any, bool, error)) error {
+	ingressRouteName := r.Rollout.Spec.Strategy.Canary.TrafficRouting.Traefik.IngressRouteName
+	ingressRoute, err := r.IngressRouteClient.Get(ctx, ingressRouteName, metav1.GetOptions{})
+	if err != nil {
+		return err
+	}
+	routes, isFound, err := unstructured.NestedSlice(ingressRoute.Object, "spec", "routes")
+	if err != nil {
+		return err
+	}
+	if !isFound {
+		return errors.New("spec.routes was not found in traefik ingress route manifest")
+	}
+	annotations := ingressRoute.GetAnnotations()
+	managed := map[string][]string{}
+	if value, ok := annotations[ManagedRoutesAnnotation]; ok {
+		if err := json.Unmarshal([]byte(value), &managed); err != nil {
+			return fmt.Errorf("failed to decode annotation %s of traefik ingress route %q: %w", ManagedRoutesAnnotation, ingressRouteName, err)
+		}
+	}
+	routes, changed, err := mutate(routes, managed)
+	if err != nil || !changed {
+		return err
+	}
+	err = unstructured.SetNestedSlice(ingressRoute.Object, routes, "spec", "routes")
+	if err != nil {
+		return err
+	}
+	if len(managed) == 0 {
+		delete(annotations, ManagedRoutesAnnotation)
+	} else {
+		if annotations == nil {
+			annotations = map[string]string{}
+		}
+		value, err := json.Marshal(managed)
+		if err != nil {
+			return err
+		}
+		annotations[ManagedRoutesAnnotation] = string(value)
+	}
+	ingressRoute.SetAnnotations(annotations)
+	_, err = r.IngressRouteClient.Update(ctx, ingressRoute, metav1.UpdateOptions{})
+	if err != nil {
+		msg := fmt.Sprintf("Error updating traefik ingress route %q: %s", ingressRoute.GetName(), err)
+		r.sendWarningEvent(TraefikIngressRouteUpdateError, msg)
+	}
+	return err
+}
+
+// dropManagedRoutes drops the routes added for the managed route, or for every managed route if name is empty
+func dropManagedRoutes(routes []any, managed map[string][]string, name string) ([]any, bool) {
+	removed := map[string][]string{}
+	for managedRoute, rules := range managed {
+		if name == "" || managedRoute == name {
+			removed[managedRoute] = rules
+			delete(managed, managedRoute)
+		}
+	}
+	if len(removed) == 0 {
+		return routes, false
+	}
+	result := []any{}
+	for _, route := range routes {
+		typedRoute, ok := route.(map[string]any)
+		if ok {
+			match, _ := typedRoute["match"].(string)
+			if managedRouteOf(match, removed) != "" {
+				continue
+			}
+		}
+		result = append(result, route)
+	}
+	return result, true
+}
+
+// managedRouteOf returns the managed route for which a route with the match rule was added, if any
+func managedRouteOf(match string, managed map[string][]string) string {
+	for managedRoute, rules := range managed {
+		if slices.Contains(rules, match) {
+			return managedRoute
+		}
+	}
+	return ""
+}
+
+// headerMatchRule converts the header matches to a Traefik rule. Prefix matches are converted to regular
+// expressions, since Traefik has no prefix header matcher.
+func headerMatchRule(matches []v1alpha1.HeaderRoutingMatch) string {
+	header, headerRegexp := "Header", "HeaderRegexp"
+	if defaults.GetTraefikAPIGroup() == legacyTraefikAPIGroup {
+		header, headerRegexp = "Headers", "HeadersRegexp"
+	}
+	rules := []string{}
+	for _, match := range matches {
+		name := quoteRuleValue(match.HeaderName)
+		switch {
+		case match.HeaderValue == nil || match.HeaderValue.Exact != "":
+			value := ""
+			if match.HeaderValue != nil {
+				value = match.HeaderValue.Exact
+			}
+			rules = append(rules, fmt.Sprintf("%s(%s, %s)", header, name, quoteRuleValue(value)))
+		case match.HeaderValue.Prefix != "":
+			rules = append(rules, fmt.Sprintf("%s(%s, %s)", headerRegexp, name, quoteRuleValue("^"+regexp.QuoteMeta(match.HeaderValue.Prefix)+".*")))
+		case match.HeaderValue.Regex != "":
+			rules = append(rules, fmt.Sprintf("%s(%s, %s)", headerRegexp, name, quoteRuleValue(match.HeaderValue.Regex)))
+		default:
+			rules = append(rules, fmt.Sprintf("%s(%s, %s)", header, name, quoteRuleValue("")))
+		}
+	}
+	return strings.Join(rules, " && ")
+}
+
+// quoteRuleValue quotes a value of a Traefik rule with backticks, or as a Go string if it contains a backtick
+func quoteRuleValue(value string) string {
+	if strings.Contains(value, "`") {
+		return strconv.Quote(value)
+	}
+	return "`" + value + "`"
 }
 
 func (r *Reconciler) VerifyWeight(desiredWeight int32, additionalDestinations ...v1alpha1.WeightDestination) (*bool, error) {
@@ -171,10 +461,168 @@ func (r *Reconciler) Type() string {
 	return Type
 }
 
+// SetMirrorRoute mirrors traffic to the canary service by adding it to the mirrors of the Traefik mirroring service.
+// Traefik mirrors all the requests reaching the mirroring service, so only one mirror route without matches is active
+// at a time.
 func (r *Reconciler) SetMirrorRoute(setMirrorRoute *v1alpha1.SetMirrorRoute) error {
-	return nil
+	if setMirrorRoute.Match == nil && setMirrorRoute.Percentage == nil {
+		return r.removeMirrorRoute(setMirrorRoute.Name)
+	}
+	for _, match := range setMirrorRoute.Match {
+		if match.Method != nil || match.Path != nil || len(match.Headers) > 0 {
+			return fmt.Errorf("mirror route %q has matches but traefik mirrors all requests", setMirrorRoute.Name)
+		}
+	}
+	ctx := context.TODO()
+	rollout := r.Rollout
+	mirrorServiceName := rollout.Spec.Strategy.Canary.TrafficRouting.Traefik.MirrorTraefikServiceName
+	if mirrorServiceName == "" {
+		return fmt.Errorf("mirror route %q requires a traefik mirroring service", setMirrorRoute.Name)
+	}
+	canaryServiceName := rollout.Spec.Strategy.Canary.CanaryService
+	port, err := r.canaryServicePort(ctx)
+	if err != nil {
+		return err
+	}
+
+	mirrorService, err := r.Client.Get(ctx, mirrorServiceName, metav1.GetOptions{})
+	if err != nil {
+		return err
+	}
+	mirrors, err := getMirrors(mirrorService)
+	if err != nil {
+		return err
+	}
+	mirrors, err = removeService(canaryServiceName, mirrors)
+	if err != nil {
+		return err
+	}
+	percent := int64(100)
+	if setMirrorRoute.Percentage != nil {
+		percent = int64(*setMirrorRoute.Percentage)
+	}
+	mirrors = append(mirrors, map[string]any{
+		"name":    canaryServiceName,
+		"port":    port,
+		"percent": percent,
+	})
+	err = unstructured.SetNestedSlice(mirrorService.Object, mirrors, "spec", "mirroring", "mirrors")
+	if err != nil {
+		return err
+	}
+	annotations := mirrorService.GetAnnotations()
+	if annotations == nil {
+		annotations = map[string]string{}
+	}
+	annotations[MirrorRouteAnnotation] = setMirrorRoute.Name
+	mirrorService.SetAnnotations(annotations)
+	return r.updateMirrorService(ctx, mirrorService)
+}
+
+// canaryServicePort returns the port of the canary service in the weighted Traefik service
+func (r *Reconciler) canaryServicePort(ctx context.Context) (any, error) {
+	weightedService, err := r.Client.Get(ctx, r.Rollout.Spec.Strategy.Canary.TrafficRouting.Traefik.WeightedTraefikServiceName, metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+	services, isFound, err := unstructured.NestedSlice(weightedService.Object, "spec", "weighted", "services")
+	if err != nil {
+		return nil, err
+	}
+	if !isFound {
+		return nil, errors.New("spec.weighted.services was not found in traefik service manifest")
+	}
+	canaryService, err := getService(r.Rollout.Spec.Strategy.Canary.CanaryService, services)
+	if err != nil {
+		return nil, err
+	}
+	if canaryService == nil {
+		return nil, errors.New("traefik canary service was not found")
+	}
+	port, isFound := canaryService["port"]
+	if !isFound {
+		return nil, errors.New("port field was not found in traefik canary service")
+	}
+	return port, nil
+}
+
+// removeMirrorRoute removes the canary service from the mirrors of the Traefik mirroring service if the mirror route
+// is the active one. An empty name removes any mirror route.
+func (r *Reconciler) removeMirrorRoute(name string) error {
+	ctx := context.TODO()
+	mirrorServiceName := r.Rollout.Spec.Strategy.Canary.TrafficRouting.Traefik.MirrorTraefikServiceName
+	if mirrorServiceName == "" {
+		return nil
+	}
+	mirrorService, err := r.Client.Get(ctx, mirrorServiceName, metav1.GetOptions{})
+	if err != nil {
+		return err
+	}
+	annotations := mirrorService.GetAnnotations()
+	activeRoute, ok := annotations[MirrorRouteAnnotation]
+	if !ok || (name != "" && activeRoute != name) {
+		return nil
+	}
+	mirrors, err := getMirrors(mirrorService)
+	if err != nil {
+		return err
+	}
+	mirrors, err = removeService(r.Rollout.Spec.Strategy.Canary.CanaryService, mirrors)
+	if err != nil {
+		return err
+	}
+	err = unstructured.SetNestedSlice(mirrorService.Object, mirrors, "spec", "mirroring", "mirrors")
+	if err != nil {
+		return err
+	}
+	delete(annotations, MirrorRouteAnnotation)
+	mirrorService.SetAnnotations(annotations)
+	return r.updateMirrorService(ctx, mirrorService)
 }
 
+func (r *Reconciler) updateMirrorService(ctx context.Context, mirrorService *unstructured.Unstructured) error {
+	_, err := r.Client.Update(ctx, mirrorService, metav1.UpdateOptions{})
+	if err != nil {
+		msg := fmt.Sprintf("Error updating traefik service %q: %s", mirrorService.GetName(), err)
+		r.sendWarningEvent(TraefikServiceUpdateError, msg)
+	}
+	return err
+}
+
+func getMirrors(mirrorService *unstructured.Unstructured) ([]any, error) {
+	_, isFound, err := unstructured.NestedMap(mirrorService.Object, "spec", "mirroring")
+	if err != nil {
+		return nil, err
+	}
+	if !isFound {
+		return nil, errors.New("spec.mirroring was not found in traefik service manifest")
+	}
+	mirrors, _, err := unstructured.NestedSlice(mirrorService.Object, "spec", "mirroring", "mirrors")
+	if err != nil {
+		return nil, err
+	}
+	return mirrors, nil
+}
+
+func removeService(serviceName string, services []any) ([]any, error) {
+	result := []any{}
+	for _, service := range services {
+		typedService, ok := service.(map[string]any)
+		if !ok {
+			return nil, errors.New("Failed type assertion removing traefik service")
+		}
+		if typedService["name"] != serviceName {
+			result = append(result, service)
+		}
+	}
+	return result, nil
+}
+
+// RemoveManagedRoutes removes the header routes from the IngressRoute and the canary service from the mirrors of the
+// Traefik mirroring service
 func (r *Reconciler) RemoveManagedRoutes() error {
-	return nil
+	if err := r.removeHeaderRoute(""); err != nil {
+		return err
+	}
+	return r.removeMirrorRoute("")
 }
@@ -1,15 +1,22 @@
 package traefik
 
 import (
+	"context"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/serializer/yaml"
+	"k8s.io/client-go/dynamic"
+	dynamicfake "k8s.io/client-go/dynamic/fake"
+	"k8s.io/utils/ptr"
 
 	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
 	"github.com/argoproj/argo-rollouts/rollout/trafficrouting/traefik/mocks"
+	"github.com/argoproj/argo-rollouts/utils/defaults"
 )
 
 const traefikService = `
@@ -187,15 +194,60 @@ func TestSetWeight(t *testing.T) {
 	})
 }
 
+const ingressRoute = `
+apiVersion: traefik.io/v1alpha1
+kind: IngressRoute
+metadata:
+  name: ingress-route
+  namespace: default
+spec:
+  entryPoints:
+    - web
+  routes:
+    - kind: Rule
+      match: Host(` + "`example.com`" + `)
+      priority: 10
+      middlewares:
+        - name: strip-prefix
+      services:
+        - name: mocks-service
+          kind: TraefikService
+    - kind: Rule
+      match: Host(` + "`other.com`" + `)
+      services:
+        - name: other-service
+          port: 80
+`
+
+func newHeaderRouteReconciler(t *testing.T) (*Reconciler, dynamic.ResourceInterface) {
+	t.Helper()
+	rollout := newRollout(stableServiceName, canaryServiceName, traefikServiceName)
+	rollout.Spec.Strategy.Canary.TrafficRouting.Traefik.IngressRouteName = "ingress-route"
+	dynamicClient := dynamicfake.NewSimpleDynamicClient(runtime.NewScheme(), toUnstructured(t, weightedTraefikService), toUnstructured(t, ingressRoute))
+	ingressRouteClient := NewIngressRouteDynamicClient(dynamicClient, "default")
+	r := NewReconciler(&ReconcilerConfig{
+		Rollout:            rollout,
+		Client:             NewDynamicClient(dynamicClient, "default"),
+		IngressRouteClient: ingressRouteClient,
+		Recorder:           &mocks.FakeRecorder{},
+	})
+	return r, ingressRouteClient
+}
+
+func getRoutes(t *testing.T, client dynamic.ResourceInterface) ([]any, map[string]string) {
+	t.Helper()
+	ingressRoute, err := client.Get(context.TODO(), "ingress-route", metav1.GetOptions{})
+	assert.NoError(t, err)
+	routes, _, err := unstructured.NestedSlice(ingressRoute.Object, "spec", "routes")
+	assert.NoError(t, err)
+	return routes, ingressRoute.GetAnnotations()
+}
+
 func TestSetHeaderRoute(t *testing.T) {
 	t.Run("SetHeaderRoute", func(t *testing.T) {
 		// Given
-		t.Parallel()
-		cfg := ReconcilerConfig{
-			Rollout: newRollout(stableServiceName, canaryServiceName, traefikServiceName),
-			Client:  &mocks.FakeClient{},
-		}
-		r := NewReconciler(&cfg)
+		r, client := newHeaderRouteReconciler(t)
+		originalRoutes, _ := getRoutes(t, client)
 
 		// When
 		err := r.SetHeaderRoute(&v1alpha1.SetHeaderRoute{
@@ -205,27 +257,270 @@ func TestSetHeaderRoute(t *testing.T) {
 				HeaderValue: &v1alpha1.StringMatch{
 					Exact: "value",
 				},
+			}, {
+				HeaderName: "user-agent",
+				HeaderValue: &v1alpha1.StringMatch{
+					Prefix: "Mozilla/",
+				},
 			}},
 		})
 
 		// Then
 		assert.NoError(t, err)
+		routes, annotations := getRoutes(t, client)
+		expectedMatch := "(Host(`example.com`)) && Header(`header-name`, `value`) && HeaderRegexp(`user-agent`, `^Mozilla/.*`)"
+		assert.Equal(t, append(originalRoutes, map[string]any{
+			"kind":        "Rule",
+			"match":       expectedMatch,
+			"priority":    int64(11),
+			"middlewares": []any{map[string]any{"name": "strip-prefix"}},
+			"services":    []any{map[string]any{"name": canaryServiceName, "port": int64(80)}},
+		}), routes)
+		assert.JSONEq(t, `{"set-header":["`+expectedMatch+`"]}`, annotations[ManagedRoutesAnnotation])
 
-		err = r.RemoveManagedRoutes()
-		assert.Nil(t, err)
+		// When
+		err = r.SetHeaderRoute(&v1alpha1.SetHeaderRoute{
+			Name: "set-header",
+			Match: []v1alpha1.HeaderRoutingMatch{{
+				HeaderName:  "header-name",
+				HeaderValue: &v1alpha1.StringMatch{Regex: "v.*"},
+			}},
+		})
+
+		// Then
+		assert.NoError(t, err)
+		routes, _ = getRoutes(t, client)
+		assert.Len(t, routes, 3)
+		assert.Equal(t, "(Host(`example.com`)) && HeaderRegexp(`header-name`, `v.*`)", routes[2].(map[string]any)["match"])
+
+		// When
+		err = r.SetHeaderRoute(&v1alpha1.SetHeaderRoute{Name: "set-header"})
+
+		// Then
+		assert.NoError(t, err)
+		routes, annotations = getRoutes(t, client)
+		assert.Equal(t, originalRoutes, routes)
+		assert.NotContains(t, annotations, ManagedRoutesAnnotation)
 	})
-}
+	t.Run("RemoveManagedRoutes", func(t *testing.T) {
+		// Given
+		r, client := newHeaderRouteReconciler(t)
+		originalRoutes, _ := getRoutes(t, client)
+		for _, name := range []string{"header-1", "header-2"} {
+			err := r.SetHeaderRoute(&v1alpha1.SetHeaderRoute{
+				Name:  name,
+				Match: []v1alpha1.HeaderRoutingMatch{{HeaderName: name, HeaderValue: &v1alpha1.StringMatch{Exact: "true"}}},
+			})
+			assert.NoError(t, err)
+		}
+		routes, _ := getRoutes(t, client)
+		assert.Len(t, routes, 4)
 
-func TestSetMirrorRoute(t *testing.T) {
-	t.Run("SetMirrorRoute", func(t *testing.T) {
+		// When
+		err := r.RemoveManagedRoutes()
+
+		// Then
+		assert.NoError(t, err)
+		routes, annotations := getRoutes(t, client)
+		assert.Equal(t, originalRoutes, routes)
+		assert.NotContains(t, annotations, ManagedRoutesAnnotation)
+	})
+	t.Run("SetHeaderRouteWithTraefikV2", func(t *testing.T) {
+		// Given
+		defaults.SetTraefikAPIGroup("traefik.containo.us")
+		defer defaults.SetTraefikAPIGroup(defaults.DefaultTraefikAPIGroup)
+
+		// When
+		rule := headerMatchRule([]v1alpha1.HeaderRoutingMatch{{
+			HeaderName:  "header-name",
+			HeaderValue: &v1alpha1.StringMatch{Exact: "with`backtick"},
+		}, {
+			HeaderName:  "header-name",
+			HeaderValue: &v1alpha1.StringMatch{Regex: "v.*"},
+		}})
+
+		// Then
+		assert.Equal(t, "Headers(`header-name`, \"with`backtick\") && HeadersRegexp(`header-name`, `v.*`)", rule)
+	})
+	t.Run("SetHeaderRouteWithoutIngressRoute", func(t *testing.T) {
 		// Given
-		t.Parallel()
 		cfg := ReconcilerConfig{
 			Rollout: newRollout(stableServiceName, canaryServiceName, traefikServiceName),
 			Client:  &mocks.FakeClient{},
 		}
 		r := NewReconciler(&cfg)
 
+		// When
+		err := r.SetHeaderRoute(&v1alpha1.SetHeaderRoute{
+			Name:  "set-header",
+			Match: []v1alpha1.HeaderRoutingMatch{{HeaderName: "header-name"}},
+		})
+
+		// Then
+		assert.EqualError(t, err, `header route "set-header" requires a traefik ingress route`)
+		assert.NoError(t, r.SetHeaderRoute(&v1alpha1.SetHeaderRoute{Name: "set-header"}))
+	})
+	t.Run("SetHeaderRouteWithoutWeightedRoute", func(t *testing.T) {
+		// Given
+		r, _ := newHeaderRouteReconciler(t)
+		r.Rollout.Spec.Strategy.Canary.TrafficRouting.Traefik.WeightedTraefikServiceName = "unknown-service"
+
+		// When
+		err := r.SetHeaderRoute(&v1alpha1.SetHeaderRoute{
+			Name:  "set-header",
+			Match: []v1alpha1.HeaderRoutingMatch{{HeaderName: "header-name"}},
+		})
+
+		// Then
+		assert.Error(t, err)
+	})
+}
+
+func TestSetWeightWithAdditionalDestinations(t *testing.T) {
+	// Given
+	r, _ := newHeaderRouteReconciler(t)
+	client := r.Client
+	getServices := func() ([]any, map[string]string) {
+		traefikService, err := client.Get(context.TODO(), traefikServiceName, metav1.GetOptions{})
+		assert.NoError(t, err)
+		services, _, err := unstructured.NestedSlice(traefikService.Object, "spec", "weighted", "services")
+		assert.NoError(t, err)
+		return services, traefikService.GetAnnotations()
+	}
+
+	// When
+	err := r.SetWeight(20, v1alpha1.WeightDestination{ServiceName: "experiment-a", Weight: 10}, v1alpha1.WeightDestination{ServiceName: "experiment-b", Weight: 5})
+
+	// Then
+	assert.NoError(t, err)
+	services, annotations := getServices()
+	assert.Equal(t, []any{
+		map[string]any{"name": stableServiceName, "port": int64(80), "weight": int64(65)},
+		map[string]any{"name": canaryServiceName, "port": int64(80), "weight": int64(20)},
+		map[string]any{"name": "experiment-a", "port": int64(80), "weight": int64(10)},
+		map[string]any{"name": "experiment-b", "port": int64(80), "weight": int64(5)},
+	}, services)
+	assert.Equal(t, "experiment-a,experiment-b", annotations[WeightDestinationsAnnotation])
+
+	// When
+	err = r.SetWeight(20, v1alpha1.WeightDestination{ServiceName: "experiment-b", Weight: 10})
+
+	// Then
+	assert.NoError(t, err)
+	services, _ = getServices()
+	assert.Equal(t, []any{
+		map[string]any{"name": stableServiceName, "port": int64(80), "weight": int64(70)},
+		map[string]any{"name": canaryServiceName, "port": int64(80), "weight": int64(20)},
+		map[string]any{"name": "experiment-b", "port": int64(80), "weight": int64(10)},
+	}, services)
+
+	// When
+	err = r.SetWeight(50)
+
+	// Then
+	assert.NoError(t, err)
+	services, annotations = getServices()
+	assert.Len(t, services, 2)
+	assert.NotContains(t, annotations, WeightDestinationsAnnotation)
+}
+
+const weightedTraefikService = `
+apiVersion: traefik.io/v1alpha1
+kind: TraefikService
+metadata:
+  name: mocks-service
+  namespace: default
+spec:
+  weighted:
+    services:
+      - name: stable-rollout
+        weight: 100
+        port: 80
+      - name: canary-rollout
+        weight: 0
+        port: 80
+`
+
+const mirrorTraefikService = `
+apiVersion: traefik.io/v1alpha1
+kind: TraefikService
+metadata:
+  name: mirror-service
+  namespace: default
+spec:
+  mirroring:
+    name: mocks-service
+    kind: TraefikService
+    mirrors:
+      - name: shadow
+        port: 8080
+        percent: 10
+`
+
+func newMirrorReconciler(t *testing.T, mirrorService string) (*Reconciler, dynamic.ResourceInterface) {
+	t.Helper()
+	rollout := newRollout(stableServiceName, canaryServiceName, traefikServiceName)
+	rollout.Spec.Strategy.Canary.TrafficRouting.Traefik.MirrorTraefikServiceName = "mirror-service"
+	client := NewDynamicClient(dynamicfake.NewSimpleDynamicClient(runtime.NewScheme(), toUnstructured(t, weightedTraefikService), toUnstructured(t, mirrorService)), "default")
+	r := NewReconciler(&ReconcilerConfig{
+		Rollout:  rollout,
+		Client:   client,
+		Recorder: &mocks.FakeRecorder{},
+	})
+	return r, client
+}
+
+func getMirrorService(t *testing.T, client dynamic.ResourceInterface) *unstructured.Unstructured {
+	t.Helper()
+	mirrorService, err := client.Get(context.TODO(), "mirror-service", metav1.GetOptions{})
+	assert.NoError(t, err)
+	return mirrorService
+}
+
+func TestSetMirrorRoute(t *testing.T) {
+	t.Run("SetMirrorRoute", func(t *testing.T) {
+		// Given
+		r, client := newMirrorReconciler(t, mirrorTraefikService)
+
+		// When
+		err := r.SetMirrorRoute(&v1alpha1.SetMirrorRoute{
+			Name:       "mirror-route",
+			Percentage: ptr.To[int32](50),
+		})
+
+		// Then
+		assert.NoError(t, err)
+		mirrorService := getMirrorService(t, client)
+		mirrors, _, err := unstructured.NestedSlice(mirrorService.Object, "spec", "mirroring", "mirrors")
+		assert.NoError(t, err)
+		assert.Equal(t, []any{
+			map[string]any{"name": "shadow", "port": int64(8080), "percent": int64(10)},
+			map[string]any{"name": canaryServiceName, "port": int64(80), "percent": int64(50)},
+		}, mirrors)
+		assert.Equal(t, "mirror-route", mirrorService.GetAnnotations()[MirrorRouteAnnotation])
+
+		// When
+		err = r.SetMirrorRoute(&v1alpha1.SetMirrorRoute{Name: "other-route"})
+
+		// Then
+		assert.NoError(t, err)
+		assert.Equal(t, "mirror-route", getMirrorService(t, client).GetAnnotations()[MirrorRouteAnnotation])
+
+		// When
+		err = r.RemoveManagedRoutes()
+
+		// Then
+		assert.NoError(t, err)
+		mirrorService = getMirrorService(t, client)
+		mirrors, _, err = unstructured.NestedSlice(mirrorService.Object, "spec", "mirroring", "mirrors")
+		assert.NoError(t, err)
+		assert.Equal(t, []any{map[string]any{"name": "shadow", "port": int64(8080), "percent": int64(10)}}, mirrors)
+		assert.NotContains(t, mirrorService.GetAnnotations(), MirrorRouteAnnotation)
+	})
+	t.Run("SetMirrorRouteWithMatches", func(t *testing.T) {
+		// Given
+		r, _ := newMirrorReconciler(t, mirrorTraefikService)
+
 		// When
 		err := r.SetMirrorRoute(&v1alpha1.SetMirrorRoute{
 			Name: "mirror-route",
@@ -235,10 +530,32 @@ func TestSetMirrorRoute(t *testing.T) {
 		})
 
 		// Then
-		assert.NoError(t, err)
+		assert.EqualError(t, err, `mirror route "mirror-route" has matches but traefik mirrors all requests`)
+	})
+	t.Run("SetMirrorRouteWithoutMirrorService", func(t *testing.T) {
+		// Given
+		cfg := ReconcilerConfig{
+			Rollout: newRollout(stableServiceName, canaryServiceName, traefikServiceName),
+			Client:  &mocks.FakeClient{},
+		}
+		r := NewReconciler(&cfg)
 
-		err = r.RemoveManagedRoutes()
-		assert.Nil(t, err)
+		// When
+		err := r.SetMirrorRoute(&v1alpha1.SetMirrorRoute{Name: "mirror-route", Match: []v1alpha1.RouteMatch{{}}})
+
+		// Then
+		assert.EqualError(t, err, `mirror route "mirror-route" requires a traefik mirroring service`)
+		assert.NoError(t, r.RemoveManagedRoutes())
+	})
+	t.Run("SetMirrorRouteWithoutMirroring", func(t *testing.T) {
+		// Given
+		r, _ := newMirrorReconciler(t, strings.Replace(weightedTraefikService, "mocks-service", "mirror-service", 1))
+
+		// When
+		err := r.SetMirrorRoute(&v1alpha1.SetMirrorRoute{Name: "mirror-route", Percentage: ptr.To[int32](100)})
+
+		// Then
+		assert.EqualError(t, err, "spec.mirroring was not found in traefik service manifest")
 	})
 }
 
@@ -22,3 +22,17 @@ type TrafficRoutingReconciler interface {
 	// Type returns the type of the traffic routing reconciler
 	Type() string
 }
+
+// LocalityRoutingReconciler is implemented by the traffic routing reconcilers supporting the setLocality step
+type LocalityRoutingReconciler interface {
+	// SetLocality sends the traffic originating from the localities of the step to the canary
+	SetLocality(setLocality *v1alpha1.SetLocality) error
+}
+
+// DriftDetector is implemented by the traffic routing reconcilers which can tell whether their managed resources
+// drifted from the desired weights, e.g. after being edited by hand
+type DriftDetector interface {
+	// DetectDrift returns a description of how the managed resources differ from the desired weights, or an empty
+	// string when they have the desired weights
+	DetectDrift(desiredWeight int32, additionalDestinations ...v1alpha1.WeightDestination) (string, error)
+}
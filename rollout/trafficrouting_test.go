@@ -30,16 +30,21 @@ import (
 	"github.com/argoproj/argo-rollouts/rollout/trafficrouting/alb"
 	apisixMocks "github.com/argoproj/argo-rollouts/rollout/trafficrouting/apisix/mocks"
 	"github.com/argoproj/argo-rollouts/rollout/trafficrouting/appmesh"
+	"github.com/argoproj/argo-rollouts/rollout/trafficrouting/consul"
+	"github.com/argoproj/argo-rollouts/rollout/trafficrouting/contour"
 	"github.com/argoproj/argo-rollouts/rollout/trafficrouting/istio"
+	"github.com/argoproj/argo-rollouts/rollout/trafficrouting/kong"
 	"github.com/argoproj/argo-rollouts/rollout/trafficrouting/nginx"
 	"github.com/argoproj/argo-rollouts/rollout/trafficrouting/smi"
 	"github.com/argoproj/argo-rollouts/rollout/trafficrouting/traefik"
 	traefikMocks "github.com/argoproj/argo-rollouts/rollout/trafficrouting/traefik/mocks"
 	testutil "github.com/argoproj/argo-rollouts/test/util"
 	"github.com/argoproj/argo-rollouts/utils/conditions"
+	"github.com/argoproj/argo-rollouts/utils/defaults"
 	ingressutil "github.com/argoproj/argo-rollouts/utils/ingress"
 	istioutil "github.com/argoproj/argo-rollouts/utils/istio"
 	logutil "github.com/argoproj/argo-rollouts/utils/log"
+	"github.com/argoproj/argo-rollouts/utils/record"
 	replicasetutil "github.com/argoproj/argo-rollouts/utils/replicaset"
 	timeutil "github.com/argoproj/argo-rollouts/utils/time"
 	unstructuredutil "github.com/argoproj/argo-rollouts/utils/unstructured"
@@ -765,6 +770,26 @@ func TestNewTrafficRoutingReconciler(t *testing.T) {
 			assert.Equal(t, apisix.Type, networkReconciler.Type())
 		}
 	}
+	{
+		tsController := Controller{}
+		r := newCanaryRollout("foo", 10, nil, steps, ptr.To[int32](1), intstr.FromInt(1), intstr.FromInt(0))
+		r.Spec.Strategy.Canary.TrafficRouting = &v1alpha1.RolloutTrafficRouting{
+			Kong:    &v1alpha1.KongTrafficRouting{},
+			Contour: &v1alpha1.ContourTrafficRouting{},
+			Consul:  &v1alpha1.ConsulTrafficRouting{},
+		}
+		roCtx := &rolloutContext{
+			rollout:      r,
+			log:          logutil.WithRollout(r),
+			pauseContext: &pauseContext{rollout: r},
+		}
+		networkReconcilerList, err := tsController.NewTrafficRoutingReconciler(roCtx)
+		assert.Nil(t, err)
+		assert.Len(t, networkReconcilerList, 3)
+		for i, reconcilerType := range []string{kong.Type, contour.Type, consul.Type} {
+			assert.Equal(t, reconcilerType, networkReconcilerList[i].Type())
+		}
+	}
 	{
 		// (2) Multiple Reconcilers (Nginx + SMI)
 		tsController := Controller{}
@@ -2294,3 +2319,123 @@ func TestDynamicStableScaleNewCanarySupersedeShouldNotOverloadStable(t *testing.
 			"checkReplicasAvailable should return early. Calls observed: %v", setWeightCalls)
 	f.fakeTrafficRouting.AssertNotCalled(t, "UpdateHash", mock.Anything, mock.Anything, mock.Anything)
 }
+
+type driftDetectingTrafficRoutingReconciler struct {
+	*mocks.TrafficRoutingReconciler
+	drift string
+	err   error
+}
+
+func (r *driftDetectingTrafficRoutingReconciler) DetectDrift(desiredWeight int32, additionalDestinations ...v1alpha1.WeightDestination) (string, error) {
+	return r.drift, r.err
+}
+
+func TestDetectTrafficRoutingDrift(t *testing.T) {
+	ro := newCanaryRollout("foo", 1, nil, nil, nil, intstr.FromInt(1), intstr.FromInt(0))
+	newRolloutContext := func() (*rolloutContext, *record.FakeEventRecorder) {
+		recorder := record.NewFakeEventRecorder()
+		return &rolloutContext{
+			log:            logutil.WithRollout(ro),
+			reconcilerBase: reconcilerBase{recorder: recorder},
+			rollout:        ro,
+		}, recorder
+	}
+
+	t.Run("reconciler without drift detection", func(t *testing.T) {
+		c, recorder := newRolloutContext()
+		assert.False(t, c.detectTrafficRoutingDrift(newUnmockedFakeTrafficRoutingReconciler(), 10))
+		assert.False(t, c.trafficRoutingDriftChecked)
+		assert.Empty(t, recorder.Events())
+	})
+
+	t.Run("no drift", func(t *testing.T) {
+		c, recorder := newRolloutContext()
+		reconciler := &driftDetectingTrafficRoutingReconciler{TrafficRoutingReconciler: newUnmockedFakeTrafficRoutingReconciler()}
+		assert.False(t, c.detectTrafficRoutingDrift(reconciler, 10))
+		assert.True(t, c.trafficRoutingDriftChecked)
+		assert.Empty(t, c.trafficRoutingDrifts)
+		assert.Empty(t, recorder.Events())
+	})
+
+	t.Run("drift", func(t *testing.T) {
+		c, recorder := newRolloutContext()
+		reconciler := &driftDetectingTrafficRoutingReconciler{
+			TrafficRoutingReconciler: newUnmockedFakeTrafficRoutingReconciler(),
+			drift:                    "canary mapping `foo-canary` has weight 50 instead of 10",
+		}
+		assert.True(t, c.detectTrafficRoutingDrift(reconciler, 10))
+		assert.Equal(t, []string{"canary mapping `foo-canary` has weight 50 instead of 10"}, c.trafficRoutingDrifts)
+		assert.Equal(t, []string{conditions.TrafficRoutingDriftReason}, recorder.Events())
+	})
+
+	t.Run("error detecting drift", func(t *testing.T) {
+		c, recorder := newRolloutContext()
+		reconciler := &driftDetectingTrafficRoutingReconciler{
+			TrafficRoutingReconciler: newUnmockedFakeTrafficRoutingReconciler(),
+			err:                      errors.New("some error"),
+		}
+		assert.False(t, c.detectTrafficRoutingDrift(reconciler, 10))
+		assert.False(t, c.trafficRoutingDriftChecked)
+		assert.Empty(t, recorder.Events())
+	})
+}
+
+func TestRolloutTrafficRoutingDriftWithoutSelfHeal(t *testing.T) {
+	defaults.SetSelfHealTrafficRouting(false)
+	defer defaults.SetSelfHealTrafficRouting(true)
+	f := newFixture(t)
+	defer f.Close()
+
+	steps := []v1alpha1.CanaryStep{
+		{
+			SetWeight: ptr.To[int32](10),
+		},
+		{
+			Pause: &v1alpha1.RolloutPause{},
+		},
+	}
+	r1 := newCanaryRollout("foo", 10, nil, steps, ptr.To[int32](0), intstr.FromInt(1), intstr.FromInt(0))
+	r2 := bumpVersion(r1)
+	r2.Spec.Strategy.Canary.TrafficRouting = &v1alpha1.RolloutTrafficRouting{}
+	r2.Spec.Strategy.Canary.CanaryService = "canary"
+	r2.Spec.Strategy.Canary.StableService = "stable"
+
+	rs1 := newReplicaSetWithStatus(r1, 10, 10)
+	rs2 := newReplicaSetWithStatus(r2, 1, 1)
+
+	rs1PodHash := rs1.Labels[v1alpha1.DefaultRolloutUniqueLabelKey]
+	rs2PodHash := rs2.Labels[v1alpha1.DefaultRolloutUniqueLabelKey]
+	canarySelector := map[string]string{v1alpha1.DefaultRolloutUniqueLabelKey: rs2PodHash}
+	stableSelector := map[string]string{v1alpha1.DefaultRolloutUniqueLabelKey: rs1PodHash}
+	canarySvc := newService("canary", 80, canarySelector, r2)
+	stableSvc := newService("stable", 80, stableSelector, r2)
+
+	f.kubeobjects = append(f.kubeobjects, rs1, rs2, canarySvc, stableSvc)
+	f.replicaSetLister = append(f.replicaSetLister, rs1, rs2)
+
+	r2 = updateCanaryRolloutStatus(r2, rs1PodHash, 10, 1, 10, false)
+	r2.Status.Canary.Weights = &v1alpha1.TrafficWeights{
+		Canary:   v1alpha1.WeightDestination{Weight: 10, ServiceName: "canary", PodTemplateHash: rs2PodHash},
+		Stable:   v1alpha1.WeightDestination{Weight: 90, ServiceName: "stable", PodTemplateHash: rs1PodHash},
+		Verified: ptr.To[bool](true),
+	}
+	f.rolloutLister = append(f.rolloutLister, r2)
+	f.objects = append(f.objects, r2)
+
+	patchIndex := f.expectPatchRolloutAction(r2)
+
+	f.fakeTrafficRouting = newUnmockedFakeTrafficRoutingReconciler()
+	f.fakeTrafficRouting.On("UpdateHash", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+	f.fakeTrafficRouting.On("SetHeaderRoute", mock.Anything, mock.Anything).Return(nil)
+	f.fakeTrafficRouting.On("SetMirrorRoute", mock.Anything, mock.Anything).Return(nil)
+	f.trafficRoutingDrift = "canary weight is 50 instead of 10"
+	f.run(getKey(r2, t))
+
+	// the drifted weight is neither re-applied nor verified, so the rollout holds at its step
+	f.fakeTrafficRouting.AssertNotCalled(t, "SetWeight", mock.Anything, mock.Anything)
+	f.fakeTrafficRouting.AssertNotCalled(t, "VerifyWeight", mock.Anything)
+	patch := f.getPatchedRollout(patchIndex)
+	assert.NotContains(t, patch, "currentStepIndex")
+	assert.Contains(t, patch, `"type":"TrafficRoutingDrift"`)
+	assert.Contains(t, f.events, conditions.TrafficRoutingDriftReason)
+}
@@ -25,6 +25,7 @@ import (
 	appslisters "k8s.io/client-go/listers/apps/v1"
 	"k8s.io/client-go/tools/cache"
 
+	"github.com/argoproj/argo-rollouts/analysis/history"
 	"github.com/argoproj/argo-rollouts/pkg/apiclient/rollout"
 	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
 	rolloutclientset "github.com/argoproj/argo-rollouts/pkg/client/clientset/versioned"
@@ -57,6 +58,8 @@ type ServerOptions struct {
 	DynamicClientset  dynamic.Interface
 	Namespace         string
 	RootPath          string
+	// AnalysisHistory, when set, serves the AnalysisRuns exported by the controller's analysis history
+	AnalysisHistory history.Lister
 }
 
 const (
@@ -119,6 +122,10 @@ func (s *ArgoRolloutsServer) newHTTPServer(ctx context.Context, port int) *http.
 		apiHandler = http.StripPrefix(stripPrefix, gwmux)
 	}
 	mux.Handle(apiPath, apiHandler)
+	if s.Options.AnalysisHistory != nil {
+		historyPath := apiPath + analysisHistoryPath
+		mux.Handle(historyPath, http.StripPrefix(historyPath, history.NewHandler(s.Options.AnalysisHistory)))
+	}
 	mux.HandleFunc("/", s.staticFileHttpHandler)
 
 	return &httpS
@@ -0,0 +1,5 @@
+package server
+
+// analysisHistoryPath is where the history of a rollout's AnalysisRuns is served, relative to the API
+// path, as analysis-history/<namespace>/<rollout>
+const analysisHistoryPath = "v1/analysis-history/"
@@ -0,0 +1,73 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/argoproj/argo-rollouts/analysis/history"
+	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
+)
+
+func TestAnalysisHistoryHttpHandler(t *testing.T) {
+	sink, err := history.NewDirSink(t.TempDir())
+	assert.NoError(t, err)
+	record := history.Record{
+		Namespace:   "default",
+		Name:        "checkout-abc-3",
+		UID:         "uid",
+		Rollout:     "checkout",
+		Revision:    "3",
+		Phase:       v1alpha1.AnalysisPhaseSuccessful,
+		CompletedAt: metav1.NewTime(time.Now().Truncate(time.Second)),
+	}
+	assert.NoError(t, sink.Write(record))
+
+	s := &ArgoRolloutsServer{
+		Options: ServerOptions{
+			RootPath:        "rollouts",
+			AnalysisHistory: sink,
+		},
+	}
+	handler := s.newHTTPServer(context.Background(), 8080).Handler
+
+	t.Run("lists the history of a rollout", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		handler.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/rollouts/api/v1/analysis-history/default/checkout", nil))
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, "application/json", w.Header().Get(ContentType))
+		var res history.Response
+		assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &res))
+		assert.Equal(t, []history.Record{record}, res.Items)
+	})
+
+	t.Run("rollout without history", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		handler.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/rollouts/api/v1/analysis-history/default/cart", nil))
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.JSONEq(t, `{"items":[]}`, w.Body.String())
+	})
+
+	t.Run("invalid requests", func(t *testing.T) {
+		for _, path := range []string{
+			"/rollouts/api/v1/analysis-history/default",
+			"/rollouts/api/v1/analysis-history/default/checkout/extra",
+			"/rollouts/api/v1/analysis-history/Default/checkout",
+			"/rollouts/api/v1/analysis-history/default/check_out",
+		} {
+			w := httptest.NewRecorder()
+			handler.ServeHTTP(w, httptest.NewRequest(http.MethodGet, path, nil))
+			assert.Equal(t, http.StatusBadRequest, w.Code, path)
+		}
+
+		w := httptest.NewRecorder()
+		handler.ServeHTTP(w, httptest.NewRequest(http.MethodPost, "/rollouts/api/v1/analysis-history/default/checkout", nil))
+		assert.Equal(t, http.StatusMethodNotAllowed, w.Code)
+	})
+}
@@ -1,12 +1,15 @@
 package util
 
 import (
+	"errors"
 	"os"
 
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	dynamicfake "k8s.io/client-go/dynamic/fake"
+	k8stesting "k8s.io/client-go/testing"
 	"sigs.k8s.io/yaml"
 
 	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts"
@@ -54,7 +57,67 @@ func NewFakeDynamicClient(objects ...runtime.Object) *dynamicfake.FakeDynamicCli
 		v1alpha1.AnalysisRunGVR:             rollouts.AnalysisRunKind + "List",
 		v1alpha1.ExperimentGVR:              rollouts.ExperimentKind + "List",
 		v1alpha1.ClusterAnalysisTemplateGVR: rollouts.ClusterAnalysisTemplateKind + "List",
+		v1alpha1.MetricProviderConfigGVR:    rollouts.MetricProviderConfigKind + "List",
 		tgbGVR:                              "TargetGroupBindingList",
 	}
 	return dynamicfake.NewSimpleDynamicClientWithCustomListKinds(scheme, listMapping, objects...)
 }
+
+// UpdateError is the error returned by the updates made to fail with FailUpdates
+const UpdateError = "intentional error"
+
+// NewCanaryRollout returns a rollout whose canary strategy shifts traffic from stable-service to
+// canary-service through the given traffic routing
+func NewCanaryRollout(trafficRouting *v1alpha1.RolloutTrafficRouting) *v1alpha1.Rollout {
+	return &v1alpha1.Rollout{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "rollout",
+			Namespace: metav1.NamespaceDefault,
+		},
+		Spec: v1alpha1.RolloutSpec{
+			Strategy: v1alpha1.RolloutStrategy{
+				Canary: &v1alpha1.CanaryStrategy{
+					StableService:  "stable-service",
+					CanaryService:  "canary-service",
+					TrafficRouting: trafficRouting,
+				},
+			},
+		},
+	}
+}
+
+// NewFakeDynamicClientFromYAML returns a FakeDynamicClient serving the given yaml objects. The objects are
+// decoded through JSON so numbers are int64, as they are when read from the API server.
+func NewFakeDynamicClientFromYAML(yamlStrs ...string) *dynamicfake.FakeDynamicClient {
+	objects := []runtime.Object{}
+	for _, yamlStr := range yamlStrs {
+		data, err := ObjectFromYAML(yamlStr).MarshalJSON()
+		if err != nil {
+			panic(err)
+		}
+		obj := &unstructured.Unstructured{}
+		if err := obj.UnmarshalJSON(data); err != nil {
+			panic(err)
+		}
+		objects = append(objects, obj)
+	}
+	return dynamicfake.NewSimpleDynamicClient(runtime.NewScheme(), objects...)
+}
+
+// CountUpdates returns the number of updates sent through the client
+func CountUpdates(client *dynamicfake.FakeDynamicClient) int {
+	updates := 0
+	for _, action := range client.Actions() {
+		if action.GetVerb() == "update" {
+			updates++
+		}
+	}
+	return updates
+}
+
+// FailUpdates makes the updates of the resource sent through the client fail with UpdateError
+func FailUpdates(client *dynamicfake.FakeDynamicClient, resource string) {
+	client.PrependReactor("update", resource, func(action k8stesting.Action) (bool, runtime.Object, error) {
+		return true, nil, errors.New(UpdateError)
+	})
+}
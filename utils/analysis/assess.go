@@ -0,0 +1,234 @@
+package analysis
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+
+	log "github.com/sirupsen/logrus"
+
+	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
+	"github.com/argoproj/argo-rollouts/utils/defaults"
+	"github.com/argoproj/argo-rollouts/utils/evaluate"
+)
+
+// SuccessfulAssessmentRunTerminatedResult is used for logging purposes when the metrics evaluation
+// is successful and the run is terminated.
+const SuccessfulAssessmentRunTerminatedResult = "Metric Assessment Result - Successful: Run Terminated"
+
+// PendingDependencies returns the names of the metrics listed in the metric's dependsOn which have
+// not yet completed successfully. A dry-run dependency is satisfied once it completes, regardless
+// of its result.
+func PendingDependencies(run *v1alpha1.AnalysisRun, metric v1alpha1.Metric) []string {
+	var pending []string
+	for _, dep := range metric.DependsOn {
+		result := GetResult(run, dep)
+		if result == nil || !result.Phase.Completed() || (!result.DryRun && result.Phase != v1alpha1.AnalysisPhaseSuccessful) {
+			pending = append(pending, dep)
+		}
+	}
+	return pending
+}
+
+// FailedDependencies returns the names of the metrics listed in the metric's dependsOn which
+// completed unsuccessfully, so that the metric will never start
+func FailedDependencies(run *v1alpha1.AnalysisRun, metric v1alpha1.Metric) []string {
+	var failed []string
+	for _, dep := range metric.DependsOn {
+		result := GetResult(run, dep)
+		if result != nil && result.Phase.Completed() && !result.DryRun && result.Phase != v1alpha1.AnalysisPhaseSuccessful {
+			failed = append(failed, fmt.Sprintf("%s (%s)", dep, result.Phase))
+		}
+	}
+	return failed
+}
+
+// EvalMeasurementsCondition evaluates the condition against the value of the last of the measurements, the
+// values of the previous ones being available as the history of the condition
+func EvalMeasurementsCondition(measurements []v1alpha1.Measurement, condition string) (bool, error) {
+	lastMeasurement := measurements[len(measurements)-1]
+	var value any
+	if err := json.Unmarshal([]byte(lastMeasurement.Value), &value); err != nil {
+		value = lastMeasurement.Value
+	}
+	return evaluate.EvalConditionWithHistory(value, measurements[:len(measurements)-1], condition)
+}
+
+// ScoreRun returns the weighted average of the scores of the metrics of the run, the score of a metric being the
+// percentage of its measurements which were successful. Metrics in dry-run mode, with a weight of 0 or without
+// any measurement are not scored. Returns nil if no metric is scored.
+func ScoreRun(run *v1alpha1.AnalysisRun, metrics []v1alpha1.Metric, dryRunMetricsMap map[string]bool) *int64 {
+	weights := make(map[string]int32)
+	for _, weight := range run.Spec.Scoring.Weights {
+		weights[weight.MetricName] = weight.Weight
+	}
+	var totalWeight, weightedScore float64
+	for _, metric := range metrics {
+		weight, ok := weights[metric.Name]
+		if !ok {
+			weight = 1
+		}
+		result := GetResult(run, metric.Name)
+		if dryRunMetricsMap[metric.Name] || weight == 0 || result == nil || result.Count == 0 {
+			continue
+		}
+		totalWeight += float64(weight)
+		weightedScore += float64(weight) * float64(result.Successful) / float64(result.Count)
+	}
+	if totalWeight == 0 {
+		return nil
+	}
+	score := int64(math.Floor(100 * weightedScore / totalWeight))
+	return &score
+}
+
+// AssessScore returns the phase of a run from its score
+func AssessScore(scoring v1alpha1.AnalysisScoring, score int64) (v1alpha1.AnalysisPhase, string) {
+	switch {
+	case score >= scoring.Threshold.Pass:
+		log.Infof("Metric Assessment Result - %s: Score (%d) >= Pass Threshold (%d)", v1alpha1.AnalysisPhaseSuccessful, score, scoring.Threshold.Pass)
+		return v1alpha1.AnalysisPhaseSuccessful, ""
+	case score >= scoring.Threshold.Marginal:
+		return v1alpha1.AnalysisPhaseInconclusive, fmt.Sprintf("Score (%d) < pass threshold (%d)", score, scoring.Threshold.Pass)
+	default:
+		return v1alpha1.AnalysisPhaseFailed, fmt.Sprintf("Score (%d) < marginal threshold (%d)", score, scoring.Threshold.Marginal)
+	}
+}
+
+// AssessMetricStatus assesses the status of a single metric based on:
+// * current or latest measurement status
+// * parameters given by the metric (failureLimit, count, etc...)
+// * whether we are terminating (e.g. due to failing run, or termination request)
+func AssessMetricStatus(metric v1alpha1.Metric, result v1alpha1.MetricResult, terminating bool) v1alpha1.AnalysisPhase {
+	if result.Phase.Completed() {
+		return result.Phase
+	}
+	logger := log.WithField("metric", metric.Name)
+	if len(result.Measurements) == 0 {
+		if terminating {
+			// we have yet to take a single measurement, but have already been instructed to stop
+			logger.Infof(SuccessfulAssessmentRunTerminatedResult)
+			return v1alpha1.AnalysisPhaseSuccessful
+		}
+		return v1alpha1.AnalysisPhasePending
+	}
+	lastMeasurement := result.Measurements[len(result.Measurements)-1]
+	if !lastMeasurement.Phase.Completed() {
+		// we still have an in-flight measurement
+		return v1alpha1.AnalysisPhaseRunning
+	}
+
+	// Check if metric was considered Failed, Inconclusive, or Error
+	// If true, then return AnalysisRunPhase as Failed, Inconclusive, or Error respectively
+	phaseFailureInconclusiveOrError, message := AssessMetricFailureInconclusiveOrError(metric, result)
+	if phaseFailureInconclusiveOrError != "" {
+		logger.Infof("Metric Assessment Result - %s: %s", phaseFailureInconclusiveOrError, message)
+		return phaseFailureInconclusiveOrError
+	}
+
+	// Check if consecutiveSuccessLimit is applicable and was reached.
+	if metric.ConsecutiveSuccessLimit != nil && metric.ConsecutiveSuccessLimit.IntValue() > 0 && result.ConsecutiveSuccess >= int32(metric.ConsecutiveSuccessLimit.IntValue()) {
+		logger.Infof("Metric Assessment Result - %s: ConsecutiveSuccessLimit (%s) Reached", v1alpha1.AnalysisPhaseSuccessful, metric.ConsecutiveSuccessLimit.String())
+		return v1alpha1.AnalysisPhaseSuccessful
+	}
+
+	// Check if the early success rule of the metric is satisfied
+	if earlySuccess := metric.EarlySuccess; earlySuccess != nil && earlySuccess.ConsecutiveSuccessLimit > 0 && result.ConsecutiveSuccess >= earlySuccess.ConsecutiveSuccessLimit {
+		succeeded := true
+		if earlySuccess.Condition != "" {
+			var err error
+			succeeded, err = EvalMeasurementsCondition(result.Measurements, earlySuccess.Condition)
+			if err != nil {
+				logger.Warnf("Failed to evaluate early success condition: %v", err)
+			}
+		}
+		if succeeded {
+			logger.Infof("Metric Assessment Result - %s: EarlySuccess ConsecutiveSuccessLimit (%d) Reached", v1alpha1.AnalysisPhaseSuccessful, earlySuccess.ConsecutiveSuccessLimit)
+			return v1alpha1.AnalysisPhaseSuccessful
+		}
+	}
+
+	// If a count was specified, and we reached that count, then metric is considered Successful.
+	// The Error, Failed, Inconclusive counters are ignored because those checks have already been
+	// taken into consideration above, and we do not want to fail if failures < failureLimit.
+	effectiveCount := metric.EffectiveCount()
+	if effectiveCount != nil && result.Count >= int32(effectiveCount.IntValue()) {
+
+		failureApplicable := (metric.FailureLimit != nil && metric.FailureLimit.IntValue() >= 0) || metric.FailureLimit == nil
+		successApplicable := metric.ConsecutiveSuccessLimit != nil && metric.ConsecutiveSuccessLimit.IntValue() > 0
+
+		if failureApplicable && successApplicable {
+
+			// failureLimit was checked above and not reached.
+			// consecutiveSuccessLimit was checked above and not reached.
+
+			failureLimit := "0"
+			if metric.FailureLimit != nil {
+				failureLimit = metric.FailureLimit.String()
+			}
+
+			logger.Infof("Metric Assessment Result - %s: ConsecutiveSuccessLimit (%s) Not Reached and FailureLimit (%s) Not Violated", v1alpha1.AnalysisPhaseInconclusive, metric.ConsecutiveSuccessLimit.String(), failureLimit)
+			return v1alpha1.AnalysisPhaseInconclusive
+
+		} else if successApplicable {
+
+			logger.Infof("Metric Assessment Result - %s: ConsecutiveSuccessLimit (%s) Not Reached", v1alpha1.AnalysisPhaseFailed, metric.ConsecutiveSuccessLimit.String())
+			return v1alpha1.AnalysisPhaseFailed
+
+		} else if failureApplicable {
+			// failureLimit was not reached in AssessMetricFailureInconclusiveOrError above.
+			// AnalysisPhaseSuccessful below.
+		} else {
+			// This cannot happen, since one of failureLimit or consecutiveSuccessLimit will be applicable
+			// We validate that failureLimit >= 0 when consecutiveSuccessLimit == 0
+		}
+
+		logger.Infof("Metric Assessment Result - %s: Count (%s) Reached", v1alpha1.AnalysisPhaseSuccessful, effectiveCount.String())
+		return v1alpha1.AnalysisPhaseSuccessful
+	}
+	// if we get here, this metric runs indefinitely
+	if terminating {
+		logger.Infof(SuccessfulAssessmentRunTerminatedResult)
+		return v1alpha1.AnalysisPhaseSuccessful
+	}
+	return v1alpha1.AnalysisPhaseRunning
+}
+
+// AssessCompletedMetric assesses the status of a metric which will take no further measurements, such
+// as a metric measured outside of the controller
+func AssessCompletedMetric(metric v1alpha1.Metric, result v1alpha1.MetricResult) v1alpha1.AnalysisPhase {
+	return AssessMetricStatus(metric, result, true)
+}
+
+// AssessMetricFailureInconclusiveOrError returns the phase of a metric which exceeded its failure, inconclusive
+// or consecutive error limit, and the limit it exceeded. Returns an empty phase if no limit was exceeded.
+func AssessMetricFailureInconclusiveOrError(metric v1alpha1.Metric, result v1alpha1.MetricResult) (v1alpha1.AnalysisPhase, string) {
+	var message string
+	var phase v1alpha1.AnalysisPhase
+
+	failureLimit := int32(0)
+	if metric.FailureLimit != nil {
+		failureLimit = int32(metric.FailureLimit.IntValue())
+	}
+	// If failureLimit is negative, that means it isn't applicable.
+	if failureLimit >= 0 && result.Failed > failureLimit {
+		phase = v1alpha1.AnalysisPhaseFailed
+		message = fmt.Sprintf("failed (%d) > failureLimit (%d)", result.Failed, failureLimit)
+	}
+
+	inconclusiveLimit := int32(0)
+	if metric.InconclusiveLimit != nil {
+		inconclusiveLimit = int32(metric.InconclusiveLimit.IntValue())
+	}
+	if result.Inconclusive > inconclusiveLimit {
+		phase = v1alpha1.AnalysisPhaseInconclusive
+		message = fmt.Sprintf("inconclusive (%d) > inconclusiveLimit (%d)", result.Inconclusive, inconclusiveLimit)
+	}
+
+	consecutiveErrorLimit := defaults.GetConsecutiveErrorLimitOrDefault(&metric)
+	if result.ConsecutiveError > consecutiveErrorLimit {
+		phase = v1alpha1.AnalysisPhaseError
+		message = fmt.Sprintf("consecutiveErrors (%d) > consecutiveErrorLimit (%d)", result.ConsecutiveError, consecutiveErrorLimit)
+	}
+	return phase, message
+}
@@ -1,6 +1,7 @@
 package analysis
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"regexp"
@@ -8,12 +9,22 @@ import (
 	"strings"
 
 	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
+	replicasetutil "github.com/argoproj/argo-rollouts/utils/replicaset"
 	templateutil "github.com/argoproj/argo-rollouts/utils/template"
 
 	appsv1 "k8s.io/api/apps/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
 	"k8s.io/kubernetes/pkg/fieldpath"
 )
 
+const (
+	// MaxProbeRequests bounds the number of requests of a probe measurement
+	MaxProbeRequests = 1000
+	// MaxProbeRPS bounds the rate at which a probe sends its requests
+	MaxProbeRPS = 1000
+)
+
 // BuildArgumentsForRolloutAnalysisRun builds the arguments for a analysis base created by a rollout
 func BuildArgumentsForRolloutAnalysisRun(args []v1alpha1.AnalysisRunArgument, stableRS, newRS *appsv1.ReplicaSet, r *v1alpha1.Rollout) ([]v1alpha1.Argument, error) {
 	var err error
@@ -40,6 +51,11 @@ func BuildArgumentsForRolloutAnalysisRun(args []v1alpha1.AnalysisRunArgument, st
 					// returned error will only be used in Analysis stage
 					value, err = extractValueFromRollout(r, arg.ValueFrom.FieldRef.FieldPath)
 				}
+			} else if arg.ValueFrom.RolloutValue != nil {
+				value, err = extractRolloutValue(r, stableRS, *arg.ValueFrom.RolloutValue)
+				if err != nil {
+					return nil, err
+				}
 			}
 		}
 
@@ -142,6 +158,98 @@ func ValidateMetrics(metrics []v1alpha1.Metric) error {
 			return fmt.Errorf("metrics[%d]: %v", i, err)
 		}
 	}
+	return validateMetricDependencies(metrics)
+}
+
+// ValidateMetricTemplates validates the metrics of an analysis before their args are resolved. The args must be
+// bound as parameters of sql queries, never interpolated into them.
+func ValidateMetricTemplates(metrics []v1alpha1.Metric) error {
+	for i, metric := range metrics {
+		if metric.Provider.SQL != nil && strings.Contains(metric.Provider.SQL.Query, "{{") {
+			return fmt.Errorf("metrics[%d]: sql query must not contain templates, use sql args to bind values to the query", i)
+		}
+	}
+	return nil
+}
+
+// ValidateScoring verifies that the weights of the scoring refer to the metrics of the analysis and that its
+// thresholds are scores between 0 and 100
+func ValidateScoring(scoring *v1alpha1.AnalysisScoring, metrics []v1alpha1.Metric) error {
+	if scoring == nil {
+		return nil
+	}
+	metricNames := make(map[string]bool)
+	for _, metric := range metrics {
+		metricNames[metric.Name] = true
+	}
+	weighted := make(map[string]bool)
+	for i, weight := range scoring.Weights {
+		if !metricNames[weight.MetricName] {
+			return fmt.Errorf("scoring.weights[%d]: metric '%s' does not exist", i, weight.MetricName)
+		}
+		if weighted[weight.MetricName] {
+			return fmt.Errorf("scoring.weights[%d]: duplicate weight for metric '%s'", i, weight.MetricName)
+		}
+		weighted[weight.MetricName] = true
+		if weight.Weight < 0 {
+			return fmt.Errorf("scoring.weights[%d]: weight must be >= 0", i)
+		}
+	}
+	threshold := scoring.Threshold
+	if threshold.Marginal < 0 || threshold.Pass > 100 || threshold.Marginal > threshold.Pass {
+		return fmt.Errorf("scoring.threshold: must satisfy 0 <= marginal <= pass <= 100")
+	}
+	return nil
+}
+
+// validateMetricDependencies verifies that every dependsOn entry refers to another metric which is
+// guaranteed to complete, and that the dependencies between metrics do not form a cycle
+func validateMetricDependencies(metrics []v1alpha1.Metric) error {
+	metricsByName := make(map[string]v1alpha1.Metric)
+	for _, metric := range metrics {
+		metricsByName[metric.Name] = metric
+	}
+	for i, metric := range metrics {
+		for _, dep := range metric.DependsOn {
+			if dep == metric.Name {
+				return fmt.Errorf("metrics[%d]: metric '%s' cannot depend on itself", i, metric.Name)
+			}
+			depMetric, ok := metricsByName[dep]
+			if !ok {
+				return fmt.Errorf("metrics[%d]: dependsOn references unknown metric '%s'", i, dep)
+			}
+			if depMetric.EffectiveCount() == nil && (depMetric.ConsecutiveSuccessLimit == nil || depMetric.ConsecutiveSuccessLimit.IntValue() <= 0) {
+				return fmt.Errorf("metrics[%d]: dependsOn references metric '%s' which runs indefinitely", i, dep)
+			}
+		}
+	}
+
+	// depth-first search for cycles. visiting holds the metrics on the current path
+	visiting := make(map[string]bool)
+	visited := make(map[string]bool)
+	var visit func(name string) error
+	visit = func(name string) error {
+		if visiting[name] {
+			return fmt.Errorf("dependsOn of metric '%s' forms a cycle", name)
+		}
+		if visited[name] {
+			return nil
+		}
+		visiting[name] = true
+		for _, dep := range metricsByName[name].DependsOn {
+			if err := visit(dep); err != nil {
+				return err
+			}
+		}
+		visiting[name] = false
+		visited[name] = true
+		return nil
+	}
+	for _, metric := range metrics {
+		if err := visit(metric.Name); err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
@@ -216,6 +324,22 @@ func ValidateMetric(metric v1alpha1.Metric) error {
 			return fmt.Errorf("invalid startDelay string: %v", err)
 		}
 	}
+	if metric.AdaptiveInterval != nil {
+		if metric.Interval == "" {
+			return fmt.Errorf("interval must be specified with adaptiveInterval")
+		}
+		minInterval, err := metric.AdaptiveInterval.MinInterval.Duration()
+		if err != nil {
+			return fmt.Errorf("invalid adaptiveInterval.minInterval string: %v", err)
+		}
+		interval, _ := metric.Interval.Duration()
+		if minInterval <= 0 || minInterval >= interval {
+			return fmt.Errorf("adaptiveInterval.minInterval must be > 0 and shorter than interval")
+		}
+	}
+	if metric.EarlySuccess != nil && metric.EarlySuccess.ConsecutiveSuccessLimit < 1 {
+		return fmt.Errorf("earlySuccess.consecutiveSuccessLimit must be >= 1")
+	}
 
 	numProviders := 0
 	if metric.Provider.Prometheus != nil {
@@ -251,6 +375,21 @@ func ValidateMetric(metric v1alpha1.Metric) error {
 	if metric.Provider.SkyWalking != nil {
 		numProviders++
 	}
+	if metric.Provider.SLOBurnRate != nil {
+		numProviders++
+	}
+	if metric.Provider.OpenSearch != nil {
+		numProviders++
+	}
+	if metric.Provider.SQL != nil {
+		numProviders++
+	}
+	if metric.Provider.Probe != nil {
+		numProviders++
+		if metric.Provider.Probe.Requests > MaxProbeRequests || metric.Provider.Probe.RPS > MaxProbeRPS {
+			return fmt.Errorf("probe requests must be at most %d and rps at most %d", MaxProbeRequests, MaxProbeRPS)
+		}
+	}
 	if metric.Provider.Plugin != nil && len(metric.Provider.Plugin) > 0 {
 		// We allow exactly one plugin to be specified per analysis run template
 		numProviders = numProviders + len(metric.Provider.Plugin)
@@ -264,6 +403,52 @@ func ValidateMetric(metric v1alpha1.Metric) error {
 	return nil
 }
 
+// extractRolloutValue returns a value computed from the state of the rollout
+func extractRolloutValue(r *v1alpha1.Rollout, stableRS *appsv1.ReplicaSet, rolloutValue v1alpha1.ValueFromRollout) (string, error) {
+	switch rolloutValue {
+	case v1alpha1.RolloutValueCurrentWeight:
+		if r.Spec.Strategy.Canary == nil {
+			return "", nil
+		}
+		if r.Status.Canary.Weights != nil {
+			return strconv.Itoa(int(r.Status.Canary.Weights.Canary.Weight)), nil
+		}
+		return strconv.Itoa(int(replicasetutil.GetCurrentSetWeight(r))), nil
+	case v1alpha1.RolloutValueCurrentStepIndex:
+		if r.Status.CurrentStepIndex == nil {
+			return "", nil
+		}
+		return strconv.Itoa(int(*r.Status.CurrentStepIndex)), nil
+	case v1alpha1.RolloutValueStablePodTemplateHash:
+		return r.Status.StableRS, nil
+	case v1alpha1.RolloutValueCanaryPodTemplateHash:
+		return r.Status.CurrentPodHash, nil
+	case v1alpha1.RolloutValueStableImage:
+		if stableRS == nil {
+			return "", nil
+		}
+		images := make([]string, 0, len(stableRS.Spec.Template.Spec.Containers))
+		for _, container := range stableRS.Spec.Template.Spec.Containers {
+			images = append(images, container.Image)
+		}
+		return strings.Join(images, ","), nil
+	}
+	return "", fmt.Errorf("invalid rollout value '%s'", rolloutValue)
+}
+
+// GetConfigMapKeyRefValue returns the value of the key of a config map referenced by an argument
+func GetConfigMapKeyRefValue(kubeclientset kubernetes.Interface, namespace string, ref *v1alpha1.ConfigMapKeyRef) (string, error) {
+	configMap, err := kubeclientset.CoreV1().ConfigMaps(namespace).Get(context.TODO(), ref.Name, metav1.GetOptions{})
+	if err != nil {
+		return "", err
+	}
+	value, ok := configMap.Data[ref.Key]
+	if !ok {
+		return "", fmt.Errorf("key '%s' does not exist in config map '%s'", ref.Key, ref.Name)
+	}
+	return value, nil
+}
+
 func extractValueFromRollout(r *v1alpha1.Rollout, path string) (string, error) {
 	j, _ := json.Marshal(r)
 	m := any(nil)
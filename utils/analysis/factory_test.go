@@ -10,6 +10,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/intstr"
 	"k8s.io/apimachinery/pkg/util/uuid"
+	k8sfake "k8s.io/client-go/kubernetes/fake"
 	"k8s.io/utils/ptr"
 
 	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
@@ -133,6 +134,85 @@ func TestBuildArgumentsForRolloutAnalysisRun(t *testing.T) {
 	assert.Contains(t, args, v1alpha1.Argument{Name: "status.pauseConditions[0].reason", Value: ptr.To[string]("test-reason")})
 }
 
+func TestBuildArgumentsForRolloutAnalysisRunWithRolloutValues(t *testing.T) {
+	rolloutValue := func(name string, value v1alpha1.ValueFromRollout) v1alpha1.AnalysisRunArgument {
+		return v1alpha1.AnalysisRunArgument{
+			Name:      name,
+			ValueFrom: &v1alpha1.ArgumentValueFrom{RolloutValue: &value},
+		}
+	}
+	args := []v1alpha1.AnalysisRunArgument{
+		rolloutValue("weight", v1alpha1.RolloutValueCurrentWeight),
+		rolloutValue("step", v1alpha1.RolloutValueCurrentStepIndex),
+		rolloutValue("stable-hash", v1alpha1.RolloutValueStablePodTemplateHash),
+		rolloutValue("canary-hash", v1alpha1.RolloutValueCanaryPodTemplateHash),
+		rolloutValue("stable-image", v1alpha1.RolloutValueStableImage),
+	}
+	stableRS := &appsv1.ReplicaSet{
+		Spec: appsv1.ReplicaSetSpec{
+			Template: corev1.PodTemplateSpec{
+				Spec: corev1.PodSpec{
+					Containers: []corev1.Container{
+						{Name: "app", Image: "guestbook:v1"},
+						{Name: "proxy", Image: "envoy:v1.30"},
+					},
+				},
+			},
+		},
+	}
+	ro := &v1alpha1.Rollout{
+		Spec: v1alpha1.RolloutSpec{
+			Strategy: v1alpha1.RolloutStrategy{
+				Canary: &v1alpha1.CanaryStrategy{
+					Steps: []v1alpha1.CanaryStep{
+						{SetWeight: ptr.To[int32](20)},
+						{Pause: &v1alpha1.RolloutPause{}},
+						{SetWeight: ptr.To[int32](50)},
+					},
+				},
+			},
+		},
+		Status: v1alpha1.RolloutStatus{
+			CurrentStepIndex: ptr.To[int32](1),
+			StableRS:         "abcdef",
+			CurrentPodHash:   "123456",
+		},
+	}
+
+	values, err := BuildArgumentsForRolloutAnalysisRun(args, stableRS, &appsv1.ReplicaSet{}, ro)
+	assert.NoError(t, err)
+	assert.Equal(t, []v1alpha1.Argument{
+		{Name: "weight", Value: ptr.To("20")},
+		{Name: "step", Value: ptr.To("1")},
+		{Name: "stable-hash", Value: ptr.To("abcdef")},
+		{Name: "canary-hash", Value: ptr.To("123456")},
+		{Name: "stable-image", Value: ptr.To("guestbook:v1,envoy:v1.30")},
+	}, values)
+
+	// the weight of the traffic router is preferred to the weight of the step
+	ro.Status.Canary.Weights = &v1alpha1.TrafficWeights{Canary: v1alpha1.WeightDestination{Weight: 15}}
+	values, err = BuildArgumentsForRolloutAnalysisRun(args[:1], stableRS, &appsv1.ReplicaSet{}, ro)
+	assert.NoError(t, err)
+	assert.Equal(t, "15", *values[0].Value)
+
+	_, err = BuildArgumentsForRolloutAnalysisRun([]v1alpha1.AnalysisRunArgument{rolloutValue("bad", "PreviousWeight")}, stableRS, &appsv1.ReplicaSet{}, ro)
+	assert.EqualError(t, err, "invalid rollout value 'PreviousWeight'")
+}
+
+func TestGetConfigMapKeyRefValue(t *testing.T) {
+	configMap := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Name: "slo", Namespace: metav1.NamespaceDefault},
+		Data:       map[string]string{"success-rate": "0.95"},
+	}
+	kubeclientset := k8sfake.NewSimpleClientset(configMap)
+	value, err := GetConfigMapKeyRefValue(kubeclientset, metav1.NamespaceDefault, &v1alpha1.ConfigMapKeyRef{Name: "slo", Key: "success-rate"})
+	assert.NoError(t, err)
+	assert.Equal(t, "0.95", value)
+
+	_, err = GetConfigMapKeyRefValue(kubeclientset, metav1.NamespaceDefault, &v1alpha1.ConfigMapKeyRef{Name: "slo", Key: "latency"})
+	assert.EqualError(t, err, "key 'latency' does not exist in config map 'slo'")
+}
+
 func TestPrePromotionLabels(t *testing.T) {
 	podHash := "abcd123"
 	expected := map[string]string{
@@ -324,6 +404,33 @@ func TestValidateMetrics(t *testing.T) {
 		err := ValidateMetrics(spec.Metrics)
 		assert.EqualError(t, err, "metrics[1]: duplicate name 'success-rate'")
 	})
+	t.Run("Ensure valid dependsOn", func(t *testing.T) {
+		newMetric := func(name string, dependsOn ...string) v1alpha1.Metric {
+			return v1alpha1.Metric{
+				Name:      name,
+				DependsOn: dependsOn,
+				Provider: v1alpha1.MetricProvider{
+					Prometheus: &v1alpha1.PrometheusMetric{},
+				},
+			}
+		}
+		err := ValidateMetrics([]v1alpha1.Metric{newMetric("smoke-test"), newMetric("success-rate", "smoke-test")})
+		assert.NoError(t, err)
+
+		err = ValidateMetrics([]v1alpha1.Metric{newMetric("success-rate", "success-rate")})
+		assert.EqualError(t, err, "metrics[0]: metric 'success-rate' cannot depend on itself")
+
+		err = ValidateMetrics([]v1alpha1.Metric{newMetric("success-rate", "smoke-test")})
+		assert.EqualError(t, err, "metrics[0]: dependsOn references unknown metric 'smoke-test'")
+
+		err = ValidateMetrics([]v1alpha1.Metric{newMetric("a", "c"), newMetric("b", "a"), newMetric("c", "b")})
+		assert.EqualError(t, err, "dependsOn of metric 'a' forms a cycle")
+
+		forever := newMetric("smoke-test")
+		forever.Interval = "1m"
+		err = ValidateMetrics([]v1alpha1.Metric{forever, newMetric("success-rate", "smoke-test")})
+		assert.EqualError(t, err, "metrics[1]: dependsOn references metric 'smoke-test' which runs indefinitely")
+	})
 	t.Run("Ensure failureLimit >= -1", func(t *testing.T) {
 		failureLimit := intstr.FromInt(-2)
 		spec := v1alpha1.AnalysisTemplateSpec{
@@ -408,6 +515,46 @@ func TestValidateMetrics(t *testing.T) {
 		err := ValidateMetrics(spec.Metrics)
 		assert.EqualError(t, err, "metrics[0]: multiple providers specified")
 	})
+	t.Run("Ensure sql query is not templated", func(t *testing.T) {
+		spec := v1alpha1.AnalysisTemplateSpec{
+			Metrics: []v1alpha1.Metric{
+				{
+					Name: "conversion-rate",
+					Provider: v1alpha1.MetricProvider{
+						SQL: &v1alpha1.SQLMetric{
+							Query: "SELECT rate FROM kpis WHERE version = '{{args.version}}'",
+						},
+					},
+				},
+			},
+		}
+		err := ValidateMetricTemplates(spec.Metrics)
+		assert.EqualError(t, err, "metrics[0]: sql query must not contain templates, use sql args to bind values to the query")
+
+		spec.Metrics[0].Provider.SQL.Query = "SELECT rate FROM kpis WHERE version = $1"
+		spec.Metrics[0].Provider.SQL.Args = []string{"{{args.version}}"}
+		assert.NoError(t, ValidateMetricTemplates(spec.Metrics))
+		assert.NoError(t, ValidateMetrics(spec.Metrics))
+	})
+	t.Run("Ensure probe requests and rps are bounded", func(t *testing.T) {
+		metrics := []v1alpha1.Metric{
+			{
+				Name: "canary-probe",
+				Provider: v1alpha1.MetricProvider{
+					Probe: &v1alpha1.ProbeMetric{RPS: 1001},
+				},
+			},
+		}
+		err := ValidateMetrics(metrics)
+		assert.EqualError(t, err, "metrics[0]: probe requests must be at most 1000 and rps at most 1000")
+
+		metrics[0].Provider.Probe = &v1alpha1.ProbeMetric{Requests: 1001}
+		err = ValidateMetrics(metrics)
+		assert.EqualError(t, err, "metrics[0]: probe requests must be at most 1000 and rps at most 1000")
+
+		metrics[0].Provider.Probe = &v1alpha1.ProbeMetric{Requests: 1000, RPS: 1000}
+		assert.NoError(t, ValidateMetrics(metrics))
+	})
 	t.Run("Ensure consecutiveSuccessLimit >= 0", func(t *testing.T) {
 		consecutiveSuccessLimit := intstr.FromInt(-1)
 		spec := v1alpha1.AnalysisTemplateSpec{
@@ -442,6 +589,75 @@ func TestValidateMetrics(t *testing.T) {
 		err := ValidateMetrics(spec.Metrics)
 		assert.EqualError(t, err, "metrics[0]: failureLimit and consecutiveSuccessLimit cannot both be disabled")
 	})
+	t.Run("Ensure adaptiveInterval is valid", func(t *testing.T) {
+		metric := v1alpha1.Metric{
+			Name:             "success-rate",
+			AdaptiveInterval: &v1alpha1.AdaptiveInterval{MinInterval: "30s"},
+			Provider: v1alpha1.MetricProvider{
+				Prometheus: &v1alpha1.PrometheusMetric{},
+			},
+		}
+		assert.EqualError(t, ValidateMetric(metric), "interval must be specified with adaptiveInterval")
+		metric.Interval = "5m"
+		assert.NoError(t, ValidateMetric(metric))
+		metric.AdaptiveInterval.MinInterval = "5m"
+		assert.EqualError(t, ValidateMetric(metric), "adaptiveInterval.minInterval must be > 0 and shorter than interval")
+		metric.AdaptiveInterval.MinInterval = "fast"
+		assert.EqualError(t, ValidateMetric(metric), `invalid adaptiveInterval.minInterval string: time: invalid duration "fast"`)
+	})
+	t.Run("Ensure earlySuccess consecutiveSuccessLimit >= 1", func(t *testing.T) {
+		metric := v1alpha1.Metric{
+			Name:         "success-rate",
+			EarlySuccess: &v1alpha1.EarlySuccess{},
+			Provider: v1alpha1.MetricProvider{
+				Prometheus: &v1alpha1.PrometheusMetric{},
+			},
+		}
+		assert.EqualError(t, ValidateMetric(metric), "earlySuccess.consecutiveSuccessLimit must be >= 1")
+	})
+}
+
+func TestValidateScoring(t *testing.T) {
+	metrics := []v1alpha1.Metric{{Name: "success-rate"}, {Name: "latency"}}
+	threshold := v1alpha1.ScoreThreshold{Pass: 90, Marginal: 60}
+	assert.NoError(t, ValidateScoring(nil, metrics))
+	assert.NoError(t, ValidateScoring(&v1alpha1.AnalysisScoring{
+		Weights:   []v1alpha1.MetricWeight{{MetricName: "success-rate", Weight: 3}, {MetricName: "latency", Weight: 0}},
+		Threshold: threshold,
+	}, metrics))
+
+	tests := []struct {
+		scoring       v1alpha1.AnalysisScoring
+		expectedError string
+	}{
+		{
+			scoring:       v1alpha1.AnalysisScoring{Weights: []v1alpha1.MetricWeight{{MetricName: "error-rate", Weight: 1}}, Threshold: threshold},
+			expectedError: "scoring.weights[0]: metric 'error-rate' does not exist",
+		},
+		{
+			scoring:       v1alpha1.AnalysisScoring{Weights: []v1alpha1.MetricWeight{{MetricName: "latency", Weight: 1}, {MetricName: "latency", Weight: 2}}, Threshold: threshold},
+			expectedError: "scoring.weights[1]: duplicate weight for metric 'latency'",
+		},
+		{
+			scoring:       v1alpha1.AnalysisScoring{Weights: []v1alpha1.MetricWeight{{MetricName: "latency", Weight: -1}}, Threshold: threshold},
+			expectedError: "scoring.weights[0]: weight must be >= 0",
+		},
+		{
+			scoring:       v1alpha1.AnalysisScoring{Threshold: v1alpha1.ScoreThreshold{Pass: 60, Marginal: 90}},
+			expectedError: "scoring.threshold: must satisfy 0 <= marginal <= pass <= 100",
+		},
+		{
+			scoring:       v1alpha1.AnalysisScoring{Threshold: v1alpha1.ScoreThreshold{Pass: 101, Marginal: 90}},
+			expectedError: "scoring.threshold: must satisfy 0 <= marginal <= pass <= 100",
+		},
+		{
+			scoring:       v1alpha1.AnalysisScoring{Threshold: v1alpha1.ScoreThreshold{Pass: 90, Marginal: -1}},
+			expectedError: "scoring.threshold: must satisfy 0 <= marginal <= pass <= 100",
+		},
+	}
+	for _, test := range tests {
+		assert.EqualError(t, ValidateScoring(&test.scoring, metrics), test.expectedError)
+	}
 }
 
 // TestResolveMetricArgs verifies that metric arguments are resolved
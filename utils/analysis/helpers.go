@@ -80,7 +80,7 @@ func Worst(left, right v1alpha1.AnalysisPhase) v1alpha1.AnalysisPhase {
 
 // IsTerminating returns whether or not the analysis run is terminating, either because a terminate
 // was requested explicitly, or because a metric has already measured Failed, Error, or Inconclusive
-// which causes the run to end prematurely.
+// which causes the run to end prematurely. Runs with scoring only end prematurely on Error.
 func IsTerminating(run *v1alpha1.AnalysisRun) bool {
 	if run.Spec.Terminate {
 		return true
@@ -93,7 +93,12 @@ func IsTerminating(run *v1alpha1.AnalysisRun) bool {
 		}
 
 		switch res.Phase {
-		case v1alpha1.AnalysisPhaseFailed, v1alpha1.AnalysisPhaseError, v1alpha1.AnalysisPhaseInconclusive:
+		case v1alpha1.AnalysisPhaseFailed, v1alpha1.AnalysisPhaseInconclusive:
+			// when the run is scored, a failing metric only lowers the score
+			if run.Spec.Scoring == nil {
+				return true
+			}
+		case v1alpha1.AnalysisPhaseError:
 			return true
 		}
 	}
@@ -165,6 +170,31 @@ func SetResult(run *v1alpha1.AnalysisRun, result v1alpha1.MetricResult) {
 	run.Status.MetricResults = append(run.Status.MetricResults, result)
 }
 
+// CountMeasurement updates the counters of the metric result with the phase of a completed measurement
+func CountMeasurement(result *v1alpha1.MetricResult, phase v1alpha1.AnalysisPhase) {
+	switch phase {
+	case v1alpha1.AnalysisPhaseSuccessful:
+		result.Successful++
+		result.Count++
+		result.ConsecutiveError = 0
+		result.ConsecutiveSuccess++
+	case v1alpha1.AnalysisPhaseFailed:
+		result.Failed++
+		result.Count++
+		result.ConsecutiveError = 0
+		result.ConsecutiveSuccess = 0
+	case v1alpha1.AnalysisPhaseInconclusive:
+		result.Inconclusive++
+		result.Count++
+		result.ConsecutiveError = 0
+		result.ConsecutiveSuccess = 0
+	case v1alpha1.AnalysisPhaseError:
+		result.Error++
+		result.ConsecutiveError++
+		result.ConsecutiveSuccess = 0
+	}
+}
+
 // MetricCompleted returns whether or not a metric was completed or not
 func MetricCompleted(run *v1alpha1.AnalysisRun, metricName string) bool {
 	if result := GetResult(run, metricName); result != nil {
@@ -309,6 +339,9 @@ func NewAnalysisRunFromTemplates(templates []*v1alpha1.AnalysisTemplate, cluster
 	if err != nil {
 		return nil, err
 	}
+	if err := ValidateScoring(template.Spec.Scoring, template.Spec.Metrics); err != nil {
+		return nil, err
+	}
 	ar := v1alpha1.AnalysisRun{
 		ObjectMeta: metav1.ObjectMeta{
 			Name:         name,
@@ -322,6 +355,7 @@ func NewAnalysisRunFromTemplates(templates []*v1alpha1.AnalysisTemplate, cluster
 			DryRun:               dryRun,
 			MeasurementRetention: measurementRetention,
 			Args:                 newArgs,
+			Scoring:              template.Spec.Scoring,
 		},
 	}
 	return &ar, nil
@@ -344,16 +378,44 @@ func FlattenTemplates(templates []*v1alpha1.AnalysisTemplate, clusterTemplates [
 	if err != nil {
 		return nil, err
 	}
+	scoring, err := flattenScoring(templates, clusterTemplates)
+	if err != nil {
+		return nil, err
+	}
 	return &v1alpha1.AnalysisTemplate{
 		Spec: v1alpha1.AnalysisTemplateSpec{
 			Metrics:              metrics,
 			DryRun:               dryRunMetrics,
 			MeasurementRetention: measurementRetentionMetrics,
 			Args:                 args,
+			Scoring:              scoring,
 		},
 	}, nil
 }
 
+// flattenScoring returns the scoring of the templates, which can only be defined by one of them since the
+// weights and thresholds of different templates cannot be combined
+func flattenScoring(templates []*v1alpha1.AnalysisTemplate, clusterTemplates []*v1alpha1.ClusterAnalysisTemplate) (*v1alpha1.AnalysisScoring, error) {
+	var scoring *v1alpha1.AnalysisScoring
+	specs := make([]v1alpha1.AnalysisTemplateSpec, 0, len(templates)+len(clusterTemplates))
+	for _, template := range templates {
+		specs = append(specs, template.Spec)
+	}
+	for _, template := range clusterTemplates {
+		specs = append(specs, template.Spec)
+	}
+	for _, spec := range specs {
+		if spec.Scoring == nil {
+			continue
+		}
+		if scoring != nil {
+			return nil, fmt.Errorf("scoring can only be defined by one of the templates of an analysis")
+		}
+		scoring = spec.Scoring.DeepCopy()
+	}
+	return scoring, nil
+}
+
 func flattenArgs(templates []*v1alpha1.AnalysisTemplate, clusterTemplates []*v1alpha1.ClusterAnalysisTemplate) ([]v1alpha1.Argument, error) {
 	var combinedArgs []v1alpha1.Argument
 	appendOrUpdate := func(newArg v1alpha1.Argument) error {
@@ -137,6 +137,25 @@ func TestSetResult(t *testing.T) {
 	assert.Equal(t, res, run.Status.MetricResults[0])
 }
 
+func TestCountMeasurement(t *testing.T) {
+	result := v1alpha1.MetricResult{Name: "success-rate"}
+	CountMeasurement(&result, v1alpha1.AnalysisPhaseSuccessful)
+	CountMeasurement(&result, v1alpha1.AnalysisPhaseSuccessful)
+	CountMeasurement(&result, v1alpha1.AnalysisPhaseError)
+	assert.Equal(t, int32(2), result.Count)
+	assert.Equal(t, int32(2), result.Successful)
+	assert.Equal(t, int32(1), result.Error)
+	assert.Equal(t, int32(1), result.ConsecutiveError)
+	assert.Equal(t, int32(0), result.ConsecutiveSuccess)
+
+	CountMeasurement(&result, v1alpha1.AnalysisPhaseFailed)
+	CountMeasurement(&result, v1alpha1.AnalysisPhaseInconclusive)
+	assert.Equal(t, int32(4), result.Count)
+	assert.Equal(t, int32(1), result.Failed)
+	assert.Equal(t, int32(1), result.Inconclusive)
+	assert.Equal(t, int32(0), result.ConsecutiveError)
+}
+
 func TestMetricCompleted(t *testing.T) {
 	run := &v1alpha1.AnalysisRun{
 		Status: v1alpha1.AnalysisRunStatus{
@@ -239,6 +258,30 @@ func TestIsTerminating(t *testing.T) {
 	assert.True(t, IsTerminating(run))
 }
 
+func TestIsTerminatingWithScoring(t *testing.T) {
+	run := &v1alpha1.AnalysisRun{
+		Spec: v1alpha1.AnalysisRunSpec{
+			Scoring: &v1alpha1.AnalysisScoring{Threshold: v1alpha1.ScoreThreshold{Pass: 90, Marginal: 60}},
+		},
+		Status: v1alpha1.AnalysisRunStatus{
+			Phase: v1alpha1.AnalysisPhaseRunning,
+			MetricResults: []v1alpha1.MetricResult{
+				{
+					Name:  "other-metric",
+					Phase: v1alpha1.AnalysisPhaseFailed,
+				},
+				{
+					Name:  "success-rate",
+					Phase: v1alpha1.AnalysisPhaseInconclusive,
+				},
+			},
+		},
+	}
+	assert.False(t, IsTerminating(run))
+	run.Status.MetricResults[1].Phase = v1alpha1.AnalysisPhaseError
+	assert.True(t, IsTerminating(run))
+}
+
 func TestTerminateRun(t *testing.T) {
 	e := &v1alpha1.AnalysisRun{
 		ObjectMeta: metav1.ObjectMeta{
@@ -604,6 +647,48 @@ func TestFlattenTemplates(t *testing.T) {
 		assert.Equal(t, fmt.Errorf("Argument `foo` specified multiple times with different values: 'true', 'false'"), err)
 		assert.Nil(t, template)
 	})
+	t.Run("Scoring from one template", func(t *testing.T) {
+		scoring := &v1alpha1.AnalysisScoring{
+			Weights:   []v1alpha1.MetricWeight{{MetricName: "foo", Weight: 2}},
+			Threshold: v1alpha1.ScoreThreshold{Pass: 90, Marginal: 60},
+		}
+		template, err := FlattenTemplates([]*v1alpha1.AnalysisTemplate{
+			{
+				Spec: v1alpha1.AnalysisTemplateSpec{
+					Metrics: []v1alpha1.Metric{metric("foo", "{{args.foo}}")},
+					Scoring: scoring,
+				},
+			},
+		}, []*v1alpha1.ClusterAnalysisTemplate{
+			{
+				Spec: v1alpha1.AnalysisTemplateSpec{
+					Metrics: []v1alpha1.Metric{metric("bar", "{{args.bar}}")},
+				},
+			},
+		})
+		assert.Nil(t, err)
+		assert.Equal(t, scoring, template.Spec.Scoring)
+	})
+	t.Run("Error: scoring defined by multiple templates", func(t *testing.T) {
+		scoring := &v1alpha1.AnalysisScoring{Threshold: v1alpha1.ScoreThreshold{Pass: 90, Marginal: 60}}
+		template, err := FlattenTemplates([]*v1alpha1.AnalysisTemplate{
+			{
+				Spec: v1alpha1.AnalysisTemplateSpec{
+					Metrics: []v1alpha1.Metric{metric("foo", "{{args.foo}}")},
+					Scoring: scoring,
+				},
+			},
+		}, []*v1alpha1.ClusterAnalysisTemplate{
+			{
+				Spec: v1alpha1.AnalysisTemplateSpec{
+					Metrics: []v1alpha1.Metric{metric("bar", "{{args.bar}}")},
+					Scoring: scoring,
+				},
+			},
+		})
+		assert.EqualError(t, err, "scoring can only be defined by one of the templates of an analysis")
+		assert.Nil(t, template)
+	})
 }
 
 func TestNewAnalysisRunFromTemplates(t *testing.T) {
@@ -706,6 +791,30 @@ func TestNewAnalysisRunFromTemplates(t *testing.T) {
 	assert.Equal(t, fmt.Errorf("two metrics have the same name 'success-rate'"), err)
 }
 
+func TestNewAnalysisRunFromTemplatesWithScoring(t *testing.T) {
+	templates := []*v1alpha1.AnalysisTemplate{{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "foo",
+			Namespace: metav1.NamespaceDefault,
+		},
+		Spec: v1alpha1.AnalysisTemplateSpec{
+			Metrics: []v1alpha1.Metric{{Name: "success-rate"}},
+			Scoring: &v1alpha1.AnalysisScoring{
+				Weights:   []v1alpha1.MetricWeight{{MetricName: "success-rate", Weight: 2}},
+				Threshold: v1alpha1.ScoreThreshold{Pass: 90, Marginal: 60},
+			},
+		},
+	}}
+	run, err := NewAnalysisRunFromTemplates(templates, nil, nil, nil, nil, nil, nil, "foo-run", "", "my-ns")
+	assert.NoError(t, err)
+	assert.Equal(t, templates[0].Spec.Scoring, run.Spec.Scoring)
+
+	templates[0].Spec.Scoring.Weights[0].MetricName = "latency"
+	run, err = NewAnalysisRunFromTemplates(templates, nil, nil, nil, nil, nil, nil, "foo-run", "", "my-ns")
+	assert.Nil(t, run)
+	assert.EqualError(t, err, "scoring.weights[0]: metric 'latency' does not exist")
+}
+
 func TestMergeArgs(t *testing.T) {
 	{
 		// nil list
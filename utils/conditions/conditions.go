@@ -187,6 +187,13 @@ const (
 	LoadBalancerNotFoundMessage = "Failed to find load balancer: %s"
 
 	RolloutAddedToInformerReason = "RolloutAddedToInformer"
+
+	// TrafficRoutingDriftReason is emitted when the resources managed by a traffic router drifted from the desired weights
+	TrafficRoutingDriftReason  = "TrafficRoutingDrift"
+	TrafficRoutingDriftMessage = "Traffic routing drifted from the desired weights: %s"
+	// TrafficRoutingInSyncReason is added in a rollout when the traffic routing resources no longer drift
+	TrafficRoutingInSyncReason  = "TrafficRoutingInSync"
+	TrafficRoutingInSyncMessage = "Traffic routing has the desired weights"
 )
 
 // NewRolloutCondition creates a new rollout condition.
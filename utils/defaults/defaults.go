@@ -50,6 +50,8 @@ const (
 	DefaultRolloutPluginFolder = "plugin-bin"
 	// DefaultDescribeTagsLimit is the default number resources (ARNs) in a single call
 	DefaultDescribeTagsLimit int = 20
+	// DefaultMetricQueryBurst is the default number of queries which can be sent at once to a metric backend
+	DefaultMetricQueryBurst int = 10
 	// Kubernetes_DNS_Limit is the maximum length of a DNS name in Kubernetes. Currently used for Analysis Job names
 	Kubernetes_DNS_Limit int = 63
 )
@@ -69,17 +71,22 @@ const (
 )
 
 var (
-	defaultVerifyTargetGroup     = false
-	traefikAPIGroup              = DefaultTraefikAPIGroup
-	traefikVersion               = DefaultTraefikVersion
-	istioAPIVersion              = DefaultIstioVersion
-	ambassadorAPIVersion         = DefaultAmbassadorVersion
-	smiAPIVersion                = DefaultSMITrafficSplitVersion
-	targetGroupBindingAPIVersion = DefaultTargetGroupBindingAPIVersion
-	albTagKeyResourceID          = DefaultAlbTagKeyResourceID
-	appmeshCRDVersion            = DefaultAppMeshCRDVersion
-	defaultMetricCleanupDelay    = DefaultMetricCleanupDelay
-	defaultDescribeTagsLimit     = DefaultDescribeTagsLimit
+	defaultVerifyTargetGroup      = false
+	defaultVerifyIstioWeight      = false
+	defaultSelfHealTrafficRouting = true
+	traefikAPIGroup               = DefaultTraefikAPIGroup
+	traefikVersion                = DefaultTraefikVersion
+	istioAPIVersion               = DefaultIstioVersion
+	ambassadorAPIVersion          = DefaultAmbassadorVersion
+	smiAPIVersion                 = DefaultSMITrafficSplitVersion
+	targetGroupBindingAPIVersion  = DefaultTargetGroupBindingAPIVersion
+	albTagKeyResourceID           = DefaultAlbTagKeyResourceID
+	appmeshCRDVersion             = DefaultAppMeshCRDVersion
+	defaultMetricCleanupDelay     = DefaultMetricCleanupDelay
+	defaultDescribeTagsLimit      = DefaultDescribeTagsLimit
+	metricQueryCacheTTL           time.Duration
+	metricQueryQPS                float32
+	metricQueryBurst              = DefaultMetricQueryBurst
 )
 
 const (
@@ -291,6 +298,27 @@ func VerifyTargetGroup() bool {
 	return defaultVerifyTargetGroup
 }
 
+// SetVerifyIstioWeight sets whether the Istio reconciler verifies that istiod reconciled the VirtualService weights
+func SetVerifyIstioWeight(b bool) {
+	defaultVerifyIstioWeight = b
+}
+
+// VerifyIstioWeight returns whether or not we should verify Istio VirtualService weights
+func VerifyIstioWeight() bool {
+	return defaultVerifyIstioWeight
+}
+
+// SetSelfHealTrafficRouting sets whether the traffic routers re-apply the desired weights to the managed resources
+// which drifted from them
+func SetSelfHealTrafficRouting(b bool) {
+	defaultSelfHealTrafficRouting = b
+}
+
+// SelfHealTrafficRouting returns whether or not we should re-apply the desired weights to drifted traffic resources
+func SelfHealTrafficRouting() bool {
+	return defaultSelfHealTrafficRouting
+}
+
 func SetIstioAPIVersion(apiVersion string) {
 	istioAPIVersion = apiVersion
 }
@@ -378,3 +406,33 @@ func GetDescribeTagsLimit() int {
 func SetDescribeTagsLimit(limit int) {
 	defaultDescribeTagsLimit = limit
 }
+
+// GetMetricQueryCacheTTL returns how long the results of metric queries are shared between AnalysisRuns
+func GetMetricQueryCacheTTL() time.Duration {
+	return metricQueryCacheTTL
+}
+
+// SetMetricQueryCacheTTL sets how long the results of metric queries are shared between AnalysisRuns
+func SetMetricQueryCacheTTL(ttl time.Duration) {
+	metricQueryCacheTTL = ttl
+}
+
+// GetMetricQueryQPS returns the maximum queries per second sent to a metric backend, 0 meaning unlimited
+func GetMetricQueryQPS() float32 {
+	return metricQueryQPS
+}
+
+// SetMetricQueryQPS sets the maximum queries per second sent to a metric backend, 0 meaning unlimited
+func SetMetricQueryQPS(qps float32) {
+	metricQueryQPS = qps
+}
+
+// GetMetricQueryBurst returns the number of queries which can be sent at once to a metric backend
+func GetMetricQueryBurst() int {
+	return metricQueryBurst
+}
+
+// SetMetricQueryBurst sets the number of queries which can be sent at once to a metric backend
+func SetMetricQueryBurst(burst int) {
+	metricQueryBurst = burst
+}
@@ -382,6 +382,17 @@ func TestSetDefaults(t *testing.T) {
 	SetVerifyTargetGroup(false)
 	assert.False(t, VerifyTargetGroup())
 
+	SetVerifyIstioWeight(true)
+	assert.True(t, VerifyIstioWeight())
+	SetVerifyIstioWeight(false)
+	assert.False(t, VerifyIstioWeight())
+
+	assert.True(t, SelfHealTrafficRouting())
+	SetSelfHealTrafficRouting(false)
+	assert.False(t, SelfHealTrafficRouting())
+	SetSelfHealTrafficRouting(true)
+	assert.True(t, SelfHealTrafficRouting())
+
 	SetIstioAPIVersion("v1alpha9")
 	assert.Equal(t, "v1alpha9", GetIstioAPIVersion())
 	SetIstioAPIVersion(DefaultIstioVersion)
@@ -429,4 +440,16 @@ func TestSetDefaults(t *testing.T) {
 	assert.Equal(t, DefaultDescribeTagsLimit, GetDescribeTagsLimit())
 	SetDescribeTagsLimit(2)
 	assert.Equal(t, 2, GetDescribeTagsLimit())
+
+	assert.Equal(t, time.Duration(0), GetMetricQueryCacheTTL())
+	SetMetricQueryCacheTTL(30 * time.Second)
+	assert.Equal(t, 30*time.Second, GetMetricQueryCacheTTL())
+
+	assert.Equal(t, float32(0), GetMetricQueryQPS())
+	SetMetricQueryQPS(5)
+	assert.Equal(t, float32(5), GetMetricQueryQPS())
+
+	assert.Equal(t, DefaultMetricQueryBurst, GetMetricQueryBurst())
+	SetMetricQueryBurst(2)
+	assert.Equal(t, 2, GetMetricQueryBurst())
 }
@@ -1,6 +1,7 @@
 package evaluate
 
 import (
+	"encoding/json"
 	"errors"
 	"fmt"
 	"math"
@@ -16,18 +17,30 @@ import (
 )
 
 func EvaluateResult(result any, metric v1alpha1.Metric, logCtx logrus.Entry) (v1alpha1.AnalysisPhase, error) {
+	return EvaluateResultWithHistory(result, metric, nil, logCtx)
+}
+
+// EvaluateResultWithHistory evaluates the result like EvaluateResult, additionally exposing the values of
+// the previous measurements of the metric to the success and failure conditions
+func EvaluateResultWithHistory(result any, metric v1alpha1.Metric, history []v1alpha1.Measurement, logCtx logrus.Entry) (v1alpha1.AnalysisPhase, error) {
+	return EvaluateResultWithVariables(result, metric, history, nil, logCtx)
+}
+
+// EvaluateResultWithVariables evaluates the result like EvaluateResultWithHistory, additionally exposing
+// provider specific variables, such as the response of a web request, to the success and failure conditions
+func EvaluateResultWithVariables(result any, metric v1alpha1.Metric, history []v1alpha1.Measurement, variables map[string]any, logCtx logrus.Entry) (v1alpha1.AnalysisPhase, error) {
 	successCondition := false
 	failCondition := false
 	var err error
 
 	if metric.SuccessCondition != "" {
-		successCondition, err = EvalCondition(result, metric.SuccessCondition)
+		successCondition, err = EvalConditionWithVariables(result, history, variables, metric.SuccessCondition)
 		if err != nil {
 			return v1alpha1.AnalysisPhaseError, formatEvalError(err, "successCondition", metric.SuccessCondition, result)
 		}
 	}
 	if metric.FailureCondition != "" {
-		failCondition, err = EvalCondition(result, metric.FailureCondition)
+		failCondition, err = EvalConditionWithVariables(result, history, variables, metric.FailureCondition)
 		if err != nil {
 			return v1alpha1.AnalysisPhaseError, formatEvalError(err, "failureCondition", metric.FailureCondition, result)
 		}
@@ -114,16 +127,39 @@ func EvalTime(expression string) (time.Time, error) {
 
 // EvalCondition evaluates the condition with the resultValue as an input
 func EvalCondition(resultValue any, condition string) (bool, error) {
+	return EvalConditionWithHistory(resultValue, nil, condition)
+}
+
+// EvalConditionWithHistory evaluates the condition with the resultValue as an input. The values of the
+// completed measurements in history, followed by resultValue, are available to the condition as `results`.
+func EvalConditionWithHistory(resultValue any, history []v1alpha1.Measurement, condition string) (bool, error) {
+	return EvalConditionWithVariables(resultValue, history, nil, condition)
+}
+
+// EvalConditionWithVariables evaluates the condition like EvalConditionWithHistory, with the variables
+// available to the condition as well. Variables cannot override the built-in ones.
+func EvalConditionWithVariables(resultValue any, history []v1alpha1.Measurement, variables map[string]any, condition string) (bool, error) {
 	var err error
 
+	results := historyValues(history, resultValue)
 	env := map[string]any{
-		"result":  valueFromPointer(resultValue),
-		"asInt":   asInt,
-		"asFloat": asFloat,
-		"isNaN":   math.IsNaN,
-		"isInf":   isInf,
-		"isNil":   isNilFunc(resultValue),
-		"default": defaultFunc(resultValue),
+		"result":   valueFromPointer(resultValue),
+		"results":  results,
+		"asInt":    asInt,
+		"asFloat":  asFloat,
+		"asFloats": asFloats,
+		"isNaN":    math.IsNaN,
+		"isInf":    isInf,
+		"isNil":    isNilFunc(resultValue),
+		"default":  defaultFunc(resultValue),
+		"latest":   latestFunc(results),
+		"avg":      avg,
+		"trend":    trend,
+	}
+	for name, value := range variables {
+		if _, ok := env[name]; !ok {
+			env[name] = value
+		}
 	}
 
 	unwrapFileErr := func(e error) error {
@@ -227,6 +263,96 @@ func asFloat(in any) float64 {
 	panic(fmt.Sprintf("asFloat() not supported on %v %v", reflect.TypeOf(in), in))
 }
 
+// historyValues returns the values of the completed measurements in history followed by the current result,
+// oldest first. Measurements which errored are skipped since they did not produce a value. Values are
+// decoded from their JSON representation, so the current result is normalized the same way.
+func historyValues(history []v1alpha1.Measurement, resultValue any) []any {
+	values := make([]any, 0, len(history)+1)
+	for _, measurement := range history {
+		if !measurement.Phase.Completed() || measurement.Phase == v1alpha1.AnalysisPhaseError {
+			continue
+		}
+		var value any
+		if err := json.Unmarshal([]byte(measurement.Value), &value); err != nil {
+			value = measurement.Value
+		}
+		values = append(values, value)
+	}
+	current := valueFromPointer(resultValue)
+	if b, err := json.Marshal(current); err == nil {
+		var value any
+		if err := json.Unmarshal(b, &value); err == nil {
+			current = value
+		}
+	}
+	return append(values, current)
+}
+
+// latestFunc returns a function which returns the n most recent values of results
+func latestFunc(results []any) func(any) []any {
+	return func(n any) []any {
+		count := int(asInt(n))
+		if count < 0 {
+			panic(fmt.Sprintf("latest() requires a non-negative count, got %d", count))
+		}
+		if count > len(results) {
+			count = len(results)
+		}
+		return results[len(results)-count:]
+	}
+}
+
+// asFloats converts a list of values into floats. Single element lists, such as the result of a
+// prometheus vector query, are unwrapped to their element.
+func asFloats(in any) []float64 {
+	v := reflect.ValueOf(in)
+	if v.Kind() != reflect.Slice && v.Kind() != reflect.Array {
+		panic(fmt.Sprintf("asFloats() not supported on %v %v", reflect.TypeOf(in), in))
+	}
+	floats := make([]float64, v.Len())
+	for i := 0; i < v.Len(); i++ {
+		elem := v.Index(i).Interface()
+		ev := reflect.ValueOf(elem)
+		if (ev.Kind() == reflect.Slice || ev.Kind() == reflect.Array) && ev.Len() == 1 {
+			elem = ev.Index(0).Interface()
+		}
+		floats[i] = asFloat(elem)
+	}
+	return floats
+}
+
+// avg returns the arithmetic mean of the values, or NaN if there are none
+func avg(in any) float64 {
+	floats := asFloats(in)
+	if len(floats) == 0 {
+		return math.NaN()
+	}
+	sum := 0.0
+	for _, f := range floats {
+		sum += f
+	}
+	return sum / float64(len(floats))
+}
+
+// trend returns the slope of the least squares regression line through the values, i.e. the average
+// change of the value per measurement. Returns 0 when there are less than two values.
+func trend(in any) float64 {
+	floats := asFloats(in)
+	n := float64(len(floats))
+	if n < 2 {
+		return 0
+	}
+	var sumX, sumY, sumXY, sumXX float64
+	for i, y := range floats {
+		x := float64(i)
+		sumX += x
+		sumY += y
+		sumXY += x * y
+		sumXX += x * x
+	}
+	return (n*sumXY - sumX*sumY) / (n*sumXX - sumX*sumX)
+}
+
 // Check whether two slices of type string are equal or not.
 func Equal(a, b []string) bool {
 	if len(a) != len(b) {
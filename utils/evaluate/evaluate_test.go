@@ -404,3 +404,94 @@ func TestIsNilOrEmpty(t *testing.T) {
 	assert.False(t, isNilOrEmpty(42))
 	assert.False(t, isNilOrEmpty("hello"))
 }
+
+func TestEvalConditionWithHistory(t *testing.T) {
+	measurement := func(phase v1alpha1.AnalysisPhase, value string) v1alpha1.Measurement {
+		return v1alpha1.Measurement{Phase: phase, Value: value}
+	}
+	history := []v1alpha1.Measurement{
+		measurement(v1alpha1.AnalysisPhaseSuccessful, "[0.1]"),
+		measurement(v1alpha1.AnalysisPhaseError, ""),
+		measurement(v1alpha1.AnalysisPhaseSuccessful, "[0.2]"),
+		measurement(v1alpha1.AnalysisPhaseFailed, "[0.3]"),
+		measurement(v1alpha1.AnalysisPhaseRunning, ""),
+	}
+	tests := []struct {
+		expression     string
+		expectedResult bool
+	}{
+		{"len(results) == 4", true},
+		{"results[3][0] == result[0]", true},
+		{"len(latest(2)) == 2 && latest(2)[0][0] == 0.3", true},
+		{"len(latest(10)) == 4", true},
+		{"avg(results) == 0.25", true},
+		{"avg(latest(2)) == 0.35", true},
+		{"trend(results) > 0.09 && trend(results) < 0.11", true},
+		{"trend(latest(1)) == 0", true},
+		{"max(asFloats(results)) == 0.4", true},
+		{"last(results)[0] == 0.4", true},
+	}
+	for _, test := range tests {
+		b, err := EvalConditionWithHistory([]float64{0.4}, history, test.expression)
+		assert.NoError(t, err, test.expression)
+		assert.Equal(t, test.expectedResult, b, test.expression)
+	}
+}
+
+func TestEvalConditionWithHistoryScalarValues(t *testing.T) {
+	history := []v1alpha1.Measurement{
+		{Phase: v1alpha1.AnalysisPhaseSuccessful, Value: "10"},
+		{Phase: v1alpha1.AnalysisPhaseSuccessful, Value: "NaN"},
+	}
+	b, err := EvalConditionWithHistory(30.0, history, "isNaN(asFloats(results)[1]) && asFloats(results)[2] == result")
+	assert.NoError(t, err)
+	assert.True(t, b)
+
+	b, err = EvalConditionWithHistory(30.0, nil, "len(results) == 1 && avg(results) == result")
+	assert.NoError(t, err)
+	assert.True(t, b)
+
+	_, err = EvalConditionWithHistory("abc", nil, "avg(results) > 0")
+	assert.Error(t, err)
+}
+
+func TestEvaluateResultWithHistory(t *testing.T) {
+	metric := v1alpha1.Metric{
+		SuccessCondition: "result < 0.5",
+		FailureCondition: "avg(latest(3)) >= 0.5",
+	}
+	logCtx := logrus.WithField("test", "test")
+	history := []v1alpha1.Measurement{
+		{Phase: v1alpha1.AnalysisPhaseFailed, Value: "0.9"},
+		{Phase: v1alpha1.AnalysisPhaseFailed, Value: "0.8"},
+	}
+	// a single good measurement does not outweigh a sustained regression
+	status, err := EvaluateResultWithHistory(0.1, metric, history, *logCtx)
+	assert.NoError(t, err)
+	assert.Equal(t, v1alpha1.AnalysisPhaseFailed, status)
+
+	history[0].Value = "0.2"
+	status, err = EvaluateResultWithHistory(0.1, metric, history, *logCtx)
+	assert.NoError(t, err)
+	assert.Equal(t, v1alpha1.AnalysisPhaseSuccessful, status)
+}
+
+func TestEvaluateResultWithVariables(t *testing.T) {
+	metric := v1alpha1.Metric{
+		SuccessCondition: "response.statusCode == 200 && result == 'ok'",
+	}
+	logCtx := logrus.WithField("test", "test")
+	variables := map[string]any{
+		"response": map[string]any{"statusCode": 200},
+		// built-in variables cannot be overridden
+		"result": "overridden",
+	}
+	status, err := EvaluateResultWithVariables("ok", metric, nil, variables, *logCtx)
+	assert.NoError(t, err)
+	assert.Equal(t, v1alpha1.AnalysisPhaseSuccessful, status)
+
+	variables["response"] = map[string]any{"statusCode": 503}
+	status, err = EvaluateResultWithVariables("ok", metric, nil, variables, *logCtx)
+	assert.NoError(t, err)
+	assert.Equal(t, v1alpha1.AnalysisPhaseFailed, status)
+}
@@ -235,6 +235,9 @@ func CanaryStepString(c v1alpha1.CanaryStep) string {
 	if c.SetMirrorRoute != nil {
 		return fmt.Sprintf("setMirrorRoute: %s", c.SetMirrorRoute.Name)
 	}
+	if c.SetLocality != nil {
+		return fmt.Sprintf("setLocality: %s", c.SetLocality.Name)
+	}
 	return "invalid"
 }
 
@@ -402,6 +402,10 @@ func TestCanaryStepString(t *testing.T) {
 			step:           v1alpha1.CanaryStep{SetMirrorRoute: &v1alpha1.SetMirrorRoute{Name: "foo"}},
 			expectedString: "setMirrorRoute: foo",
 		},
+		{
+			step:           v1alpha1.CanaryStep{SetLocality: &v1alpha1.SetLocality{Name: "foo"}},
+			expectedString: "setLocality: foo",
+		},
 	}
 	for _, test := range tests {
 		assert.Equal(t, test.expectedString, CanaryStepString(test.step))
@@ -0,0 +1,61 @@
+package tolerantinformer
+
+import (
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/dynamic/dynamicinformer"
+	"k8s.io/client-go/informers"
+	"k8s.io/client-go/tools/cache"
+
+	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
+	rolloutinformers "github.com/argoproj/argo-rollouts/pkg/client/informers/externalversions/rollouts/v1alpha1"
+	rolloutlisters "github.com/argoproj/argo-rollouts/pkg/client/listers/rollouts/v1alpha1"
+)
+
+func NewTolerantMetricProviderConfigInformer(factory dynamicinformer.DynamicSharedInformerFactory) rolloutinformers.MetricProviderConfigInformer {
+	delegate := factory.ForResource(v1alpha1.MetricProviderConfigGVR)
+	newFn := func() *v1alpha1.MetricProviderConfig { return &v1alpha1.MetricProviderConfig{} }
+	transform := makeTransform(newFn)
+	installTransform(delegate.Informer(), transform, "MetricProviderConfig")
+	return &tolerantMetricProviderConfigInformer{delegate: delegate, transform: transform, newFn: newFn}
+}
+
+type tolerantMetricProviderConfigInformer struct {
+	delegate  informers.GenericInformer
+	transform cache.TransformFunc
+	newFn     func() *v1alpha1.MetricProviderConfig
+}
+
+func (i *tolerantMetricProviderConfigInformer) Informer() cache.SharedIndexInformer {
+	return &transformingInformer{SharedIndexInformer: i.delegate.Informer(), transform: i.transform}
+}
+
+func (i *tolerantMetricProviderConfigInformer) Lister() rolloutlisters.MetricProviderConfigLister {
+	return &tolerantMetricProviderConfigLister{indexer: i.delegate.Informer().GetIndexer(), newFn: i.newFn}
+}
+
+type tolerantMetricProviderConfigLister struct {
+	indexer cache.Indexer
+	newFn   func() *v1alpha1.MetricProviderConfig
+}
+
+func (t *tolerantMetricProviderConfigLister) List(selector labels.Selector) ([]*v1alpha1.MetricProviderConfig, error) {
+	return listTyped(t.indexer, "", selector, t.newFn)
+}
+
+func (t *tolerantMetricProviderConfigLister) MetricProviderConfigs(namespace string) rolloutlisters.MetricProviderConfigNamespaceLister {
+	return &tolerantMetricProviderConfigNamespaceLister{indexer: t.indexer, namespace: namespace, newFn: t.newFn}
+}
+
+type tolerantMetricProviderConfigNamespaceLister struct {
+	indexer   cache.Indexer
+	namespace string
+	newFn     func() *v1alpha1.MetricProviderConfig
+}
+
+func (t *tolerantMetricProviderConfigNamespaceLister) Get(name string) (*v1alpha1.MetricProviderConfig, error) {
+	return getTyped(t.indexer, v1alpha1.Resource("metricproviderconfig"), t.namespace, name, t.newFn)
+}
+
+func (t *tolerantMetricProviderConfigNamespaceLister) List(selector labels.Selector) ([]*v1alpha1.MetricProviderConfig, error) {
+	return listTyped(t.indexer, t.namespace, selector, t.newFn)
+}
@@ -31,6 +31,7 @@ type fakeInformers struct {
 	analysisRun             rolloutinformers.AnalysisRunInformer
 	experiment              rolloutinformers.ExperimentInformer
 	clusterAnalysisTemplate rolloutinformers.ClusterAnalysisTemplateInformer
+	metricProviderConfig    rolloutinformers.MetricProviderConfigInformer
 }
 
 func newFakeDynamicInformer(objs ...runtime.Object) *fakeInformers {
@@ -47,6 +48,7 @@ func newFakeDynamicInformer(objs ...runtime.Object) *fakeInformers {
 		analysisRun:             NewTolerantAnalysisRunInformer(factory),
 		experiment:              NewTolerantExperimentInformer(factory),
 		clusterAnalysisTemplate: NewTolerantClusterAnalysisTemplateInformer(factory),
+		metricProviderConfig:    NewTolerantMetricProviderConfigInformer(factory),
 	}
 
 	// Start then stop the informer. We just want the informer to be filled in with the fake objects
@@ -55,7 +57,7 @@ func newFakeDynamicInformer(objs ...runtime.Object) *fakeInformers {
 	factory.Start(stopCh)
 	synced := factory.WaitForCacheSync(stopCh)
 	close(stopCh)
-	if len(synced) != 5 {
+	if len(synced) != 6 {
 		panic("could not sync fake informer")
 	}
 	for gvr, isSynced := range synced {